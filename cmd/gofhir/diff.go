@@ -0,0 +1,197 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/robertoaraneda/gofhir/pkg/common"
+)
+
+func newDiffCmd() *cobra.Command {
+	var patch bool
+
+	cmd := &cobra.Command{
+		Use:   "diff [old] [new]",
+		Short: "Compare two FHIR resources",
+		Long: `Compare two FHIR resources and print the paths that differ.
+
+Both resources are compared in canonical form, so differences in key order
+or whitespace are ignored.
+
+Examples:
+  gofhir diff old.json new.json
+  gofhir diff old.json new.json --patch`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			oldData, err := os.ReadFile(args[0])
+			if err != nil {
+				return fmt.Errorf("failed to read file %s: %w", args[0], err)
+			}
+			newData, err := os.ReadFile(args[1])
+			if err != nil {
+				return fmt.Errorf("failed to read file %s: %w", args[1], err)
+			}
+
+			changes, err := common.DiffResources(oldData, newData)
+			if err != nil {
+				return err
+			}
+
+			if patch {
+				return outputPatch(cmd.OutOrStdout(), changes)
+			}
+			return outputChanges(cmd.OutOrStdout(), changes)
+		},
+	}
+
+	cmd.Flags().BoolVar(&patch, "patch", false, "Emit a FHIRPath Patch Parameters resource instead of a change list")
+
+	return cmd
+}
+
+// canonicalJSON returns the compact canonical JSON encoding of a decoded value.
+func canonicalJSON(v interface{}) string {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+	return string(data)
+}
+
+func outputChanges(w io.Writer, changes []common.Change) error {
+	if len(changes) == 0 {
+		fmt.Fprintln(w, "No differences")
+		return nil
+	}
+
+	for _, change := range changes {
+		switch change.Op {
+		case common.ChangeAdded:
+			fmt.Fprintf(w, "+ %s: %s\n", change.Path, canonicalJSON(change.New))
+		case common.ChangeRemoved:
+			fmt.Fprintf(w, "- %s: %s\n", change.Path, canonicalJSON(change.Old))
+		default:
+			fmt.Fprintf(w, "~ %s: %s -> %s\n", change.Path, canonicalJSON(change.Old), canonicalJSON(change.New))
+		}
+	}
+	return nil
+}
+
+// outputPatch prints the changes as a FHIRPath Patch Parameters resource.
+// See https://hl7.org/fhir/fhirpatch.html
+func outputPatch(w io.Writer, changes []common.Change) error {
+	operations := make([]interface{}, 0, len(changes))
+	for _, change := range changes {
+		operations = append(operations, patchOperation(change))
+	}
+
+	params := map[string]interface{}{
+		"resourceType": "Parameters",
+		"parameter":    operations,
+	}
+
+	jsonBytes, err := json.MarshalIndent(params, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal patch: %w", err)
+	}
+
+	fmt.Fprintln(w, string(jsonBytes))
+	return nil
+}
+
+// patchOperation converts a change into a FHIRPath Patch "operation" parameter.
+func patchOperation(change common.Change) map[string]interface{} {
+	var parts []interface{}
+
+	switch change.Op {
+	case common.ChangeAdded:
+		parent, name := patchTarget(change.Path)
+		parts = []interface{}{
+			patchPart("type", "valueCode", "add"),
+			patchPart("path", "valueString", parent),
+			patchPart("name", "valueString", name),
+			patchValue("value", change.New),
+		}
+	case common.ChangeRemoved:
+		parts = []interface{}{
+			patchPart("type", "valueCode", "delete"),
+			patchPart("path", "valueString", change.Path),
+		}
+	default:
+		parts = []interface{}{
+			patchPart("type", "valueCode", "replace"),
+			patchPart("path", "valueString", change.Path),
+			patchValue("value", change.New),
+		}
+	}
+
+	return map[string]interface{}{
+		"name": "operation",
+		"part": parts,
+	}
+}
+
+// patchTarget splits the path of an added element into the path of its parent
+// and its element name (e.g., "Patient.name[1]" -> "Patient", "name").
+func patchTarget(path string) (parent, name string) {
+	if i := strings.LastIndex(path, "["); i >= 0 && strings.HasSuffix(path, "]") {
+		path = path[:i]
+	}
+	i := strings.LastIndex(path, ".")
+	if i < 0 {
+		return path, ""
+	}
+	return path[:i], path[i+1:]
+}
+
+func patchPart(name, valueKey string, value interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"name":   name,
+		valueKey: value,
+	}
+}
+
+// patchValue encodes a decoded JSON value as a Parameters part.
+// Primitives use the matching value[x]; complex values are expressed as nested parts.
+func patchValue(name string, value interface{}) map[string]interface{} {
+	switch v := value.(type) {
+	case string:
+		return patchPart(name, "valueString", v)
+	case bool:
+		return patchPart(name, "valueBoolean", v)
+	case json.Number:
+		if strings.ContainsAny(v.String(), ".eE") {
+			return patchPart(name, "valueDecimal", v)
+		}
+		return patchPart(name, "valueInteger", v)
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		parts := make([]interface{}, 0, len(keys))
+		for _, key := range keys {
+			if arr, ok := v[key].([]interface{}); ok {
+				for _, item := range arr {
+					parts = append(parts, patchValue(key, item))
+				}
+				continue
+			}
+			parts = append(parts, patchValue(key, v[key]))
+		}
+		return map[string]interface{}{
+			"name": name,
+			"part": parts,
+		}
+	default:
+		return patchPart(name, "valueString", canonicalJSON(v))
+	}
+}
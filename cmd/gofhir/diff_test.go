@@ -0,0 +1,155 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const (
+	diffOldPatient = `{"resourceType": "Patient", "id": "p1", "active": true, "gender": "male", "name": [{"family": "Doe"}]}`
+	diffNewPatient = `{"resourceType": "Patient", "id": "p1", "gender": "female", "name": [{"family": "Doe"}, {"family": "Roe"}]}`
+	// diffOldPatient with its keys reordered and other whitespace
+	diffSamePatient = `{
+  "name": [{"family": "Doe"}],
+  "gender": "male",
+  "active": true,
+  "id": "p1",
+  "resourceType": "Patient"
+}`
+)
+
+// writeDiffFixtures writes the old and new resources to files, returning their paths.
+func writeDiffFixtures(t *testing.T, oldResource, newResource string) (oldPath, newPath string) {
+	t.Helper()
+	dir := t.TempDir()
+	oldPath = filepath.Join(dir, "old.json")
+	newPath = filepath.Join(dir, "new.json")
+	if err := os.WriteFile(oldPath, []byte(oldResource), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(newPath, []byte(newResource), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return oldPath, newPath
+}
+
+func TestDiff(t *testing.T) {
+	tests := []struct {
+		name        string
+		oldResource string
+		newResource string
+		want        []string
+	}{
+		{
+			name:        "changes",
+			oldResource: diffOldPatient,
+			newResource: diffNewPatient,
+			want: []string{
+				`- Patient.active: true`,
+				`~ Patient.gender: "male" -> "female"`,
+				`+ Patient.name[1]: {"family":"Roe"}`,
+			},
+		},
+		{
+			name:        "no differences",
+			oldResource: diffOldPatient,
+			newResource: diffSamePatient,
+			want:        []string{"No differences"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			oldPath, newPath := writeDiffFixtures(t, tt.oldResource, tt.newResource)
+			stdout, _, err := runCLI("diff", oldPath, newPath)
+			if err != nil {
+				t.Fatalf("diff error = %v", err)
+			}
+			if got := strings.Split(strings.TrimSpace(stdout), "\n"); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("diff output = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDiffPatch(t *testing.T) {
+	tests := []struct {
+		name        string
+		oldResource string
+		newResource string
+		want        string
+	}{
+		{
+			name:        "changes",
+			oldResource: diffOldPatient,
+			newResource: diffNewPatient,
+			want: `{"resourceType": "Parameters", "parameter": [
+				{"name": "operation", "part": [
+					{"name": "type", "valueCode": "delete"},
+					{"name": "path", "valueString": "Patient.active"}]},
+				{"name": "operation", "part": [
+					{"name": "type", "valueCode": "replace"},
+					{"name": "path", "valueString": "Patient.gender"},
+					{"name": "value", "valueString": "female"}]},
+				{"name": "operation", "part": [
+					{"name": "type", "valueCode": "add"},
+					{"name": "path", "valueString": "Patient"},
+					{"name": "name", "valueString": "name"},
+					{"name": "value", "part": [{"name": "family", "valueString": "Roe"}]}]}]}`,
+		},
+		{
+			name:        "no differences",
+			oldResource: diffOldPatient,
+			newResource: diffSamePatient,
+			want:        `{"resourceType": "Parameters", "parameter": []}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			oldPath, newPath := writeDiffFixtures(t, tt.oldResource, tt.newResource)
+			stdout, _, err := runCLI("diff", oldPath, newPath, "--patch")
+			if err != nil {
+				t.Fatalf("diff --patch error = %v", err)
+			}
+
+			var got, want interface{}
+			if err := json.Unmarshal([]byte(stdout), &got); err != nil {
+				t.Fatalf("output is not JSON: %v\n%s", err, stdout)
+			}
+			if err := json.Unmarshal([]byte(tt.want), &want); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("diff --patch output = %s", stdout)
+			}
+		})
+	}
+}
+
+func TestPatchTarget(t *testing.T) {
+	tests := []struct {
+		path       string
+		wantParent string
+		wantName   string
+	}{
+		{"Patient.active", "Patient", "active"},
+		{"Patient.name[1]", "Patient", "name"},
+		{"Patient.name[0].given[2]", "Patient.name[0]", "given"},
+		{"Patient.contact[0].name", "Patient.contact[0]", "name"},
+		{"Patient", "Patient", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			parent, name := patchTarget(tt.path)
+			if parent != tt.wantParent || name != tt.wantName {
+				t.Errorf("patchTarget(%q) = %q, %q; want %q, %q", tt.path, parent, name, tt.wantParent, tt.wantName)
+			}
+		})
+	}
+}
@@ -2,7 +2,9 @@ package main
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"strings"
@@ -17,7 +19,9 @@ var version = "dev"
 
 func main() {
 	if err := execute(); err != nil {
-		fmt.Fprintln(os.Stderr, err)
+		if !errors.Is(err, errEmptyResult) {
+			fmt.Fprintln(os.Stderr, err)
+		}
 		os.Exit(1)
 	}
 }
@@ -46,6 +50,7 @@ For more information, visit: https://github.com/robertoaraneda/gofhir`,
 	rootCmd.AddCommand(newValidateCmd())
 	rootCmd.AddCommand(newFHIRPathCmd())
 	rootCmd.AddCommand(newGenerateCmd())
+	rootCmd.AddCommand(newDiffCmd())
 
 	return rootCmd
 }
@@ -60,30 +65,17 @@ func newVersionCmd() *cobra.Command {
 	}
 }
 
-func newValidateCmd() *cobra.Command {
-	cmd := &cobra.Command{
-		Use:   "validate [file]",
-		Short: "Validate a FHIR resource",
-		Long:  `Validate a FHIR resource against its StructureDefinition.`,
-		Args:  cobra.ExactArgs(1),
-		RunE: func(cmd *cobra.Command, args []string) error {
-			// TODO: Implement validation
-			fmt.Printf("Validating: %s\n", args[0])
-			fmt.Println("Validation not yet implemented")
-			return nil
-		},
-	}
-
-	cmd.Flags().StringP("version", "v", "R4", "FHIR version (R4, R4B, R5)")
-	cmd.Flags().Bool("constraints", true, "Validate FHIRPath constraints")
-	cmd.Flags().Bool("terminology", false, "Validate terminology bindings")
-	cmd.Flags().StringP("output", "o", "text", "Output format (text, json)")
-
-	return cmd
-}
+// errEmptyResult is returned by fhirpath --exists when the result is empty, so
+// that the command exits with status 1.
+var errEmptyResult = errors.New("expression result is empty")
 
 func newFHIRPathCmd() *cobra.Command {
-	var outputFormat string
+	var (
+		outputFormat string
+		countOnly    bool
+		existsOnly   bool
+		expectType   string
+	)
 
 	cmd := &cobra.Command{
 		Use:   "fhirpath [expression] [file]",
@@ -93,9 +85,12 @@ func newFHIRPathCmd() *cobra.Command {
 Examples:
   gofhir fhirpath "Patient.name.given" patient.json
   gofhir fhirpath "Observation.value.ofType(Quantity).value" observation.json
-  gofhir fhirpath "Bundle.entry.resource.ofType(Patient)" bundle.json --output json`,
+  gofhir fhirpath "Bundle.entry.resource.ofType(Patient)" bundle.json --output json
+  gofhir fhirpath "Patient.name" patient.json --count
+  gofhir fhirpath "Patient.deceased" patient.json --exists && echo deceased
+  gofhir fhirpath "Patient.birthDate" patient.json --expect-type date`,
 		Args: cobra.ExactArgs(2),
-		RunE: func(_ *cobra.Command, args []string) error {
+		RunE: func(cmd *cobra.Command, args []string) error {
 			expression := args[0]
 			filePath := args[1]
 
@@ -117,75 +112,97 @@ Examples:
 				return fmt.Errorf("evaluation error: %w", err)
 			}
 
+			// Check the values against the expected search parameter type
+			if expectType != "" {
+				mismatches, err := checkSearchType(result, expectType)
+				if err != nil {
+					return err
+				}
+				if len(mismatches) > 0 {
+					for _, mismatch := range mismatches {
+						fmt.Fprintln(cmd.ErrOrStderr(), mismatch)
+					}
+					cmd.SilenceUsage = true
+					return fmt.Errorf("%d of %d values do not conform to search type %s", len(mismatches), len(result), expectType)
+				}
+			}
+
+			// Short-circuit modes for scripting
+			switch {
+			case countOnly:
+				fmt.Fprintln(cmd.OutOrStdout(), len(result))
+				return nil
+			case existsOnly:
+				fmt.Fprintln(cmd.OutOrStdout(), !result.Empty())
+				if result.Empty() {
+					cmd.SilenceUsage = true
+					cmd.SilenceErrors = true
+					return errEmptyResult
+				}
+				return nil
+			}
+
 			// Output the result
 			switch outputFormat {
 			case "json":
-				return outputJSON(result)
+				return outputJSON(cmd.OutOrStdout(), result)
 			default:
-				return outputText(result)
+				return outputText(cmd.OutOrStdout(), result)
 			}
 		},
 	}
 
 	cmd.Flags().StringVarP(&outputFormat, "output", "o", "text", "Output format (text, json)")
+	cmd.Flags().BoolVar(&countOnly, "count", false, "Print only the number of items in the result")
+	cmd.Flags().BoolVar(&existsOnly, "exists", false, "Print whether the result is non-empty and exit with status 1 if it is empty")
+	cmd.Flags().StringVar(&expectType, "expect-type", "",
+		"Check that each value conforms to a search parameter type ("+strings.Join(searchTypeNames(), ", ")+")")
+	cmd.MarkFlagsMutuallyExclusive("count", "exists")
 
 	return cmd
 }
 
-func outputText(result fhirpath.Collection) error {
+func outputText(out io.Writer, result fhirpath.Collection) error {
 	if result.Empty() {
-		fmt.Println("(empty)")
+		fmt.Fprintln(out, "(empty)")
 		return nil
 	}
 
 	for i, value := range result {
 		if len(result) > 1 {
-			fmt.Printf("[%d] ", i)
+			fmt.Fprintf(out, "[%d] ", i)
 		}
-		fmt.Println(value.String())
+		fmt.Fprintln(out, value.String())
 	}
 	return nil
 }
 
-func outputJSON(result fhirpath.Collection) error {
+func outputJSON(out io.Writer, result fhirpath.Collection) error {
 	if result.Empty() {
-		fmt.Println("[]")
+		fmt.Fprintln(out, "[]")
 		return nil
 	}
 
-	// Convert to JSON-serializable format
-	output := make([]interface{}, len(result))
-	for i, value := range result {
-		output[i] = valueToInterface(value)
-	}
-
-	jsonBytes, err := json.MarshalIndent(output, "", "  ")
+	// Values serialize to their FHIR JSON representation
+	jsonBytes, err := json.MarshalIndent(result, "", "  ")
 	if err != nil {
 		return fmt.Errorf("failed to marshal result: %w", err)
 	}
 
-	fmt.Println(string(jsonBytes))
+	fmt.Fprintln(out, string(jsonBytes))
 	return nil
 }
 
-func valueToInterface(v fhirpath.Value) interface{} {
-	switch val := v.(type) {
-	case interface{ Bool() bool }:
-		return val.Bool()
-	case interface{ Value() int64 }:
-		return val.Value()
-	case interface{ Value() string }:
-		return val.Value()
-	default:
-		return v.String()
-	}
-}
-
 func newGenerateCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "generate",
 		Short: "Generate Go types from FHIR specifications",
-		Long:  `Generate Go types, builders, and utilities from FHIR StructureDefinitions.`,
+		Long: `Generate Go types, builders, and utilities from FHIR StructureDefinitions.
+
+Use --resource to regenerate only the files of specific resources, which is
+much faster than regenerating a whole version:
+
+  gofhir generate --version r5 --resource Observation`,
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			specsDir, err := cmd.Flags().GetString("specs")
 			if err != nil {
@@ -199,6 +216,18 @@ func newGenerateCmd() *cobra.Command {
 			if err != nil {
 				return fmt.Errorf("failed to get version flag: %w", err)
 			}
+			categoryInterfaces, err := cmd.Flags().GetBool("category-interfaces")
+			if err != nil {
+				return fmt.Errorf("failed to get category-interfaces flag: %w", err)
+			}
+			resources, err := cmd.Flags().GetStringSlice("resource")
+			if err != nil {
+				return fmt.Errorf("failed to get resource flag: %w", err)
+			}
+			dryRun, err := cmd.Flags().GetBool("dry-run")
+			if err != nil {
+				return fmt.Errorf("failed to get dry-run flag: %w", err)
+			}
 
 			// Normalize version to lowercase
 			fhirVersion = strings.ToLower(fhirVersion)
@@ -212,10 +241,13 @@ func newGenerateCmd() *cobra.Command {
 				fmt.Printf("Generating FHIR %s types...\n", strings.ToUpper(v))
 
 				config := generator.Config{
-					SpecsDir:    specsDir,
-					OutputDir:   filepath.Join(outputDir, v),
-					PackageName: v,
-					Version:     v,
+					SpecsDir:           specsDir,
+					OutputDir:          filepath.Join(outputDir, v),
+					PackageName:        v,
+					Version:            v,
+					CategoryInterfaces: categoryInterfaces,
+					Resources:          resources,
+					DryRun:             dryRun,
 				}
 
 				gen := generator.New(config)
@@ -225,14 +257,30 @@ func newGenerateCmd() *cobra.Command {
 					return fmt.Errorf("failed to load types for %s: %w", v, err)
 				}
 
-				fmt.Printf("  Generating code to %s...\n", config.OutputDir)
+				if !dryRun {
+					fmt.Printf("  Generating code to %s...\n", config.OutputDir)
+				}
 				if err := gen.Generate(); err != nil {
 					return fmt.Errorf("failed to generate code for %s: %w", v, err)
 				}
 
+				summary := gen.Summary()
+				if dryRun {
+					fmt.Printf("  Would write %d files to %s:\n", len(summary.Files), config.OutputDir)
+					for _, file := range summary.Files {
+						fmt.Printf("    %s\n", filepath.Base(file))
+					}
+					fmt.Printf("  Would generate %d resources and %d types\n\n", summary.Resources, summary.Types)
+					continue
+				}
+				fmt.Printf("  Generated %d files with %d resources and %d types\n", len(summary.Files), summary.Resources, summary.Types)
 				fmt.Printf("  Done with %s\n\n", strings.ToUpper(v))
 			}
 
+			if dryRun {
+				fmt.Println("Dry run complete; no files were written.")
+				return nil
+			}
 			fmt.Println("Code generation complete!")
 			return nil
 		},
@@ -241,6 +289,9 @@ func newGenerateCmd() *cobra.Command {
 	cmd.Flags().String("specs", "./specs", "Path to FHIR specifications")
 	cmd.Flags().String("output", "./pkg/fhir", "Output directory")
 	cmd.Flags().String("version", "r4", "FHIR version to generate (r4, r4b, r5, all)")
+	cmd.Flags().Bool("category-interfaces", true, "Generate resource category interfaces (e.g., CanonicalResource)")
+	cmd.Flags().StringSlice("resource", nil, "Only regenerate the files of these resources (e.g., Observation)")
+	cmd.Flags().Bool("dry-run", false, "Report the files that would be generated without writing them")
 
 	return cmd
 }
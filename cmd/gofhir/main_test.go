@@ -0,0 +1,200 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestFHIRPathJSONOutput(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "observation.json")
+	observation := `{
+		"resourceType": "Observation",
+		"effectiveDateTime": "2024-03-01T10:30:00Z",
+		"valueQuantity": {"value": 98.60, "unit": "[degF]", "system": "http://unitsofmeasure.org", "code": "[degF]"}
+	}`
+	if err := os.WriteFile(path, []byte(observation), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	evaluate := func(t *testing.T, expression string) []interface{} {
+		t.Helper()
+		stdout, _, err := runCLI("fhirpath", expression, path, "-o", "json")
+		if err != nil {
+			t.Fatalf("fhirpath %q error = %v", expression, err)
+		}
+		var output []interface{}
+		if err := json.Unmarshal([]byte(stdout), &output); err != nil {
+			t.Fatalf("output is not a JSON array: %v\n%s", err, stdout)
+		}
+		return output
+	}
+
+	t.Run("decimal is a number", func(t *testing.T) {
+		output := evaluate(t, "(120+80)/2")
+		if len(output) != 1 {
+			t.Fatalf("output = %v, want one value", output)
+		}
+		if got, ok := output[0].(float64); !ok || got != 100 {
+			t.Errorf("output[0] = %#v, want the number 100", output[0])
+		}
+	})
+
+	t.Run("decimal keeps its precision", func(t *testing.T) {
+		stdout, _, err := runCLI("fhirpath", "Observation.value.value", path, "-o", "json")
+		if err != nil {
+			t.Fatalf("fhirpath error = %v", err)
+		}
+		if !strings.Contains(stdout, "98.60") {
+			t.Errorf("output = %s, want 98.60", stdout)
+		}
+	})
+
+	t.Run("quantity is an object", func(t *testing.T) {
+		output := evaluate(t, "5 'mg' + 3 'mg'")
+		want := map[string]interface{}{"value": float64(8), "unit": "mg", "system": "http://unitsofmeasure.org", "code": "mg"}
+		if len(output) != 1 || !mapsEqual(output[0], want) {
+			t.Errorf("output = %#v, want %v", output, want)
+		}
+	})
+
+	t.Run("calendar duration is not coded", func(t *testing.T) {
+		output := evaluate(t, "2 days")
+		want := map[string]interface{}{"value": float64(2), "unit": "days"}
+		if len(output) != 1 || !mapsEqual(output[0], want) {
+			t.Errorf("output = %#v, want %v", output, want)
+		}
+	})
+
+	t.Run("datetime is a string", func(t *testing.T) {
+		output := evaluate(t, "Observation.effective")
+		if len(output) != 1 || output[0] != "2024-03-01T10:30:00Z" {
+			t.Errorf("output = %#v, want the dateTime string", output)
+		}
+	})
+}
+
+// mapsEqual reports whether got is a JSON object with exactly the entries of want.
+func mapsEqual(got interface{}, want map[string]interface{}) bool {
+	m, ok := got.(map[string]interface{})
+	if !ok || len(m) != len(want) {
+		return false
+	}
+	for key, value := range want {
+		if m[key] != value {
+			return false
+		}
+	}
+	return true
+}
+
+func TestFHIRPathCountAndExists(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "patient.json")
+	patient := `{
+		"resourceType": "Patient",
+		"name": [{"family": "Doe", "given": ["John", "Q"]}, {"given": ["Johnny"]}]
+	}`
+	if err := os.WriteFile(path, []byte(patient), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("count", func(t *testing.T) {
+		tests := []struct {
+			expression string
+			want       string
+		}{
+			{"Patient.name.given", "3"},
+			{"Patient.name.family", "1"},
+			{"Patient.birthDate", "0"},
+		}
+		for _, tt := range tests {
+			stdout, _, err := runCLI("fhirpath", tt.expression, path, "--count")
+			if err != nil {
+				t.Fatalf("fhirpath %q --count error = %v", tt.expression, err)
+			}
+			if got := strings.TrimSpace(stdout); got != tt.want {
+				t.Errorf("fhirpath %q --count = %q, want %q", tt.expression, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("exists", func(t *testing.T) {
+		stdout, _, err := runCLI("fhirpath", "Patient.name.where(family = 'Doe')", path, "--exists")
+		if err != nil {
+			t.Errorf("fhirpath --exists error = %v, want nil for a non-empty result", err)
+		}
+		if got := strings.TrimSpace(stdout); got != "true" {
+			t.Errorf("fhirpath --exists = %q, want true", got)
+		}
+	})
+
+	t.Run("exists with empty result", func(t *testing.T) {
+		stdout, stderr, err := runCLI("fhirpath", "Patient.birthDate", path, "--exists")
+		if !errors.Is(err, errEmptyResult) {
+			t.Errorf("fhirpath --exists error = %v, want errEmptyResult", err)
+		}
+		if got := strings.TrimSpace(stdout); got != "false" {
+			t.Errorf("fhirpath --exists = %q, want false", got)
+		}
+		if stderr != "" {
+			t.Errorf("stderr = %q, want no output", stderr)
+		}
+	})
+
+	t.Run("count and exists are exclusive", func(t *testing.T) {
+		if _, _, err := runCLI("fhirpath", "Patient.name", path, "--count", "--exists"); err == nil {
+			t.Error("fhirpath --count --exists error = nil, want an error")
+		}
+	})
+}
+
+func TestFHIRPathExpectType(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "observation.json")
+	observation := `{
+		"resourceType": "Observation",
+		"status": "final",
+		"code": {"coding": [{"system": "http://loinc.org", "code": "8310-5"}]},
+		"subject": {"reference": "Patient/123"},
+		"effectiveDateTime": "2024-03-01T10:30:00Z",
+		"issued": "2024-03-01",
+		"valueQuantity": {"value": 37.5, "unit": "Cel", "system": "http://unitsofmeasure.org", "code": "Cel"},
+		"note": [{"text": "taken orally"}]
+	}`
+	if err := os.WriteFile(path, []byte(observation), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		expression string
+		searchType string
+		wantErr    bool
+	}{
+		{"Observation.status", "token", false},
+		{"Observation.code", "token", false},
+		{"Observation.effective | Observation.issued", "date", false},
+		{"Observation.subject", "reference", false},
+		{"Observation.value", "quantity", false},
+		{"Observation.note.text", "string", false},
+		{"Observation.status", "date", true},
+		{"Observation.subject", "token", true},
+		{"Observation.code | Observation.value", "quantity", true},
+	}
+	for _, tt := range tests {
+		_, stderr, err := runCLI("fhirpath", tt.expression, path, "--expect-type", tt.searchType)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("fhirpath %q --expect-type %s error = %v, wantErr %v", tt.expression, tt.searchType, err, tt.wantErr)
+		}
+		if tt.wantErr && !strings.Contains(stderr, "is not a "+tt.searchType+" value") {
+			t.Errorf("fhirpath %q --expect-type %s stderr = %q, want the mismatching values", tt.expression, tt.searchType, stderr)
+		}
+	}
+
+	t.Run("unknown type", func(t *testing.T) {
+		if _, _, err := runCLI("fhirpath", "Observation.status", path, "--expect-type", "number-ish"); err == nil {
+			t.Error("fhirpath --expect-type number-ish error = nil, want an error")
+		}
+	})
+}
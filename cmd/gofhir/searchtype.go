@@ -0,0 +1,85 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/robertoaraneda/gofhir/pkg/fhirpath"
+	"github.com/robertoaraneda/gofhir/pkg/fhirpath/types"
+)
+
+// searchTypeMatchers report whether a FHIRPath value can be indexed by a search
+// parameter of the given type, following the FHIR search type to data type
+// mapping. Primitives read from JSON are strings, so dates are recognized by
+// their format.
+var searchTypeMatchers = map[string]func(fhirpath.Value) bool{
+	"string": func(value fhirpath.Value) bool {
+		return isAnyType(value, "String", "HumanName", "Address")
+	},
+	"token": func(value fhirpath.Value) bool {
+		return isAnyType(value, "String", "Boolean", "Coding", "CodeableConcept", "Identifier", "ContactPoint")
+	},
+	"date": func(value fhirpath.Value) bool {
+		if s, ok := value.(types.String); ok {
+			return isDateString(s.Value())
+		}
+		return isAnyType(value, "Date", "DateTime", "Period")
+	},
+	"reference": func(value fhirpath.Value) bool {
+		if s, ok := value.(types.String); ok {
+			// canonical and uri elements
+			return strings.Contains(s.Value(), "/") || strings.HasPrefix(s.Value(), "urn:")
+		}
+		return isAnyType(value, "Reference")
+	},
+	"quantity": func(value fhirpath.Value) bool {
+		return isAnyType(value, "Quantity")
+	},
+}
+
+// searchTypeNames returns the supported --expect-type values.
+func searchTypeNames() []string {
+	names := make([]string, 0, len(searchTypeMatchers))
+	for name := range searchTypeMatchers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// checkSearchType returns a description of each value of result that does not
+// conform to the search parameter type searchType.
+func checkSearchType(result fhirpath.Collection, searchType string) ([]string, error) {
+	matches, ok := searchTypeMatchers[searchType]
+	if !ok {
+		return nil, fmt.Errorf("unknown search type %q (expected one of %s)", searchType, strings.Join(searchTypeNames(), ", "))
+	}
+
+	var mismatches []string
+	for i, value := range result {
+		if !matches(value) {
+			mismatches = append(mismatches, fmt.Sprintf("[%d] %s is not a %s value: %s", i, value.Type(), searchType, value.String()))
+		}
+	}
+	return mismatches, nil
+}
+
+// isAnyType reports whether the type of value is one of typeNames.
+func isAnyType(value fhirpath.Value, typeNames ...string) bool {
+	for _, name := range typeNames {
+		if value.Type() == name {
+			return true
+		}
+	}
+	return false
+}
+
+// isDateString reports whether s is a FHIR date, dateTime or instant.
+func isDateString(s string) bool {
+	if _, err := types.NewDate(s); err == nil {
+		return true
+	}
+	_, err := types.NewDateTime(s)
+	return err == nil
+}
@@ -0,0 +1,438 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/robertoaraneda/gofhir/pkg/validator"
+)
+
+// canonicalSDPrefix is the canonical URL prefix of the core FHIR StructureDefinitions.
+const canonicalSDPrefix = "http://hl7.org/fhir/StructureDefinition/"
+
+// Values accepted by the --fail-on flag.
+const (
+	failOnError   = "error"
+	failOnWarning = "warning"
+	failOnInfo    = "info"
+	failOnNever   = "never"
+)
+
+// Values accepted by the --format flag.
+const (
+	formatJSON   = "json"
+	formatNDJSON = "ndjson"
+)
+
+// severityRank orders issue severities from least to most severe.
+var severityRank = map[string]int{
+	validator.SeverityInformation: 1,
+	validator.SeverityWarning:     2,
+	validator.SeverityError:       3,
+	validator.SeverityFatal:       4,
+}
+
+// failOnRank maps --fail-on values to the minimum severity rank that fails validation.
+// Zero means validation never fails.
+var failOnRank = map[string]int{
+	failOnError:   severityRank[validator.SeverityError],
+	failOnWarning: severityRank[validator.SeverityWarning],
+	failOnInfo:    severityRank[validator.SeverityInformation],
+	failOnNever:   0,
+}
+
+// arrayIndexPattern matches array indices in issue paths (e.g., "[0]").
+var arrayIndexPattern = regexp.MustCompile(`\[\d+\]`)
+
+// validateOptions holds the flags of the validate command.
+type validateOptions struct {
+	fhirVersion string
+	specsDir    string
+	profile     string
+	constraints bool
+	terminology bool
+	output      string
+	explain     bool
+	failOn      string
+	quiet       bool
+	format      string
+}
+
+// explainedIssue is a validation issue enriched with the ElementDefinition that triggered it.
+type explainedIssue struct {
+	validator.ValidationIssue
+	// Element is the ElementDefinition at the issue location, if it could be resolved
+	Element *validator.ElementDef `json:"element,omitempty"`
+}
+
+func newValidateCmd() *cobra.Command {
+	opts := validateOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "validate [file]",
+		Short: "Validate a FHIR resource",
+		Long: `Validate a FHIR resource against its StructureDefinition.
+
+StructureDefinitions are loaded from profiles-resources.json, profiles-types.json
+and extension-definitions.json in the specs directory (default: ./specs/<version>).
+
+With --format ndjson the file is read as newline-delimited JSON (one resource per
+line, as produced by FHIR Bulk Data export) and each line is validated in turn.
+Text output prints a result per line followed by a summary; JSON output prints one
+OperationOutcome per line and writes the summary to stderr.
+
+Examples:
+  gofhir validate patient.json
+  gofhir validate patient.json --profile http://example.org/StructureDefinition/my-patient
+  gofhir validate patient.json --explain --output json
+  gofhir validate Patient.ndjson --format ndjson`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			threshold, ok := failOnRank[opts.failOn]
+			if !ok {
+				return fmt.Errorf("invalid --fail-on value %q (expected error, warning, info or never)", opts.failOn)
+			}
+
+			if opts.format != formatJSON && opts.format != formatNDJSON {
+				return fmt.Errorf("invalid --format value %q (expected json or ndjson)", opts.format)
+			}
+			if opts.format == formatNDJSON {
+				return runValidateNDJSON(cmd, args[0], opts, threshold)
+			}
+
+			resourceData, err := os.ReadFile(args[0])
+			if err != nil {
+				return fmt.Errorf("failed to read file %s: %w", args[0], err)
+			}
+
+			registry, err := loadValidationRegistry(opts)
+			if err != nil {
+				return err
+			}
+
+			v := validator.NewValidator(registry, validatorOptionsFor(opts))
+			result, err := v.Validate(context.Background(), resourceData)
+			if err != nil {
+				return fmt.Errorf("validation error: %w", err)
+			}
+
+			issues, failing := collectIssues(registry, opts, threshold, resourceData, result)
+
+			out := cmd.OutOrStdout()
+			switch opts.output {
+			case "json":
+				err = outputValidationJSON(out, failing == 0, issues)
+			default:
+				err = outputValidationText(out, result, issues, opts.explain, failing == 0)
+			}
+			if err != nil {
+				return err
+			}
+
+			if failing > 0 {
+				// The issues have already been printed; don't repeat the usage text
+				cmd.SilenceUsage = true
+				return fmt.Errorf("validation failed with %d issue(s) at or above severity %s", failing, opts.failOn)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.fhirVersion, "version", "v", "R4", "FHIR version (R4, R4B, R5)")
+	cmd.Flags().StringVar(&opts.specsDir, "specs", "", "Directory containing the FHIR specification JSON files (default ./specs/<version>)")
+	cmd.Flags().StringVar(&opts.profile, "profile", "", "Profile URL to validate against")
+	cmd.Flags().BoolVar(&opts.constraints, "constraints", true, "Validate FHIRPath constraints")
+	cmd.Flags().BoolVar(&opts.terminology, "terminology", false, "Validate terminology bindings")
+	cmd.Flags().StringVarP(&opts.output, "output", "o", "text", "Output format (text, json)")
+	cmd.Flags().BoolVar(&opts.explain, "explain", false, "Show the constraint and ElementDefinition behind each issue")
+	cmd.Flags().StringVar(&opts.failOn, "fail-on", failOnError, "Lowest issue severity that fails validation (error, warning, info, never)")
+	cmd.Flags().BoolVarP(&opts.quiet, "quiet", "q", false, "Only print issues that fail validation")
+	cmd.Flags().StringVar(&opts.format, "format", formatJSON, "Input format (json, ndjson)")
+
+	return cmd
+}
+
+// runValidateNDJSON validates each resource of an NDJSON file, printing a result per
+// line and a final summary. It fails if any resource has issues at or above the
+// --fail-on threshold.
+func runValidateNDJSON(cmd *cobra.Command, path string, opts validateOptions, threshold int) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to read file %s: %w", path, err)
+	}
+	defer file.Close()
+
+	registry, err := loadValidationRegistry(opts)
+	if err != nil {
+		return err
+	}
+
+	out := cmd.OutOrStdout()
+	var resources, invalid, errorCount, warningCount, failing int
+	v := validator.NewValidator(registry, validatorOptionsFor(opts))
+	err = v.ValidateNDJSON(context.Background(), file, func(r validator.NDJSONResult) error {
+		issues, lineFailing := collectIssues(registry, opts, threshold, r.Resource, r.Result)
+		resources++
+		if !r.Result.Valid {
+			invalid++
+		}
+		errorCount += r.Result.ErrorCount()
+		warningCount += r.Result.WarningCount()
+		failing += lineFailing
+
+		if opts.output == "json" {
+			return outputOperationOutcome(out, issues)
+		}
+		status := "valid"
+		if !r.Result.Valid {
+			status = "invalid"
+		}
+		fmt.Fprintf(out, "line %d %s: %s\n", r.Line, resourceLabel(r.Resource), status)
+		return outputIssuesText(out, "  ", issues, opts.explain)
+	})
+	if err != nil {
+		return fmt.Errorf("validation error: %w", err)
+	}
+
+	// Keep stdout valid NDJSON when printing OperationOutcomes
+	summaryOut := out
+	if opts.output == "json" {
+		summaryOut = cmd.ErrOrStderr()
+	}
+	fmt.Fprintf(summaryOut, "Validated %d resource(s): %d invalid, %d error(s), %d warning(s)\n",
+		resources, invalid, errorCount, warningCount)
+
+	if failing > 0 {
+		cmd.SilenceUsage = true
+		return fmt.Errorf("validation failed with %d issue(s) at or above severity %s", failing, opts.failOn)
+	}
+	return nil
+}
+
+// collectIssues returns the issues to print for a validation result, explained if
+// requested, and the number of issues at or above the failure threshold.
+func collectIssues(registry *validator.Registry, opts validateOptions, threshold int, resourceData []byte, result *validator.ValidationResult) (issues []explainedIssue, failing int) {
+	issues = make([]explainedIssue, 0, len(result.Issues))
+	for _, issue := range result.Issues {
+		isFailing := threshold > 0 && severityRank[issue.Severity] >= threshold
+		if isFailing {
+			failing++
+		}
+		if opts.quiet && !isFailing {
+			continue
+		}
+
+		explained := explainedIssue{ValidationIssue: issue}
+		if opts.explain {
+			explained.Element = explainIssue(registry, opts.profile, resourceData, issue)
+		}
+		issues = append(issues, explained)
+	}
+	return issues, failing
+}
+
+// resourceLabel returns "Type/id" for a resource, or "<invalid JSON>" if it cannot be parsed.
+func resourceLabel(resourceData []byte) string {
+	var header struct {
+		ResourceType string `json:"resourceType"`
+		ID           string `json:"id"`
+	}
+	if json.Unmarshal(resourceData, &header) != nil {
+		return "<invalid JSON>"
+	}
+	if header.ID == "" {
+		return header.ResourceType
+	}
+	return header.ResourceType + "/" + header.ID
+}
+
+// loadValidationRegistry loads the StructureDefinitions for the requested FHIR version.
+func loadValidationRegistry(opts validateOptions) (*validator.Registry, error) {
+	specsDir := opts.specsDir
+	if specsDir == "" {
+		specsDir = filepath.Join("specs", strings.ToLower(opts.fhirVersion))
+	}
+
+	registry := validator.NewRegistry(validator.FHIRVersion(strings.ToUpper(opts.fhirVersion)))
+	count, err := registry.LoadR4Specs(specsDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load specs from %s: %w", specsDir, err)
+	}
+	if count == 0 {
+		return nil, fmt.Errorf("no StructureDefinitions found in %s", specsDir)
+	}
+	return registry, nil
+}
+
+// validatorOptionsFor converts command flags into validator options.
+func validatorOptionsFor(opts validateOptions) validator.ValidatorOptions {
+	vopts := validator.DefaultValidatorOptions()
+	vopts.ValidateConstraints = opts.constraints
+	vopts.ValidateTerminology = opts.terminology
+	vopts.Profile = opts.profile
+
+	switch strings.ToUpper(opts.fhirVersion) {
+	case "R4B":
+		vopts.TerminologyService = validator.TerminologyEmbeddedR4B
+	case "R5":
+		vopts.TerminologyService = validator.TerminologyEmbeddedR5
+	default:
+		vopts.TerminologyService = validator.TerminologyEmbeddedR4
+	}
+	return vopts
+}
+
+// explainIssue resolves the ElementDefinition at the location of an issue.
+// For constraint violations, only the triggering constraint is kept in the snippet.
+func explainIssue(registry *validator.Registry, profile string, resourceData []byte, issue validator.ValidationIssue) *validator.ElementDef {
+	if len(issue.Expression) == 0 {
+		return nil
+	}
+
+	ctx := context.Background()
+	var sd *validator.StructureDef
+	var err error
+	if profile != "" {
+		sd, err = registry.Get(ctx, profile)
+	} else {
+		var header struct {
+			ResourceType string `json:"resourceType"`
+		}
+		if json.Unmarshal(resourceData, &header) != nil {
+			return nil
+		}
+		sd, err = registry.GetByType(ctx, header.ResourceType)
+	}
+	if err != nil {
+		return nil
+	}
+
+	path := arrayIndexPattern.ReplaceAllString(issue.Expression[0], "")
+	elem := findElementDef(ctx, registry, sd, path)
+	if elem == nil {
+		return nil
+	}
+
+	snippet := *elem
+	snippet.Constraints = nil
+	if issue.Constraint != nil {
+		for _, c := range elem.Constraints {
+			if c.Key == issue.Constraint.Key {
+				snippet.Constraints = []validator.ElementConstraint{c}
+			}
+		}
+	}
+	return &snippet
+}
+
+// findElementDef finds the ElementDefinition for a path in sd, descending into
+// the StructureDefinitions of complex types when the path leaves sd.
+func findElementDef(ctx context.Context, registry *validator.Registry, sd *validator.StructureDef, path string) *validator.ElementDef {
+	if elem, ok := sd.Element(path); ok {
+		return elem
+	}
+
+	// Find the deepest ancestor defined in sd and continue in its type
+	segments := strings.Split(path, ".")
+	for i := len(segments) - 1; i > 0; i-- {
+		parent, ok := sd.Element(strings.Join(segments[:i], "."))
+		if !ok || len(parent.Types) == 0 {
+			continue
+		}
+		typeSD, err := registry.Get(ctx, canonicalSDPrefix+parent.Types[0].Code)
+		if err != nil || typeSD == sd {
+			return nil
+		}
+		typePath := typeSD.Type + "." + strings.Join(segments[i:], ".")
+		return findElementDef(ctx, registry, typeSD, typePath)
+	}
+	return nil
+}
+
+// outputValidationText prints the issues and a summary line, which reports whether
+// any issue reached the --fail-on threshold.
+func outputValidationText(w io.Writer, result *validator.ValidationResult, issues []explainedIssue, explain, passed bool) error {
+	if err := outputIssuesText(w, "", issues, explain); err != nil {
+		return err
+	}
+
+	status := "Validation passed"
+	if !passed {
+		status = "Validation failed"
+	}
+	fmt.Fprintf(w, "%s: %d error(s), %d warning(s)\n", status, result.ErrorCount(), result.WarningCount())
+	return nil
+}
+
+// outputIssuesText prints one line per issue, prefixed with indent.
+func outputIssuesText(w io.Writer, indent string, issues []explainedIssue, explain bool) error {
+	for _, issue := range issues {
+		location := ""
+		if len(issue.Expression) > 0 {
+			location = " " + issue.Expression[0]
+		}
+		fmt.Fprintf(w, "%s%s [%s]%s: %s\n", indent, issue.Severity, issue.Code, location, issue.Diagnostics)
+
+		if !explain {
+			continue
+		}
+		if c := issue.Constraint; c != nil {
+			fmt.Fprintf(w, "%s    constraint: %s (%s)\n", indent, c.Key, c.Severity)
+			fmt.Fprintf(w, "%s    human:      %s\n", indent, c.Human)
+			fmt.Fprintf(w, "%s    expression: %s\n", indent, c.Expression)
+			fmt.Fprintf(w, "%s    source:     %s\n", indent, c.Source)
+		}
+		if issue.Element != nil {
+			jsonBytes, err := json.MarshalIndent(issue.Element, indent+"    ", "  ")
+			if err != nil {
+				return fmt.Errorf("failed to marshal element: %w", err)
+			}
+			fmt.Fprintf(w, "%s    element:    %s\n", indent, jsonBytes)
+		}
+	}
+	return nil
+}
+
+// outputValidationJSON prints the issues and whether validation passed, which, as
+// in the text summary, depends on the --fail-on threshold.
+func outputValidationJSON(w io.Writer, valid bool, issues []explainedIssue) error {
+	output := struct {
+		Valid  bool             `json:"valid"`
+		Issues []explainedIssue `json:"issues,omitempty"`
+	}{
+		Valid:  valid,
+		Issues: issues,
+	}
+
+	jsonBytes, err := json.MarshalIndent(output, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	fmt.Fprintln(w, string(jsonBytes))
+	return nil
+}
+
+// outputOperationOutcome prints the issues of one resource as a single-line
+// OperationOutcome.
+func outputOperationOutcome(w io.Writer, issues []explainedIssue) error {
+	result := validator.ValidationResult{Issues: make([]validator.ValidationIssue, 0, len(issues))}
+	for _, issue := range issues {
+		result.Issues = append(result.Issues, issue.ValidationIssue)
+	}
+
+	jsonBytes, err := json.Marshal(result.ToOperationOutcome())
+	if err != nil {
+		return fmt.Errorf("failed to marshal OperationOutcome: %w", err)
+	}
+	fmt.Fprintln(w, string(jsonBytes))
+	return nil
+}
@@ -0,0 +1,375 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/robertoaraneda/gofhir/pkg/validator"
+)
+
+// testPatientSD is a minimal Patient StructureDefinition with a required gender.
+const testPatientSD = `{
+	"resourceType": "StructureDefinition",
+	"url": "http://hl7.org/fhir/StructureDefinition/Patient",
+	"name": "Patient",
+	"kind": "resource",
+	"type": "Patient",
+	"snapshot": {
+		"element": [
+			{"id": "Patient", "path": "Patient", "min": 0, "max": "*"},
+			{"id": "Patient.id", "path": "Patient.id", "min": 0, "max": "1", "type": [{"code": "id"}]},
+			{"id": "Patient.gender", "path": "Patient.gender", "min": 1, "max": "1", "type": [{"code": "code"}]}
+		]
+	}
+}`
+
+// testWarningPatientSD is a minimal Patient StructureDefinition with a required
+// gender and warning-level invariants, one of which asks for a name.
+const testWarningPatientSD = `{
+	"resourceType": "StructureDefinition",
+	"url": "http://hl7.org/fhir/StructureDefinition/Patient",
+	"name": "Patient",
+	"kind": "resource",
+	"type": "Patient",
+	"snapshot": {
+		"element": [
+			{"id": "Patient", "path": "Patient", "min": 0, "max": "*", "constraint": [
+				{"key": "pat-w1", "severity": "warning", "human": "A patient should have a name", "expression": "name.exists()"},
+				{"key": "pat-w2", "severity": "warning", "human": "An id is not empty", "expression": "id.exists() implies id.length() > 0"}
+			]},
+			{"id": "Patient.id", "path": "Patient.id", "min": 0, "max": "1", "type": [{"code": "id"}]},
+			{"id": "Patient.name", "path": "Patient.name", "min": 0, "max": "*", "type": [{"code": "HumanName"}]},
+			{"id": "Patient.gender", "path": "Patient.gender", "min": 1, "max": "1", "type": [{"code": "code"}]}
+		]
+	}
+}`
+
+// writeSpecsFixture writes a specs directory holding sd as its resource profiles
+// and returns its path.
+func writeSpecsFixture(t *testing.T, sd string) string {
+	t.Helper()
+	specsDir := filepath.Join(t.TempDir(), "specs")
+	if err := os.Mkdir(specsDir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(specsDir, "profiles-resources.json"), []byte(sd), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return specsDir
+}
+
+// writeResourceFixture writes resource to a JSON file and returns its path.
+func writeResourceFixture(t *testing.T, resource string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "resource.json")
+	if err := os.WriteFile(path, []byte(resource), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+// writeValidateFixtures writes a specs directory and an NDJSON file with one valid
+// and one invalid Patient, returning their paths.
+func writeValidateFixtures(t *testing.T) (specsDir, ndjsonPath string) {
+	t.Helper()
+	specsDir = writeSpecsFixture(t, testPatientSD)
+
+	ndjsonPath = filepath.Join(t.TempDir(), "Patient.ndjson")
+	ndjson := `{"resourceType": "Patient", "id": "p1", "gender": "male"}
+{"resourceType": "Patient", "id": "p2"}
+`
+	if err := os.WriteFile(ndjsonPath, []byte(ndjson), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return specsDir, ndjsonPath
+}
+
+// runCLI runs the gofhir command with args and returns its stdout, stderr and error.
+func runCLI(args ...string) (stdout, stderr string, err error) {
+	var out, errOut bytes.Buffer
+	cmd := newRootCmd()
+	cmd.SetOut(&out)
+	cmd.SetErr(&errOut)
+	cmd.SetArgs(args)
+	err = cmd.Execute()
+	return out.String(), errOut.String(), err
+}
+
+func TestValidateNDJSON(t *testing.T) {
+	specsDir, ndjsonPath := writeValidateFixtures(t)
+
+	t.Run("text output", func(t *testing.T) {
+		stdout, _, err := runCLI("validate", ndjsonPath, "--format", "ndjson", "--specs", specsDir, "--constraints=false")
+		if err == nil {
+			t.Fatal("expected an error because p2 is invalid")
+		}
+
+		lines := strings.Split(strings.TrimSpace(stdout), "\n")
+		if len(lines) != 4 {
+			t.Fatalf("expected 4 output lines, got %d:\n%s", len(lines), stdout)
+		}
+		if lines[0] != "line 1 Patient/p1: valid" {
+			t.Errorf("line 0 = %q", lines[0])
+		}
+		if lines[1] != "line 2 Patient/p2: invalid" {
+			t.Errorf("line 1 = %q", lines[1])
+		}
+		if !strings.HasPrefix(lines[2], "  error [required]") {
+			t.Errorf("line 2 = %q, want an indented required error", lines[2])
+		}
+		if lines[3] != "Validated 2 resource(s): 1 invalid, 1 error(s), 0 warning(s)" {
+			t.Errorf("summary = %q", lines[3])
+		}
+	})
+
+	t.Run("json output", func(t *testing.T) {
+		stdout, stderr, err := runCLI("validate", ndjsonPath, "--format", "ndjson", "--specs", specsDir, "--constraints=false", "-o", "json")
+		if err == nil {
+			t.Fatal("expected an error because p2 is invalid")
+		}
+
+		lines := strings.Split(strings.TrimSpace(stdout), "\n")
+		if len(lines) != 2 {
+			t.Fatalf("expected one OperationOutcome per resource, got:\n%s", stdout)
+		}
+		var outcomes [2]struct {
+			ResourceType string `json:"resourceType"`
+			Issue        []struct {
+				Severity string `json:"severity"`
+				Code     string `json:"code"`
+			} `json:"issue"`
+		}
+		for i, line := range lines {
+			if err := json.Unmarshal([]byte(line), &outcomes[i]); err != nil {
+				t.Fatalf("line %d is not JSON: %v", i+1, err)
+			}
+			if outcomes[i].ResourceType != "OperationOutcome" {
+				t.Errorf("line %d resourceType = %q", i+1, outcomes[i].ResourceType)
+			}
+		}
+		if got := outcomes[0].Issue; len(got) != 1 || got[0].Severity != "information" {
+			t.Errorf("valid resource issues = %+v, want a single informational issue", got)
+		}
+		if got := outcomes[1].Issue; len(got) != 1 || got[0].Code != "required" {
+			t.Errorf("invalid resource issues = %+v, want a required error", got)
+		}
+		if !strings.Contains(stderr, "Validated 2 resource(s)") {
+			t.Errorf("expected summary on stderr, got %q", stderr)
+		}
+	})
+
+	t.Run("fail-on never succeeds", func(t *testing.T) {
+		if _, _, err := runCLI("validate", ndjsonPath, "--format", "ndjson", "--specs", specsDir, "--constraints=false", "--fail-on", "never"); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("invalid format", func(t *testing.T) {
+		if _, _, err := runCLI("validate", ndjsonPath, "--format", "xml", "--specs", specsDir); err == nil {
+			t.Error("expected error for unknown format")
+		}
+	})
+}
+
+func TestValidateFailOnSummary(t *testing.T) {
+	specsDir := writeSpecsFixture(t, testWarningPatientSD)
+	// Only the pat-w1 warning
+	warningOnly := writeResourceFixture(t, `{"resourceType": "Patient", "id": "p1", "gender": "male"}`)
+	// The pat-w1 warning and a missing gender error
+	withError := writeResourceFixture(t, `{"resourceType": "Patient", "id": "p2"}`)
+
+	tests := []struct {
+		name        string
+		failOn      string
+		resource    string
+		wantErr     bool
+		wantSummary string
+	}{
+		{"error passes warnings", "error", warningOnly, false, "Validation passed: 0 error(s), 1 warning(s)"},
+		{"error fails errors", "error", withError, true, "Validation failed: 1 error(s), 1 warning(s)"},
+		{"warning fails warnings", "warning", warningOnly, true, "Validation failed: 0 error(s), 1 warning(s)"},
+		{"info fails warnings", "info", warningOnly, true, "Validation failed: 0 error(s), 1 warning(s)"},
+		{"never passes errors", "never", withError, false, "Validation passed: 1 error(s), 1 warning(s)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stdout, _, err := runCLI("validate", tt.resource, "--specs", specsDir, "--fail-on", tt.failOn)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error = %v, wantErr %v\n%s", err, tt.wantErr, stdout)
+			}
+			lines := strings.Split(strings.TrimSpace(stdout), "\n")
+			if summary := lines[len(lines)-1]; summary != tt.wantSummary {
+				t.Errorf("summary = %q, want %q", summary, tt.wantSummary)
+			}
+		})
+	}
+}
+
+func TestValidateFailOnJSON(t *testing.T) {
+	specsDir := writeSpecsFixture(t, testWarningPatientSD)
+	// Only the pat-w1 warning
+	warningOnly := writeResourceFixture(t, `{"resourceType": "Patient", "id": "p1", "gender": "male"}`)
+	// The pat-w1 warning and a missing gender error
+	withError := writeResourceFixture(t, `{"resourceType": "Patient", "id": "p2"}`)
+
+	tests := []struct {
+		name      string
+		failOn    string
+		resource  string
+		wantErr   bool
+		wantValid bool
+	}{
+		{"error passes warnings", "error", warningOnly, false, true},
+		{"error fails errors", "error", withError, true, false},
+		{"warning fails warnings", "warning", warningOnly, true, false},
+		{"never passes errors", "never", withError, false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stdout, _, err := runCLI("validate", tt.resource, "--specs", specsDir, "--fail-on", tt.failOn, "-o", "json")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error = %v, wantErr %v\n%s", err, tt.wantErr, stdout)
+			}
+			var output struct {
+				Valid  bool              `json:"valid"`
+				Issues []json.RawMessage `json:"issues"`
+			}
+			if err := json.Unmarshal([]byte(stdout), &output); err != nil {
+				t.Fatalf("output is not JSON: %v\n%s", err, stdout)
+			}
+			if output.Valid != tt.wantValid {
+				t.Errorf("valid = %v, want %v", output.Valid, tt.wantValid)
+			}
+			if len(output.Issues) == 0 {
+				t.Error("expected the issues to be printed")
+			}
+		})
+	}
+}
+
+func TestValidateExplain(t *testing.T) {
+	specsDir := writeSpecsFixture(t, testWarningPatientSD)
+	// Violates pat-w1 and the cardinality of Patient.gender
+	resource := writeResourceFixture(t, `{"resourceType": "Patient", "id": "p2"}`)
+
+	t.Run("text output", func(t *testing.T) {
+		stdout, _, err := runCLI("validate", resource, "--specs", specsDir, "--explain")
+		if err == nil {
+			t.Fatal("expected an error because gender is missing")
+		}
+
+		for _, want := range []string{
+			"warning [invariant] Patient: Constraint pat-w1 violated: A patient should have a name\n" +
+				"    constraint: pat-w1 (warning)\n" +
+				"    human:      A patient should have a name\n" +
+				"    expression: name.exists()\n" +
+				"    source:     http://hl7.org/fhir/StructureDefinition/Patient\n" +
+				"    element:    {\n",
+			"error [required] Patient.gender: Missing required element: Patient.gender (min=1)\n" +
+				"    element:    {\n" +
+				"      \"id\": \"Patient.gender\",\n" +
+				"      \"path\": \"Patient.gender\",\n" +
+				"      \"min\": 1,\n",
+		} {
+			if !strings.Contains(stdout, want) {
+				t.Errorf("output is missing:\n%s\ngot:\n%s", want, stdout)
+			}
+		}
+		if strings.Contains(stdout, "pat-w2") {
+			t.Errorf("only the violated constraint should be explained, got:\n%s", stdout)
+		}
+	})
+
+	t.Run("json output", func(t *testing.T) {
+		stdout, _, err := runCLI("validate", resource, "--specs", specsDir, "--explain", "-o", "json")
+		if err == nil {
+			t.Fatal("expected an error because gender is missing")
+		}
+
+		var output struct {
+			Issues []struct {
+				Code    string                `json:"code"`
+				Element *validator.ElementDef `json:"element"`
+			} `json:"issues"`
+		}
+		if err := json.Unmarshal([]byte(stdout), &output); err != nil {
+			t.Fatalf("output is not JSON: %v\n%s", err, stdout)
+		}
+		elements := make(map[string]*validator.ElementDef)
+		for _, issue := range output.Issues {
+			elements[issue.Code] = issue.Element
+		}
+
+		if elem := elements["required"]; elem == nil || elem.Path != "Patient.gender" || elem.Min != 1 {
+			t.Errorf("required issue element = %+v, want Patient.gender with min 1", elem)
+		}
+		elem := elements["invariant"]
+		if elem == nil || elem.Path != "Patient" {
+			t.Fatalf("invariant issue element = %+v, want Patient", elem)
+		}
+		if len(elem.Constraints) != 1 || elem.Constraints[0].Key != "pat-w1" {
+			t.Errorf("invariant issue constraints = %+v, want only pat-w1", elem.Constraints)
+		}
+	})
+}
+
+func TestFindElementDef(t *testing.T) {
+	registry := validator.NewRegistry(validator.FHIRVersionR4)
+	patient := &validator.StructureDef{
+		URL:  canonicalSDPrefix + "Patient",
+		Name: "Patient",
+		Type: "Patient",
+		Kind: "resource",
+		Snapshot: []validator.ElementDef{
+			{Path: "Patient", Min: 0, Max: "*"},
+			{Path: "Patient.name", Min: 0, Max: "*", Types: []validator.TypeRef{{Code: "HumanName"}}},
+			{Path: "Patient.deceased[x]", Min: 0, Max: "1", Types: []validator.TypeRef{{Code: "boolean"}, {Code: "dateTime"}}},
+		},
+	}
+	humanName := &validator.StructureDef{
+		URL:  canonicalSDPrefix + "HumanName",
+		Name: "HumanName",
+		Type: "HumanName",
+		Kind: "complex-type",
+		Snapshot: []validator.ElementDef{
+			{Path: "HumanName", Min: 0, Max: "*"},
+			{Path: "HumanName.given", Min: 0, Max: "*", Types: []validator.TypeRef{{Code: "string"}}},
+		},
+	}
+	for _, sd := range []*validator.StructureDef{patient, humanName} {
+		if err := registry.Register(sd); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		path string
+		want string
+	}{
+		{"Patient.name", "Patient.name"},
+		{"Patient.deceasedBoolean", "Patient.deceasedBoolean"},
+		{"Patient.name.given", "HumanName.given"},
+		{"Patient.deceased", ""},
+		{"Patient.name.family", ""},
+		{"Patient.unknown", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			elem := findElementDef(context.Background(), registry, patient, tt.path)
+			got := ""
+			if elem != nil {
+				got = elem.Path
+			}
+			if got != tt.want {
+				t.Errorf("findElementDef(%q) = %q, want %q", tt.path, got, tt.want)
+			}
+		})
+	}
+}
@@ -45,6 +45,7 @@ type AnalyzedType struct {
 	Description    string             // Documentation
 	URL            string             // Canonical URL
 	IsAbstract     bool               // Whether this is an abstract type
+	BaseType       string             // Name of the base definition (e.g., "DomainResource")
 	Properties     []AnalyzedProperty // Fields of this type
 	Constraints    []AnalyzedConstraint
 	BackboneTypes  []*AnalyzedType // Nested backbone element types for this resource
@@ -100,6 +101,7 @@ func (a *Analyzer) Analyze(sd *parser.StructureDefinition) (*AnalyzedType, error
 		Description: sd.Title,
 		URL:         sd.URL,
 		IsAbstract:  sd.Abstract,
+		BaseType:    sd.BaseDefinition[strings.LastIndex(sd.BaseDefinition, "/")+1:],
 	}
 
 	elements := sd.GetElements()
@@ -207,6 +207,40 @@ func TestAnalyzer_Analyze(t *testing.T) {
 	})
 }
 
+// Required primitives must still be pointers: with omitempty a non-pointer false, 0
+// or "" would be dropped from the JSON, and nil must remain distinguishable from the zero value.
+func TestAnalyzer_RequiredPrimitivesArePointers(t *testing.T) {
+	sd, err := parser.ParseStructureDefinition([]byte(`{
+		"resourceType": "StructureDefinition",
+		"url": "http://example.org/StructureDefinition/Sample",
+		"name": "Sample",
+		"kind": "resource",
+		"type": "Sample",
+		"snapshot": {
+			"element": [
+				{"id": "Sample", "path": "Sample", "min": 0, "max": "*"},
+				{"id": "Sample.flag", "path": "Sample.flag", "min": 1, "max": "1", "type": [{"code": "boolean"}]},
+				{"id": "Sample.count", "path": "Sample.count", "min": 1, "max": "1", "type": [{"code": "integer"}]},
+				{"id": "Sample.note", "path": "Sample.note", "min": 1, "max": "1", "type": [{"code": "string"}]},
+				{"id": "Sample.amount", "path": "Sample.amount", "min": 1, "max": "1", "type": [{"code": "decimal"}]}
+			]
+		}
+	}`))
+	require.NoError(t, err)
+
+	result, err := NewAnalyzer([]*parser.StructureDefinition{sd}, nil).Analyze(sd)
+	require.NoError(t, err)
+
+	goTypes := make(map[string]string)
+	for _, p := range result.Properties {
+		goTypes[p.Name] = p.GoType
+	}
+	assert.Equal(t, "*bool", goTypes["Flag"])
+	assert.Equal(t, "*int", goTypes["Count"])
+	assert.Equal(t, "*string", goTypes["Note"])
+	assert.Equal(t, "*float64", goTypes["Amount"])
+}
+
 func TestAnalyzer_NilInput(t *testing.T) {
 	analyzer := NewAnalyzer(nil, nil)
 	result, err := analyzer.Analyze(nil)
@@ -22,6 +22,25 @@ type Config struct {
 	PackageName string
 	// Version is the FHIR version (r4, r4b, r5)
 	Version string
+	// CategoryInterfaces enables the resource category interfaces (e.g., CanonicalResource)
+	// and the accessor methods resources need to implement them
+	CategoryInterfaces bool
+	// Resources limits generation to the files of the named resources (resource,
+	// backbone, builder and options files). Shared files such as registry.go and
+	// codesystems.go are left untouched. All resources are generated when empty.
+	Resources []string
+	// DryRun executes the templates without writing any file
+	DryRun bool
+}
+
+// Summary describes the output of a generation run.
+type Summary struct {
+	// Files are the paths of the generated files
+	Files []string
+	// Resources is the number of generated resources
+	Resources int
+	// Types is the number of generated Go types (datatypes, resources and backbone elements)
+	Types int
 }
 
 // CodeGen generates Go code from FHIR specifications.
@@ -31,6 +50,7 @@ type CodeGen struct {
 	types        []*analyzer.AnalyzedType
 	valueSets    *parser.ValueSetRegistry
 	usedBindings map[string]bool // Track which bindings are actually used
+	files        []string        // Files generated so far
 }
 
 // New creates a new CodeGen instance.
@@ -136,8 +156,17 @@ func (c *CodeGen) loadStructureDefinitions(path string) ([]*parser.StructureDefi
 
 // Generate writes all generated code to the output directory.
 func (c *CodeGen) Generate() error {
-	if err := os.MkdirAll(c.config.OutputDir, 0o755); err != nil {
-		return fmt.Errorf("failed to create output directory: %w", err)
+	if !c.config.DryRun {
+		if err := os.MkdirAll(c.config.OutputDir, 0o755); err != nil {
+			return fmt.Errorf("failed to create output directory: %w", err)
+		}
+	}
+
+	if len(c.config.Resources) > 0 {
+		if err := c.generateSelectedResources(); err != nil {
+			return err
+		}
+		return c.verifyOutput()
 	}
 
 	// Generate interfaces.go (shared interfaces, small file)
@@ -160,6 +189,16 @@ func (c *CodeGen) Generate() error {
 		return fmt.Errorf("failed to generate summary: %w", err)
 	}
 
+	// Generate stringer.go (String and GoString methods for resources)
+	if err := c.generateStringerFromTemplate(); err != nil {
+		return fmt.Errorf("failed to generate stringer: %w", err)
+	}
+
+	// Generate bundle_helpers.go (entry helpers of the Bundle builder)
+	if err := c.generateBundleHelpersFromTemplate(); err != nil {
+		return fmt.Errorf("failed to generate bundle helpers: %w", err)
+	}
+
 	// NEW: Generate separate files for datatypes (one file per datatype)
 	if err := c.generateDatatypesSeparately(); err != nil {
 		return fmt.Errorf("failed to generate datatypes: %w", err)
@@ -185,9 +224,81 @@ func (c *CodeGen) Generate() error {
 		return fmt.Errorf("failed to generate options: %w", err)
 	}
 
+	return c.verifyOutput()
+}
+
+// verifyOutput checks that the generated package parses and has no duplicate declarations.
+func (c *CodeGen) verifyOutput() error {
+	if c.config.DryRun {
+		return nil
+	}
+	if err := verifyGeneratedCode(c.config.OutputDir); err != nil {
+		return fmt.Errorf("generated code in %s is invalid: %w", c.config.OutputDir, err)
+	}
+	return nil
+}
+
+// Summary reports the files generated by Generate and the number of resources
+// and types they contain. In dry-run mode it reports what would be generated.
+func (c *CodeGen) Summary() Summary {
+	summary := Summary{Files: c.files}
+	for _, t := range c.types {
+		if t.Kind == kindResource {
+			if !c.isSelected(t.Name) {
+				continue
+			}
+			summary.Resources++
+		} else if len(c.config.Resources) > 0 {
+			continue
+		}
+		summary.Types += 1 + len(t.BackboneTypes)
+	}
+	return summary
+}
+
+// generateSelectedResources regenerates only the per-resource files of the
+// resources listed in the configuration.
+func (c *CodeGen) generateSelectedResources() error {
+	known := make(map[string]bool)
+	for _, t := range c.types {
+		if t.Kind == kindResource {
+			known[t.Name] = true
+		}
+	}
+	for _, name := range c.config.Resources {
+		if !known[name] {
+			return fmt.Errorf("unknown resource %q for %s", name, c.config.Version)
+		}
+	}
+
+	if err := c.generateResourcesSeparately(); err != nil {
+		return fmt.Errorf("failed to generate resources: %w", err)
+	}
+	if err := c.generateBackbonesSeparately(); err != nil {
+		return fmt.Errorf("failed to generate backbones: %w", err)
+	}
+	if err := c.generateBuildersSeparately(); err != nil {
+		return fmt.Errorf("failed to generate builders: %w", err)
+	}
+	if err := c.generateOptionsSeparately(); err != nil {
+		return fmt.Errorf("failed to generate options: %w", err)
+	}
 	return nil
 }
 
+// isSelected reports whether files for the named type should be generated.
+func (c *CodeGen) isSelected(name string) bool {
+	if len(c.config.Resources) == 0 {
+		return true
+	}
+	for _, r := range c.config.Resources {
+		if r == name {
+			return true
+		}
+	}
+	return false
+}
+
 // sanitizeTypeName converts a ValueSet name to a valid Go type name.
 func sanitizeTypeName(name string) string {
 	// Remove/replace invalid characters
@@ -0,0 +1,95 @@
+package generator
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/robertoaraneda/gofhir/internal/codegen/analyzer"
+)
+
+// newTestCodeGen returns a CodeGen with two small resources already loaded.
+func newTestCodeGen(outputDir string, resources ...string) *CodeGen {
+	gen := New(Config{OutputDir: outputDir, PackageName: "r5", Version: "r5", Resources: resources})
+	status := analyzer.AnalyzedProperty{Name: "Status", JSONName: "status", GoType: "*string", IsPointer: true, IsPrimitive: true}
+	gen.types = []*analyzer.AnalyzedType{
+		{
+			Name: "Observation", Kind: kindResource, BaseType: "DomainResource",
+			Properties: []analyzer.AnalyzedProperty{status},
+			BackboneTypes: []*analyzer.AnalyzedType{
+				{Name: "ObservationComponent", Kind: "backbone", ParentResource: "Observation"},
+			},
+		},
+		{Name: "Patient", Kind: kindResource, BaseType: "DomainResource", Properties: []analyzer.AnalyzedProperty{status}},
+	}
+	return gen
+}
+
+// generatedFiles returns the sorted names of the files in dir.
+func generatedFiles(t *testing.T, dir string) []string {
+	t.Helper()
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, e := range entries {
+		names = append(names, e.Name())
+	}
+	sort.Strings(names)
+	return names
+}
+
+func TestGenerateSelectedResources(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "r5")
+	if err := newTestCodeGen(dir, "Observation").Generate(); err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+
+	want := []string{"backbone_observation.go", "builder_observation.go", "options_observation.go", "resource_observation.go"}
+	got := generatedFiles(t, dir)
+	if len(got) != len(want) {
+		t.Fatalf("generated files = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("generated files = %v, want %v", got, want)
+			break
+		}
+	}
+}
+
+func TestGenerateUnknownResource(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "r5")
+	if err := newTestCodeGen(dir, "Observaton").Generate(); err == nil {
+		t.Fatal("expected error for unknown resource")
+	}
+	if files := generatedFiles(t, dir); len(files) != 0 {
+		t.Errorf("expected no files for an unknown resource, got %v", files)
+	}
+}
+
+func TestGenerateDryRun(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "r5")
+	gen := newTestCodeGen(dir, "Observation")
+	gen.config.DryRun = true
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+
+	if _, err := os.Stat(dir); !os.IsNotExist(err) {
+		t.Errorf("dry run created the output directory (stat error = %v)", err)
+	}
+
+	summary := gen.Summary()
+	if len(summary.Files) != 4 {
+		t.Errorf("Summary().Files = %v, want the 4 Observation files", summary.Files)
+	}
+	if summary.Resources != 1 {
+		t.Errorf("Summary().Resources = %d, want 1", summary.Resources)
+	}
+	if summary.Types != 2 {
+		t.Errorf("Summary().Types = %d, want 2 (Observation and ObservationComponent)", summary.Types)
+	}
+}
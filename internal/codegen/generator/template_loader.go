@@ -34,6 +34,8 @@ type TemplateData struct {
 type TypesTemplateData struct {
 	TemplateData
 	Types []*analyzer.AnalyzedType
+	// CanonicalResources is the set of resources that implement CanonicalResource
+	CanonicalResources map[string]bool
 }
 
 // RegistryTemplateData holds data for registry template.
@@ -52,6 +54,7 @@ type CodeSystemsTemplateData struct {
 type ValueSetData struct {
 	Name     string
 	TypeName string
+	VarName  string // Unexported prefix for package-level variables (e.g., "administrativeGender")
 	Title    string
 	Codes    []CodeData
 }
@@ -124,7 +127,8 @@ func executeTemplate(tmpl *template.Template, data interface{}) ([]byte, error)
 }
 
 // writeTemplateFile executes a template and writes to file.
-func writeTemplateFile(outputPath, templateName string, data interface{}) error {
+// In dry-run mode the template is still executed, but nothing is written.
+func (c *CodeGen) writeTemplateFile(outputPath, templateName string, data interface{}) error {
 	tmpl, err := loadTemplate(templateName)
 	if err != nil {
 		return err
@@ -132,6 +136,9 @@ func writeTemplateFile(outputPath, templateName string, data interface{}) error
 
 	content, err := executeTemplate(tmpl, data)
 	if err != nil {
+		if c.config.DryRun {
+			return err
+		}
 		// Write unformatted content for debugging
 		unformattedPath := outputPath + ".unformatted"
 		if writeErr := os.WriteFile(unformattedPath, content, 0o600); writeErr != nil {
@@ -140,6 +147,10 @@ func writeTemplateFile(outputPath, templateName string, data interface{}) error
 		return fmt.Errorf("%w (saved to %s)", err, unformattedPath)
 	}
 
+	c.files = append(c.files, outputPath)
+	if c.config.DryRun {
+		return nil
+	}
 	return os.WriteFile(outputPath, content, 0o600)
 }
 
@@ -164,19 +175,54 @@ func (c *CodeGen) generateRegistryFromTemplate() error {
 	}
 
 	path := filepath.Join(c.config.OutputDir, "registry.go")
-	return writeTemplateFile(path, "registry.go.tmpl", data)
+	return c.writeTemplateFile(path, "registry.go.tmpl", data)
+}
+
+// InterfacesTemplateData holds data for the interfaces template.
+type InterfacesTemplateData struct {
+	TemplateData
+	CategoryInterfaces bool
+	Resources          []ResourceInterfaceData
+}
+
+// ResourceInterfaceData holds the most specific resource interface implemented by a resource.
+type ResourceInterfaceData struct {
+	Name      string
+	Interface string // Resource, DomainResource or CanonicalResource
 }
 
 // generateInterfacesFromTemplate generates interfaces.go using template.
 func (c *CodeGen) generateInterfacesFromTemplate() error {
-	data := TemplateData{
-		PackageName: c.config.PackageName,
-		Version:     strings.ToUpper(c.config.Version),
-		FileType:    "interfaces",
+	data := InterfacesTemplateData{
+		TemplateData: TemplateData{
+			PackageName: c.config.PackageName,
+			Version:     strings.ToUpper(c.config.Version),
+			FileType:    "interfaces",
+		},
+		CategoryInterfaces: c.config.CategoryInterfaces,
+	}
+	if c.config.CategoryInterfaces {
+		canonical := c.canonicalResources()
+		for _, t := range c.types {
+			if t.Kind != kindResource {
+				continue
+			}
+			iface := "DomainResource"
+			switch {
+			case canonical[t.Name]:
+				iface = "CanonicalResource"
+			case t.BaseType == "Resource":
+				iface = "Resource"
+			}
+			data.Resources = append(data.Resources, ResourceInterfaceData{Name: t.Name, Interface: iface})
+		}
+		sort.Slice(data.Resources, func(i, j int) bool {
+			return data.Resources[i].Name < data.Resources[j].Name
+		})
 	}
 
 	path := filepath.Join(c.config.OutputDir, "interfaces.go")
-	return writeTemplateFile(path, "interfaces.go.tmpl", data)
+	return c.writeTemplateFile(path, "interfaces.go.tmpl", data)
 }
 
 // generateCodeSystemsFromTemplate generates codesystems.go using template.
@@ -211,6 +257,7 @@ func (c *CodeGen) generateCodeSystemsFromTemplate() error {
 		vsData := ValueSetData{
 			Name:     vs.Name,
 			TypeName: typeName,
+			VarName:  toLowerFirstChar(typeName),
 			Title:    vs.Title,
 			Codes:    make([]CodeData, 0, len(vs.Codes)),
 		}
@@ -236,7 +283,7 @@ func (c *CodeGen) generateCodeSystemsFromTemplate() error {
 	}
 
 	path := filepath.Join(c.config.OutputDir, "codesystems.go")
-	return writeTemplateFile(path, "codesystems.go.tmpl", data)
+	return c.writeTemplateFile(path, "codesystems.go.tmpl", data)
 }
 
 // toLowerFirstChar converts the first character to lowercase.
@@ -301,7 +348,134 @@ func (c *CodeGen) generateSummaryFromTemplate() error {
 	}
 
 	path := filepath.Join(c.config.OutputDir, "summary.go")
-	return writeTemplateFile(path, "summary.go.tmpl", data)
+	return c.writeTemplateFile(path, "summary.go.tmpl", data)
+}
+
+// StringerTemplateData holds data for stringer.go generation.
+type StringerTemplateData struct {
+	TemplateData
+	Resources            []ResourceStringData
+	HasCodeableReference bool
+}
+
+// ResourceStringData holds the fields shown by a resource's String method.
+type ResourceStringData struct {
+	Name   string
+	Fields []StringFieldData
+}
+
+// StringFieldData is a field shown in a resource's String summary.
+type StringFieldData struct {
+	Label     string // JSON name, without the type suffix for choice elements (e.g., "value")
+	FieldName string // Go field name (e.g., "ValueQuantity")
+}
+
+// stringSummaryFields lists, per resource type, the elements shown by the
+// generated String method. Choice elements are listed without [x] and match
+// every typed variant. Elements missing in a FHIR version are skipped.
+var stringSummaryFields = map[string][]string{
+	"AllergyIntolerance":  {"code", "criticality"},
+	"Bundle":              {"type", "total"},
+	"Condition":           {"code", "clinicalStatus"},
+	"DiagnosticReport":    {"status", "code"},
+	"Encounter":           {"status", "class"},
+	"Immunization":        {"status", "vaccineCode"},
+	"Location":            {"name", "status"},
+	"MedicationRequest":   {"status", "medication"},
+	"MedicationStatement": {"status", "medication"},
+	"Observation":         {"status", "code", "value"},
+	"Organization":        {"name"},
+	"Patient":             {"name", "gender", "birthDate"},
+	"Person":              {"name"},
+	"Practitioner":        {"name"},
+	"Procedure":           {"status", "code"},
+	"RelatedPerson":       {"name", "relationship"},
+	"ServiceRequest":      {"status", "code"},
+}
+
+// defaultStringSummaryFields are shown for resources not in stringSummaryFields.
+var defaultStringSummaryFields = []string{"url", "status", "code"}
+
+// generateStringerFromTemplate generates stringer.go (String and GoString methods) using template.
+func (c *CodeGen) generateStringerFromTemplate() error {
+	resources := make([]ResourceStringData, 0)
+	hasCodeableReference := false
+
+	for _, t := range c.types {
+		if t.Name == "CodeableReference" {
+			hasCodeableReference = true
+		}
+		if t.Kind != kindResource {
+			continue
+		}
+
+		names, ok := stringSummaryFields[t.Name]
+		if !ok {
+			names = defaultStringSummaryFields
+		}
+
+		fields := make([]StringFieldData, 0)
+		for _, name := range names {
+			for _, prop := range t.Properties {
+				if prop.JSONName == name || (prop.IsChoice && isChoiceVariant(prop.JSONName, name)) {
+					fields = append(fields, StringFieldData{Label: name, FieldName: prop.Name})
+				}
+			}
+		}
+
+		resources = append(resources, ResourceStringData{
+			Name:   t.Name,
+			Fields: fields,
+		})
+	}
+
+	sort.Slice(resources, func(i, j int) bool {
+		return resources[i].Name < resources[j].Name
+	})
+
+	data := StringerTemplateData{
+		TemplateData: TemplateData{
+			PackageName: c.config.PackageName,
+			Version:     strings.ToUpper(c.config.Version),
+			FileType:    "stringer",
+		},
+		Resources:            resources,
+		HasCodeableReference: hasCodeableReference,
+	}
+
+	path := filepath.Join(c.config.OutputDir, "stringer.go")
+	return c.writeTemplateFile(path, "stringer.go.tmpl", data)
+}
+
+// generateBundleHelpersFromTemplate generates bundle_helpers.go (entry helpers of
+// the Bundle builder) using template. Nothing is generated without a Bundle resource.
+func (c *CodeGen) generateBundleHelpersFromTemplate() error {
+	hasBundle := false
+	for _, t := range c.types {
+		if t.Kind == kindResource && t.Name == "Bundle" {
+			hasBundle = true
+			break
+		}
+	}
+	if !hasBundle {
+		return nil
+	}
+
+	data := TemplateData{
+		PackageName: c.config.PackageName,
+		Version:     strings.ToUpper(c.config.Version),
+		FileType:    "bundle helpers",
+	}
+
+	path := filepath.Join(c.config.OutputDir, "bundle_helpers.go")
+	return c.writeTemplateFile(path, "bundle_helpers.go.tmpl", data)
+}
+
+// isChoiceVariant reports whether jsonName is a typed variant of the choice
+// element base (e.g., "valueQuantity" for "value").
+func isChoiceVariant(jsonName, base string) bool {
+	suffix, ok := strings.CutPrefix(jsonName, base)
+	return ok && suffix != "" && unicode.IsUpper(rune(suffix[0]))
 }
 
 // ============================================================================
@@ -335,7 +509,7 @@ func (c *CodeGen) generateDatatypesSeparately() error {
 		filename := fmt.Sprintf("datatype_%s.go", strings.ToLower(t.Name))
 		path := filepath.Join(c.config.OutputDir, filename)
 
-		if err := writeTemplateFile(path, "datatypes.go.tmpl", data); err != nil {
+		if err := c.writeTemplateFile(path, "datatypes.go.tmpl", data); err != nil {
 			return fmt.Errorf("failed to generate %s: %w", filename, err)
 		}
 	}
@@ -359,7 +533,7 @@ func (c *CodeGen) generateDatatypesSeparately() error {
 		}
 
 		path := filepath.Join(c.config.OutputDir, "datatype_base.go")
-		if err := writeTemplateFile(path, "datatypes.go.tmpl", data); err != nil {
+		if err := c.writeTemplateFile(path, "datatypes.go.tmpl", data); err != nil {
 			return fmt.Errorf("failed to generate datatype_base.go: %w", err)
 		}
 	}
@@ -369,8 +543,9 @@ func (c *CodeGen) generateDatatypesSeparately() error {
 
 // generateResourcesSeparately generates one file per resource.
 func (c *CodeGen) generateResourcesSeparately() error {
+	canonical := c.canonicalResources()
 	for _, t := range c.types {
-		if t.Kind != kindResource {
+		if t.Kind != kindResource || !c.isSelected(t.Name) {
 			continue
 		}
 
@@ -380,14 +555,15 @@ func (c *CodeGen) generateResourcesSeparately() error {
 				Version:     strings.ToUpper(c.config.Version),
 				FileType:    "resources",
 			},
-			Types: []*analyzer.AnalyzedType{t},
+			Types:              []*analyzer.AnalyzedType{t},
+			CanonicalResources: canonical,
 		}
 
 		// Naming convention: resource_<lowercase_name>.go
 		filename := fmt.Sprintf("resource_%s.go", strings.ToLower(t.Name))
 		path := filepath.Join(c.config.OutputDir, filename)
 
-		if err := writeTemplateFile(path, "resources.go.tmpl", data); err != nil {
+		if err := c.writeTemplateFile(path, "resources.go.tmpl", data); err != nil {
 			return fmt.Errorf("failed to generate %s: %w", filename, err)
 		}
 	}
@@ -395,13 +571,43 @@ func (c *CodeGen) generateResourcesSeparately() error {
 	return nil
 }
 
+// canonicalResources returns the resources that implement CanonicalResource, or
+// nil if category interfaces are disabled.
+//
+// Only R4B declares canonical resources through a CanonicalResource or MetadataResource
+// base definition; R4 and R5 derive them from DomainResource. Resources are therefore
+// classified by the canonical elements they define (url, version and a publication
+// status), which holds for every version.
+func (c *CodeGen) canonicalResources() map[string]bool {
+	if !c.config.CategoryInterfaces {
+		return nil
+	}
+	canonical := make(map[string]bool)
+	for _, t := range c.types {
+		if t.Kind == kindResource && hasCanonicalElements(t) {
+			canonical[t.Name] = true
+		}
+	}
+	return canonical
+}
+
+// hasCanonicalElements reports whether t has the url, version and status elements
+// required by the CanonicalResource interface.
+func hasCanonicalElements(t *analyzer.AnalyzedType) bool {
+	goTypes := make(map[string]string, len(t.Properties))
+	for _, p := range t.Properties {
+		goTypes[p.JSONName] = p.GoType
+	}
+	return goTypes["url"] == "*string" && goTypes["version"] == "*string" && goTypes["status"] == "*PublicationStatus"
+}
+
 // generateBackbonesSeparately generates backbone files grouped by parent resource.
 func (c *CodeGen) generateBackbonesSeparately() error {
 	// Group backbones by parent resource
 	backbonesByParent := make(map[string][]*analyzer.AnalyzedType)
 
 	for _, t := range c.types {
-		if len(t.BackboneTypes) == 0 {
+		if len(t.BackboneTypes) == 0 || !c.isSelected(t.Name) {
 			continue
 		}
 
@@ -429,7 +635,7 @@ func (c *CodeGen) generateBackbonesSeparately() error {
 		filename := fmt.Sprintf("backbone_%s.go", strings.ToLower(parentName))
 		path := filepath.Join(c.config.OutputDir, filename)
 
-		if err := writeTemplateFile(path, "backbones.go.tmpl", data); err != nil {
+		if err := c.writeTemplateFile(path, "backbones.go.tmpl", data); err != nil {
 			return fmt.Errorf("failed to generate %s: %w", filename, err)
 		}
 	}
@@ -470,7 +676,7 @@ func buildResourceBuilderData(t *analyzer.AnalyzedType) ResourceBuilderData {
 // generateBuildersSeparately generates one fluent builder file per resource.
 func (c *CodeGen) generateBuildersSeparately() error {
 	for _, t := range c.types {
-		if t.Kind != kindResource {
+		if t.Kind != kindResource || !c.isSelected(t.Name) {
 			continue
 		}
 
@@ -486,7 +692,7 @@ func (c *CodeGen) generateBuildersSeparately() error {
 		filename := fmt.Sprintf("builder_%s.go", strings.ToLower(t.Name))
 		path := filepath.Join(c.config.OutputDir, filename)
 
-		if err := writeTemplateFile(path, "fluent_builders.go.tmpl", data); err != nil {
+		if err := c.writeTemplateFile(path, "fluent_builders.go.tmpl", data); err != nil {
 			return fmt.Errorf("failed to generate %s: %w", filename, err)
 		}
 	}
@@ -497,7 +703,7 @@ func (c *CodeGen) generateBuildersSeparately() error {
 // generateOptionsSeparately generates one functional options file per resource.
 func (c *CodeGen) generateOptionsSeparately() error {
 	for _, t := range c.types {
-		if t.Kind != kindResource {
+		if t.Kind != kindResource || !c.isSelected(t.Name) {
 			continue
 		}
 
@@ -513,7 +719,7 @@ func (c *CodeGen) generateOptionsSeparately() error {
 		filename := fmt.Sprintf("options_%s.go", strings.ToLower(t.Name))
 		path := filepath.Join(c.config.OutputDir, filename)
 
-		if err := writeTemplateFile(path, "functional_options.go.tmpl", data); err != nil {
+		if err := c.writeTemplateFile(path, "functional_options.go.tmpl", data); err != nil {
 			return fmt.Errorf("failed to generate %s: %w", filename, err)
 		}
 	}
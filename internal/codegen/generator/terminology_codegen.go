@@ -19,16 +19,18 @@ type TerminologyCodegen struct {
 }
 
 type codeSystemDef struct {
-	URL     string
-	Name    string
-	Content string
-	Codes   []string
+	URL      string
+	Name     string
+	Content  string
+	Codes    []string
+	Statuses map[string]string // Status of deprecated or retired codes
 }
 
 type valueSetDef struct {
-	URL   string
-	Name  string
-	Codes []string // Just the code strings, not full CodeInfo
+	URL      string
+	Name     string
+	Codes    []string          // Just the code strings, not full CodeInfo
+	Statuses map[string]string // Status of deprecated or retired codes
 }
 
 // NewTerminologyCodegen creates a new terminology code generator.
@@ -108,8 +110,26 @@ type codeSystemResource struct {
 }
 
 type codeSystemConcept struct {
-	Code    string              `json:"code"`
-	Concept []codeSystemConcept `json:"concept,omitempty"`
+	Code      string                      `json:"code"`
+	Extension []conceptExtension          `json:"extension,omitempty"`
+	Property  []codeSystemConceptProperty `json:"property,omitempty"`
+	Concept   []codeSystemConcept         `json:"concept,omitempty"`
+}
+
+type conceptExtension struct {
+	URL       string `json:"url"`
+	ValueCode string `json:"valueCode,omitempty"`
+}
+
+// standardsStatusURL is the extension the core CodeSystems use to flag individual
+// concepts as deprecated or withdrawn.
+const standardsStatusURL = "http://hl7.org/fhir/StructureDefinition/structuredefinition-standards-status"
+
+type codeSystemConceptProperty struct {
+	Code          string `json:"code"`
+	ValueCode     string `json:"valueCode,omitempty"`
+	ValueBoolean  *bool  `json:"valueBoolean,omitempty"`
+	ValueDateTime string `json:"valueDateTime,omitempty"`
 }
 
 func (g *TerminologyCodegen) loadCodeSystem(data []byte) {
@@ -123,28 +143,68 @@ func (g *TerminologyCodegen) loadCodeSystem(data []byte) {
 		return
 	}
 
-	codes := flattenCSConcepts(cs.Concept)
+	statuses := make(map[string]string)
+	codes := flattenCSConcepts(cs.Concept, statuses)
 	if len(codes) > 0 {
 		g.codeSystems[cs.URL] = &codeSystemDef{
-			URL:     cs.URL,
-			Name:    cs.Name,
-			Content: cs.Content,
-			Codes:   codes,
+			URL:      cs.URL,
+			Name:     cs.Name,
+			Content:  cs.Content,
+			Codes:    codes,
+			Statuses: statuses,
 		}
 	}
 }
 
-func flattenCSConcepts(concepts []codeSystemConcept) []string {
+func flattenCSConcepts(concepts []codeSystemConcept, statuses map[string]string) []string {
 	codes := make([]string, 0, len(concepts))
 	for _, c := range concepts {
 		codes = append(codes, c.Code)
+		if status := conceptStatus(c.Extension, c.Property); status != "" {
+			statuses[c.Code] = status
+		}
 		if len(c.Concept) > 0 {
-			codes = append(codes, flattenCSConcepts(c.Concept)...)
+			codes = append(codes, flattenCSConcepts(c.Concept, statuses)...)
 		}
 	}
 	return codes
 }
 
+// conceptStatus returns "deprecated" or "retired" for concepts marked as such by the
+// standard concept properties (status, deprecated/deprecationDate, retirementDate,
+// inactive) or the standards-status extension, or "" for active concepts.
+func conceptStatus(extensions []conceptExtension, properties []codeSystemConceptProperty) string {
+	status := ""
+	for _, ext := range extensions {
+		if ext.URL != standardsStatusURL {
+			continue
+		}
+		switch ext.ValueCode {
+		case "deprecated":
+			status = "deprecated"
+		case "withdrawn":
+			return "retired"
+		}
+	}
+	for _, p := range properties {
+		switch p.Code {
+		case "status":
+			if p.ValueCode == "deprecated" || p.ValueCode == "retired" {
+				return p.ValueCode
+			}
+		case "retirementDate":
+			return "retired"
+		case "inactive":
+			if p.ValueBoolean != nil && *p.ValueBoolean {
+				return "retired"
+			}
+		case "deprecated", "deprecationDate":
+			status = "deprecated"
+		}
+	}
+	return status
+}
+
 type valueSetResource struct {
 	URL       string             `json:"url"`
 	Name      string             `json:"name"`
@@ -170,7 +230,9 @@ type valueSetExpansion struct {
 }
 
 type expansionContains struct {
-	Code string `json:"code,omitempty"`
+	System   string `json:"system,omitempty"`
+	Code     string `json:"code,omitempty"`
+	Inactive bool   `json:"inactive,omitempty"`
 }
 
 func (g *TerminologyCodegen) loadValueSet(data []byte) {
@@ -180,25 +242,38 @@ func (g *TerminologyCodegen) loadValueSet(data []byte) {
 	}
 
 	var codes []string
+	statuses := make(map[string]string)
 
 	// First try expansion
 	if vs.Expansion != nil && len(vs.Expansion.Contains) > 0 {
 		for _, c := range vs.Expansion.Contains {
 			if c.Code != "" {
 				codes = append(codes, c.Code)
+				if c.Inactive {
+					statuses[c.Code] = "retired"
+				} else if cs := g.codeSystems[c.System]; cs != nil && cs.Statuses[c.Code] != "" {
+					statuses[c.Code] = cs.Statuses[c.Code]
+				}
 			}
 		}
 	} else if vs.Compose != nil {
 		// Otherwise expand from compose
 		for _, include := range vs.Compose.Include {
+			cs := g.codeSystems[include.System]
 			if len(include.Concept) > 0 {
 				// Explicit concepts
 				for _, c := range include.Concept {
 					codes = append(codes, c.Code)
+					if cs != nil && cs.Statuses[c.Code] != "" {
+						statuses[c.Code] = cs.Statuses[c.Code]
+					}
 				}
-			} else if cs, ok := g.codeSystems[include.System]; ok {
+			} else if cs != nil {
 				// All codes from CodeSystem
 				codes = append(codes, cs.Codes...)
+				for code, status := range cs.Statuses {
+					statuses[code] = status
+				}
 			}
 		}
 	}
@@ -207,9 +282,10 @@ func (g *TerminologyCodegen) loadValueSet(data []byte) {
 		// Remove duplicates
 		codes = uniqueStrings(codes)
 		g.valueSets[vs.URL] = &valueSetDef{
-			URL:   vs.URL,
-			Name:  vs.Name,
-			Codes: codes,
+			URL:      vs.URL,
+			Name:     vs.Name,
+			Codes:    codes,
+			Statuses: statuses,
 		}
 	}
 }
@@ -350,7 +426,21 @@ var embeddedValueSets{{.VersionSuffix}} = map[string]map[string]bool{
 {{- end}}
 }
 
+// embeddedCodeStatus{{.VersionSuffix}} lists the deprecated or retired codes of the ValueSets above.
+var embeddedCodeStatus{{.VersionSuffix}} = map[string]map[string]string{
+{{- range .ValueSets}}
+{{- if .Statuses}}
+	"{{.URL}}": {
+		{{- range $code, $status := .Statuses}}
+		"{{$code}}": "{{$status}}",
+		{{- end}}
+	},
+{{- end}}
+{{- end}}
+}
+
 func init() {
 	registerEmbeddedValueSets("{{.FHIRVersion}}", embeddedValueSets{{.VersionSuffix}})
+	registerEmbeddedCodeStatus("{{.FHIRVersion}}", embeddedCodeStatus{{.VersionSuffix}})
 }
 `
@@ -0,0 +1,83 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+)
+
+const terminologyTestBundle = `{
+  "resourceType": "Bundle",
+  "entry": [
+    {"resource": {
+      "resourceType": "ValueSet",
+      "url": "http://example.org/ValueSet/colors",
+      "name": "Colors",
+      "compose": {"include": [{"system": "http://example.org/CodeSystem/colors"}]}
+    }},
+    {"resource": {
+      "resourceType": "ValueSet",
+      "url": "http://example.org/ValueSet/shapes",
+      "name": "Shapes",
+      "expansion": {"contains": [
+        {"system": "http://example.org/CodeSystem/colors", "code": "mauve"},
+        {"code": "circle"},
+        {"code": "oval", "inactive": true}
+      ]}
+    }},
+    {"resource": {
+      "resourceType": "CodeSystem",
+      "url": "http://example.org/CodeSystem/colors",
+      "name": "Colors",
+      "content": "complete",
+      "concept": [
+        {"code": "red"},
+        {"code": "mauve", "property": [{"code": "status", "valueCode": "deprecated"}]},
+        {"code": "puce", "extension": [{
+          "url": "http://hl7.org/fhir/StructureDefinition/structuredefinition-standards-status",
+          "valueCode": "withdrawn"
+        }]},
+        {"code": "warm", "concept": [
+          {"code": "ochre", "property": [{"code": "inactive", "valueBoolean": true}]}
+        ]}
+      ]
+    }}
+  ]
+}`
+
+func TestTerminologyCodegenCodeStatus(t *testing.T) {
+	g := NewTerminologyCodegen()
+	if err := g.LoadFromBundle([]byte(terminologyTestBundle)); err != nil {
+		t.Fatalf("LoadFromBundle: %v", err)
+	}
+
+	want := map[string]map[string]string{
+		"http://example.org/ValueSet/colors": {"mauve": "deprecated", "puce": "retired", "ochre": "retired"},
+		"http://example.org/ValueSet/shapes": {"mauve": "deprecated", "oval": "retired"},
+	}
+	for url, codes := range want {
+		vs := g.valueSets[url]
+		if vs == nil {
+			t.Fatalf("ValueSet %s was not loaded", url)
+		}
+		if len(vs.Statuses) != len(codes) {
+			t.Errorf("%s statuses = %v, want %v", url, vs.Statuses, codes)
+		}
+		for code, status := range codes {
+			if vs.Statuses[code] != status {
+				t.Errorf("%s status of %q = %q, want %q", url, code, vs.Statuses[code], status)
+			}
+		}
+	}
+
+	code, err := g.GenerateEmbeddedValueSets("validator", "4.0.1", nil)
+	if err != nil {
+		t.Fatalf("GenerateEmbeddedValueSets: %v", err)
+	}
+	out := string(code)
+	if strings.Contains(out, "embeddedCodeStatusR4 = map[string]map[string]string{\n}") {
+		t.Fatalf("generated code status map is empty:\n%s", out)
+	}
+	if !strings.Contains(out, `"mauve": "deprecated",`) || !strings.Contains(out, `"oval": "retired",`) {
+		t.Errorf("generated code is missing code statuses:\n%s", out)
+	}
+}
@@ -0,0 +1,158 @@
+package generator
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// verifyGeneratedCode parses the Go files in dir and reports syntax errors and
+// the duplicate declarations the parser accepts but the compiler rejects:
+// redeclared package-level names and methods, duplicate struct fields, and
+// fields sharing a name with a method of their type. Each problem is reported
+// with its file and line so that codegen regressions surface at generation time.
+func verifyGeneratedCode(dir string) error {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return fmt.Errorf("failed to list generated files: %w", err)
+	}
+	sort.Strings(paths)
+
+	fset := token.NewFileSet()
+	var files []*ast.File
+	for _, path := range paths {
+		if strings.HasSuffix(path, "_test.go") {
+			continue
+		}
+		src, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %w", path, err)
+		}
+		file, err := parser.ParseFile(fset, path, src, parser.SkipObjectResolution)
+		if err != nil {
+			return fmt.Errorf("generated code does not parse: %w", err)
+		}
+		files = append(files, file)
+	}
+
+	v := &declVerifier{
+		fset:    fset,
+		decls:   make(map[string]token.Pos),
+		fields:  make(map[string]map[string]token.Pos),
+		methods: make(map[string]token.Pos),
+	}
+	for _, file := range files {
+		v.collect(file)
+	}
+	v.checkFieldMethodClashes()
+	return errors.Join(v.errs...)
+}
+
+// declVerifier collects the declarations of a package and the problems found in them.
+type declVerifier struct {
+	fset    *token.FileSet
+	decls   map[string]token.Pos            // package-level names
+	fields  map[string]map[string]token.Pos // struct type -> field name -> position
+	methods map[string]token.Pos            // "Type.Method" -> position
+	errs    []error
+}
+
+// collect records the declarations of file, reporting any redeclarations.
+func (v *declVerifier) collect(file *ast.File) {
+	for _, decl := range file.Decls {
+		switch d := decl.(type) {
+		case *ast.FuncDecl:
+			if d.Recv == nil {
+				if d.Name.Name != "init" {
+					v.declare(v.decls, d.Name.Name, d.Name.Pos())
+				}
+				continue
+			}
+			if recv := receiverTypeName(d.Recv.List[0].Type); recv != "" {
+				v.declare(v.methods, recv+"."+d.Name.Name, d.Name.Pos())
+			}
+		case *ast.GenDecl:
+			for _, spec := range d.Specs {
+				switch s := spec.(type) {
+				case *ast.TypeSpec:
+					v.declare(v.decls, s.Name.Name, s.Name.Pos())
+					if st, ok := s.Type.(*ast.StructType); ok {
+						v.collectFields(s.Name.Name, st)
+					}
+				case *ast.ValueSpec:
+					for _, name := range s.Names {
+						v.declare(v.decls, name.Name, name.Pos())
+					}
+				}
+			}
+		}
+	}
+}
+
+// collectFields records the fields of a struct type, reporting duplicates.
+func (v *declVerifier) collectFields(typeName string, st *ast.StructType) {
+	fields := make(map[string]token.Pos)
+	v.fields[typeName] = fields
+	for _, field := range st.Fields.List {
+		if len(field.Names) == 0 {
+			// Embedded field, named after its type
+			if name := receiverTypeName(field.Type); name != "" {
+				v.declare(fields, name, field.Type.Pos())
+			}
+			continue
+		}
+		for _, name := range field.Names {
+			v.declare(fields, name.Name, name.Pos())
+		}
+	}
+}
+
+// declare records name in scope, reporting it if it was already declared.
+func (v *declVerifier) declare(scope map[string]token.Pos, name string, pos token.Pos) {
+	if name == "_" {
+		return
+	}
+	if prev, ok := scope[name]; ok {
+		v.errs = append(v.errs, fmt.Errorf("%s: %s redeclared (previous declaration at %s)",
+			v.fset.Position(pos), name, v.fset.Position(prev)))
+		return
+	}
+	scope[name] = pos
+}
+
+// checkFieldMethodClashes reports methods named like a field of their receiver type.
+func (v *declVerifier) checkFieldMethodClashes() {
+	keys := make([]string, 0, len(v.methods))
+	for key := range v.methods {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		typeName, method, _ := strings.Cut(key, ".")
+		if fieldPos, ok := v.fields[typeName][method]; ok {
+			v.errs = append(v.errs, fmt.Errorf("%s: field and method with the same name %s.%s (field at %s)",
+				v.fset.Position(v.methods[key]), typeName, method, v.fset.Position(fieldPos)))
+		}
+	}
+}
+
+// receiverTypeName returns the type name of a receiver or embedded field (T, *T or pkg.T).
+func receiverTypeName(expr ast.Expr) string {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		return e.Name
+	case *ast.StarExpr:
+		return receiverTypeName(e.X)
+	case *ast.SelectorExpr:
+		return e.Sel.Name
+	case *ast.IndexExpr:
+		return receiverTypeName(e.X)
+	}
+	return ""
+}
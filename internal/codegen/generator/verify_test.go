@@ -0,0 +1,83 @@
+package generator
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestVerifyGeneratedCode(t *testing.T) {
+	tests := []struct {
+		name    string
+		files   map[string]string
+		wantErr string
+	}{
+		{
+			name: "valid package",
+			files: map[string]string{
+				"resource_patient.go": "package r4\n\ntype Patient struct {\n\tId *string\n}\n\nfunc (r *Patient) GetId() *string { return r.Id }\n",
+				"registry.go":         "package r4\n\nvar _ = 1\n\nfunc init() {}\n",
+				"registry_test.go":    "package r4\n\ntype Patient struct{}\n",
+			},
+		},
+		{
+			name: "syntax error",
+			files: map[string]string{
+				"resource_patient.go": "package r4\n\ntype Patient struct {\n\ttype *string\n}\n",
+			},
+			wantErr: "resource_patient.go:4:",
+		},
+		{
+			name: "type redeclared across files",
+			files: map[string]string{
+				"resource_patient.go": "package r4\n\ntype Patient struct{}\n",
+				"datatype_patient.go": "package r4\n\ntype Patient struct{}\n",
+			},
+			wantErr: "resource_patient.go:3:6: Patient redeclared",
+		},
+		{
+			name: "duplicate field",
+			files: map[string]string{
+				"resource_patient.go": "package r4\n\ntype Patient struct {\n\tValue *string\n\tValue *int\n}\n",
+			},
+			wantErr: "resource_patient.go:5:2: Value redeclared",
+		},
+		{
+			name: "duplicate method",
+			files: map[string]string{
+				"resource_patient.go": "package r4\n\ntype Patient struct{}\n\nfunc (r *Patient) Get() {}\n\nfunc (r Patient) Get() {}\n",
+			},
+			wantErr: "Patient.Get redeclared",
+		},
+		{
+			name: "field and method with the same name",
+			files: map[string]string{
+				"resource_patient.go": "package r4\n\ntype Patient struct {\n\tString *string\n}\n\nfunc (r Patient) String() string { return \"\" }\n",
+			},
+			wantErr: "field and method with the same name Patient.String",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			for name, src := range tt.files {
+				if err := os.WriteFile(filepath.Join(dir, name), []byte(src), 0o600); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			err := verifyGeneratedCode(dir)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("verifyGeneratedCode() error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("verifyGeneratedCode() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,195 @@
+package common
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+)
+
+// ChangeOp is the kind of a Change.
+type ChangeOp string
+
+// Change operations reported by DiffResources.
+const (
+	// ChangeAdded is an element of the new resource that the old one lacks.
+	ChangeAdded ChangeOp = "added"
+	// ChangeRemoved is an element of the old resource that the new one lacks.
+	ChangeRemoved ChangeOp = "removed"
+	// ChangeChanged is an element whose value differs.
+	ChangeChanged ChangeOp = "changed"
+)
+
+// Change describes a single difference between two resources.
+type Change struct {
+	// Path is the FHIRPath location of the element (e.g., "Patient.name[0].family")
+	Path string
+	// Op is the kind of change
+	Op ChangeOp
+	// Old is the previous decoded JSON value (nil for additions)
+	Old interface{}
+	// New is the new decoded JSON value (nil for removals)
+	New interface{}
+}
+
+// DiffResources compares two FHIR JSON resources element by element and returns
+// the changes that turn before into after, in a deterministic order.
+//
+// Resources are compared in canonical form, so key order and whitespace are
+// ignored, and decimals keep their precision (values are decoded with json.Number).
+// Object properties are compared in sorted order and array items by index. Added
+// and removed arrays are reported as one change per item, and removed items are
+// reported last first, so that applying the changes in order keeps indices valid.
+// Resources of different types are reported as a single change of the root.
+//
+// Usage:
+//
+//	changes, err := common.DiffResources(before, after)
+//	for _, c := range changes {
+//	    log.Printf("%s %s", c.Op, c.Path)
+//	}
+func DiffResources(before, after []byte) ([]Change, error) {
+	oldValue, err := decodeCanonical(before)
+	if err != nil {
+		return nil, fmt.Errorf("%w: old resource: %v", ErrInvalidJSON, err)
+	}
+	newValue, err := decodeCanonical(after)
+	if err != nil {
+		return nil, fmt.Errorf("%w: new resource: %v", ErrInvalidJSON, err)
+	}
+
+	root := diffRootPath(oldValue)
+	if newRoot := diffRootPath(newValue); newRoot != root {
+		// Different resource types cannot be diffed element by element
+		return []Change{{Path: root, Op: ChangeChanged, Old: oldValue, New: newValue}}, nil
+	}
+
+	var changes []Change
+	diffValues(root, oldValue, newValue, &changes)
+	return changes, nil
+}
+
+// decodeCanonical decodes JSON preserving number precision.
+func decodeCanonical(data []byte) (interface{}, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// diffRootPath returns the resourceType of a decoded resource, used as the FHIRPath root.
+func diffRootPath(v interface{}) string {
+	if m, ok := v.(map[string]interface{}); ok {
+		if rt, ok := m["resourceType"].(string); ok && rt != "" {
+			return rt
+		}
+	}
+	return "$this"
+}
+
+// diffValues recursively compares two decoded JSON values.
+func diffValues(path string, oldValue, newValue interface{}, changes *[]Change) {
+	switch oldTyped := oldValue.(type) {
+	case map[string]interface{}:
+		if newTyped, ok := newValue.(map[string]interface{}); ok {
+			diffObjects(path, oldTyped, newTyped, changes)
+			return
+		}
+	case []interface{}:
+		if newTyped, ok := newValue.([]interface{}); ok {
+			diffArrays(path, oldTyped, newTyped, changes)
+			return
+		}
+	}
+
+	if canonicalString(oldValue) != canonicalString(newValue) {
+		*changes = append(*changes, Change{Path: path, Op: ChangeChanged, Old: oldValue, New: newValue})
+	}
+}
+
+// diffObjects compares two JSON objects key by key in sorted order.
+func diffObjects(path string, oldObj, newObj map[string]interface{}, changes *[]Change) {
+	keys := make([]string, 0, len(oldObj)+len(newObj))
+	for key := range oldObj {
+		keys = append(keys, key)
+	}
+	for key := range newObj {
+		if _, ok := oldObj[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if key == "resourceType" && path == diffRootPath(oldObj) {
+			continue
+		}
+
+		childPath := path + "." + key
+		oldChild, inOld := oldObj[key]
+		newChild, inNew := newObj[key]
+
+		switch {
+		case inOld && !inNew:
+			appendRemoved(childPath, oldChild, changes)
+		case !inOld && inNew:
+			appendAdded(childPath, newChild, changes)
+		default:
+			diffValues(childPath, oldChild, newChild, changes)
+		}
+	}
+}
+
+// diffArrays compares two JSON arrays element by element.
+func diffArrays(path string, oldArr, newArr []interface{}, changes *[]Change) {
+	common := min(len(oldArr), len(newArr))
+	for i := 0; i < common; i++ {
+		diffValues(fmt.Sprintf("%s[%d]", path, i), oldArr[i], newArr[i], changes)
+	}
+
+	// Removals are reported from the end so indices stay valid when applied in order
+	for i := len(oldArr) - 1; i >= common; i-- {
+		*changes = append(*changes, Change{Path: fmt.Sprintf("%s[%d]", path, i), Op: ChangeRemoved, Old: oldArr[i]})
+	}
+	for i := common; i < len(newArr); i++ {
+		*changes = append(*changes, Change{Path: fmt.Sprintf("%s[%d]", path, i), Op: ChangeAdded, New: newArr[i]})
+	}
+}
+
+// appendAdded records the addition of a new element. Arrays are expanded into
+// one addition per item, matching FHIRPath Patch "add" semantics.
+func appendAdded(path string, value interface{}, changes *[]Change) {
+	if arr, ok := value.([]interface{}); ok {
+		for i, item := range arr {
+			*changes = append(*changes, Change{Path: fmt.Sprintf("%s[%d]", path, i), Op: ChangeAdded, New: item})
+		}
+		return
+	}
+	*changes = append(*changes, Change{Path: path, Op: ChangeAdded, New: value})
+}
+
+// appendRemoved records the removal of an element. Arrays are expanded into
+// one removal per item, last item first, since FHIRPath Patch "delete"
+// operates on a single element.
+func appendRemoved(path string, value interface{}, changes *[]Change) {
+	if arr, ok := value.([]interface{}); ok {
+		for i := len(arr) - 1; i >= 0; i-- {
+			*changes = append(*changes, Change{Path: fmt.Sprintf("%s[%d]", path, i), Op: ChangeRemoved, Old: arr[i]})
+		}
+		return
+	}
+	*changes = append(*changes, Change{Path: path, Op: ChangeRemoved, Old: value})
+}
+
+// canonicalString returns the compact canonical JSON encoding of a decoded value.
+// encoding/json sorts map keys, so key order does not affect the result.
+func canonicalString(v interface{}) string {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+	return string(data)
+}
@@ -0,0 +1,87 @@
+package common
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffResources(t *testing.T) {
+	tests := []struct {
+		name string
+		old  string
+		new  string
+		want []Change
+	}{
+		{
+			name: "identical ignoring key order and whitespace",
+			old:  `{"resourceType": "Patient", "id": "1", "active": true}`,
+			new:  `{"active":true,"id":"1","resourceType":"Patient"}`,
+		},
+		{
+			name: "added element",
+			old:  `{"resourceType": "Patient", "id": "1"}`,
+			new:  `{"resourceType": "Patient", "id": "1", "gender": "male"}`,
+			want: []Change{{Path: "Patient.gender", Op: ChangeAdded, New: "male"}},
+		},
+		{
+			name: "removed element",
+			old:  `{"resourceType": "Patient", "id": "1", "birthDate": "1970-01-01"}`,
+			new:  `{"resourceType": "Patient", "id": "1"}`,
+			want: []Change{{Path: "Patient.birthDate", Op: ChangeRemoved, Old: "1970-01-01"}},
+		},
+		{
+			name: "changed nested element",
+			old:  `{"resourceType": "Patient", "name": [{"family": "Doe", "given": ["John"]}]}`,
+			new:  `{"resourceType": "Patient", "name": [{"family": "Smith", "given": ["John"]}]}`,
+			want: []Change{{Path: "Patient.name[0].family", Op: ChangeChanged, Old: "Doe", New: "Smith"}},
+		},
+		{
+			name: "added and removed array items",
+			old:  `{"resourceType": "Patient", "name": [{"given": ["A", "B", "C"]}]}`,
+			new:  `{"resourceType": "Patient", "name": [{"given": ["A"]}, {"family": "Doe"}], "telecom": [{"value": "1"}, {"value": "2"}]}`,
+			want: []Change{
+				{Path: "Patient.name[0].given[2]", Op: ChangeRemoved, Old: "C"},
+				{Path: "Patient.name[0].given[1]", Op: ChangeRemoved, Old: "B"},
+				{Path: "Patient.name[1]", Op: ChangeAdded, New: map[string]interface{}{"family": "Doe"}},
+				{Path: "Patient.telecom[0]", Op: ChangeAdded, New: map[string]interface{}{"value": "1"}},
+				{Path: "Patient.telecom[1]", Op: ChangeAdded, New: map[string]interface{}{"value": "2"}},
+			},
+		},
+		{
+			name: "decimal precision",
+			old:  `{"resourceType": "Observation", "valueQuantity": {"value": 1.0}}`,
+			new:  `{"resourceType": "Observation", "valueQuantity": {"value": 1.00}}`,
+			want: []Change{{Path: "Observation.valueQuantity.value", Op: ChangeChanged, Old: json.Number("1.0"), New: json.Number("1.00")}},
+		},
+		{
+			name: "different resource types",
+			old:  `{"resourceType": "Patient"}`,
+			new:  `{"resourceType": "Practitioner"}`,
+			want: []Change{{
+				Path: "Patient",
+				Op:   ChangeChanged,
+				Old:  map[string]interface{}{"resourceType": "Patient"},
+				New:  map[string]interface{}{"resourceType": "Practitioner"},
+			}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			changes, err := DiffResources([]byte(tt.old), []byte(tt.new))
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, changes)
+		})
+	}
+}
+
+func TestDiffResources_InvalidJSON(t *testing.T) {
+	_, err := DiffResources([]byte(`{"resourceType": "Patient"`), []byte(`{}`))
+	assert.ErrorIs(t, err, ErrInvalidJSON)
+
+	_, err = DiffResources([]byte(`{}`), []byte(`not json`))
+	assert.ErrorIs(t, err, ErrInvalidJSON)
+}
@@ -4,5 +4,9 @@
 //   - Pointer helpers (String, Bool, Int, etc.)
 //   - Generic Clone function for deep copying
 //   - Error types with path context
-//   - JSON utilities
+//   - JSON utilities (strict decoding with duplicate key detection)
+//   - Walk for depth-first traversal of resource elements with their paths
+//   - FilterResource for keeping only selected elements of a resource
+//   - ToFHIRJSON for marshaling resources without empty values FHIR forbids
+//   - DiffResources for listing the element changes between two resources
 package common
@@ -54,6 +54,7 @@ var (
 	ErrInvalidJSON     = errors.New("invalid JSON")
 	ErrMarshalFailed   = errors.New("marshal failed")
 	ErrUnmarshalFailed = errors.New("unmarshal failed")
+	ErrDuplicateKey    = errors.New("duplicate JSON key")
 
 	// Code generation
 	ErrInvalidSpec     = errors.New("invalid specification")
@@ -0,0 +1,212 @@
+package common
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// jsonMember is a key of a JSON object with its value.
+type jsonMember struct {
+	key   string
+	value any
+}
+
+// jsonObject is a JSON object that keeps the order of its keys.
+type jsonObject []jsonMember
+
+// ToFHIRJSON marshals a resource to JSON and removes the values FHIR does not
+// allow in JSON but Go marshaling can produce: null-valued keys, empty strings,
+// empty arrays and objects left without any member (e.g., a zero-valued *Meta).
+// Removing them can empty their parents, which are removed as well. Nulls that
+// align a primitive array with its extensions ("given" and "_given") are kept.
+//
+// Keys keep the order of the marshaled resource, and HTML characters in strings
+// (e.g., the Narrative div) are not escaped.
+//
+// Usage:
+//
+//	data, err := common.ToFHIRJSON(patient)
+func ToFHIRJSON(resource any) ([]byte, error) {
+	raw, err := json.Marshal(resource)
+	if err != nil {
+		return nil, err
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+	value, err := decodeOrdered(dec)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidJSON, err)
+	}
+
+	cleaned, ok := cleanJSONValue(value)
+	if !ok {
+		cleaned = jsonObject{}
+	}
+	var buf bytes.Buffer
+	if err := encodeOrdered(&buf, cleaned); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// decodeOrdered reads the next JSON value from dec, decoding objects as jsonObject.
+func decodeOrdered(dec *json.Decoder) (any, error) {
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	switch tok {
+	case json.Delim('{'):
+		obj := jsonObject{}
+		for dec.More() {
+			keyTok, err := dec.Token()
+			if err != nil {
+				return nil, err
+			}
+			value, err := decodeOrdered(dec)
+			if err != nil {
+				return nil, err
+			}
+			key, _ := keyTok.(string)
+			obj = append(obj, jsonMember{key: key, value: value})
+		}
+		if _, err := dec.Token(); err != nil {
+			return nil, err
+		}
+		return obj, nil
+	case json.Delim('['):
+		arr := []any{}
+		for dec.More() {
+			value, err := decodeOrdered(dec)
+			if err != nil {
+				return nil, err
+			}
+			arr = append(arr, value)
+		}
+		if _, err := dec.Token(); err != nil {
+			return nil, err
+		}
+		return arr, nil
+	default:
+		return tok, nil
+	}
+}
+
+// cleanJSONValue removes the empty values in value, reporting false if value
+// itself is empty.
+func cleanJSONValue(value any) (any, bool) {
+	switch v := value.(type) {
+	case nil:
+		return nil, false
+	case string:
+		return v, v != ""
+	case jsonObject:
+		return cleanJSONObject(v)
+	case []any:
+		return cleanJSONArray(v, false)
+	default:
+		return v, true
+	}
+}
+
+// cleanJSONObject removes the empty members of obj. A primitive array and its
+// "_" extension array are aligned by index, so both are kept while either has
+// content.
+func cleanJSONObject(obj jsonObject) (any, bool) {
+	arrays := make(map[string]bool)
+	for _, member := range obj {
+		if _, ok := member.value.([]any); ok {
+			arrays[member.key] = true
+		}
+	}
+
+	values := make([]any, len(obj))
+	keep := make(map[string]bool)
+	for i, member := range obj {
+		if arr, ok := member.value.([]any); ok && arrays[alignedKey(member.key)] {
+			values[i], keep[member.key] = cleanJSONArray(arr, true)
+		} else {
+			values[i], keep[member.key] = cleanJSONValue(member.value)
+		}
+	}
+
+	cleaned := jsonObject{}
+	for i, member := range obj {
+		if keep[member.key] || arrays[member.key] && keep[alignedKey(member.key)] {
+			cleaned = append(cleaned, jsonMember{key: member.key, value: values[i]})
+		}
+	}
+	return cleaned, len(cleaned) > 0
+}
+
+// alignedKey returns the key of the array aligned with key: "_given" for
+// "given", and "given" for "_given".
+func alignedKey(key string) string {
+	if strings.HasPrefix(key, "_") {
+		return key[1:]
+	}
+	return "_" + key
+}
+
+// cleanJSONArray removes the empty items of arr. Items of an aligned array are
+// replaced with null instead, so that the indexes of both arrays still match.
+func cleanJSONArray(arr []any, aligned bool) (any, bool) {
+	cleaned := []any{}
+	nonEmpty := 0
+	for _, item := range arr {
+		value, ok := cleanJSONValue(item)
+		switch {
+		case ok:
+			cleaned = append(cleaned, value)
+			nonEmpty++
+		case aligned:
+			cleaned = append(cleaned, nil)
+		}
+	}
+	return cleaned, nonEmpty > 0
+}
+
+// encodeOrdered writes value as compact JSON without HTML escaping.
+func encodeOrdered(buf *bytes.Buffer, value any) error {
+	switch v := value.(type) {
+	case jsonObject:
+		buf.WriteByte('{')
+		for i, member := range v {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			if err := encodeOrdered(buf, member.key); err != nil {
+				return err
+			}
+			buf.WriteByte(':')
+			if err := encodeOrdered(buf, member.value); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte('}')
+	case []any:
+		buf.WriteByte('[')
+		for i, item := range v {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			if err := encodeOrdered(buf, item); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte(']')
+	default:
+		enc := json.NewEncoder(buf)
+		enc.SetEscapeHTML(false)
+		if err := enc.Encode(v); err != nil {
+			return err
+		}
+		// Encode terminates each value with a newline
+		buf.Truncate(buf.Len() - 1)
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+package common
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/robertoaraneda/gofhir/pkg/fhir/r4"
+)
+
+func TestToFHIRJSON(t *testing.T) {
+	t.Run("patient with only id", func(t *testing.T) {
+		patient := &r4.Patient{
+			Id:   String("123"),
+			Meta: &r4.Meta{},
+			Text: &r4.Narrative{Div: String("")},
+			Name: []r4.HumanName{{Given: []string{}}},
+		}
+
+		out, err := ToFHIRJSON(patient)
+		require.NoError(t, err)
+		assert.Equal(t, `{"resourceType":"Patient","id":"123"}`, string(out))
+	})
+
+	t.Run("keeps key order and content", func(t *testing.T) {
+		status := r4.NarrativeStatusGenerated
+		patient := &r4.Patient{
+			Id:     String("123"),
+			Active: Bool(false),
+			Text:   &r4.Narrative{Status: &status, Div: String(`<div xmlns="http://www.w3.org/1999/xhtml">Doe &amp; Co</div>`)},
+			Name:   []r4.HumanName{{Family: String("Doe"), Given: []string{"John", ""}}},
+		}
+
+		out, err := ToFHIRJSON(patient)
+		require.NoError(t, err)
+		assert.Equal(t, `{"resourceType":"Patient","id":"123",`+
+			`"text":{"status":"generated","div":"<div xmlns=\"http://www.w3.org/1999/xhtml\">Doe &amp; Co</div>"},`+
+			`"active":false,"name":[{"family":"Doe","given":["John"]}]}`, string(out))
+	})
+
+	t.Run("keeps nulls aligning primitive extensions", func(t *testing.T) {
+		out, err := ToFHIRJSON(map[string]any{
+			"resourceType": "Patient",
+			"name": []any{map[string]any{
+				"given":   []any{"", "Jim"},
+				"_given":  []any{map[string]any{"extension": []any{map[string]any{"url": "http://example.org/x", "valueBoolean": true}}}, map[string]any{}},
+				"prefix":  []any{""},
+				"_prefix": []any{map[string]any{"id": "p1"}},
+				"suffix":  []any{""},
+				"_suffix": []any{nil},
+			}},
+		})
+		require.NoError(t, err)
+		assert.JSONEq(t, `{
+			"resourceType": "Patient",
+			"name": [{
+				"given": [null, "Jim"],
+				"_given": [{"extension": [{"url": "http://example.org/x", "valueBoolean": true}]}, null],
+				"prefix": [null],
+				"_prefix": [{"id": "p1"}]
+			}]
+		}`, string(out))
+	})
+
+	t.Run("keeps decimal precision", func(t *testing.T) {
+		out, err := ToFHIRJSON(map[string]any{"resourceType": "Observation", "valueQuantity": map[string]any{"value": json.Number("98.60"), "unit": ""}})
+		require.NoError(t, err)
+		assert.Equal(t, `{"resourceType":"Observation","valueQuantity":{"value":98.60}}`, string(out))
+	})
+
+	t.Run("marshal error", func(t *testing.T) {
+		_, err := ToFHIRJSON(map[string]any{"value": make(chan int)})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,133 @@
+package common
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// filterNode is a node of the tree of kept paths. A node without children keeps
+// the whole subtree of its element.
+type filterNode map[string]filterNode
+
+// FilterResource returns a copy of a FHIR JSON resource containing only the
+// elements at keepPaths, plus resourceType and id. This is the projection
+// counterpart of removing elements: everything not listed is dropped.
+//
+// Paths are dot-separated element names, optionally prefixed with the resource
+// type (e.g., "name", "Patient.name.family", "contact.telecom"). A path keeps the
+// whole subtree of its element, and paths through arrays apply to every item.
+// Primitive extensions follow their element: keeping "birthDate" also keeps
+// "_birthDate". Objects and array items left without elements are dropped.
+//
+// Invalid JSON returns an error wrapping ErrInvalidJSON, and a malformed path one
+// wrapping ErrInvalidExpression.
+//
+// Usage:
+//
+//	summary, err := common.FilterResource(data, []string{"name", "birthDate"})
+func FilterResource(resource []byte, keepPaths []string) ([]byte, error) {
+	dec := json.NewDecoder(bytes.NewReader(resource))
+	dec.UseNumber()
+	var obj map[string]interface{}
+	if err := dec.Decode(&obj); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidJSON, err)
+	}
+	if obj == nil {
+		return nil, fmt.Errorf("%w: resource is not a JSON object", ErrInvalidJSON)
+	}
+
+	resourceType, _ := obj["resourceType"].(string)
+	tree := filterNode{}
+	for _, path := range keepPaths {
+		if err := tree.add(resourceType, path); err != nil {
+			return nil, err
+		}
+	}
+
+	filtered := filterObject(obj, tree)
+	if filtered == nil {
+		filtered = make(map[string]interface{})
+	}
+	for _, key := range []string{"resourceType", "id"} {
+		if value, ok := obj[key]; ok {
+			filtered[key] = value
+		}
+	}
+	return json.Marshal(filtered)
+}
+
+// add adds a kept path to the tree.
+func (n filterNode) add(resourceType, path string) error {
+	segments := strings.Split(path, ".")
+	if resourceType != "" && len(segments) > 1 && segments[0] == resourceType {
+		segments = segments[1:]
+	}
+
+	node := n
+	for i, segment := range segments {
+		if segment == "" || strings.ContainsAny(segment, "[]()") {
+			return fmt.Errorf("%w: %q", ErrInvalidExpression, path)
+		}
+		child, seen := node[segment]
+		if seen && child == nil {
+			// An ancestor path already keeps the whole subtree
+			return nil
+		}
+		if i == len(segments)-1 {
+			node[segment] = nil
+			return nil
+		}
+		if child == nil {
+			child = filterNode{}
+			node[segment] = child
+		}
+		node = child
+	}
+	return nil
+}
+
+// filterObject returns the elements of obj kept by node, or nil if none is kept.
+func filterObject(obj map[string]interface{}, node filterNode) map[string]interface{} {
+	out := make(map[string]interface{})
+	for name, child := range node {
+		for _, key := range []string{name, "_" + name} {
+			value, ok := obj[key]
+			if !ok {
+				continue
+			}
+			if kept := filterValue(value, child); kept != nil {
+				out[key] = kept
+			}
+		}
+	}
+	if len(out) == 0 {
+		return nil
+	}
+	return out
+}
+
+// filterValue returns the parts of value kept by node, or nil if none is kept.
+func filterValue(value interface{}, node filterNode) interface{} {
+	if node == nil {
+		return value
+	}
+	switch v := value.(type) {
+	case map[string]interface{}:
+		if kept := filterObject(v, node); kept != nil {
+			return kept
+		}
+	case []interface{}:
+		var items []interface{}
+		for _, item := range v {
+			if kept := filterValue(item, node); kept != nil {
+				items = append(items, kept)
+			}
+		}
+		if len(items) > 0 {
+			return items
+		}
+	}
+	return nil
+}
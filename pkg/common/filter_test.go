@@ -0,0 +1,81 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const filterTestPatient = `{
+	"resourceType": "Patient",
+	"id": "123",
+	"meta": {"versionId": "2"},
+	"active": true,
+	"name": [
+		{"use": "official", "family": "Doe", "given": ["John"]},
+		{"use": "nickname", "given": ["Johnny"]}
+	],
+	"telecom": [{"system": "phone", "value": "555-1234"}],
+	"birthDate": "1990-01-15",
+	"_birthDate": {"extension": [{"url": "http://example.org/accuracy", "valueDecimal": 0.50}]},
+	"contact": [{"name": {"family": "Roe"}, "telecom": [{"value": "555-9876"}]}]
+}`
+
+func TestFilterResource(t *testing.T) {
+	t.Run("keep name and birthDate", func(t *testing.T) {
+		out, err := FilterResource([]byte(filterTestPatient), []string{"name", "birthDate"})
+		require.NoError(t, err)
+		assert.JSONEq(t, `{
+			"resourceType": "Patient",
+			"id": "123",
+			"name": [
+				{"use": "official", "family": "Doe", "given": ["John"]},
+				{"use": "nickname", "given": ["Johnny"]}
+			],
+			"birthDate": "1990-01-15",
+			"_birthDate": {"extension": [{"url": "http://example.org/accuracy", "valueDecimal": 0.50}]}
+		}`, string(out))
+		assert.Contains(t, string(out), `"valueDecimal":0.50`)
+	})
+
+	t.Run("nested paths", func(t *testing.T) {
+		out, err := FilterResource([]byte(filterTestPatient), []string{"Patient.name.family", "contact.telecom.value"})
+		require.NoError(t, err)
+		assert.JSONEq(t, `{
+			"resourceType": "Patient",
+			"id": "123",
+			"name": [{"family": "Doe"}],
+			"contact": [{"telecom": [{"value": "555-9876"}]}]
+		}`, string(out))
+	})
+
+	t.Run("ancestor path keeps the whole subtree", func(t *testing.T) {
+		out, err := FilterResource([]byte(filterTestPatient), []string{"telecom.value", "telecom"})
+		require.NoError(t, err)
+		assert.JSONEq(t, `{
+			"resourceType": "Patient",
+			"id": "123",
+			"telecom": [{"system": "phone", "value": "555-1234"}]
+		}`, string(out))
+	})
+
+	t.Run("missing elements", func(t *testing.T) {
+		out, err := FilterResource([]byte(filterTestPatient), []string{"deceasedBoolean"})
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"resourceType": "Patient", "id": "123"}`, string(out))
+	})
+
+	t.Run("invalid path", func(t *testing.T) {
+		_, err := FilterResource([]byte(filterTestPatient), []string{"name..family"})
+		assert.ErrorIs(t, err, ErrInvalidExpression)
+
+		_, err = FilterResource([]byte(filterTestPatient), []string{"name.where(use = 'official')"})
+		assert.ErrorIs(t, err, ErrInvalidExpression)
+	})
+
+	t.Run("invalid JSON", func(t *testing.T) {
+		_, err := FilterResource([]byte(`[1, 2]`), []string{"name"})
+		assert.ErrorIs(t, err, ErrInvalidJSON)
+	})
+}
@@ -0,0 +1,78 @@
+package common
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// DecodeStrict unmarshals FHIR JSON into v, rejecting objects with duplicate keys.
+// encoding/json silently keeps the last value of a duplicated key, which FHIR forbids.
+//
+// Usage:
+//
+//	var patient r4.Patient
+//	if err := common.DecodeStrict(data, &patient); err != nil {
+//	    // errors.Is(err, common.ErrDuplicateKey) for duplicated keys
+//	}
+func DecodeStrict(data []byte, v any) error {
+	if err := CheckDuplicateKeys(data); err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+// CheckDuplicateKeys scans JSON data and returns an error for the first object
+// containing a duplicated key. The error is a PathError (e.g., "name[0].family")
+// wrapping ErrDuplicateKey; malformed JSON is reported as ErrInvalidJSON.
+func CheckDuplicateKeys(data []byte) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	return checkDuplicateKeys(dec, "")
+}
+
+// checkDuplicateKeys walks the next JSON value from dec, tracking its path.
+func checkDuplicateKeys(dec *json.Decoder, path string) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return WrapPath(path, fmt.Errorf("%w: %v", ErrInvalidJSON, err))
+	}
+
+	switch tok {
+	case json.Delim('{'):
+		seen := make(map[string]bool)
+		for dec.More() {
+			keyTok, err := dec.Token()
+			if err != nil {
+				return WrapPath(path, fmt.Errorf("%w: %v", ErrInvalidJSON, err))
+			}
+			key, _ := keyTok.(string)
+			keyPath := key
+			if path != "" {
+				keyPath = path + "." + key
+			}
+			if seen[key] {
+				return WrapPath(keyPath, fmt.Errorf("%w: %q", ErrDuplicateKey, key))
+			}
+			seen[key] = true
+			if err := checkDuplicateKeys(dec, keyPath); err != nil {
+				return err
+			}
+		}
+	case json.Delim('['):
+		for i := 0; dec.More(); i++ {
+			if err := checkDuplicateKeys(dec, path+"["+strconv.Itoa(i)+"]"); err != nil {
+				return err
+			}
+		}
+	default:
+		return nil
+	}
+
+	// Consume the closing delimiter
+	if _, err := dec.Token(); err != nil {
+		return WrapPath(path, fmt.Errorf("%w: %v", ErrInvalidJSON, err))
+	}
+	return nil
+}
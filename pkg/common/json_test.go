@@ -0,0 +1,78 @@
+package common
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckDuplicateKeys(t *testing.T) {
+	tests := []struct {
+		name     string
+		json     string
+		wantErr  error
+		wantPath string
+	}{
+		{
+			name: "no duplicates",
+			json: `{"resourceType": "Observation", "status": "final", "code": {"text": "x"}}`,
+		},
+		{
+			name: "same key in sibling objects",
+			json: `{"name": [{"family": "Doe"}, {"family": "Roe"}]}`,
+		},
+		{
+			name:     "duplicated top-level key",
+			json:     `{"resourceType": "Observation", "status": "final", "status": "amended"}`,
+			wantErr:  ErrDuplicateKey,
+			wantPath: "status",
+		},
+		{
+			name:     "duplicated nested key",
+			json:     `{"name": [{"family": "Doe"}, {"family": "Roe", "family": "Poe"}]}`,
+			wantErr:  ErrDuplicateKey,
+			wantPath: "name[1].family",
+		},
+		{
+			name:    "malformed JSON",
+			json:    `{"status": }`,
+			wantErr: ErrInvalidJSON,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckDuplicateKeys([]byte(tt.json))
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, tt.wantErr), "unexpected error: %v", err)
+			if tt.wantPath != "" {
+				assert.Equal(t, tt.wantPath, GetPath(err))
+			}
+		})
+	}
+}
+
+func TestDecodeStrict(t *testing.T) {
+	type observation struct {
+		Status string `json:"status"`
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		var obs observation
+		require.NoError(t, DecodeStrict([]byte(`{"status": "final"}`), &obs))
+		assert.Equal(t, "final", obs.Status)
+	})
+
+	t.Run("duplicated status", func(t *testing.T) {
+		var obs observation
+		err := DecodeStrict([]byte(`{"status": "final", "status": "amended"}`), &obs)
+		assert.ErrorIs(t, err, ErrDuplicateKey)
+		assert.Empty(t, obs.Status, "value must not be decoded when keys are duplicated")
+	})
+}
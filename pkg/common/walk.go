@@ -0,0 +1,104 @@
+package common
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+)
+
+// Sentinel errors returned by a WalkFunc to control the traversal.
+var (
+	// ErrSkipElement skips the children of the element being visited.
+	ErrSkipElement = errors.New("skip element")
+	// ErrStopWalk stops the traversal; Walk returns nil.
+	ErrStopWalk = errors.New("stop walk")
+)
+
+// WalkFunc is called by Walk for each element of a resource.
+//
+// value is the decoded JSON value: map[string]interface{} for objects, string,
+// bool, json.Number (so decimals keep their precision) or nil. Arrays are not
+// visited themselves; each of their items is visited with an indexed path.
+type WalkFunc func(path string, value interface{}) error
+
+// Walk performs a depth-first traversal of a FHIR JSON resource, calling visit
+// for each element with its FHIRPath-style path (e.g., "Patient.name[0].given[1]").
+// The resource itself is visited first with its resource type as the path.
+//
+// Object properties are visited in sorted order and resourceType properties are
+// not visited. Primitive extensions (e.g., "_birthDate") are visited under their
+// JSON property name.
+//
+// If visit returns ErrSkipElement the children of the element are skipped, and if
+// it returns ErrStopWalk the traversal stops and Walk returns nil. Any other error
+// stops the traversal and is returned.
+//
+// Usage:
+//
+//	err := common.Walk(data, func(path string, value interface{}) error {
+//	    fmt.Println(path)
+//	    return nil
+//	})
+func Walk(resource []byte, visit WalkFunc) error {
+	dec := json.NewDecoder(bytes.NewReader(resource))
+	dec.UseNumber()
+	var root interface{}
+	if err := dec.Decode(&root); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidJSON, err)
+	}
+
+	path := ""
+	if obj, ok := root.(map[string]interface{}); ok {
+		path, _ = obj["resourceType"].(string)
+	}
+
+	err := walkValue(path, root, visit)
+	if errors.Is(err, ErrStopWalk) {
+		return nil
+	}
+	return err
+}
+
+// walkValue visits value at path and then its children.
+func walkValue(path string, value interface{}, visit WalkFunc) error {
+	if items, ok := value.([]interface{}); ok {
+		for i, item := range items {
+			if err := walkValue(path+"["+strconv.Itoa(i)+"]", item, visit); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if err := visit(path, value); err != nil {
+		if errors.Is(err, ErrSkipElement) {
+			return nil
+		}
+		return err
+	}
+
+	obj, ok := value.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	keys := make([]string, 0, len(obj))
+	for key := range obj {
+		if key != "resourceType" {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		childPath := key
+		if path != "" {
+			childPath = path + "." + key
+		}
+		if err := walkValue(childPath, obj[key], visit); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+package common
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const walkTestPatient = `{
+	"resourceType": "Patient",
+	"id": "123",
+	"active": true,
+	"name": [{"family": "Doe", "given": ["John", "Q"]}],
+	"birthDate": "1990-01-15",
+	"_birthDate": {"extension": [{"url": "http://example.org/accuracy", "valueDecimal": 0.50}]}
+}`
+
+func TestWalk(t *testing.T) {
+	var paths []string
+	values := make(map[string]interface{})
+	err := Walk([]byte(walkTestPatient), func(path string, value interface{}) error {
+		paths = append(paths, path)
+		values[path] = value
+		return nil
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{
+		"Patient",
+		"Patient._birthDate",
+		"Patient._birthDate.extension[0]",
+		"Patient._birthDate.extension[0].url",
+		"Patient._birthDate.extension[0].valueDecimal",
+		"Patient.active",
+		"Patient.birthDate",
+		"Patient.id",
+		"Patient.name[0]",
+		"Patient.name[0].family",
+		"Patient.name[0].given[0]",
+		"Patient.name[0].given[1]",
+	}, paths)
+
+	assert.Equal(t, true, values["Patient.active"])
+	assert.Equal(t, "Q", values["Patient.name[0].given[1]"])
+	assert.Equal(t, json.Number("0.50"), values["Patient._birthDate.extension[0].valueDecimal"])
+	assert.IsType(t, map[string]interface{}{}, values["Patient.name[0]"])
+}
+
+func TestWalkControl(t *testing.T) {
+	t.Run("skip element", func(t *testing.T) {
+		var paths []string
+		err := Walk([]byte(walkTestPatient), func(path string, _ interface{}) error {
+			paths = append(paths, path)
+			if strings.HasPrefix(path, "Patient._birthDate") || path == "Patient.name[0]" {
+				return ErrSkipElement
+			}
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Patient", "Patient._birthDate", "Patient.active", "Patient.birthDate", "Patient.id", "Patient.name[0]"}, paths)
+	})
+
+	t.Run("stop walk", func(t *testing.T) {
+		var paths []string
+		err := Walk([]byte(walkTestPatient), func(path string, _ interface{}) error {
+			paths = append(paths, path)
+			if path == "Patient.active" {
+				return ErrStopWalk
+			}
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "Patient.active", paths[len(paths)-1])
+		assert.NotContains(t, paths, "Patient.birthDate")
+	})
+
+	t.Run("visit error is returned", func(t *testing.T) {
+		boom := errors.New("boom")
+		err := Walk([]byte(walkTestPatient), func(path string, _ interface{}) error {
+			if path == "Patient.id" {
+				return boom
+			}
+			return nil
+		})
+		assert.ErrorIs(t, err, boom)
+	})
+
+	t.Run("invalid JSON", func(t *testing.T) {
+		err := Walk([]byte(`{"resourceType": `), func(string, interface{}) error { return nil })
+		assert.ErrorIs(t, err, ErrInvalidJSON)
+	})
+}
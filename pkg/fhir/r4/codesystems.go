@@ -4,6 +4,26 @@
 
 package r4
 
+import (
+	"fmt"
+	"strings"
+)
+
+// parseCode returns the code of displays equal to s or, failing that, the code
+// whose value or display name matches s ignoring case.
+func parseCode[T ~string](s string, displays map[T]string) (T, error) {
+	if _, ok := displays[T(s)]; ok {
+		return T(s), nil
+	}
+	for code, display := range displays {
+		if strings.EqualFold(string(code), s) || (display != "" && strings.EqualFold(display, s)) {
+			return code, nil
+		}
+	}
+	var zero T
+	return zero, fmt.Errorf("unknown %T code: %q", zero, s)
+}
+
 // FHIRVersion represents FHIRVersion.
 type FHIRVersion string
 
@@ -55,6 +75,57 @@ const (
 	FHIRVersion401 FHIRVersion = "4.0.1"
 )
 
+// fHIRVersionDisplays maps each FHIRVersion code to its display name.
+var fHIRVersionDisplays = map[FHIRVersion]string{
+	FHIRVersion001:  "0.01",
+	FHIRVersion005:  "0.05",
+	FHIRVersion006:  "0.06",
+	FHIRVersion011:  "0.11",
+	FHIRVersion0080: "0.0.80",
+	FHIRVersion0081: "0.0.81",
+	FHIRVersion0082: "0.0.82",
+	FHIRVersion040:  "0.4.0",
+	FHIRVersion050:  "0.5.0",
+	FHIRVersion100:  "1.0.0",
+	FHIRVersion101:  "1.0.1",
+	FHIRVersion102:  "1.0.2",
+	FHIRVersion110:  "1.1.0",
+	FHIRVersion140:  "1.4.0",
+	FHIRVersion160:  "1.6.0",
+	FHIRVersion180:  "1.8.0",
+	FHIRVersion300:  "3.0.0",
+	FHIRVersion301:  "3.0.1",
+	FHIRVersion330:  "3.3.0",
+	FHIRVersion350:  "3.5.0",
+	FHIRVersion400:  "4.0.0",
+	FHIRVersion401:  "4.0.1",
+}
+
+// Code returns the code value.
+func (c FHIRVersion) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the FHIRVersion values.
+func (c FHIRVersion) IsValid() bool {
+	_, ok := fHIRVersionDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c FHIRVersion) Display() string {
+	if display := fHIRVersionDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseFHIRVersion returns the FHIRVersion for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseFHIRVersion(s string) (FHIRVersion, error) {
+	return parseCode(s, fHIRVersionDisplays)
+}
+
 // AccountStatus represents AccountStatus.
 type AccountStatus string
 
@@ -72,6 +143,40 @@ const (
 	AccountStatusUnknown AccountStatus = "unknown"
 )
 
+// accountStatusDisplays maps each AccountStatus code to its display name.
+var accountStatusDisplays = map[AccountStatus]string{
+	AccountStatusActive:         "Active",
+	AccountStatusInactive:       "Inactive",
+	AccountStatusEnteredInError: "Entered in error",
+	AccountStatusOnHold:         "On Hold",
+	AccountStatusUnknown:        "Unknown",
+}
+
+// Code returns the code value.
+func (c AccountStatus) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the AccountStatus values.
+func (c AccountStatus) IsValid() bool {
+	_, ok := accountStatusDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c AccountStatus) Display() string {
+	if display := accountStatusDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseAccountStatus returns the AccountStatus for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseAccountStatus(s string) (AccountStatus, error) {
+	return parseCode(s, accountStatusDisplays)
+}
+
 // ActionCardinalityBehavior represents ActionCardinalityBehavior.
 type ActionCardinalityBehavior string
 
@@ -83,6 +188,37 @@ const (
 	ActionCardinalityBehaviorMultiple ActionCardinalityBehavior = "multiple"
 )
 
+// actionCardinalityBehaviorDisplays maps each ActionCardinalityBehavior code to its display name.
+var actionCardinalityBehaviorDisplays = map[ActionCardinalityBehavior]string{
+	ActionCardinalityBehaviorSingle:   "Single",
+	ActionCardinalityBehaviorMultiple: "Multiple",
+}
+
+// Code returns the code value.
+func (c ActionCardinalityBehavior) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the ActionCardinalityBehavior values.
+func (c ActionCardinalityBehavior) IsValid() bool {
+	_, ok := actionCardinalityBehaviorDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c ActionCardinalityBehavior) Display() string {
+	if display := actionCardinalityBehaviorDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseActionCardinalityBehavior returns the ActionCardinalityBehavior for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseActionCardinalityBehavior(s string) (ActionCardinalityBehavior, error) {
+	return parseCode(s, actionCardinalityBehaviorDisplays)
+}
+
 // ActionConditionKind represents ActionConditionKind.
 type ActionConditionKind string
 
@@ -96,6 +232,38 @@ const (
 	ActionConditionKindStop ActionConditionKind = "stop"
 )
 
+// actionConditionKindDisplays maps each ActionConditionKind code to its display name.
+var actionConditionKindDisplays = map[ActionConditionKind]string{
+	ActionConditionKindApplicability: "Applicability",
+	ActionConditionKindStart:         "Start",
+	ActionConditionKindStop:          "Stop",
+}
+
+// Code returns the code value.
+func (c ActionConditionKind) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the ActionConditionKind values.
+func (c ActionConditionKind) IsValid() bool {
+	_, ok := actionConditionKindDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c ActionConditionKind) Display() string {
+	if display := actionConditionKindDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseActionConditionKind returns the ActionConditionKind for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseActionConditionKind(s string) (ActionConditionKind, error) {
+	return parseCode(s, actionConditionKindDisplays)
+}
+
 // ActionGroupingBehavior represents ActionGroupingBehavior.
 type ActionGroupingBehavior string
 
@@ -109,6 +277,38 @@ const (
 	ActionGroupingBehaviorSentenceGroup ActionGroupingBehavior = "sentence-group"
 )
 
+// actionGroupingBehaviorDisplays maps each ActionGroupingBehavior code to its display name.
+var actionGroupingBehaviorDisplays = map[ActionGroupingBehavior]string{
+	ActionGroupingBehaviorVisualGroup:   "Visual Group",
+	ActionGroupingBehaviorLogicalGroup:  "Logical Group",
+	ActionGroupingBehaviorSentenceGroup: "Sentence Group",
+}
+
+// Code returns the code value.
+func (c ActionGroupingBehavior) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the ActionGroupingBehavior values.
+func (c ActionGroupingBehavior) IsValid() bool {
+	_, ok := actionGroupingBehaviorDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c ActionGroupingBehavior) Display() string {
+	if display := actionGroupingBehaviorDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseActionGroupingBehavior returns the ActionGroupingBehavior for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseActionGroupingBehavior(s string) (ActionGroupingBehavior, error) {
+	return parseCode(s, actionGroupingBehaviorDisplays)
+}
+
 // ActionParticipantType represents ActionParticipantType.
 type ActionParticipantType string
 
@@ -124,6 +324,39 @@ const (
 	ActionParticipantTypeDevice ActionParticipantType = "device"
 )
 
+// actionParticipantTypeDisplays maps each ActionParticipantType code to its display name.
+var actionParticipantTypeDisplays = map[ActionParticipantType]string{
+	ActionParticipantTypePatient:       "Patient",
+	ActionParticipantTypePractitioner:  "Practitioner",
+	ActionParticipantTypeRelatedPerson: "Related Person",
+	ActionParticipantTypeDevice:        "Device",
+}
+
+// Code returns the code value.
+func (c ActionParticipantType) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the ActionParticipantType values.
+func (c ActionParticipantType) IsValid() bool {
+	_, ok := actionParticipantTypeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c ActionParticipantType) Display() string {
+	if display := actionParticipantTypeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseActionParticipantType returns the ActionParticipantType for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseActionParticipantType(s string) (ActionParticipantType, error) {
+	return parseCode(s, actionParticipantTypeDisplays)
+}
+
 // ActionPrecheckBehavior represents ActionPrecheckBehavior.
 type ActionPrecheckBehavior string
 
@@ -135,6 +368,37 @@ const (
 	ActionPrecheckBehaviorNo ActionPrecheckBehavior = "no"
 )
 
+// actionPrecheckBehaviorDisplays maps each ActionPrecheckBehavior code to its display name.
+var actionPrecheckBehaviorDisplays = map[ActionPrecheckBehavior]string{
+	ActionPrecheckBehaviorYes: "Yes",
+	ActionPrecheckBehaviorNo:  "No",
+}
+
+// Code returns the code value.
+func (c ActionPrecheckBehavior) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the ActionPrecheckBehavior values.
+func (c ActionPrecheckBehavior) IsValid() bool {
+	_, ok := actionPrecheckBehaviorDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c ActionPrecheckBehavior) Display() string {
+	if display := actionPrecheckBehaviorDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseActionPrecheckBehavior returns the ActionPrecheckBehavior for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseActionPrecheckBehavior(s string) (ActionPrecheckBehavior, error) {
+	return parseCode(s, actionPrecheckBehaviorDisplays)
+}
+
 // ActionRelationshipType represents ActionRelationshipType.
 type ActionRelationshipType string
 
@@ -160,6 +424,44 @@ const (
 	ActionRelationshipTypeAfterEnd ActionRelationshipType = "after-end"
 )
 
+// actionRelationshipTypeDisplays maps each ActionRelationshipType code to its display name.
+var actionRelationshipTypeDisplays = map[ActionRelationshipType]string{
+	ActionRelationshipTypeBeforeStart:         "Before Start",
+	ActionRelationshipTypeBefore:              "Before",
+	ActionRelationshipTypeBeforeEnd:           "Before End",
+	ActionRelationshipTypeConcurrentWithStart: "Concurrent With Start",
+	ActionRelationshipTypeConcurrent:          "Concurrent",
+	ActionRelationshipTypeConcurrentWithEnd:   "Concurrent With End",
+	ActionRelationshipTypeAfterStart:          "After Start",
+	ActionRelationshipTypeAfter:               "After",
+	ActionRelationshipTypeAfterEnd:            "After End",
+}
+
+// Code returns the code value.
+func (c ActionRelationshipType) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the ActionRelationshipType values.
+func (c ActionRelationshipType) IsValid() bool {
+	_, ok := actionRelationshipTypeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c ActionRelationshipType) Display() string {
+	if display := actionRelationshipTypeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseActionRelationshipType returns the ActionRelationshipType for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseActionRelationshipType(s string) (ActionRelationshipType, error) {
+	return parseCode(s, actionRelationshipTypeDisplays)
+}
+
 // ActionRequiredBehavior represents ActionRequiredBehavior.
 type ActionRequiredBehavior string
 
@@ -173,6 +475,38 @@ const (
 	ActionRequiredBehaviorMustUnlessDocumented ActionRequiredBehavior = "must-unless-documented"
 )
 
+// actionRequiredBehaviorDisplays maps each ActionRequiredBehavior code to its display name.
+var actionRequiredBehaviorDisplays = map[ActionRequiredBehavior]string{
+	ActionRequiredBehaviorMust:                 "Must",
+	ActionRequiredBehaviorCould:                "Could",
+	ActionRequiredBehaviorMustUnlessDocumented: "Must Unless Documented",
+}
+
+// Code returns the code value.
+func (c ActionRequiredBehavior) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the ActionRequiredBehavior values.
+func (c ActionRequiredBehavior) IsValid() bool {
+	_, ok := actionRequiredBehaviorDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c ActionRequiredBehavior) Display() string {
+	if display := actionRequiredBehaviorDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseActionRequiredBehavior returns the ActionRequiredBehavior for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseActionRequiredBehavior(s string) (ActionRequiredBehavior, error) {
+	return parseCode(s, actionRequiredBehaviorDisplays)
+}
+
 // ActionSelectionBehavior represents ActionSelectionBehavior.
 type ActionSelectionBehavior string
 
@@ -192,6 +526,41 @@ const (
 	ActionSelectionBehaviorOneOrMore ActionSelectionBehavior = "one-or-more"
 )
 
+// actionSelectionBehaviorDisplays maps each ActionSelectionBehavior code to its display name.
+var actionSelectionBehaviorDisplays = map[ActionSelectionBehavior]string{
+	ActionSelectionBehaviorAny:        "Any",
+	ActionSelectionBehaviorAll:        "All",
+	ActionSelectionBehaviorAllOrNone:  "All Or None",
+	ActionSelectionBehaviorExactlyOne: "Exactly One",
+	ActionSelectionBehaviorAtMostOne:  "At Most One",
+	ActionSelectionBehaviorOneOrMore:  "One Or More",
+}
+
+// Code returns the code value.
+func (c ActionSelectionBehavior) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the ActionSelectionBehavior values.
+func (c ActionSelectionBehavior) IsValid() bool {
+	_, ok := actionSelectionBehaviorDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c ActionSelectionBehavior) Display() string {
+	if display := actionSelectionBehaviorDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseActionSelectionBehavior returns the ActionSelectionBehavior for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseActionSelectionBehavior(s string) (ActionSelectionBehavior, error) {
+	return parseCode(s, actionSelectionBehaviorDisplays)
+}
+
 // AddressType represents AddressType.
 type AddressType string
 
@@ -205,6 +574,38 @@ const (
 	AddressTypeBoth AddressType = "both"
 )
 
+// addressTypeDisplays maps each AddressType code to its display name.
+var addressTypeDisplays = map[AddressType]string{
+	AddressTypePostal:   "Postal",
+	AddressTypePhysical: "Physical",
+	AddressTypeBoth:     "Postal & Physical",
+}
+
+// Code returns the code value.
+func (c AddressType) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the AddressType values.
+func (c AddressType) IsValid() bool {
+	_, ok := addressTypeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c AddressType) Display() string {
+	if display := addressTypeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseAddressType returns the AddressType for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseAddressType(s string) (AddressType, error) {
+	return parseCode(s, addressTypeDisplays)
+}
+
 // AddressUse represents AddressUse.
 type AddressUse string
 
@@ -222,6 +623,40 @@ const (
 	AddressUseBilling AddressUse = "billing"
 )
 
+// addressUseDisplays maps each AddressUse code to its display name.
+var addressUseDisplays = map[AddressUse]string{
+	AddressUseHome:    "Home",
+	AddressUseWork:    "Work",
+	AddressUseTemp:    "Temporary",
+	AddressUseOld:     "Old / Incorrect",
+	AddressUseBilling: "Billing",
+}
+
+// Code returns the code value.
+func (c AddressUse) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the AddressUse values.
+func (c AddressUse) IsValid() bool {
+	_, ok := addressUseDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c AddressUse) Display() string {
+	if display := addressUseDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseAddressUse returns the AddressUse for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseAddressUse(s string) (AddressUse, error) {
+	return parseCode(s, addressUseDisplays)
+}
+
 // AdministrativeGender represents AdministrativeGender.
 type AdministrativeGender string
 
@@ -237,6 +672,39 @@ const (
 	AdministrativeGenderUnknown AdministrativeGender = "unknown"
 )
 
+// administrativeGenderDisplays maps each AdministrativeGender code to its display name.
+var administrativeGenderDisplays = map[AdministrativeGender]string{
+	AdministrativeGenderMale:    "Male",
+	AdministrativeGenderFemale:  "Female",
+	AdministrativeGenderOther:   "Other",
+	AdministrativeGenderUnknown: "Unknown",
+}
+
+// Code returns the code value.
+func (c AdministrativeGender) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the AdministrativeGender values.
+func (c AdministrativeGender) IsValid() bool {
+	_, ok := administrativeGenderDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c AdministrativeGender) Display() string {
+	if display := administrativeGenderDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseAdministrativeGender returns the AdministrativeGender for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseAdministrativeGender(s string) (AdministrativeGender, error) {
+	return parseCode(s, administrativeGenderDisplays)
+}
+
 // AdverseEventActuality represents AdverseEventActuality.
 type AdverseEventActuality string
 
@@ -248,6 +716,37 @@ const (
 	AdverseEventActualityPotential AdverseEventActuality = "potential"
 )
 
+// adverseEventActualityDisplays maps each AdverseEventActuality code to its display name.
+var adverseEventActualityDisplays = map[AdverseEventActuality]string{
+	AdverseEventActualityActual:    "Adverse Event",
+	AdverseEventActualityPotential: "Potential Adverse Event",
+}
+
+// Code returns the code value.
+func (c AdverseEventActuality) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the AdverseEventActuality values.
+func (c AdverseEventActuality) IsValid() bool {
+	_, ok := adverseEventActualityDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c AdverseEventActuality) Display() string {
+	if display := adverseEventActualityDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseAdverseEventActuality returns the AdverseEventActuality for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseAdverseEventActuality(s string) (AdverseEventActuality, error) {
+	return parseCode(s, adverseEventActualityDisplays)
+}
+
 // AllergyIntoleranceCategory represents AllergyIntoleranceCategory.
 type AllergyIntoleranceCategory string
 
@@ -263,6 +762,39 @@ const (
 	AllergyIntoleranceCategoryBiologic AllergyIntoleranceCategory = "biologic"
 )
 
+// allergyIntoleranceCategoryDisplays maps each AllergyIntoleranceCategory code to its display name.
+var allergyIntoleranceCategoryDisplays = map[AllergyIntoleranceCategory]string{
+	AllergyIntoleranceCategoryFood:        "Food",
+	AllergyIntoleranceCategoryMedication:  "Medication",
+	AllergyIntoleranceCategoryEnvironment: "Environment",
+	AllergyIntoleranceCategoryBiologic:    "Biologic",
+}
+
+// Code returns the code value.
+func (c AllergyIntoleranceCategory) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the AllergyIntoleranceCategory values.
+func (c AllergyIntoleranceCategory) IsValid() bool {
+	_, ok := allergyIntoleranceCategoryDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c AllergyIntoleranceCategory) Display() string {
+	if display := allergyIntoleranceCategoryDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseAllergyIntoleranceCategory returns the AllergyIntoleranceCategory for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseAllergyIntoleranceCategory(s string) (AllergyIntoleranceCategory, error) {
+	return parseCode(s, allergyIntoleranceCategoryDisplays)
+}
+
 // AllergyIntoleranceCriticality represents AllergyIntoleranceCriticality.
 type AllergyIntoleranceCriticality string
 
@@ -276,6 +808,38 @@ const (
 	AllergyIntoleranceCriticalityUnableToAssess AllergyIntoleranceCriticality = "unable-to-assess"
 )
 
+// allergyIntoleranceCriticalityDisplays maps each AllergyIntoleranceCriticality code to its display name.
+var allergyIntoleranceCriticalityDisplays = map[AllergyIntoleranceCriticality]string{
+	AllergyIntoleranceCriticalityLow:            "Low Risk",
+	AllergyIntoleranceCriticalityHigh:           "High Risk",
+	AllergyIntoleranceCriticalityUnableToAssess: "Unable to Assess Risk",
+}
+
+// Code returns the code value.
+func (c AllergyIntoleranceCriticality) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the AllergyIntoleranceCriticality values.
+func (c AllergyIntoleranceCriticality) IsValid() bool {
+	_, ok := allergyIntoleranceCriticalityDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c AllergyIntoleranceCriticality) Display() string {
+	if display := allergyIntoleranceCriticalityDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseAllergyIntoleranceCriticality returns the AllergyIntoleranceCriticality for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseAllergyIntoleranceCriticality(s string) (AllergyIntoleranceCriticality, error) {
+	return parseCode(s, allergyIntoleranceCriticalityDisplays)
+}
+
 // AllergyIntoleranceType represents AllergyIntoleranceType.
 type AllergyIntoleranceType string
 
@@ -287,6 +851,37 @@ const (
 	AllergyIntoleranceTypeIntolerance AllergyIntoleranceType = "intolerance"
 )
 
+// allergyIntoleranceTypeDisplays maps each AllergyIntoleranceType code to its display name.
+var allergyIntoleranceTypeDisplays = map[AllergyIntoleranceType]string{
+	AllergyIntoleranceTypeAllergy:     "Allergy",
+	AllergyIntoleranceTypeIntolerance: "Intolerance",
+}
+
+// Code returns the code value.
+func (c AllergyIntoleranceType) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the AllergyIntoleranceType values.
+func (c AllergyIntoleranceType) IsValid() bool {
+	_, ok := allergyIntoleranceTypeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c AllergyIntoleranceType) Display() string {
+	if display := allergyIntoleranceTypeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseAllergyIntoleranceType returns the AllergyIntoleranceType for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseAllergyIntoleranceType(s string) (AllergyIntoleranceType, error) {
+	return parseCode(s, allergyIntoleranceTypeDisplays)
+}
+
 // AppointmentStatus represents AppointmentStatus.
 type AppointmentStatus string
 
@@ -314,6 +909,45 @@ const (
 	AppointmentStatusWaitlist AppointmentStatus = "waitlist"
 )
 
+// appointmentStatusDisplays maps each AppointmentStatus code to its display name.
+var appointmentStatusDisplays = map[AppointmentStatus]string{
+	AppointmentStatusProposed:       "Proposed",
+	AppointmentStatusPending:        "Pending",
+	AppointmentStatusBooked:         "Booked",
+	AppointmentStatusArrived:        "Arrived",
+	AppointmentStatusFulfilled:      "Fulfilled",
+	AppointmentStatusCancelled:      "Cancelled",
+	AppointmentStatusNoshow:         "No Show",
+	AppointmentStatusEnteredInError: "Entered in error",
+	AppointmentStatusCheckedIn:      "Checked In",
+	AppointmentStatusWaitlist:       "Waitlisted",
+}
+
+// Code returns the code value.
+func (c AppointmentStatus) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the AppointmentStatus values.
+func (c AppointmentStatus) IsValid() bool {
+	_, ok := appointmentStatusDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c AppointmentStatus) Display() string {
+	if display := appointmentStatusDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseAppointmentStatus returns the AppointmentStatus for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseAppointmentStatus(s string) (AppointmentStatus, error) {
+	return parseCode(s, appointmentStatusDisplays)
+}
+
 // AssertionDirectionType represents AssertionDirectionType.
 type AssertionDirectionType string
 
@@ -325,6 +959,37 @@ const (
 	AssertionDirectionTypeRequest AssertionDirectionType = "request"
 )
 
+// assertionDirectionTypeDisplays maps each AssertionDirectionType code to its display name.
+var assertionDirectionTypeDisplays = map[AssertionDirectionType]string{
+	AssertionDirectionTypeResponse: "response",
+	AssertionDirectionTypeRequest:  "request",
+}
+
+// Code returns the code value.
+func (c AssertionDirectionType) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the AssertionDirectionType values.
+func (c AssertionDirectionType) IsValid() bool {
+	_, ok := assertionDirectionTypeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c AssertionDirectionType) Display() string {
+	if display := assertionDirectionTypeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseAssertionDirectionType returns the AssertionDirectionType for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseAssertionDirectionType(s string) (AssertionDirectionType, error) {
+	return parseCode(s, assertionDirectionTypeDisplays)
+}
+
 // AssertionOperatorType represents AssertionOperatorType.
 type AssertionOperatorType string
 
@@ -354,6 +1019,46 @@ const (
 	AssertionOperatorTypeEval AssertionOperatorType = "eval"
 )
 
+// assertionOperatorTypeDisplays maps each AssertionOperatorType code to its display name.
+var assertionOperatorTypeDisplays = map[AssertionOperatorType]string{
+	AssertionOperatorTypeEquals:      "equals",
+	AssertionOperatorTypeNotequals:   "notEquals",
+	AssertionOperatorTypeIn:          "in",
+	AssertionOperatorTypeNotin:       "notIn",
+	AssertionOperatorTypeGreaterthan: "greaterThan",
+	AssertionOperatorTypeLessthan:    "lessThan",
+	AssertionOperatorTypeEmpty:       "empty",
+	AssertionOperatorTypeNotempty:    "notEmpty",
+	AssertionOperatorTypeContains:    "contains",
+	AssertionOperatorTypeNotcontains: "notContains",
+	AssertionOperatorTypeEval:        "evaluate",
+}
+
+// Code returns the code value.
+func (c AssertionOperatorType) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the AssertionOperatorType values.
+func (c AssertionOperatorType) IsValid() bool {
+	_, ok := assertionOperatorTypeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c AssertionOperatorType) Display() string {
+	if display := assertionOperatorTypeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseAssertionOperatorType returns the AssertionOperatorType for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseAssertionOperatorType(s string) (AssertionOperatorType, error) {
+	return parseCode(s, assertionOperatorTypeDisplays)
+}
+
 // AssertionResponseTypes represents AssertionResponseTypes.
 type AssertionResponseTypes string
 
@@ -385,6 +1090,47 @@ const (
 	AssertionResponseTypesUnprocessable AssertionResponseTypes = "unprocessable"
 )
 
+// assertionResponseTypesDisplays maps each AssertionResponseTypes code to its display name.
+var assertionResponseTypesDisplays = map[AssertionResponseTypes]string{
+	AssertionResponseTypesOkay:               "okay",
+	AssertionResponseTypesCreated:            "created",
+	AssertionResponseTypesNocontent:          "noContent",
+	AssertionResponseTypesNotmodified:        "notModified",
+	AssertionResponseTypesBad:                "bad",
+	AssertionResponseTypesForbidden:          "forbidden",
+	AssertionResponseTypesNotfound:           "notFound",
+	AssertionResponseTypesMethodnotallowed:   "methodNotAllowed",
+	AssertionResponseTypesConflict:           "conflict",
+	AssertionResponseTypesGone:               "gone",
+	AssertionResponseTypesPreconditionfailed: "preconditionFailed",
+	AssertionResponseTypesUnprocessable:      "unprocessable",
+}
+
+// Code returns the code value.
+func (c AssertionResponseTypes) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the AssertionResponseTypes values.
+func (c AssertionResponseTypes) IsValid() bool {
+	_, ok := assertionResponseTypesDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c AssertionResponseTypes) Display() string {
+	if display := assertionResponseTypesDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseAssertionResponseTypes returns the AssertionResponseTypes for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseAssertionResponseTypes(s string) (AssertionResponseTypes, error) {
+	return parseCode(s, assertionResponseTypesDisplays)
+}
+
 // AuditEventAction represents AuditEventAction.
 type AuditEventAction string
 
@@ -402,6 +1148,40 @@ const (
 	AuditEventActionE AuditEventAction = "E"
 )
 
+// auditEventActionDisplays maps each AuditEventAction code to its display name.
+var auditEventActionDisplays = map[AuditEventAction]string{
+	AuditEventActionC: "Create",
+	AuditEventActionR: "Read/View/Print",
+	AuditEventActionU: "Update",
+	AuditEventActionD: "Delete",
+	AuditEventActionE: "Execute",
+}
+
+// Code returns the code value.
+func (c AuditEventAction) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the AuditEventAction values.
+func (c AuditEventAction) IsValid() bool {
+	_, ok := auditEventActionDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c AuditEventAction) Display() string {
+	if display := auditEventActionDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseAuditEventAction returns the AuditEventAction for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseAuditEventAction(s string) (AuditEventAction, error) {
+	return parseCode(s, auditEventActionDisplays)
+}
+
 // AuditEventOutcome represents AuditEventOutcome.
 type AuditEventOutcome string
 
@@ -417,6 +1197,39 @@ const (
 	AuditEventOutcome12 AuditEventOutcome = "12"
 )
 
+// auditEventOutcomeDisplays maps each AuditEventOutcome code to its display name.
+var auditEventOutcomeDisplays = map[AuditEventOutcome]string{
+	AuditEventOutcome0:  "Success",
+	AuditEventOutcome4:  "Minor failure",
+	AuditEventOutcome8:  "Serious failure",
+	AuditEventOutcome12: "Major failure",
+}
+
+// Code returns the code value.
+func (c AuditEventOutcome) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the AuditEventOutcome values.
+func (c AuditEventOutcome) IsValid() bool {
+	_, ok := auditEventOutcomeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c AuditEventOutcome) Display() string {
+	if display := auditEventOutcomeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseAuditEventOutcome returns the AuditEventOutcome for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseAuditEventOutcome(s string) (AuditEventOutcome, error) {
+	return parseCode(s, auditEventOutcomeDisplays)
+}
+
 // BindingStrength represents BindingStrength.
 type BindingStrength string
 
@@ -432,6 +1245,39 @@ const (
 	BindingStrengthExample BindingStrength = "example"
 )
 
+// bindingStrengthDisplays maps each BindingStrength code to its display name.
+var bindingStrengthDisplays = map[BindingStrength]string{
+	BindingStrengthRequired:   "Required",
+	BindingStrengthExtensible: "Extensible",
+	BindingStrengthPreferred:  "Preferred",
+	BindingStrengthExample:    "Example",
+}
+
+// Code returns the code value.
+func (c BindingStrength) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the BindingStrength values.
+func (c BindingStrength) IsValid() bool {
+	_, ok := bindingStrengthDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c BindingStrength) Display() string {
+	if display := bindingStrengthDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseBindingStrength returns the BindingStrength for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseBindingStrength(s string) (BindingStrength, error) {
+	return parseCode(s, bindingStrengthDisplays)
+}
+
 // BundleType represents BundleType.
 type BundleType string
 
@@ -457,6 +1303,44 @@ const (
 	BundleTypeCollection BundleType = "collection"
 )
 
+// bundleTypeDisplays maps each BundleType code to its display name.
+var bundleTypeDisplays = map[BundleType]string{
+	BundleTypeDocument:            "Document",
+	BundleTypeMessage:             "Message",
+	BundleTypeTransaction:         "Transaction",
+	BundleTypeTransactionResponse: "Transaction Response",
+	BundleTypeBatch:               "Batch",
+	BundleTypeBatchResponse:       "Batch Response",
+	BundleTypeHistory:             "History List",
+	BundleTypeSearchset:           "Search Results",
+	BundleTypeCollection:          "Collection",
+}
+
+// Code returns the code value.
+func (c BundleType) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the BundleType values.
+func (c BundleType) IsValid() bool {
+	_, ok := bundleTypeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c BundleType) Display() string {
+	if display := bundleTypeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseBundleType returns the BundleType for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseBundleType(s string) (BundleType, error) {
+	return parseCode(s, bundleTypeDisplays)
+}
+
 // CapabilityStatementKind represents CapabilityStatementKind.
 type CapabilityStatementKind string
 
@@ -470,6 +1354,38 @@ const (
 	CapabilityStatementKindRequirements CapabilityStatementKind = "requirements"
 )
 
+// capabilityStatementKindDisplays maps each CapabilityStatementKind code to its display name.
+var capabilityStatementKindDisplays = map[CapabilityStatementKind]string{
+	CapabilityStatementKindInstance:     "Instance",
+	CapabilityStatementKindCapability:   "Capability",
+	CapabilityStatementKindRequirements: "Requirements",
+}
+
+// Code returns the code value.
+func (c CapabilityStatementKind) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the CapabilityStatementKind values.
+func (c CapabilityStatementKind) IsValid() bool {
+	_, ok := capabilityStatementKindDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c CapabilityStatementKind) Display() string {
+	if display := capabilityStatementKindDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseCapabilityStatementKind returns the CapabilityStatementKind for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseCapabilityStatementKind(s string) (CapabilityStatementKind, error) {
+	return parseCode(s, capabilityStatementKindDisplays)
+}
+
 // CarePlanActivityKind represents Care Plan Activity Kind.
 type CarePlanActivityKind string
 
@@ -485,6 +1401,43 @@ const (
 	CarePlanActivityKindVisionprescription   CarePlanActivityKind = "VisionPrescription"
 )
 
+// carePlanActivityKindDisplays maps each CarePlanActivityKind code to its display name.
+var carePlanActivityKindDisplays = map[CarePlanActivityKind]string{
+	CarePlanActivityKindAppointment:          "",
+	CarePlanActivityKindCommunicationrequest: "",
+	CarePlanActivityKindDevicerequest:        "",
+	CarePlanActivityKindMedicationrequest:    "",
+	CarePlanActivityKindNutritionorder:       "",
+	CarePlanActivityKindTask:                 "",
+	CarePlanActivityKindServicerequest:       "",
+	CarePlanActivityKindVisionprescription:   "",
+}
+
+// Code returns the code value.
+func (c CarePlanActivityKind) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the CarePlanActivityKind values.
+func (c CarePlanActivityKind) IsValid() bool {
+	_, ok := carePlanActivityKindDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c CarePlanActivityKind) Display() string {
+	if display := carePlanActivityKindDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseCarePlanActivityKind returns the CarePlanActivityKind for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseCarePlanActivityKind(s string) (CarePlanActivityKind, error) {
+	return parseCode(s, carePlanActivityKindDisplays)
+}
+
 // CarePlanActivityStatus represents CarePlanActivityStatus.
 type CarePlanActivityStatus string
 
@@ -510,6 +1463,44 @@ const (
 	CarePlanActivityStatusEnteredInError CarePlanActivityStatus = "entered-in-error"
 )
 
+// carePlanActivityStatusDisplays maps each CarePlanActivityStatus code to its display name.
+var carePlanActivityStatusDisplays = map[CarePlanActivityStatus]string{
+	CarePlanActivityStatusNotStarted:     "Not Started",
+	CarePlanActivityStatusScheduled:      "Scheduled",
+	CarePlanActivityStatusInProgress:     "In Progress",
+	CarePlanActivityStatusOnHold:         "On Hold",
+	CarePlanActivityStatusCompleted:      "Completed",
+	CarePlanActivityStatusCancelled:      "Cancelled",
+	CarePlanActivityStatusStopped:        "Stopped",
+	CarePlanActivityStatusUnknown:        "Unknown",
+	CarePlanActivityStatusEnteredInError: "Entered in Error",
+}
+
+// Code returns the code value.
+func (c CarePlanActivityStatus) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the CarePlanActivityStatus values.
+func (c CarePlanActivityStatus) IsValid() bool {
+	_, ok := carePlanActivityStatusDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c CarePlanActivityStatus) Display() string {
+	if display := carePlanActivityStatusDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseCarePlanActivityStatus returns the CarePlanActivityStatus for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseCarePlanActivityStatus(s string) (CarePlanActivityStatus, error) {
+	return parseCode(s, carePlanActivityStatusDisplays)
+}
+
 // CarePlanIntent represents Care Plan Intent.
 type CarePlanIntent string
 
@@ -521,6 +1512,39 @@ const (
 	CarePlanIntentOption   CarePlanIntent = "option"
 )
 
+// carePlanIntentDisplays maps each CarePlanIntent code to its display name.
+var carePlanIntentDisplays = map[CarePlanIntent]string{
+	CarePlanIntentProposal: "",
+	CarePlanIntentPlan:     "",
+	CarePlanIntentOrder:    "",
+	CarePlanIntentOption:   "",
+}
+
+// Code returns the code value.
+func (c CarePlanIntent) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the CarePlanIntent values.
+func (c CarePlanIntent) IsValid() bool {
+	_, ok := carePlanIntentDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c CarePlanIntent) Display() string {
+	if display := carePlanIntentDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseCarePlanIntent returns the CarePlanIntent for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseCarePlanIntent(s string) (CarePlanIntent, error) {
+	return parseCode(s, carePlanIntentDisplays)
+}
+
 // CareTeamStatus represents CareTeamStatus.
 type CareTeamStatus string
 
@@ -538,6 +1562,40 @@ const (
 	CareTeamStatusEnteredInError CareTeamStatus = "entered-in-error"
 )
 
+// careTeamStatusDisplays maps each CareTeamStatus code to its display name.
+var careTeamStatusDisplays = map[CareTeamStatus]string{
+	CareTeamStatusProposed:       "Proposed",
+	CareTeamStatusActive:         "Active",
+	CareTeamStatusSuspended:      "Suspended",
+	CareTeamStatusInactive:       "Inactive",
+	CareTeamStatusEnteredInError: "Entered in Error",
+}
+
+// Code returns the code value.
+func (c CareTeamStatus) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the CareTeamStatus values.
+func (c CareTeamStatus) IsValid() bool {
+	_, ok := careTeamStatusDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c CareTeamStatus) Display() string {
+	if display := careTeamStatusDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseCareTeamStatus returns the CareTeamStatus for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseCareTeamStatus(s string) (CareTeamStatus, error) {
+	return parseCode(s, careTeamStatusDisplays)
+}
+
 // ChargeItemStatus represents ChargeItemStatus.
 type ChargeItemStatus string
 
@@ -559,6 +1617,42 @@ const (
 	ChargeItemStatusUnknown ChargeItemStatus = "unknown"
 )
 
+// chargeItemStatusDisplays maps each ChargeItemStatus code to its display name.
+var chargeItemStatusDisplays = map[ChargeItemStatus]string{
+	ChargeItemStatusPlanned:        "Planned",
+	ChargeItemStatusBillable:       "Billable",
+	ChargeItemStatusNotBillable:    "Not billable",
+	ChargeItemStatusAborted:        "Aborted",
+	ChargeItemStatusBilled:         "Billed",
+	ChargeItemStatusEnteredInError: "Entered in Error",
+	ChargeItemStatusUnknown:        "Unknown",
+}
+
+// Code returns the code value.
+func (c ChargeItemStatus) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the ChargeItemStatus values.
+func (c ChargeItemStatus) IsValid() bool {
+	_, ok := chargeItemStatusDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c ChargeItemStatus) Display() string {
+	if display := chargeItemStatusDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseChargeItemStatus returns the ChargeItemStatus for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseChargeItemStatus(s string) (ChargeItemStatus, error) {
+	return parseCode(s, chargeItemStatusDisplays)
+}
+
 // Use represents Use.
 type Use string
 
@@ -572,6 +1666,38 @@ const (
 	UsePredetermination Use = "predetermination"
 )
 
+// useDisplays maps each Use code to its display name.
+var useDisplays = map[Use]string{
+	UseClaim:            "Claim",
+	UsePreauthorization: "Preauthorization",
+	UsePredetermination: "Predetermination",
+}
+
+// Code returns the code value.
+func (c Use) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the Use values.
+func (c Use) IsValid() bool {
+	_, ok := useDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c Use) Display() string {
+	if display := useDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseUse returns the Use for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseUse(s string) (Use, error) {
+	return parseCode(s, useDisplays)
+}
+
 // ClinicalImpressionStatus represents Clinical Impression Status.
 type ClinicalImpressionStatus string
 
@@ -582,6 +1708,38 @@ const (
 	ClinicalImpressionStatusEnteredInError ClinicalImpressionStatus = "entered-in-error"
 )
 
+// clinicalImpressionStatusDisplays maps each ClinicalImpressionStatus code to its display name.
+var clinicalImpressionStatusDisplays = map[ClinicalImpressionStatus]string{
+	ClinicalImpressionStatusInProgress:     "",
+	ClinicalImpressionStatusCompleted:      "",
+	ClinicalImpressionStatusEnteredInError: "",
+}
+
+// Code returns the code value.
+func (c ClinicalImpressionStatus) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the ClinicalImpressionStatus values.
+func (c ClinicalImpressionStatus) IsValid() bool {
+	_, ok := clinicalImpressionStatusDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c ClinicalImpressionStatus) Display() string {
+	if display := clinicalImpressionStatusDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseClinicalImpressionStatus returns the ClinicalImpressionStatus for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseClinicalImpressionStatus(s string) (ClinicalImpressionStatus, error) {
+	return parseCode(s, clinicalImpressionStatusDisplays)
+}
+
 // CodeSearchSupport represents CodeSearchSupport.
 type CodeSearchSupport string
 
@@ -593,6 +1751,37 @@ const (
 	CodeSearchSupportAll CodeSearchSupport = "all"
 )
 
+// codeSearchSupportDisplays maps each CodeSearchSupport code to its display name.
+var codeSearchSupportDisplays = map[CodeSearchSupport]string{
+	CodeSearchSupportExplicit: "Explicit Codes",
+	CodeSearchSupportAll:      "Implicit Codes",
+}
+
+// Code returns the code value.
+func (c CodeSearchSupport) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the CodeSearchSupport values.
+func (c CodeSearchSupport) IsValid() bool {
+	_, ok := codeSearchSupportDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c CodeSearchSupport) Display() string {
+	if display := codeSearchSupportDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseCodeSearchSupport returns the CodeSearchSupport for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseCodeSearchSupport(s string) (CodeSearchSupport, error) {
+	return parseCode(s, codeSearchSupportDisplays)
+}
+
 // CodeSystemContentMode represents CodeSystemContentMode.
 type CodeSystemContentMode string
 
@@ -610,6 +1799,40 @@ const (
 	CodeSystemContentModeSupplement CodeSystemContentMode = "supplement"
 )
 
+// codeSystemContentModeDisplays maps each CodeSystemContentMode code to its display name.
+var codeSystemContentModeDisplays = map[CodeSystemContentMode]string{
+	CodeSystemContentModeNotPresent: "Not Present",
+	CodeSystemContentModeExample:    "Example",
+	CodeSystemContentModeFragment:   "Fragment",
+	CodeSystemContentModeComplete:   "Complete",
+	CodeSystemContentModeSupplement: "Supplement",
+}
+
+// Code returns the code value.
+func (c CodeSystemContentMode) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the CodeSystemContentMode values.
+func (c CodeSystemContentMode) IsValid() bool {
+	_, ok := codeSystemContentModeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c CodeSystemContentMode) Display() string {
+	if display := codeSystemContentModeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseCodeSystemContentMode returns the CodeSystemContentMode for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseCodeSystemContentMode(s string) (CodeSystemContentMode, error) {
+	return parseCode(s, codeSystemContentModeDisplays)
+}
+
 // CodeSystemHierarchyMeaning represents CodeSystemHierarchyMeaning.
 type CodeSystemHierarchyMeaning string
 
@@ -625,6 +1848,39 @@ const (
 	CodeSystemHierarchyMeaningClassifiedWith CodeSystemHierarchyMeaning = "classified-with"
 )
 
+// codeSystemHierarchyMeaningDisplays maps each CodeSystemHierarchyMeaning code to its display name.
+var codeSystemHierarchyMeaningDisplays = map[CodeSystemHierarchyMeaning]string{
+	CodeSystemHierarchyMeaningGroupedBy:      "Grouped By",
+	CodeSystemHierarchyMeaningIsA:            "Is-A",
+	CodeSystemHierarchyMeaningPartOf:         "Part Of",
+	CodeSystemHierarchyMeaningClassifiedWith: "Classified With",
+}
+
+// Code returns the code value.
+func (c CodeSystemHierarchyMeaning) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the CodeSystemHierarchyMeaning values.
+func (c CodeSystemHierarchyMeaning) IsValid() bool {
+	_, ok := codeSystemHierarchyMeaningDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c CodeSystemHierarchyMeaning) Display() string {
+	if display := codeSystemHierarchyMeaningDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseCodeSystemHierarchyMeaning returns the CodeSystemHierarchyMeaning for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseCodeSystemHierarchyMeaning(s string) (CodeSystemHierarchyMeaning, error) {
+	return parseCode(s, codeSystemHierarchyMeaningDisplays)
+}
+
 // CompartmentType represents CompartmentType.
 type CompartmentType string
 
@@ -642,6 +1898,40 @@ const (
 	CompartmentTypeDevice CompartmentType = "Device"
 )
 
+// compartmentTypeDisplays maps each CompartmentType code to its display name.
+var compartmentTypeDisplays = map[CompartmentType]string{
+	CompartmentTypePatient:       "Patient",
+	CompartmentTypeEncounter:     "Encounter",
+	CompartmentTypeRelatedperson: "RelatedPerson",
+	CompartmentTypePractitioner:  "Practitioner",
+	CompartmentTypeDevice:        "Device",
+}
+
+// Code returns the code value.
+func (c CompartmentType) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the CompartmentType values.
+func (c CompartmentType) IsValid() bool {
+	_, ok := compartmentTypeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c CompartmentType) Display() string {
+	if display := compartmentTypeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseCompartmentType returns the CompartmentType for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseCompartmentType(s string) (CompartmentType, error) {
+	return parseCode(s, compartmentTypeDisplays)
+}
+
 // CompositionAttestationMode represents CompositionAttestationMode.
 type CompositionAttestationMode string
 
@@ -657,6 +1947,39 @@ const (
 	CompositionAttestationModeOfficial CompositionAttestationMode = "official"
 )
 
+// compositionAttestationModeDisplays maps each CompositionAttestationMode code to its display name.
+var compositionAttestationModeDisplays = map[CompositionAttestationMode]string{
+	CompositionAttestationModePersonal:     "Personal",
+	CompositionAttestationModeProfessional: "Professional",
+	CompositionAttestationModeLegal:        "Legal",
+	CompositionAttestationModeOfficial:     "Official",
+}
+
+// Code returns the code value.
+func (c CompositionAttestationMode) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the CompositionAttestationMode values.
+func (c CompositionAttestationMode) IsValid() bool {
+	_, ok := compositionAttestationModeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c CompositionAttestationMode) Display() string {
+	if display := compositionAttestationModeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseCompositionAttestationMode returns the CompositionAttestationMode for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseCompositionAttestationMode(s string) (CompositionAttestationMode, error) {
+	return parseCode(s, compositionAttestationModeDisplays)
+}
+
 // CompositionStatus represents CompositionStatus.
 type CompositionStatus string
 
@@ -672,6 +1995,39 @@ const (
 	CompositionStatusEnteredInError CompositionStatus = "entered-in-error"
 )
 
+// compositionStatusDisplays maps each CompositionStatus code to its display name.
+var compositionStatusDisplays = map[CompositionStatus]string{
+	CompositionStatusPreliminary:    "Preliminary",
+	CompositionStatusFinal:          "Final",
+	CompositionStatusAmended:        "Amended",
+	CompositionStatusEnteredInError: "Entered in Error",
+}
+
+// Code returns the code value.
+func (c CompositionStatus) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the CompositionStatus values.
+func (c CompositionStatus) IsValid() bool {
+	_, ok := compositionStatusDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c CompositionStatus) Display() string {
+	if display := compositionStatusDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseCompositionStatus returns the CompositionStatus for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseCompositionStatus(s string) (CompositionStatus, error) {
+	return parseCode(s, compositionStatusDisplays)
+}
+
 // ConceptMapEquivalence represents ConceptMapEquivalence.
 type ConceptMapEquivalence string
 
@@ -699,6 +2055,45 @@ const (
 	ConceptMapEquivalenceDisjoint ConceptMapEquivalence = "disjoint"
 )
 
+// conceptMapEquivalenceDisplays maps each ConceptMapEquivalence code to its display name.
+var conceptMapEquivalenceDisplays = map[ConceptMapEquivalence]string{
+	ConceptMapEquivalenceRelatedto:   "Related To",
+	ConceptMapEquivalenceEquivalent:  "Equivalent",
+	ConceptMapEquivalenceEqual:       "Equal",
+	ConceptMapEquivalenceWider:       "Wider",
+	ConceptMapEquivalenceSubsumes:    "Subsumes",
+	ConceptMapEquivalenceNarrower:    "Narrower",
+	ConceptMapEquivalenceSpecializes: "Specializes",
+	ConceptMapEquivalenceInexact:     "Inexact",
+	ConceptMapEquivalenceUnmatched:   "Unmatched",
+	ConceptMapEquivalenceDisjoint:    "Disjoint",
+}
+
+// Code returns the code value.
+func (c ConceptMapEquivalence) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the ConceptMapEquivalence values.
+func (c ConceptMapEquivalence) IsValid() bool {
+	_, ok := conceptMapEquivalenceDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c ConceptMapEquivalence) Display() string {
+	if display := conceptMapEquivalenceDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseConceptMapEquivalence returns the ConceptMapEquivalence for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseConceptMapEquivalence(s string) (ConceptMapEquivalence, error) {
+	return parseCode(s, conceptMapEquivalenceDisplays)
+}
+
 // PropertyType represents PropertyType.
 type PropertyType string
 
@@ -720,6 +2115,42 @@ const (
 	PropertyTypeDecimal PropertyType = "decimal"
 )
 
+// propertyTypeDisplays maps each PropertyType code to its display name.
+var propertyTypeDisplays = map[PropertyType]string{
+	PropertyTypeCode:     "code (internal reference)",
+	PropertyTypeCoding:   "Coding (external reference)",
+	PropertyTypeString:   "string",
+	PropertyTypeInteger:  "integer",
+	PropertyTypeBoolean:  "boolean",
+	PropertyTypeDatetime: "dateTime",
+	PropertyTypeDecimal:  "decimal",
+}
+
+// Code returns the code value.
+func (c PropertyType) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the PropertyType values.
+func (c PropertyType) IsValid() bool {
+	_, ok := propertyTypeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c PropertyType) Display() string {
+	if display := propertyTypeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParsePropertyType returns the PropertyType for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParsePropertyType(s string) (PropertyType, error) {
+	return parseCode(s, propertyTypeDisplays)
+}
+
 // ConceptMapGroupUnmappedMode represents ConceptMapGroupUnmappedMode.
 type ConceptMapGroupUnmappedMode string
 
@@ -733,6 +2164,38 @@ const (
 	ConceptMapGroupUnmappedModeOtherMap ConceptMapGroupUnmappedMode = "other-map"
 )
 
+// conceptMapGroupUnmappedModeDisplays maps each ConceptMapGroupUnmappedMode code to its display name.
+var conceptMapGroupUnmappedModeDisplays = map[ConceptMapGroupUnmappedMode]string{
+	ConceptMapGroupUnmappedModeProvided: "Provided Code",
+	ConceptMapGroupUnmappedModeFixed:    "Fixed Code",
+	ConceptMapGroupUnmappedModeOtherMap: "Other Map",
+}
+
+// Code returns the code value.
+func (c ConceptMapGroupUnmappedMode) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the ConceptMapGroupUnmappedMode values.
+func (c ConceptMapGroupUnmappedMode) IsValid() bool {
+	_, ok := conceptMapGroupUnmappedModeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c ConceptMapGroupUnmappedMode) Display() string {
+	if display := conceptMapGroupUnmappedModeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseConceptMapGroupUnmappedMode returns the ConceptMapGroupUnmappedMode for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseConceptMapGroupUnmappedMode(s string) (ConceptMapGroupUnmappedMode, error) {
+	return parseCode(s, conceptMapGroupUnmappedModeDisplays)
+}
+
 // ConditionalDeleteStatus represents ConditionalDeleteStatus.
 type ConditionalDeleteStatus string
 
@@ -746,6 +2209,38 @@ const (
 	ConditionalDeleteStatusMultiple ConditionalDeleteStatus = "multiple"
 )
 
+// conditionalDeleteStatusDisplays maps each ConditionalDeleteStatus code to its display name.
+var conditionalDeleteStatusDisplays = map[ConditionalDeleteStatus]string{
+	ConditionalDeleteStatusNotSupported: "Not Supported",
+	ConditionalDeleteStatusSingle:       "Single Deletes Supported",
+	ConditionalDeleteStatusMultiple:     "Multiple Deletes Supported",
+}
+
+// Code returns the code value.
+func (c ConditionalDeleteStatus) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the ConditionalDeleteStatus values.
+func (c ConditionalDeleteStatus) IsValid() bool {
+	_, ok := conditionalDeleteStatusDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c ConditionalDeleteStatus) Display() string {
+	if display := conditionalDeleteStatusDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseConditionalDeleteStatus returns the ConditionalDeleteStatus for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseConditionalDeleteStatus(s string) (ConditionalDeleteStatus, error) {
+	return parseCode(s, conditionalDeleteStatusDisplays)
+}
+
 // ConditionalReadStatus represents ConditionalReadStatus.
 type ConditionalReadStatus string
 
@@ -761,6 +2256,39 @@ const (
 	ConditionalReadStatusFullSupport ConditionalReadStatus = "full-support"
 )
 
+// conditionalReadStatusDisplays maps each ConditionalReadStatus code to its display name.
+var conditionalReadStatusDisplays = map[ConditionalReadStatus]string{
+	ConditionalReadStatusNotSupported:  "Not Supported",
+	ConditionalReadStatusModifiedSince: "If-Modified-Since",
+	ConditionalReadStatusNotMatch:      "If-None-Match",
+	ConditionalReadStatusFullSupport:   "Full Support",
+}
+
+// Code returns the code value.
+func (c ConditionalReadStatus) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the ConditionalReadStatus values.
+func (c ConditionalReadStatus) IsValid() bool {
+	_, ok := conditionalReadStatusDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c ConditionalReadStatus) Display() string {
+	if display := conditionalReadStatusDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseConditionalReadStatus returns the ConditionalReadStatus for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseConditionalReadStatus(s string) (ConditionalReadStatus, error) {
+	return parseCode(s, conditionalReadStatusDisplays)
+}
+
 // ConsentDataMeaning represents ConsentDataMeaning.
 type ConsentDataMeaning string
 
@@ -776,6 +2304,39 @@ const (
 	ConsentDataMeaningAuthoredby ConsentDataMeaning = "authoredby"
 )
 
+// consentDataMeaningDisplays maps each ConsentDataMeaning code to its display name.
+var consentDataMeaningDisplays = map[ConsentDataMeaning]string{
+	ConsentDataMeaningInstance:   "Instance",
+	ConsentDataMeaningRelated:    "Related",
+	ConsentDataMeaningDependents: "Dependents",
+	ConsentDataMeaningAuthoredby: "AuthoredBy",
+}
+
+// Code returns the code value.
+func (c ConsentDataMeaning) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the ConsentDataMeaning values.
+func (c ConsentDataMeaning) IsValid() bool {
+	_, ok := consentDataMeaningDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c ConsentDataMeaning) Display() string {
+	if display := consentDataMeaningDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseConsentDataMeaning returns the ConsentDataMeaning for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseConsentDataMeaning(s string) (ConsentDataMeaning, error) {
+	return parseCode(s, consentDataMeaningDisplays)
+}
+
 // ConsentProvisionType represents ConsentProvisionType.
 type ConsentProvisionType string
 
@@ -787,6 +2348,37 @@ const (
 	ConsentProvisionTypePermit ConsentProvisionType = "permit"
 )
 
+// consentProvisionTypeDisplays maps each ConsentProvisionType code to its display name.
+var consentProvisionTypeDisplays = map[ConsentProvisionType]string{
+	ConsentProvisionTypeDeny:   "Opt Out",
+	ConsentProvisionTypePermit: "Opt In",
+}
+
+// Code returns the code value.
+func (c ConsentProvisionType) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the ConsentProvisionType values.
+func (c ConsentProvisionType) IsValid() bool {
+	_, ok := consentProvisionTypeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c ConsentProvisionType) Display() string {
+	if display := consentProvisionTypeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseConsentProvisionType returns the ConsentProvisionType for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseConsentProvisionType(s string) (ConsentProvisionType, error) {
+	return parseCode(s, consentProvisionTypeDisplays)
+}
+
 // ConsentState represents ConsentState.
 type ConsentState string
 
@@ -806,6 +2398,41 @@ const (
 	ConsentStateEnteredInError ConsentState = "entered-in-error"
 )
 
+// consentStateDisplays maps each ConsentState code to its display name.
+var consentStateDisplays = map[ConsentState]string{
+	ConsentStateDraft:          "Pending",
+	ConsentStateProposed:       "Proposed",
+	ConsentStateActive:         "Active",
+	ConsentStateRejected:       "Rejected",
+	ConsentStateInactive:       "Inactive",
+	ConsentStateEnteredInError: "Entered in Error",
+}
+
+// Code returns the code value.
+func (c ConsentState) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the ConsentState values.
+func (c ConsentState) IsValid() bool {
+	_, ok := consentStateDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c ConsentState) Display() string {
+	if display := consentStateDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseConsentState returns the ConsentState for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseConsentState(s string) (ConsentState, error) {
+	return parseCode(s, consentStateDisplays)
+}
+
 // ConstraintSeverity represents ConstraintSeverity.
 type ConstraintSeverity string
 
@@ -817,6 +2444,37 @@ const (
 	ConstraintSeverityWarning ConstraintSeverity = "warning"
 )
 
+// constraintSeverityDisplays maps each ConstraintSeverity code to its display name.
+var constraintSeverityDisplays = map[ConstraintSeverity]string{
+	ConstraintSeverityError:   "Error",
+	ConstraintSeverityWarning: "Warning",
+}
+
+// Code returns the code value.
+func (c ConstraintSeverity) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the ConstraintSeverity values.
+func (c ConstraintSeverity) IsValid() bool {
+	_, ok := constraintSeverityDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c ConstraintSeverity) Display() string {
+	if display := constraintSeverityDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseConstraintSeverity returns the ConstraintSeverity for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseConstraintSeverity(s string) (ConstraintSeverity, error) {
+	return parseCode(s, constraintSeverityDisplays)
+}
+
 // ContactPointSystem represents ContactPointSystem.
 type ContactPointSystem string
 
@@ -838,6 +2496,42 @@ const (
 	ContactPointSystemOther ContactPointSystem = "other"
 )
 
+// contactPointSystemDisplays maps each ContactPointSystem code to its display name.
+var contactPointSystemDisplays = map[ContactPointSystem]string{
+	ContactPointSystemPhone: "Phone",
+	ContactPointSystemFax:   "Fax",
+	ContactPointSystemEmail: "Email",
+	ContactPointSystemPager: "Pager",
+	ContactPointSystemUrl:   "URL",
+	ContactPointSystemSms:   "SMS",
+	ContactPointSystemOther: "Other",
+}
+
+// Code returns the code value.
+func (c ContactPointSystem) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the ContactPointSystem values.
+func (c ContactPointSystem) IsValid() bool {
+	_, ok := contactPointSystemDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c ContactPointSystem) Display() string {
+	if display := contactPointSystemDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseContactPointSystem returns the ContactPointSystem for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseContactPointSystem(s string) (ContactPointSystem, error) {
+	return parseCode(s, contactPointSystemDisplays)
+}
+
 // ContactPointUse represents ContactPointUse.
 type ContactPointUse string
 
@@ -855,6 +2549,40 @@ const (
 	ContactPointUseMobile ContactPointUse = "mobile"
 )
 
+// contactPointUseDisplays maps each ContactPointUse code to its display name.
+var contactPointUseDisplays = map[ContactPointUse]string{
+	ContactPointUseHome:   "Home",
+	ContactPointUseWork:   "Work",
+	ContactPointUseTemp:   "Temp",
+	ContactPointUseOld:    "Old",
+	ContactPointUseMobile: "Mobile",
+}
+
+// Code returns the code value.
+func (c ContactPointUse) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the ContactPointUse values.
+func (c ContactPointUse) IsValid() bool {
+	_, ok := contactPointUseDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c ContactPointUse) Display() string {
+	if display := contactPointUseDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseContactPointUse returns the ContactPointUse for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseContactPointUse(s string) (ContactPointUse, error) {
+	return parseCode(s, contactPointUseDisplays)
+}
+
 // ContractResourcePublicationStatusCodes represents Contract Resource Publication Status codes.
 type ContractResourcePublicationStatusCodes string
 
@@ -892,6 +2620,50 @@ const (
 	ContractResourcePublicationStatusCodesTerminated ContractResourcePublicationStatusCodes = "terminated"
 )
 
+// contractResourcePublicationStatusCodesDisplays maps each ContractResourcePublicationStatusCodes code to its display name.
+var contractResourcePublicationStatusCodesDisplays = map[ContractResourcePublicationStatusCodes]string{
+	ContractResourcePublicationStatusCodesAmended:        "Amended",
+	ContractResourcePublicationStatusCodesAppended:       "Appended",
+	ContractResourcePublicationStatusCodesCancelled:      "Cancelled",
+	ContractResourcePublicationStatusCodesDisputed:       "Disputed",
+	ContractResourcePublicationStatusCodesEnteredInError: "Entered in Error",
+	ContractResourcePublicationStatusCodesExecutable:     "Executable",
+	ContractResourcePublicationStatusCodesExecuted:       "Executed",
+	ContractResourcePublicationStatusCodesNegotiable:     "Negotiable",
+	ContractResourcePublicationStatusCodesOffered:        "Offered",
+	ContractResourcePublicationStatusCodesPolicy:         "Policy",
+	ContractResourcePublicationStatusCodesRejected:       "Rejected",
+	ContractResourcePublicationStatusCodesRenewed:        "Renewed",
+	ContractResourcePublicationStatusCodesRevoked:        "Revoked",
+	ContractResourcePublicationStatusCodesResolved:       "Resolved",
+	ContractResourcePublicationStatusCodesTerminated:     "Terminated",
+}
+
+// Code returns the code value.
+func (c ContractResourcePublicationStatusCodes) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the ContractResourcePublicationStatusCodes values.
+func (c ContractResourcePublicationStatusCodes) IsValid() bool {
+	_, ok := contractResourcePublicationStatusCodesDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c ContractResourcePublicationStatusCodes) Display() string {
+	if display := contractResourcePublicationStatusCodesDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseContractResourcePublicationStatusCodes returns the ContractResourcePublicationStatusCodes for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseContractResourcePublicationStatusCodes(s string) (ContractResourcePublicationStatusCodes, error) {
+	return parseCode(s, contractResourcePublicationStatusCodesDisplays)
+}
+
 // ContractResourceStatusCodes represents Contract Resource Status Codes.
 type ContractResourceStatusCodes string
 
@@ -929,6 +2701,50 @@ const (
 	ContractResourceStatusCodesTerminated ContractResourceStatusCodes = "terminated"
 )
 
+// contractResourceStatusCodesDisplays maps each ContractResourceStatusCodes code to its display name.
+var contractResourceStatusCodesDisplays = map[ContractResourceStatusCodes]string{
+	ContractResourceStatusCodesAmended:        "Amended",
+	ContractResourceStatusCodesAppended:       "Appended",
+	ContractResourceStatusCodesCancelled:      "Cancelled",
+	ContractResourceStatusCodesDisputed:       "Disputed",
+	ContractResourceStatusCodesEnteredInError: "Entered in Error",
+	ContractResourceStatusCodesExecutable:     "Executable",
+	ContractResourceStatusCodesExecuted:       "Executed",
+	ContractResourceStatusCodesNegotiable:     "Negotiable",
+	ContractResourceStatusCodesOffered:        "Offered",
+	ContractResourceStatusCodesPolicy:         "Policy",
+	ContractResourceStatusCodesRejected:       "Rejected",
+	ContractResourceStatusCodesRenewed:        "Renewed",
+	ContractResourceStatusCodesRevoked:        "Revoked",
+	ContractResourceStatusCodesResolved:       "Resolved",
+	ContractResourceStatusCodesTerminated:     "Terminated",
+}
+
+// Code returns the code value.
+func (c ContractResourceStatusCodes) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the ContractResourceStatusCodes values.
+func (c ContractResourceStatusCodes) IsValid() bool {
+	_, ok := contractResourceStatusCodesDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c ContractResourceStatusCodes) Display() string {
+	if display := contractResourceStatusCodesDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseContractResourceStatusCodes returns the ContractResourceStatusCodes for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseContractResourceStatusCodes(s string) (ContractResourceStatusCodes, error) {
+	return parseCode(s, contractResourceStatusCodesDisplays)
+}
+
 // ContributorType represents ContributorType.
 type ContributorType string
 
@@ -944,6 +2760,39 @@ const (
 	ContributorTypeEndorser ContributorType = "endorser"
 )
 
+// contributorTypeDisplays maps each ContributorType code to its display name.
+var contributorTypeDisplays = map[ContributorType]string{
+	ContributorTypeAuthor:   "Author",
+	ContributorTypeEditor:   "Editor",
+	ContributorTypeReviewer: "Reviewer",
+	ContributorTypeEndorser: "Endorser",
+}
+
+// Code returns the code value.
+func (c ContributorType) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the ContributorType values.
+func (c ContributorType) IsValid() bool {
+	_, ok := contributorTypeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c ContributorType) Display() string {
+	if display := contributorTypeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseContributorType returns the ContributorType for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseContributorType(s string) (ContributorType, error) {
+	return parseCode(s, contributorTypeDisplays)
+}
+
 // DaysOfWeek represents DaysOfWeek.
 type DaysOfWeek string
 
@@ -965,6 +2814,42 @@ const (
 	DaysOfWeekSun DaysOfWeek = "sun"
 )
 
+// daysOfWeekDisplays maps each DaysOfWeek code to its display name.
+var daysOfWeekDisplays = map[DaysOfWeek]string{
+	DaysOfWeekMon: "Monday",
+	DaysOfWeekTue: "Tuesday",
+	DaysOfWeekWed: "Wednesday",
+	DaysOfWeekThu: "Thursday",
+	DaysOfWeekFri: "Friday",
+	DaysOfWeekSat: "Saturday",
+	DaysOfWeekSun: "Sunday",
+}
+
+// Code returns the code value.
+func (c DaysOfWeek) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the DaysOfWeek values.
+func (c DaysOfWeek) IsValid() bool {
+	_, ok := daysOfWeekDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c DaysOfWeek) Display() string {
+	if display := daysOfWeekDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseDaysOfWeek returns the DaysOfWeek for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseDaysOfWeek(s string) (DaysOfWeek, error) {
+	return parseCode(s, daysOfWeekDisplays)
+}
+
 // DetectedIssueSeverity represents DetectedIssueSeverity.
 type DetectedIssueSeverity string
 
@@ -978,6 +2863,38 @@ const (
 	DetectedIssueSeverityLow DetectedIssueSeverity = "low"
 )
 
+// detectedIssueSeverityDisplays maps each DetectedIssueSeverity code to its display name.
+var detectedIssueSeverityDisplays = map[DetectedIssueSeverity]string{
+	DetectedIssueSeverityHigh:     "High",
+	DetectedIssueSeverityModerate: "Moderate",
+	DetectedIssueSeverityLow:      "Low",
+}
+
+// Code returns the code value.
+func (c DetectedIssueSeverity) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the DetectedIssueSeverity values.
+func (c DetectedIssueSeverity) IsValid() bool {
+	_, ok := detectedIssueSeverityDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c DetectedIssueSeverity) Display() string {
+	if display := detectedIssueSeverityDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseDetectedIssueSeverity returns the DetectedIssueSeverity for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseDetectedIssueSeverity(s string) (DetectedIssueSeverity, error) {
+	return parseCode(s, detectedIssueSeverityDisplays)
+}
+
 // DeviceNameType represents DeviceNameType.
 type DeviceNameType string
 
@@ -997,6 +2914,41 @@ const (
 	DeviceNameTypeOther DeviceNameType = "other"
 )
 
+// deviceNameTypeDisplays maps each DeviceNameType code to its display name.
+var deviceNameTypeDisplays = map[DeviceNameType]string{
+	DeviceNameTypeUdiLabelName:        "UDI Label name",
+	DeviceNameTypeUserFriendlyName:    "User Friendly name",
+	DeviceNameTypePatientReportedName: "Patient Reported name",
+	DeviceNameTypeManufacturerName:    "Manufacturer name",
+	DeviceNameTypeModelName:           "Model name",
+	DeviceNameTypeOther:               "other",
+}
+
+// Code returns the code value.
+func (c DeviceNameType) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the DeviceNameType values.
+func (c DeviceNameType) IsValid() bool {
+	_, ok := deviceNameTypeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c DeviceNameType) Display() string {
+	if display := deviceNameTypeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseDeviceNameType returns the DeviceNameType for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseDeviceNameType(s string) (DeviceNameType, error) {
+	return parseCode(s, deviceNameTypeDisplays)
+}
+
 // DeviceUseStatementStatus represents DeviceUseStatementStatus.
 type DeviceUseStatementStatus string
 
@@ -1016,6 +2968,41 @@ const (
 	DeviceUseStatementStatusOnHold DeviceUseStatementStatus = "on-hold"
 )
 
+// deviceUseStatementStatusDisplays maps each DeviceUseStatementStatus code to its display name.
+var deviceUseStatementStatusDisplays = map[DeviceUseStatementStatus]string{
+	DeviceUseStatementStatusActive:         "Active",
+	DeviceUseStatementStatusCompleted:      "Completed",
+	DeviceUseStatementStatusEnteredInError: "Entered in Error",
+	DeviceUseStatementStatusIntended:       "Intended",
+	DeviceUseStatementStatusStopped:        "Stopped",
+	DeviceUseStatementStatusOnHold:         "On Hold",
+}
+
+// Code returns the code value.
+func (c DeviceUseStatementStatus) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the DeviceUseStatementStatus values.
+func (c DeviceUseStatementStatus) IsValid() bool {
+	_, ok := deviceUseStatementStatusDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c DeviceUseStatementStatus) Display() string {
+	if display := deviceUseStatementStatusDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseDeviceUseStatementStatus returns the DeviceUseStatementStatus for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseDeviceUseStatementStatus(s string) (DeviceUseStatementStatus, error) {
+	return parseCode(s, deviceUseStatementStatusDisplays)
+}
+
 // FHIRDeviceStatus represents FHIRDeviceStatus.
 type FHIRDeviceStatus string
 
@@ -1031,6 +3018,39 @@ const (
 	FHIRDeviceStatusUnknown FHIRDeviceStatus = "unknown"
 )
 
+// fHIRDeviceStatusDisplays maps each FHIRDeviceStatus code to its display name.
+var fHIRDeviceStatusDisplays = map[FHIRDeviceStatus]string{
+	FHIRDeviceStatusActive:         "Active",
+	FHIRDeviceStatusInactive:       "Inactive",
+	FHIRDeviceStatusEnteredInError: "Entered in Error",
+	FHIRDeviceStatusUnknown:        "Unknown",
+}
+
+// Code returns the code value.
+func (c FHIRDeviceStatus) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the FHIRDeviceStatus values.
+func (c FHIRDeviceStatus) IsValid() bool {
+	_, ok := fHIRDeviceStatusDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c FHIRDeviceStatus) Display() string {
+	if display := fHIRDeviceStatusDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseFHIRDeviceStatus returns the FHIRDeviceStatus for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseFHIRDeviceStatus(s string) (FHIRDeviceStatus, error) {
+	return parseCode(s, fHIRDeviceStatusDisplays)
+}
+
 // DiagnosticReportStatus represents DiagnosticReportStatus.
 type DiagnosticReportStatus string
 
@@ -1058,6 +3078,45 @@ const (
 	DiagnosticReportStatusUnknown DiagnosticReportStatus = "unknown"
 )
 
+// diagnosticReportStatusDisplays maps each DiagnosticReportStatus code to its display name.
+var diagnosticReportStatusDisplays = map[DiagnosticReportStatus]string{
+	DiagnosticReportStatusRegistered:     "Registered",
+	DiagnosticReportStatusPartial:        "Partial",
+	DiagnosticReportStatusPreliminary:    "Preliminary",
+	DiagnosticReportStatusFinal:          "Final",
+	DiagnosticReportStatusAmended:        "Amended",
+	DiagnosticReportStatusCorrected:      "Corrected",
+	DiagnosticReportStatusAppended:       "Appended",
+	DiagnosticReportStatusCancelled:      "Cancelled",
+	DiagnosticReportStatusEnteredInError: "Entered in Error",
+	DiagnosticReportStatusUnknown:        "Unknown",
+}
+
+// Code returns the code value.
+func (c DiagnosticReportStatus) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the DiagnosticReportStatus values.
+func (c DiagnosticReportStatus) IsValid() bool {
+	_, ok := diagnosticReportStatusDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c DiagnosticReportStatus) Display() string {
+	if display := diagnosticReportStatusDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseDiagnosticReportStatus returns the DiagnosticReportStatus for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseDiagnosticReportStatus(s string) (DiagnosticReportStatus, error) {
+	return parseCode(s, diagnosticReportStatusDisplays)
+}
+
 // DiscriminatorType represents DiscriminatorType.
 type DiscriminatorType string
 
@@ -1075,6 +3134,40 @@ const (
 	DiscriminatorTypeProfile DiscriminatorType = "profile"
 )
 
+// discriminatorTypeDisplays maps each DiscriminatorType code to its display name.
+var discriminatorTypeDisplays = map[DiscriminatorType]string{
+	DiscriminatorTypeValue:   "Value",
+	DiscriminatorTypeExists:  "Exists",
+	DiscriminatorTypePattern: "Pattern",
+	DiscriminatorTypeType:    "Type",
+	DiscriminatorTypeProfile: "Profile",
+}
+
+// Code returns the code value.
+func (c DiscriminatorType) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the DiscriminatorType values.
+func (c DiscriminatorType) IsValid() bool {
+	_, ok := discriminatorTypeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c DiscriminatorType) Display() string {
+	if display := discriminatorTypeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseDiscriminatorType returns the DiscriminatorType for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseDiscriminatorType(s string) (DiscriminatorType, error) {
+	return parseCode(s, discriminatorTypeDisplays)
+}
+
 // DocumentMode represents DocumentMode.
 type DocumentMode string
 
@@ -1086,6 +3179,37 @@ const (
 	DocumentModeConsumer DocumentMode = "consumer"
 )
 
+// documentModeDisplays maps each DocumentMode code to its display name.
+var documentModeDisplays = map[DocumentMode]string{
+	DocumentModeProducer: "Producer",
+	DocumentModeConsumer: "Consumer",
+}
+
+// Code returns the code value.
+func (c DocumentMode) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the DocumentMode values.
+func (c DocumentMode) IsValid() bool {
+	_, ok := documentModeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c DocumentMode) Display() string {
+	if display := documentModeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseDocumentMode returns the DocumentMode for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseDocumentMode(s string) (DocumentMode, error) {
+	return parseCode(s, documentModeDisplays)
+}
+
 // DocumentReferenceStatus represents DocumentReferenceStatus.
 type DocumentReferenceStatus string
 
@@ -1099,6 +3223,38 @@ const (
 	DocumentReferenceStatusEnteredInError DocumentReferenceStatus = "entered-in-error"
 )
 
+// documentReferenceStatusDisplays maps each DocumentReferenceStatus code to its display name.
+var documentReferenceStatusDisplays = map[DocumentReferenceStatus]string{
+	DocumentReferenceStatusCurrent:        "Current",
+	DocumentReferenceStatusSuperseded:     "Superseded",
+	DocumentReferenceStatusEnteredInError: "Entered in Error",
+}
+
+// Code returns the code value.
+func (c DocumentReferenceStatus) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the DocumentReferenceStatus values.
+func (c DocumentReferenceStatus) IsValid() bool {
+	_, ok := documentReferenceStatusDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c DocumentReferenceStatus) Display() string {
+	if display := documentReferenceStatusDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseDocumentReferenceStatus returns the DocumentReferenceStatus for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseDocumentReferenceStatus(s string) (DocumentReferenceStatus, error) {
+	return parseCode(s, documentReferenceStatusDisplays)
+}
+
 // DocumentRelationshipType represents DocumentRelationshipType.
 type DocumentRelationshipType string
 
@@ -1114,6 +3270,39 @@ const (
 	DocumentRelationshipTypeAppends DocumentRelationshipType = "appends"
 )
 
+// documentRelationshipTypeDisplays maps each DocumentRelationshipType code to its display name.
+var documentRelationshipTypeDisplays = map[DocumentRelationshipType]string{
+	DocumentRelationshipTypeReplaces:   "Replaces",
+	DocumentRelationshipTypeTransforms: "Transforms",
+	DocumentRelationshipTypeSigns:      "Signs",
+	DocumentRelationshipTypeAppends:    "Appends",
+}
+
+// Code returns the code value.
+func (c DocumentRelationshipType) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the DocumentRelationshipType values.
+func (c DocumentRelationshipType) IsValid() bool {
+	_, ok := documentRelationshipTypeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c DocumentRelationshipType) Display() string {
+	if display := documentRelationshipTypeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseDocumentRelationshipType returns the DocumentRelationshipType for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseDocumentRelationshipType(s string) (DocumentRelationshipType, error) {
+	return parseCode(s, documentRelationshipTypeDisplays)
+}
+
 // EligibilityRequestPurpose represents EligibilityRequestPurpose.
 type EligibilityRequestPurpose string
 
@@ -1129,6 +3318,39 @@ const (
 	EligibilityRequestPurposeValidation EligibilityRequestPurpose = "validation"
 )
 
+// eligibilityRequestPurposeDisplays maps each EligibilityRequestPurpose code to its display name.
+var eligibilityRequestPurposeDisplays = map[EligibilityRequestPurpose]string{
+	EligibilityRequestPurposeAuthRequirements: "Coverage auth-requirements",
+	EligibilityRequestPurposeBenefits:         "Coverage benefits",
+	EligibilityRequestPurposeDiscovery:        "Coverage Discovery",
+	EligibilityRequestPurposeValidation:       "Coverage Validation",
+}
+
+// Code returns the code value.
+func (c EligibilityRequestPurpose) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the EligibilityRequestPurpose values.
+func (c EligibilityRequestPurpose) IsValid() bool {
+	_, ok := eligibilityRequestPurposeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c EligibilityRequestPurpose) Display() string {
+	if display := eligibilityRequestPurposeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseEligibilityRequestPurpose returns the EligibilityRequestPurpose for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseEligibilityRequestPurpose(s string) (EligibilityRequestPurpose, error) {
+	return parseCode(s, eligibilityRequestPurposeDisplays)
+}
+
 // EligibilityResponsePurpose represents EligibilityResponsePurpose.
 type EligibilityResponsePurpose string
 
@@ -1144,6 +3366,39 @@ const (
 	EligibilityResponsePurposeValidation EligibilityResponsePurpose = "validation"
 )
 
+// eligibilityResponsePurposeDisplays maps each EligibilityResponsePurpose code to its display name.
+var eligibilityResponsePurposeDisplays = map[EligibilityResponsePurpose]string{
+	EligibilityResponsePurposeAuthRequirements: "Coverage auth-requirements",
+	EligibilityResponsePurposeBenefits:         "Coverage benefits",
+	EligibilityResponsePurposeDiscovery:        "Coverage Discovery",
+	EligibilityResponsePurposeValidation:       "Coverage Validation",
+}
+
+// Code returns the code value.
+func (c EligibilityResponsePurpose) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the EligibilityResponsePurpose values.
+func (c EligibilityResponsePurpose) IsValid() bool {
+	_, ok := eligibilityResponsePurposeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c EligibilityResponsePurpose) Display() string {
+	if display := eligibilityResponsePurposeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseEligibilityResponsePurpose returns the EligibilityResponsePurpose for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseEligibilityResponsePurpose(s string) (EligibilityResponsePurpose, error) {
+	return parseCode(s, eligibilityResponsePurposeDisplays)
+}
+
 // EncounterLocationStatus represents EncounterLocationStatus.
 type EncounterLocationStatus string
 
@@ -1159,6 +3414,39 @@ const (
 	EncounterLocationStatusCompleted EncounterLocationStatus = "completed"
 )
 
+// encounterLocationStatusDisplays maps each EncounterLocationStatus code to its display name.
+var encounterLocationStatusDisplays = map[EncounterLocationStatus]string{
+	EncounterLocationStatusPlanned:   "Planned",
+	EncounterLocationStatusActive:    "Active",
+	EncounterLocationStatusReserved:  "Reserved",
+	EncounterLocationStatusCompleted: "Completed",
+}
+
+// Code returns the code value.
+func (c EncounterLocationStatus) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the EncounterLocationStatus values.
+func (c EncounterLocationStatus) IsValid() bool {
+	_, ok := encounterLocationStatusDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c EncounterLocationStatus) Display() string {
+	if display := encounterLocationStatusDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseEncounterLocationStatus returns the EncounterLocationStatus for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseEncounterLocationStatus(s string) (EncounterLocationStatus, error) {
+	return parseCode(s, encounterLocationStatusDisplays)
+}
+
 // EncounterStatus represents EncounterStatus.
 type EncounterStatus string
 
@@ -1184,6 +3472,44 @@ const (
 	EncounterStatusUnknown EncounterStatus = "unknown"
 )
 
+// encounterStatusDisplays maps each EncounterStatus code to its display name.
+var encounterStatusDisplays = map[EncounterStatus]string{
+	EncounterStatusPlanned:        "Planned",
+	EncounterStatusArrived:        "Arrived",
+	EncounterStatusTriaged:        "Triaged",
+	EncounterStatusInProgress:     "In Progress",
+	EncounterStatusOnleave:        "On Leave",
+	EncounterStatusFinished:       "Finished",
+	EncounterStatusCancelled:      "Cancelled",
+	EncounterStatusEnteredInError: "Entered in Error",
+	EncounterStatusUnknown:        "Unknown",
+}
+
+// Code returns the code value.
+func (c EncounterStatus) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the EncounterStatus values.
+func (c EncounterStatus) IsValid() bool {
+	_, ok := encounterStatusDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c EncounterStatus) Display() string {
+	if display := encounterStatusDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseEncounterStatus returns the EncounterStatus for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseEncounterStatus(s string) (EncounterStatus, error) {
+	return parseCode(s, encounterStatusDisplays)
+}
+
 // EndpointStatus represents EndpointStatus.
 type EndpointStatus string
 
@@ -1203,6 +3529,41 @@ const (
 	EndpointStatusTest EndpointStatus = "test"
 )
 
+// endpointStatusDisplays maps each EndpointStatus code to its display name.
+var endpointStatusDisplays = map[EndpointStatus]string{
+	EndpointStatusActive:         "Active",
+	EndpointStatusSuspended:      "Suspended",
+	EndpointStatusError:          "Error",
+	EndpointStatusOff:            "Off",
+	EndpointStatusEnteredInError: "Entered in error",
+	EndpointStatusTest:           "Test",
+}
+
+// Code returns the code value.
+func (c EndpointStatus) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the EndpointStatus values.
+func (c EndpointStatus) IsValid() bool {
+	_, ok := endpointStatusDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c EndpointStatus) Display() string {
+	if display := endpointStatusDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseEndpointStatus returns the EndpointStatus for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseEndpointStatus(s string) (EndpointStatus, error) {
+	return parseCode(s, endpointStatusDisplays)
+}
+
 // EpisodeOfCareStatus represents EpisodeOfCareStatus.
 type EpisodeOfCareStatus string
 
@@ -1224,6 +3585,42 @@ const (
 	EpisodeOfCareStatusEnteredInError EpisodeOfCareStatus = "entered-in-error"
 )
 
+// episodeOfCareStatusDisplays maps each EpisodeOfCareStatus code to its display name.
+var episodeOfCareStatusDisplays = map[EpisodeOfCareStatus]string{
+	EpisodeOfCareStatusPlanned:        "Planned",
+	EpisodeOfCareStatusWaitlist:       "Waitlist",
+	EpisodeOfCareStatusActive:         "Active",
+	EpisodeOfCareStatusOnhold:         "On Hold",
+	EpisodeOfCareStatusFinished:       "Finished",
+	EpisodeOfCareStatusCancelled:      "Cancelled",
+	EpisodeOfCareStatusEnteredInError: "Entered in Error",
+}
+
+// Code returns the code value.
+func (c EpisodeOfCareStatus) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the EpisodeOfCareStatus values.
+func (c EpisodeOfCareStatus) IsValid() bool {
+	_, ok := episodeOfCareStatusDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c EpisodeOfCareStatus) Display() string {
+	if display := episodeOfCareStatusDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseEpisodeOfCareStatus returns the EpisodeOfCareStatus for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseEpisodeOfCareStatus(s string) (EpisodeOfCareStatus, error) {
+	return parseCode(s, episodeOfCareStatusDisplays)
+}
+
 // EventCapabilityMode represents EventCapabilityMode.
 type EventCapabilityMode string
 
@@ -1235,6 +3632,37 @@ const (
 	EventCapabilityModeReceiver EventCapabilityMode = "receiver"
 )
 
+// eventCapabilityModeDisplays maps each EventCapabilityMode code to its display name.
+var eventCapabilityModeDisplays = map[EventCapabilityMode]string{
+	EventCapabilityModeSender:   "Sender",
+	EventCapabilityModeReceiver: "Receiver",
+}
+
+// Code returns the code value.
+func (c EventCapabilityMode) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the EventCapabilityMode values.
+func (c EventCapabilityMode) IsValid() bool {
+	_, ok := eventCapabilityModeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c EventCapabilityMode) Display() string {
+	if display := eventCapabilityModeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseEventCapabilityMode returns the EventCapabilityMode for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseEventCapabilityMode(s string) (EventCapabilityMode, error) {
+	return parseCode(s, eventCapabilityModeDisplays)
+}
+
 // EventStatus represents EventStatus.
 type EventStatus string
 
@@ -1258,6 +3686,43 @@ const (
 	EventStatusUnknown EventStatus = "unknown"
 )
 
+// eventStatusDisplays maps each EventStatus code to its display name.
+var eventStatusDisplays = map[EventStatus]string{
+	EventStatusPreparation:    "Preparation",
+	EventStatusInProgress:     "In Progress",
+	EventStatusNotDone:        "Not Done",
+	EventStatusOnHold:         "On Hold",
+	EventStatusStopped:        "Stopped",
+	EventStatusCompleted:      "Completed",
+	EventStatusEnteredInError: "Entered in Error",
+	EventStatusUnknown:        "Unknown",
+}
+
+// Code returns the code value.
+func (c EventStatus) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the EventStatus values.
+func (c EventStatus) IsValid() bool {
+	_, ok := eventStatusDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c EventStatus) Display() string {
+	if display := eventStatusDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseEventStatus returns the EventStatus for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseEventStatus(s string) (EventStatus, error) {
+	return parseCode(s, eventStatusDisplays)
+}
+
 // EventTiming represents EventTiming.
 type EventTiming string
 
@@ -1303,6 +3768,61 @@ const (
 	EventTimingPcv  EventTiming = "PCV"
 )
 
+// eventTimingDisplays maps each EventTiming code to its display name.
+var eventTimingDisplays = map[EventTiming]string{
+	EventTimingMorn:      "Morning",
+	EventTimingMornEarly: "Early Morning",
+	EventTimingMornLate:  "Late Morning",
+	EventTimingNoon:      "Noon",
+	EventTimingAft:       "Afternoon",
+	EventTimingAftEarly:  "Early Afternoon",
+	EventTimingAftLate:   "Late Afternoon",
+	EventTimingEve:       "Evening",
+	EventTimingEveEarly:  "Early Evening",
+	EventTimingEveLate:   "Late Evening",
+	EventTimingNight:     "Night",
+	EventTimingPhs:       "After Sleep",
+	EventTimingHs:        "",
+	EventTimingWake:      "",
+	EventTimingC:         "",
+	EventTimingCm:        "",
+	EventTimingCd:        "",
+	EventTimingCv:        "",
+	EventTimingAc:        "",
+	EventTimingAcm:       "",
+	EventTimingAcd:       "",
+	EventTimingAcv:       "",
+	EventTimingPc:        "",
+	EventTimingPcm:       "",
+	EventTimingPcd:       "",
+	EventTimingPcv:       "",
+}
+
+// Code returns the code value.
+func (c EventTiming) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the EventTiming values.
+func (c EventTiming) IsValid() bool {
+	_, ok := eventTimingDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c EventTiming) Display() string {
+	if display := eventTimingDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseEventTiming returns the EventTiming for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseEventTiming(s string) (EventTiming, error) {
+	return parseCode(s, eventTimingDisplays)
+}
+
 // ExampleScenarioActorType represents ExampleScenarioActorType.
 type ExampleScenarioActorType string
 
@@ -1314,6 +3834,37 @@ const (
 	ExampleScenarioActorTypeEntity ExampleScenarioActorType = "entity"
 )
 
+// exampleScenarioActorTypeDisplays maps each ExampleScenarioActorType code to its display name.
+var exampleScenarioActorTypeDisplays = map[ExampleScenarioActorType]string{
+	ExampleScenarioActorTypePerson: "Person",
+	ExampleScenarioActorTypeEntity: "System",
+}
+
+// Code returns the code value.
+func (c ExampleScenarioActorType) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the ExampleScenarioActorType values.
+func (c ExampleScenarioActorType) IsValid() bool {
+	_, ok := exampleScenarioActorTypeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c ExampleScenarioActorType) Display() string {
+	if display := exampleScenarioActorTypeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseExampleScenarioActorType returns the ExampleScenarioActorType for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseExampleScenarioActorType(s string) (ExampleScenarioActorType, error) {
+	return parseCode(s, exampleScenarioActorTypeDisplays)
+}
+
 // ExplanationOfBenefitStatus represents ExplanationOfBenefitStatus.
 type ExplanationOfBenefitStatus string
 
@@ -1329,6 +3880,39 @@ const (
 	ExplanationOfBenefitStatusEnteredInError ExplanationOfBenefitStatus = "entered-in-error"
 )
 
+// explanationOfBenefitStatusDisplays maps each ExplanationOfBenefitStatus code to its display name.
+var explanationOfBenefitStatusDisplays = map[ExplanationOfBenefitStatus]string{
+	ExplanationOfBenefitStatusActive:         "Active",
+	ExplanationOfBenefitStatusCancelled:      "Cancelled",
+	ExplanationOfBenefitStatusDraft:          "Draft",
+	ExplanationOfBenefitStatusEnteredInError: "Entered In Error",
+}
+
+// Code returns the code value.
+func (c ExplanationOfBenefitStatus) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the ExplanationOfBenefitStatus values.
+func (c ExplanationOfBenefitStatus) IsValid() bool {
+	_, ok := explanationOfBenefitStatusDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c ExplanationOfBenefitStatus) Display() string {
+	if display := explanationOfBenefitStatusDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseExplanationOfBenefitStatus returns the ExplanationOfBenefitStatus for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseExplanationOfBenefitStatus(s string) (ExplanationOfBenefitStatus, error) {
+	return parseCode(s, explanationOfBenefitStatusDisplays)
+}
+
 // ExposureState represents ExposureState.
 type ExposureState string
 
@@ -1340,6 +3924,37 @@ const (
 	ExposureStateExposureAlternative ExposureState = "exposure-alternative"
 )
 
+// exposureStateDisplays maps each ExposureState code to its display name.
+var exposureStateDisplays = map[ExposureState]string{
+	ExposureStateExposure:            "Exposure",
+	ExposureStateExposureAlternative: "Exposure Alternative",
+}
+
+// Code returns the code value.
+func (c ExposureState) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the ExposureState values.
+func (c ExposureState) IsValid() bool {
+	_, ok := exposureStateDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c ExposureState) Display() string {
+	if display := exposureStateDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseExposureState returns the ExposureState for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseExposureState(s string) (ExposureState, error) {
+	return parseCode(s, exposureStateDisplays)
+}
+
 // ExtensionContextType represents ExtensionContextType.
 type ExtensionContextType string
 
@@ -1353,6 +3968,38 @@ const (
 	ExtensionContextTypeExtension ExtensionContextType = "extension"
 )
 
+// extensionContextTypeDisplays maps each ExtensionContextType code to its display name.
+var extensionContextTypeDisplays = map[ExtensionContextType]string{
+	ExtensionContextTypeFhirpath:  "FHIRPath",
+	ExtensionContextTypeElement:   "Element ID",
+	ExtensionContextTypeExtension: "Extension URL",
+}
+
+// Code returns the code value.
+func (c ExtensionContextType) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the ExtensionContextType values.
+func (c ExtensionContextType) IsValid() bool {
+	_, ok := extensionContextTypeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c ExtensionContextType) Display() string {
+	if display := extensionContextTypeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseExtensionContextType returns the ExtensionContextType for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseExtensionContextType(s string) (ExtensionContextType, error) {
+	return parseCode(s, extensionContextTypeDisplays)
+}
+
 // FilterOperator represents FilterOperator.
 type FilterOperator string
 
@@ -1378,6 +4025,44 @@ const (
 	FilterOperatorExists FilterOperator = "exists"
 )
 
+// filterOperatorDisplays maps each FilterOperator code to its display name.
+var filterOperatorDisplays = map[FilterOperator]string{
+	FilterOperatorEqual:        "Equals",
+	FilterOperatorIsA:          "Is A (by subsumption)",
+	FilterOperatorDescendentOf: "Descendent Of (by subsumption)",
+	FilterOperatorIsNotA:       "Not (Is A) (by subsumption)",
+	FilterOperatorRegex:        "Regular Expression",
+	FilterOperatorIn:           "In Set",
+	FilterOperatorNotIn:        "Not in Set",
+	FilterOperatorGeneralizes:  "Generalizes (by Subsumption)",
+	FilterOperatorExists:       "Exists",
+}
+
+// Code returns the code value.
+func (c FilterOperator) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the FilterOperator values.
+func (c FilterOperator) IsValid() bool {
+	_, ok := filterOperatorDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c FilterOperator) Display() string {
+	if display := filterOperatorDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseFilterOperator returns the FilterOperator for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseFilterOperator(s string) (FilterOperator, error) {
+	return parseCode(s, filterOperatorDisplays)
+}
+
 // FlagStatus represents FlagStatus.
 type FlagStatus string
 
@@ -1391,6 +4076,38 @@ const (
 	FlagStatusEnteredInError FlagStatus = "entered-in-error"
 )
 
+// flagStatusDisplays maps each FlagStatus code to its display name.
+var flagStatusDisplays = map[FlagStatus]string{
+	FlagStatusActive:         "Active",
+	FlagStatusInactive:       "Inactive",
+	FlagStatusEnteredInError: "Entered in Error",
+}
+
+// Code returns the code value.
+func (c FlagStatus) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the FlagStatus values.
+func (c FlagStatus) IsValid() bool {
+	_, ok := flagStatusDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c FlagStatus) Display() string {
+	if display := flagStatusDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseFlagStatus returns the FlagStatus for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseFlagStatus(s string) (FlagStatus, error) {
+	return parseCode(s, flagStatusDisplays)
+}
+
 // FinancialResourceStatusCodes represents Financial Resource Status Codes.
 type FinancialResourceStatusCodes string
 
@@ -1406,6 +4123,39 @@ const (
 	FinancialResourceStatusCodesEnteredInError FinancialResourceStatusCodes = "entered-in-error"
 )
 
+// financialResourceStatusCodesDisplays maps each FinancialResourceStatusCodes code to its display name.
+var financialResourceStatusCodesDisplays = map[FinancialResourceStatusCodes]string{
+	FinancialResourceStatusCodesActive:         "Active",
+	FinancialResourceStatusCodesCancelled:      "Cancelled",
+	FinancialResourceStatusCodesDraft:          "Draft",
+	FinancialResourceStatusCodesEnteredInError: "Entered in Error",
+}
+
+// Code returns the code value.
+func (c FinancialResourceStatusCodes) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the FinancialResourceStatusCodes values.
+func (c FinancialResourceStatusCodes) IsValid() bool {
+	_, ok := financialResourceStatusCodesDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c FinancialResourceStatusCodes) Display() string {
+	if display := financialResourceStatusCodesDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseFinancialResourceStatusCodes returns the FinancialResourceStatusCodes for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseFinancialResourceStatusCodes(s string) (FinancialResourceStatusCodes, error) {
+	return parseCode(s, financialResourceStatusCodesDisplays)
+}
+
 // GoalLifecycleStatus represents GoalLifecycleStatus.
 type GoalLifecycleStatus string
 
@@ -1431,6 +4181,44 @@ const (
 	GoalLifecycleStatusRejected GoalLifecycleStatus = "rejected"
 )
 
+// goalLifecycleStatusDisplays maps each GoalLifecycleStatus code to its display name.
+var goalLifecycleStatusDisplays = map[GoalLifecycleStatus]string{
+	GoalLifecycleStatusProposed:       "Proposed",
+	GoalLifecycleStatusPlanned:        "Planned",
+	GoalLifecycleStatusAccepted:       "Accepted",
+	GoalLifecycleStatusActive:         "Active",
+	GoalLifecycleStatusOnHold:         "On Hold",
+	GoalLifecycleStatusCompleted:      "Completed",
+	GoalLifecycleStatusCancelled:      "Cancelled",
+	GoalLifecycleStatusEnteredInError: "Entered in Error",
+	GoalLifecycleStatusRejected:       "Rejected",
+}
+
+// Code returns the code value.
+func (c GoalLifecycleStatus) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the GoalLifecycleStatus values.
+func (c GoalLifecycleStatus) IsValid() bool {
+	_, ok := goalLifecycleStatusDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c GoalLifecycleStatus) Display() string {
+	if display := goalLifecycleStatusDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseGoalLifecycleStatus returns the GoalLifecycleStatus for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseGoalLifecycleStatus(s string) (GoalLifecycleStatus, error) {
+	return parseCode(s, goalLifecycleStatusDisplays)
+}
+
 // GraphCompartmentRule represents GraphCompartmentRule.
 type GraphCompartmentRule string
 
@@ -1446,6 +4234,39 @@ const (
 	GraphCompartmentRuleCustom GraphCompartmentRule = "custom"
 )
 
+// graphCompartmentRuleDisplays maps each GraphCompartmentRule code to its display name.
+var graphCompartmentRuleDisplays = map[GraphCompartmentRule]string{
+	GraphCompartmentRuleIdentical: "Identical",
+	GraphCompartmentRuleMatching:  "Matching",
+	GraphCompartmentRuleDifferent: "Different",
+	GraphCompartmentRuleCustom:    "Custom",
+}
+
+// Code returns the code value.
+func (c GraphCompartmentRule) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the GraphCompartmentRule values.
+func (c GraphCompartmentRule) IsValid() bool {
+	_, ok := graphCompartmentRuleDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c GraphCompartmentRule) Display() string {
+	if display := graphCompartmentRuleDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseGraphCompartmentRule returns the GraphCompartmentRule for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseGraphCompartmentRule(s string) (GraphCompartmentRule, error) {
+	return parseCode(s, graphCompartmentRuleDisplays)
+}
+
 // GraphCompartmentUse represents GraphCompartmentUse.
 type GraphCompartmentUse string
 
@@ -1457,6 +4278,37 @@ const (
 	GraphCompartmentUseRequirement GraphCompartmentUse = "requirement"
 )
 
+// graphCompartmentUseDisplays maps each GraphCompartmentUse code to its display name.
+var graphCompartmentUseDisplays = map[GraphCompartmentUse]string{
+	GraphCompartmentUseCondition:   "Condition",
+	GraphCompartmentUseRequirement: "Requirement",
+}
+
+// Code returns the code value.
+func (c GraphCompartmentUse) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the GraphCompartmentUse values.
+func (c GraphCompartmentUse) IsValid() bool {
+	_, ok := graphCompartmentUseDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c GraphCompartmentUse) Display() string {
+	if display := graphCompartmentUseDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseGraphCompartmentUse returns the GraphCompartmentUse for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseGraphCompartmentUse(s string) (GraphCompartmentUse, error) {
+	return parseCode(s, graphCompartmentUseDisplays)
+}
+
 // GroupMeasure represents GroupMeasure.
 type GroupMeasure string
 
@@ -1476,6 +4328,41 @@ const (
 	GroupMeasureMedianOfMedian GroupMeasure = "median-of-median"
 )
 
+// groupMeasureDisplays maps each GroupMeasure code to its display name.
+var groupMeasureDisplays = map[GroupMeasure]string{
+	GroupMeasureMean:           "Mean",
+	GroupMeasureMedian:         "Median",
+	GroupMeasureMeanOfMean:     "Mean of Study Means",
+	GroupMeasureMeanOfMedian:   "Mean of Study Medins",
+	GroupMeasureMedianOfMean:   "Median of Study Means",
+	GroupMeasureMedianOfMedian: "Median of Study Medians",
+}
+
+// Code returns the code value.
+func (c GroupMeasure) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the GroupMeasure values.
+func (c GroupMeasure) IsValid() bool {
+	_, ok := groupMeasureDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c GroupMeasure) Display() string {
+	if display := groupMeasureDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseGroupMeasure returns the GroupMeasure for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseGroupMeasure(s string) (GroupMeasure, error) {
+	return parseCode(s, groupMeasureDisplays)
+}
+
 // GroupType represents GroupType.
 type GroupType string
 
@@ -1495,6 +4382,41 @@ const (
 	GroupTypeSubstance GroupType = "substance"
 )
 
+// groupTypeDisplays maps each GroupType code to its display name.
+var groupTypeDisplays = map[GroupType]string{
+	GroupTypePerson:       "Person",
+	GroupTypeAnimal:       "Animal",
+	GroupTypePractitioner: "Practitioner",
+	GroupTypeDevice:       "Device",
+	GroupTypeMedication:   "Medication",
+	GroupTypeSubstance:    "Substance",
+}
+
+// Code returns the code value.
+func (c GroupType) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the GroupType values.
+func (c GroupType) IsValid() bool {
+	_, ok := groupTypeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c GroupType) Display() string {
+	if display := groupTypeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseGroupType returns the GroupType for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseGroupType(s string) (GroupType, error) {
+	return parseCode(s, groupTypeDisplays)
+}
+
 // GuidanceResponseStatus represents GuidanceResponseStatus.
 type GuidanceResponseStatus string
 
@@ -1514,6 +4436,41 @@ const (
 	GuidanceResponseStatusEnteredInError GuidanceResponseStatus = "entered-in-error"
 )
 
+// guidanceResponseStatusDisplays maps each GuidanceResponseStatus code to its display name.
+var guidanceResponseStatusDisplays = map[GuidanceResponseStatus]string{
+	GuidanceResponseStatusSuccess:        "Success",
+	GuidanceResponseStatusDataRequested:  "Data Requested",
+	GuidanceResponseStatusDataRequired:   "Data Required",
+	GuidanceResponseStatusInProgress:     "In Progress",
+	GuidanceResponseStatusFailure:        "Failure",
+	GuidanceResponseStatusEnteredInError: "Entered In Error",
+}
+
+// Code returns the code value.
+func (c GuidanceResponseStatus) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the GuidanceResponseStatus values.
+func (c GuidanceResponseStatus) IsValid() bool {
+	_, ok := guidanceResponseStatusDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c GuidanceResponseStatus) Display() string {
+	if display := guidanceResponseStatusDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseGuidanceResponseStatus returns the GuidanceResponseStatus for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseGuidanceResponseStatus(s string) (GuidanceResponseStatus, error) {
+	return parseCode(s, guidanceResponseStatusDisplays)
+}
+
 // GuidePageGeneration represents GuidePageGeneration.
 type GuidePageGeneration string
 
@@ -1529,6 +4486,39 @@ const (
 	GuidePageGenerationGenerated GuidePageGeneration = "generated"
 )
 
+// guidePageGenerationDisplays maps each GuidePageGeneration code to its display name.
+var guidePageGenerationDisplays = map[GuidePageGeneration]string{
+	GuidePageGenerationHtml:      "HTML",
+	GuidePageGenerationMarkdown:  "Markdown",
+	GuidePageGenerationXml:       "XML",
+	GuidePageGenerationGenerated: "Generated",
+}
+
+// Code returns the code value.
+func (c GuidePageGeneration) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the GuidePageGeneration values.
+func (c GuidePageGeneration) IsValid() bool {
+	_, ok := guidePageGenerationDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c GuidePageGeneration) Display() string {
+	if display := guidePageGenerationDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseGuidePageGeneration returns the GuidePageGeneration for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseGuidePageGeneration(s string) (GuidePageGeneration, error) {
+	return parseCode(s, guidePageGenerationDisplays)
+}
+
 // GuideParameterCode represents GuideParameterCode.
 type GuideParameterCode string
 
@@ -1556,6 +4546,45 @@ const (
 	GuideParameterCodeHtmlTemplate GuideParameterCode = "html-template"
 )
 
+// guideParameterCodeDisplays maps each GuideParameterCode code to its display name.
+var guideParameterCodeDisplays = map[GuideParameterCode]string{
+	GuideParameterCodeApply:              "Apply Metadata Value",
+	GuideParameterCodePathResource:       "Resource Path",
+	GuideParameterCodePathPages:          "Pages Path",
+	GuideParameterCodePathTxCache:        "Terminology Cache Path",
+	GuideParameterCodeExpansionParameter: "Expansion Profile",
+	GuideParameterCodeRuleBrokenLinks:    "Broken Links Rule",
+	GuideParameterCodeGenerateXml:        "Generate XML",
+	GuideParameterCodeGenerateJson:       "Generate JSON",
+	GuideParameterCodeGenerateTurtle:     "Generate Turtle",
+	GuideParameterCodeHtmlTemplate:       "HTML Template",
+}
+
+// Code returns the code value.
+func (c GuideParameterCode) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the GuideParameterCode values.
+func (c GuideParameterCode) IsValid() bool {
+	_, ok := guideParameterCodeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c GuideParameterCode) Display() string {
+	if display := guideParameterCodeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseGuideParameterCode returns the GuideParameterCode for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseGuideParameterCode(s string) (GuideParameterCode, error) {
+	return parseCode(s, guideParameterCodeDisplays)
+}
+
 // FamilyHistoryStatus represents FamilyHistoryStatus.
 type FamilyHistoryStatus string
 
@@ -1571,6 +4600,39 @@ const (
 	FamilyHistoryStatusHealthUnknown FamilyHistoryStatus = "health-unknown"
 )
 
+// familyHistoryStatusDisplays maps each FamilyHistoryStatus code to its display name.
+var familyHistoryStatusDisplays = map[FamilyHistoryStatus]string{
+	FamilyHistoryStatusPartial:        "Partial",
+	FamilyHistoryStatusCompleted:      "Completed",
+	FamilyHistoryStatusEnteredInError: "Entered in Error",
+	FamilyHistoryStatusHealthUnknown:  "Health Unknown",
+}
+
+// Code returns the code value.
+func (c FamilyHistoryStatus) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the FamilyHistoryStatus values.
+func (c FamilyHistoryStatus) IsValid() bool {
+	_, ok := familyHistoryStatusDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c FamilyHistoryStatus) Display() string {
+	if display := familyHistoryStatusDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseFamilyHistoryStatus returns the FamilyHistoryStatus for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseFamilyHistoryStatus(s string) (FamilyHistoryStatus, error) {
+	return parseCode(s, familyHistoryStatusDisplays)
+}
+
 // TestScriptRequestMethodCode represents TestScriptRequestMethodCode.
 type TestScriptRequestMethodCode string
 
@@ -1592,6 +4654,42 @@ const (
 	TestScriptRequestMethodCodeHead TestScriptRequestMethodCode = "head"
 )
 
+// testScriptRequestMethodCodeDisplays maps each TestScriptRequestMethodCode code to its display name.
+var testScriptRequestMethodCodeDisplays = map[TestScriptRequestMethodCode]string{
+	TestScriptRequestMethodCodeDelete:  "DELETE",
+	TestScriptRequestMethodCodeGet:     "GET",
+	TestScriptRequestMethodCodeOptions: "OPTIONS",
+	TestScriptRequestMethodCodePatch:   "PATCH",
+	TestScriptRequestMethodCodePost:    "POST",
+	TestScriptRequestMethodCodePut:     "PUT",
+	TestScriptRequestMethodCodeHead:    "HEAD",
+}
+
+// Code returns the code value.
+func (c TestScriptRequestMethodCode) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the TestScriptRequestMethodCode values.
+func (c TestScriptRequestMethodCode) IsValid() bool {
+	_, ok := testScriptRequestMethodCodeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c TestScriptRequestMethodCode) Display() string {
+	if display := testScriptRequestMethodCodeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseTestScriptRequestMethodCode returns the TestScriptRequestMethodCode for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseTestScriptRequestMethodCode(s string) (TestScriptRequestMethodCode, error) {
+	return parseCode(s, testScriptRequestMethodCodeDisplays)
+}
+
 // HTTPVerb represents HTTPVerb.
 type HTTPVerb string
 
@@ -1611,6 +4709,41 @@ const (
 	HTTPVerbPatch HTTPVerb = "PATCH"
 )
 
+// hTTPVerbDisplays maps each HTTPVerb code to its display name.
+var hTTPVerbDisplays = map[HTTPVerb]string{
+	HTTPVerbGet:    "GET",
+	HTTPVerbHead:   "HEAD",
+	HTTPVerbPost:   "POST",
+	HTTPVerbPut:    "PUT",
+	HTTPVerbDelete: "DELETE",
+	HTTPVerbPatch:  "PATCH",
+}
+
+// Code returns the code value.
+func (c HTTPVerb) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the HTTPVerb values.
+func (c HTTPVerb) IsValid() bool {
+	_, ok := hTTPVerbDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c HTTPVerb) Display() string {
+	if display := hTTPVerbDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseHTTPVerb returns the HTTPVerb for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseHTTPVerb(s string) (HTTPVerb, error) {
+	return parseCode(s, hTTPVerbDisplays)
+}
+
 // IdentifierUse represents IdentifierUse.
 type IdentifierUse string
 
@@ -1628,6 +4761,40 @@ const (
 	IdentifierUseOld IdentifierUse = "old"
 )
 
+// identifierUseDisplays maps each IdentifierUse code to its display name.
+var identifierUseDisplays = map[IdentifierUse]string{
+	IdentifierUseUsual:     "Usual",
+	IdentifierUseOfficial:  "Official",
+	IdentifierUseTemp:      "Temp",
+	IdentifierUseSecondary: "Secondary",
+	IdentifierUseOld:       "Old",
+}
+
+// Code returns the code value.
+func (c IdentifierUse) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the IdentifierUse values.
+func (c IdentifierUse) IsValid() bool {
+	_, ok := identifierUseDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c IdentifierUse) Display() string {
+	if display := identifierUseDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseIdentifierUse returns the IdentifierUse for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseIdentifierUse(s string) (IdentifierUse, error) {
+	return parseCode(s, identifierUseDisplays)
+}
+
 // IdentityAssuranceLevel represents IdentityAssuranceLevel.
 type IdentityAssuranceLevel string
 
@@ -1643,6 +4810,39 @@ const (
 	IdentityAssuranceLevelLevel4 IdentityAssuranceLevel = "level4"
 )
 
+// identityAssuranceLevelDisplays maps each IdentityAssuranceLevel code to its display name.
+var identityAssuranceLevelDisplays = map[IdentityAssuranceLevel]string{
+	IdentityAssuranceLevelLevel1: "Level 1",
+	IdentityAssuranceLevelLevel2: "Level 2",
+	IdentityAssuranceLevelLevel3: "Level 3",
+	IdentityAssuranceLevelLevel4: "Level 4",
+}
+
+// Code returns the code value.
+func (c IdentityAssuranceLevel) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the IdentityAssuranceLevel values.
+func (c IdentityAssuranceLevel) IsValid() bool {
+	_, ok := identityAssuranceLevelDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c IdentityAssuranceLevel) Display() string {
+	if display := identityAssuranceLevelDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseIdentityAssuranceLevel returns the IdentityAssuranceLevel for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseIdentityAssuranceLevel(s string) (IdentityAssuranceLevel, error) {
+	return parseCode(s, identityAssuranceLevelDisplays)
+}
+
 // ImagingStudyStatus represents ImagingStudyStatus.
 type ImagingStudyStatus string
 
@@ -1660,6 +4860,40 @@ const (
 	ImagingStudyStatusUnknown ImagingStudyStatus = "unknown"
 )
 
+// imagingStudyStatusDisplays maps each ImagingStudyStatus code to its display name.
+var imagingStudyStatusDisplays = map[ImagingStudyStatus]string{
+	ImagingStudyStatusRegistered:     "Registered",
+	ImagingStudyStatusAvailable:      "Available",
+	ImagingStudyStatusCancelled:      "Cancelled",
+	ImagingStudyStatusEnteredInError: "Entered in Error",
+	ImagingStudyStatusUnknown:        "Unknown",
+}
+
+// Code returns the code value.
+func (c ImagingStudyStatus) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the ImagingStudyStatus values.
+func (c ImagingStudyStatus) IsValid() bool {
+	_, ok := imagingStudyStatusDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c ImagingStudyStatus) Display() string {
+	if display := imagingStudyStatusDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseImagingStudyStatus returns the ImagingStudyStatus for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseImagingStudyStatus(s string) (ImagingStudyStatus, error) {
+	return parseCode(s, imagingStudyStatusDisplays)
+}
+
 // ImmunizationEvaluationStatusCodes represents Immunization Evaluation Status Codes.
 type ImmunizationEvaluationStatusCodes string
 
@@ -1669,6 +4903,37 @@ const (
 	ImmunizationEvaluationStatusCodesEnteredInError ImmunizationEvaluationStatusCodes = "entered-in-error"
 )
 
+// immunizationEvaluationStatusCodesDisplays maps each ImmunizationEvaluationStatusCodes code to its display name.
+var immunizationEvaluationStatusCodesDisplays = map[ImmunizationEvaluationStatusCodes]string{
+	ImmunizationEvaluationStatusCodesCompleted:      "",
+	ImmunizationEvaluationStatusCodesEnteredInError: "",
+}
+
+// Code returns the code value.
+func (c ImmunizationEvaluationStatusCodes) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the ImmunizationEvaluationStatusCodes values.
+func (c ImmunizationEvaluationStatusCodes) IsValid() bool {
+	_, ok := immunizationEvaluationStatusCodesDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c ImmunizationEvaluationStatusCodes) Display() string {
+	if display := immunizationEvaluationStatusCodesDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseImmunizationEvaluationStatusCodes returns the ImmunizationEvaluationStatusCodes for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseImmunizationEvaluationStatusCodes(s string) (ImmunizationEvaluationStatusCodes, error) {
+	return parseCode(s, immunizationEvaluationStatusCodesDisplays)
+}
+
 // ImmunizationStatusCodes represents Immunization Status Codes.
 type ImmunizationStatusCodes string
 
@@ -1679,6 +4944,38 @@ const (
 	ImmunizationStatusCodesNotDone        ImmunizationStatusCodes = "not-done"
 )
 
+// immunizationStatusCodesDisplays maps each ImmunizationStatusCodes code to its display name.
+var immunizationStatusCodesDisplays = map[ImmunizationStatusCodes]string{
+	ImmunizationStatusCodesCompleted:      "",
+	ImmunizationStatusCodesEnteredInError: "",
+	ImmunizationStatusCodesNotDone:        "",
+}
+
+// Code returns the code value.
+func (c ImmunizationStatusCodes) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the ImmunizationStatusCodes values.
+func (c ImmunizationStatusCodes) IsValid() bool {
+	_, ok := immunizationStatusCodesDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c ImmunizationStatusCodes) Display() string {
+	if display := immunizationStatusCodesDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseImmunizationStatusCodes returns the ImmunizationStatusCodes for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseImmunizationStatusCodes(s string) (ImmunizationStatusCodes, error) {
+	return parseCode(s, immunizationStatusCodesDisplays)
+}
+
 // InvoicePriceComponentType represents InvoicePriceComponentType.
 type InvoicePriceComponentType string
 
@@ -1698,6 +4995,41 @@ const (
 	InvoicePriceComponentTypeInformational InvoicePriceComponentType = "informational"
 )
 
+// invoicePriceComponentTypeDisplays maps each InvoicePriceComponentType code to its display name.
+var invoicePriceComponentTypeDisplays = map[InvoicePriceComponentType]string{
+	InvoicePriceComponentTypeBase:          "base price",
+	InvoicePriceComponentTypeSurcharge:     "surcharge",
+	InvoicePriceComponentTypeDeduction:     "deduction",
+	InvoicePriceComponentTypeDiscount:      "discount",
+	InvoicePriceComponentTypeTax:           "tax",
+	InvoicePriceComponentTypeInformational: "informational",
+}
+
+// Code returns the code value.
+func (c InvoicePriceComponentType) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the InvoicePriceComponentType values.
+func (c InvoicePriceComponentType) IsValid() bool {
+	_, ok := invoicePriceComponentTypeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c InvoicePriceComponentType) Display() string {
+	if display := invoicePriceComponentTypeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseInvoicePriceComponentType returns the InvoicePriceComponentType for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseInvoicePriceComponentType(s string) (InvoicePriceComponentType, error) {
+	return parseCode(s, invoicePriceComponentTypeDisplays)
+}
+
 // InvoiceStatus represents InvoiceStatus.
 type InvoiceStatus string
 
@@ -1715,6 +5047,40 @@ const (
 	InvoiceStatusEnteredInError InvoiceStatus = "entered-in-error"
 )
 
+// invoiceStatusDisplays maps each InvoiceStatus code to its display name.
+var invoiceStatusDisplays = map[InvoiceStatus]string{
+	InvoiceStatusDraft:          "draft",
+	InvoiceStatusIssued:         "issued",
+	InvoiceStatusBalanced:       "balanced",
+	InvoiceStatusCancelled:      "cancelled",
+	InvoiceStatusEnteredInError: "entered in error",
+}
+
+// Code returns the code value.
+func (c InvoiceStatus) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the InvoiceStatus values.
+func (c InvoiceStatus) IsValid() bool {
+	_, ok := invoiceStatusDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c InvoiceStatus) Display() string {
+	if display := invoiceStatusDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseInvoiceStatus returns the InvoiceStatus for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseInvoiceStatus(s string) (InvoiceStatus, error) {
+	return parseCode(s, invoiceStatusDisplays)
+}
+
 // IssueSeverity represents IssueSeverity.
 type IssueSeverity string
 
@@ -1730,6 +5096,39 @@ const (
 	IssueSeverityInformation IssueSeverity = "information"
 )
 
+// issueSeverityDisplays maps each IssueSeverity code to its display name.
+var issueSeverityDisplays = map[IssueSeverity]string{
+	IssueSeverityFatal:       "Fatal",
+	IssueSeverityError:       "Error",
+	IssueSeverityWarning:     "Warning",
+	IssueSeverityInformation: "Information",
+}
+
+// Code returns the code value.
+func (c IssueSeverity) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the IssueSeverity values.
+func (c IssueSeverity) IsValid() bool {
+	_, ok := issueSeverityDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c IssueSeverity) Display() string {
+	if display := issueSeverityDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseIssueSeverity returns the IssueSeverity for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseIssueSeverity(s string) (IssueSeverity, error) {
+	return parseCode(s, issueSeverityDisplays)
+}
+
 // IssueType represents IssueType.
 type IssueType string
 
@@ -1799,6 +5198,66 @@ const (
 	IssueTypeInformational IssueType = "informational"
 )
 
+// issueTypeDisplays maps each IssueType code to its display name.
+var issueTypeDisplays = map[IssueType]string{
+	IssueTypeInvalid:         "Invalid Content",
+	IssueTypeStructure:       "Structural Issue",
+	IssueTypeRequired:        "Required element missing",
+	IssueTypeValue:           "Element value invalid",
+	IssueTypeInvariant:       "Validation rule failed",
+	IssueTypeSecurity:        "Security Problem",
+	IssueTypeLogin:           "Login Required",
+	IssueTypeUnknown:         "Unknown User",
+	IssueTypeExpired:         "Session Expired",
+	IssueTypeForbidden:       "Forbidden",
+	IssueTypeSuppressed:      "Information  Suppressed",
+	IssueTypeProcessing:      "Processing Failure",
+	IssueTypeNotSupported:    "Content not supported",
+	IssueTypeDuplicate:       "Duplicate",
+	IssueTypeMultipleMatches: "Multiple Matches",
+	IssueTypeNotFound:        "Not Found",
+	IssueTypeDeleted:         "Deleted",
+	IssueTypeTooLong:         "Content Too Long",
+	IssueTypeCodeInvalid:     "Invalid Code",
+	IssueTypeExtension:       "Unacceptable Extension",
+	IssueTypeTooCostly:       "Operation Too Costly",
+	IssueTypeBusinessRule:    "Business Rule Violation",
+	IssueTypeConflict:        "Edit Version Conflict",
+	IssueTypeTransient:       "Transient Issue",
+	IssueTypeLockError:       "Lock Error",
+	IssueTypeNoStore:         "No Store Available",
+	IssueTypeException:       "Exception",
+	IssueTypeTimeout:         "Timeout",
+	IssueTypeIncomplete:      "Incomplete Results",
+	IssueTypeThrottled:       "Throttled",
+	IssueTypeInformational:   "Informational Note",
+}
+
+// Code returns the code value.
+func (c IssueType) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the IssueType values.
+func (c IssueType) IsValid() bool {
+	_, ok := issueTypeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c IssueType) Display() string {
+	if display := issueTypeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseIssueType returns the IssueType for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseIssueType(s string) (IssueType, error) {
+	return parseCode(s, issueTypeDisplays)
+}
+
 // QuestionnaireItemType represents QuestionnaireItemType.
 type QuestionnaireItemType string
 
@@ -1840,6 +5299,52 @@ const (
 	QuestionnaireItemTypeQuantity QuestionnaireItemType = "quantity"
 )
 
+// questionnaireItemTypeDisplays maps each QuestionnaireItemType code to its display name.
+var questionnaireItemTypeDisplays = map[QuestionnaireItemType]string{
+	QuestionnaireItemTypeGroup:      "Group",
+	QuestionnaireItemTypeDisplay:    "Display",
+	QuestionnaireItemTypeQuestion:   "Question",
+	QuestionnaireItemTypeBoolean:    "Boolean",
+	QuestionnaireItemTypeDecimal:    "Decimal",
+	QuestionnaireItemTypeInteger:    "Integer",
+	QuestionnaireItemTypeDate:       "Date",
+	QuestionnaireItemTypeDatetime:   "Date Time",
+	QuestionnaireItemTypeTime:       "Time",
+	QuestionnaireItemTypeString:     "String",
+	QuestionnaireItemTypeText:       "Text",
+	QuestionnaireItemTypeUrl:        "Url",
+	QuestionnaireItemTypeChoice:     "Choice",
+	QuestionnaireItemTypeOpenChoice: "Open Choice",
+	QuestionnaireItemTypeAttachment: "Attachment",
+	QuestionnaireItemTypeReference:  "Reference",
+	QuestionnaireItemTypeQuantity:   "Quantity",
+}
+
+// Code returns the code value.
+func (c QuestionnaireItemType) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the QuestionnaireItemType values.
+func (c QuestionnaireItemType) IsValid() bool {
+	_, ok := questionnaireItemTypeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c QuestionnaireItemType) Display() string {
+	if display := questionnaireItemTypeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseQuestionnaireItemType returns the QuestionnaireItemType for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseQuestionnaireItemType(s string) (QuestionnaireItemType, error) {
+	return parseCode(s, questionnaireItemTypeDisplays)
+}
+
 // LinkType represents LinkType.
 type LinkType string
 
@@ -1855,6 +5360,39 @@ const (
 	LinkTypeSeealso LinkType = "seealso"
 )
 
+// linkTypeDisplays maps each LinkType code to its display name.
+var linkTypeDisplays = map[LinkType]string{
+	LinkTypeReplacedBy: "Replaced-by",
+	LinkTypeReplaces:   "Replaces",
+	LinkTypeRefer:      "Refer",
+	LinkTypeSeealso:    "See also",
+}
+
+// Code returns the code value.
+func (c LinkType) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the LinkType values.
+func (c LinkType) IsValid() bool {
+	_, ok := linkTypeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c LinkType) Display() string {
+	if display := linkTypeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseLinkType returns the LinkType for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseLinkType(s string) (LinkType, error) {
+	return parseCode(s, linkTypeDisplays)
+}
+
 // LinkageType represents LinkageType.
 type LinkageType string
 
@@ -1868,6 +5406,38 @@ const (
 	LinkageTypeHistorical LinkageType = "historical"
 )
 
+// linkageTypeDisplays maps each LinkageType code to its display name.
+var linkageTypeDisplays = map[LinkageType]string{
+	LinkageTypeSource:     "Source of Truth",
+	LinkageTypeAlternate:  "Alternate Record",
+	LinkageTypeHistorical: "Historical/Obsolete Record",
+}
+
+// Code returns the code value.
+func (c LinkageType) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the LinkageType values.
+func (c LinkageType) IsValid() bool {
+	_, ok := linkageTypeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c LinkageType) Display() string {
+	if display := linkageTypeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseLinkageType returns the LinkageType for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseLinkageType(s string) (LinkageType, error) {
+	return parseCode(s, linkageTypeDisplays)
+}
+
 // ListMode represents ListMode.
 type ListMode string
 
@@ -1881,6 +5451,38 @@ const (
 	ListModeChanges ListMode = "changes"
 )
 
+// listModeDisplays maps each ListMode code to its display name.
+var listModeDisplays = map[ListMode]string{
+	ListModeWorking:  "Working List",
+	ListModeSnapshot: "Snapshot List",
+	ListModeChanges:  "Change List",
+}
+
+// Code returns the code value.
+func (c ListMode) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the ListMode values.
+func (c ListMode) IsValid() bool {
+	_, ok := listModeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c ListMode) Display() string {
+	if display := listModeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseListMode returns the ListMode for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseListMode(s string) (ListMode, error) {
+	return parseCode(s, listModeDisplays)
+}
+
 // ListStatus represents ListStatus.
 type ListStatus string
 
@@ -1894,6 +5496,38 @@ const (
 	ListStatusEnteredInError ListStatus = "entered-in-error"
 )
 
+// listStatusDisplays maps each ListStatus code to its display name.
+var listStatusDisplays = map[ListStatus]string{
+	ListStatusCurrent:        "Current",
+	ListStatusRetired:        "Retired",
+	ListStatusEnteredInError: "Entered In Error",
+}
+
+// Code returns the code value.
+func (c ListStatus) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the ListStatus values.
+func (c ListStatus) IsValid() bool {
+	_, ok := listStatusDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c ListStatus) Display() string {
+	if display := listStatusDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseListStatus returns the ListStatus for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseListStatus(s string) (ListStatus, error) {
+	return parseCode(s, listStatusDisplays)
+}
+
 // LocationMode represents LocationMode.
 type LocationMode string
 
@@ -1905,6 +5539,37 @@ const (
 	LocationModeKind LocationMode = "kind"
 )
 
+// locationModeDisplays maps each LocationMode code to its display name.
+var locationModeDisplays = map[LocationMode]string{
+	LocationModeInstance: "Instance",
+	LocationModeKind:     "Kind",
+}
+
+// Code returns the code value.
+func (c LocationMode) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the LocationMode values.
+func (c LocationMode) IsValid() bool {
+	_, ok := locationModeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c LocationMode) Display() string {
+	if display := locationModeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseLocationMode returns the LocationMode for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseLocationMode(s string) (LocationMode, error) {
+	return parseCode(s, locationModeDisplays)
+}
+
 // LocationStatus represents LocationStatus.
 type LocationStatus string
 
@@ -1918,6 +5583,38 @@ const (
 	LocationStatusInactive LocationStatus = "inactive"
 )
 
+// locationStatusDisplays maps each LocationStatus code to its display name.
+var locationStatusDisplays = map[LocationStatus]string{
+	LocationStatusActive:    "Active",
+	LocationStatusSuspended: "Suspended",
+	LocationStatusInactive:  "Inactive",
+}
+
+// Code returns the code value.
+func (c LocationStatus) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the LocationStatus values.
+func (c LocationStatus) IsValid() bool {
+	_, ok := locationStatusDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c LocationStatus) Display() string {
+	if display := locationStatusDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseLocationStatus returns the LocationStatus for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseLocationStatus(s string) (LocationStatus, error) {
+	return parseCode(s, locationStatusDisplays)
+}
+
 // StructureMapContextType represents StructureMapContextType.
 type StructureMapContextType string
 
@@ -1929,6 +5626,37 @@ const (
 	StructureMapContextTypeVariable StructureMapContextType = "variable"
 )
 
+// structureMapContextTypeDisplays maps each StructureMapContextType code to its display name.
+var structureMapContextTypeDisplays = map[StructureMapContextType]string{
+	StructureMapContextTypeType:     "Type",
+	StructureMapContextTypeVariable: "Variable",
+}
+
+// Code returns the code value.
+func (c StructureMapContextType) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the StructureMapContextType values.
+func (c StructureMapContextType) IsValid() bool {
+	_, ok := structureMapContextTypeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c StructureMapContextType) Display() string {
+	if display := structureMapContextTypeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseStructureMapContextType returns the StructureMapContextType for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseStructureMapContextType(s string) (StructureMapContextType, error) {
+	return parseCode(s, structureMapContextTypeDisplays)
+}
+
 // StructureMapGroupTypeMode represents StructureMapGroupTypeMode.
 type StructureMapGroupTypeMode string
 
@@ -1942,6 +5670,38 @@ const (
 	StructureMapGroupTypeModeTypeAndTypes StructureMapGroupTypeMode = "type-and-types"
 )
 
+// structureMapGroupTypeModeDisplays maps each StructureMapGroupTypeMode code to its display name.
+var structureMapGroupTypeModeDisplays = map[StructureMapGroupTypeMode]string{
+	StructureMapGroupTypeModeNone:         "Not a Default",
+	StructureMapGroupTypeModeTypes:        "Default for Type Combination",
+	StructureMapGroupTypeModeTypeAndTypes: "Default for type + combination",
+}
+
+// Code returns the code value.
+func (c StructureMapGroupTypeMode) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the StructureMapGroupTypeMode values.
+func (c StructureMapGroupTypeMode) IsValid() bool {
+	_, ok := structureMapGroupTypeModeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c StructureMapGroupTypeMode) Display() string {
+	if display := structureMapGroupTypeModeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseStructureMapGroupTypeMode returns the StructureMapGroupTypeMode for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseStructureMapGroupTypeMode(s string) (StructureMapGroupTypeMode, error) {
+	return parseCode(s, structureMapGroupTypeModeDisplays)
+}
+
 // StructureMapInputMode represents StructureMapInputMode.
 type StructureMapInputMode string
 
@@ -1953,6 +5713,37 @@ const (
 	StructureMapInputModeTarget StructureMapInputMode = "target"
 )
 
+// structureMapInputModeDisplays maps each StructureMapInputMode code to its display name.
+var structureMapInputModeDisplays = map[StructureMapInputMode]string{
+	StructureMapInputModeSource: "Source Instance",
+	StructureMapInputModeTarget: "Target Instance",
+}
+
+// Code returns the code value.
+func (c StructureMapInputMode) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the StructureMapInputMode values.
+func (c StructureMapInputMode) IsValid() bool {
+	_, ok := structureMapInputModeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c StructureMapInputMode) Display() string {
+	if display := structureMapInputModeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseStructureMapInputMode returns the StructureMapInputMode for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseStructureMapInputMode(s string) (StructureMapInputMode, error) {
+	return parseCode(s, structureMapInputModeDisplays)
+}
+
 // StructureMapModelMode represents StructureMapModelMode.
 type StructureMapModelMode string
 
@@ -1968,6 +5759,39 @@ const (
 	StructureMapModelModeProduced StructureMapModelMode = "produced"
 )
 
+// structureMapModelModeDisplays maps each StructureMapModelMode code to its display name.
+var structureMapModelModeDisplays = map[StructureMapModelMode]string{
+	StructureMapModelModeSource:   "Source Structure Definition",
+	StructureMapModelModeQueried:  "Queried Structure Definition",
+	StructureMapModelModeTarget:   "Target Structure Definition",
+	StructureMapModelModeProduced: "Produced Structure Definition",
+}
+
+// Code returns the code value.
+func (c StructureMapModelMode) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the StructureMapModelMode values.
+func (c StructureMapModelMode) IsValid() bool {
+	_, ok := structureMapModelModeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c StructureMapModelMode) Display() string {
+	if display := structureMapModelModeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseStructureMapModelMode returns the StructureMapModelMode for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseStructureMapModelMode(s string) (StructureMapModelMode, error) {
+	return parseCode(s, structureMapModelModeDisplays)
+}
+
 // StructureMapSourceListMode represents StructureMapSourceListMode.
 type StructureMapSourceListMode string
 
@@ -1985,6 +5809,40 @@ const (
 	StructureMapSourceListModeOnlyOne StructureMapSourceListMode = "only_one"
 )
 
+// structureMapSourceListModeDisplays maps each StructureMapSourceListMode code to its display name.
+var structureMapSourceListModeDisplays = map[StructureMapSourceListMode]string{
+	StructureMapSourceListModeFirst:    "First",
+	StructureMapSourceListModeNotFirst: "All but the first",
+	StructureMapSourceListModeLast:     "Last",
+	StructureMapSourceListModeNotLast:  "All but the last",
+	StructureMapSourceListModeOnlyOne:  "Enforce only one",
+}
+
+// Code returns the code value.
+func (c StructureMapSourceListMode) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the StructureMapSourceListMode values.
+func (c StructureMapSourceListMode) IsValid() bool {
+	_, ok := structureMapSourceListModeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c StructureMapSourceListMode) Display() string {
+	if display := structureMapSourceListModeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseStructureMapSourceListMode returns the StructureMapSourceListMode for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseStructureMapSourceListMode(s string) (StructureMapSourceListMode, error) {
+	return parseCode(s, structureMapSourceListModeDisplays)
+}
+
 // StructureMapTargetListMode represents StructureMapTargetListMode.
 type StructureMapTargetListMode string
 
@@ -2000,6 +5858,39 @@ const (
 	StructureMapTargetListModeCollate StructureMapTargetListMode = "collate"
 )
 
+// structureMapTargetListModeDisplays maps each StructureMapTargetListMode code to its display name.
+var structureMapTargetListModeDisplays = map[StructureMapTargetListMode]string{
+	StructureMapTargetListModeFirst:   "First",
+	StructureMapTargetListModeShare:   "Share",
+	StructureMapTargetListModeLast:    "Last",
+	StructureMapTargetListModeCollate: "Collate",
+}
+
+// Code returns the code value.
+func (c StructureMapTargetListMode) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the StructureMapTargetListMode values.
+func (c StructureMapTargetListMode) IsValid() bool {
+	_, ok := structureMapTargetListModeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c StructureMapTargetListMode) Display() string {
+	if display := structureMapTargetListModeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseStructureMapTargetListMode returns the StructureMapTargetListMode for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseStructureMapTargetListMode(s string) (StructureMapTargetListMode, error) {
+	return parseCode(s, structureMapTargetListModeDisplays)
+}
+
 // StructureMapTransform represents StructureMapTransform.
 type StructureMapTransform string
 
@@ -2041,6 +5932,52 @@ const (
 	StructureMapTransformCp StructureMapTransform = "cp"
 )
 
+// structureMapTransformDisplays maps each StructureMapTransform code to its display name.
+var structureMapTransformDisplays = map[StructureMapTransform]string{
+	StructureMapTransformCreate:    "create",
+	StructureMapTransformCopy:      "copy",
+	StructureMapTransformTruncate:  "truncate",
+	StructureMapTransformEscape:    "escape",
+	StructureMapTransformCast:      "cast",
+	StructureMapTransformAppend:    "append",
+	StructureMapTransformTranslate: "translate",
+	StructureMapTransformReference: "reference",
+	StructureMapTransformDateop:    "dateOp",
+	StructureMapTransformUuid:      "uuid",
+	StructureMapTransformPointer:   "pointer",
+	StructureMapTransformEvaluate:  "evaluate",
+	StructureMapTransformCc:        "cc",
+	StructureMapTransformC:         "c",
+	StructureMapTransformQty:       "qty",
+	StructureMapTransformId:        "id",
+	StructureMapTransformCp:        "cp",
+}
+
+// Code returns the code value.
+func (c StructureMapTransform) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the StructureMapTransform values.
+func (c StructureMapTransform) IsValid() bool {
+	_, ok := structureMapTransformDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c StructureMapTransform) Display() string {
+	if display := structureMapTransformDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseStructureMapTransform returns the StructureMapTransform for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseStructureMapTransform(s string) (StructureMapTransform, error) {
+	return parseCode(s, structureMapTransformDisplays)
+}
+
 // MeasureReportStatus represents MeasureReportStatus.
 type MeasureReportStatus string
 
@@ -2054,6 +5991,38 @@ const (
 	MeasureReportStatusError MeasureReportStatus = "error"
 )
 
+// measureReportStatusDisplays maps each MeasureReportStatus code to its display name.
+var measureReportStatusDisplays = map[MeasureReportStatus]string{
+	MeasureReportStatusComplete: "Complete",
+	MeasureReportStatusPending:  "Pending",
+	MeasureReportStatusError:    "Error",
+}
+
+// Code returns the code value.
+func (c MeasureReportStatus) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the MeasureReportStatus values.
+func (c MeasureReportStatus) IsValid() bool {
+	_, ok := measureReportStatusDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c MeasureReportStatus) Display() string {
+	if display := measureReportStatusDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseMeasureReportStatus returns the MeasureReportStatus for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseMeasureReportStatus(s string) (MeasureReportStatus, error) {
+	return parseCode(s, measureReportStatusDisplays)
+}
+
 // MeasureReportType represents MeasureReportType.
 type MeasureReportType string
 
@@ -2069,6 +6038,39 @@ const (
 	MeasureReportTypeDataCollection MeasureReportType = "data-collection"
 )
 
+// measureReportTypeDisplays maps each MeasureReportType code to its display name.
+var measureReportTypeDisplays = map[MeasureReportType]string{
+	MeasureReportTypeIndividual:     "Individual",
+	MeasureReportTypeSubjectList:    "Subject List",
+	MeasureReportTypeSummary:        "Summary",
+	MeasureReportTypeDataCollection: "Data Collection",
+}
+
+// Code returns the code value.
+func (c MeasureReportType) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the MeasureReportType values.
+func (c MeasureReportType) IsValid() bool {
+	_, ok := measureReportTypeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c MeasureReportType) Display() string {
+	if display := measureReportTypeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseMeasureReportType returns the MeasureReportType for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseMeasureReportType(s string) (MeasureReportType, error) {
+	return parseCode(s, measureReportTypeDisplays)
+}
+
 // MedicationAdministrationStatusCodes represents Medication administration  status  codes.
 type MedicationAdministrationStatusCodes string
 
@@ -2090,6 +6092,42 @@ const (
 	MedicationAdministrationStatusCodesUnknown MedicationAdministrationStatusCodes = "unknown"
 )
 
+// medicationAdministrationStatusCodesDisplays maps each MedicationAdministrationStatusCodes code to its display name.
+var medicationAdministrationStatusCodesDisplays = map[MedicationAdministrationStatusCodes]string{
+	MedicationAdministrationStatusCodesInProgress:     "In Progress",
+	MedicationAdministrationStatusCodesNotDone:        "Not Done",
+	MedicationAdministrationStatusCodesOnHold:         "On Hold",
+	MedicationAdministrationStatusCodesCompleted:      "Completed",
+	MedicationAdministrationStatusCodesEnteredInError: "Entered in Error",
+	MedicationAdministrationStatusCodesStopped:        "Stopped",
+	MedicationAdministrationStatusCodesUnknown:        "Unknown",
+}
+
+// Code returns the code value.
+func (c MedicationAdministrationStatusCodes) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the MedicationAdministrationStatusCodes values.
+func (c MedicationAdministrationStatusCodes) IsValid() bool {
+	_, ok := medicationAdministrationStatusCodesDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c MedicationAdministrationStatusCodes) Display() string {
+	if display := medicationAdministrationStatusCodesDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseMedicationAdministrationStatusCodes returns the MedicationAdministrationStatusCodes for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseMedicationAdministrationStatusCodes(s string) (MedicationAdministrationStatusCodes, error) {
+	return parseCode(s, medicationAdministrationStatusCodesDisplays)
+}
+
 // MedicationStatusCodes represents Medication  status  codes.
 type MedicationStatusCodes string
 
@@ -2113,6 +6151,43 @@ const (
 	MedicationStatusCodesNotTaken MedicationStatusCodes = "not-taken"
 )
 
+// medicationStatusCodesDisplays maps each MedicationStatusCodes code to its display name.
+var medicationStatusCodesDisplays = map[MedicationStatusCodes]string{
+	MedicationStatusCodesActive:         "Active",
+	MedicationStatusCodesCompleted:      "Completed",
+	MedicationStatusCodesEnteredInError: "Entered in Error",
+	MedicationStatusCodesIntended:       "Intended",
+	MedicationStatusCodesStopped:        "Stopped",
+	MedicationStatusCodesOnHold:         "On Hold",
+	MedicationStatusCodesUnknown:        "Unknown",
+	MedicationStatusCodesNotTaken:       "Not Taken",
+}
+
+// Code returns the code value.
+func (c MedicationStatusCodes) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the MedicationStatusCodes values.
+func (c MedicationStatusCodes) IsValid() bool {
+	_, ok := medicationStatusCodesDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c MedicationStatusCodes) Display() string {
+	if display := medicationStatusCodesDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseMedicationStatusCodes returns the MedicationStatusCodes for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseMedicationStatusCodes(s string) (MedicationStatusCodes, error) {
+	return parseCode(s, medicationStatusCodesDisplays)
+}
+
 // MedicationDispenseStatusCodes represents Medication dispense  status  codes.
 type MedicationDispenseStatusCodes string
 
@@ -2138,6 +6213,44 @@ const (
 	MedicationDispenseStatusCodesUnknown MedicationDispenseStatusCodes = "unknown"
 )
 
+// medicationDispenseStatusCodesDisplays maps each MedicationDispenseStatusCodes code to its display name.
+var medicationDispenseStatusCodesDisplays = map[MedicationDispenseStatusCodes]string{
+	MedicationDispenseStatusCodesPreparation:    "Preparation",
+	MedicationDispenseStatusCodesInProgress:     "In Progress",
+	MedicationDispenseStatusCodesCancelled:      "Cancelled",
+	MedicationDispenseStatusCodesOnHold:         "On Hold",
+	MedicationDispenseStatusCodesCompleted:      "Completed",
+	MedicationDispenseStatusCodesEnteredInError: "Entered in Error",
+	MedicationDispenseStatusCodesStopped:        "Stopped",
+	MedicationDispenseStatusCodesDeclined:       "Declined",
+	MedicationDispenseStatusCodesUnknown:        "Unknown",
+}
+
+// Code returns the code value.
+func (c MedicationDispenseStatusCodes) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the MedicationDispenseStatusCodes values.
+func (c MedicationDispenseStatusCodes) IsValid() bool {
+	_, ok := medicationDispenseStatusCodesDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c MedicationDispenseStatusCodes) Display() string {
+	if display := medicationDispenseStatusCodesDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseMedicationDispenseStatusCodes returns the MedicationDispenseStatusCodes for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseMedicationDispenseStatusCodes(s string) (MedicationDispenseStatusCodes, error) {
+	return parseCode(s, medicationDispenseStatusCodesDisplays)
+}
+
 // MedicationKnowledgeStatusCodes represents Medication knowledge  status  codes.
 type MedicationKnowledgeStatusCodes string
 
@@ -2151,6 +6264,38 @@ const (
 	MedicationKnowledgeStatusCodesEnteredInError MedicationKnowledgeStatusCodes = "entered-in-error"
 )
 
+// medicationKnowledgeStatusCodesDisplays maps each MedicationKnowledgeStatusCodes code to its display name.
+var medicationKnowledgeStatusCodesDisplays = map[MedicationKnowledgeStatusCodes]string{
+	MedicationKnowledgeStatusCodesActive:         "Active",
+	MedicationKnowledgeStatusCodesInactive:       "Inactive",
+	MedicationKnowledgeStatusCodesEnteredInError: "Entered in Error",
+}
+
+// Code returns the code value.
+func (c MedicationKnowledgeStatusCodes) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the MedicationKnowledgeStatusCodes values.
+func (c MedicationKnowledgeStatusCodes) IsValid() bool {
+	_, ok := medicationKnowledgeStatusCodesDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c MedicationKnowledgeStatusCodes) Display() string {
+	if display := medicationKnowledgeStatusCodesDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseMedicationKnowledgeStatusCodes returns the MedicationKnowledgeStatusCodes for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseMedicationKnowledgeStatusCodes(s string) (MedicationKnowledgeStatusCodes, error) {
+	return parseCode(s, medicationKnowledgeStatusCodesDisplays)
+}
+
 // MedicationRequestIntent represents Medication request  intent.
 type MedicationRequestIntent string
 
@@ -2174,6 +6319,43 @@ const (
 	MedicationRequestIntentOption MedicationRequestIntent = "option"
 )
 
+// medicationRequestIntentDisplays maps each MedicationRequestIntent code to its display name.
+var medicationRequestIntentDisplays = map[MedicationRequestIntent]string{
+	MedicationRequestIntentProposal:      "Proposal",
+	MedicationRequestIntentPlan:          "Plan",
+	MedicationRequestIntentOrder:         "Order",
+	MedicationRequestIntentOriginalOrder: "Original Order",
+	MedicationRequestIntentReflexOrder:   "Reflex Order",
+	MedicationRequestIntentFillerOrder:   "Filler Order",
+	MedicationRequestIntentInstanceOrder: "Instance Order",
+	MedicationRequestIntentOption:        "Option",
+}
+
+// Code returns the code value.
+func (c MedicationRequestIntent) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the MedicationRequestIntent values.
+func (c MedicationRequestIntent) IsValid() bool {
+	_, ok := medicationRequestIntentDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c MedicationRequestIntent) Display() string {
+	if display := medicationRequestIntentDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseMedicationRequestIntent returns the MedicationRequestIntent for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseMedicationRequestIntent(s string) (MedicationRequestIntent, error) {
+	return parseCode(s, medicationRequestIntentDisplays)
+}
+
 // MedicationrequestStatus represents Medicationrequest  status.
 type MedicationrequestStatus string
 
@@ -2197,6 +6379,43 @@ const (
 	MedicationrequestStatusUnknown MedicationrequestStatus = "unknown"
 )
 
+// medicationrequestStatusDisplays maps each MedicationrequestStatus code to its display name.
+var medicationrequestStatusDisplays = map[MedicationrequestStatus]string{
+	MedicationrequestStatusActive:         "Active",
+	MedicationrequestStatusOnHold:         "On Hold",
+	MedicationrequestStatusCancelled:      "Cancelled",
+	MedicationrequestStatusCompleted:      "Completed",
+	MedicationrequestStatusEnteredInError: "Entered in Error",
+	MedicationrequestStatusStopped:        "Stopped",
+	MedicationrequestStatusDraft:          "Draft",
+	MedicationrequestStatusUnknown:        "Unknown",
+}
+
+// Code returns the code value.
+func (c MedicationrequestStatus) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the MedicationrequestStatus values.
+func (c MedicationrequestStatus) IsValid() bool {
+	_, ok := medicationrequestStatusDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c MedicationrequestStatus) Display() string {
+	if display := medicationrequestStatusDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseMedicationrequestStatus returns the MedicationrequestStatus for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseMedicationrequestStatus(s string) (MedicationrequestStatus, error) {
+	return parseCode(s, medicationrequestStatusDisplays)
+}
+
 // MessageSignificanceCategory represents MessageSignificanceCategory.
 type MessageSignificanceCategory string
 
@@ -2210,6 +6429,38 @@ const (
 	MessageSignificanceCategoryNotification MessageSignificanceCategory = "notification"
 )
 
+// messageSignificanceCategoryDisplays maps each MessageSignificanceCategory code to its display name.
+var messageSignificanceCategoryDisplays = map[MessageSignificanceCategory]string{
+	MessageSignificanceCategoryConsequence:  "Consequence",
+	MessageSignificanceCategoryCurrency:     "Currency",
+	MessageSignificanceCategoryNotification: "Notification",
+}
+
+// Code returns the code value.
+func (c MessageSignificanceCategory) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the MessageSignificanceCategory values.
+func (c MessageSignificanceCategory) IsValid() bool {
+	_, ok := messageSignificanceCategoryDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c MessageSignificanceCategory) Display() string {
+	if display := messageSignificanceCategoryDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseMessageSignificanceCategory returns the MessageSignificanceCategory for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseMessageSignificanceCategory(s string) (MessageSignificanceCategory, error) {
+	return parseCode(s, messageSignificanceCategoryDisplays)
+}
+
 // Messageheaderresponserequest represents messageheader-response-request.
 type Messageheaderresponserequest string
 
@@ -2225,6 +6476,39 @@ const (
 	MessageheaderresponserequestOnSuccess Messageheaderresponserequest = "on-success"
 )
 
+// messageheaderresponserequestDisplays maps each Messageheaderresponserequest code to its display name.
+var messageheaderresponserequestDisplays = map[Messageheaderresponserequest]string{
+	MessageheaderresponserequestAlways:    "Always",
+	MessageheaderresponserequestOnError:   "Error/reject conditions only",
+	MessageheaderresponserequestNever:     "Never",
+	MessageheaderresponserequestOnSuccess: "Successful completion only",
+}
+
+// Code returns the code value.
+func (c Messageheaderresponserequest) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the Messageheaderresponserequest values.
+func (c Messageheaderresponserequest) IsValid() bool {
+	_, ok := messageheaderresponserequestDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c Messageheaderresponserequest) Display() string {
+	if display := messageheaderresponserequestDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseMessageheaderresponserequest returns the Messageheaderresponserequest for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseMessageheaderresponserequest(s string) (Messageheaderresponserequest, error) {
+	return parseCode(s, messageheaderresponserequestDisplays)
+}
+
 // DeviceMetricCalibrationState represents DeviceMetricCalibrationState.
 type DeviceMetricCalibrationState string
 
@@ -2240,6 +6524,39 @@ const (
 	DeviceMetricCalibrationStateUnspecified DeviceMetricCalibrationState = "unspecified"
 )
 
+// deviceMetricCalibrationStateDisplays maps each DeviceMetricCalibrationState code to its display name.
+var deviceMetricCalibrationStateDisplays = map[DeviceMetricCalibrationState]string{
+	DeviceMetricCalibrationStateNotCalibrated:       "Not Calibrated",
+	DeviceMetricCalibrationStateCalibrationRequired: "Calibration Required",
+	DeviceMetricCalibrationStateCalibrated:          "Calibrated",
+	DeviceMetricCalibrationStateUnspecified:         "Unspecified",
+}
+
+// Code returns the code value.
+func (c DeviceMetricCalibrationState) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the DeviceMetricCalibrationState values.
+func (c DeviceMetricCalibrationState) IsValid() bool {
+	_, ok := deviceMetricCalibrationStateDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c DeviceMetricCalibrationState) Display() string {
+	if display := deviceMetricCalibrationStateDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseDeviceMetricCalibrationState returns the DeviceMetricCalibrationState for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseDeviceMetricCalibrationState(s string) (DeviceMetricCalibrationState, error) {
+	return parseCode(s, deviceMetricCalibrationStateDisplays)
+}
+
 // DeviceMetricCalibrationType represents DeviceMetricCalibrationType.
 type DeviceMetricCalibrationType string
 
@@ -2255,6 +6572,39 @@ const (
 	DeviceMetricCalibrationTypeTwoPoint DeviceMetricCalibrationType = "two-point"
 )
 
+// deviceMetricCalibrationTypeDisplays maps each DeviceMetricCalibrationType code to its display name.
+var deviceMetricCalibrationTypeDisplays = map[DeviceMetricCalibrationType]string{
+	DeviceMetricCalibrationTypeUnspecified: "Unspecified",
+	DeviceMetricCalibrationTypeOffset:      "Offset",
+	DeviceMetricCalibrationTypeGain:        "Gain",
+	DeviceMetricCalibrationTypeTwoPoint:    "Two Point",
+}
+
+// Code returns the code value.
+func (c DeviceMetricCalibrationType) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the DeviceMetricCalibrationType values.
+func (c DeviceMetricCalibrationType) IsValid() bool {
+	_, ok := deviceMetricCalibrationTypeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c DeviceMetricCalibrationType) Display() string {
+	if display := deviceMetricCalibrationTypeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseDeviceMetricCalibrationType returns the DeviceMetricCalibrationType for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseDeviceMetricCalibrationType(s string) (DeviceMetricCalibrationType, error) {
+	return parseCode(s, deviceMetricCalibrationTypeDisplays)
+}
+
 // DeviceMetricCategory represents DeviceMetricCategory.
 type DeviceMetricCategory string
 
@@ -2270,6 +6620,39 @@ const (
 	DeviceMetricCategoryUnspecified DeviceMetricCategory = "unspecified"
 )
 
+// deviceMetricCategoryDisplays maps each DeviceMetricCategory code to its display name.
+var deviceMetricCategoryDisplays = map[DeviceMetricCategory]string{
+	DeviceMetricCategoryMeasurement: "Measurement",
+	DeviceMetricCategorySetting:     "Setting",
+	DeviceMetricCategoryCalculation: "Calculation",
+	DeviceMetricCategoryUnspecified: "Unspecified",
+}
+
+// Code returns the code value.
+func (c DeviceMetricCategory) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the DeviceMetricCategory values.
+func (c DeviceMetricCategory) IsValid() bool {
+	_, ok := deviceMetricCategoryDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c DeviceMetricCategory) Display() string {
+	if display := deviceMetricCategoryDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseDeviceMetricCategory returns the DeviceMetricCategory for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseDeviceMetricCategory(s string) (DeviceMetricCategory, error) {
+	return parseCode(s, deviceMetricCategoryDisplays)
+}
+
 // DeviceMetricColor represents DeviceMetricColor.
 type DeviceMetricColor string
 
@@ -2293,6 +6676,43 @@ const (
 	DeviceMetricColorWhite DeviceMetricColor = "white"
 )
 
+// deviceMetricColorDisplays maps each DeviceMetricColor code to its display name.
+var deviceMetricColorDisplays = map[DeviceMetricColor]string{
+	DeviceMetricColorBlack:   "Color Black",
+	DeviceMetricColorRed:     "Color Red",
+	DeviceMetricColorGreen:   "Color Green",
+	DeviceMetricColorYellow:  "Color Yellow",
+	DeviceMetricColorBlue:    "Color Blue",
+	DeviceMetricColorMagenta: "Color Magenta",
+	DeviceMetricColorCyan:    "Color Cyan",
+	DeviceMetricColorWhite:   "Color White",
+}
+
+// Code returns the code value.
+func (c DeviceMetricColor) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the DeviceMetricColor values.
+func (c DeviceMetricColor) IsValid() bool {
+	_, ok := deviceMetricColorDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c DeviceMetricColor) Display() string {
+	if display := deviceMetricColorDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseDeviceMetricColor returns the DeviceMetricColor for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseDeviceMetricColor(s string) (DeviceMetricColor, error) {
+	return parseCode(s, deviceMetricColorDisplays)
+}
+
 // DeviceMetricOperationalStatus represents DeviceMetricOperationalStatus.
 type DeviceMetricOperationalStatus string
 
@@ -2308,6 +6728,39 @@ const (
 	DeviceMetricOperationalStatusEnteredInError DeviceMetricOperationalStatus = "entered-in-error"
 )
 
+// deviceMetricOperationalStatusDisplays maps each DeviceMetricOperationalStatus code to its display name.
+var deviceMetricOperationalStatusDisplays = map[DeviceMetricOperationalStatus]string{
+	DeviceMetricOperationalStatusOn:             "On",
+	DeviceMetricOperationalStatusOff:            "Off",
+	DeviceMetricOperationalStatusStandby:        "Standby",
+	DeviceMetricOperationalStatusEnteredInError: "Entered In Error",
+}
+
+// Code returns the code value.
+func (c DeviceMetricOperationalStatus) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the DeviceMetricOperationalStatus values.
+func (c DeviceMetricOperationalStatus) IsValid() bool {
+	_, ok := deviceMetricOperationalStatusDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c DeviceMetricOperationalStatus) Display() string {
+	if display := deviceMetricOperationalStatusDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseDeviceMetricOperationalStatus returns the DeviceMetricOperationalStatus for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseDeviceMetricOperationalStatus(s string) (DeviceMetricOperationalStatus, error) {
+	return parseCode(s, deviceMetricOperationalStatusDisplays)
+}
+
 // NameUse represents NameUse.
 type NameUse string
 
@@ -2329,6 +6782,42 @@ const (
 	NameUseMaiden NameUse = "maiden"
 )
 
+// nameUseDisplays maps each NameUse code to its display name.
+var nameUseDisplays = map[NameUse]string{
+	NameUseUsual:     "Usual",
+	NameUseOfficial:  "Official",
+	NameUseTemp:      "Temp",
+	NameUseNickname:  "Nickname",
+	NameUseAnonymous: "Anonymous",
+	NameUseOld:       "Old",
+	NameUseMaiden:    "Name changed for Marriage",
+}
+
+// Code returns the code value.
+func (c NameUse) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the NameUse values.
+func (c NameUse) IsValid() bool {
+	_, ok := nameUseDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c NameUse) Display() string {
+	if display := nameUseDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseNameUse returns the NameUse for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseNameUse(s string) (NameUse, error) {
+	return parseCode(s, nameUseDisplays)
+}
+
 // NamingSystemIdentifierType represents NamingSystemIdentifierType.
 type NamingSystemIdentifierType string
 
@@ -2344,6 +6833,39 @@ const (
 	NamingSystemIdentifierTypeOther NamingSystemIdentifierType = "other"
 )
 
+// namingSystemIdentifierTypeDisplays maps each NamingSystemIdentifierType code to its display name.
+var namingSystemIdentifierTypeDisplays = map[NamingSystemIdentifierType]string{
+	NamingSystemIdentifierTypeOid:   "OID",
+	NamingSystemIdentifierTypeUuid:  "UUID",
+	NamingSystemIdentifierTypeUri:   "URI",
+	NamingSystemIdentifierTypeOther: "Other",
+}
+
+// Code returns the code value.
+func (c NamingSystemIdentifierType) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the NamingSystemIdentifierType values.
+func (c NamingSystemIdentifierType) IsValid() bool {
+	_, ok := namingSystemIdentifierTypeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c NamingSystemIdentifierType) Display() string {
+	if display := namingSystemIdentifierTypeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseNamingSystemIdentifierType returns the NamingSystemIdentifierType for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseNamingSystemIdentifierType(s string) (NamingSystemIdentifierType, error) {
+	return parseCode(s, namingSystemIdentifierTypeDisplays)
+}
+
 // NamingSystemType represents NamingSystemType.
 type NamingSystemType string
 
@@ -2357,6 +6879,38 @@ const (
 	NamingSystemTypeRoot NamingSystemType = "root"
 )
 
+// namingSystemTypeDisplays maps each NamingSystemType code to its display name.
+var namingSystemTypeDisplays = map[NamingSystemType]string{
+	NamingSystemTypeCodesystem: "Code System",
+	NamingSystemTypeIdentifier: "Identifier",
+	NamingSystemTypeRoot:       "Root",
+}
+
+// Code returns the code value.
+func (c NamingSystemType) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the NamingSystemType values.
+func (c NamingSystemType) IsValid() bool {
+	_, ok := namingSystemTypeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c NamingSystemType) Display() string {
+	if display := namingSystemTypeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseNamingSystemType returns the NamingSystemType for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseNamingSystemType(s string) (NamingSystemType, error) {
+	return parseCode(s, namingSystemTypeDisplays)
+}
+
 // NarrativeStatus represents NarrativeStatus.
 type NarrativeStatus string
 
@@ -2372,6 +6926,39 @@ const (
 	NarrativeStatusEmpty NarrativeStatus = "empty"
 )
 
+// narrativeStatusDisplays maps each NarrativeStatus code to its display name.
+var narrativeStatusDisplays = map[NarrativeStatus]string{
+	NarrativeStatusGenerated:  "Generated",
+	NarrativeStatusExtensions: "Extensions",
+	NarrativeStatusAdditional: "Additional",
+	NarrativeStatusEmpty:      "Empty",
+}
+
+// Code returns the code value.
+func (c NarrativeStatus) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the NarrativeStatus values.
+func (c NarrativeStatus) IsValid() bool {
+	_, ok := narrativeStatusDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c NarrativeStatus) Display() string {
+	if display := narrativeStatusDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseNarrativeStatus returns the NarrativeStatus for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseNarrativeStatus(s string) (NarrativeStatus, error) {
+	return parseCode(s, narrativeStatusDisplays)
+}
+
 // AuditEventAgentNetworkType represents AuditEventAgentNetworkType.
 type AuditEventAgentNetworkType string
 
@@ -2389,6 +6976,40 @@ const (
 	AuditEventAgentNetworkType5 AuditEventAgentNetworkType = "5"
 )
 
+// auditEventAgentNetworkTypeDisplays maps each AuditEventAgentNetworkType code to its display name.
+var auditEventAgentNetworkTypeDisplays = map[AuditEventAgentNetworkType]string{
+	AuditEventAgentNetworkType1: "Machine Name",
+	AuditEventAgentNetworkType2: "IP Address",
+	AuditEventAgentNetworkType3: "Telephone Number",
+	AuditEventAgentNetworkType4: "Email address",
+	AuditEventAgentNetworkType5: "URI",
+}
+
+// Code returns the code value.
+func (c AuditEventAgentNetworkType) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the AuditEventAgentNetworkType values.
+func (c AuditEventAgentNetworkType) IsValid() bool {
+	_, ok := auditEventAgentNetworkTypeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c AuditEventAgentNetworkType) Display() string {
+	if display := auditEventAgentNetworkTypeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseAuditEventAgentNetworkType returns the AuditEventAgentNetworkType for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseAuditEventAgentNetworkType(s string) (AuditEventAgentNetworkType, error) {
+	return parseCode(s, auditEventAgentNetworkTypeDisplays)
+}
+
 // NoteType represents NoteType.
 type NoteType string
 
@@ -2402,6 +7023,38 @@ const (
 	NoteTypePrintoper NoteType = "printoper"
 )
 
+// noteTypeDisplays maps each NoteType code to its display name.
+var noteTypeDisplays = map[NoteType]string{
+	NoteTypeDisplay:   "Display",
+	NoteTypePrint:     "Print (Form)",
+	NoteTypePrintoper: "Print (Operator)",
+}
+
+// Code returns the code value.
+func (c NoteType) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the NoteType values.
+func (c NoteType) IsValid() bool {
+	_, ok := noteTypeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c NoteType) Display() string {
+	if display := noteTypeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseNoteType returns the NoteType for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseNoteType(s string) (NoteType, error) {
+	return parseCode(s, noteTypeDisplays)
+}
+
 // ObservationRangeCategory represents ObservationRangeCategory.
 type ObservationRangeCategory string
 
@@ -2415,6 +7068,38 @@ const (
 	ObservationRangeCategoryAbsolute ObservationRangeCategory = "absolute"
 )
 
+// observationRangeCategoryDisplays maps each ObservationRangeCategory code to its display name.
+var observationRangeCategoryDisplays = map[ObservationRangeCategory]string{
+	ObservationRangeCategoryReference: "reference range",
+	ObservationRangeCategoryCritical:  "critical range",
+	ObservationRangeCategoryAbsolute:  "absolute range",
+}
+
+// Code returns the code value.
+func (c ObservationRangeCategory) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the ObservationRangeCategory values.
+func (c ObservationRangeCategory) IsValid() bool {
+	_, ok := observationRangeCategoryDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c ObservationRangeCategory) Display() string {
+	if display := observationRangeCategoryDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseObservationRangeCategory returns the ObservationRangeCategory for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseObservationRangeCategory(s string) (ObservationRangeCategory, error) {
+	return parseCode(s, observationRangeCategoryDisplays)
+}
+
 // ObservationStatus represents ObservationStatus.
 type ObservationStatus string
 
@@ -2438,6 +7123,43 @@ const (
 	ObservationStatusUnknown ObservationStatus = "unknown"
 )
 
+// observationStatusDisplays maps each ObservationStatus code to its display name.
+var observationStatusDisplays = map[ObservationStatus]string{
+	ObservationStatusRegistered:     "Registered",
+	ObservationStatusPreliminary:    "Preliminary",
+	ObservationStatusFinal:          "Final",
+	ObservationStatusAmended:        "Amended",
+	ObservationStatusCorrected:      "Corrected",
+	ObservationStatusCancelled:      "Cancelled",
+	ObservationStatusEnteredInError: "Entered in Error",
+	ObservationStatusUnknown:        "Unknown",
+}
+
+// Code returns the code value.
+func (c ObservationStatus) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the ObservationStatus values.
+func (c ObservationStatus) IsValid() bool {
+	_, ok := observationStatusDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c ObservationStatus) Display() string {
+	if display := observationStatusDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseObservationStatus returns the ObservationStatus for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseObservationStatus(s string) (ObservationStatus, error) {
+	return parseCode(s, observationStatusDisplays)
+}
+
 // OperationKind represents OperationKind.
 type OperationKind string
 
@@ -2449,6 +7171,37 @@ const (
 	OperationKindQuery OperationKind = "query"
 )
 
+// operationKindDisplays maps each OperationKind code to its display name.
+var operationKindDisplays = map[OperationKind]string{
+	OperationKindOperation: "Operation",
+	OperationKindQuery:     "Query",
+}
+
+// Code returns the code value.
+func (c OperationKind) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the OperationKind values.
+func (c OperationKind) IsValid() bool {
+	_, ok := operationKindDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c OperationKind) Display() string {
+	if display := operationKindDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseOperationKind returns the OperationKind for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseOperationKind(s string) (OperationKind, error) {
+	return parseCode(s, operationKindDisplays)
+}
+
 // OperationParameterUse represents OperationParameterUse.
 type OperationParameterUse string
 
@@ -2460,6 +7213,37 @@ const (
 	OperationParameterUseOut OperationParameterUse = "out"
 )
 
+// operationParameterUseDisplays maps each OperationParameterUse code to its display name.
+var operationParameterUseDisplays = map[OperationParameterUse]string{
+	OperationParameterUseIn:  "In",
+	OperationParameterUseOut: "Out",
+}
+
+// Code returns the code value.
+func (c OperationParameterUse) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the OperationParameterUse values.
+func (c OperationParameterUse) IsValid() bool {
+	_, ok := operationParameterUseDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c OperationParameterUse) Display() string {
+	if display := operationParameterUseDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseOperationParameterUse returns the OperationParameterUse for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseOperationParameterUse(s string) (OperationParameterUse, error) {
+	return parseCode(s, operationParameterUseDisplays)
+}
+
 // OrientationType represents orientationType.
 type OrientationType string
 
@@ -2471,6 +7255,37 @@ const (
 	OrientationTypeAntisense OrientationType = "antisense"
 )
 
+// orientationTypeDisplays maps each OrientationType code to its display name.
+var orientationTypeDisplays = map[OrientationType]string{
+	OrientationTypeSense:     "Sense orientation of referenceSeq",
+	OrientationTypeAntisense: "Antisense orientation of referenceSeq",
+}
+
+// Code returns the code value.
+func (c OrientationType) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the OrientationType values.
+func (c OrientationType) IsValid() bool {
+	_, ok := orientationTypeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c OrientationType) Display() string {
+	if display := orientationTypeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseOrientationType returns the OrientationType for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseOrientationType(s string) (OrientationType, error) {
+	return parseCode(s, orientationTypeDisplays)
+}
+
 // ParticipantRequired represents ParticipantRequired.
 type ParticipantRequired string
 
@@ -2484,6 +7299,38 @@ const (
 	ParticipantRequiredInformationOnly ParticipantRequired = "information-only"
 )
 
+// participantRequiredDisplays maps each ParticipantRequired code to its display name.
+var participantRequiredDisplays = map[ParticipantRequired]string{
+	ParticipantRequiredRequired:        "Required",
+	ParticipantRequiredOptional:        "Optional",
+	ParticipantRequiredInformationOnly: "Information Only",
+}
+
+// Code returns the code value.
+func (c ParticipantRequired) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the ParticipantRequired values.
+func (c ParticipantRequired) IsValid() bool {
+	_, ok := participantRequiredDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c ParticipantRequired) Display() string {
+	if display := participantRequiredDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseParticipantRequired returns the ParticipantRequired for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseParticipantRequired(s string) (ParticipantRequired, error) {
+	return parseCode(s, participantRequiredDisplays)
+}
+
 // ParticipationStatus represents ParticipationStatus.
 type ParticipationStatus string
 
@@ -2499,6 +7346,39 @@ const (
 	ParticipationStatusNeedsAction ParticipationStatus = "needs-action"
 )
 
+// participationStatusDisplays maps each ParticipationStatus code to its display name.
+var participationStatusDisplays = map[ParticipationStatus]string{
+	ParticipationStatusAccepted:    "Accepted",
+	ParticipationStatusDeclined:    "Declined",
+	ParticipationStatusTentative:   "Tentative",
+	ParticipationStatusNeedsAction: "Needs Action",
+}
+
+// Code returns the code value.
+func (c ParticipationStatus) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the ParticipationStatus values.
+func (c ParticipationStatus) IsValid() bool {
+	_, ok := participationStatusDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c ParticipationStatus) Display() string {
+	if display := participationStatusDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseParticipationStatus returns the ParticipationStatus for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseParticipationStatus(s string) (ParticipationStatus, error) {
+	return parseCode(s, participationStatusDisplays)
+}
+
 // ObservationDataType represents ObservationDataType.
 type ObservationDataType string
 
@@ -2528,6 +7408,46 @@ const (
 	ObservationDataTypePeriod ObservationDataType = "Period"
 )
 
+// observationDataTypeDisplays maps each ObservationDataType code to its display name.
+var observationDataTypeDisplays = map[ObservationDataType]string{
+	ObservationDataTypeQuantity:        "Quantity",
+	ObservationDataTypeCodeableconcept: "CodeableConcept",
+	ObservationDataTypeString:          "string",
+	ObservationDataTypeBoolean:         "boolean",
+	ObservationDataTypeInteger:         "integer",
+	ObservationDataTypeRange:           "Range",
+	ObservationDataTypeRatio:           "Ratio",
+	ObservationDataTypeSampleddata:     "SampledData",
+	ObservationDataTypeTime:            "time",
+	ObservationDataTypeDatetime:        "dateTime",
+	ObservationDataTypePeriod:          "Period",
+}
+
+// Code returns the code value.
+func (c ObservationDataType) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the ObservationDataType values.
+func (c ObservationDataType) IsValid() bool {
+	_, ok := observationDataTypeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c ObservationDataType) Display() string {
+	if display := observationDataTypeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseObservationDataType returns the ObservationDataType for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseObservationDataType(s string) (ObservationDataType, error) {
+	return parseCode(s, observationDataTypeDisplays)
+}
+
 // BiologicallyDerivedProductCategory represents BiologicallyDerivedProductCategory.
 type BiologicallyDerivedProductCategory string
 
@@ -2545,6 +7465,40 @@ const (
 	BiologicallyDerivedProductCategoryBiologicalagent BiologicallyDerivedProductCategory = "biologicalAgent"
 )
 
+// biologicallyDerivedProductCategoryDisplays maps each BiologicallyDerivedProductCategory code to its display name.
+var biologicallyDerivedProductCategoryDisplays = map[BiologicallyDerivedProductCategory]string{
+	BiologicallyDerivedProductCategoryOrgan:           "Organ",
+	BiologicallyDerivedProductCategoryTissue:          "Tissue",
+	BiologicallyDerivedProductCategoryFluid:           "Fluid",
+	BiologicallyDerivedProductCategoryCells:           "Cells",
+	BiologicallyDerivedProductCategoryBiologicalagent: "BiologicalAgent",
+}
+
+// Code returns the code value.
+func (c BiologicallyDerivedProductCategory) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the BiologicallyDerivedProductCategory values.
+func (c BiologicallyDerivedProductCategory) IsValid() bool {
+	_, ok := biologicallyDerivedProductCategoryDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c BiologicallyDerivedProductCategory) Display() string {
+	if display := biologicallyDerivedProductCategoryDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseBiologicallyDerivedProductCategory returns the BiologicallyDerivedProductCategory for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseBiologicallyDerivedProductCategory(s string) (BiologicallyDerivedProductCategory, error) {
+	return parseCode(s, biologicallyDerivedProductCategoryDisplays)
+}
+
 // BiologicallyDerivedProductStatus represents BiologicallyDerivedProductStatus.
 type BiologicallyDerivedProductStatus string
 
@@ -2556,6 +7510,37 @@ const (
 	BiologicallyDerivedProductStatusUnavailable BiologicallyDerivedProductStatus = "unavailable"
 )
 
+// biologicallyDerivedProductStatusDisplays maps each BiologicallyDerivedProductStatus code to its display name.
+var biologicallyDerivedProductStatusDisplays = map[BiologicallyDerivedProductStatus]string{
+	BiologicallyDerivedProductStatusAvailable:   "Available",
+	BiologicallyDerivedProductStatusUnavailable: "Unavailable",
+}
+
+// Code returns the code value.
+func (c BiologicallyDerivedProductStatus) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the BiologicallyDerivedProductStatus values.
+func (c BiologicallyDerivedProductStatus) IsValid() bool {
+	_, ok := biologicallyDerivedProductStatusDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c BiologicallyDerivedProductStatus) Display() string {
+	if display := biologicallyDerivedProductStatusDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseBiologicallyDerivedProductStatus returns the BiologicallyDerivedProductStatus for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseBiologicallyDerivedProductStatus(s string) (BiologicallyDerivedProductStatus, error) {
+	return parseCode(s, biologicallyDerivedProductStatusDisplays)
+}
+
 // BiologicallyDerivedProductStorageScale represents BiologicallyDerivedProductStorageScale.
 type BiologicallyDerivedProductStorageScale string
 
@@ -2569,6 +7554,38 @@ const (
 	BiologicallyDerivedProductStorageScaleKelvin BiologicallyDerivedProductStorageScale = "kelvin"
 )
 
+// biologicallyDerivedProductStorageScaleDisplays maps each BiologicallyDerivedProductStorageScale code to its display name.
+var biologicallyDerivedProductStorageScaleDisplays = map[BiologicallyDerivedProductStorageScale]string{
+	BiologicallyDerivedProductStorageScaleFarenheit: "Fahrenheit",
+	BiologicallyDerivedProductStorageScaleCelsius:   "Celsius",
+	BiologicallyDerivedProductStorageScaleKelvin:    "Kelvin",
+}
+
+// Code returns the code value.
+func (c BiologicallyDerivedProductStorageScale) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the BiologicallyDerivedProductStorageScale values.
+func (c BiologicallyDerivedProductStorageScale) IsValid() bool {
+	_, ok := biologicallyDerivedProductStorageScaleDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c BiologicallyDerivedProductStorageScale) Display() string {
+	if display := biologicallyDerivedProductStorageScaleDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseBiologicallyDerivedProductStorageScale returns the BiologicallyDerivedProductStorageScale for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseBiologicallyDerivedProductStorageScale(s string) (BiologicallyDerivedProductStorageScale, error) {
+	return parseCode(s, biologicallyDerivedProductStorageScaleDisplays)
+}
+
 // PropertyRepresentation represents PropertyRepresentation.
 type PropertyRepresentation string
 
@@ -2586,6 +7603,40 @@ const (
 	PropertyRepresentationXhtml PropertyRepresentation = "xhtml"
 )
 
+// propertyRepresentationDisplays maps each PropertyRepresentation code to its display name.
+var propertyRepresentationDisplays = map[PropertyRepresentation]string{
+	PropertyRepresentationXmlattr:  "XML Attribute",
+	PropertyRepresentationXmltext:  "XML Text",
+	PropertyRepresentationTypeattr: "Type Attribute",
+	PropertyRepresentationCdatext:  "CDA Text Format",
+	PropertyRepresentationXhtml:    "XHTML",
+}
+
+// Code returns the code value.
+func (c PropertyRepresentation) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the PropertyRepresentation values.
+func (c PropertyRepresentation) IsValid() bool {
+	_, ok := propertyRepresentationDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c PropertyRepresentation) Display() string {
+	if display := propertyRepresentationDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParsePropertyRepresentation returns the PropertyRepresentation for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParsePropertyRepresentation(s string) (PropertyRepresentation, error) {
+	return parseCode(s, propertyRepresentationDisplays)
+}
+
 // ProvenanceEntityRole represents ProvenanceEntityRole.
 type ProvenanceEntityRole string
 
@@ -2603,6 +7654,40 @@ const (
 	ProvenanceEntityRoleRemoval ProvenanceEntityRole = "removal"
 )
 
+// provenanceEntityRoleDisplays maps each ProvenanceEntityRole code to its display name.
+var provenanceEntityRoleDisplays = map[ProvenanceEntityRole]string{
+	ProvenanceEntityRoleDerivation: "Derivation",
+	ProvenanceEntityRoleRevision:   "Revision",
+	ProvenanceEntityRoleQuotation:  "Quotation",
+	ProvenanceEntityRoleSource:     "Source",
+	ProvenanceEntityRoleRemoval:    "Removal",
+}
+
+// Code returns the code value.
+func (c ProvenanceEntityRole) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the ProvenanceEntityRole values.
+func (c ProvenanceEntityRole) IsValid() bool {
+	_, ok := provenanceEntityRoleDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c ProvenanceEntityRole) Display() string {
+	if display := provenanceEntityRoleDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseProvenanceEntityRole returns the ProvenanceEntityRole for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseProvenanceEntityRole(s string) (ProvenanceEntityRole, error) {
+	return parseCode(s, provenanceEntityRoleDisplays)
+}
+
 // PublicationStatus represents PublicationStatus.
 type PublicationStatus string
 
@@ -2618,6 +7703,39 @@ const (
 	PublicationStatusUnknown PublicationStatus = "unknown"
 )
 
+// publicationStatusDisplays maps each PublicationStatus code to its display name.
+var publicationStatusDisplays = map[PublicationStatus]string{
+	PublicationStatusDraft:   "Draft",
+	PublicationStatusActive:  "Active",
+	PublicationStatusRetired: "Retired",
+	PublicationStatusUnknown: "Unknown",
+}
+
+// Code returns the code value.
+func (c PublicationStatus) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the PublicationStatus values.
+func (c PublicationStatus) IsValid() bool {
+	_, ok := publicationStatusDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c PublicationStatus) Display() string {
+	if display := publicationStatusDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParsePublicationStatus returns the PublicationStatus for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParsePublicationStatus(s string) (PublicationStatus, error) {
+	return parseCode(s, publicationStatusDisplays)
+}
+
 // QualityType represents qualityType.
 type QualityType string
 
@@ -2631,6 +7749,38 @@ const (
 	QualityTypeUnknown QualityType = "unknown"
 )
 
+// qualityTypeDisplays maps each QualityType code to its display name.
+var qualityTypeDisplays = map[QualityType]string{
+	QualityTypeIndel:   "INDEL Comparison",
+	QualityTypeSnp:     "SNP Comparison",
+	QualityTypeUnknown: "UNKNOWN Comparison",
+}
+
+// Code returns the code value.
+func (c QualityType) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the QualityType values.
+func (c QualityType) IsValid() bool {
+	_, ok := qualityTypeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c QualityType) Display() string {
+	if display := qualityTypeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseQualityType returns the QualityType for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseQualityType(s string) (QualityType, error) {
+	return parseCode(s, qualityTypeDisplays)
+}
+
 // QuantityComparator represents QuantityComparator.
 type QuantityComparator string
 
@@ -2646,6 +7796,39 @@ const (
 	QuantityComparatorGreaterThan QuantityComparator = ">"
 )
 
+// quantityComparatorDisplays maps each QuantityComparator code to its display name.
+var quantityComparatorDisplays = map[QuantityComparator]string{
+	QuantityComparatorLessThan:       "Less than",
+	QuantityComparatorLessOrEqual:    "Less or Equal to",
+	QuantityComparatorGreaterOrEqual: "Greater or Equal to",
+	QuantityComparatorGreaterThan:    "Greater than",
+}
+
+// Code returns the code value.
+func (c QuantityComparator) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the QuantityComparator values.
+func (c QuantityComparator) IsValid() bool {
+	_, ok := quantityComparatorDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c QuantityComparator) Display() string {
+	if display := quantityComparatorDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseQuantityComparator returns the QuantityComparator for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseQuantityComparator(s string) (QuantityComparator, error) {
+	return parseCode(s, quantityComparatorDisplays)
+}
+
 // QuestionnaireResponseStatus represents QuestionnaireResponseStatus.
 type QuestionnaireResponseStatus string
 
@@ -2663,6 +7846,40 @@ const (
 	QuestionnaireResponseStatusStopped QuestionnaireResponseStatus = "stopped"
 )
 
+// questionnaireResponseStatusDisplays maps each QuestionnaireResponseStatus code to its display name.
+var questionnaireResponseStatusDisplays = map[QuestionnaireResponseStatus]string{
+	QuestionnaireResponseStatusInProgress:     "In Progress",
+	QuestionnaireResponseStatusCompleted:      "Completed",
+	QuestionnaireResponseStatusAmended:        "Amended",
+	QuestionnaireResponseStatusEnteredInError: "Entered in Error",
+	QuestionnaireResponseStatusStopped:        "Stopped",
+}
+
+// Code returns the code value.
+func (c QuestionnaireResponseStatus) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the QuestionnaireResponseStatus values.
+func (c QuestionnaireResponseStatus) IsValid() bool {
+	_, ok := questionnaireResponseStatusDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c QuestionnaireResponseStatus) Display() string {
+	if display := questionnaireResponseStatusDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseQuestionnaireResponseStatus returns the QuestionnaireResponseStatus for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseQuestionnaireResponseStatus(s string) (QuestionnaireResponseStatus, error) {
+	return parseCode(s, questionnaireResponseStatusDisplays)
+}
+
 // EnableWhenBehavior represents EnableWhenBehavior.
 type EnableWhenBehavior string
 
@@ -2674,6 +7891,37 @@ const (
 	EnableWhenBehaviorAny EnableWhenBehavior = "any"
 )
 
+// enableWhenBehaviorDisplays maps each EnableWhenBehavior code to its display name.
+var enableWhenBehaviorDisplays = map[EnableWhenBehavior]string{
+	EnableWhenBehaviorAll: "All",
+	EnableWhenBehaviorAny: "Any",
+}
+
+// Code returns the code value.
+func (c EnableWhenBehavior) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the EnableWhenBehavior values.
+func (c EnableWhenBehavior) IsValid() bool {
+	_, ok := enableWhenBehaviorDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c EnableWhenBehavior) Display() string {
+	if display := enableWhenBehaviorDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseEnableWhenBehavior returns the EnableWhenBehavior for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseEnableWhenBehavior(s string) (EnableWhenBehavior, error) {
+	return parseCode(s, enableWhenBehaviorDisplays)
+}
+
 // QuestionnaireItemOperator represents QuestionnaireItemOperator.
 type QuestionnaireItemOperator string
 
@@ -2695,6 +7943,42 @@ const (
 	QuestionnaireItemOperatorLessOrEqual QuestionnaireItemOperator = "<="
 )
 
+// questionnaireItemOperatorDisplays maps each QuestionnaireItemOperator code to its display name.
+var questionnaireItemOperatorDisplays = map[QuestionnaireItemOperator]string{
+	QuestionnaireItemOperatorExists:         "Exists",
+	QuestionnaireItemOperatorEqual:          "Equals",
+	QuestionnaireItemOperatorNotEqual:       "Not Equals",
+	QuestionnaireItemOperatorGreaterThan:    "Greater Than",
+	QuestionnaireItemOperatorLessThan:       "Less Than",
+	QuestionnaireItemOperatorGreaterOrEqual: "Greater or Equals",
+	QuestionnaireItemOperatorLessOrEqual:    "Less or Equals",
+}
+
+// Code returns the code value.
+func (c QuestionnaireItemOperator) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the QuestionnaireItemOperator values.
+func (c QuestionnaireItemOperator) IsValid() bool {
+	_, ok := questionnaireItemOperatorDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c QuestionnaireItemOperator) Display() string {
+	if display := questionnaireItemOperatorDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseQuestionnaireItemOperator returns the QuestionnaireItemOperator for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseQuestionnaireItemOperator(s string) (QuestionnaireItemOperator, error) {
+	return parseCode(s, questionnaireItemOperatorDisplays)
+}
+
 // AllergyIntoleranceSeverity represents AllergyIntoleranceSeverity.
 type AllergyIntoleranceSeverity string
 
@@ -2708,6 +7992,38 @@ const (
 	AllergyIntoleranceSeveritySevere AllergyIntoleranceSeverity = "severe"
 )
 
+// allergyIntoleranceSeverityDisplays maps each AllergyIntoleranceSeverity code to its display name.
+var allergyIntoleranceSeverityDisplays = map[AllergyIntoleranceSeverity]string{
+	AllergyIntoleranceSeverityMild:     "Mild",
+	AllergyIntoleranceSeverityModerate: "Moderate",
+	AllergyIntoleranceSeveritySevere:   "Severe",
+}
+
+// Code returns the code value.
+func (c AllergyIntoleranceSeverity) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the AllergyIntoleranceSeverity values.
+func (c AllergyIntoleranceSeverity) IsValid() bool {
+	_, ok := allergyIntoleranceSeverityDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c AllergyIntoleranceSeverity) Display() string {
+	if display := allergyIntoleranceSeverityDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseAllergyIntoleranceSeverity returns the AllergyIntoleranceSeverity for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseAllergyIntoleranceSeverity(s string) (AllergyIntoleranceSeverity, error) {
+	return parseCode(s, allergyIntoleranceSeverityDisplays)
+}
+
 // ReferenceHandlingPolicy represents ReferenceHandlingPolicy.
 type ReferenceHandlingPolicy string
 
@@ -2725,6 +8041,40 @@ const (
 	ReferenceHandlingPolicyLocal ReferenceHandlingPolicy = "local"
 )
 
+// referenceHandlingPolicyDisplays maps each ReferenceHandlingPolicy code to its display name.
+var referenceHandlingPolicyDisplays = map[ReferenceHandlingPolicy]string{
+	ReferenceHandlingPolicyLiteral:  "Literal References",
+	ReferenceHandlingPolicyLogical:  "Logical References",
+	ReferenceHandlingPolicyResolves: "Resolves References",
+	ReferenceHandlingPolicyEnforced: "Reference Integrity Enforced",
+	ReferenceHandlingPolicyLocal:    "Local References Only",
+}
+
+// Code returns the code value.
+func (c ReferenceHandlingPolicy) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the ReferenceHandlingPolicy values.
+func (c ReferenceHandlingPolicy) IsValid() bool {
+	_, ok := referenceHandlingPolicyDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c ReferenceHandlingPolicy) Display() string {
+	if display := referenceHandlingPolicyDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseReferenceHandlingPolicy returns the ReferenceHandlingPolicy for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseReferenceHandlingPolicy(s string) (ReferenceHandlingPolicy, error) {
+	return parseCode(s, referenceHandlingPolicyDisplays)
+}
+
 // ReferenceVersionRules represents ReferenceVersionRules.
 type ReferenceVersionRules string
 
@@ -2738,6 +8088,38 @@ const (
 	ReferenceVersionRulesSpecific ReferenceVersionRules = "specific"
 )
 
+// referenceVersionRulesDisplays maps each ReferenceVersionRules code to its display name.
+var referenceVersionRulesDisplays = map[ReferenceVersionRules]string{
+	ReferenceVersionRulesEither:      "Either Specific or independent",
+	ReferenceVersionRulesIndependent: "Version independent",
+	ReferenceVersionRulesSpecific:    "Version Specific",
+}
+
+// Code returns the code value.
+func (c ReferenceVersionRules) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the ReferenceVersionRules values.
+func (c ReferenceVersionRules) IsValid() bool {
+	_, ok := referenceVersionRulesDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c ReferenceVersionRules) Display() string {
+	if display := referenceVersionRulesDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseReferenceVersionRules returns the ReferenceVersionRules for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseReferenceVersionRules(s string) (ReferenceVersionRules, error) {
+	return parseCode(s, referenceVersionRulesDisplays)
+}
+
 // RelatedArtifactType represents RelatedArtifactType.
 type RelatedArtifactType string
 
@@ -2761,6 +8143,43 @@ const (
 	RelatedArtifactTypeComposedOf RelatedArtifactType = "composed-of"
 )
 
+// relatedArtifactTypeDisplays maps each RelatedArtifactType code to its display name.
+var relatedArtifactTypeDisplays = map[RelatedArtifactType]string{
+	RelatedArtifactTypeDocumentation: "Documentation",
+	RelatedArtifactTypeJustification: "Justification",
+	RelatedArtifactTypeCitation:      "Citation",
+	RelatedArtifactTypePredecessor:   "Predecessor",
+	RelatedArtifactTypeSuccessor:     "Successor",
+	RelatedArtifactTypeDerivedFrom:   "Derived From",
+	RelatedArtifactTypeDependsOn:     "Depends On",
+	RelatedArtifactTypeComposedOf:    "Composed Of",
+}
+
+// Code returns the code value.
+func (c RelatedArtifactType) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the RelatedArtifactType values.
+func (c RelatedArtifactType) IsValid() bool {
+	_, ok := relatedArtifactTypeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c RelatedArtifactType) Display() string {
+	if display := relatedArtifactTypeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseRelatedArtifactType returns the RelatedArtifactType for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseRelatedArtifactType(s string) (RelatedArtifactType, error) {
+	return parseCode(s, relatedArtifactTypeDisplays)
+}
+
 // CatalogEntryRelationType represents CatalogEntryRelationType.
 type CatalogEntryRelationType string
 
@@ -2772,6 +8191,37 @@ const (
 	CatalogEntryRelationTypeIsReplacedBy CatalogEntryRelationType = "is-replaced-by"
 )
 
+// catalogEntryRelationTypeDisplays maps each CatalogEntryRelationType code to its display name.
+var catalogEntryRelationTypeDisplays = map[CatalogEntryRelationType]string{
+	CatalogEntryRelationTypeTriggers:     "Triggers",
+	CatalogEntryRelationTypeIsReplacedBy: "Replaced By",
+}
+
+// Code returns the code value.
+func (c CatalogEntryRelationType) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the CatalogEntryRelationType values.
+func (c CatalogEntryRelationType) IsValid() bool {
+	_, ok := catalogEntryRelationTypeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c CatalogEntryRelationType) Display() string {
+	if display := catalogEntryRelationTypeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseCatalogEntryRelationType returns the CatalogEntryRelationType for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseCatalogEntryRelationType(s string) (CatalogEntryRelationType, error) {
+	return parseCode(s, catalogEntryRelationTypeDisplays)
+}
+
 // ClaimProcessingCodes represents Claim Processing Codes.
 type ClaimProcessingCodes string
 
@@ -2787,6 +8237,39 @@ const (
 	ClaimProcessingCodesPartial ClaimProcessingCodes = "partial"
 )
 
+// claimProcessingCodesDisplays maps each ClaimProcessingCodes code to its display name.
+var claimProcessingCodesDisplays = map[ClaimProcessingCodes]string{
+	ClaimProcessingCodesQueued:   "Queued",
+	ClaimProcessingCodesComplete: "Processing Complete",
+	ClaimProcessingCodesError:    "Error",
+	ClaimProcessingCodesPartial:  "Partial Processing",
+}
+
+// Code returns the code value.
+func (c ClaimProcessingCodes) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the ClaimProcessingCodes values.
+func (c ClaimProcessingCodes) IsValid() bool {
+	_, ok := claimProcessingCodesDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c ClaimProcessingCodes) Display() string {
+	if display := claimProcessingCodesDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseClaimProcessingCodes returns the ClaimProcessingCodes for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseClaimProcessingCodes(s string) (ClaimProcessingCodes, error) {
+	return parseCode(s, claimProcessingCodesDisplays)
+}
+
 // TestReportActionResult represents TestReportActionResult.
 type TestReportActionResult string
 
@@ -2804,6 +8287,40 @@ const (
 	TestReportActionResultError TestReportActionResult = "error"
 )
 
+// testReportActionResultDisplays maps each TestReportActionResult code to its display name.
+var testReportActionResultDisplays = map[TestReportActionResult]string{
+	TestReportActionResultPass:    "Pass",
+	TestReportActionResultSkip:    "Skip",
+	TestReportActionResultFail:    "Fail",
+	TestReportActionResultWarning: "Warning",
+	TestReportActionResultError:   "Error",
+}
+
+// Code returns the code value.
+func (c TestReportActionResult) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the TestReportActionResult values.
+func (c TestReportActionResult) IsValid() bool {
+	_, ok := testReportActionResultDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c TestReportActionResult) Display() string {
+	if display := testReportActionResultDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseTestReportActionResult returns the TestReportActionResult for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseTestReportActionResult(s string) (TestReportActionResult, error) {
+	return parseCode(s, testReportActionResultDisplays)
+}
+
 // TestReportParticipantType represents TestReportParticipantType.
 type TestReportParticipantType string
 
@@ -2817,6 +8334,38 @@ const (
 	TestReportParticipantTypeServer TestReportParticipantType = "server"
 )
 
+// testReportParticipantTypeDisplays maps each TestReportParticipantType code to its display name.
+var testReportParticipantTypeDisplays = map[TestReportParticipantType]string{
+	TestReportParticipantTypeTestEngine: "Test Engine",
+	TestReportParticipantTypeClient:     "Client",
+	TestReportParticipantTypeServer:     "Server",
+}
+
+// Code returns the code value.
+func (c TestReportParticipantType) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the TestReportParticipantType values.
+func (c TestReportParticipantType) IsValid() bool {
+	_, ok := testReportParticipantTypeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c TestReportParticipantType) Display() string {
+	if display := testReportParticipantTypeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseTestReportParticipantType returns the TestReportParticipantType for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseTestReportParticipantType(s string) (TestReportParticipantType, error) {
+	return parseCode(s, testReportParticipantTypeDisplays)
+}
+
 // TestReportResult represents TestReportResult.
 type TestReportResult string
 
@@ -2830,6 +8379,38 @@ const (
 	TestReportResultPending TestReportResult = "pending"
 )
 
+// testReportResultDisplays maps each TestReportResult code to its display name.
+var testReportResultDisplays = map[TestReportResult]string{
+	TestReportResultPass:    "Pass",
+	TestReportResultFail:    "Fail",
+	TestReportResultPending: "Pending",
+}
+
+// Code returns the code value.
+func (c TestReportResult) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the TestReportResult values.
+func (c TestReportResult) IsValid() bool {
+	_, ok := testReportResultDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c TestReportResult) Display() string {
+	if display := testReportResultDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseTestReportResult returns the TestReportResult for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseTestReportResult(s string) (TestReportResult, error) {
+	return parseCode(s, testReportResultDisplays)
+}
+
 // TestReportStatus represents TestReportStatus.
 type TestReportStatus string
 
@@ -2847,6 +8428,40 @@ const (
 	TestReportStatusEnteredInError TestReportStatus = "entered-in-error"
 )
 
+// testReportStatusDisplays maps each TestReportStatus code to its display name.
+var testReportStatusDisplays = map[TestReportStatus]string{
+	TestReportStatusCompleted:      "Completed",
+	TestReportStatusInProgress:     "In Progress",
+	TestReportStatusWaiting:        "Waiting",
+	TestReportStatusStopped:        "Stopped",
+	TestReportStatusEnteredInError: "Entered In Error",
+}
+
+// Code returns the code value.
+func (c TestReportStatus) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the TestReportStatus values.
+func (c TestReportStatus) IsValid() bool {
+	_, ok := testReportStatusDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c TestReportStatus) Display() string {
+	if display := testReportStatusDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseTestReportStatus returns the TestReportStatus for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseTestReportStatus(s string) (TestReportStatus, error) {
+	return parseCode(s, testReportStatusDisplays)
+}
+
 // RepositoryType represents repositoryType.
 type RepositoryType string
 
@@ -2864,6 +8479,40 @@ const (
 	RepositoryTypeOther RepositoryType = "other"
 )
 
+// repositoryTypeDisplays maps each RepositoryType code to its display name.
+var repositoryTypeDisplays = map[RepositoryType]string{
+	RepositoryTypeDirectlink: "Click and see",
+	RepositoryTypeOpenapi:    "The URL is the RESTful or other kind of API that can access to the result.",
+	RepositoryTypeLogin:      "Result cannot be access unless an account is logged in",
+	RepositoryTypeOauth:      "Result need to be fetched with API and need LOGIN( or cookies are required when visiting the link of resource)",
+	RepositoryTypeOther:      "Some other complicated or particular way to get resource from URL.",
+}
+
+// Code returns the code value.
+func (c RepositoryType) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the RepositoryType values.
+func (c RepositoryType) IsValid() bool {
+	_, ok := repositoryTypeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c RepositoryType) Display() string {
+	if display := repositoryTypeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseRepositoryType returns the RepositoryType for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseRepositoryType(s string) (RepositoryType, error) {
+	return parseCode(s, repositoryTypeDisplays)
+}
+
 // RequestIntent represents RequestIntent.
 type RequestIntent string
 
@@ -2889,6 +8538,44 @@ const (
 	RequestIntentOption RequestIntent = "option"
 )
 
+// requestIntentDisplays maps each RequestIntent code to its display name.
+var requestIntentDisplays = map[RequestIntent]string{
+	RequestIntentProposal:      "Proposal",
+	RequestIntentPlan:          "Plan",
+	RequestIntentDirective:     "Directive",
+	RequestIntentOrder:         "Order",
+	RequestIntentOriginalOrder: "Original Order",
+	RequestIntentReflexOrder:   "Reflex Order",
+	RequestIntentFillerOrder:   "Filler Order",
+	RequestIntentInstanceOrder: "Instance Order",
+	RequestIntentOption:        "Option",
+}
+
+// Code returns the code value.
+func (c RequestIntent) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the RequestIntent values.
+func (c RequestIntent) IsValid() bool {
+	_, ok := requestIntentDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c RequestIntent) Display() string {
+	if display := requestIntentDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseRequestIntent returns the RequestIntent for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseRequestIntent(s string) (RequestIntent, error) {
+	return parseCode(s, requestIntentDisplays)
+}
+
 // RequestPriority represents Request priority.
 type RequestPriority string
 
@@ -2904,6 +8591,39 @@ const (
 	RequestPriorityStat RequestPriority = "stat"
 )
 
+// requestPriorityDisplays maps each RequestPriority code to its display name.
+var requestPriorityDisplays = map[RequestPriority]string{
+	RequestPriorityRoutine: "Routine",
+	RequestPriorityUrgent:  "Urgent",
+	RequestPriorityAsap:    "ASAP",
+	RequestPriorityStat:    "STAT",
+}
+
+// Code returns the code value.
+func (c RequestPriority) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the RequestPriority values.
+func (c RequestPriority) IsValid() bool {
+	_, ok := requestPriorityDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c RequestPriority) Display() string {
+	if display := requestPriorityDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseRequestPriority returns the RequestPriority for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseRequestPriority(s string) (RequestPriority, error) {
+	return parseCode(s, requestPriorityDisplays)
+}
+
 // RequestResourceType represents RequestResourceType.
 type RequestResourceType string
 
@@ -2941,6 +8661,50 @@ const (
 	RequestResourceTypeVisionprescription RequestResourceType = "VisionPrescription"
 )
 
+// requestResourceTypeDisplays maps each RequestResourceType code to its display name.
+var requestResourceTypeDisplays = map[RequestResourceType]string{
+	RequestResourceTypeAppointment:                "Appointment",
+	RequestResourceTypeAppointmentresponse:        "AppointmentResponse",
+	RequestResourceTypeCareplan:                   "CarePlan",
+	RequestResourceTypeClaim:                      "Claim",
+	RequestResourceTypeCommunicationrequest:       "CommunicationRequest",
+	RequestResourceTypeContract:                   "Contract",
+	RequestResourceTypeDevicerequest:              "DeviceRequest",
+	RequestResourceTypeEnrollmentrequest:          "EnrollmentRequest",
+	RequestResourceTypeImmunizationrecommendation: "ImmunizationRecommendation",
+	RequestResourceTypeMedicationrequest:          "MedicationRequest",
+	RequestResourceTypeNutritionorder:             "NutritionOrder",
+	RequestResourceTypeServicerequest:             "ServiceRequest",
+	RequestResourceTypeSupplyrequest:              "SupplyRequest",
+	RequestResourceTypeTask:                       "Task",
+	RequestResourceTypeVisionprescription:         "VisionPrescription",
+}
+
+// Code returns the code value.
+func (c RequestResourceType) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the RequestResourceType values.
+func (c RequestResourceType) IsValid() bool {
+	_, ok := requestResourceTypeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c RequestResourceType) Display() string {
+	if display := requestResourceTypeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseRequestResourceType returns the RequestResourceType for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseRequestResourceType(s string) (RequestResourceType, error) {
+	return parseCode(s, requestResourceTypeDisplays)
+}
+
 // RequestStatus represents RequestStatus.
 type RequestStatus string
 
@@ -2962,6 +8726,42 @@ const (
 	RequestStatusUnknown RequestStatus = "unknown"
 )
 
+// requestStatusDisplays maps each RequestStatus code to its display name.
+var requestStatusDisplays = map[RequestStatus]string{
+	RequestStatusDraft:          "Draft",
+	RequestStatusActive:         "Active",
+	RequestStatusOnHold:         "On Hold",
+	RequestStatusRevoked:        "Revoked",
+	RequestStatusCompleted:      "Completed",
+	RequestStatusEnteredInError: "Entered in Error",
+	RequestStatusUnknown:        "Unknown",
+}
+
+// Code returns the code value.
+func (c RequestStatus) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the RequestStatus values.
+func (c RequestStatus) IsValid() bool {
+	_, ok := requestStatusDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c RequestStatus) Display() string {
+	if display := requestStatusDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseRequestStatus returns the RequestStatus for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseRequestStatus(s string) (RequestStatus, error) {
+	return parseCode(s, requestStatusDisplays)
+}
+
 // ResearchElementType represents ResearchElementType.
 type ResearchElementType string
 
@@ -2975,6 +8775,38 @@ const (
 	ResearchElementTypeOutcome ResearchElementType = "outcome"
 )
 
+// researchElementTypeDisplays maps each ResearchElementType code to its display name.
+var researchElementTypeDisplays = map[ResearchElementType]string{
+	ResearchElementTypePopulation: "Population",
+	ResearchElementTypeExposure:   "Exposure",
+	ResearchElementTypeOutcome:    "Outcome",
+}
+
+// Code returns the code value.
+func (c ResearchElementType) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the ResearchElementType values.
+func (c ResearchElementType) IsValid() bool {
+	_, ok := researchElementTypeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c ResearchElementType) Display() string {
+	if display := researchElementTypeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseResearchElementType returns the ResearchElementType for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseResearchElementType(s string) (ResearchElementType, error) {
+	return parseCode(s, researchElementTypeDisplays)
+}
+
 // ResearchStudyStatus represents ResearchStudyStatus.
 type ResearchStudyStatus string
 
@@ -3004,6 +8836,46 @@ const (
 	ResearchStudyStatusWithdrawn ResearchStudyStatus = "withdrawn"
 )
 
+// researchStudyStatusDisplays maps each ResearchStudyStatus code to its display name.
+var researchStudyStatusDisplays = map[ResearchStudyStatus]string{
+	ResearchStudyStatusActive:                                    "Active",
+	ResearchStudyStatusAdministrativelyCompleted:                 "Administratively Completed",
+	ResearchStudyStatusApproved:                                  "Approved",
+	ResearchStudyStatusClosedToAccrual:                           "Closed to Accrual",
+	ResearchStudyStatusClosedToAccrualAndIntervention:            "Closed to Accrual and Intervention",
+	ResearchStudyStatusCompleted:                                 "Completed",
+	ResearchStudyStatusDisapproved:                               "Disapproved",
+	ResearchStudyStatusInReview:                                  "In Review",
+	ResearchStudyStatusTemporarilyClosedToAccrual:                "Temporarily Closed to Accrual",
+	ResearchStudyStatusTemporarilyClosedToAccrualAndIntervention: "Temporarily Closed to Accrual and Intervention",
+	ResearchStudyStatusWithdrawn:                                 "Withdrawn",
+}
+
+// Code returns the code value.
+func (c ResearchStudyStatus) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the ResearchStudyStatus values.
+func (c ResearchStudyStatus) IsValid() bool {
+	_, ok := researchStudyStatusDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c ResearchStudyStatus) Display() string {
+	if display := researchStudyStatusDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseResearchStudyStatus returns the ResearchStudyStatus for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseResearchStudyStatus(s string) (ResearchStudyStatus, error) {
+	return parseCode(s, researchStudyStatusDisplays)
+}
+
 // ResearchSubjectStatus represents ResearchSubjectStatus.
 type ResearchSubjectStatus string
 
@@ -3037,6 +8909,48 @@ const (
 	ResearchSubjectStatusWithdrawn ResearchSubjectStatus = "withdrawn"
 )
 
+// researchSubjectStatusDisplays maps each ResearchSubjectStatus code to its display name.
+var researchSubjectStatusDisplays = map[ResearchSubjectStatus]string{
+	ResearchSubjectStatusCandidate:           "Candidate",
+	ResearchSubjectStatusEligible:            "Eligible",
+	ResearchSubjectStatusFollowUp:            "Follow-up",
+	ResearchSubjectStatusIneligible:          "Ineligible",
+	ResearchSubjectStatusNotRegistered:       "Not Registered",
+	ResearchSubjectStatusOffStudy:            "Off-study",
+	ResearchSubjectStatusOnStudy:             "On-study",
+	ResearchSubjectStatusOnStudyIntervention: "On-study-intervention",
+	ResearchSubjectStatusOnStudyObservation:  "On-study-observation",
+	ResearchSubjectStatusPendingOnStudy:      "Pending on-study",
+	ResearchSubjectStatusPotentialCandidate:  "Potential Candidate",
+	ResearchSubjectStatusScreening:           "Screening",
+	ResearchSubjectStatusWithdrawn:           "Withdrawn",
+}
+
+// Code returns the code value.
+func (c ResearchSubjectStatus) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the ResearchSubjectStatus values.
+func (c ResearchSubjectStatus) IsValid() bool {
+	_, ok := researchSubjectStatusDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c ResearchSubjectStatus) Display() string {
+	if display := researchSubjectStatusDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseResearchSubjectStatus returns the ResearchSubjectStatus for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseResearchSubjectStatus(s string) (ResearchSubjectStatus, error) {
+	return parseCode(s, researchSubjectStatusDisplays)
+}
+
 // AggregationMode represents AggregationMode.
 type AggregationMode string
 
@@ -3050,6 +8964,38 @@ const (
 	AggregationModeBundled AggregationMode = "bundled"
 )
 
+// aggregationModeDisplays maps each AggregationMode code to its display name.
+var aggregationModeDisplays = map[AggregationMode]string{
+	AggregationModeContained:  "Contained",
+	AggregationModeReferenced: "Referenced",
+	AggregationModeBundled:    "Bundled",
+}
+
+// Code returns the code value.
+func (c AggregationMode) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the AggregationMode values.
+func (c AggregationMode) IsValid() bool {
+	_, ok := aggregationModeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c AggregationMode) Display() string {
+	if display := aggregationModeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseAggregationMode returns the AggregationMode for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseAggregationMode(s string) (AggregationMode, error) {
+	return parseCode(s, aggregationModeDisplays)
+}
+
 // SlicingRules represents SlicingRules.
 type SlicingRules string
 
@@ -3063,6 +9009,38 @@ const (
 	SlicingRulesOpenatend SlicingRules = "openAtEnd"
 )
 
+// slicingRulesDisplays maps each SlicingRules code to its display name.
+var slicingRulesDisplays = map[SlicingRules]string{
+	SlicingRulesClosed:    "Closed",
+	SlicingRulesOpen:      "Open",
+	SlicingRulesOpenatend: "Open at End",
+}
+
+// Code returns the code value.
+func (c SlicingRules) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the SlicingRules values.
+func (c SlicingRules) IsValid() bool {
+	_, ok := slicingRulesDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c SlicingRules) Display() string {
+	if display := slicingRulesDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseSlicingRules returns the SlicingRules for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseSlicingRules(s string) (SlicingRules, error) {
+	return parseCode(s, slicingRulesDisplays)
+}
+
 // ResponseType represents ResponseType.
 type ResponseType string
 
@@ -3076,6 +9054,38 @@ const (
 	ResponseTypeFatalError ResponseType = "fatal-error"
 )
 
+// responseTypeDisplays maps each ResponseType code to its display name.
+var responseTypeDisplays = map[ResponseType]string{
+	ResponseTypeOk:             "OK",
+	ResponseTypeTransientError: "Transient Error",
+	ResponseTypeFatalError:     "Fatal Error",
+}
+
+// Code returns the code value.
+func (c ResponseType) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the ResponseType values.
+func (c ResponseType) IsValid() bool {
+	_, ok := responseTypeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c ResponseType) Display() string {
+	if display := responseTypeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseResponseType returns the ResponseType for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseResponseType(s string) (ResponseType, error) {
+	return parseCode(s, responseTypeDisplays)
+}
+
 // RestfulCapabilityMode represents RestfulCapabilityMode.
 type RestfulCapabilityMode string
 
@@ -3087,6 +9097,37 @@ const (
 	RestfulCapabilityModeServer RestfulCapabilityMode = "server"
 )
 
+// restfulCapabilityModeDisplays maps each RestfulCapabilityMode code to its display name.
+var restfulCapabilityModeDisplays = map[RestfulCapabilityMode]string{
+	RestfulCapabilityModeClient: "Client",
+	RestfulCapabilityModeServer: "Server",
+}
+
+// Code returns the code value.
+func (c RestfulCapabilityMode) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the RestfulCapabilityMode values.
+func (c RestfulCapabilityMode) IsValid() bool {
+	_, ok := restfulCapabilityModeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c RestfulCapabilityMode) Display() string {
+	if display := restfulCapabilityModeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseRestfulCapabilityMode returns the RestfulCapabilityMode for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseRestfulCapabilityMode(s string) (RestfulCapabilityMode, error) {
+	return parseCode(s, restfulCapabilityModeDisplays)
+}
+
 // SearchComparator represents SearchComparator.
 type SearchComparator string
 
@@ -3112,6 +9153,44 @@ const (
 	SearchComparatorAp SearchComparator = "ap"
 )
 
+// searchComparatorDisplays maps each SearchComparator code to its display name.
+var searchComparatorDisplays = map[SearchComparator]string{
+	SearchComparatorEq: "Equals",
+	SearchComparatorNe: "Not Equals",
+	SearchComparatorGt: "Greater Than",
+	SearchComparatorLt: "Less Than",
+	SearchComparatorGe: "Greater or Equals",
+	SearchComparatorLe: "Less of Equal",
+	SearchComparatorSa: "Starts After",
+	SearchComparatorEb: "Ends Before",
+	SearchComparatorAp: "Approximately",
+}
+
+// Code returns the code value.
+func (c SearchComparator) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the SearchComparator values.
+func (c SearchComparator) IsValid() bool {
+	_, ok := searchComparatorDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c SearchComparator) Display() string {
+	if display := searchComparatorDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseSearchComparator returns the SearchComparator for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseSearchComparator(s string) (SearchComparator, error) {
+	return parseCode(s, searchComparatorDisplays)
+}
+
 // SearchEntryMode represents SearchEntryMode.
 type SearchEntryMode string
 
@@ -3125,6 +9204,38 @@ const (
 	SearchEntryModeOutcome SearchEntryMode = "outcome"
 )
 
+// searchEntryModeDisplays maps each SearchEntryMode code to its display name.
+var searchEntryModeDisplays = map[SearchEntryMode]string{
+	SearchEntryModeMatch:   "Match",
+	SearchEntryModeInclude: "Include",
+	SearchEntryModeOutcome: "Outcome",
+}
+
+// Code returns the code value.
+func (c SearchEntryMode) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the SearchEntryMode values.
+func (c SearchEntryMode) IsValid() bool {
+	_, ok := searchEntryModeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c SearchEntryMode) Display() string {
+	if display := searchEntryModeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseSearchEntryMode returns the SearchEntryMode for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseSearchEntryMode(s string) (SearchEntryMode, error) {
+	return parseCode(s, searchEntryModeDisplays)
+}
+
 // SearchModifierCode represents SearchModifierCode.
 type SearchModifierCode string
 
@@ -3156,6 +9267,47 @@ const (
 	SearchModifierCodeOftype SearchModifierCode = "ofType"
 )
 
+// searchModifierCodeDisplays maps each SearchModifierCode code to its display name.
+var searchModifierCodeDisplays = map[SearchModifierCode]string{
+	SearchModifierCodeMissing:    "Missing",
+	SearchModifierCodeExact:      "Exact",
+	SearchModifierCodeContains:   "Contains",
+	SearchModifierCodeNot:        "Not",
+	SearchModifierCodeText:       "Text",
+	SearchModifierCodeIn:         "In",
+	SearchModifierCodeNotIn:      "Not In",
+	SearchModifierCodeBelow:      "Below",
+	SearchModifierCodeAbove:      "Above",
+	SearchModifierCodeType:       "Type",
+	SearchModifierCodeIdentifier: "Identifier",
+	SearchModifierCodeOftype:     "Of Type",
+}
+
+// Code returns the code value.
+func (c SearchModifierCode) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the SearchModifierCode values.
+func (c SearchModifierCode) IsValid() bool {
+	_, ok := searchModifierCodeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c SearchModifierCode) Display() string {
+	if display := searchModifierCodeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseSearchModifierCode returns the SearchModifierCode for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseSearchModifierCode(s string) (SearchModifierCode, error) {
+	return parseCode(s, searchModifierCodeDisplays)
+}
+
 // SearchParamType represents SearchParamType.
 type SearchParamType string
 
@@ -3181,6 +9333,44 @@ const (
 	SearchParamTypeSpecial SearchParamType = "special"
 )
 
+// searchParamTypeDisplays maps each SearchParamType code to its display name.
+var searchParamTypeDisplays = map[SearchParamType]string{
+	SearchParamTypeNumber:    "Number",
+	SearchParamTypeDate:      "Date/DateTime",
+	SearchParamTypeString:    "String",
+	SearchParamTypeToken:     "Token",
+	SearchParamTypeReference: "Reference",
+	SearchParamTypeComposite: "Composite",
+	SearchParamTypeQuantity:  "Quantity",
+	SearchParamTypeUri:       "URI",
+	SearchParamTypeSpecial:   "Special",
+}
+
+// Code returns the code value.
+func (c SearchParamType) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the SearchParamType values.
+func (c SearchParamType) IsValid() bool {
+	_, ok := searchParamTypeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c SearchParamType) Display() string {
+	if display := searchParamTypeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseSearchParamType returns the SearchParamType for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseSearchParamType(s string) (SearchParamType, error) {
+	return parseCode(s, searchParamTypeDisplays)
+}
+
 // XPathUsageType represents XPathUsageType.
 type XPathUsageType string
 
@@ -3198,6 +9388,40 @@ const (
 	XPathUsageTypeOther XPathUsageType = "other"
 )
 
+// xPathUsageTypeDisplays maps each XPathUsageType code to its display name.
+var xPathUsageTypeDisplays = map[XPathUsageType]string{
+	XPathUsageTypeNormal:   "Normal",
+	XPathUsageTypePhonetic: "Phonetic",
+	XPathUsageTypeNearby:   "Nearby",
+	XPathUsageTypeDistance: "Distance",
+	XPathUsageTypeOther:    "Other",
+}
+
+// Code returns the code value.
+func (c XPathUsageType) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the XPathUsageType values.
+func (c XPathUsageType) IsValid() bool {
+	_, ok := xPathUsageTypeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c XPathUsageType) Display() string {
+	if display := xPathUsageTypeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseXPathUsageType returns the XPathUsageType for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseXPathUsageType(s string) (XPathUsageType, error) {
+	return parseCode(s, xPathUsageTypeDisplays)
+}
+
 // SequenceType represents sequenceType.
 type SequenceType string
 
@@ -3211,6 +9435,38 @@ const (
 	SequenceTypeRna SequenceType = "rna"
 )
 
+// sequenceTypeDisplays maps each SequenceType code to its display name.
+var sequenceTypeDisplays = map[SequenceType]string{
+	SequenceTypeAa:  "AA Sequence",
+	SequenceTypeDna: "DNA Sequence",
+	SequenceTypeRna: "RNA Sequence",
+}
+
+// Code returns the code value.
+func (c SequenceType) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the SequenceType values.
+func (c SequenceType) IsValid() bool {
+	_, ok := sequenceTypeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c SequenceType) Display() string {
+	if display := sequenceTypeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseSequenceType returns the SequenceType for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseSequenceType(s string) (SequenceType, error) {
+	return parseCode(s, sequenceTypeDisplays)
+}
+
 // SlotStatus represents SlotStatus.
 type SlotStatus string
 
@@ -3228,6 +9484,40 @@ const (
 	SlotStatusEnteredInError SlotStatus = "entered-in-error"
 )
 
+// slotStatusDisplays maps each SlotStatus code to its display name.
+var slotStatusDisplays = map[SlotStatus]string{
+	SlotStatusBusy:            "Busy",
+	SlotStatusFree:            "Free",
+	SlotStatusBusyUnavailable: "Busy (Unavailable)",
+	SlotStatusBusyTentative:   "Busy (Tentative)",
+	SlotStatusEnteredInError:  "Entered in error",
+}
+
+// Code returns the code value.
+func (c SlotStatus) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the SlotStatus values.
+func (c SlotStatus) IsValid() bool {
+	_, ok := slotStatusDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c SlotStatus) Display() string {
+	if display := slotStatusDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseSlotStatus returns the SlotStatus for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseSlotStatus(s string) (SlotStatus, error) {
+	return parseCode(s, slotStatusDisplays)
+}
+
 // SortDirection represents SortDirection.
 type SortDirection string
 
@@ -3239,6 +9529,37 @@ const (
 	SortDirectionDescending SortDirection = "descending"
 )
 
+// sortDirectionDisplays maps each SortDirection code to its display name.
+var sortDirectionDisplays = map[SortDirection]string{
+	SortDirectionAscending:  "Ascending",
+	SortDirectionDescending: "Descending",
+}
+
+// Code returns the code value.
+func (c SortDirection) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the SortDirection values.
+func (c SortDirection) IsValid() bool {
+	_, ok := sortDirectionDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c SortDirection) Display() string {
+	if display := sortDirectionDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseSortDirection returns the SortDirection for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseSortDirection(s string) (SortDirection, error) {
+	return parseCode(s, sortDirectionDisplays)
+}
+
 // SpecimenContainedPreference represents SpecimenContainedPreference.
 type SpecimenContainedPreference string
 
@@ -3250,6 +9571,37 @@ const (
 	SpecimenContainedPreferenceAlternate SpecimenContainedPreference = "alternate"
 )
 
+// specimenContainedPreferenceDisplays maps each SpecimenContainedPreference code to its display name.
+var specimenContainedPreferenceDisplays = map[SpecimenContainedPreference]string{
+	SpecimenContainedPreferencePreferred: "Preferred",
+	SpecimenContainedPreferenceAlternate: "Alternate",
+}
+
+// Code returns the code value.
+func (c SpecimenContainedPreference) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the SpecimenContainedPreference values.
+func (c SpecimenContainedPreference) IsValid() bool {
+	_, ok := specimenContainedPreferenceDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c SpecimenContainedPreference) Display() string {
+	if display := specimenContainedPreferenceDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseSpecimenContainedPreference returns the SpecimenContainedPreference for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseSpecimenContainedPreference(s string) (SpecimenContainedPreference, error) {
+	return parseCode(s, specimenContainedPreferenceDisplays)
+}
+
 // SpecimenStatus represents SpecimenStatus.
 type SpecimenStatus string
 
@@ -3265,6 +9617,39 @@ const (
 	SpecimenStatusEnteredInError SpecimenStatus = "entered-in-error"
 )
 
+// specimenStatusDisplays maps each SpecimenStatus code to its display name.
+var specimenStatusDisplays = map[SpecimenStatus]string{
+	SpecimenStatusAvailable:      "Available",
+	SpecimenStatusUnavailable:    "Unavailable",
+	SpecimenStatusUnsatisfactory: "Unsatisfactory",
+	SpecimenStatusEnteredInError: "Entered in Error",
+}
+
+// Code returns the code value.
+func (c SpecimenStatus) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the SpecimenStatus values.
+func (c SpecimenStatus) IsValid() bool {
+	_, ok := specimenStatusDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c SpecimenStatus) Display() string {
+	if display := specimenStatusDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseSpecimenStatus returns the SpecimenStatus for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseSpecimenStatus(s string) (SpecimenStatus, error) {
+	return parseCode(s, specimenStatusDisplays)
+}
+
 // StrandType represents strandType.
 type StrandType string
 
@@ -3276,6 +9661,37 @@ const (
 	StrandTypeCrick StrandType = "crick"
 )
 
+// strandTypeDisplays maps each StrandType code to its display name.
+var strandTypeDisplays = map[StrandType]string{
+	StrandTypeWatson: "Watson strand of referenceSeq",
+	StrandTypeCrick:  "Crick strand of referenceSeq",
+}
+
+// Code returns the code value.
+func (c StrandType) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the StrandType values.
+func (c StrandType) IsValid() bool {
+	_, ok := strandTypeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c StrandType) Display() string {
+	if display := strandTypeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseStrandType returns the StrandType for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseStrandType(s string) (StrandType, error) {
+	return parseCode(s, strandTypeDisplays)
+}
+
 // StructureDefinitionKind represents StructureDefinitionKind.
 type StructureDefinitionKind string
 
@@ -3291,6 +9707,39 @@ const (
 	StructureDefinitionKindLogical StructureDefinitionKind = "logical"
 )
 
+// structureDefinitionKindDisplays maps each StructureDefinitionKind code to its display name.
+var structureDefinitionKindDisplays = map[StructureDefinitionKind]string{
+	StructureDefinitionKindPrimitiveType: "Primitive Data Type",
+	StructureDefinitionKindComplexType:   "Complex Data Type",
+	StructureDefinitionKindResource:      "Resource",
+	StructureDefinitionKindLogical:       "Logical",
+}
+
+// Code returns the code value.
+func (c StructureDefinitionKind) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the StructureDefinitionKind values.
+func (c StructureDefinitionKind) IsValid() bool {
+	_, ok := structureDefinitionKindDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c StructureDefinitionKind) Display() string {
+	if display := structureDefinitionKindDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseStructureDefinitionKind returns the StructureDefinitionKind for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseStructureDefinitionKind(s string) (StructureDefinitionKind, error) {
+	return parseCode(s, structureDefinitionKindDisplays)
+}
+
 // SubscriptionChannelType represents SubscriptionChannelType.
 type SubscriptionChannelType string
 
@@ -3308,6 +9757,40 @@ const (
 	SubscriptionChannelTypeMessage SubscriptionChannelType = "message"
 )
 
+// subscriptionChannelTypeDisplays maps each SubscriptionChannelType code to its display name.
+var subscriptionChannelTypeDisplays = map[SubscriptionChannelType]string{
+	SubscriptionChannelTypeRestHook:  "Rest Hook",
+	SubscriptionChannelTypeWebsocket: "Websocket",
+	SubscriptionChannelTypeEmail:     "Email",
+	SubscriptionChannelTypeSms:       "SMS",
+	SubscriptionChannelTypeMessage:   "Message",
+}
+
+// Code returns the code value.
+func (c SubscriptionChannelType) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the SubscriptionChannelType values.
+func (c SubscriptionChannelType) IsValid() bool {
+	_, ok := subscriptionChannelTypeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c SubscriptionChannelType) Display() string {
+	if display := subscriptionChannelTypeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseSubscriptionChannelType returns the SubscriptionChannelType for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseSubscriptionChannelType(s string) (SubscriptionChannelType, error) {
+	return parseCode(s, subscriptionChannelTypeDisplays)
+}
+
 // SubscriptionStatus represents SubscriptionStatus.
 type SubscriptionStatus string
 
@@ -3323,6 +9806,39 @@ const (
 	SubscriptionStatusOff SubscriptionStatus = "off"
 )
 
+// subscriptionStatusDisplays maps each SubscriptionStatus code to its display name.
+var subscriptionStatusDisplays = map[SubscriptionStatus]string{
+	SubscriptionStatusRequested: "Requested",
+	SubscriptionStatusActive:    "Active",
+	SubscriptionStatusError:     "Error",
+	SubscriptionStatusOff:       "Off",
+}
+
+// Code returns the code value.
+func (c SubscriptionStatus) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the SubscriptionStatus values.
+func (c SubscriptionStatus) IsValid() bool {
+	_, ok := subscriptionStatusDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c SubscriptionStatus) Display() string {
+	if display := subscriptionStatusDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseSubscriptionStatus returns the SubscriptionStatus for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseSubscriptionStatus(s string) (SubscriptionStatus, error) {
+	return parseCode(s, subscriptionStatusDisplays)
+}
+
 // FHIRSubstanceStatus represents FHIRSubstanceStatus.
 type FHIRSubstanceStatus string
 
@@ -3336,6 +9852,38 @@ const (
 	FHIRSubstanceStatusEnteredInError FHIRSubstanceStatus = "entered-in-error"
 )
 
+// fHIRSubstanceStatusDisplays maps each FHIRSubstanceStatus code to its display name.
+var fHIRSubstanceStatusDisplays = map[FHIRSubstanceStatus]string{
+	FHIRSubstanceStatusActive:         "Active",
+	FHIRSubstanceStatusInactive:       "Inactive",
+	FHIRSubstanceStatusEnteredInError: "Entered in Error",
+}
+
+// Code returns the code value.
+func (c FHIRSubstanceStatus) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the FHIRSubstanceStatus values.
+func (c FHIRSubstanceStatus) IsValid() bool {
+	_, ok := fHIRSubstanceStatusDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c FHIRSubstanceStatus) Display() string {
+	if display := fHIRSubstanceStatusDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseFHIRSubstanceStatus returns the FHIRSubstanceStatus for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseFHIRSubstanceStatus(s string) (FHIRSubstanceStatus, error) {
+	return parseCode(s, fHIRSubstanceStatusDisplays)
+}
+
 // SupplyDeliveryStatus represents SupplyDeliveryStatus.
 type SupplyDeliveryStatus string
 
@@ -3351,6 +9899,39 @@ const (
 	SupplyDeliveryStatusEnteredInError SupplyDeliveryStatus = "entered-in-error"
 )
 
+// supplyDeliveryStatusDisplays maps each SupplyDeliveryStatus code to its display name.
+var supplyDeliveryStatusDisplays = map[SupplyDeliveryStatus]string{
+	SupplyDeliveryStatusInProgress:     "In Progress",
+	SupplyDeliveryStatusCompleted:      "Delivered",
+	SupplyDeliveryStatusAbandoned:      "Abandoned",
+	SupplyDeliveryStatusEnteredInError: "Entered In Error",
+}
+
+// Code returns the code value.
+func (c SupplyDeliveryStatus) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the SupplyDeliveryStatus values.
+func (c SupplyDeliveryStatus) IsValid() bool {
+	_, ok := supplyDeliveryStatusDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c SupplyDeliveryStatus) Display() string {
+	if display := supplyDeliveryStatusDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseSupplyDeliveryStatus returns the SupplyDeliveryStatus for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseSupplyDeliveryStatus(s string) (SupplyDeliveryStatus, error) {
+	return parseCode(s, supplyDeliveryStatusDisplays)
+}
+
 // SupplyRequestStatus represents SupplyRequestStatus.
 type SupplyRequestStatus string
 
@@ -3372,6 +9953,42 @@ const (
 	SupplyRequestStatusUnknown SupplyRequestStatus = "unknown"
 )
 
+// supplyRequestStatusDisplays maps each SupplyRequestStatus code to its display name.
+var supplyRequestStatusDisplays = map[SupplyRequestStatus]string{
+	SupplyRequestStatusDraft:          "Draft",
+	SupplyRequestStatusActive:         "Active",
+	SupplyRequestStatusSuspended:      "Suspended",
+	SupplyRequestStatusCancelled:      "Cancelled",
+	SupplyRequestStatusCompleted:      "Completed",
+	SupplyRequestStatusEnteredInError: "Entered in Error",
+	SupplyRequestStatusUnknown:        "Unknown",
+}
+
+// Code returns the code value.
+func (c SupplyRequestStatus) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the SupplyRequestStatus values.
+func (c SupplyRequestStatus) IsValid() bool {
+	_, ok := supplyRequestStatusDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c SupplyRequestStatus) Display() string {
+	if display := supplyRequestStatusDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseSupplyRequestStatus returns the SupplyRequestStatus for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseSupplyRequestStatus(s string) (SupplyRequestStatus, error) {
+	return parseCode(s, supplyRequestStatusDisplays)
+}
+
 // SystemRestfulInteraction represents SystemRestfulInteraction.
 type SystemRestfulInteraction string
 
@@ -3383,6 +10000,39 @@ const (
 	SystemRestfulInteractionHistorySystem SystemRestfulInteraction = "history-system"
 )
 
+// systemRestfulInteractionDisplays maps each SystemRestfulInteraction code to its display name.
+var systemRestfulInteractionDisplays = map[SystemRestfulInteraction]string{
+	SystemRestfulInteractionTransaction:   "",
+	SystemRestfulInteractionBatch:         "",
+	SystemRestfulInteractionSearchSystem:  "",
+	SystemRestfulInteractionHistorySystem: "",
+}
+
+// Code returns the code value.
+func (c SystemRestfulInteraction) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the SystemRestfulInteraction values.
+func (c SystemRestfulInteraction) IsValid() bool {
+	_, ok := systemRestfulInteractionDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c SystemRestfulInteraction) Display() string {
+	if display := systemRestfulInteractionDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseSystemRestfulInteraction returns the SystemRestfulInteraction for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseSystemRestfulInteraction(s string) (SystemRestfulInteraction, error) {
+	return parseCode(s, systemRestfulInteractionDisplays)
+}
+
 // TaskIntent represents TaskIntent.
 type TaskIntent string
 
@@ -3400,6 +10050,44 @@ const (
 	TaskIntentOption        TaskIntent = "option"
 )
 
+// taskIntentDisplays maps each TaskIntent code to its display name.
+var taskIntentDisplays = map[TaskIntent]string{
+	TaskIntentUnknown:       "Unknown",
+	TaskIntentProposal:      "",
+	TaskIntentPlan:          "",
+	TaskIntentOrder:         "",
+	TaskIntentOriginalOrder: "",
+	TaskIntentReflexOrder:   "",
+	TaskIntentFillerOrder:   "",
+	TaskIntentInstanceOrder: "",
+	TaskIntentOption:        "",
+}
+
+// Code returns the code value.
+func (c TaskIntent) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the TaskIntent values.
+func (c TaskIntent) IsValid() bool {
+	_, ok := taskIntentDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c TaskIntent) Display() string {
+	if display := taskIntentDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseTaskIntent returns the TaskIntent for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseTaskIntent(s string) (TaskIntent, error) {
+	return parseCode(s, taskIntentDisplays)
+}
+
 // TaskStatus represents TaskStatus.
 type TaskStatus string
 
@@ -3431,6 +10119,47 @@ const (
 	TaskStatusEnteredInError TaskStatus = "entered-in-error"
 )
 
+// taskStatusDisplays maps each TaskStatus code to its display name.
+var taskStatusDisplays = map[TaskStatus]string{
+	TaskStatusDraft:          "Draft",
+	TaskStatusRequested:      "Requested",
+	TaskStatusReceived:       "Received",
+	TaskStatusAccepted:       "Accepted",
+	TaskStatusRejected:       "Rejected",
+	TaskStatusReady:          "Ready",
+	TaskStatusCancelled:      "Cancelled",
+	TaskStatusInProgress:     "In Progress",
+	TaskStatusOnHold:         "On Hold",
+	TaskStatusFailed:         "Failed",
+	TaskStatusCompleted:      "Completed",
+	TaskStatusEnteredInError: "Entered in Error",
+}
+
+// Code returns the code value.
+func (c TaskStatus) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the TaskStatus values.
+func (c TaskStatus) IsValid() bool {
+	_, ok := taskStatusDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c TaskStatus) Display() string {
+	if display := taskStatusDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseTaskStatus returns the TaskStatus for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseTaskStatus(s string) (TaskStatus, error) {
+	return parseCode(s, taskStatusDisplays)
+}
+
 // TriggerType represents TriggerType.
 type TriggerType string
 
@@ -3454,6 +10183,43 @@ const (
 	TriggerTypeDataAccessEnded TriggerType = "data-access-ended"
 )
 
+// triggerTypeDisplays maps each TriggerType code to its display name.
+var triggerTypeDisplays = map[TriggerType]string{
+	TriggerTypeNamedEvent:      "Named Event",
+	TriggerTypePeriodic:        "Periodic",
+	TriggerTypeDataChanged:     "Data Changed",
+	TriggerTypeDataAdded:       "Data Added",
+	TriggerTypeDataModified:    "Data Updated",
+	TriggerTypeDataRemoved:     "Data Removed",
+	TriggerTypeDataAccessed:    "Data Accessed",
+	TriggerTypeDataAccessEnded: "Data Access Ended",
+}
+
+// Code returns the code value.
+func (c TriggerType) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the TriggerType values.
+func (c TriggerType) IsValid() bool {
+	_, ok := triggerTypeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c TriggerType) Display() string {
+	if display := triggerTypeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseTriggerType returns the TriggerType for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseTriggerType(s string) (TriggerType, error) {
+	return parseCode(s, triggerTypeDisplays)
+}
+
 // TypeDerivationRule represents TypeDerivationRule.
 type TypeDerivationRule string
 
@@ -3465,6 +10231,37 @@ const (
 	TypeDerivationRuleConstraint TypeDerivationRule = "constraint"
 )
 
+// typeDerivationRuleDisplays maps each TypeDerivationRule code to its display name.
+var typeDerivationRuleDisplays = map[TypeDerivationRule]string{
+	TypeDerivationRuleSpecialization: "Specialization",
+	TypeDerivationRuleConstraint:     "Constraint",
+}
+
+// Code returns the code value.
+func (c TypeDerivationRule) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the TypeDerivationRule values.
+func (c TypeDerivationRule) IsValid() bool {
+	_, ok := typeDerivationRuleDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c TypeDerivationRule) Display() string {
+	if display := typeDerivationRuleDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseTypeDerivationRule returns the TypeDerivationRule for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseTypeDerivationRule(s string) (TypeDerivationRule, error) {
+	return parseCode(s, typeDerivationRuleDisplays)
+}
+
 // TypeRestfulInteraction represents TypeRestfulInteraction.
 type TypeRestfulInteraction string
 
@@ -3481,6 +10278,44 @@ const (
 	TypeRestfulInteractionSearchType      TypeRestfulInteraction = "search-type"
 )
 
+// typeRestfulInteractionDisplays maps each TypeRestfulInteraction code to its display name.
+var typeRestfulInteractionDisplays = map[TypeRestfulInteraction]string{
+	TypeRestfulInteractionRead:            "",
+	TypeRestfulInteractionVread:           "",
+	TypeRestfulInteractionUpdate:          "",
+	TypeRestfulInteractionPatch:           "",
+	TypeRestfulInteractionDelete:          "",
+	TypeRestfulInteractionHistoryInstance: "",
+	TypeRestfulInteractionHistoryType:     "",
+	TypeRestfulInteractionCreate:          "",
+	TypeRestfulInteractionSearchType:      "",
+}
+
+// Code returns the code value.
+func (c TypeRestfulInteraction) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the TypeRestfulInteraction values.
+func (c TypeRestfulInteraction) IsValid() bool {
+	_, ok := typeRestfulInteractionDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c TypeRestfulInteraction) Display() string {
+	if display := typeRestfulInteractionDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseTypeRestfulInteraction returns the TypeRestfulInteraction for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseTypeRestfulInteraction(s string) (TypeRestfulInteraction, error) {
+	return parseCode(s, typeRestfulInteractionDisplays)
+}
+
 // UDIEntryType represents UDIEntryType.
 type UDIEntryType string
 
@@ -3500,6 +10335,41 @@ const (
 	UDIEntryTypeUnknown UDIEntryType = "unknown"
 )
 
+// uDIEntryTypeDisplays maps each UDIEntryType code to its display name.
+var uDIEntryTypeDisplays = map[UDIEntryType]string{
+	UDIEntryTypeBarcode:      "Barcode",
+	UDIEntryTypeRfid:         "RFID",
+	UDIEntryTypeManual:       "Manual",
+	UDIEntryTypeCard:         "Card",
+	UDIEntryTypeSelfReported: "Self Reported",
+	UDIEntryTypeUnknown:      "Unknown",
+}
+
+// Code returns the code value.
+func (c UDIEntryType) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the UDIEntryType values.
+func (c UDIEntryType) IsValid() bool {
+	_, ok := uDIEntryTypeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c UDIEntryType) Display() string {
+	if display := uDIEntryTypeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseUDIEntryType returns the UDIEntryType for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseUDIEntryType(s string) (UDIEntryType, error) {
+	return parseCode(s, uDIEntryTypeDisplays)
+}
+
 // UnitsOfTime represents UnitsOfTime.
 type UnitsOfTime string
 
@@ -3521,6 +10391,42 @@ const (
 	UnitsOfTimeA UnitsOfTime = "a"
 )
 
+// unitsOfTimeDisplays maps each UnitsOfTime code to its display name.
+var unitsOfTimeDisplays = map[UnitsOfTime]string{
+	UnitsOfTimeS:   "second",
+	UnitsOfTimeMin: "minute",
+	UnitsOfTimeH:   "hour",
+	UnitsOfTimeD:   "day",
+	UnitsOfTimeWk:  "week",
+	UnitsOfTimeMo:  "month",
+	UnitsOfTimeA:   "year",
+}
+
+// Code returns the code value.
+func (c UnitsOfTime) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the UnitsOfTime values.
+func (c UnitsOfTime) IsValid() bool {
+	_, ok := unitsOfTimeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c UnitsOfTime) Display() string {
+	if display := unitsOfTimeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseUnitsOfTime returns the UnitsOfTime for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseUnitsOfTime(s string) (UnitsOfTime, error) {
+	return parseCode(s, unitsOfTimeDisplays)
+}
+
 // EvidenceVariableType represents EvidenceVariableType.
 type EvidenceVariableType string
 
@@ -3534,6 +10440,38 @@ const (
 	EvidenceVariableTypeDescriptive EvidenceVariableType = "descriptive"
 )
 
+// evidenceVariableTypeDisplays maps each EvidenceVariableType code to its display name.
+var evidenceVariableTypeDisplays = map[EvidenceVariableType]string{
+	EvidenceVariableTypeDichotomous: "Dichotomous",
+	EvidenceVariableTypeContinuous:  "Continuous",
+	EvidenceVariableTypeDescriptive: "Descriptive",
+}
+
+// Code returns the code value.
+func (c EvidenceVariableType) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the EvidenceVariableType values.
+func (c EvidenceVariableType) IsValid() bool {
+	_, ok := evidenceVariableTypeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c EvidenceVariableType) Display() string {
+	if display := evidenceVariableTypeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseEvidenceVariableType returns the EvidenceVariableType for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseEvidenceVariableType(s string) (EvidenceVariableType, error) {
+	return parseCode(s, evidenceVariableTypeDisplays)
+}
+
 // Status represents Status.
 type Status string
 
@@ -3553,6 +10491,41 @@ const (
 	StatusRevalFail Status = "reval-fail"
 )
 
+// statusDisplays maps each Status code to its display name.
+var statusDisplays = map[Status]string{
+	StatusAttested:   "Attested",
+	StatusValidated:  "Validated",
+	StatusInProcess:  "In process",
+	StatusReqRevalid: "Requires revalidation",
+	StatusValFail:    "Validation failed",
+	StatusRevalFail:  "Re-Validation failed",
+}
+
+// Code returns the code value.
+func (c Status) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the Status values.
+func (c Status) IsValid() bool {
+	_, ok := statusDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c Status) Display() string {
+	if display := statusDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseStatus returns the Status for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseStatus(s string) (Status, error) {
+	return parseCode(s, statusDisplays)
+}
+
 // ResourceVersionPolicy represents ResourceVersionPolicy.
 type ResourceVersionPolicy string
 
@@ -3566,6 +10539,38 @@ const (
 	ResourceVersionPolicyVersionedUpdate ResourceVersionPolicy = "versioned-update"
 )
 
+// resourceVersionPolicyDisplays maps each ResourceVersionPolicy code to its display name.
+var resourceVersionPolicyDisplays = map[ResourceVersionPolicy]string{
+	ResourceVersionPolicyNoVersion:       "No VersionId Support",
+	ResourceVersionPolicyVersioned:       "Versioned",
+	ResourceVersionPolicyVersionedUpdate: "VersionId tracked fully",
+}
+
+// Code returns the code value.
+func (c ResourceVersionPolicy) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the ResourceVersionPolicy values.
+func (c ResourceVersionPolicy) IsValid() bool {
+	_, ok := resourceVersionPolicyDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c ResourceVersionPolicy) Display() string {
+	if display := resourceVersionPolicyDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseResourceVersionPolicy returns the ResourceVersionPolicy for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseResourceVersionPolicy(s string) (ResourceVersionPolicy, error) {
+	return parseCode(s, resourceVersionPolicyDisplays)
+}
+
 // VisionBase represents VisionBase.
 type VisionBase string
 
@@ -3581,6 +10586,39 @@ const (
 	VisionBaseOut VisionBase = "out"
 )
 
+// visionBaseDisplays maps each VisionBase code to its display name.
+var visionBaseDisplays = map[VisionBase]string{
+	VisionBaseUp:   "Up",
+	VisionBaseDown: "Down",
+	VisionBaseIn:   "In",
+	VisionBaseOut:  "Out",
+}
+
+// Code returns the code value.
+func (c VisionBase) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the VisionBase values.
+func (c VisionBase) IsValid() bool {
+	_, ok := visionBaseDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c VisionBase) Display() string {
+	if display := visionBaseDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseVisionBase returns the VisionBase for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseVisionBase(s string) (VisionBase, error) {
+	return parseCode(s, visionBaseDisplays)
+}
+
 // VisionEyes represents VisionEyes.
 type VisionEyes string
 
@@ -3591,3 +10629,34 @@ const (
 	// VisionEyesLeft - Left Eye
 	VisionEyesLeft VisionEyes = "left"
 )
+
+// visionEyesDisplays maps each VisionEyes code to its display name.
+var visionEyesDisplays = map[VisionEyes]string{
+	VisionEyesRight: "Right Eye",
+	VisionEyesLeft:  "Left Eye",
+}
+
+// Code returns the code value.
+func (c VisionEyes) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the VisionEyes values.
+func (c VisionEyes) IsValid() bool {
+	_, ok := visionEyesDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c VisionEyes) Display() string {
+	if display := visionEyesDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseVisionEyes returns the VisionEyes for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseVisionEyes(s string) (VisionEyes, error) {
+	return parseCode(s, visionEyesDisplays)
+}
@@ -23,3 +23,162 @@ type DomainResource interface {
 	GetExtension() []Extension
 	GetModifierExtension() []Extension
 }
+
+// CanonicalResource is the interface for resources with a canonical URL, such as
+// StructureDefinition, ValueSet and Questionnaire, which are referenced by url and version.
+type CanonicalResource interface {
+	DomainResource
+	GetUrl() *string
+	GetVersion() *string
+	GetStatus() *PublicationStatus
+}
+
+// Compile-time checks that each resource implements the interface of its category.
+var (
+	_ DomainResource    = (*Account)(nil)
+	_ CanonicalResource = (*ActivityDefinition)(nil)
+	_ DomainResource    = (*AdverseEvent)(nil)
+	_ DomainResource    = (*AllergyIntolerance)(nil)
+	_ DomainResource    = (*Appointment)(nil)
+	_ DomainResource    = (*AppointmentResponse)(nil)
+	_ DomainResource    = (*AuditEvent)(nil)
+	_ DomainResource    = (*Basic)(nil)
+	_ Resource          = (*Binary)(nil)
+	_ DomainResource    = (*BiologicallyDerivedProduct)(nil)
+	_ DomainResource    = (*BodyStructure)(nil)
+	_ Resource          = (*Bundle)(nil)
+	_ CanonicalResource = (*CapabilityStatement)(nil)
+	_ DomainResource    = (*CarePlan)(nil)
+	_ DomainResource    = (*CareTeam)(nil)
+	_ DomainResource    = (*CatalogEntry)(nil)
+	_ DomainResource    = (*ChargeItem)(nil)
+	_ CanonicalResource = (*ChargeItemDefinition)(nil)
+	_ DomainResource    = (*Claim)(nil)
+	_ DomainResource    = (*ClaimResponse)(nil)
+	_ DomainResource    = (*ClinicalImpression)(nil)
+	_ CanonicalResource = (*CodeSystem)(nil)
+	_ DomainResource    = (*Communication)(nil)
+	_ DomainResource    = (*CommunicationRequest)(nil)
+	_ CanonicalResource = (*CompartmentDefinition)(nil)
+	_ DomainResource    = (*Composition)(nil)
+	_ CanonicalResource = (*ConceptMap)(nil)
+	_ DomainResource    = (*Condition)(nil)
+	_ DomainResource    = (*Consent)(nil)
+	_ DomainResource    = (*Contract)(nil)
+	_ DomainResource    = (*Coverage)(nil)
+	_ DomainResource    = (*CoverageEligibilityRequest)(nil)
+	_ DomainResource    = (*CoverageEligibilityResponse)(nil)
+	_ DomainResource    = (*DetectedIssue)(nil)
+	_ DomainResource    = (*Device)(nil)
+	_ DomainResource    = (*DeviceDefinition)(nil)
+	_ DomainResource    = (*DeviceMetric)(nil)
+	_ DomainResource    = (*DeviceRequest)(nil)
+	_ DomainResource    = (*DeviceUseStatement)(nil)
+	_ DomainResource    = (*DiagnosticReport)(nil)
+	_ DomainResource    = (*DocumentManifest)(nil)
+	_ DomainResource    = (*DocumentReference)(nil)
+	_ CanonicalResource = (*EffectEvidenceSynthesis)(nil)
+	_ DomainResource    = (*Encounter)(nil)
+	_ DomainResource    = (*Endpoint)(nil)
+	_ DomainResource    = (*EnrollmentRequest)(nil)
+	_ DomainResource    = (*EnrollmentResponse)(nil)
+	_ DomainResource    = (*EpisodeOfCare)(nil)
+	_ CanonicalResource = (*EventDefinition)(nil)
+	_ CanonicalResource = (*Evidence)(nil)
+	_ CanonicalResource = (*EvidenceVariable)(nil)
+	_ CanonicalResource = (*ExampleScenario)(nil)
+	_ DomainResource    = (*ExplanationOfBenefit)(nil)
+	_ DomainResource    = (*FamilyMemberHistory)(nil)
+	_ DomainResource    = (*Flag)(nil)
+	_ DomainResource    = (*Goal)(nil)
+	_ CanonicalResource = (*GraphDefinition)(nil)
+	_ DomainResource    = (*Group)(nil)
+	_ DomainResource    = (*GuidanceResponse)(nil)
+	_ DomainResource    = (*HealthcareService)(nil)
+	_ DomainResource    = (*ImagingStudy)(nil)
+	_ DomainResource    = (*Immunization)(nil)
+	_ DomainResource    = (*ImmunizationEvaluation)(nil)
+	_ DomainResource    = (*ImmunizationRecommendation)(nil)
+	_ CanonicalResource = (*ImplementationGuide)(nil)
+	_ DomainResource    = (*InsurancePlan)(nil)
+	_ DomainResource    = (*Invoice)(nil)
+	_ CanonicalResource = (*Library)(nil)
+	_ DomainResource    = (*Linkage)(nil)
+	_ DomainResource    = (*List)(nil)
+	_ DomainResource    = (*Location)(nil)
+	_ CanonicalResource = (*Measure)(nil)
+	_ DomainResource    = (*MeasureReport)(nil)
+	_ DomainResource    = (*Media)(nil)
+	_ DomainResource    = (*Medication)(nil)
+	_ DomainResource    = (*MedicationAdministration)(nil)
+	_ DomainResource    = (*MedicationDispense)(nil)
+	_ DomainResource    = (*MedicationKnowledge)(nil)
+	_ DomainResource    = (*MedicationRequest)(nil)
+	_ DomainResource    = (*MedicationStatement)(nil)
+	_ DomainResource    = (*MedicinalProduct)(nil)
+	_ DomainResource    = (*MedicinalProductAuthorization)(nil)
+	_ DomainResource    = (*MedicinalProductContraindication)(nil)
+	_ DomainResource    = (*MedicinalProductIndication)(nil)
+	_ DomainResource    = (*MedicinalProductIngredient)(nil)
+	_ DomainResource    = (*MedicinalProductInteraction)(nil)
+	_ DomainResource    = (*MedicinalProductManufactured)(nil)
+	_ DomainResource    = (*MedicinalProductPackaged)(nil)
+	_ DomainResource    = (*MedicinalProductPharmaceutical)(nil)
+	_ DomainResource    = (*MedicinalProductUndesirableEffect)(nil)
+	_ CanonicalResource = (*MessageDefinition)(nil)
+	_ DomainResource    = (*MessageHeader)(nil)
+	_ DomainResource    = (*MolecularSequence)(nil)
+	_ DomainResource    = (*NamingSystem)(nil)
+	_ DomainResource    = (*NutritionOrder)(nil)
+	_ DomainResource    = (*Observation)(nil)
+	_ DomainResource    = (*ObservationDefinition)(nil)
+	_ CanonicalResource = (*OperationDefinition)(nil)
+	_ DomainResource    = (*OperationOutcome)(nil)
+	_ DomainResource    = (*Organization)(nil)
+	_ DomainResource    = (*OrganizationAffiliation)(nil)
+	_ Resource          = (*Parameters)(nil)
+	_ DomainResource    = (*Patient)(nil)
+	_ DomainResource    = (*PaymentNotice)(nil)
+	_ DomainResource    = (*PaymentReconciliation)(nil)
+	_ DomainResource    = (*Person)(nil)
+	_ CanonicalResource = (*PlanDefinition)(nil)
+	_ DomainResource    = (*Practitioner)(nil)
+	_ DomainResource    = (*PractitionerRole)(nil)
+	_ DomainResource    = (*Procedure)(nil)
+	_ DomainResource    = (*Provenance)(nil)
+	_ CanonicalResource = (*Questionnaire)(nil)
+	_ DomainResource    = (*QuestionnaireResponse)(nil)
+	_ DomainResource    = (*RelatedPerson)(nil)
+	_ DomainResource    = (*RequestGroup)(nil)
+	_ CanonicalResource = (*ResearchDefinition)(nil)
+	_ CanonicalResource = (*ResearchElementDefinition)(nil)
+	_ DomainResource    = (*ResearchStudy)(nil)
+	_ DomainResource    = (*ResearchSubject)(nil)
+	_ DomainResource    = (*RiskAssessment)(nil)
+	_ CanonicalResource = (*RiskEvidenceSynthesis)(nil)
+	_ DomainResource    = (*Schedule)(nil)
+	_ CanonicalResource = (*SearchParameter)(nil)
+	_ DomainResource    = (*ServiceRequest)(nil)
+	_ DomainResource    = (*Slot)(nil)
+	_ DomainResource    = (*Specimen)(nil)
+	_ DomainResource    = (*SpecimenDefinition)(nil)
+	_ CanonicalResource = (*StructureDefinition)(nil)
+	_ CanonicalResource = (*StructureMap)(nil)
+	_ DomainResource    = (*Subscription)(nil)
+	_ DomainResource    = (*Substance)(nil)
+	_ DomainResource    = (*SubstanceNucleicAcid)(nil)
+	_ DomainResource    = (*SubstancePolymer)(nil)
+	_ DomainResource    = (*SubstanceProtein)(nil)
+	_ DomainResource    = (*SubstanceReferenceInformation)(nil)
+	_ DomainResource    = (*SubstanceSourceMaterial)(nil)
+	_ DomainResource    = (*SubstanceSpecification)(nil)
+	_ DomainResource    = (*SupplyDelivery)(nil)
+	_ DomainResource    = (*SupplyRequest)(nil)
+	_ DomainResource    = (*Task)(nil)
+	_ CanonicalResource = (*TerminologyCapabilities)(nil)
+	_ DomainResource    = (*TestReport)(nil)
+	_ CanonicalResource = (*TestScript)(nil)
+	_ CanonicalResource = (*ValueSet)(nil)
+	_ DomainResource    = (*VerificationResult)(nil)
+	_ DomainResource    = (*VisionPrescription)(nil)
+)
@@ -20,6 +20,14 @@ func NewAppointment(opts ...AppointmentOption) *Appointment {
 	return r
 }
 
+// Apply applies the given options to an existing Appointment, such as a decoded one, and returns it.
+func (r *Appointment) Apply(opts ...AppointmentOption) *Appointment {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithAppointmentId sets the Id field.
 func WithAppointmentId(v string) AppointmentOption {
 	return func(r *Appointment) {
@@ -20,6 +20,14 @@ func NewAppointmentResponse(opts ...AppointmentResponseOption) *AppointmentRespo
 	return r
 }
 
+// Apply applies the given options to an existing AppointmentResponse, such as a decoded one, and returns it.
+func (r *AppointmentResponse) Apply(opts ...AppointmentResponseOption) *AppointmentResponse {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithAppointmentResponseId sets the Id field.
 func WithAppointmentResponseId(v string) AppointmentResponseOption {
 	return func(r *AppointmentResponse) {
@@ -20,6 +20,14 @@ func NewBinary(opts ...BinaryOption) *Binary {
 	return r
 }
 
+// Apply applies the given options to an existing Binary, such as a decoded one, and returns it.
+func (r *Binary) Apply(opts ...BinaryOption) *Binary {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithBinaryId sets the Id field.
 func WithBinaryId(v string) BinaryOption {
 	return func(r *Binary) {
@@ -20,6 +20,14 @@ func NewBiologicallyDerivedProduct(opts ...BiologicallyDerivedProductOption) *Bi
 	return r
 }
 
+// Apply applies the given options to an existing BiologicallyDerivedProduct, such as a decoded one, and returns it.
+func (r *BiologicallyDerivedProduct) Apply(opts ...BiologicallyDerivedProductOption) *BiologicallyDerivedProduct {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithBiologicallyDerivedProductId sets the Id field.
 func WithBiologicallyDerivedProductId(v string) BiologicallyDerivedProductOption {
 	return func(r *BiologicallyDerivedProduct) {
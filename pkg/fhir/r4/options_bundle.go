@@ -20,6 +20,14 @@ func NewBundle(opts ...BundleOption) *Bundle {
 	return r
 }
 
+// Apply applies the given options to an existing Bundle, such as a decoded one, and returns it.
+func (r *Bundle) Apply(opts ...BundleOption) *Bundle {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithBundleId sets the Id field.
 func WithBundleId(v string) BundleOption {
 	return func(r *Bundle) {
@@ -20,6 +20,14 @@ func NewDetectedIssue(opts ...DetectedIssueOption) *DetectedIssue {
 	return r
 }
 
+// Apply applies the given options to an existing DetectedIssue, such as a decoded one, and returns it.
+func (r *DetectedIssue) Apply(opts ...DetectedIssueOption) *DetectedIssue {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithDetectedIssueId sets the Id field.
 func WithDetectedIssueId(v string) DetectedIssueOption {
 	return func(r *DetectedIssue) {
@@ -20,6 +20,14 @@ func NewDeviceUseStatement(opts ...DeviceUseStatementOption) *DeviceUseStatement
 	return r
 }
 
+// Apply applies the given options to an existing DeviceUseStatement, such as a decoded one, and returns it.
+func (r *DeviceUseStatement) Apply(opts ...DeviceUseStatementOption) *DeviceUseStatement {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithDeviceUseStatementId sets the Id field.
 func WithDeviceUseStatementId(v string) DeviceUseStatementOption {
 	return func(r *DeviceUseStatement) {
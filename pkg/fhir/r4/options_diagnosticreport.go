@@ -20,6 +20,14 @@ func NewDiagnosticReport(opts ...DiagnosticReportOption) *DiagnosticReport {
 	return r
 }
 
+// Apply applies the given options to an existing DiagnosticReport, such as a decoded one, and returns it.
+func (r *DiagnosticReport) Apply(opts ...DiagnosticReportOption) *DiagnosticReport {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithDiagnosticReportId sets the Id field.
 func WithDiagnosticReportId(v string) DiagnosticReportOption {
 	return func(r *DiagnosticReport) {
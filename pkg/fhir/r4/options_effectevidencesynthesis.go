@@ -20,6 +20,14 @@ func NewEffectEvidenceSynthesis(opts ...EffectEvidenceSynthesisOption) *EffectEv
 	return r
 }
 
+// Apply applies the given options to an existing EffectEvidenceSynthesis, such as a decoded one, and returns it.
+func (r *EffectEvidenceSynthesis) Apply(opts ...EffectEvidenceSynthesisOption) *EffectEvidenceSynthesis {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithEffectEvidenceSynthesisId sets the Id field.
 func WithEffectEvidenceSynthesisId(v string) EffectEvidenceSynthesisOption {
 	return func(r *EffectEvidenceSynthesis) {
@@ -20,6 +20,14 @@ func NewEncounter(opts ...EncounterOption) *Encounter {
 	return r
 }
 
+// Apply applies the given options to an existing Encounter, such as a decoded one, and returns it.
+func (r *Encounter) Apply(opts ...EncounterOption) *Encounter {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithEncounterId sets the Id field.
 func WithEncounterId(v string) EncounterOption {
 	return func(r *Encounter) {
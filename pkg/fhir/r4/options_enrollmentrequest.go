@@ -20,6 +20,14 @@ func NewEnrollmentRequest(opts ...EnrollmentRequestOption) *EnrollmentRequest {
 	return r
 }
 
+// Apply applies the given options to an existing EnrollmentRequest, such as a decoded one, and returns it.
+func (r *EnrollmentRequest) Apply(opts ...EnrollmentRequestOption) *EnrollmentRequest {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithEnrollmentRequestId sets the Id field.
 func WithEnrollmentRequestId(v string) EnrollmentRequestOption {
 	return func(r *EnrollmentRequest) {
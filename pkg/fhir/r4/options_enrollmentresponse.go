@@ -20,6 +20,14 @@ func NewEnrollmentResponse(opts ...EnrollmentResponseOption) *EnrollmentResponse
 	return r
 }
 
+// Apply applies the given options to an existing EnrollmentResponse, such as a decoded one, and returns it.
+func (r *EnrollmentResponse) Apply(opts ...EnrollmentResponseOption) *EnrollmentResponse {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithEnrollmentResponseId sets the Id field.
 func WithEnrollmentResponseId(v string) EnrollmentResponseOption {
 	return func(r *EnrollmentResponse) {
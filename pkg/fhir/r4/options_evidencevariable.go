@@ -20,6 +20,14 @@ func NewEvidenceVariable(opts ...EvidenceVariableOption) *EvidenceVariable {
 	return r
 }
 
+// Apply applies the given options to an existing EvidenceVariable, such as a decoded one, and returns it.
+func (r *EvidenceVariable) Apply(opts ...EvidenceVariableOption) *EvidenceVariable {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithEvidenceVariableId sets the Id field.
 func WithEvidenceVariableId(v string) EvidenceVariableOption {
 	return func(r *EvidenceVariable) {
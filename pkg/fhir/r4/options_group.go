@@ -20,6 +20,14 @@ func NewGroup(opts ...GroupOption) *Group {
 	return r
 }
 
+// Apply applies the given options to an existing Group, such as a decoded one, and returns it.
+func (r *Group) Apply(opts ...GroupOption) *Group {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithGroupId sets the Id field.
 func WithGroupId(v string) GroupOption {
 	return func(r *Group) {
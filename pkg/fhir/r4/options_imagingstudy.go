@@ -20,6 +20,14 @@ func NewImagingStudy(opts ...ImagingStudyOption) *ImagingStudy {
 	return r
 }
 
+// Apply applies the given options to an existing ImagingStudy, such as a decoded one, and returns it.
+func (r *ImagingStudy) Apply(opts ...ImagingStudyOption) *ImagingStudy {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithImagingStudyId sets the Id field.
 func WithImagingStudyId(v string) ImagingStudyOption {
 	return func(r *ImagingStudy) {
@@ -20,6 +20,14 @@ func NewImmunization(opts ...ImmunizationOption) *Immunization {
 	return r
 }
 
+// Apply applies the given options to an existing Immunization, such as a decoded one, and returns it.
+func (r *Immunization) Apply(opts ...ImmunizationOption) *Immunization {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithImmunizationId sets the Id field.
 func WithImmunizationId(v string) ImmunizationOption {
 	return func(r *Immunization) {
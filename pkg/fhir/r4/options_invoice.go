@@ -20,6 +20,14 @@ func NewInvoice(opts ...InvoiceOption) *Invoice {
 	return r
 }
 
+// Apply applies the given options to an existing Invoice, such as a decoded one, and returns it.
+func (r *Invoice) Apply(opts ...InvoiceOption) *Invoice {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithInvoiceId sets the Id field.
 func WithInvoiceId(v string) InvoiceOption {
 	return func(r *Invoice) {
@@ -20,6 +20,14 @@ func NewLibrary(opts ...LibraryOption) *Library {
 	return r
 }
 
+// Apply applies the given options to an existing Library, such as a decoded one, and returns it.
+func (r *Library) Apply(opts ...LibraryOption) *Library {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithLibraryId sets the Id field.
 func WithLibraryId(v string) LibraryOption {
 	return func(r *Library) {
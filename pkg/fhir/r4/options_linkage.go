@@ -20,6 +20,14 @@ func NewLinkage(opts ...LinkageOption) *Linkage {
 	return r
 }
 
+// Apply applies the given options to an existing Linkage, such as a decoded one, and returns it.
+func (r *Linkage) Apply(opts ...LinkageOption) *Linkage {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithLinkageId sets the Id field.
 func WithLinkageId(v string) LinkageOption {
 	return func(r *Linkage) {
@@ -20,6 +20,14 @@ func NewMedication(opts ...MedicationOption) *Medication {
 	return r
 }
 
+// Apply applies the given options to an existing Medication, such as a decoded one, and returns it.
+func (r *Medication) Apply(opts ...MedicationOption) *Medication {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithMedicationId sets the Id field.
 func WithMedicationId(v string) MedicationOption {
 	return func(r *Medication) {
@@ -20,6 +20,14 @@ func NewMedicationRequest(opts ...MedicationRequestOption) *MedicationRequest {
 	return r
 }
 
+// Apply applies the given options to an existing MedicationRequest, such as a decoded one, and returns it.
+func (r *MedicationRequest) Apply(opts ...MedicationRequestOption) *MedicationRequest {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithMedicationRequestId sets the Id field.
 func WithMedicationRequestId(v string) MedicationRequestOption {
 	return func(r *MedicationRequest) {
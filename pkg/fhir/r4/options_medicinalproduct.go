@@ -20,6 +20,14 @@ func NewMedicinalProduct(opts ...MedicinalProductOption) *MedicinalProduct {
 	return r
 }
 
+// Apply applies the given options to an existing MedicinalProduct, such as a decoded one, and returns it.
+func (r *MedicinalProduct) Apply(opts ...MedicinalProductOption) *MedicinalProduct {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithMedicinalProductId sets the Id field.
 func WithMedicinalProductId(v string) MedicinalProductOption {
 	return func(r *MedicinalProduct) {
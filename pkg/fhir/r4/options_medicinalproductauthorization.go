@@ -20,6 +20,14 @@ func NewMedicinalProductAuthorization(opts ...MedicinalProductAuthorizationOptio
 	return r
 }
 
+// Apply applies the given options to an existing MedicinalProductAuthorization, such as a decoded one, and returns it.
+func (r *MedicinalProductAuthorization) Apply(opts ...MedicinalProductAuthorizationOption) *MedicinalProductAuthorization {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithMedicinalProductAuthorizationId sets the Id field.
 func WithMedicinalProductAuthorizationId(v string) MedicinalProductAuthorizationOption {
 	return func(r *MedicinalProductAuthorization) {
@@ -20,6 +20,14 @@ func NewMedicinalProductContraindication(opts ...MedicinalProductContraindicatio
 	return r
 }
 
+// Apply applies the given options to an existing MedicinalProductContraindication, such as a decoded one, and returns it.
+func (r *MedicinalProductContraindication) Apply(opts ...MedicinalProductContraindicationOption) *MedicinalProductContraindication {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithMedicinalProductContraindicationId sets the Id field.
 func WithMedicinalProductContraindicationId(v string) MedicinalProductContraindicationOption {
 	return func(r *MedicinalProductContraindication) {
@@ -20,6 +20,14 @@ func NewMedicinalProductIndication(opts ...MedicinalProductIndicationOption) *Me
 	return r
 }
 
+// Apply applies the given options to an existing MedicinalProductIndication, such as a decoded one, and returns it.
+func (r *MedicinalProductIndication) Apply(opts ...MedicinalProductIndicationOption) *MedicinalProductIndication {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithMedicinalProductIndicationId sets the Id field.
 func WithMedicinalProductIndicationId(v string) MedicinalProductIndicationOption {
 	return func(r *MedicinalProductIndication) {
@@ -20,6 +20,14 @@ func NewMedicinalProductIngredient(opts ...MedicinalProductIngredientOption) *Me
 	return r
 }
 
+// Apply applies the given options to an existing MedicinalProductIngredient, such as a decoded one, and returns it.
+func (r *MedicinalProductIngredient) Apply(opts ...MedicinalProductIngredientOption) *MedicinalProductIngredient {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithMedicinalProductIngredientId sets the Id field.
 func WithMedicinalProductIngredientId(v string) MedicinalProductIngredientOption {
 	return func(r *MedicinalProductIngredient) {
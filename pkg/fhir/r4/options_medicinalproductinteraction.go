@@ -20,6 +20,14 @@ func NewMedicinalProductInteraction(opts ...MedicinalProductInteractionOption) *
 	return r
 }
 
+// Apply applies the given options to an existing MedicinalProductInteraction, such as a decoded one, and returns it.
+func (r *MedicinalProductInteraction) Apply(opts ...MedicinalProductInteractionOption) *MedicinalProductInteraction {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithMedicinalProductInteractionId sets the Id field.
 func WithMedicinalProductInteractionId(v string) MedicinalProductInteractionOption {
 	return func(r *MedicinalProductInteraction) {
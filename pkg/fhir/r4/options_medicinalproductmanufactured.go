@@ -20,6 +20,14 @@ func NewMedicinalProductManufactured(opts ...MedicinalProductManufacturedOption)
 	return r
 }
 
+// Apply applies the given options to an existing MedicinalProductManufactured, such as a decoded one, and returns it.
+func (r *MedicinalProductManufactured) Apply(opts ...MedicinalProductManufacturedOption) *MedicinalProductManufactured {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithMedicinalProductManufacturedId sets the Id field.
 func WithMedicinalProductManufacturedId(v string) MedicinalProductManufacturedOption {
 	return func(r *MedicinalProductManufactured) {
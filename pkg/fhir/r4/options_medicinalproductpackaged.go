@@ -20,6 +20,14 @@ func NewMedicinalProductPackaged(opts ...MedicinalProductPackagedOption) *Medici
 	return r
 }
 
+// Apply applies the given options to an existing MedicinalProductPackaged, such as a decoded one, and returns it.
+func (r *MedicinalProductPackaged) Apply(opts ...MedicinalProductPackagedOption) *MedicinalProductPackaged {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithMedicinalProductPackagedId sets the Id field.
 func WithMedicinalProductPackagedId(v string) MedicinalProductPackagedOption {
 	return func(r *MedicinalProductPackaged) {
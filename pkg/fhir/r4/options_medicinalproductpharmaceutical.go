@@ -20,6 +20,14 @@ func NewMedicinalProductPharmaceutical(opts ...MedicinalProductPharmaceuticalOpt
 	return r
 }
 
+// Apply applies the given options to an existing MedicinalProductPharmaceutical, such as a decoded one, and returns it.
+func (r *MedicinalProductPharmaceutical) Apply(opts ...MedicinalProductPharmaceuticalOption) *MedicinalProductPharmaceutical {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithMedicinalProductPharmaceuticalId sets the Id field.
 func WithMedicinalProductPharmaceuticalId(v string) MedicinalProductPharmaceuticalOption {
 	return func(r *MedicinalProductPharmaceutical) {
@@ -20,6 +20,14 @@ func NewMedicinalProductUndesirableEffect(opts ...MedicinalProductUndesirableEff
 	return r
 }
 
+// Apply applies the given options to an existing MedicinalProductUndesirableEffect, such as a decoded one, and returns it.
+func (r *MedicinalProductUndesirableEffect) Apply(opts ...MedicinalProductUndesirableEffectOption) *MedicinalProductUndesirableEffect {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithMedicinalProductUndesirableEffectId sets the Id field.
 func WithMedicinalProductUndesirableEffectId(v string) MedicinalProductUndesirableEffectOption {
 	return func(r *MedicinalProductUndesirableEffect) {
@@ -20,6 +20,14 @@ func NewParameters(opts ...ParametersOption) *Parameters {
 	return r
 }
 
+// Apply applies the given options to an existing Parameters, such as a decoded one, and returns it.
+func (r *Parameters) Apply(opts ...ParametersOption) *Parameters {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithParametersId sets the Id field.
 func WithParametersId(v string) ParametersOption {
 	return func(r *Parameters) {
@@ -20,6 +20,14 @@ func NewPatient(opts ...PatientOption) *Patient {
 	return r
 }
 
+// Apply applies the given options to an existing Patient, such as a decoded one, and returns it.
+func (r *Patient) Apply(opts ...PatientOption) *Patient {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithPatientId sets the Id field.
 func WithPatientId(v string) PatientOption {
 	return func(r *Patient) {
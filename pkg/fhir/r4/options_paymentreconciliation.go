@@ -20,6 +20,14 @@ func NewPaymentReconciliation(opts ...PaymentReconciliationOption) *PaymentRecon
 	return r
 }
 
+// Apply applies the given options to an existing PaymentReconciliation, such as a decoded one, and returns it.
+func (r *PaymentReconciliation) Apply(opts ...PaymentReconciliationOption) *PaymentReconciliation {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithPaymentReconciliationId sets the Id field.
 func WithPaymentReconciliationId(v string) PaymentReconciliationOption {
 	return func(r *PaymentReconciliation) {
@@ -20,6 +20,14 @@ func NewPerson(opts ...PersonOption) *Person {
 	return r
 }
 
+// Apply applies the given options to an existing Person, such as a decoded one, and returns it.
+func (r *Person) Apply(opts ...PersonOption) *Person {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithPersonId sets the Id field.
 func WithPersonId(v string) PersonOption {
 	return func(r *Person) {
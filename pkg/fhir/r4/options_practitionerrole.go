@@ -20,6 +20,14 @@ func NewPractitionerRole(opts ...PractitionerRoleOption) *PractitionerRole {
 	return r
 }
 
+// Apply applies the given options to an existing PractitionerRole, such as a decoded one, and returns it.
+func (r *PractitionerRole) Apply(opts ...PractitionerRoleOption) *PractitionerRole {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithPractitionerRoleId sets the Id field.
 func WithPractitionerRoleId(v string) PractitionerRoleOption {
 	return func(r *PractitionerRole) {
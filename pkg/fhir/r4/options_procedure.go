@@ -20,6 +20,14 @@ func NewProcedure(opts ...ProcedureOption) *Procedure {
 	return r
 }
 
+// Apply applies the given options to an existing Procedure, such as a decoded one, and returns it.
+func (r *Procedure) Apply(opts ...ProcedureOption) *Procedure {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithProcedureId sets the Id field.
 func WithProcedureId(v string) ProcedureOption {
 	return func(r *Procedure) {
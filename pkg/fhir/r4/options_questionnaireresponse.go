@@ -20,6 +20,14 @@ func NewQuestionnaireResponse(opts ...QuestionnaireResponseOption) *Questionnair
 	return r
 }
 
+// Apply applies the given options to an existing QuestionnaireResponse, such as a decoded one, and returns it.
+func (r *QuestionnaireResponse) Apply(opts ...QuestionnaireResponseOption) *QuestionnaireResponse {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithQuestionnaireResponseId sets the Id field.
 func WithQuestionnaireResponseId(v string) QuestionnaireResponseOption {
 	return func(r *QuestionnaireResponse) {
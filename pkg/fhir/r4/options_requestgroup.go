@@ -20,6 +20,14 @@ func NewRequestGroup(opts ...RequestGroupOption) *RequestGroup {
 	return r
 }
 
+// Apply applies the given options to an existing RequestGroup, such as a decoded one, and returns it.
+func (r *RequestGroup) Apply(opts ...RequestGroupOption) *RequestGroup {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithRequestGroupId sets the Id field.
 func WithRequestGroupId(v string) RequestGroupOption {
 	return func(r *RequestGroup) {
@@ -20,6 +20,14 @@ func NewResearchDefinition(opts ...ResearchDefinitionOption) *ResearchDefinition
 	return r
 }
 
+// Apply applies the given options to an existing ResearchDefinition, such as a decoded one, and returns it.
+func (r *ResearchDefinition) Apply(opts ...ResearchDefinitionOption) *ResearchDefinition {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithResearchDefinitionId sets the Id field.
 func WithResearchDefinitionId(v string) ResearchDefinitionOption {
 	return func(r *ResearchDefinition) {
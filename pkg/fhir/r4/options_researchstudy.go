@@ -20,6 +20,14 @@ func NewResearchStudy(opts ...ResearchStudyOption) *ResearchStudy {
 	return r
 }
 
+// Apply applies the given options to an existing ResearchStudy, such as a decoded one, and returns it.
+func (r *ResearchStudy) Apply(opts ...ResearchStudyOption) *ResearchStudy {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithResearchStudyId sets the Id field.
 func WithResearchStudyId(v string) ResearchStudyOption {
 	return func(r *ResearchStudy) {
@@ -20,6 +20,14 @@ func NewRiskEvidenceSynthesis(opts ...RiskEvidenceSynthesisOption) *RiskEvidence
 	return r
 }
 
+// Apply applies the given options to an existing RiskEvidenceSynthesis, such as a decoded one, and returns it.
+func (r *RiskEvidenceSynthesis) Apply(opts ...RiskEvidenceSynthesisOption) *RiskEvidenceSynthesis {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithRiskEvidenceSynthesisId sets the Id field.
 func WithRiskEvidenceSynthesisId(v string) RiskEvidenceSynthesisOption {
 	return func(r *RiskEvidenceSynthesis) {
@@ -20,6 +20,14 @@ func NewServiceRequest(opts ...ServiceRequestOption) *ServiceRequest {
 	return r
 }
 
+// Apply applies the given options to an existing ServiceRequest, such as a decoded one, and returns it.
+func (r *ServiceRequest) Apply(opts ...ServiceRequestOption) *ServiceRequest {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithServiceRequestId sets the Id field.
 func WithServiceRequestId(v string) ServiceRequestOption {
 	return func(r *ServiceRequest) {
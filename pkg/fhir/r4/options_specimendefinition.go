@@ -20,6 +20,14 @@ func NewSpecimenDefinition(opts ...SpecimenDefinitionOption) *SpecimenDefinition
 	return r
 }
 
+// Apply applies the given options to an existing SpecimenDefinition, such as a decoded one, and returns it.
+func (r *SpecimenDefinition) Apply(opts ...SpecimenDefinitionOption) *SpecimenDefinition {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithSpecimenDefinitionId sets the Id field.
 func WithSpecimenDefinitionId(v string) SpecimenDefinitionOption {
 	return func(r *SpecimenDefinition) {
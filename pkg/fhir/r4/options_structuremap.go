@@ -20,6 +20,14 @@ func NewStructureMap(opts ...StructureMapOption) *StructureMap {
 	return r
 }
 
+// Apply applies the given options to an existing StructureMap, such as a decoded one, and returns it.
+func (r *StructureMap) Apply(opts ...StructureMapOption) *StructureMap {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithStructureMapId sets the Id field.
 func WithStructureMapId(v string) StructureMapOption {
 	return func(r *StructureMap) {
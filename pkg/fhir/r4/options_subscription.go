@@ -20,6 +20,14 @@ func NewSubscription(opts ...SubscriptionOption) *Subscription {
 	return r
 }
 
+// Apply applies the given options to an existing Subscription, such as a decoded one, and returns it.
+func (r *Subscription) Apply(opts ...SubscriptionOption) *Subscription {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithSubscriptionId sets the Id field.
 func WithSubscriptionId(v string) SubscriptionOption {
 	return func(r *Subscription) {
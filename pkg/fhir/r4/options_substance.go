@@ -20,6 +20,14 @@ func NewSubstance(opts ...SubstanceOption) *Substance {
 	return r
 }
 
+// Apply applies the given options to an existing Substance, such as a decoded one, and returns it.
+func (r *Substance) Apply(opts ...SubstanceOption) *Substance {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithSubstanceId sets the Id field.
 func WithSubstanceId(v string) SubstanceOption {
 	return func(r *Substance) {
@@ -20,6 +20,14 @@ func NewSubstanceNucleicAcid(opts ...SubstanceNucleicAcidOption) *SubstanceNucle
 	return r
 }
 
+// Apply applies the given options to an existing SubstanceNucleicAcid, such as a decoded one, and returns it.
+func (r *SubstanceNucleicAcid) Apply(opts ...SubstanceNucleicAcidOption) *SubstanceNucleicAcid {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithSubstanceNucleicAcidId sets the Id field.
 func WithSubstanceNucleicAcidId(v string) SubstanceNucleicAcidOption {
 	return func(r *SubstanceNucleicAcid) {
@@ -20,6 +20,14 @@ func NewSubstanceProtein(opts ...SubstanceProteinOption) *SubstanceProtein {
 	return r
 }
 
+// Apply applies the given options to an existing SubstanceProtein, such as a decoded one, and returns it.
+func (r *SubstanceProtein) Apply(opts ...SubstanceProteinOption) *SubstanceProtein {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithSubstanceProteinId sets the Id field.
 func WithSubstanceProteinId(v string) SubstanceProteinOption {
 	return func(r *SubstanceProtein) {
@@ -20,6 +20,14 @@ func NewSubstanceReferenceInformation(opts ...SubstanceReferenceInformationOptio
 	return r
 }
 
+// Apply applies the given options to an existing SubstanceReferenceInformation, such as a decoded one, and returns it.
+func (r *SubstanceReferenceInformation) Apply(opts ...SubstanceReferenceInformationOption) *SubstanceReferenceInformation {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithSubstanceReferenceInformationId sets the Id field.
 func WithSubstanceReferenceInformationId(v string) SubstanceReferenceInformationOption {
 	return func(r *SubstanceReferenceInformation) {
@@ -20,6 +20,14 @@ func NewSubstanceSpecification(opts ...SubstanceSpecificationOption) *SubstanceS
 	return r
 }
 
+// Apply applies the given options to an existing SubstanceSpecification, such as a decoded one, and returns it.
+func (r *SubstanceSpecification) Apply(opts ...SubstanceSpecificationOption) *SubstanceSpecification {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithSubstanceSpecificationId sets the Id field.
 func WithSubstanceSpecificationId(v string) SubstanceSpecificationOption {
 	return func(r *SubstanceSpecification) {
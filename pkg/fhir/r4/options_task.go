@@ -20,6 +20,14 @@ func NewTask(opts ...TaskOption) *Task {
 	return r
 }
 
+// Apply applies the given options to an existing Task, such as a decoded one, and returns it.
+func (r *Task) Apply(opts ...TaskOption) *Task {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithTaskId sets the Id field.
 func WithTaskId(v string) TaskOption {
 	return func(r *Task) {
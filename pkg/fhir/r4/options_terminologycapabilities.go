@@ -20,6 +20,14 @@ func NewTerminologyCapabilities(opts ...TerminologyCapabilitiesOption) *Terminol
 	return r
 }
 
+// Apply applies the given options to an existing TerminologyCapabilities, such as a decoded one, and returns it.
+func (r *TerminologyCapabilities) Apply(opts ...TerminologyCapabilitiesOption) *TerminologyCapabilities {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithTerminologyCapabilitiesId sets the Id field.
 func WithTerminologyCapabilitiesId(v string) TerminologyCapabilitiesOption {
 	return func(r *TerminologyCapabilities) {
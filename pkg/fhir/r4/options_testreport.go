@@ -20,6 +20,14 @@ func NewTestReport(opts ...TestReportOption) *TestReport {
 	return r
 }
 
+// Apply applies the given options to an existing TestReport, such as a decoded one, and returns it.
+func (r *TestReport) Apply(opts ...TestReportOption) *TestReport {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithTestReportId sets the Id field.
 func WithTestReportId(v string) TestReportOption {
 	return func(r *TestReport) {
@@ -20,6 +20,14 @@ func NewTestScript(opts ...TestScriptOption) *TestScript {
 	return r
 }
 
+// Apply applies the given options to an existing TestScript, such as a decoded one, and returns it.
+func (r *TestScript) Apply(opts ...TestScriptOption) *TestScript {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithTestScriptId sets the Id field.
 func WithTestScriptId(v string) TestScriptOption {
 	return func(r *TestScript) {
@@ -20,6 +20,14 @@ func NewVerificationResult(opts ...VerificationResultOption) *VerificationResult
 	return r
 }
 
+// Apply applies the given options to an existing VerificationResult, such as a decoded one, and returns it.
+func (r *VerificationResult) Apply(opts ...VerificationResultOption) *VerificationResult {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithVerificationResultId sets the Id field.
 func WithVerificationResultId(v string) VerificationResultOption {
 	return func(r *VerificationResult) {
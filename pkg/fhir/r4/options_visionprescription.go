@@ -20,6 +20,14 @@ func NewVisionPrescription(opts ...VisionPrescriptionOption) *VisionPrescription
 	return r
 }
 
+// Apply applies the given options to an existing VisionPrescription, such as a decoded one, and returns it.
+func (r *VisionPrescription) Apply(opts ...VisionPrescriptionOption) *VisionPrescription {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithVisionPrescriptionId sets the Id field.
 func WithVisionPrescriptionId(v string) VisionPrescriptionOption {
 	return func(r *VisionPrescription) {
@@ -2,6 +2,7 @@ package r4_test
 
 import (
 	"encoding/json"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -170,6 +171,28 @@ func TestUnmarshalResourceRoundTrip(t *testing.T) {
 	assert.Equal(t, []string{"John"}, patient.Name[0].Given)
 }
 
+func TestUnmarshalResourcePreservesUnknownFields(t *testing.T) {
+	data := []byte(`{"resourceType":"Patient","id":"p1","vendorScore":{"value":42},"active":true}`)
+
+	resource, err := r4.UnmarshalResourceWithOptions(data, r4.UnmarshalOptions{PreserveUnknownFields: true})
+	require.NoError(t, err)
+
+	patient, ok := resource.(*r4.Patient)
+	require.True(t, ok, "expected *r4.Patient, got %T", resource)
+	assert.Equal(t, "p1", *patient.Id)
+	require.Len(t, patient.Extra, 1)
+	assert.JSONEq(t, `{"value":42}`, string(patient.Extra["vendorScore"]))
+
+	out, err := json.Marshal(patient)
+	require.NoError(t, err)
+	assert.JSONEq(t, string(data), string(out))
+
+	// Without the option unknown fields are dropped
+	resource, err = r4.UnmarshalResourceWithOptions(data, r4.UnmarshalOptions{})
+	require.NoError(t, err)
+	assert.Empty(t, resource.(*r4.Patient).Extra)
+}
+
 func TestGetResourceType(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -259,6 +282,22 @@ func TestAllResourceTypes(t *testing.T) {
 	assert.True(t, typeSet["Medication"], "should include Medication")
 }
 
+func TestResourceTypeConstants(t *testing.T) {
+	// One constant per generated resource file
+	files, err := filepath.Glob("resource_*.go")
+	require.NoError(t, err)
+	assert.Len(t, r4.ResourceTypes, len(files))
+	assert.Len(t, r4.AllResourceTypes(), len(r4.ResourceTypes))
+
+	assert.Equal(t, r4.ResourceType("Patient"), r4.ResourceTypePatient)
+
+	for _, rt := range r4.ResourceTypes {
+		resource, err := r4.NewResource(string(rt))
+		require.NoError(t, err)
+		assert.Equal(t, string(rt), resource.GetResourceType())
+	}
+}
+
 // Helper functions
 func ptrString(s string) *string {
 	return &s
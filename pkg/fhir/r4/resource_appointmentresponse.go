@@ -57,11 +57,13 @@ type AppointmentResponse struct {
 	Comment *string `json:"comment,omitempty"`
 	// Extension for Comment
 	CommentExt *Element `json:"_comment,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *AppointmentResponse) GetResourceType() string {
-	return "AppointmentResponse"
+	return string(ResourceTypeAppointmentResponse)
 }
 
 // GetId returns the resource's logical ID.
@@ -109,11 +111,16 @@ func (r *AppointmentResponse) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r AppointmentResponse) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "AppointmentResponse"
+	r.ResourceType = string(ResourceTypeAppointmentResponse)
 	type Alias AppointmentResponse
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
@@ -45,11 +45,13 @@ type Basic struct {
 	CreatedExt *Element `json:"_created,omitempty"`
 	// Who created
 	Author *Reference `json:"author,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *Basic) GetResourceType() string {
-	return "Basic"
+	return string(ResourceTypeBasic)
 }
 
 // GetId returns the resource's logical ID.
@@ -97,11 +99,16 @@ func (r *Basic) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r Basic) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "Basic"
+	r.ResourceType = string(ResourceTypeBasic)
 	type Alias Basic
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
@@ -105,11 +105,13 @@ type ChargeItem struct {
 	Note []Annotation `json:"note,omitempty"`
 	// Further information supporting this charge
 	SupportingInformation []Reference `json:"supportingInformation,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *ChargeItem) GetResourceType() string {
-	return "ChargeItem"
+	return string(ResourceTypeChargeItem)
 }
 
 // GetId returns the resource's logical ID.
@@ -157,11 +159,16 @@ func (r *ChargeItem) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r ChargeItem) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "ChargeItem"
+	r.ResourceType = string(ResourceTypeChargeItem)
 	type Alias ChargeItem
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
@@ -87,11 +87,13 @@ type ClinicalImpression struct {
 	SupportingInfo []Reference `json:"supportingInfo,omitempty"`
 	// Comments made about the ClinicalImpression
 	Note []Annotation `json:"note,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *ClinicalImpression) GetResourceType() string {
-	return "ClinicalImpression"
+	return string(ResourceTypeClinicalImpression)
 }
 
 // GetId returns the resource's logical ID.
@@ -139,11 +141,16 @@ func (r *ClinicalImpression) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r ClinicalImpression) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "ClinicalImpression"
+	r.ResourceType = string(ResourceTypeClinicalImpression)
 	type Alias ClinicalImpression
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
@@ -121,11 +121,13 @@ type Contract struct {
 	LegallyBindingAttachment *Attachment `json:"legallyBindingAttachment,omitempty"`
 	// Binding Contract
 	LegallyBindingReference *Reference `json:"legallyBindingReference,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *Contract) GetResourceType() string {
-	return "Contract"
+	return string(ResourceTypeContract)
 }
 
 // GetId returns the resource's logical ID.
@@ -173,11 +175,16 @@ func (r *Contract) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r Contract) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "Contract"
+	r.ResourceType = string(ResourceTypeContract)
 	type Alias Contract
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
@@ -59,11 +59,13 @@ type DeviceMetric struct {
 	MeasurementPeriod *Timing `json:"measurementPeriod,omitempty"`
 	// Describes the calibrations that have been performed or that are required to be performed
 	Calibration []DeviceMetricCalibration `json:"calibration,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *DeviceMetric) GetResourceType() string {
-	return "DeviceMetric"
+	return string(ResourceTypeDeviceMetric)
 }
 
 // GetId returns the resource's logical ID.
@@ -111,11 +113,16 @@ func (r *DeviceMetric) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r DeviceMetric) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "DeviceMetric"
+	r.ResourceType = string(ResourceTypeDeviceMetric)
 	type Alias DeviceMetric
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
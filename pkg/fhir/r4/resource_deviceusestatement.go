@@ -69,11 +69,13 @@ type DeviceUseStatement struct {
 	BodySite *CodeableConcept `json:"bodySite,omitempty"`
 	// Addition details (comments, instructions)
 	Note []Annotation `json:"note,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *DeviceUseStatement) GetResourceType() string {
-	return "DeviceUseStatement"
+	return string(ResourceTypeDeviceUseStatement)
 }
 
 // GetId returns the resource's logical ID.
@@ -121,11 +123,16 @@ func (r *DeviceUseStatement) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r DeviceUseStatement) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "DeviceUseStatement"
+	r.ResourceType = string(ResourceTypeDeviceUseStatement)
 	type Alias DeviceUseStatement
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
@@ -73,11 +73,13 @@ type DocumentReference struct {
 	Content []DocumentReferenceContent `json:"content,omitempty"`
 	// Clinical context of document
 	Context *DocumentReferenceContext `json:"context,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *DocumentReference) GetResourceType() string {
-	return "DocumentReference"
+	return string(ResourceTypeDocumentReference)
 }
 
 // GetId returns the resource's logical ID.
@@ -125,11 +127,16 @@ func (r *DocumentReference) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r DocumentReference) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "DocumentReference"
+	r.ResourceType = string(ResourceTypeDocumentReference)
 	type Alias DocumentReference
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
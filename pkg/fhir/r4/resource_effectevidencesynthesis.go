@@ -121,11 +121,13 @@ type EffectEvidenceSynthesis struct {
 	EffectEstimate []EffectEvidenceSynthesisEffectEstimate `json:"effectEstimate,omitempty"`
 	// How certain is the effect
 	Certainty []EffectEvidenceSynthesisCertainty `json:"certainty,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *EffectEvidenceSynthesis) GetResourceType() string {
-	return "EffectEvidenceSynthesis"
+	return string(ResourceTypeEffectEvidenceSynthesis)
 }
 
 // GetId returns the resource's logical ID.
@@ -173,11 +175,31 @@ func (r *EffectEvidenceSynthesis) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// GetUrl returns the resource's canonical URL.
+func (r *EffectEvidenceSynthesis) GetUrl() *string {
+	return r.Url
+}
+
+// GetVersion returns the resource's business version.
+func (r *EffectEvidenceSynthesis) GetVersion() *string {
+	return r.Version
+}
+
+// GetStatus returns the resource's publication status.
+func (r *EffectEvidenceSynthesis) GetStatus() *PublicationStatus {
+	return r.Status
+}
+
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r EffectEvidenceSynthesis) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "EffectEvidenceSynthesis"
+	r.ResourceType = string(ResourceTypeEffectEvidenceSynthesis)
 	type Alias EffectEvidenceSynthesis
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
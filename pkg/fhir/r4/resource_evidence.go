@@ -115,11 +115,13 @@ type Evidence struct {
 	ExposureVariant []Reference `json:"exposureVariant,omitempty"`
 	// What outcome?
 	Outcome []Reference `json:"outcome,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *Evidence) GetResourceType() string {
-	return "Evidence"
+	return string(ResourceTypeEvidence)
 }
 
 // GetId returns the resource's logical ID.
@@ -167,11 +169,31 @@ func (r *Evidence) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// GetUrl returns the resource's canonical URL.
+func (r *Evidence) GetUrl() *string {
+	return r.Url
+}
+
+// GetVersion returns the resource's business version.
+func (r *Evidence) GetVersion() *string {
+	return r.Version
+}
+
+// GetStatus returns the resource's publication status.
+func (r *Evidence) GetStatus() *PublicationStatus {
+	return r.Status
+}
+
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r Evidence) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "Evidence"
+	r.ResourceType = string(ResourceTypeEvidence)
 	type Alias Evidence
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
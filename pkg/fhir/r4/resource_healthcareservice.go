@@ -93,11 +93,13 @@ type HealthcareService struct {
 	AvailabilityExceptionsExt *Element `json:"_availabilityExceptions,omitempty"`
 	// Technical endpoints providing access to electronic services operated for the healthcare service
 	Endpoint []Reference `json:"endpoint,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *HealthcareService) GetResourceType() string {
-	return "HealthcareService"
+	return string(ResourceTypeHealthcareService)
 }
 
 // GetId returns the resource's logical ID.
@@ -145,11 +147,16 @@ func (r *HealthcareService) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r HealthcareService) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "HealthcareService"
+	r.ResourceType = string(ResourceTypeHealthcareService)
 	type Alias HealthcareService
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
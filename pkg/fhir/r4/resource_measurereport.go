@@ -63,11 +63,13 @@ type MeasureReport struct {
 	Group []MeasureReportGroup `json:"group,omitempty"`
 	// What data was used to calculate the measure score
 	EvaluatedResource []Reference `json:"evaluatedResource,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *MeasureReport) GetResourceType() string {
-	return "MeasureReport"
+	return string(ResourceTypeMeasureReport)
 }
 
 // GetId returns the resource's logical ID.
@@ -115,11 +117,16 @@ func (r *MeasureReport) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r MeasureReport) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "MeasureReport"
+	r.ResourceType = string(ResourceTypeMeasureReport)
 	type Alias MeasureReport
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
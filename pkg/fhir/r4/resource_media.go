@@ -95,11 +95,13 @@ type Media struct {
 	Content Attachment `json:"content"`
 	// Comments made about the media
 	Note []Annotation `json:"note,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *Media) GetResourceType() string {
-	return "Media"
+	return string(ResourceTypeMedia)
 }
 
 // GetId returns the resource's logical ID.
@@ -147,11 +149,16 @@ func (r *Media) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r Media) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "Media"
+	r.ResourceType = string(ResourceTypeMedia)
 	type Alias Media
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
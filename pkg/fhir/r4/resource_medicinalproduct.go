@@ -75,11 +75,13 @@ type MedicinalProduct struct {
 	ManufacturingBusinessOperation []MedicinalProductManufacturingBusinessOperation `json:"manufacturingBusinessOperation,omitempty"`
 	// Indicates if the medicinal product has an orphan designation for the treatment of a rare disease
 	SpecialDesignation []MedicinalProductSpecialDesignation `json:"specialDesignation,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *MedicinalProduct) GetResourceType() string {
-	return "MedicinalProduct"
+	return string(ResourceTypeMedicinalProduct)
 }
 
 // GetId returns the resource's logical ID.
@@ -127,11 +129,16 @@ func (r *MedicinalProduct) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r MedicinalProduct) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "MedicinalProduct"
+	r.ResourceType = string(ResourceTypeMedicinalProduct)
 	type Alias MedicinalProduct
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
@@ -73,11 +73,13 @@ type MedicinalProductAuthorization struct {
 	Regulator *Reference `json:"regulator,omitempty"`
 	// The regulatory procedure for granting or amending a marketing authorization
 	Procedure *MedicinalProductAuthorizationProcedure `json:"procedure,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *MedicinalProductAuthorization) GetResourceType() string {
-	return "MedicinalProductAuthorization"
+	return string(ResourceTypeMedicinalProductAuthorization)
 }
 
 // GetId returns the resource's logical ID.
@@ -125,11 +127,16 @@ func (r *MedicinalProductAuthorization) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r MedicinalProductAuthorization) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "MedicinalProductAuthorization"
+	r.ResourceType = string(ResourceTypeMedicinalProductAuthorization)
 	type Alias MedicinalProductAuthorization
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
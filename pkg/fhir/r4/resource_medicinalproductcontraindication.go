@@ -47,11 +47,13 @@ type MedicinalProductContraindication struct {
 	OtherTherapy []MedicinalProductContraindicationOtherTherapy `json:"otherTherapy,omitempty"`
 	// The population group to which this applies
 	Population []Population `json:"population,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *MedicinalProductContraindication) GetResourceType() string {
-	return "MedicinalProductContraindication"
+	return string(ResourceTypeMedicinalProductContraindication)
 }
 
 // GetId returns the resource's logical ID.
@@ -99,11 +101,16 @@ func (r *MedicinalProductContraindication) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r MedicinalProductContraindication) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "MedicinalProductContraindication"
+	r.ResourceType = string(ResourceTypeMedicinalProductContraindication)
 	type Alias MedicinalProductContraindication
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
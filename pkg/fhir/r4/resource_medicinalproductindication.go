@@ -51,11 +51,13 @@ type MedicinalProductIndication struct {
 	UndesirableEffect []Reference `json:"undesirableEffect,omitempty"`
 	// The population group to which this applies
 	Population []Population `json:"population,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *MedicinalProductIndication) GetResourceType() string {
-	return "MedicinalProductIndication"
+	return string(ResourceTypeMedicinalProductIndication)
 }
 
 // GetId returns the resource's logical ID.
@@ -103,11 +105,16 @@ func (r *MedicinalProductIndication) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r MedicinalProductIndication) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "MedicinalProductIndication"
+	r.ResourceType = string(ResourceTypeMedicinalProductIndication)
 	type Alias MedicinalProductIndication
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
@@ -47,11 +47,13 @@ type MedicinalProductIngredient struct {
 	SpecifiedSubstance []MedicinalProductIngredientSpecifiedSubstance `json:"specifiedSubstance,omitempty"`
 	// The ingredient substance
 	Substance *MedicinalProductIngredientSubstance `json:"substance,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *MedicinalProductIngredient) GetResourceType() string {
-	return "MedicinalProductIngredient"
+	return string(ResourceTypeMedicinalProductIngredient)
 }
 
 // GetId returns the resource's logical ID.
@@ -99,11 +101,16 @@ func (r *MedicinalProductIngredient) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r MedicinalProductIngredient) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "MedicinalProductIngredient"
+	r.ResourceType = string(ResourceTypeMedicinalProductIngredient)
 	type Alias MedicinalProductIngredient
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
@@ -49,11 +49,13 @@ type MedicinalProductInteraction struct {
 	Incidence *CodeableConcept `json:"incidence,omitempty"`
 	// Actions for managing the interaction
 	Management *CodeableConcept `json:"management,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *MedicinalProductInteraction) GetResourceType() string {
-	return "MedicinalProductInteraction"
+	return string(ResourceTypeMedicinalProductInteraction)
 }
 
 // GetId returns the resource's logical ID.
@@ -101,11 +103,16 @@ func (r *MedicinalProductInteraction) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r MedicinalProductInteraction) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "MedicinalProductInteraction"
+	r.ResourceType = string(ResourceTypeMedicinalProductInteraction)
 	type Alias MedicinalProductInteraction
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
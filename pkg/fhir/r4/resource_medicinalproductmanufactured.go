@@ -47,11 +47,13 @@ type MedicinalProductManufactured struct {
 	PhysicalCharacteristics *ProdCharacteristic `json:"physicalCharacteristics,omitempty"`
 	// Other codeable characteristics
 	OtherCharacteristics []CodeableConcept `json:"otherCharacteristics,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *MedicinalProductManufactured) GetResourceType() string {
-	return "MedicinalProductManufactured"
+	return string(ResourceTypeMedicinalProductManufactured)
 }
 
 // GetId returns the resource's logical ID.
@@ -99,11 +101,16 @@ func (r *MedicinalProductManufactured) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r MedicinalProductManufactured) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "MedicinalProductManufactured"
+	r.ResourceType = string(ResourceTypeMedicinalProductManufactured)
 	type Alias MedicinalProductManufactured
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
@@ -53,11 +53,13 @@ type MedicinalProductPackaged struct {
 	BatchIdentifier []MedicinalProductPackagedBatchIdentifier `json:"batchIdentifier,omitempty"`
 	// A packaging item, as a contained for medicine, possibly with other packaging items within
 	PackageItem []MedicinalProductPackagedPackageItem `json:"packageItem,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *MedicinalProductPackaged) GetResourceType() string {
-	return "MedicinalProductPackaged"
+	return string(ResourceTypeMedicinalProductPackaged)
 }
 
 // GetId returns the resource's logical ID.
@@ -105,11 +107,16 @@ func (r *MedicinalProductPackaged) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r MedicinalProductPackaged) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "MedicinalProductPackaged"
+	r.ResourceType = string(ResourceTypeMedicinalProductPackaged)
 	type Alias MedicinalProductPackaged
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
@@ -47,11 +47,13 @@ type MedicinalProductPharmaceutical struct {
 	Characteristics []MedicinalProductPharmaceuticalCharacteristics `json:"characteristics,omitempty"`
 	// The path by which the pharmaceutical product is taken into or makes contact with the body
 	RouteOfAdministration []MedicinalProductPharmaceuticalRouteOfAdministration `json:"routeOfAdministration,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *MedicinalProductPharmaceutical) GetResourceType() string {
-	return "MedicinalProductPharmaceutical"
+	return string(ResourceTypeMedicinalProductPharmaceutical)
 }
 
 // GetId returns the resource's logical ID.
@@ -99,11 +101,16 @@ func (r *MedicinalProductPharmaceutical) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r MedicinalProductPharmaceutical) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "MedicinalProductPharmaceutical"
+	r.ResourceType = string(ResourceTypeMedicinalProductPharmaceutical)
 	type Alias MedicinalProductPharmaceutical
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
@@ -43,11 +43,13 @@ type MedicinalProductUndesirableEffect struct {
 	FrequencyOfOccurrence *CodeableConcept `json:"frequencyOfOccurrence,omitempty"`
 	// The population group to which this applies
 	Population []Population `json:"population,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *MedicinalProductUndesirableEffect) GetResourceType() string {
-	return "MedicinalProductUndesirableEffect"
+	return string(ResourceTypeMedicinalProductUndesirableEffect)
 }
 
 // GetId returns the resource's logical ID.
@@ -95,11 +97,16 @@ func (r *MedicinalProductUndesirableEffect) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r MedicinalProductUndesirableEffect) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "MedicinalProductUndesirableEffect"
+	r.ResourceType = string(ResourceTypeMedicinalProductUndesirableEffect)
 	type Alias MedicinalProductUndesirableEffect
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
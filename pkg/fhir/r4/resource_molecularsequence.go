@@ -73,11 +73,13 @@ type MolecularSequence struct {
 	Pointer []Reference `json:"pointer,omitempty"`
 	// Structural variant
 	StructureVariant []MolecularSequenceStructureVariant `json:"structureVariant,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *MolecularSequence) GetResourceType() string {
-	return "MolecularSequence"
+	return string(ResourceTypeMolecularSequence)
 }
 
 // GetId returns the resource's logical ID.
@@ -125,11 +127,16 @@ func (r *MolecularSequence) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r MolecularSequence) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "MolecularSequence"
+	r.ResourceType = string(ResourceTypeMolecularSequence)
 	type Alias MolecularSequence
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
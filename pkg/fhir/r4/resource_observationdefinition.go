@@ -65,11 +65,13 @@ type ObservationDefinition struct {
 	AbnormalCodedValueSet *Reference `json:"abnormalCodedValueSet,omitempty"`
 	// Value set of critical coded values for the observations conforming to this ObservationDefinition
 	CriticalCodedValueSet *Reference `json:"criticalCodedValueSet,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *ObservationDefinition) GetResourceType() string {
-	return "ObservationDefinition"
+	return string(ResourceTypeObservationDefinition)
 }
 
 // GetId returns the resource's logical ID.
@@ -117,11 +119,16 @@ func (r *ObservationDefinition) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r ObservationDefinition) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "ObservationDefinition"
+	r.ResourceType = string(ResourceTypeObservationDefinition)
 	type Alias ObservationDefinition
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
@@ -59,11 +59,13 @@ type OrganizationAffiliation struct {
 	Telecom []ContactPoint `json:"telecom,omitempty"`
 	// Technical endpoints providing access to services operated for this role
 	Endpoint []Reference `json:"endpoint,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *OrganizationAffiliation) GetResourceType() string {
-	return "OrganizationAffiliation"
+	return string(ResourceTypeOrganizationAffiliation)
 }
 
 // GetId returns the resource's logical ID.
@@ -111,11 +113,16 @@ func (r *OrganizationAffiliation) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r OrganizationAffiliation) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "OrganizationAffiliation"
+	r.ResourceType = string(ResourceTypeOrganizationAffiliation)
 	type Alias OrganizationAffiliation
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
@@ -129,11 +129,13 @@ type PlanDefinition struct {
 	Goal []PlanDefinitionGoal `json:"goal,omitempty"`
 	// Action defined by the plan
 	Action []PlanDefinitionAction `json:"action,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *PlanDefinition) GetResourceType() string {
-	return "PlanDefinition"
+	return string(ResourceTypePlanDefinition)
 }
 
 // GetId returns the resource's logical ID.
@@ -181,11 +183,31 @@ func (r *PlanDefinition) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// GetUrl returns the resource's canonical URL.
+func (r *PlanDefinition) GetUrl() *string {
+	return r.Url
+}
+
+// GetVersion returns the resource's business version.
+func (r *PlanDefinition) GetVersion() *string {
+	return r.Version
+}
+
+// GetStatus returns the resource's publication status.
+func (r *PlanDefinition) GetStatus() *PublicationStatus {
+	return r.Status
+}
+
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r PlanDefinition) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "PlanDefinition"
+	r.ResourceType = string(ResourceTypePlanDefinition)
 	type Alias PlanDefinition
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
@@ -59,11 +59,13 @@ type Practitioner struct {
 	Qualification []PractitionerQualification `json:"qualification,omitempty"`
 	// A language the practitioner can use in patient communication
 	Communication []CodeableConcept `json:"communication,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *Practitioner) GetResourceType() string {
-	return "Practitioner"
+	return string(ResourceTypePractitioner)
 }
 
 // GetId returns the resource's logical ID.
@@ -111,11 +113,16 @@ func (r *Practitioner) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r Practitioner) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "Practitioner"
+	r.ResourceType = string(ResourceTypePractitioner)
 	type Alias Practitioner
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
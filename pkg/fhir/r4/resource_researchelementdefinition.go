@@ -141,11 +141,13 @@ type ResearchElementDefinition struct {
 	VariableTypeExt *Element `json:"_variableType,omitempty"`
 	// What defines the members of the research element
 	Characteristic []ResearchElementDefinitionCharacteristic `json:"characteristic,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *ResearchElementDefinition) GetResourceType() string {
-	return "ResearchElementDefinition"
+	return string(ResourceTypeResearchElementDefinition)
 }
 
 // GetId returns the resource's logical ID.
@@ -193,11 +195,31 @@ func (r *ResearchElementDefinition) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// GetUrl returns the resource's canonical URL.
+func (r *ResearchElementDefinition) GetUrl() *string {
+	return r.Url
+}
+
+// GetVersion returns the resource's business version.
+func (r *ResearchElementDefinition) GetVersion() *string {
+	return r.Version
+}
+
+// GetStatus returns the resource's publication status.
+func (r *ResearchElementDefinition) GetStatus() *PublicationStatus {
+	return r.Status
+}
+
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r ResearchElementDefinition) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "ResearchElementDefinition"
+	r.ResourceType = string(ResourceTypeResearchElementDefinition)
 	type Alias ResearchElementDefinition
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
@@ -87,11 +87,13 @@ type ResearchStudy struct {
 	Arm []ResearchStudyArm `json:"arm,omitempty"`
 	// A goal for the study
 	Objective []ResearchStudyObjective `json:"objective,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *ResearchStudy) GetResourceType() string {
-	return "ResearchStudy"
+	return string(ResourceTypeResearchStudy)
 }
 
 // GetId returns the resource's logical ID.
@@ -139,11 +141,16 @@ func (r *ResearchStudy) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r ResearchStudy) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "ResearchStudy"
+	r.ResourceType = string(ResourceTypeResearchStudy)
 	type Alias ResearchStudy
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
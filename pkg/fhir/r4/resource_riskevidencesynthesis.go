@@ -117,11 +117,13 @@ type RiskEvidenceSynthesis struct {
 	RiskEstimate *RiskEvidenceSynthesisRiskEstimate `json:"riskEstimate,omitempty"`
 	// How certain is the risk
 	Certainty []RiskEvidenceSynthesisCertainty `json:"certainty,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *RiskEvidenceSynthesis) GetResourceType() string {
-	return "RiskEvidenceSynthesis"
+	return string(ResourceTypeRiskEvidenceSynthesis)
 }
 
 // GetId returns the resource's logical ID.
@@ -169,11 +171,31 @@ func (r *RiskEvidenceSynthesis) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// GetUrl returns the resource's canonical URL.
+func (r *RiskEvidenceSynthesis) GetUrl() *string {
+	return r.Url
+}
+
+// GetVersion returns the resource's business version.
+func (r *RiskEvidenceSynthesis) GetVersion() *string {
+	return r.Version
+}
+
+// GetStatus returns the resource's publication status.
+func (r *RiskEvidenceSynthesis) GetStatus() *PublicationStatus {
+	return r.Status
+}
+
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r RiskEvidenceSynthesis) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "RiskEvidenceSynthesis"
+	r.ResourceType = string(ResourceTypeRiskEvidenceSynthesis)
 	type Alias RiskEvidenceSynthesis
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
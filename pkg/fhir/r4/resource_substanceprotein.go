@@ -45,11 +45,13 @@ type SubstanceProtein struct {
 	DisulfideLinkageExt []Element `json:"_disulfideLinkage,omitempty"`
 	// This subclause refers to the description of each subunit constituting the SubstanceProtein. A subunit is a linear sequence of amino acids linked through peptide bonds. The Subunit information shall be provided when the finished SubstanceProtein is a complex of multiple sequences; subunits are not used to delineate domains within a single sequence. Subunits are listed in order of decreasing length; sequences of the same length will be ordered by decreasing molecular weight; subunits that have identical sequences will be repeated multiple times
 	Subunit []SubstanceProteinSubunit `json:"subunit,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *SubstanceProtein) GetResourceType() string {
-	return "SubstanceProtein"
+	return string(ResourceTypeSubstanceProtein)
 }
 
 // GetId returns the resource's logical ID.
@@ -97,11 +99,16 @@ func (r *SubstanceProtein) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r SubstanceProtein) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "SubstanceProtein"
+	r.ResourceType = string(ResourceTypeSubstanceProtein)
 	type Alias SubstanceProtein
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
@@ -75,11 +75,13 @@ type SubstanceSpecification struct {
 	Protein *Reference `json:"protein,omitempty"`
 	// Material or taxonomic/anatomical source for the substance
 	SourceMaterial *Reference `json:"sourceMaterial,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *SubstanceSpecification) GetResourceType() string {
-	return "SubstanceSpecification"
+	return string(ResourceTypeSubstanceSpecification)
 }
 
 // GetId returns the resource's logical ID.
@@ -127,11 +129,16 @@ func (r *SubstanceSpecification) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r SubstanceSpecification) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "SubstanceSpecification"
+	r.ResourceType = string(ResourceTypeSubstanceSpecification)
 	type Alias SubstanceSpecification
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
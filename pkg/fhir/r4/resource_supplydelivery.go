@@ -63,11 +63,13 @@ type SupplyDelivery struct {
 	Destination *Reference `json:"destination,omitempty"`
 	// Who collected the Supply
 	Receiver []Reference `json:"receiver,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *SupplyDelivery) GetResourceType() string {
-	return "SupplyDelivery"
+	return string(ResourceTypeSupplyDelivery)
 }
 
 // GetId returns the resource's logical ID.
@@ -115,11 +117,16 @@ func (r *SupplyDelivery) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r SupplyDelivery) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "SupplyDelivery"
+	r.ResourceType = string(ResourceTypeSupplyDelivery)
 	type Alias SupplyDelivery
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
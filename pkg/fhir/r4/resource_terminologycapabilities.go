@@ -109,11 +109,13 @@ type TerminologyCapabilities struct {
 	Translation *TerminologyCapabilitiesTranslation `json:"translation,omitempty"`
 	// Information about the [ConceptMap/$closure](conceptmap-operation-closure.html) operation
 	Closure *TerminologyCapabilitiesClosure `json:"closure,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *TerminologyCapabilities) GetResourceType() string {
-	return "TerminologyCapabilities"
+	return string(ResourceTypeTerminologyCapabilities)
 }
 
 // GetId returns the resource's logical ID.
@@ -161,11 +163,31 @@ func (r *TerminologyCapabilities) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// GetUrl returns the resource's canonical URL.
+func (r *TerminologyCapabilities) GetUrl() *string {
+	return r.Url
+}
+
+// GetVersion returns the resource's business version.
+func (r *TerminologyCapabilities) GetVersion() *string {
+	return r.Version
+}
+
+// GetStatus returns the resource's publication status.
+func (r *TerminologyCapabilities) GetStatus() *PublicationStatus {
+	return r.Status
+}
+
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r TerminologyCapabilities) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "TerminologyCapabilities"
+	r.ResourceType = string(ResourceTypeTerminologyCapabilities)
 	type Alias TerminologyCapabilities
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
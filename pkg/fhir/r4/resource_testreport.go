@@ -69,11 +69,13 @@ type TestReport struct {
 	Test []TestReportTest `json:"test,omitempty"`
 	// The results of running the series of required clean up steps
 	Teardown *TestReportTeardown `json:"teardown,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *TestReport) GetResourceType() string {
-	return "TestReport"
+	return string(ResourceTypeTestReport)
 }
 
 // GetId returns the resource's logical ID.
@@ -121,11 +123,16 @@ func (r *TestReport) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r TestReport) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "TestReport"
+	r.ResourceType = string(ResourceTypeTestReport)
 	type Alias TestReport
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
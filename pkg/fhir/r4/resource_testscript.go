@@ -103,11 +103,13 @@ type TestScript struct {
 	Test []TestScriptTest `json:"test,omitempty"`
 	// A series of required clean up steps
 	Teardown *TestScriptTeardown `json:"teardown,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *TestScript) GetResourceType() string {
-	return "TestScript"
+	return string(ResourceTypeTestScript)
 }
 
 // GetId returns the resource's logical ID.
@@ -155,11 +157,31 @@ func (r *TestScript) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// GetUrl returns the resource's canonical URL.
+func (r *TestScript) GetUrl() *string {
+	return r.Url
+}
+
+// GetVersion returns the resource's business version.
+func (r *TestScript) GetVersion() *string {
+	return r.Version
+}
+
+// GetStatus returns the resource's publication status.
+func (r *TestScript) GetStatus() *PublicationStatus {
+	return r.Status
+}
+
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r TestScript) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "TestScript"
+	r.ResourceType = string(ResourceTypeTestScript)
 	type Alias TestScript
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
@@ -1,6 +1,7 @@
 package r4b_test
 
 import (
+	"context"
 	"encoding/json"
 	"testing"
 
@@ -8,6 +9,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/robertoaraneda/gofhir/pkg/fhir/r4b"
+	"github.com/robertoaraneda/gofhir/pkg/validator"
 )
 
 func TestPatientBuilder(t *testing.T) {
@@ -225,6 +227,77 @@ func TestBundleBuilder(t *testing.T) {
 		assert.Equal(t, "bundle-001", *bundle.Id)
 		assert.Equal(t, r4b.BundleTypeTransaction, *bundle.Type)
 	})
+
+	t.Run("transaction entries", func(t *testing.T) {
+		patient := r4b.NewPatientBuilder().SetActive(true).Build()
+		bundle := r4b.NewBundleBuilder().
+			SetType(r4b.BundleTypeTransaction).
+			AddTransactionEntry(r4b.HTTPVerbPost, "Patient", patient).
+			AddTransactionEntry(r4b.HTTPVerbDelete, "Observation/123", nil).
+			Build()
+
+		require.Len(t, bundle.Entry, 2)
+		create := bundle.Entry[0]
+		require.NotNil(t, create.FullUrl)
+		assert.Regexp(t, `^urn:uuid:[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`, *create.FullUrl)
+		assert.Same(t, patient, create.Resource)
+		assert.Equal(t, r4b.HTTPVerbPost, *create.Request.Method)
+		assert.Equal(t, "Patient", *create.Request.Url)
+
+		remove := bundle.Entry[1]
+		assert.Nil(t, remove.FullUrl)
+		assert.Nil(t, remove.Resource)
+		assert.Equal(t, r4b.HTTPVerbDelete, *remove.Request.Method)
+		assert.Equal(t, "Observation/123", *remove.Request.Url)
+
+		// The entries get distinct fullUrls
+		other := r4b.NewBundleBuilder().AddResourceEntry(patient, nil).AddResourceEntry(patient, nil).Build()
+		assert.NotEqual(t, *other.Entry[0].FullUrl, *other.Entry[1].FullUrl)
+		assert.Nil(t, other.Entry[0].Request)
+
+		// The Bundle is a valid transaction
+		data, err := json.Marshal(bundle)
+		require.NoError(t, err)
+		result, err := validator.NewValidator(newBundleRegistry(t), validator.ValidatorOptions{ValidateConstraints: true}).
+			Validate(context.Background(), data)
+		require.NoError(t, err)
+		assert.True(t, result.Valid, "Issues: %v", result.Issues)
+	})
+}
+
+// newBundleRegistry returns a registry with minimal Bundle and Patient
+// definitions, enough to validate transaction Bundles of patients.
+func newBundleRegistry(t *testing.T) *validator.Registry {
+	t.Helper()
+
+	registry := validator.NewRegistry(validator.FHIRVersionR4B)
+	require.NoError(t, registry.Register(&validator.StructureDef{
+		URL:  "http://hl7.org/fhir/StructureDefinition/Bundle",
+		Name: "Bundle",
+		Type: "Bundle",
+		Kind: "resource",
+		Snapshot: []validator.ElementDef{
+			{Path: "Bundle", Min: 0, Max: "*"},
+			{Path: "Bundle.type", Min: 1, Max: "1", Types: []validator.TypeRef{{Code: "code"}}},
+			{Path: "Bundle.entry", Min: 0, Max: "*", Types: []validator.TypeRef{{Code: "BackboneElement"}}},
+			{Path: "Bundle.entry.fullUrl", Min: 0, Max: "1", Types: []validator.TypeRef{{Code: "uri"}}},
+			{Path: "Bundle.entry.resource", Min: 0, Max: "1", Types: []validator.TypeRef{{Code: "Resource"}}},
+			{Path: "Bundle.entry.request", Min: 0, Max: "1", Types: []validator.TypeRef{{Code: "BackboneElement"}}},
+			{Path: "Bundle.entry.request.method", Min: 1, Max: "1", Types: []validator.TypeRef{{Code: "code"}}},
+			{Path: "Bundle.entry.request.url", Min: 1, Max: "1", Types: []validator.TypeRef{{Code: "uri"}}},
+		},
+	}))
+	require.NoError(t, registry.Register(&validator.StructureDef{
+		URL:  "http://hl7.org/fhir/StructureDefinition/Patient",
+		Name: "Patient",
+		Type: "Patient",
+		Kind: "resource",
+		Snapshot: []validator.ElementDef{
+			{Path: "Patient", Min: 0, Max: "*"},
+			{Path: "Patient.active", Min: 0, Max: "1", Types: []validator.TypeRef{{Code: "boolean"}}},
+		},
+	}))
+	return registry
 }
 
 func TestMixedBuilderPatterns(t *testing.T) {
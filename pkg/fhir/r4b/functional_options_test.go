@@ -1,6 +1,7 @@
 package r4b_test
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -73,6 +74,21 @@ func TestPatientFunctionalOptions(t *testing.T) {
 		assert.Nil(t, patient.Active)
 		assert.Empty(t, patient.Name)
 	})
+
+	t.Run("apply options to decoded patient", func(t *testing.T) {
+		var patient r4b.Patient
+		require.NoError(t, json.Unmarshal([]byte(`{"resourceType":"Patient","id":"p1","active":false}`), &patient))
+
+		result := patient.Apply(
+			r4b.WithPatientActive(true),
+			r4b.WithPatientBirthDate("1990-01-15"),
+		)
+
+		assert.Same(t, &patient, result)
+		assert.Equal(t, "p1", *patient.Id)
+		assert.True(t, *patient.Active)
+		assert.Equal(t, "1990-01-15", *patient.BirthDate)
+	})
 }
 
 func TestObservationFunctionalOptions(t *testing.T) {
@@ -20,6 +20,14 @@ func NewActivityDefinition(opts ...ActivityDefinitionOption) *ActivityDefinition
 	return r
 }
 
+// Apply applies the given options to an existing ActivityDefinition, such as a decoded one, and returns it.
+func (r *ActivityDefinition) Apply(opts ...ActivityDefinitionOption) *ActivityDefinition {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithActivityDefinitionId sets the Id field.
 func WithActivityDefinitionId(v string) ActivityDefinitionOption {
 	return func(r *ActivityDefinition) {
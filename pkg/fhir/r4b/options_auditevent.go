@@ -20,6 +20,14 @@ func NewAuditEvent(opts ...AuditEventOption) *AuditEvent {
 	return r
 }
 
+// Apply applies the given options to an existing AuditEvent, such as a decoded one, and returns it.
+func (r *AuditEvent) Apply(opts ...AuditEventOption) *AuditEvent {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithAuditEventId sets the Id field.
 func WithAuditEventId(v string) AuditEventOption {
 	return func(r *AuditEvent) {
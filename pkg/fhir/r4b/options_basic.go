@@ -20,6 +20,14 @@ func NewBasic(opts ...BasicOption) *Basic {
 	return r
 }
 
+// Apply applies the given options to an existing Basic, such as a decoded one, and returns it.
+func (r *Basic) Apply(opts ...BasicOption) *Basic {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithBasicId sets the Id field.
 func WithBasicId(v string) BasicOption {
 	return func(r *Basic) {
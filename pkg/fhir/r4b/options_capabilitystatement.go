@@ -20,6 +20,14 @@ func NewCapabilityStatement(opts ...CapabilityStatementOption) *CapabilityStatem
 	return r
 }
 
+// Apply applies the given options to an existing CapabilityStatement, such as a decoded one, and returns it.
+func (r *CapabilityStatement) Apply(opts ...CapabilityStatementOption) *CapabilityStatement {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithCapabilityStatementId sets the Id field.
 func WithCapabilityStatementId(v string) CapabilityStatementOption {
 	return func(r *CapabilityStatement) {
@@ -20,6 +20,14 @@ func NewCarePlan(opts ...CarePlanOption) *CarePlan {
 	return r
 }
 
+// Apply applies the given options to an existing CarePlan, such as a decoded one, and returns it.
+func (r *CarePlan) Apply(opts ...CarePlanOption) *CarePlan {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithCarePlanId sets the Id field.
 func WithCarePlanId(v string) CarePlanOption {
 	return func(r *CarePlan) {
@@ -20,6 +20,14 @@ func NewCatalogEntry(opts ...CatalogEntryOption) *CatalogEntry {
 	return r
 }
 
+// Apply applies the given options to an existing CatalogEntry, such as a decoded one, and returns it.
+func (r *CatalogEntry) Apply(opts ...CatalogEntryOption) *CatalogEntry {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithCatalogEntryId sets the Id field.
 func WithCatalogEntryId(v string) CatalogEntryOption {
 	return func(r *CatalogEntry) {
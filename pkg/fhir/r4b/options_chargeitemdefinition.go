@@ -20,6 +20,14 @@ func NewChargeItemDefinition(opts ...ChargeItemDefinitionOption) *ChargeItemDefi
 	return r
 }
 
+// Apply applies the given options to an existing ChargeItemDefinition, such as a decoded one, and returns it.
+func (r *ChargeItemDefinition) Apply(opts ...ChargeItemDefinitionOption) *ChargeItemDefinition {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithChargeItemDefinitionId sets the Id field.
 func WithChargeItemDefinitionId(v string) ChargeItemDefinitionOption {
 	return func(r *ChargeItemDefinition) {
@@ -20,6 +20,14 @@ func NewCitation(opts ...CitationOption) *Citation {
 	return r
 }
 
+// Apply applies the given options to an existing Citation, such as a decoded one, and returns it.
+func (r *Citation) Apply(opts ...CitationOption) *Citation {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithCitationId sets the Id field.
 func WithCitationId(v string) CitationOption {
 	return func(r *Citation) {
@@ -20,6 +20,14 @@ func NewClaimResponse(opts ...ClaimResponseOption) *ClaimResponse {
 	return r
 }
 
+// Apply applies the given options to an existing ClaimResponse, such as a decoded one, and returns it.
+func (r *ClaimResponse) Apply(opts ...ClaimResponseOption) *ClaimResponse {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithClaimResponseId sets the Id field.
 func WithClaimResponseId(v string) ClaimResponseOption {
 	return func(r *ClaimResponse) {
@@ -20,6 +20,14 @@ func NewClinicalUseDefinition(opts ...ClinicalUseDefinitionOption) *ClinicalUseD
 	return r
 }
 
+// Apply applies the given options to an existing ClinicalUseDefinition, such as a decoded one, and returns it.
+func (r *ClinicalUseDefinition) Apply(opts ...ClinicalUseDefinitionOption) *ClinicalUseDefinition {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithClinicalUseDefinitionId sets the Id field.
 func WithClinicalUseDefinitionId(v string) ClinicalUseDefinitionOption {
 	return func(r *ClinicalUseDefinition) {
@@ -20,6 +20,14 @@ func NewCommunication(opts ...CommunicationOption) *Communication {
 	return r
 }
 
+// Apply applies the given options to an existing Communication, such as a decoded one, and returns it.
+func (r *Communication) Apply(opts ...CommunicationOption) *Communication {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithCommunicationId sets the Id field.
 func WithCommunicationId(v string) CommunicationOption {
 	return func(r *Communication) {
@@ -20,6 +20,14 @@ func NewCommunicationRequest(opts ...CommunicationRequestOption) *CommunicationR
 	return r
 }
 
+// Apply applies the given options to an existing CommunicationRequest, such as a decoded one, and returns it.
+func (r *CommunicationRequest) Apply(opts ...CommunicationRequestOption) *CommunicationRequest {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithCommunicationRequestId sets the Id field.
 func WithCommunicationRequestId(v string) CommunicationRequestOption {
 	return func(r *CommunicationRequest) {
@@ -20,6 +20,14 @@ func NewConceptMap(opts ...ConceptMapOption) *ConceptMap {
 	return r
 }
 
+// Apply applies the given options to an existing ConceptMap, such as a decoded one, and returns it.
+func (r *ConceptMap) Apply(opts ...ConceptMapOption) *ConceptMap {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithConceptMapId sets the Id field.
 func WithConceptMapId(v string) ConceptMapOption {
 	return func(r *ConceptMap) {
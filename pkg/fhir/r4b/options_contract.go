@@ -20,6 +20,14 @@ func NewContract(opts ...ContractOption) *Contract {
 	return r
 }
 
+// Apply applies the given options to an existing Contract, such as a decoded one, and returns it.
+func (r *Contract) Apply(opts ...ContractOption) *Contract {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithContractId sets the Id field.
 func WithContractId(v string) ContractOption {
 	return func(r *Contract) {
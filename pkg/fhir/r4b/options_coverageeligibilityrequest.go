@@ -20,6 +20,14 @@ func NewCoverageEligibilityRequest(opts ...CoverageEligibilityRequestOption) *Co
 	return r
 }
 
+// Apply applies the given options to an existing CoverageEligibilityRequest, such as a decoded one, and returns it.
+func (r *CoverageEligibilityRequest) Apply(opts ...CoverageEligibilityRequestOption) *CoverageEligibilityRequest {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithCoverageEligibilityRequestId sets the Id field.
 func WithCoverageEligibilityRequestId(v string) CoverageEligibilityRequestOption {
 	return func(r *CoverageEligibilityRequest) {
@@ -20,6 +20,14 @@ func NewCoverageEligibilityResponse(opts ...CoverageEligibilityResponseOption) *
 	return r
 }
 
+// Apply applies the given options to an existing CoverageEligibilityResponse, such as a decoded one, and returns it.
+func (r *CoverageEligibilityResponse) Apply(opts ...CoverageEligibilityResponseOption) *CoverageEligibilityResponse {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithCoverageEligibilityResponseId sets the Id field.
 func WithCoverageEligibilityResponseId(v string) CoverageEligibilityResponseOption {
 	return func(r *CoverageEligibilityResponse) {
@@ -20,6 +20,14 @@ func NewDevice(opts ...DeviceOption) *Device {
 	return r
 }
 
+// Apply applies the given options to an existing Device, such as a decoded one, and returns it.
+func (r *Device) Apply(opts ...DeviceOption) *Device {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithDeviceId sets the Id field.
 func WithDeviceId(v string) DeviceOption {
 	return func(r *Device) {
@@ -20,6 +20,14 @@ func NewDeviceDefinition(opts ...DeviceDefinitionOption) *DeviceDefinition {
 	return r
 }
 
+// Apply applies the given options to an existing DeviceDefinition, such as a decoded one, and returns it.
+func (r *DeviceDefinition) Apply(opts ...DeviceDefinitionOption) *DeviceDefinition {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithDeviceDefinitionId sets the Id field.
 func WithDeviceDefinitionId(v string) DeviceDefinitionOption {
 	return func(r *DeviceDefinition) {
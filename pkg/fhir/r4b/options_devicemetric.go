@@ -20,6 +20,14 @@ func NewDeviceMetric(opts ...DeviceMetricOption) *DeviceMetric {
 	return r
 }
 
+// Apply applies the given options to an existing DeviceMetric, such as a decoded one, and returns it.
+func (r *DeviceMetric) Apply(opts ...DeviceMetricOption) *DeviceMetric {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithDeviceMetricId sets the Id field.
 func WithDeviceMetricId(v string) DeviceMetricOption {
 	return func(r *DeviceMetric) {
@@ -20,6 +20,14 @@ func NewDeviceRequest(opts ...DeviceRequestOption) *DeviceRequest {
 	return r
 }
 
+// Apply applies the given options to an existing DeviceRequest, such as a decoded one, and returns it.
+func (r *DeviceRequest) Apply(opts ...DeviceRequestOption) *DeviceRequest {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithDeviceRequestId sets the Id field.
 func WithDeviceRequestId(v string) DeviceRequestOption {
 	return func(r *DeviceRequest) {
@@ -20,6 +20,14 @@ func NewDocumentManifest(opts ...DocumentManifestOption) *DocumentManifest {
 	return r
 }
 
+// Apply applies the given options to an existing DocumentManifest, such as a decoded one, and returns it.
+func (r *DocumentManifest) Apply(opts ...DocumentManifestOption) *DocumentManifest {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithDocumentManifestId sets the Id field.
 func WithDocumentManifestId(v string) DocumentManifestOption {
 	return func(r *DocumentManifest) {
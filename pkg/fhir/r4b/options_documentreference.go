@@ -20,6 +20,14 @@ func NewDocumentReference(opts ...DocumentReferenceOption) *DocumentReference {
 	return r
 }
 
+// Apply applies the given options to an existing DocumentReference, such as a decoded one, and returns it.
+func (r *DocumentReference) Apply(opts ...DocumentReferenceOption) *DocumentReference {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithDocumentReferenceId sets the Id field.
 func WithDocumentReferenceId(v string) DocumentReferenceOption {
 	return func(r *DocumentReference) {
@@ -20,6 +20,14 @@ func NewEventDefinition(opts ...EventDefinitionOption) *EventDefinition {
 	return r
 }
 
+// Apply applies the given options to an existing EventDefinition, such as a decoded one, and returns it.
+func (r *EventDefinition) Apply(opts ...EventDefinitionOption) *EventDefinition {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithEventDefinitionId sets the Id field.
 func WithEventDefinitionId(v string) EventDefinitionOption {
 	return func(r *EventDefinition) {
@@ -20,6 +20,14 @@ func NewEvidence(opts ...EvidenceOption) *Evidence {
 	return r
 }
 
+// Apply applies the given options to an existing Evidence, such as a decoded one, and returns it.
+func (r *Evidence) Apply(opts ...EvidenceOption) *Evidence {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithEvidenceId sets the Id field.
 func WithEvidenceId(v string) EvidenceOption {
 	return func(r *Evidence) {
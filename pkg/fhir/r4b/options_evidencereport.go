@@ -20,6 +20,14 @@ func NewEvidenceReport(opts ...EvidenceReportOption) *EvidenceReport {
 	return r
 }
 
+// Apply applies the given options to an existing EvidenceReport, such as a decoded one, and returns it.
+func (r *EvidenceReport) Apply(opts ...EvidenceReportOption) *EvidenceReport {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithEvidenceReportId sets the Id field.
 func WithEvidenceReportId(v string) EvidenceReportOption {
 	return func(r *EvidenceReport) {
@@ -20,6 +20,14 @@ func NewExplanationOfBenefit(opts ...ExplanationOfBenefitOption) *ExplanationOfB
 	return r
 }
 
+// Apply applies the given options to an existing ExplanationOfBenefit, such as a decoded one, and returns it.
+func (r *ExplanationOfBenefit) Apply(opts ...ExplanationOfBenefitOption) *ExplanationOfBenefit {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithExplanationOfBenefitId sets the Id field.
 func WithExplanationOfBenefitId(v string) ExplanationOfBenefitOption {
 	return func(r *ExplanationOfBenefit) {
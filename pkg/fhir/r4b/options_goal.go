@@ -20,6 +20,14 @@ func NewGoal(opts ...GoalOption) *Goal {
 	return r
 }
 
+// Apply applies the given options to an existing Goal, such as a decoded one, and returns it.
+func (r *Goal) Apply(opts ...GoalOption) *Goal {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithGoalId sets the Id field.
 func WithGoalId(v string) GoalOption {
 	return func(r *Goal) {
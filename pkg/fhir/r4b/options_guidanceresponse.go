@@ -20,6 +20,14 @@ func NewGuidanceResponse(opts ...GuidanceResponseOption) *GuidanceResponse {
 	return r
 }
 
+// Apply applies the given options to an existing GuidanceResponse, such as a decoded one, and returns it.
+func (r *GuidanceResponse) Apply(opts ...GuidanceResponseOption) *GuidanceResponse {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithGuidanceResponseId sets the Id field.
 func WithGuidanceResponseId(v string) GuidanceResponseOption {
 	return func(r *GuidanceResponse) {
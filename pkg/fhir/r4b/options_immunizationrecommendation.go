@@ -20,6 +20,14 @@ func NewImmunizationRecommendation(opts ...ImmunizationRecommendationOption) *Im
 	return r
 }
 
+// Apply applies the given options to an existing ImmunizationRecommendation, such as a decoded one, and returns it.
+func (r *ImmunizationRecommendation) Apply(opts ...ImmunizationRecommendationOption) *ImmunizationRecommendation {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithImmunizationRecommendationId sets the Id field.
 func WithImmunizationRecommendationId(v string) ImmunizationRecommendationOption {
 	return func(r *ImmunizationRecommendation) {
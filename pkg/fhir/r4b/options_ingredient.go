@@ -20,6 +20,14 @@ func NewIngredient(opts ...IngredientOption) *Ingredient {
 	return r
 }
 
+// Apply applies the given options to an existing Ingredient, such as a decoded one, and returns it.
+func (r *Ingredient) Apply(opts ...IngredientOption) *Ingredient {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithIngredientId sets the Id field.
 func WithIngredientId(v string) IngredientOption {
 	return func(r *Ingredient) {
@@ -20,6 +20,14 @@ func NewMedia(opts ...MediaOption) *Media {
 	return r
 }
 
+// Apply applies the given options to an existing Media, such as a decoded one, and returns it.
+func (r *Media) Apply(opts ...MediaOption) *Media {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithMediaId sets the Id field.
 func WithMediaId(v string) MediaOption {
 	return func(r *Media) {
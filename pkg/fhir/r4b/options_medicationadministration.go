@@ -20,6 +20,14 @@ func NewMedicationAdministration(opts ...MedicationAdministrationOption) *Medica
 	return r
 }
 
+// Apply applies the given options to an existing MedicationAdministration, such as a decoded one, and returns it.
+func (r *MedicationAdministration) Apply(opts ...MedicationAdministrationOption) *MedicationAdministration {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithMedicationAdministrationId sets the Id field.
 func WithMedicationAdministrationId(v string) MedicationAdministrationOption {
 	return func(r *MedicationAdministration) {
@@ -20,6 +20,14 @@ func NewMedicationDispense(opts ...MedicationDispenseOption) *MedicationDispense
 	return r
 }
 
+// Apply applies the given options to an existing MedicationDispense, such as a decoded one, and returns it.
+func (r *MedicationDispense) Apply(opts ...MedicationDispenseOption) *MedicationDispense {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithMedicationDispenseId sets the Id field.
 func WithMedicationDispenseId(v string) MedicationDispenseOption {
 	return func(r *MedicationDispense) {
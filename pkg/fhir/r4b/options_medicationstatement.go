@@ -20,6 +20,14 @@ func NewMedicationStatement(opts ...MedicationStatementOption) *MedicationStatem
 	return r
 }
 
+// Apply applies the given options to an existing MedicationStatement, such as a decoded one, and returns it.
+func (r *MedicationStatement) Apply(opts ...MedicationStatementOption) *MedicationStatement {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithMedicationStatementId sets the Id field.
 func WithMedicationStatementId(v string) MedicationStatementOption {
 	return func(r *MedicationStatement) {
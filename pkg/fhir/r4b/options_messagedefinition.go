@@ -20,6 +20,14 @@ func NewMessageDefinition(opts ...MessageDefinitionOption) *MessageDefinition {
 	return r
 }
 
+// Apply applies the given options to an existing MessageDefinition, such as a decoded one, and returns it.
+func (r *MessageDefinition) Apply(opts ...MessageDefinitionOption) *MessageDefinition {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithMessageDefinitionId sets the Id field.
 func WithMessageDefinitionId(v string) MessageDefinitionOption {
 	return func(r *MessageDefinition) {
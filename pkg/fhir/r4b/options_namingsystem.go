@@ -20,6 +20,14 @@ func NewNamingSystem(opts ...NamingSystemOption) *NamingSystem {
 	return r
 }
 
+// Apply applies the given options to an existing NamingSystem, such as a decoded one, and returns it.
+func (r *NamingSystem) Apply(opts ...NamingSystemOption) *NamingSystem {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithNamingSystemId sets the Id field.
 func WithNamingSystemId(v string) NamingSystemOption {
 	return func(r *NamingSystem) {
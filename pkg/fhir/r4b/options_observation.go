@@ -20,6 +20,14 @@ func NewObservation(opts ...ObservationOption) *Observation {
 	return r
 }
 
+// Apply applies the given options to an existing Observation, such as a decoded one, and returns it.
+func (r *Observation) Apply(opts ...ObservationOption) *Observation {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithObservationId sets the Id field.
 func WithObservationId(v string) ObservationOption {
 	return func(r *Observation) {
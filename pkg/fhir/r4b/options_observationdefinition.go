@@ -20,6 +20,14 @@ func NewObservationDefinition(opts ...ObservationDefinitionOption) *ObservationD
 	return r
 }
 
+// Apply applies the given options to an existing ObservationDefinition, such as a decoded one, and returns it.
+func (r *ObservationDefinition) Apply(opts ...ObservationDefinitionOption) *ObservationDefinition {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithObservationDefinitionId sets the Id field.
 func WithObservationDefinitionId(v string) ObservationDefinitionOption {
 	return func(r *ObservationDefinition) {
@@ -20,6 +20,14 @@ func NewOperationDefinition(opts ...OperationDefinitionOption) *OperationDefinit
 	return r
 }
 
+// Apply applies the given options to an existing OperationDefinition, such as a decoded one, and returns it.
+func (r *OperationDefinition) Apply(opts ...OperationDefinitionOption) *OperationDefinition {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithOperationDefinitionId sets the Id field.
 func WithOperationDefinitionId(v string) OperationDefinitionOption {
 	return func(r *OperationDefinition) {
@@ -20,6 +20,14 @@ func NewOrganization(opts ...OrganizationOption) *Organization {
 	return r
 }
 
+// Apply applies the given options to an existing Organization, such as a decoded one, and returns it.
+func (r *Organization) Apply(opts ...OrganizationOption) *Organization {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithOrganizationId sets the Id field.
 func WithOrganizationId(v string) OrganizationOption {
 	return func(r *Organization) {
@@ -20,6 +20,14 @@ func NewPaymentNotice(opts ...PaymentNoticeOption) *PaymentNotice {
 	return r
 }
 
+// Apply applies the given options to an existing PaymentNotice, such as a decoded one, and returns it.
+func (r *PaymentNotice) Apply(opts ...PaymentNoticeOption) *PaymentNotice {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithPaymentNoticeId sets the Id field.
 func WithPaymentNoticeId(v string) PaymentNoticeOption {
 	return func(r *PaymentNotice) {
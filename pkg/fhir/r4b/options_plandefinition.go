@@ -20,6 +20,14 @@ func NewPlanDefinition(opts ...PlanDefinitionOption) *PlanDefinition {
 	return r
 }
 
+// Apply applies the given options to an existing PlanDefinition, such as a decoded one, and returns it.
+func (r *PlanDefinition) Apply(opts ...PlanDefinitionOption) *PlanDefinition {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithPlanDefinitionId sets the Id field.
 func WithPlanDefinitionId(v string) PlanDefinitionOption {
 	return func(r *PlanDefinition) {
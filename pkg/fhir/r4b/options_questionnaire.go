@@ -20,6 +20,14 @@ func NewQuestionnaire(opts ...QuestionnaireOption) *Questionnaire {
 	return r
 }
 
+// Apply applies the given options to an existing Questionnaire, such as a decoded one, and returns it.
+func (r *Questionnaire) Apply(opts ...QuestionnaireOption) *Questionnaire {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithQuestionnaireId sets the Id field.
 func WithQuestionnaireId(v string) QuestionnaireOption {
 	return func(r *Questionnaire) {
@@ -20,6 +20,14 @@ func NewRegulatedAuthorization(opts ...RegulatedAuthorizationOption) *RegulatedA
 	return r
 }
 
+// Apply applies the given options to an existing RegulatedAuthorization, such as a decoded one, and returns it.
+func (r *RegulatedAuthorization) Apply(opts ...RegulatedAuthorizationOption) *RegulatedAuthorization {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithRegulatedAuthorizationId sets the Id field.
 func WithRegulatedAuthorizationId(v string) RegulatedAuthorizationOption {
 	return func(r *RegulatedAuthorization) {
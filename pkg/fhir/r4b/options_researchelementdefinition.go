@@ -20,6 +20,14 @@ func NewResearchElementDefinition(opts ...ResearchElementDefinitionOption) *Rese
 	return r
 }
 
+// Apply applies the given options to an existing ResearchElementDefinition, such as a decoded one, and returns it.
+func (r *ResearchElementDefinition) Apply(opts ...ResearchElementDefinitionOption) *ResearchElementDefinition {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithResearchElementDefinitionId sets the Id field.
 func WithResearchElementDefinitionId(v string) ResearchElementDefinitionOption {
 	return func(r *ResearchElementDefinition) {
@@ -20,6 +20,14 @@ func NewResearchSubject(opts ...ResearchSubjectOption) *ResearchSubject {
 	return r
 }
 
+// Apply applies the given options to an existing ResearchSubject, such as a decoded one, and returns it.
+func (r *ResearchSubject) Apply(opts ...ResearchSubjectOption) *ResearchSubject {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithResearchSubjectId sets the Id field.
 func WithResearchSubjectId(v string) ResearchSubjectOption {
 	return func(r *ResearchSubject) {
@@ -20,6 +20,14 @@ func NewRiskAssessment(opts ...RiskAssessmentOption) *RiskAssessment {
 	return r
 }
 
+// Apply applies the given options to an existing RiskAssessment, such as a decoded one, and returns it.
+func (r *RiskAssessment) Apply(opts ...RiskAssessmentOption) *RiskAssessment {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithRiskAssessmentId sets the Id field.
 func WithRiskAssessmentId(v string) RiskAssessmentOption {
 	return func(r *RiskAssessment) {
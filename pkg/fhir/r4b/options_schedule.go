@@ -20,6 +20,14 @@ func NewSchedule(opts ...ScheduleOption) *Schedule {
 	return r
 }
 
+// Apply applies the given options to an existing Schedule, such as a decoded one, and returns it.
+func (r *Schedule) Apply(opts ...ScheduleOption) *Schedule {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithScheduleId sets the Id field.
 func WithScheduleId(v string) ScheduleOption {
 	return func(r *Schedule) {
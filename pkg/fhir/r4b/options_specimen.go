@@ -20,6 +20,14 @@ func NewSpecimen(opts ...SpecimenOption) *Specimen {
 	return r
 }
 
+// Apply applies the given options to an existing Specimen, such as a decoded one, and returns it.
+func (r *Specimen) Apply(opts ...SpecimenOption) *Specimen {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithSpecimenId sets the Id field.
 func WithSpecimenId(v string) SpecimenOption {
 	return func(r *Specimen) {
@@ -20,6 +20,14 @@ func NewStructureDefinition(opts ...StructureDefinitionOption) *StructureDefinit
 	return r
 }
 
+// Apply applies the given options to an existing StructureDefinition, such as a decoded one, and returns it.
+func (r *StructureDefinition) Apply(opts ...StructureDefinitionOption) *StructureDefinition {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithStructureDefinitionId sets the Id field.
 func WithStructureDefinitionId(v string) StructureDefinitionOption {
 	return func(r *StructureDefinition) {
@@ -20,6 +20,14 @@ func NewSubscriptionTopic(opts ...SubscriptionTopicOption) *SubscriptionTopic {
 	return r
 }
 
+// Apply applies the given options to an existing SubscriptionTopic, such as a decoded one, and returns it.
+func (r *SubscriptionTopic) Apply(opts ...SubscriptionTopicOption) *SubscriptionTopic {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithSubscriptionTopicId sets the Id field.
 func WithSubscriptionTopicId(v string) SubscriptionTopicOption {
 	return func(r *SubscriptionTopic) {
@@ -20,6 +20,14 @@ func NewSupplyDelivery(opts ...SupplyDeliveryOption) *SupplyDelivery {
 	return r
 }
 
+// Apply applies the given options to an existing SupplyDelivery, such as a decoded one, and returns it.
+func (r *SupplyDelivery) Apply(opts ...SupplyDeliveryOption) *SupplyDelivery {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithSupplyDeliveryId sets the Id field.
 func WithSupplyDeliveryId(v string) SupplyDeliveryOption {
 	return func(r *SupplyDelivery) {
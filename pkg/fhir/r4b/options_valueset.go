@@ -20,6 +20,14 @@ func NewValueSet(opts ...ValueSetOption) *ValueSet {
 	return r
 }
 
+// Apply applies the given options to an existing ValueSet, such as a decoded one, and returns it.
+func (r *ValueSet) Apply(opts ...ValueSetOption) *ValueSet {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithValueSetId sets the Id field.
 func WithValueSetId(v string) ValueSetOption {
 	return func(r *ValueSet) {
@@ -5,159 +5,457 @@
 package r4b
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
 )
 
+// ResourceType is the name of a FHIR resource type.
+type ResourceType string
+
+// Resource type constants.
+const (
+	ResourceTypeAccount                        ResourceType = "Account"
+	ResourceTypeActivityDefinition             ResourceType = "ActivityDefinition"
+	ResourceTypeAdministrableProductDefinition ResourceType = "AdministrableProductDefinition"
+	ResourceTypeAdverseEvent                   ResourceType = "AdverseEvent"
+	ResourceTypeAllergyIntolerance             ResourceType = "AllergyIntolerance"
+	ResourceTypeAppointment                    ResourceType = "Appointment"
+	ResourceTypeAppointmentResponse            ResourceType = "AppointmentResponse"
+	ResourceTypeAuditEvent                     ResourceType = "AuditEvent"
+	ResourceTypeBasic                          ResourceType = "Basic"
+	ResourceTypeBinary                         ResourceType = "Binary"
+	ResourceTypeBiologicallyDerivedProduct     ResourceType = "BiologicallyDerivedProduct"
+	ResourceTypeBodyStructure                  ResourceType = "BodyStructure"
+	ResourceTypeBundle                         ResourceType = "Bundle"
+	ResourceTypeCapabilityStatement            ResourceType = "CapabilityStatement"
+	ResourceTypeCarePlan                       ResourceType = "CarePlan"
+	ResourceTypeCareTeam                       ResourceType = "CareTeam"
+	ResourceTypeCatalogEntry                   ResourceType = "CatalogEntry"
+	ResourceTypeChargeItem                     ResourceType = "ChargeItem"
+	ResourceTypeChargeItemDefinition           ResourceType = "ChargeItemDefinition"
+	ResourceTypeCitation                       ResourceType = "Citation"
+	ResourceTypeClaim                          ResourceType = "Claim"
+	ResourceTypeClaimResponse                  ResourceType = "ClaimResponse"
+	ResourceTypeClinicalImpression             ResourceType = "ClinicalImpression"
+	ResourceTypeClinicalUseDefinition          ResourceType = "ClinicalUseDefinition"
+	ResourceTypeCodeSystem                     ResourceType = "CodeSystem"
+	ResourceTypeCommunication                  ResourceType = "Communication"
+	ResourceTypeCommunicationRequest           ResourceType = "CommunicationRequest"
+	ResourceTypeCompartmentDefinition          ResourceType = "CompartmentDefinition"
+	ResourceTypeComposition                    ResourceType = "Composition"
+	ResourceTypeConceptMap                     ResourceType = "ConceptMap"
+	ResourceTypeCondition                      ResourceType = "Condition"
+	ResourceTypeConsent                        ResourceType = "Consent"
+	ResourceTypeContract                       ResourceType = "Contract"
+	ResourceTypeCoverage                       ResourceType = "Coverage"
+	ResourceTypeCoverageEligibilityRequest     ResourceType = "CoverageEligibilityRequest"
+	ResourceTypeCoverageEligibilityResponse    ResourceType = "CoverageEligibilityResponse"
+	ResourceTypeDetectedIssue                  ResourceType = "DetectedIssue"
+	ResourceTypeDevice                         ResourceType = "Device"
+	ResourceTypeDeviceDefinition               ResourceType = "DeviceDefinition"
+	ResourceTypeDeviceMetric                   ResourceType = "DeviceMetric"
+	ResourceTypeDeviceRequest                  ResourceType = "DeviceRequest"
+	ResourceTypeDeviceUseStatement             ResourceType = "DeviceUseStatement"
+	ResourceTypeDiagnosticReport               ResourceType = "DiagnosticReport"
+	ResourceTypeDocumentManifest               ResourceType = "DocumentManifest"
+	ResourceTypeDocumentReference              ResourceType = "DocumentReference"
+	ResourceTypeEncounter                      ResourceType = "Encounter"
+	ResourceTypeEndpoint                       ResourceType = "Endpoint"
+	ResourceTypeEnrollmentRequest              ResourceType = "EnrollmentRequest"
+	ResourceTypeEnrollmentResponse             ResourceType = "EnrollmentResponse"
+	ResourceTypeEpisodeOfCare                  ResourceType = "EpisodeOfCare"
+	ResourceTypeEventDefinition                ResourceType = "EventDefinition"
+	ResourceTypeEvidence                       ResourceType = "Evidence"
+	ResourceTypeEvidenceReport                 ResourceType = "EvidenceReport"
+	ResourceTypeEvidenceVariable               ResourceType = "EvidenceVariable"
+	ResourceTypeExampleScenario                ResourceType = "ExampleScenario"
+	ResourceTypeExplanationOfBenefit           ResourceType = "ExplanationOfBenefit"
+	ResourceTypeFamilyMemberHistory            ResourceType = "FamilyMemberHistory"
+	ResourceTypeFlag                           ResourceType = "Flag"
+	ResourceTypeGoal                           ResourceType = "Goal"
+	ResourceTypeGraphDefinition                ResourceType = "GraphDefinition"
+	ResourceTypeGroup                          ResourceType = "Group"
+	ResourceTypeGuidanceResponse               ResourceType = "GuidanceResponse"
+	ResourceTypeHealthcareService              ResourceType = "HealthcareService"
+	ResourceTypeImagingStudy                   ResourceType = "ImagingStudy"
+	ResourceTypeImmunization                   ResourceType = "Immunization"
+	ResourceTypeImmunizationEvaluation         ResourceType = "ImmunizationEvaluation"
+	ResourceTypeImmunizationRecommendation     ResourceType = "ImmunizationRecommendation"
+	ResourceTypeImplementationGuide            ResourceType = "ImplementationGuide"
+	ResourceTypeIngredient                     ResourceType = "Ingredient"
+	ResourceTypeInsurancePlan                  ResourceType = "InsurancePlan"
+	ResourceTypeInvoice                        ResourceType = "Invoice"
+	ResourceTypeLibrary                        ResourceType = "Library"
+	ResourceTypeLinkage                        ResourceType = "Linkage"
+	ResourceTypeList                           ResourceType = "List"
+	ResourceTypeLocation                       ResourceType = "Location"
+	ResourceTypeManufacturedItemDefinition     ResourceType = "ManufacturedItemDefinition"
+	ResourceTypeMeasure                        ResourceType = "Measure"
+	ResourceTypeMeasureReport                  ResourceType = "MeasureReport"
+	ResourceTypeMedia                          ResourceType = "Media"
+	ResourceTypeMedication                     ResourceType = "Medication"
+	ResourceTypeMedicationAdministration       ResourceType = "MedicationAdministration"
+	ResourceTypeMedicationDispense             ResourceType = "MedicationDispense"
+	ResourceTypeMedicationKnowledge            ResourceType = "MedicationKnowledge"
+	ResourceTypeMedicationRequest              ResourceType = "MedicationRequest"
+	ResourceTypeMedicationStatement            ResourceType = "MedicationStatement"
+	ResourceTypeMedicinalProductDefinition     ResourceType = "MedicinalProductDefinition"
+	ResourceTypeMessageDefinition              ResourceType = "MessageDefinition"
+	ResourceTypeMessageHeader                  ResourceType = "MessageHeader"
+	ResourceTypeMolecularSequence              ResourceType = "MolecularSequence"
+	ResourceTypeNamingSystem                   ResourceType = "NamingSystem"
+	ResourceTypeNutritionOrder                 ResourceType = "NutritionOrder"
+	ResourceTypeNutritionProduct               ResourceType = "NutritionProduct"
+	ResourceTypeObservation                    ResourceType = "Observation"
+	ResourceTypeObservationDefinition          ResourceType = "ObservationDefinition"
+	ResourceTypeOperationDefinition            ResourceType = "OperationDefinition"
+	ResourceTypeOperationOutcome               ResourceType = "OperationOutcome"
+	ResourceTypeOrganization                   ResourceType = "Organization"
+	ResourceTypeOrganizationAffiliation        ResourceType = "OrganizationAffiliation"
+	ResourceTypePackagedProductDefinition      ResourceType = "PackagedProductDefinition"
+	ResourceTypeParameters                     ResourceType = "Parameters"
+	ResourceTypePatient                        ResourceType = "Patient"
+	ResourceTypePaymentNotice                  ResourceType = "PaymentNotice"
+	ResourceTypePaymentReconciliation          ResourceType = "PaymentReconciliation"
+	ResourceTypePerson                         ResourceType = "Person"
+	ResourceTypePlanDefinition                 ResourceType = "PlanDefinition"
+	ResourceTypePractitioner                   ResourceType = "Practitioner"
+	ResourceTypePractitionerRole               ResourceType = "PractitionerRole"
+	ResourceTypeProcedure                      ResourceType = "Procedure"
+	ResourceTypeProvenance                     ResourceType = "Provenance"
+	ResourceTypeQuestionnaire                  ResourceType = "Questionnaire"
+	ResourceTypeQuestionnaireResponse          ResourceType = "QuestionnaireResponse"
+	ResourceTypeRegulatedAuthorization         ResourceType = "RegulatedAuthorization"
+	ResourceTypeRelatedPerson                  ResourceType = "RelatedPerson"
+	ResourceTypeRequestGroup                   ResourceType = "RequestGroup"
+	ResourceTypeResearchDefinition             ResourceType = "ResearchDefinition"
+	ResourceTypeResearchElementDefinition      ResourceType = "ResearchElementDefinition"
+	ResourceTypeResearchStudy                  ResourceType = "ResearchStudy"
+	ResourceTypeResearchSubject                ResourceType = "ResearchSubject"
+	ResourceTypeRiskAssessment                 ResourceType = "RiskAssessment"
+	ResourceTypeSchedule                       ResourceType = "Schedule"
+	ResourceTypeSearchParameter                ResourceType = "SearchParameter"
+	ResourceTypeServiceRequest                 ResourceType = "ServiceRequest"
+	ResourceTypeSlot                           ResourceType = "Slot"
+	ResourceTypeSpecimen                       ResourceType = "Specimen"
+	ResourceTypeSpecimenDefinition             ResourceType = "SpecimenDefinition"
+	ResourceTypeStructureDefinition            ResourceType = "StructureDefinition"
+	ResourceTypeStructureMap                   ResourceType = "StructureMap"
+	ResourceTypeSubscription                   ResourceType = "Subscription"
+	ResourceTypeSubscriptionStatus             ResourceType = "SubscriptionStatus"
+	ResourceTypeSubscriptionTopic              ResourceType = "SubscriptionTopic"
+	ResourceTypeSubstance                      ResourceType = "Substance"
+	ResourceTypeSubstanceDefinition            ResourceType = "SubstanceDefinition"
+	ResourceTypeSupplyDelivery                 ResourceType = "SupplyDelivery"
+	ResourceTypeSupplyRequest                  ResourceType = "SupplyRequest"
+	ResourceTypeTask                           ResourceType = "Task"
+	ResourceTypeTerminologyCapabilities        ResourceType = "TerminologyCapabilities"
+	ResourceTypeTestReport                     ResourceType = "TestReport"
+	ResourceTypeTestScript                     ResourceType = "TestScript"
+	ResourceTypeValueSet                       ResourceType = "ValueSet"
+	ResourceTypeVerificationResult             ResourceType = "VerificationResult"
+	ResourceTypeVisionPrescription             ResourceType = "VisionPrescription"
+)
+
+// ResourceTypes lists every resource type in this package, in alphabetical order.
+var ResourceTypes = []ResourceType{
+	ResourceTypeAccount,
+	ResourceTypeActivityDefinition,
+	ResourceTypeAdministrableProductDefinition,
+	ResourceTypeAdverseEvent,
+	ResourceTypeAllergyIntolerance,
+	ResourceTypeAppointment,
+	ResourceTypeAppointmentResponse,
+	ResourceTypeAuditEvent,
+	ResourceTypeBasic,
+	ResourceTypeBinary,
+	ResourceTypeBiologicallyDerivedProduct,
+	ResourceTypeBodyStructure,
+	ResourceTypeBundle,
+	ResourceTypeCapabilityStatement,
+	ResourceTypeCarePlan,
+	ResourceTypeCareTeam,
+	ResourceTypeCatalogEntry,
+	ResourceTypeChargeItem,
+	ResourceTypeChargeItemDefinition,
+	ResourceTypeCitation,
+	ResourceTypeClaim,
+	ResourceTypeClaimResponse,
+	ResourceTypeClinicalImpression,
+	ResourceTypeClinicalUseDefinition,
+	ResourceTypeCodeSystem,
+	ResourceTypeCommunication,
+	ResourceTypeCommunicationRequest,
+	ResourceTypeCompartmentDefinition,
+	ResourceTypeComposition,
+	ResourceTypeConceptMap,
+	ResourceTypeCondition,
+	ResourceTypeConsent,
+	ResourceTypeContract,
+	ResourceTypeCoverage,
+	ResourceTypeCoverageEligibilityRequest,
+	ResourceTypeCoverageEligibilityResponse,
+	ResourceTypeDetectedIssue,
+	ResourceTypeDevice,
+	ResourceTypeDeviceDefinition,
+	ResourceTypeDeviceMetric,
+	ResourceTypeDeviceRequest,
+	ResourceTypeDeviceUseStatement,
+	ResourceTypeDiagnosticReport,
+	ResourceTypeDocumentManifest,
+	ResourceTypeDocumentReference,
+	ResourceTypeEncounter,
+	ResourceTypeEndpoint,
+	ResourceTypeEnrollmentRequest,
+	ResourceTypeEnrollmentResponse,
+	ResourceTypeEpisodeOfCare,
+	ResourceTypeEventDefinition,
+	ResourceTypeEvidence,
+	ResourceTypeEvidenceReport,
+	ResourceTypeEvidenceVariable,
+	ResourceTypeExampleScenario,
+	ResourceTypeExplanationOfBenefit,
+	ResourceTypeFamilyMemberHistory,
+	ResourceTypeFlag,
+	ResourceTypeGoal,
+	ResourceTypeGraphDefinition,
+	ResourceTypeGroup,
+	ResourceTypeGuidanceResponse,
+	ResourceTypeHealthcareService,
+	ResourceTypeImagingStudy,
+	ResourceTypeImmunization,
+	ResourceTypeImmunizationEvaluation,
+	ResourceTypeImmunizationRecommendation,
+	ResourceTypeImplementationGuide,
+	ResourceTypeIngredient,
+	ResourceTypeInsurancePlan,
+	ResourceTypeInvoice,
+	ResourceTypeLibrary,
+	ResourceTypeLinkage,
+	ResourceTypeList,
+	ResourceTypeLocation,
+	ResourceTypeManufacturedItemDefinition,
+	ResourceTypeMeasure,
+	ResourceTypeMeasureReport,
+	ResourceTypeMedia,
+	ResourceTypeMedication,
+	ResourceTypeMedicationAdministration,
+	ResourceTypeMedicationDispense,
+	ResourceTypeMedicationKnowledge,
+	ResourceTypeMedicationRequest,
+	ResourceTypeMedicationStatement,
+	ResourceTypeMedicinalProductDefinition,
+	ResourceTypeMessageDefinition,
+	ResourceTypeMessageHeader,
+	ResourceTypeMolecularSequence,
+	ResourceTypeNamingSystem,
+	ResourceTypeNutritionOrder,
+	ResourceTypeNutritionProduct,
+	ResourceTypeObservation,
+	ResourceTypeObservationDefinition,
+	ResourceTypeOperationDefinition,
+	ResourceTypeOperationOutcome,
+	ResourceTypeOrganization,
+	ResourceTypeOrganizationAffiliation,
+	ResourceTypePackagedProductDefinition,
+	ResourceTypeParameters,
+	ResourceTypePatient,
+	ResourceTypePaymentNotice,
+	ResourceTypePaymentReconciliation,
+	ResourceTypePerson,
+	ResourceTypePlanDefinition,
+	ResourceTypePractitioner,
+	ResourceTypePractitionerRole,
+	ResourceTypeProcedure,
+	ResourceTypeProvenance,
+	ResourceTypeQuestionnaire,
+	ResourceTypeQuestionnaireResponse,
+	ResourceTypeRegulatedAuthorization,
+	ResourceTypeRelatedPerson,
+	ResourceTypeRequestGroup,
+	ResourceTypeResearchDefinition,
+	ResourceTypeResearchElementDefinition,
+	ResourceTypeResearchStudy,
+	ResourceTypeResearchSubject,
+	ResourceTypeRiskAssessment,
+	ResourceTypeSchedule,
+	ResourceTypeSearchParameter,
+	ResourceTypeServiceRequest,
+	ResourceTypeSlot,
+	ResourceTypeSpecimen,
+	ResourceTypeSpecimenDefinition,
+	ResourceTypeStructureDefinition,
+	ResourceTypeStructureMap,
+	ResourceTypeSubscription,
+	ResourceTypeSubscriptionStatus,
+	ResourceTypeSubscriptionTopic,
+	ResourceTypeSubstance,
+	ResourceTypeSubstanceDefinition,
+	ResourceTypeSupplyDelivery,
+	ResourceTypeSupplyRequest,
+	ResourceTypeTask,
+	ResourceTypeTerminologyCapabilities,
+	ResourceTypeTestReport,
+	ResourceTypeTestScript,
+	ResourceTypeValueSet,
+	ResourceTypeVerificationResult,
+	ResourceTypeVisionPrescription,
+}
+
 // resourceFactories maps resourceType to factory function.
-var resourceFactories = map[string]func() Resource{
-	"Account":                        func() Resource { return &Account{} },
-	"ActivityDefinition":             func() Resource { return &ActivityDefinition{} },
-	"AdministrableProductDefinition": func() Resource { return &AdministrableProductDefinition{} },
-	"AdverseEvent":                   func() Resource { return &AdverseEvent{} },
-	"AllergyIntolerance":             func() Resource { return &AllergyIntolerance{} },
-	"Appointment":                    func() Resource { return &Appointment{} },
-	"AppointmentResponse":            func() Resource { return &AppointmentResponse{} },
-	"AuditEvent":                     func() Resource { return &AuditEvent{} },
-	"Basic":                          func() Resource { return &Basic{} },
-	"Binary":                         func() Resource { return &Binary{} },
-	"BiologicallyDerivedProduct":     func() Resource { return &BiologicallyDerivedProduct{} },
-	"BodyStructure":                  func() Resource { return &BodyStructure{} },
-	"Bundle":                         func() Resource { return &Bundle{} },
-	"CapabilityStatement":            func() Resource { return &CapabilityStatement{} },
-	"CarePlan":                       func() Resource { return &CarePlan{} },
-	"CareTeam":                       func() Resource { return &CareTeam{} },
-	"CatalogEntry":                   func() Resource { return &CatalogEntry{} },
-	"ChargeItem":                     func() Resource { return &ChargeItem{} },
-	"ChargeItemDefinition":           func() Resource { return &ChargeItemDefinition{} },
-	"Citation":                       func() Resource { return &Citation{} },
-	"Claim":                          func() Resource { return &Claim{} },
-	"ClaimResponse":                  func() Resource { return &ClaimResponse{} },
-	"ClinicalImpression":             func() Resource { return &ClinicalImpression{} },
-	"ClinicalUseDefinition":          func() Resource { return &ClinicalUseDefinition{} },
-	"CodeSystem":                     func() Resource { return &CodeSystem{} },
-	"Communication":                  func() Resource { return &Communication{} },
-	"CommunicationRequest":           func() Resource { return &CommunicationRequest{} },
-	"CompartmentDefinition":          func() Resource { return &CompartmentDefinition{} },
-	"Composition":                    func() Resource { return &Composition{} },
-	"ConceptMap":                     func() Resource { return &ConceptMap{} },
-	"Condition":                      func() Resource { return &Condition{} },
-	"Consent":                        func() Resource { return &Consent{} },
-	"Contract":                       func() Resource { return &Contract{} },
-	"Coverage":                       func() Resource { return &Coverage{} },
-	"CoverageEligibilityRequest":     func() Resource { return &CoverageEligibilityRequest{} },
-	"CoverageEligibilityResponse":    func() Resource { return &CoverageEligibilityResponse{} },
-	"DetectedIssue":                  func() Resource { return &DetectedIssue{} },
-	"Device":                         func() Resource { return &Device{} },
-	"DeviceDefinition":               func() Resource { return &DeviceDefinition{} },
-	"DeviceMetric":                   func() Resource { return &DeviceMetric{} },
-	"DeviceRequest":                  func() Resource { return &DeviceRequest{} },
-	"DeviceUseStatement":             func() Resource { return &DeviceUseStatement{} },
-	"DiagnosticReport":               func() Resource { return &DiagnosticReport{} },
-	"DocumentManifest":               func() Resource { return &DocumentManifest{} },
-	"DocumentReference":              func() Resource { return &DocumentReference{} },
-	"Encounter":                      func() Resource { return &Encounter{} },
-	"Endpoint":                       func() Resource { return &Endpoint{} },
-	"EnrollmentRequest":              func() Resource { return &EnrollmentRequest{} },
-	"EnrollmentResponse":             func() Resource { return &EnrollmentResponse{} },
-	"EpisodeOfCare":                  func() Resource { return &EpisodeOfCare{} },
-	"EventDefinition":                func() Resource { return &EventDefinition{} },
-	"Evidence":                       func() Resource { return &Evidence{} },
-	"EvidenceReport":                 func() Resource { return &EvidenceReport{} },
-	"EvidenceVariable":               func() Resource { return &EvidenceVariable{} },
-	"ExampleScenario":                func() Resource { return &ExampleScenario{} },
-	"ExplanationOfBenefit":           func() Resource { return &ExplanationOfBenefit{} },
-	"FamilyMemberHistory":            func() Resource { return &FamilyMemberHistory{} },
-	"Flag":                           func() Resource { return &Flag{} },
-	"Goal":                           func() Resource { return &Goal{} },
-	"GraphDefinition":                func() Resource { return &GraphDefinition{} },
-	"Group":                          func() Resource { return &Group{} },
-	"GuidanceResponse":               func() Resource { return &GuidanceResponse{} },
-	"HealthcareService":              func() Resource { return &HealthcareService{} },
-	"ImagingStudy":                   func() Resource { return &ImagingStudy{} },
-	"Immunization":                   func() Resource { return &Immunization{} },
-	"ImmunizationEvaluation":         func() Resource { return &ImmunizationEvaluation{} },
-	"ImmunizationRecommendation":     func() Resource { return &ImmunizationRecommendation{} },
-	"ImplementationGuide":            func() Resource { return &ImplementationGuide{} },
-	"Ingredient":                     func() Resource { return &Ingredient{} },
-	"InsurancePlan":                  func() Resource { return &InsurancePlan{} },
-	"Invoice":                        func() Resource { return &Invoice{} },
-	"Library":                        func() Resource { return &Library{} },
-	"Linkage":                        func() Resource { return &Linkage{} },
-	"List":                           func() Resource { return &List{} },
-	"Location":                       func() Resource { return &Location{} },
-	"ManufacturedItemDefinition":     func() Resource { return &ManufacturedItemDefinition{} },
-	"Measure":                        func() Resource { return &Measure{} },
-	"MeasureReport":                  func() Resource { return &MeasureReport{} },
-	"Media":                          func() Resource { return &Media{} },
-	"Medication":                     func() Resource { return &Medication{} },
-	"MedicationAdministration":       func() Resource { return &MedicationAdministration{} },
-	"MedicationDispense":             func() Resource { return &MedicationDispense{} },
-	"MedicationKnowledge":            func() Resource { return &MedicationKnowledge{} },
-	"MedicationRequest":              func() Resource { return &MedicationRequest{} },
-	"MedicationStatement":            func() Resource { return &MedicationStatement{} },
-	"MedicinalProductDefinition":     func() Resource { return &MedicinalProductDefinition{} },
-	"MessageDefinition":              func() Resource { return &MessageDefinition{} },
-	"MessageHeader":                  func() Resource { return &MessageHeader{} },
-	"MolecularSequence":              func() Resource { return &MolecularSequence{} },
-	"NamingSystem":                   func() Resource { return &NamingSystem{} },
-	"NutritionOrder":                 func() Resource { return &NutritionOrder{} },
-	"NutritionProduct":               func() Resource { return &NutritionProduct{} },
-	"Observation":                    func() Resource { return &Observation{} },
-	"ObservationDefinition":          func() Resource { return &ObservationDefinition{} },
-	"OperationDefinition":            func() Resource { return &OperationDefinition{} },
-	"OperationOutcome":               func() Resource { return &OperationOutcome{} },
-	"Organization":                   func() Resource { return &Organization{} },
-	"OrganizationAffiliation":        func() Resource { return &OrganizationAffiliation{} },
-	"PackagedProductDefinition":      func() Resource { return &PackagedProductDefinition{} },
-	"Parameters":                     func() Resource { return &Parameters{} },
-	"Patient":                        func() Resource { return &Patient{} },
-	"PaymentNotice":                  func() Resource { return &PaymentNotice{} },
-	"PaymentReconciliation":          func() Resource { return &PaymentReconciliation{} },
-	"Person":                         func() Resource { return &Person{} },
-	"PlanDefinition":                 func() Resource { return &PlanDefinition{} },
-	"Practitioner":                   func() Resource { return &Practitioner{} },
-	"PractitionerRole":               func() Resource { return &PractitionerRole{} },
-	"Procedure":                      func() Resource { return &Procedure{} },
-	"Provenance":                     func() Resource { return &Provenance{} },
-	"Questionnaire":                  func() Resource { return &Questionnaire{} },
-	"QuestionnaireResponse":          func() Resource { return &QuestionnaireResponse{} },
-	"RegulatedAuthorization":         func() Resource { return &RegulatedAuthorization{} },
-	"RelatedPerson":                  func() Resource { return &RelatedPerson{} },
-	"RequestGroup":                   func() Resource { return &RequestGroup{} },
-	"ResearchDefinition":             func() Resource { return &ResearchDefinition{} },
-	"ResearchElementDefinition":      func() Resource { return &ResearchElementDefinition{} },
-	"ResearchStudy":                  func() Resource { return &ResearchStudy{} },
-	"ResearchSubject":                func() Resource { return &ResearchSubject{} },
-	"RiskAssessment":                 func() Resource { return &RiskAssessment{} },
-	"Schedule":                       func() Resource { return &Schedule{} },
-	"SearchParameter":                func() Resource { return &SearchParameter{} },
-	"ServiceRequest":                 func() Resource { return &ServiceRequest{} },
-	"Slot":                           func() Resource { return &Slot{} },
-	"Specimen":                       func() Resource { return &Specimen{} },
-	"SpecimenDefinition":             func() Resource { return &SpecimenDefinition{} },
-	"StructureDefinition":            func() Resource { return &StructureDefinition{} },
-	"StructureMap":                   func() Resource { return &StructureMap{} },
-	"Subscription":                   func() Resource { return &Subscription{} },
-	"SubscriptionStatus":             func() Resource { return &SubscriptionStatus{} },
-	"SubscriptionTopic":              func() Resource { return &SubscriptionTopic{} },
-	"Substance":                      func() Resource { return &Substance{} },
-	"SubstanceDefinition":            func() Resource { return &SubstanceDefinition{} },
-	"SupplyDelivery":                 func() Resource { return &SupplyDelivery{} },
-	"SupplyRequest":                  func() Resource { return &SupplyRequest{} },
-	"Task":                           func() Resource { return &Task{} },
-	"TerminologyCapabilities":        func() Resource { return &TerminologyCapabilities{} },
-	"TestReport":                     func() Resource { return &TestReport{} },
-	"TestScript":                     func() Resource { return &TestScript{} },
-	"ValueSet":                       func() Resource { return &ValueSet{} },
-	"VerificationResult":             func() Resource { return &VerificationResult{} },
-	"VisionPrescription":             func() Resource { return &VisionPrescription{} },
+var resourceFactories = map[ResourceType]func() Resource{
+	ResourceTypeAccount:                        func() Resource { return &Account{} },
+	ResourceTypeActivityDefinition:             func() Resource { return &ActivityDefinition{} },
+	ResourceTypeAdministrableProductDefinition: func() Resource { return &AdministrableProductDefinition{} },
+	ResourceTypeAdverseEvent:                   func() Resource { return &AdverseEvent{} },
+	ResourceTypeAllergyIntolerance:             func() Resource { return &AllergyIntolerance{} },
+	ResourceTypeAppointment:                    func() Resource { return &Appointment{} },
+	ResourceTypeAppointmentResponse:            func() Resource { return &AppointmentResponse{} },
+	ResourceTypeAuditEvent:                     func() Resource { return &AuditEvent{} },
+	ResourceTypeBasic:                          func() Resource { return &Basic{} },
+	ResourceTypeBinary:                         func() Resource { return &Binary{} },
+	ResourceTypeBiologicallyDerivedProduct:     func() Resource { return &BiologicallyDerivedProduct{} },
+	ResourceTypeBodyStructure:                  func() Resource { return &BodyStructure{} },
+	ResourceTypeBundle:                         func() Resource { return &Bundle{} },
+	ResourceTypeCapabilityStatement:            func() Resource { return &CapabilityStatement{} },
+	ResourceTypeCarePlan:                       func() Resource { return &CarePlan{} },
+	ResourceTypeCareTeam:                       func() Resource { return &CareTeam{} },
+	ResourceTypeCatalogEntry:                   func() Resource { return &CatalogEntry{} },
+	ResourceTypeChargeItem:                     func() Resource { return &ChargeItem{} },
+	ResourceTypeChargeItemDefinition:           func() Resource { return &ChargeItemDefinition{} },
+	ResourceTypeCitation:                       func() Resource { return &Citation{} },
+	ResourceTypeClaim:                          func() Resource { return &Claim{} },
+	ResourceTypeClaimResponse:                  func() Resource { return &ClaimResponse{} },
+	ResourceTypeClinicalImpression:             func() Resource { return &ClinicalImpression{} },
+	ResourceTypeClinicalUseDefinition:          func() Resource { return &ClinicalUseDefinition{} },
+	ResourceTypeCodeSystem:                     func() Resource { return &CodeSystem{} },
+	ResourceTypeCommunication:                  func() Resource { return &Communication{} },
+	ResourceTypeCommunicationRequest:           func() Resource { return &CommunicationRequest{} },
+	ResourceTypeCompartmentDefinition:          func() Resource { return &CompartmentDefinition{} },
+	ResourceTypeComposition:                    func() Resource { return &Composition{} },
+	ResourceTypeConceptMap:                     func() Resource { return &ConceptMap{} },
+	ResourceTypeCondition:                      func() Resource { return &Condition{} },
+	ResourceTypeConsent:                        func() Resource { return &Consent{} },
+	ResourceTypeContract:                       func() Resource { return &Contract{} },
+	ResourceTypeCoverage:                       func() Resource { return &Coverage{} },
+	ResourceTypeCoverageEligibilityRequest:     func() Resource { return &CoverageEligibilityRequest{} },
+	ResourceTypeCoverageEligibilityResponse:    func() Resource { return &CoverageEligibilityResponse{} },
+	ResourceTypeDetectedIssue:                  func() Resource { return &DetectedIssue{} },
+	ResourceTypeDevice:                         func() Resource { return &Device{} },
+	ResourceTypeDeviceDefinition:               func() Resource { return &DeviceDefinition{} },
+	ResourceTypeDeviceMetric:                   func() Resource { return &DeviceMetric{} },
+	ResourceTypeDeviceRequest:                  func() Resource { return &DeviceRequest{} },
+	ResourceTypeDeviceUseStatement:             func() Resource { return &DeviceUseStatement{} },
+	ResourceTypeDiagnosticReport:               func() Resource { return &DiagnosticReport{} },
+	ResourceTypeDocumentManifest:               func() Resource { return &DocumentManifest{} },
+	ResourceTypeDocumentReference:              func() Resource { return &DocumentReference{} },
+	ResourceTypeEncounter:                      func() Resource { return &Encounter{} },
+	ResourceTypeEndpoint:                       func() Resource { return &Endpoint{} },
+	ResourceTypeEnrollmentRequest:              func() Resource { return &EnrollmentRequest{} },
+	ResourceTypeEnrollmentResponse:             func() Resource { return &EnrollmentResponse{} },
+	ResourceTypeEpisodeOfCare:                  func() Resource { return &EpisodeOfCare{} },
+	ResourceTypeEventDefinition:                func() Resource { return &EventDefinition{} },
+	ResourceTypeEvidence:                       func() Resource { return &Evidence{} },
+	ResourceTypeEvidenceReport:                 func() Resource { return &EvidenceReport{} },
+	ResourceTypeEvidenceVariable:               func() Resource { return &EvidenceVariable{} },
+	ResourceTypeExampleScenario:                func() Resource { return &ExampleScenario{} },
+	ResourceTypeExplanationOfBenefit:           func() Resource { return &ExplanationOfBenefit{} },
+	ResourceTypeFamilyMemberHistory:            func() Resource { return &FamilyMemberHistory{} },
+	ResourceTypeFlag:                           func() Resource { return &Flag{} },
+	ResourceTypeGoal:                           func() Resource { return &Goal{} },
+	ResourceTypeGraphDefinition:                func() Resource { return &GraphDefinition{} },
+	ResourceTypeGroup:                          func() Resource { return &Group{} },
+	ResourceTypeGuidanceResponse:               func() Resource { return &GuidanceResponse{} },
+	ResourceTypeHealthcareService:              func() Resource { return &HealthcareService{} },
+	ResourceTypeImagingStudy:                   func() Resource { return &ImagingStudy{} },
+	ResourceTypeImmunization:                   func() Resource { return &Immunization{} },
+	ResourceTypeImmunizationEvaluation:         func() Resource { return &ImmunizationEvaluation{} },
+	ResourceTypeImmunizationRecommendation:     func() Resource { return &ImmunizationRecommendation{} },
+	ResourceTypeImplementationGuide:            func() Resource { return &ImplementationGuide{} },
+	ResourceTypeIngredient:                     func() Resource { return &Ingredient{} },
+	ResourceTypeInsurancePlan:                  func() Resource { return &InsurancePlan{} },
+	ResourceTypeInvoice:                        func() Resource { return &Invoice{} },
+	ResourceTypeLibrary:                        func() Resource { return &Library{} },
+	ResourceTypeLinkage:                        func() Resource { return &Linkage{} },
+	ResourceTypeList:                           func() Resource { return &List{} },
+	ResourceTypeLocation:                       func() Resource { return &Location{} },
+	ResourceTypeManufacturedItemDefinition:     func() Resource { return &ManufacturedItemDefinition{} },
+	ResourceTypeMeasure:                        func() Resource { return &Measure{} },
+	ResourceTypeMeasureReport:                  func() Resource { return &MeasureReport{} },
+	ResourceTypeMedia:                          func() Resource { return &Media{} },
+	ResourceTypeMedication:                     func() Resource { return &Medication{} },
+	ResourceTypeMedicationAdministration:       func() Resource { return &MedicationAdministration{} },
+	ResourceTypeMedicationDispense:             func() Resource { return &MedicationDispense{} },
+	ResourceTypeMedicationKnowledge:            func() Resource { return &MedicationKnowledge{} },
+	ResourceTypeMedicationRequest:              func() Resource { return &MedicationRequest{} },
+	ResourceTypeMedicationStatement:            func() Resource { return &MedicationStatement{} },
+	ResourceTypeMedicinalProductDefinition:     func() Resource { return &MedicinalProductDefinition{} },
+	ResourceTypeMessageDefinition:              func() Resource { return &MessageDefinition{} },
+	ResourceTypeMessageHeader:                  func() Resource { return &MessageHeader{} },
+	ResourceTypeMolecularSequence:              func() Resource { return &MolecularSequence{} },
+	ResourceTypeNamingSystem:                   func() Resource { return &NamingSystem{} },
+	ResourceTypeNutritionOrder:                 func() Resource { return &NutritionOrder{} },
+	ResourceTypeNutritionProduct:               func() Resource { return &NutritionProduct{} },
+	ResourceTypeObservation:                    func() Resource { return &Observation{} },
+	ResourceTypeObservationDefinition:          func() Resource { return &ObservationDefinition{} },
+	ResourceTypeOperationDefinition:            func() Resource { return &OperationDefinition{} },
+	ResourceTypeOperationOutcome:               func() Resource { return &OperationOutcome{} },
+	ResourceTypeOrganization:                   func() Resource { return &Organization{} },
+	ResourceTypeOrganizationAffiliation:        func() Resource { return &OrganizationAffiliation{} },
+	ResourceTypePackagedProductDefinition:      func() Resource { return &PackagedProductDefinition{} },
+	ResourceTypeParameters:                     func() Resource { return &Parameters{} },
+	ResourceTypePatient:                        func() Resource { return &Patient{} },
+	ResourceTypePaymentNotice:                  func() Resource { return &PaymentNotice{} },
+	ResourceTypePaymentReconciliation:          func() Resource { return &PaymentReconciliation{} },
+	ResourceTypePerson:                         func() Resource { return &Person{} },
+	ResourceTypePlanDefinition:                 func() Resource { return &PlanDefinition{} },
+	ResourceTypePractitioner:                   func() Resource { return &Practitioner{} },
+	ResourceTypePractitionerRole:               func() Resource { return &PractitionerRole{} },
+	ResourceTypeProcedure:                      func() Resource { return &Procedure{} },
+	ResourceTypeProvenance:                     func() Resource { return &Provenance{} },
+	ResourceTypeQuestionnaire:                  func() Resource { return &Questionnaire{} },
+	ResourceTypeQuestionnaireResponse:          func() Resource { return &QuestionnaireResponse{} },
+	ResourceTypeRegulatedAuthorization:         func() Resource { return &RegulatedAuthorization{} },
+	ResourceTypeRelatedPerson:                  func() Resource { return &RelatedPerson{} },
+	ResourceTypeRequestGroup:                   func() Resource { return &RequestGroup{} },
+	ResourceTypeResearchDefinition:             func() Resource { return &ResearchDefinition{} },
+	ResourceTypeResearchElementDefinition:      func() Resource { return &ResearchElementDefinition{} },
+	ResourceTypeResearchStudy:                  func() Resource { return &ResearchStudy{} },
+	ResourceTypeResearchSubject:                func() Resource { return &ResearchSubject{} },
+	ResourceTypeRiskAssessment:                 func() Resource { return &RiskAssessment{} },
+	ResourceTypeSchedule:                       func() Resource { return &Schedule{} },
+	ResourceTypeSearchParameter:                func() Resource { return &SearchParameter{} },
+	ResourceTypeServiceRequest:                 func() Resource { return &ServiceRequest{} },
+	ResourceTypeSlot:                           func() Resource { return &Slot{} },
+	ResourceTypeSpecimen:                       func() Resource { return &Specimen{} },
+	ResourceTypeSpecimenDefinition:             func() Resource { return &SpecimenDefinition{} },
+	ResourceTypeStructureDefinition:            func() Resource { return &StructureDefinition{} },
+	ResourceTypeStructureMap:                   func() Resource { return &StructureMap{} },
+	ResourceTypeSubscription:                   func() Resource { return &Subscription{} },
+	ResourceTypeSubscriptionStatus:             func() Resource { return &SubscriptionStatus{} },
+	ResourceTypeSubscriptionTopic:              func() Resource { return &SubscriptionTopic{} },
+	ResourceTypeSubstance:                      func() Resource { return &Substance{} },
+	ResourceTypeSubstanceDefinition:            func() Resource { return &SubstanceDefinition{} },
+	ResourceTypeSupplyDelivery:                 func() Resource { return &SupplyDelivery{} },
+	ResourceTypeSupplyRequest:                  func() Resource { return &SupplyRequest{} },
+	ResourceTypeTask:                           func() Resource { return &Task{} },
+	ResourceTypeTerminologyCapabilities:        func() Resource { return &TerminologyCapabilities{} },
+	ResourceTypeTestReport:                     func() Resource { return &TestReport{} },
+	ResourceTypeTestScript:                     func() Resource { return &TestScript{} },
+	ResourceTypeValueSet:                       func() Resource { return &ValueSet{} },
+	ResourceTypeVerificationResult:             func() Resource { return &VerificationResult{} },
+	ResourceTypeVisionPrescription:             func() Resource { return &VisionPrescription{} },
 }
 
 // NewResource creates an empty instance of the specified resource type.
 // Returns an error if the resource type is unknown.
 func NewResource(resourceType string) (Resource, error) {
-	factory, ok := resourceFactories[resourceType]
+	factory, ok := resourceFactories[ResourceType(resourceType)]
 	if !ok {
 		return nil, fmt.Errorf("unknown resource type: %s", resourceType)
 	}
@@ -185,6 +483,98 @@ func UnmarshalResource(data []byte) (Resource, error) {
 	return resource, nil
 }
 
+// UnmarshalOptions configures UnmarshalResourceWithOptions.
+type UnmarshalOptions struct {
+	// PreserveUnknownFields captures top-level JSON fields that are not part of the
+	// resource definition into the resource's Extra map, so that they survive a
+	// marshal round-trip (e.g., vendor fields passing through a proxy).
+	PreserveUnknownFields bool
+}
+
+// UnmarshalResourceWithOptions deserializes JSON to the correct resource type like
+// UnmarshalResource, applying the given options.
+func UnmarshalResourceWithOptions(data []byte, opts UnmarshalOptions) (Resource, error) {
+	resource, err := UnmarshalResource(data)
+	if err != nil || !opts.PreserveUnknownFields {
+		return resource, err
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, fmt.Errorf("failed to parse JSON: %w", err)
+	}
+
+	value := reflect.ValueOf(resource).Elem()
+	known := knownJSONFields(value.Type())
+	for name := range fields {
+		if known[name] {
+			delete(fields, name)
+		}
+	}
+	if len(fields) > 0 {
+		value.FieldByName("Extra").Set(reflect.ValueOf(fields))
+	}
+
+	return resource, nil
+}
+
+// knownFieldsCache caches the JSON field names of each resource struct type.
+var knownFieldsCache sync.Map
+
+// knownJSONFields returns the JSON field names declared by a resource struct type.
+func knownJSONFields(t reflect.Type) map[string]bool {
+	if cached, ok := knownFieldsCache.Load(t); ok {
+		return cached.(map[string]bool)
+	}
+
+	known := make(map[string]bool, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		name, _, _ := strings.Cut(t.Field(i).Tag.Get("json"), ",")
+		if name != "" && name != "-" {
+			known[name] = true
+		}
+	}
+	knownFieldsCache.Store(t, known)
+	return known
+}
+
+// appendExtraFields appends the fields in extra to the JSON object in data, in
+// sorted order. Fields already present in data are not overwritten.
+func appendExtraFields(data []byte, extra map[string]json.RawMessage) ([]byte, error) {
+	var present map[string]json.RawMessage
+	if err := json.Unmarshal(data, &present); err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(extra))
+	for name := range extra {
+		if _, ok := present[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	buf := bytes.NewBuffer(data[:len(data)-1])
+	for _, name := range names {
+		key, err := json.Marshal(name)
+		if err != nil {
+			return nil, err
+		}
+		value, err := json.Marshal(extra[name])
+		if err != nil {
+			return nil, err
+		}
+		if buf.Len() > 1 {
+			buf.WriteByte(',')
+		}
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
 // GetResourceType extracts the resourceType from JSON without fully deserializing.
 // This is useful for routing or validation before full deserialization.
 func GetResourceType(data []byte) (string, error) {
@@ -202,15 +592,15 @@ func GetResourceType(data []byte) (string, error) {
 
 // IsKnownResourceType returns true if the given resource type is known.
 func IsKnownResourceType(resourceType string) bool {
-	_, ok := resourceFactories[resourceType]
+	_, ok := resourceFactories[ResourceType(resourceType)]
 	return ok
 }
 
-// AllResourceTypes returns a slice of all known resource type names.
+// AllResourceTypes returns a slice of all known resource type names, in alphabetical order.
 func AllResourceTypes() []string {
-	types := make([]string, 0, len(resourceFactories))
-	for t := range resourceFactories {
-		types = append(types, t)
+	types := make([]string, len(ResourceTypes))
+	for i, t := range ResourceTypes {
+		types[i] = string(t)
 	}
 	return types
 }
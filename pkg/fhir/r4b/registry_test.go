@@ -2,6 +2,7 @@ package r4b_test
 
 import (
 	"encoding/json"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -170,6 +171,28 @@ func TestUnmarshalResourceRoundTrip(t *testing.T) {
 	assert.Equal(t, []string{"John"}, patient.Name[0].Given)
 }
 
+func TestUnmarshalResourcePreservesUnknownFields(t *testing.T) {
+	data := []byte(`{"resourceType":"Patient","id":"p1","vendorScore":{"value":42},"active":true}`)
+
+	resource, err := r4b.UnmarshalResourceWithOptions(data, r4b.UnmarshalOptions{PreserveUnknownFields: true})
+	require.NoError(t, err)
+
+	patient, ok := resource.(*r4b.Patient)
+	require.True(t, ok, "expected *r4b.Patient, got %T", resource)
+	assert.Equal(t, "p1", *patient.Id)
+	require.Len(t, patient.Extra, 1)
+	assert.JSONEq(t, `{"value":42}`, string(patient.Extra["vendorScore"]))
+
+	out, err := json.Marshal(patient)
+	require.NoError(t, err)
+	assert.JSONEq(t, string(data), string(out))
+
+	// Without the option unknown fields are dropped
+	resource, err = r4b.UnmarshalResourceWithOptions(data, r4b.UnmarshalOptions{})
+	require.NoError(t, err)
+	assert.Empty(t, resource.(*r4b.Patient).Extra)
+}
+
 func TestGetResourceType(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -259,6 +282,22 @@ func TestAllResourceTypes(t *testing.T) {
 	assert.True(t, typeSet["Medication"], "should include Medication")
 }
 
+func TestResourceTypeConstants(t *testing.T) {
+	// One constant per generated resource file
+	files, err := filepath.Glob("resource_*.go")
+	require.NoError(t, err)
+	assert.Len(t, r4b.ResourceTypes, len(files))
+	assert.Len(t, r4b.AllResourceTypes(), len(r4b.ResourceTypes))
+
+	assert.Equal(t, r4b.ResourceType("Patient"), r4b.ResourceTypePatient)
+
+	for _, rt := range r4b.ResourceTypes {
+		resource, err := r4b.NewResource(string(rt))
+		require.NoError(t, err)
+		assert.Equal(t, string(rt), resource.GetResourceType())
+	}
+}
+
 // Helper functions
 func ptrString(s string) *string {
 	return &s
@@ -61,11 +61,13 @@ type Account struct {
 	Guarantor []AccountGuarantor `json:"guarantor,omitempty"`
 	// Reference to a parent Account
 	PartOf *Reference `json:"partOf,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *Account) GetResourceType() string {
-	return "Account"
+	return string(ResourceTypeAccount)
 }
 
 // GetId returns the resource's logical ID.
@@ -113,11 +115,16 @@ func (r *Account) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r Account) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "Account"
+	r.ResourceType = string(ResourceTypeAccount)
 	type Alias Account
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
@@ -189,11 +189,13 @@ type ActivityDefinition struct {
 	TransformExt *Element `json:"_transform,omitempty"`
 	// Dynamic aspects of the definition
 	DynamicValue []ActivityDefinitionDynamicValue `json:"dynamicValue,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *ActivityDefinition) GetResourceType() string {
-	return "ActivityDefinition"
+	return string(ResourceTypeActivityDefinition)
 }
 
 // GetId returns the resource's logical ID.
@@ -241,11 +243,31 @@ func (r *ActivityDefinition) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// GetUrl returns the resource's canonical URL.
+func (r *ActivityDefinition) GetUrl() *string {
+	return r.Url
+}
+
+// GetVersion returns the resource's business version.
+func (r *ActivityDefinition) GetVersion() *string {
+	return r.Version
+}
+
+// GetStatus returns the resource's publication status.
+func (r *ActivityDefinition) GetStatus() *PublicationStatus {
+	return r.Status
+}
+
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r ActivityDefinition) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "ActivityDefinition"
+	r.ResourceType = string(ResourceTypeActivityDefinition)
 	type Alias ActivityDefinition
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
@@ -55,11 +55,13 @@ type AdministrableProductDefinition struct {
 	Property []AdministrableProductDefinitionProperty `json:"property,omitempty"`
 	// The path by which the product is taken into or makes contact with the body
 	RouteOfAdministration []AdministrableProductDefinitionRouteOfAdministration `json:"routeOfAdministration,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *AdministrableProductDefinition) GetResourceType() string {
-	return "AdministrableProductDefinition"
+	return string(ResourceTypeAdministrableProductDefinition)
 }
 
 // GetId returns the resource's logical ID.
@@ -107,11 +109,16 @@ func (r *AdministrableProductDefinition) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r AdministrableProductDefinition) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "AdministrableProductDefinition"
+	r.ResourceType = string(ResourceTypeAdministrableProductDefinition)
 	type Alias AdministrableProductDefinition
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
@@ -95,11 +95,13 @@ type Appointment struct {
 	Participant []AppointmentParticipant `json:"participant,omitempty"`
 	// Potential date/time interval(s) requested to allocate the appointment within
 	RequestedPeriod []Period `json:"requestedPeriod,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *Appointment) GetResourceType() string {
-	return "Appointment"
+	return string(ResourceTypeAppointment)
 }
 
 // GetId returns the resource's logical ID.
@@ -147,11 +149,16 @@ func (r *Appointment) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r Appointment) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "Appointment"
+	r.ResourceType = string(ResourceTypeAppointment)
 	type Alias Appointment
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
@@ -63,11 +63,13 @@ type AuditEvent struct {
 	Source *AuditEventSource `json:"source,omitempty"`
 	// Data or objects used
 	Entity []AuditEventEntity `json:"entity,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *AuditEvent) GetResourceType() string {
-	return "AuditEvent"
+	return string(ResourceTypeAuditEvent)
 }
 
 // GetId returns the resource's logical ID.
@@ -115,11 +117,16 @@ func (r *AuditEvent) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r AuditEvent) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "AuditEvent"
+	r.ResourceType = string(ResourceTypeAuditEvent)
 	type Alias AuditEvent
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
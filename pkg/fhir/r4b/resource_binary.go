@@ -34,11 +34,13 @@ type Binary struct {
 	Data *string `json:"data,omitempty"`
 	// Extension for Data
 	DataExt *Element `json:"_data,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *Binary) GetResourceType() string {
-	return "Binary"
+	return string(ResourceTypeBinary)
 }
 
 // GetId returns the resource's logical ID.
@@ -61,9 +63,14 @@ func (r *Binary) SetMeta(m *Meta) {
 	r.Meta = m
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r Binary) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "Binary"
+	r.ResourceType = string(ResourceTypeBinary)
 	type Alias Binary
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
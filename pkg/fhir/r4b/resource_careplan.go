@@ -93,11 +93,13 @@ type CarePlan struct {
 	Activity []CarePlanActivity `json:"activity,omitempty"`
 	// Comments about the plan
 	Note []Annotation `json:"note,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *CarePlan) GetResourceType() string {
-	return "CarePlan"
+	return string(ResourceTypeCarePlan)
 }
 
 // GetId returns the resource's logical ID.
@@ -145,11 +147,16 @@ func (r *CarePlan) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r CarePlan) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "CarePlan"
+	r.ResourceType = string(ResourceTypeCarePlan)
 	type Alias CarePlan
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
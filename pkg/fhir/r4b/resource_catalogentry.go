@@ -67,11 +67,13 @@ type CatalogEntry struct {
 	AdditionalClassification []CodeableConcept `json:"additionalClassification,omitempty"`
 	// An item that this catalog entry is related to
 	RelatedEntry []CatalogEntryRelatedEntry `json:"relatedEntry,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *CatalogEntry) GetResourceType() string {
-	return "CatalogEntry"
+	return string(ResourceTypeCatalogEntry)
 }
 
 // GetId returns the resource's logical ID.
@@ -119,11 +121,16 @@ func (r *CatalogEntry) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r CatalogEntry) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "CatalogEntry"
+	r.ResourceType = string(ResourceTypeCatalogEntry)
 	type Alias CatalogEntry
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
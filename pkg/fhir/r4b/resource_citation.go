@@ -117,11 +117,13 @@ type Citation struct {
 	RelatesTo []CitationRelatesTo `json:"relatesTo,omitempty"`
 	// The article or artifact being described
 	CitedArtifact *CitationCitedArtifact `json:"citedArtifact,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *Citation) GetResourceType() string {
-	return "Citation"
+	return string(ResourceTypeCitation)
 }
 
 // GetId returns the resource's logical ID.
@@ -169,11 +171,31 @@ func (r *Citation) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// GetUrl returns the resource's canonical URL.
+func (r *Citation) GetUrl() *string {
+	return r.Url
+}
+
+// GetVersion returns the resource's business version.
+func (r *Citation) GetVersion() *string {
+	return r.Version
+}
+
+// GetStatus returns the resource's publication status.
+func (r *Citation) GetStatus() *PublicationStatus {
+	return r.Status
+}
+
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r Citation) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "Citation"
+	r.ResourceType = string(ResourceTypeCitation)
 	type Alias Citation
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
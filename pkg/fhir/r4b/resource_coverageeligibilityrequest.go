@@ -71,11 +71,13 @@ type CoverageEligibilityRequest struct {
 	Insurance []CoverageEligibilityRequestInsurance `json:"insurance,omitempty"`
 	// Item to be evaluated for eligibiity
 	Item []CoverageEligibilityRequestItem `json:"item,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *CoverageEligibilityRequest) GetResourceType() string {
-	return "CoverageEligibilityRequest"
+	return string(ResourceTypeCoverageEligibilityRequest)
 }
 
 // GetId returns the resource's logical ID.
@@ -123,11 +125,16 @@ func (r *CoverageEligibilityRequest) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r CoverageEligibilityRequest) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "CoverageEligibilityRequest"
+	r.ResourceType = string(ResourceTypeCoverageEligibilityRequest)
 	type Alias CoverageEligibilityRequest
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
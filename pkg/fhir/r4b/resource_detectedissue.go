@@ -69,11 +69,13 @@ type DetectedIssue struct {
 	ReferenceExt *Element `json:"_reference,omitempty"`
 	// Step taken to address
 	Mitigation []DetectedIssueMitigation `json:"mitigation,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *DetectedIssue) GetResourceType() string {
-	return "DetectedIssue"
+	return string(ResourceTypeDetectedIssue)
 }
 
 // GetId returns the resource's logical ID.
@@ -121,11 +123,16 @@ func (r *DetectedIssue) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r DetectedIssue) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "DetectedIssue"
+	r.ResourceType = string(ResourceTypeDetectedIssue)
 	type Alias DetectedIssue
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
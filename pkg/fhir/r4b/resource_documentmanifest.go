@@ -65,11 +65,13 @@ type DocumentManifest struct {
 	Content []Reference `json:"content,omitempty"`
 	// Related things
 	Related []DocumentManifestRelated `json:"related,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *DocumentManifest) GetResourceType() string {
-	return "DocumentManifest"
+	return string(ResourceTypeDocumentManifest)
 }
 
 // GetId returns the resource's logical ID.
@@ -117,11 +119,16 @@ func (r *DocumentManifest) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r DocumentManifest) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "DocumentManifest"
+	r.ResourceType = string(ResourceTypeDocumentManifest)
 	type Alias DocumentManifest
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
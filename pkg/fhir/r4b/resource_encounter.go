@@ -81,11 +81,13 @@ type Encounter struct {
 	ServiceProvider *Reference `json:"serviceProvider,omitempty"`
 	// Another Encounter this encounter is part of
 	PartOf *Reference `json:"partOf,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *Encounter) GetResourceType() string {
-	return "Encounter"
+	return string(ResourceTypeEncounter)
 }
 
 // GetId returns the resource's logical ID.
@@ -133,11 +135,16 @@ func (r *Encounter) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r Encounter) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "Encounter"
+	r.ResourceType = string(ResourceTypeEncounter)
 	type Alias Encounter
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
@@ -57,11 +57,13 @@ type EnrollmentResponse struct {
 	Organization *Reference `json:"organization,omitempty"`
 	// Responsible practitioner
 	RequestProvider *Reference `json:"requestProvider,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *EnrollmentResponse) GetResourceType() string {
-	return "EnrollmentResponse"
+	return string(ResourceTypeEnrollmentResponse)
 }
 
 // GetId returns the resource's logical ID.
@@ -109,11 +111,16 @@ func (r *EnrollmentResponse) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r EnrollmentResponse) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "EnrollmentResponse"
+	r.ResourceType = string(ResourceTypeEnrollmentResponse)
 	type Alias EnrollmentResponse
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
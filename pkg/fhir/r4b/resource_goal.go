@@ -75,11 +75,13 @@ type Goal struct {
 	OutcomeCode []CodeableConcept `json:"outcomeCode,omitempty"`
 	// Observation that resulted from goal
 	OutcomeReference []Reference `json:"outcomeReference,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *Goal) GetResourceType() string {
-	return "Goal"
+	return string(ResourceTypeGoal)
 }
 
 // GetId returns the resource's logical ID.
@@ -127,11 +129,16 @@ func (r *Goal) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r Goal) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "Goal"
+	r.ResourceType = string(ResourceTypeGoal)
 	type Alias Goal
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
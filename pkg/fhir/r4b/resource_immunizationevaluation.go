@@ -79,11 +79,13 @@ type ImmunizationEvaluation struct {
 	SeriesDosesString *string `json:"seriesDosesString,omitempty"`
 	// Extension for SeriesDosesString
 	SeriesDosesStringExt *Element `json:"_seriesDosesString,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *ImmunizationEvaluation) GetResourceType() string {
-	return "ImmunizationEvaluation"
+	return string(ResourceTypeImmunizationEvaluation)
 }
 
 // GetId returns the resource's logical ID.
@@ -131,11 +133,16 @@ func (r *ImmunizationEvaluation) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r ImmunizationEvaluation) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "ImmunizationEvaluation"
+	r.ResourceType = string(ResourceTypeImmunizationEvaluation)
 	type Alias ImmunizationEvaluation
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
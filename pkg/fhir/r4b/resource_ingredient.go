@@ -53,11 +53,13 @@ type Ingredient struct {
 	Manufacturer []IngredientManufacturer `json:"manufacturer,omitempty"`
 	// The substance that comprises this ingredient
 	Substance *IngredientSubstance `json:"substance,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *Ingredient) GetResourceType() string {
-	return "Ingredient"
+	return string(ResourceTypeIngredient)
 }
 
 // GetId returns the resource's logical ID.
@@ -105,11 +107,16 @@ func (r *Ingredient) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r Ingredient) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "Ingredient"
+	r.ResourceType = string(ResourceTypeIngredient)
 	type Alias Ingredient
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
@@ -67,11 +67,13 @@ type InsurancePlan struct {
 	Coverage []InsurancePlanCoverage `json:"coverage,omitempty"`
 	// Plan details
 	Plan []InsurancePlanPlan `json:"plan,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *InsurancePlan) GetResourceType() string {
-	return "InsurancePlan"
+	return string(ResourceTypeInsurancePlan)
 }
 
 // GetId returns the resource's logical ID.
@@ -119,11 +121,16 @@ func (r *InsurancePlan) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r InsurancePlan) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "InsurancePlan"
+	r.ResourceType = string(ResourceTypeInsurancePlan)
 	type Alias InsurancePlan
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
@@ -41,11 +41,13 @@ type Linkage struct {
 	Author *Reference `json:"author,omitempty"`
 	// Item to be linked
 	Item []LinkageItem `json:"item,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *Linkage) GetResourceType() string {
-	return "Linkage"
+	return string(ResourceTypeLinkage)
 }
 
 // GetId returns the resource's logical ID.
@@ -93,11 +95,16 @@ func (r *Linkage) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r Linkage) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "Linkage"
+	r.ResourceType = string(ResourceTypeLinkage)
 	type Alias Linkage
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
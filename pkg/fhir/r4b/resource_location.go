@@ -79,11 +79,13 @@ type Location struct {
 	AvailabilityExceptionsExt *Element `json:"_availabilityExceptions,omitempty"`
 	// Technical endpoints providing access to services operated for the location
 	Endpoint []Reference `json:"endpoint,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *Location) GetResourceType() string {
-	return "Location"
+	return string(ResourceTypeLocation)
 }
 
 // GetId returns the resource's logical ID.
@@ -131,11 +133,16 @@ func (r *Location) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r Location) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "Location"
+	r.ResourceType = string(ResourceTypeLocation)
 	type Alias Location
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
@@ -49,11 +49,13 @@ type ManufacturedItemDefinition struct {
 	Ingredient []CodeableConcept `json:"ingredient,omitempty"`
 	// General characteristics of this item
 	Property []ManufacturedItemDefinitionProperty `json:"property,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *ManufacturedItemDefinition) GetResourceType() string {
-	return "ManufacturedItemDefinition"
+	return string(ResourceTypeManufacturedItemDefinition)
 }
 
 // GetId returns the resource's logical ID.
@@ -101,11 +103,16 @@ func (r *ManufacturedItemDefinition) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r ManufacturedItemDefinition) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "ManufacturedItemDefinition"
+	r.ResourceType = string(ResourceTypeManufacturedItemDefinition)
 	type Alias ManufacturedItemDefinition
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
@@ -79,11 +79,13 @@ type NutritionOrder struct {
 	EnteralFormula *NutritionOrderEnteralFormula `json:"enteralFormula,omitempty"`
 	// Comments
 	Note []Annotation `json:"note,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *NutritionOrder) GetResourceType() string {
-	return "NutritionOrder"
+	return string(ResourceTypeNutritionOrder)
 }
 
 // GetId returns the resource's logical ID.
@@ -131,11 +133,16 @@ func (r *NutritionOrder) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r NutritionOrder) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "NutritionOrder"
+	r.ResourceType = string(ResourceTypeNutritionOrder)
 	type Alias NutritionOrder
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
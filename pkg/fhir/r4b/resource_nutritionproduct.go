@@ -55,11 +55,13 @@ type NutritionProduct struct {
 	Instance *NutritionProductInstance `json:"instance,omitempty"`
 	// Comments made about the product
 	Note []Annotation `json:"note,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *NutritionProduct) GetResourceType() string {
-	return "NutritionProduct"
+	return string(ResourceTypeNutritionProduct)
 }
 
 // GetId returns the resource's logical ID.
@@ -107,11 +109,16 @@ func (r *NutritionProduct) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r NutritionProduct) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "NutritionProduct"
+	r.ResourceType = string(ResourceTypeNutritionProduct)
 	type Alias NutritionProduct
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
@@ -35,11 +35,13 @@ type OperationOutcome struct {
 	ModifierExtension []Extension `json:"modifierExtension,omitempty"`
 	// A single issue associated with the action
 	Issue []OperationOutcomeIssue `json:"issue,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *OperationOutcome) GetResourceType() string {
-	return "OperationOutcome"
+	return string(ResourceTypeOperationOutcome)
 }
 
 // GetId returns the resource's logical ID.
@@ -87,11 +89,16 @@ func (r *OperationOutcome) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r OperationOutcome) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "OperationOutcome"
+	r.ResourceType = string(ResourceTypeOperationOutcome)
 	type Alias OperationOutcome
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
@@ -26,11 +26,13 @@ type Parameters struct {
 	LanguageExt *Element `json:"_language,omitempty"`
 	// Operation Parameter
 	Parameter []ParametersParameter `json:"parameter,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *Parameters) GetResourceType() string {
-	return "Parameters"
+	return string(ResourceTypeParameters)
 }
 
 // GetId returns the resource's logical ID.
@@ -53,9 +55,14 @@ func (r *Parameters) SetMeta(m *Meta) {
 	r.Meta = m
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r Parameters) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "Parameters"
+	r.ResourceType = string(ResourceTypeParameters)
 	type Alias Parameters
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
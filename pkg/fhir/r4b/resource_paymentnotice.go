@@ -63,11 +63,13 @@ type PaymentNotice struct {
 	Amount Money `json:"amount"`
 	// Issued or cleared Status of the payment
 	PaymentStatus *CodeableConcept `json:"paymentStatus,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *PaymentNotice) GetResourceType() string {
-	return "PaymentNotice"
+	return string(ResourceTypePaymentNotice)
 }
 
 // GetId returns the resource's logical ID.
@@ -115,11 +117,16 @@ func (r *PaymentNotice) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r PaymentNotice) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "PaymentNotice"
+	r.ResourceType = string(ResourceTypePaymentNotice)
 	type Alias PaymentNotice
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
@@ -65,11 +65,13 @@ type PractitionerRole struct {
 	AvailabilityExceptionsExt *Element `json:"_availabilityExceptions,omitempty"`
 	// Technical endpoints providing access to services operated for the practitioner with this role
 	Endpoint []Reference `json:"endpoint,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *PractitionerRole) GetResourceType() string {
-	return "PractitionerRole"
+	return string(ResourceTypePractitionerRole)
 }
 
 // GetId returns the resource's logical ID.
@@ -117,11 +119,16 @@ func (r *PractitionerRole) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r PractitionerRole) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "PractitionerRole"
+	r.ResourceType = string(ResourceTypePractitionerRole)
 	type Alias PractitionerRole
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
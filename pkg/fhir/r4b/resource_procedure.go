@@ -107,11 +107,13 @@ type Procedure struct {
 	UsedReference []Reference `json:"usedReference,omitempty"`
 	// Coded items used during the procedure
 	UsedCode []CodeableConcept `json:"usedCode,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *Procedure) GetResourceType() string {
-	return "Procedure"
+	return string(ResourceTypeProcedure)
 }
 
 // GetId returns the resource's logical ID.
@@ -159,11 +161,16 @@ func (r *Procedure) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r Procedure) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "Procedure"
+	r.ResourceType = string(ResourceTypeProcedure)
 	type Alias Procedure
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
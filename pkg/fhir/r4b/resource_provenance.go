@@ -61,11 +61,13 @@ type Provenance struct {
 	Entity []ProvenanceEntity `json:"entity,omitempty"`
 	// Signature on target
 	Signature []Signature `json:"signature,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *Provenance) GetResourceType() string {
-	return "Provenance"
+	return string(ResourceTypeProvenance)
 }
 
 // GetId returns the resource's logical ID.
@@ -113,11 +115,16 @@ func (r *Provenance) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r Provenance) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "Provenance"
+	r.ResourceType = string(ResourceTypeProvenance)
 	type Alias Provenance
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
@@ -107,11 +107,13 @@ type Questionnaire struct {
 	Code []Coding `json:"code,omitempty"`
 	// Questions and sections within the Questionnaire
 	Item []QuestionnaireItem `json:"item,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *Questionnaire) GetResourceType() string {
-	return "Questionnaire"
+	return string(ResourceTypeQuestionnaire)
 }
 
 // GetId returns the resource's logical ID.
@@ -159,11 +161,31 @@ func (r *Questionnaire) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// GetUrl returns the resource's canonical URL.
+func (r *Questionnaire) GetUrl() *string {
+	return r.Url
+}
+
+// GetVersion returns the resource's business version.
+func (r *Questionnaire) GetVersion() *string {
+	return r.Version
+}
+
+// GetStatus returns the resource's publication status.
+func (r *Questionnaire) GetStatus() *PublicationStatus {
+	return r.Status
+}
+
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r Questionnaire) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "Questionnaire"
+	r.ResourceType = string(ResourceTypeQuestionnaire)
 	type Alias Questionnaire
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
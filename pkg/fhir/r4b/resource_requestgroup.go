@@ -81,11 +81,13 @@ type RequestGroup struct {
 	Note []Annotation `json:"note,omitempty"`
 	// Proposed actions, if any
 	Action []RequestGroupAction `json:"action,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *RequestGroup) GetResourceType() string {
-	return "RequestGroup"
+	return string(ResourceTypeRequestGroup)
 }
 
 // GetId returns the resource's logical ID.
@@ -133,11 +135,16 @@ func (r *RequestGroup) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r RequestGroup) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "RequestGroup"
+	r.ResourceType = string(ResourceTypeRequestGroup)
 	type Alias RequestGroup
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
@@ -139,11 +139,13 @@ type ResearchDefinition struct {
 	ExposureAlternative *Reference `json:"exposureAlternative,omitempty"`
 	// What outcome?
 	Outcome *Reference `json:"outcome,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *ResearchDefinition) GetResourceType() string {
-	return "ResearchDefinition"
+	return string(ResourceTypeResearchDefinition)
 }
 
 // GetId returns the resource's logical ID.
@@ -191,11 +193,31 @@ func (r *ResearchDefinition) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// GetUrl returns the resource's canonical URL.
+func (r *ResearchDefinition) GetUrl() *string {
+	return r.Url
+}
+
+// GetVersion returns the resource's business version.
+func (r *ResearchDefinition) GetVersion() *string {
+	return r.Version
+}
+
+// GetStatus returns the resource's publication status.
+func (r *ResearchDefinition) GetStatus() *PublicationStatus {
+	return r.Status
+}
+
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r ResearchDefinition) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "ResearchDefinition"
+	r.ResourceType = string(ResourceTypeResearchDefinition)
 	type Alias ResearchDefinition
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
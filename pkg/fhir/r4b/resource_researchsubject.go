@@ -55,11 +55,13 @@ type ResearchSubject struct {
 	ActualArmExt *Element `json:"_actualArm,omitempty"`
 	// Agreement to participate in study
 	Consent *Reference `json:"consent,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *ResearchSubject) GetResourceType() string {
-	return "ResearchSubject"
+	return string(ResourceTypeResearchSubject)
 }
 
 // GetId returns the resource's logical ID.
@@ -107,11 +109,16 @@ func (r *ResearchSubject) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r ResearchSubject) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "ResearchSubject"
+	r.ResourceType = string(ResourceTypeResearchSubject)
 	type Alias ResearchSubject
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
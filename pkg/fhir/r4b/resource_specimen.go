@@ -63,11 +63,13 @@ type Specimen struct {
 	Condition []CodeableConcept `json:"condition,omitempty"`
 	// Comments
 	Note []Annotation `json:"note,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *Specimen) GetResourceType() string {
-	return "Specimen"
+	return string(ResourceTypeSpecimen)
 }
 
 // GetId returns the resource's logical ID.
@@ -115,11 +117,16 @@ func (r *Specimen) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r Specimen) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "Specimen"
+	r.ResourceType = string(ResourceTypeSpecimen)
 	type Alias Specimen
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
@@ -47,11 +47,13 @@ type SpecimenDefinition struct {
 	Collection []CodeableConcept `json:"collection,omitempty"`
 	// Specimen in container intended for testing by lab
 	TypeTested []SpecimenDefinitionTypeTested `json:"typeTested,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *SpecimenDefinition) GetResourceType() string {
-	return "SpecimenDefinition"
+	return string(ResourceTypeSpecimenDefinition)
 }
 
 // GetId returns the resource's logical ID.
@@ -99,11 +101,16 @@ func (r *SpecimenDefinition) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r SpecimenDefinition) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "SpecimenDefinition"
+	r.ResourceType = string(ResourceTypeSpecimenDefinition)
 	type Alias SpecimenDefinition
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
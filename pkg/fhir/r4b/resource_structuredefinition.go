@@ -123,11 +123,13 @@ type StructureDefinition struct {
 	Snapshot *StructureDefinitionSnapshot `json:"snapshot,omitempty"`
 	// Differential view of the structure
 	Differential *StructureDefinitionDifferential `json:"differential,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *StructureDefinition) GetResourceType() string {
-	return "StructureDefinition"
+	return string(ResourceTypeStructureDefinition)
 }
 
 // GetId returns the resource's logical ID.
@@ -175,11 +177,31 @@ func (r *StructureDefinition) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// GetUrl returns the resource's canonical URL.
+func (r *StructureDefinition) GetUrl() *string {
+	return r.Url
+}
+
+// GetVersion returns the resource's business version.
+func (r *StructureDefinition) GetVersion() *string {
+	return r.Version
+}
+
+// GetStatus returns the resource's publication status.
+func (r *StructureDefinition) GetStatus() *PublicationStatus {
+	return r.Status
+}
+
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r StructureDefinition) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "StructureDefinition"
+	r.ResourceType = string(ResourceTypeStructureDefinition)
 	type Alias StructureDefinition
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
@@ -57,11 +57,13 @@ type Subscription struct {
 	ErrorExt *Element `json:"_error,omitempty"`
 	// The channel on which to report matches to the criteria
 	Channel *SubscriptionChannel `json:"channel,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *Subscription) GetResourceType() string {
-	return "Subscription"
+	return string(ResourceTypeSubscription)
 }
 
 // GetId returns the resource's logical ID.
@@ -109,11 +111,16 @@ func (r *Subscription) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r Subscription) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "Subscription"
+	r.ResourceType = string(ResourceTypeSubscription)
 	type Alias Subscription
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
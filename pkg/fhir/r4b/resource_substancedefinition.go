@@ -75,11 +75,13 @@ type SubstanceDefinition struct {
 	Relationship []SubstanceDefinitionRelationship `json:"relationship,omitempty"`
 	// Material or taxonomic/anatomical source
 	SourceMaterial *SubstanceDefinitionSourceMaterial `json:"sourceMaterial,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *SubstanceDefinition) GetResourceType() string {
-	return "SubstanceDefinition"
+	return string(ResourceTypeSubstanceDefinition)
 }
 
 // GetId returns the resource's logical ID.
@@ -127,11 +129,16 @@ func (r *SubstanceDefinition) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r SubstanceDefinition) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "SubstanceDefinition"
+	r.ResourceType = string(ResourceTypeSubstanceDefinition)
 	type Alias SubstanceDefinition
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
@@ -77,11 +77,13 @@ type SupplyRequest struct {
 	DeliverFrom *Reference `json:"deliverFrom,omitempty"`
 	// The destination of the supply
 	DeliverTo *Reference `json:"deliverTo,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *SupplyRequest) GetResourceType() string {
-	return "SupplyRequest"
+	return string(ResourceTypeSupplyRequest)
 }
 
 // GetId returns the resource's logical ID.
@@ -129,11 +131,16 @@ func (r *SupplyRequest) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r SupplyRequest) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "SupplyRequest"
+	r.ResourceType = string(ResourceTypeSupplyRequest)
 	type Alias SupplyRequest
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
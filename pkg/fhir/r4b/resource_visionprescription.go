@@ -55,11 +55,13 @@ type VisionPrescription struct {
 	Prescriber Reference `json:"prescriber"`
 	// Vision lens authorization
 	LensSpecification []VisionPrescriptionLensSpecification `json:"lensSpecification,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *VisionPrescription) GetResourceType() string {
-	return "VisionPrescription"
+	return string(ResourceTypeVisionPrescription)
 }
 
 // GetId returns the resource's logical ID.
@@ -107,11 +109,16 @@ func (r *VisionPrescription) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r VisionPrescription) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "VisionPrescription"
+	r.ResourceType = string(ResourceTypeVisionPrescription)
 	type Alias VisionPrescription
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
@@ -0,0 +1,1936 @@
+// Code generated by gofhir. DO NOT EDIT.
+// Source: FHIR StructureDefinitions (resource summaries)
+// Package: r4b
+
+package r4b
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// summaryField is a labelled field shown in a resource's String summary.
+type summaryField struct {
+	label string
+	value interface{}
+}
+
+// formatResourceString formats a one-line resource summary such as
+// `Patient/123 name="John Doe" gender=male`. Empty fields are omitted.
+func formatResourceString(resourceType string, id *string, fields ...summaryField) string {
+	var b strings.Builder
+	b.WriteString(resourceType)
+	if id != nil && *id != "" {
+		b.WriteString("/")
+		b.WriteString(*id)
+	}
+	for _, f := range fields {
+		if s := summaryValue(reflect.ValueOf(f.value)); s != "" {
+			fmt.Fprintf(&b, " %s=%s", f.label, s)
+		}
+	}
+	return b.String()
+}
+
+// formatResourceGoString formats a resource for the %#v verb as its Go type
+// followed by its JSON representation.
+func formatResourceGoString(typeName string, resource interface{}) string {
+	data, err := json.Marshal(resource)
+	if err != nil {
+		return fmt.Sprintf("%s(%v)", typeName, err)
+	}
+	return fmt.Sprintf("%s(%s)", typeName, data)
+}
+
+// summaryValue formats a field value for a resource summary.
+// It returns "" for empty values and types without a summary format.
+func summaryValue(v reflect.Value) string {
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return ""
+		}
+		v = v.Elem()
+	}
+	if !v.IsValid() {
+		return ""
+	}
+
+	switch val := v.Interface().(type) {
+	case HumanName:
+		return quoteSummary(formatHumanNameSummary(val))
+	case CodeableConcept:
+		return formatCodeableConceptSummary(val)
+	case Coding:
+		return formatCodingSummary(val)
+	case Quantity:
+		return formatQuantitySummary(val)
+	case Range:
+		low, high := "", ""
+		if val.Low != nil {
+			low = formatQuantitySummary(*val.Low)
+		}
+		if val.High != nil {
+			high = formatQuantitySummary(*val.High)
+		}
+		if low == "" && high == "" {
+			return ""
+		}
+		return low + ".." + high
+	case Reference:
+		return formatReferenceSummary(val)
+	case Identifier:
+		return quoteSummary(derefSummary(val.Value))
+	case Period:
+		if val.Start == nil && val.End == nil {
+			return ""
+		}
+		return derefSummary(val.Start) + ".." + derefSummary(val.End)
+	case CodeableReference:
+		if val.Concept != nil {
+			if s := formatCodeableConceptSummary(*val.Concept); s != "" {
+				return s
+			}
+		}
+		if val.Reference != nil {
+			return formatReferenceSummary(*val.Reference)
+		}
+		return ""
+	}
+
+	switch v.Kind() {
+	case reflect.Slice:
+		if v.Len() == 0 {
+			return ""
+		}
+		s := summaryValue(v.Index(0))
+		if s != "" && v.Len() > 1 {
+			s += fmt.Sprintf(" (+%d)", v.Len()-1)
+		}
+		return s
+	case reflect.String:
+		return quoteSummary(v.String())
+	case reflect.Bool, reflect.Int, reflect.Int32, reflect.Int64, reflect.Uint, reflect.Uint32, reflect.Uint64, reflect.Float64:
+		return fmt.Sprint(v.Interface())
+	}
+	return ""
+}
+
+// formatHumanNameSummary formats a name as its text or "given family".
+func formatHumanNameSummary(name HumanName) string {
+	if name.Text != nil && *name.Text != "" {
+		return *name.Text
+	}
+	parts := append([]string{}, name.Given...)
+	if name.Family != nil {
+		parts = append(parts, *name.Family)
+	}
+	return strings.Join(parts, " ")
+}
+
+// formatCodeableConceptSummary formats the first coding of a concept, or its text.
+func formatCodeableConceptSummary(cc CodeableConcept) string {
+	for _, coding := range cc.Coding {
+		if s := formatCodingSummary(coding); s != "" {
+			return s
+		}
+	}
+	return quoteSummary(derefSummary(cc.Text))
+}
+
+// formatCodingSummary formats a coding as its code followed by its quoted display.
+func formatCodingSummary(coding Coding) string {
+	code, display := derefSummary(coding.Code), derefSummary(coding.Display)
+	switch {
+	case code != "" && display != "":
+		return code + " " + strconv.Quote(display)
+	case code != "":
+		return quoteSummary(code)
+	default:
+		return quoteSummary(display)
+	}
+}
+
+// formatQuantitySummary formats a quantity as a FHIRPath quantity literal (e.g., 72 'beats/min').
+func formatQuantitySummary(q Quantity) string {
+	if q.Value == nil {
+		return ""
+	}
+	s := strconv.FormatFloat(*q.Value, 'f', -1, 64)
+	unit := derefSummary(q.Code)
+	if unit == "" {
+		unit = derefSummary(q.Unit)
+	}
+	if unit != "" {
+		s += " '" + unit + "'"
+	}
+	return s
+}
+
+// formatReferenceSummary formats a reference as its literal reference, identifier or display.
+func formatReferenceSummary(ref Reference) string {
+	if ref.Reference != nil && *ref.Reference != "" {
+		return quoteSummary(*ref.Reference)
+	}
+	if ref.Identifier != nil && ref.Identifier.Value != nil {
+		return quoteSummary(*ref.Identifier.Value)
+	}
+	return quoteSummary(derefSummary(ref.Display))
+}
+
+// derefSummary returns the value of a string pointer, or "" if it is nil.
+func derefSummary(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// quoteSummary quotes s if it contains spaces, quotes or '=' so that summaries stay unambiguous.
+func quoteSummary(s string) string {
+	if strings.ContainsAny(s, " \t\n\"=") {
+		return strconv.Quote(s)
+	}
+	return s
+}
+
+// String returns a one-line summary of the Account for logs and debugging.
+func (r Account) String() string {
+	return formatResourceString("Account", r.Id,
+		summaryField{"status", r.Status},
+	)
+}
+
+// GoString returns the Account as its Go type and JSON representation for the %#v verb.
+func (r Account) GoString() string {
+	return formatResourceGoString("r4b.Account", r)
+}
+
+// String returns a one-line summary of the ActivityDefinition for logs and debugging.
+func (r ActivityDefinition) String() string {
+	return formatResourceString("ActivityDefinition", r.Id,
+		summaryField{"url", r.Url},
+		summaryField{"status", r.Status},
+		summaryField{"code", r.Code},
+	)
+}
+
+// GoString returns the ActivityDefinition as its Go type and JSON representation for the %#v verb.
+func (r ActivityDefinition) GoString() string {
+	return formatResourceGoString("r4b.ActivityDefinition", r)
+}
+
+// String returns a one-line summary of the AdministrableProductDefinition for logs and debugging.
+func (r AdministrableProductDefinition) String() string {
+	return formatResourceString("AdministrableProductDefinition", r.Id,
+		summaryField{"status", r.Status},
+	)
+}
+
+// GoString returns the AdministrableProductDefinition as its Go type and JSON representation for the %#v verb.
+func (r AdministrableProductDefinition) GoString() string {
+	return formatResourceGoString("r4b.AdministrableProductDefinition", r)
+}
+
+// String returns a one-line summary of the AdverseEvent for logs and debugging.
+func (r AdverseEvent) String() string {
+	return formatResourceString("AdverseEvent", r.Id)
+}
+
+// GoString returns the AdverseEvent as its Go type and JSON representation for the %#v verb.
+func (r AdverseEvent) GoString() string {
+	return formatResourceGoString("r4b.AdverseEvent", r)
+}
+
+// String returns a one-line summary of the AllergyIntolerance for logs and debugging.
+func (r AllergyIntolerance) String() string {
+	return formatResourceString("AllergyIntolerance", r.Id,
+		summaryField{"code", r.Code},
+		summaryField{"criticality", r.Criticality},
+	)
+}
+
+// GoString returns the AllergyIntolerance as its Go type and JSON representation for the %#v verb.
+func (r AllergyIntolerance) GoString() string {
+	return formatResourceGoString("r4b.AllergyIntolerance", r)
+}
+
+// String returns a one-line summary of the Appointment for logs and debugging.
+func (r Appointment) String() string {
+	return formatResourceString("Appointment", r.Id,
+		summaryField{"status", r.Status},
+	)
+}
+
+// GoString returns the Appointment as its Go type and JSON representation for the %#v verb.
+func (r Appointment) GoString() string {
+	return formatResourceGoString("r4b.Appointment", r)
+}
+
+// String returns a one-line summary of the AppointmentResponse for logs and debugging.
+func (r AppointmentResponse) String() string {
+	return formatResourceString("AppointmentResponse", r.Id)
+}
+
+// GoString returns the AppointmentResponse as its Go type and JSON representation for the %#v verb.
+func (r AppointmentResponse) GoString() string {
+	return formatResourceGoString("r4b.AppointmentResponse", r)
+}
+
+// String returns a one-line summary of the AuditEvent for logs and debugging.
+func (r AuditEvent) String() string {
+	return formatResourceString("AuditEvent", r.Id)
+}
+
+// GoString returns the AuditEvent as its Go type and JSON representation for the %#v verb.
+func (r AuditEvent) GoString() string {
+	return formatResourceGoString("r4b.AuditEvent", r)
+}
+
+// String returns a one-line summary of the Basic for logs and debugging.
+func (r Basic) String() string {
+	return formatResourceString("Basic", r.Id,
+		summaryField{"code", r.Code},
+	)
+}
+
+// GoString returns the Basic as its Go type and JSON representation for the %#v verb.
+func (r Basic) GoString() string {
+	return formatResourceGoString("r4b.Basic", r)
+}
+
+// String returns a one-line summary of the Binary for logs and debugging.
+func (r Binary) String() string {
+	return formatResourceString("Binary", r.Id)
+}
+
+// GoString returns the Binary as its Go type and JSON representation for the %#v verb.
+func (r Binary) GoString() string {
+	return formatResourceGoString("r4b.Binary", r)
+}
+
+// String returns a one-line summary of the BiologicallyDerivedProduct for logs and debugging.
+func (r BiologicallyDerivedProduct) String() string {
+	return formatResourceString("BiologicallyDerivedProduct", r.Id,
+		summaryField{"status", r.Status},
+	)
+}
+
+// GoString returns the BiologicallyDerivedProduct as its Go type and JSON representation for the %#v verb.
+func (r BiologicallyDerivedProduct) GoString() string {
+	return formatResourceGoString("r4b.BiologicallyDerivedProduct", r)
+}
+
+// String returns a one-line summary of the BodyStructure for logs and debugging.
+func (r BodyStructure) String() string {
+	return formatResourceString("BodyStructure", r.Id)
+}
+
+// GoString returns the BodyStructure as its Go type and JSON representation for the %#v verb.
+func (r BodyStructure) GoString() string {
+	return formatResourceGoString("r4b.BodyStructure", r)
+}
+
+// String returns a one-line summary of the Bundle for logs and debugging.
+func (r Bundle) String() string {
+	return formatResourceString("Bundle", r.Id,
+		summaryField{"type", r.Type},
+		summaryField{"total", r.Total},
+	)
+}
+
+// GoString returns the Bundle as its Go type and JSON representation for the %#v verb.
+func (r Bundle) GoString() string {
+	return formatResourceGoString("r4b.Bundle", r)
+}
+
+// String returns a one-line summary of the CapabilityStatement for logs and debugging.
+func (r CapabilityStatement) String() string {
+	return formatResourceString("CapabilityStatement", r.Id,
+		summaryField{"url", r.Url},
+		summaryField{"status", r.Status},
+	)
+}
+
+// GoString returns the CapabilityStatement as its Go type and JSON representation for the %#v verb.
+func (r CapabilityStatement) GoString() string {
+	return formatResourceGoString("r4b.CapabilityStatement", r)
+}
+
+// String returns a one-line summary of the CarePlan for logs and debugging.
+func (r CarePlan) String() string {
+	return formatResourceString("CarePlan", r.Id,
+		summaryField{"status", r.Status},
+	)
+}
+
+// GoString returns the CarePlan as its Go type and JSON representation for the %#v verb.
+func (r CarePlan) GoString() string {
+	return formatResourceGoString("r4b.CarePlan", r)
+}
+
+// String returns a one-line summary of the CareTeam for logs and debugging.
+func (r CareTeam) String() string {
+	return formatResourceString("CareTeam", r.Id,
+		summaryField{"status", r.Status},
+	)
+}
+
+// GoString returns the CareTeam as its Go type and JSON representation for the %#v verb.
+func (r CareTeam) GoString() string {
+	return formatResourceGoString("r4b.CareTeam", r)
+}
+
+// String returns a one-line summary of the CatalogEntry for logs and debugging.
+func (r CatalogEntry) String() string {
+	return formatResourceString("CatalogEntry", r.Id,
+		summaryField{"status", r.Status},
+	)
+}
+
+// GoString returns the CatalogEntry as its Go type and JSON representation for the %#v verb.
+func (r CatalogEntry) GoString() string {
+	return formatResourceGoString("r4b.CatalogEntry", r)
+}
+
+// String returns a one-line summary of the ChargeItem for logs and debugging.
+func (r ChargeItem) String() string {
+	return formatResourceString("ChargeItem", r.Id,
+		summaryField{"status", r.Status},
+		summaryField{"code", r.Code},
+	)
+}
+
+// GoString returns the ChargeItem as its Go type and JSON representation for the %#v verb.
+func (r ChargeItem) GoString() string {
+	return formatResourceGoString("r4b.ChargeItem", r)
+}
+
+// String returns a one-line summary of the ChargeItemDefinition for logs and debugging.
+func (r ChargeItemDefinition) String() string {
+	return formatResourceString("ChargeItemDefinition", r.Id,
+		summaryField{"url", r.Url},
+		summaryField{"status", r.Status},
+		summaryField{"code", r.Code},
+	)
+}
+
+// GoString returns the ChargeItemDefinition as its Go type and JSON representation for the %#v verb.
+func (r ChargeItemDefinition) GoString() string {
+	return formatResourceGoString("r4b.ChargeItemDefinition", r)
+}
+
+// String returns a one-line summary of the Citation for logs and debugging.
+func (r Citation) String() string {
+	return formatResourceString("Citation", r.Id,
+		summaryField{"url", r.Url},
+		summaryField{"status", r.Status},
+	)
+}
+
+// GoString returns the Citation as its Go type and JSON representation for the %#v verb.
+func (r Citation) GoString() string {
+	return formatResourceGoString("r4b.Citation", r)
+}
+
+// String returns a one-line summary of the Claim for logs and debugging.
+func (r Claim) String() string {
+	return formatResourceString("Claim", r.Id,
+		summaryField{"status", r.Status},
+	)
+}
+
+// GoString returns the Claim as its Go type and JSON representation for the %#v verb.
+func (r Claim) GoString() string {
+	return formatResourceGoString("r4b.Claim", r)
+}
+
+// String returns a one-line summary of the ClaimResponse for logs and debugging.
+func (r ClaimResponse) String() string {
+	return formatResourceString("ClaimResponse", r.Id,
+		summaryField{"status", r.Status},
+	)
+}
+
+// GoString returns the ClaimResponse as its Go type and JSON representation for the %#v verb.
+func (r ClaimResponse) GoString() string {
+	return formatResourceGoString("r4b.ClaimResponse", r)
+}
+
+// String returns a one-line summary of the ClinicalImpression for logs and debugging.
+func (r ClinicalImpression) String() string {
+	return formatResourceString("ClinicalImpression", r.Id,
+		summaryField{"status", r.Status},
+		summaryField{"code", r.Code},
+	)
+}
+
+// GoString returns the ClinicalImpression as its Go type and JSON representation for the %#v verb.
+func (r ClinicalImpression) GoString() string {
+	return formatResourceGoString("r4b.ClinicalImpression", r)
+}
+
+// String returns a one-line summary of the ClinicalUseDefinition for logs and debugging.
+func (r ClinicalUseDefinition) String() string {
+	return formatResourceString("ClinicalUseDefinition", r.Id,
+		summaryField{"status", r.Status},
+	)
+}
+
+// GoString returns the ClinicalUseDefinition as its Go type and JSON representation for the %#v verb.
+func (r ClinicalUseDefinition) GoString() string {
+	return formatResourceGoString("r4b.ClinicalUseDefinition", r)
+}
+
+// String returns a one-line summary of the CodeSystem for logs and debugging.
+func (r CodeSystem) String() string {
+	return formatResourceString("CodeSystem", r.Id,
+		summaryField{"url", r.Url},
+		summaryField{"status", r.Status},
+	)
+}
+
+// GoString returns the CodeSystem as its Go type and JSON representation for the %#v verb.
+func (r CodeSystem) GoString() string {
+	return formatResourceGoString("r4b.CodeSystem", r)
+}
+
+// String returns a one-line summary of the Communication for logs and debugging.
+func (r Communication) String() string {
+	return formatResourceString("Communication", r.Id,
+		summaryField{"status", r.Status},
+	)
+}
+
+// GoString returns the Communication as its Go type and JSON representation for the %#v verb.
+func (r Communication) GoString() string {
+	return formatResourceGoString("r4b.Communication", r)
+}
+
+// String returns a one-line summary of the CommunicationRequest for logs and debugging.
+func (r CommunicationRequest) String() string {
+	return formatResourceString("CommunicationRequest", r.Id,
+		summaryField{"status", r.Status},
+	)
+}
+
+// GoString returns the CommunicationRequest as its Go type and JSON representation for the %#v verb.
+func (r CommunicationRequest) GoString() string {
+	return formatResourceGoString("r4b.CommunicationRequest", r)
+}
+
+// String returns a one-line summary of the CompartmentDefinition for logs and debugging.
+func (r CompartmentDefinition) String() string {
+	return formatResourceString("CompartmentDefinition", r.Id,
+		summaryField{"url", r.Url},
+		summaryField{"status", r.Status},
+		summaryField{"code", r.Code},
+	)
+}
+
+// GoString returns the CompartmentDefinition as its Go type and JSON representation for the %#v verb.
+func (r CompartmentDefinition) GoString() string {
+	return formatResourceGoString("r4b.CompartmentDefinition", r)
+}
+
+// String returns a one-line summary of the Composition for logs and debugging.
+func (r Composition) String() string {
+	return formatResourceString("Composition", r.Id,
+		summaryField{"status", r.Status},
+	)
+}
+
+// GoString returns the Composition as its Go type and JSON representation for the %#v verb.
+func (r Composition) GoString() string {
+	return formatResourceGoString("r4b.Composition", r)
+}
+
+// String returns a one-line summary of the ConceptMap for logs and debugging.
+func (r ConceptMap) String() string {
+	return formatResourceString("ConceptMap", r.Id,
+		summaryField{"url", r.Url},
+		summaryField{"status", r.Status},
+	)
+}
+
+// GoString returns the ConceptMap as its Go type and JSON representation for the %#v verb.
+func (r ConceptMap) GoString() string {
+	return formatResourceGoString("r4b.ConceptMap", r)
+}
+
+// String returns a one-line summary of the Condition for logs and debugging.
+func (r Condition) String() string {
+	return formatResourceString("Condition", r.Id,
+		summaryField{"code", r.Code},
+		summaryField{"clinicalStatus", r.ClinicalStatus},
+	)
+}
+
+// GoString returns the Condition as its Go type and JSON representation for the %#v verb.
+func (r Condition) GoString() string {
+	return formatResourceGoString("r4b.Condition", r)
+}
+
+// String returns a one-line summary of the Consent for logs and debugging.
+func (r Consent) String() string {
+	return formatResourceString("Consent", r.Id,
+		summaryField{"status", r.Status},
+	)
+}
+
+// GoString returns the Consent as its Go type and JSON representation for the %#v verb.
+func (r Consent) GoString() string {
+	return formatResourceGoString("r4b.Consent", r)
+}
+
+// String returns a one-line summary of the Contract for logs and debugging.
+func (r Contract) String() string {
+	return formatResourceString("Contract", r.Id,
+		summaryField{"url", r.Url},
+		summaryField{"status", r.Status},
+	)
+}
+
+// GoString returns the Contract as its Go type and JSON representation for the %#v verb.
+func (r Contract) GoString() string {
+	return formatResourceGoString("r4b.Contract", r)
+}
+
+// String returns a one-line summary of the Coverage for logs and debugging.
+func (r Coverage) String() string {
+	return formatResourceString("Coverage", r.Id,
+		summaryField{"status", r.Status},
+	)
+}
+
+// GoString returns the Coverage as its Go type and JSON representation for the %#v verb.
+func (r Coverage) GoString() string {
+	return formatResourceGoString("r4b.Coverage", r)
+}
+
+// String returns a one-line summary of the CoverageEligibilityRequest for logs and debugging.
+func (r CoverageEligibilityRequest) String() string {
+	return formatResourceString("CoverageEligibilityRequest", r.Id,
+		summaryField{"status", r.Status},
+	)
+}
+
+// GoString returns the CoverageEligibilityRequest as its Go type and JSON representation for the %#v verb.
+func (r CoverageEligibilityRequest) GoString() string {
+	return formatResourceGoString("r4b.CoverageEligibilityRequest", r)
+}
+
+// String returns a one-line summary of the CoverageEligibilityResponse for logs and debugging.
+func (r CoverageEligibilityResponse) String() string {
+	return formatResourceString("CoverageEligibilityResponse", r.Id,
+		summaryField{"status", r.Status},
+	)
+}
+
+// GoString returns the CoverageEligibilityResponse as its Go type and JSON representation for the %#v verb.
+func (r CoverageEligibilityResponse) GoString() string {
+	return formatResourceGoString("r4b.CoverageEligibilityResponse", r)
+}
+
+// String returns a one-line summary of the DetectedIssue for logs and debugging.
+func (r DetectedIssue) String() string {
+	return formatResourceString("DetectedIssue", r.Id,
+		summaryField{"status", r.Status},
+		summaryField{"code", r.Code},
+	)
+}
+
+// GoString returns the DetectedIssue as its Go type and JSON representation for the %#v verb.
+func (r DetectedIssue) GoString() string {
+	return formatResourceGoString("r4b.DetectedIssue", r)
+}
+
+// String returns a one-line summary of the Device for logs and debugging.
+func (r Device) String() string {
+	return formatResourceString("Device", r.Id,
+		summaryField{"url", r.Url},
+		summaryField{"status", r.Status},
+	)
+}
+
+// GoString returns the Device as its Go type and JSON representation for the %#v verb.
+func (r Device) GoString() string {
+	return formatResourceGoString("r4b.Device", r)
+}
+
+// String returns a one-line summary of the DeviceDefinition for logs and debugging.
+func (r DeviceDefinition) String() string {
+	return formatResourceString("DeviceDefinition", r.Id,
+		summaryField{"url", r.Url},
+	)
+}
+
+// GoString returns the DeviceDefinition as its Go type and JSON representation for the %#v verb.
+func (r DeviceDefinition) GoString() string {
+	return formatResourceGoString("r4b.DeviceDefinition", r)
+}
+
+// String returns a one-line summary of the DeviceMetric for logs and debugging.
+func (r DeviceMetric) String() string {
+	return formatResourceString("DeviceMetric", r.Id)
+}
+
+// GoString returns the DeviceMetric as its Go type and JSON representation for the %#v verb.
+func (r DeviceMetric) GoString() string {
+	return formatResourceGoString("r4b.DeviceMetric", r)
+}
+
+// String returns a one-line summary of the DeviceRequest for logs and debugging.
+func (r DeviceRequest) String() string {
+	return formatResourceString("DeviceRequest", r.Id,
+		summaryField{"status", r.Status},
+		summaryField{"code", r.CodeReference},
+		summaryField{"code", r.CodeCodeableConcept},
+	)
+}
+
+// GoString returns the DeviceRequest as its Go type and JSON representation for the %#v verb.
+func (r DeviceRequest) GoString() string {
+	return formatResourceGoString("r4b.DeviceRequest", r)
+}
+
+// String returns a one-line summary of the DeviceUseStatement for logs and debugging.
+func (r DeviceUseStatement) String() string {
+	return formatResourceString("DeviceUseStatement", r.Id,
+		summaryField{"status", r.Status},
+	)
+}
+
+// GoString returns the DeviceUseStatement as its Go type and JSON representation for the %#v verb.
+func (r DeviceUseStatement) GoString() string {
+	return formatResourceGoString("r4b.DeviceUseStatement", r)
+}
+
+// String returns a one-line summary of the DiagnosticReport for logs and debugging.
+func (r DiagnosticReport) String() string {
+	return formatResourceString("DiagnosticReport", r.Id,
+		summaryField{"status", r.Status},
+		summaryField{"code", r.Code},
+	)
+}
+
+// GoString returns the DiagnosticReport as its Go type and JSON representation for the %#v verb.
+func (r DiagnosticReport) GoString() string {
+	return formatResourceGoString("r4b.DiagnosticReport", r)
+}
+
+// String returns a one-line summary of the DocumentManifest for logs and debugging.
+func (r DocumentManifest) String() string {
+	return formatResourceString("DocumentManifest", r.Id,
+		summaryField{"status", r.Status},
+	)
+}
+
+// GoString returns the DocumentManifest as its Go type and JSON representation for the %#v verb.
+func (r DocumentManifest) GoString() string {
+	return formatResourceGoString("r4b.DocumentManifest", r)
+}
+
+// String returns a one-line summary of the DocumentReference for logs and debugging.
+func (r DocumentReference) String() string {
+	return formatResourceString("DocumentReference", r.Id,
+		summaryField{"status", r.Status},
+	)
+}
+
+// GoString returns the DocumentReference as its Go type and JSON representation for the %#v verb.
+func (r DocumentReference) GoString() string {
+	return formatResourceGoString("r4b.DocumentReference", r)
+}
+
+// String returns a one-line summary of the Encounter for logs and debugging.
+func (r Encounter) String() string {
+	return formatResourceString("Encounter", r.Id,
+		summaryField{"status", r.Status},
+		summaryField{"class", r.Class},
+	)
+}
+
+// GoString returns the Encounter as its Go type and JSON representation for the %#v verb.
+func (r Encounter) GoString() string {
+	return formatResourceGoString("r4b.Encounter", r)
+}
+
+// String returns a one-line summary of the Endpoint for logs and debugging.
+func (r Endpoint) String() string {
+	return formatResourceString("Endpoint", r.Id,
+		summaryField{"status", r.Status},
+	)
+}
+
+// GoString returns the Endpoint as its Go type and JSON representation for the %#v verb.
+func (r Endpoint) GoString() string {
+	return formatResourceGoString("r4b.Endpoint", r)
+}
+
+// String returns a one-line summary of the EnrollmentRequest for logs and debugging.
+func (r EnrollmentRequest) String() string {
+	return formatResourceString("EnrollmentRequest", r.Id,
+		summaryField{"status", r.Status},
+	)
+}
+
+// GoString returns the EnrollmentRequest as its Go type and JSON representation for the %#v verb.
+func (r EnrollmentRequest) GoString() string {
+	return formatResourceGoString("r4b.EnrollmentRequest", r)
+}
+
+// String returns a one-line summary of the EnrollmentResponse for logs and debugging.
+func (r EnrollmentResponse) String() string {
+	return formatResourceString("EnrollmentResponse", r.Id,
+		summaryField{"status", r.Status},
+	)
+}
+
+// GoString returns the EnrollmentResponse as its Go type and JSON representation for the %#v verb.
+func (r EnrollmentResponse) GoString() string {
+	return formatResourceGoString("r4b.EnrollmentResponse", r)
+}
+
+// String returns a one-line summary of the EpisodeOfCare for logs and debugging.
+func (r EpisodeOfCare) String() string {
+	return formatResourceString("EpisodeOfCare", r.Id,
+		summaryField{"status", r.Status},
+	)
+}
+
+// GoString returns the EpisodeOfCare as its Go type and JSON representation for the %#v verb.
+func (r EpisodeOfCare) GoString() string {
+	return formatResourceGoString("r4b.EpisodeOfCare", r)
+}
+
+// String returns a one-line summary of the EventDefinition for logs and debugging.
+func (r EventDefinition) String() string {
+	return formatResourceString("EventDefinition", r.Id,
+		summaryField{"url", r.Url},
+		summaryField{"status", r.Status},
+	)
+}
+
+// GoString returns the EventDefinition as its Go type and JSON representation for the %#v verb.
+func (r EventDefinition) GoString() string {
+	return formatResourceGoString("r4b.EventDefinition", r)
+}
+
+// String returns a one-line summary of the Evidence for logs and debugging.
+func (r Evidence) String() string {
+	return formatResourceString("Evidence", r.Id,
+		summaryField{"url", r.Url},
+		summaryField{"status", r.Status},
+	)
+}
+
+// GoString returns the Evidence as its Go type and JSON representation for the %#v verb.
+func (r Evidence) GoString() string {
+	return formatResourceGoString("r4b.Evidence", r)
+}
+
+// String returns a one-line summary of the EvidenceReport for logs and debugging.
+func (r EvidenceReport) String() string {
+	return formatResourceString("EvidenceReport", r.Id,
+		summaryField{"url", r.Url},
+		summaryField{"status", r.Status},
+	)
+}
+
+// GoString returns the EvidenceReport as its Go type and JSON representation for the %#v verb.
+func (r EvidenceReport) GoString() string {
+	return formatResourceGoString("r4b.EvidenceReport", r)
+}
+
+// String returns a one-line summary of the EvidenceVariable for logs and debugging.
+func (r EvidenceVariable) String() string {
+	return formatResourceString("EvidenceVariable", r.Id,
+		summaryField{"url", r.Url},
+		summaryField{"status", r.Status},
+	)
+}
+
+// GoString returns the EvidenceVariable as its Go type and JSON representation for the %#v verb.
+func (r EvidenceVariable) GoString() string {
+	return formatResourceGoString("r4b.EvidenceVariable", r)
+}
+
+// String returns a one-line summary of the ExampleScenario for logs and debugging.
+func (r ExampleScenario) String() string {
+	return formatResourceString("ExampleScenario", r.Id,
+		summaryField{"url", r.Url},
+		summaryField{"status", r.Status},
+	)
+}
+
+// GoString returns the ExampleScenario as its Go type and JSON representation for the %#v verb.
+func (r ExampleScenario) GoString() string {
+	return formatResourceGoString("r4b.ExampleScenario", r)
+}
+
+// String returns a one-line summary of the ExplanationOfBenefit for logs and debugging.
+func (r ExplanationOfBenefit) String() string {
+	return formatResourceString("ExplanationOfBenefit", r.Id,
+		summaryField{"status", r.Status},
+	)
+}
+
+// GoString returns the ExplanationOfBenefit as its Go type and JSON representation for the %#v verb.
+func (r ExplanationOfBenefit) GoString() string {
+	return formatResourceGoString("r4b.ExplanationOfBenefit", r)
+}
+
+// String returns a one-line summary of the FamilyMemberHistory for logs and debugging.
+func (r FamilyMemberHistory) String() string {
+	return formatResourceString("FamilyMemberHistory", r.Id,
+		summaryField{"status", r.Status},
+	)
+}
+
+// GoString returns the FamilyMemberHistory as its Go type and JSON representation for the %#v verb.
+func (r FamilyMemberHistory) GoString() string {
+	return formatResourceGoString("r4b.FamilyMemberHistory", r)
+}
+
+// String returns a one-line summary of the Flag for logs and debugging.
+func (r Flag) String() string {
+	return formatResourceString("Flag", r.Id,
+		summaryField{"status", r.Status},
+		summaryField{"code", r.Code},
+	)
+}
+
+// GoString returns the Flag as its Go type and JSON representation for the %#v verb.
+func (r Flag) GoString() string {
+	return formatResourceGoString("r4b.Flag", r)
+}
+
+// String returns a one-line summary of the Goal for logs and debugging.
+func (r Goal) String() string {
+	return formatResourceString("Goal", r.Id)
+}
+
+// GoString returns the Goal as its Go type and JSON representation for the %#v verb.
+func (r Goal) GoString() string {
+	return formatResourceGoString("r4b.Goal", r)
+}
+
+// String returns a one-line summary of the GraphDefinition for logs and debugging.
+func (r GraphDefinition) String() string {
+	return formatResourceString("GraphDefinition", r.Id,
+		summaryField{"url", r.Url},
+		summaryField{"status", r.Status},
+	)
+}
+
+// GoString returns the GraphDefinition as its Go type and JSON representation for the %#v verb.
+func (r GraphDefinition) GoString() string {
+	return formatResourceGoString("r4b.GraphDefinition", r)
+}
+
+// String returns a one-line summary of the Group for logs and debugging.
+func (r Group) String() string {
+	return formatResourceString("Group", r.Id,
+		summaryField{"code", r.Code},
+	)
+}
+
+// GoString returns the Group as its Go type and JSON representation for the %#v verb.
+func (r Group) GoString() string {
+	return formatResourceGoString("r4b.Group", r)
+}
+
+// String returns a one-line summary of the GuidanceResponse for logs and debugging.
+func (r GuidanceResponse) String() string {
+	return formatResourceString("GuidanceResponse", r.Id,
+		summaryField{"status", r.Status},
+	)
+}
+
+// GoString returns the GuidanceResponse as its Go type and JSON representation for the %#v verb.
+func (r GuidanceResponse) GoString() string {
+	return formatResourceGoString("r4b.GuidanceResponse", r)
+}
+
+// String returns a one-line summary of the HealthcareService for logs and debugging.
+func (r HealthcareService) String() string {
+	return formatResourceString("HealthcareService", r.Id)
+}
+
+// GoString returns the HealthcareService as its Go type and JSON representation for the %#v verb.
+func (r HealthcareService) GoString() string {
+	return formatResourceGoString("r4b.HealthcareService", r)
+}
+
+// String returns a one-line summary of the ImagingStudy for logs and debugging.
+func (r ImagingStudy) String() string {
+	return formatResourceString("ImagingStudy", r.Id,
+		summaryField{"status", r.Status},
+	)
+}
+
+// GoString returns the ImagingStudy as its Go type and JSON representation for the %#v verb.
+func (r ImagingStudy) GoString() string {
+	return formatResourceGoString("r4b.ImagingStudy", r)
+}
+
+// String returns a one-line summary of the Immunization for logs and debugging.
+func (r Immunization) String() string {
+	return formatResourceString("Immunization", r.Id,
+		summaryField{"status", r.Status},
+		summaryField{"vaccineCode", r.VaccineCode},
+	)
+}
+
+// GoString returns the Immunization as its Go type and JSON representation for the %#v verb.
+func (r Immunization) GoString() string {
+	return formatResourceGoString("r4b.Immunization", r)
+}
+
+// String returns a one-line summary of the ImmunizationEvaluation for logs and debugging.
+func (r ImmunizationEvaluation) String() string {
+	return formatResourceString("ImmunizationEvaluation", r.Id,
+		summaryField{"status", r.Status},
+	)
+}
+
+// GoString returns the ImmunizationEvaluation as its Go type and JSON representation for the %#v verb.
+func (r ImmunizationEvaluation) GoString() string {
+	return formatResourceGoString("r4b.ImmunizationEvaluation", r)
+}
+
+// String returns a one-line summary of the ImmunizationRecommendation for logs and debugging.
+func (r ImmunizationRecommendation) String() string {
+	return formatResourceString("ImmunizationRecommendation", r.Id)
+}
+
+// GoString returns the ImmunizationRecommendation as its Go type and JSON representation for the %#v verb.
+func (r ImmunizationRecommendation) GoString() string {
+	return formatResourceGoString("r4b.ImmunizationRecommendation", r)
+}
+
+// String returns a one-line summary of the ImplementationGuide for logs and debugging.
+func (r ImplementationGuide) String() string {
+	return formatResourceString("ImplementationGuide", r.Id,
+		summaryField{"url", r.Url},
+		summaryField{"status", r.Status},
+	)
+}
+
+// GoString returns the ImplementationGuide as its Go type and JSON representation for the %#v verb.
+func (r ImplementationGuide) GoString() string {
+	return formatResourceGoString("r4b.ImplementationGuide", r)
+}
+
+// String returns a one-line summary of the Ingredient for logs and debugging.
+func (r Ingredient) String() string {
+	return formatResourceString("Ingredient", r.Id,
+		summaryField{"status", r.Status},
+	)
+}
+
+// GoString returns the Ingredient as its Go type and JSON representation for the %#v verb.
+func (r Ingredient) GoString() string {
+	return formatResourceGoString("r4b.Ingredient", r)
+}
+
+// String returns a one-line summary of the InsurancePlan for logs and debugging.
+func (r InsurancePlan) String() string {
+	return formatResourceString("InsurancePlan", r.Id,
+		summaryField{"status", r.Status},
+	)
+}
+
+// GoString returns the InsurancePlan as its Go type and JSON representation for the %#v verb.
+func (r InsurancePlan) GoString() string {
+	return formatResourceGoString("r4b.InsurancePlan", r)
+}
+
+// String returns a one-line summary of the Invoice for logs and debugging.
+func (r Invoice) String() string {
+	return formatResourceString("Invoice", r.Id,
+		summaryField{"status", r.Status},
+	)
+}
+
+// GoString returns the Invoice as its Go type and JSON representation for the %#v verb.
+func (r Invoice) GoString() string {
+	return formatResourceGoString("r4b.Invoice", r)
+}
+
+// String returns a one-line summary of the Library for logs and debugging.
+func (r Library) String() string {
+	return formatResourceString("Library", r.Id,
+		summaryField{"url", r.Url},
+		summaryField{"status", r.Status},
+	)
+}
+
+// GoString returns the Library as its Go type and JSON representation for the %#v verb.
+func (r Library) GoString() string {
+	return formatResourceGoString("r4b.Library", r)
+}
+
+// String returns a one-line summary of the Linkage for logs and debugging.
+func (r Linkage) String() string {
+	return formatResourceString("Linkage", r.Id)
+}
+
+// GoString returns the Linkage as its Go type and JSON representation for the %#v verb.
+func (r Linkage) GoString() string {
+	return formatResourceGoString("r4b.Linkage", r)
+}
+
+// String returns a one-line summary of the List for logs and debugging.
+func (r List) String() string {
+	return formatResourceString("List", r.Id,
+		summaryField{"status", r.Status},
+		summaryField{"code", r.Code},
+	)
+}
+
+// GoString returns the List as its Go type and JSON representation for the %#v verb.
+func (r List) GoString() string {
+	return formatResourceGoString("r4b.List", r)
+}
+
+// String returns a one-line summary of the Location for logs and debugging.
+func (r Location) String() string {
+	return formatResourceString("Location", r.Id,
+		summaryField{"name", r.Name},
+		summaryField{"status", r.Status},
+	)
+}
+
+// GoString returns the Location as its Go type and JSON representation for the %#v verb.
+func (r Location) GoString() string {
+	return formatResourceGoString("r4b.Location", r)
+}
+
+// String returns a one-line summary of the ManufacturedItemDefinition for logs and debugging.
+func (r ManufacturedItemDefinition) String() string {
+	return formatResourceString("ManufacturedItemDefinition", r.Id,
+		summaryField{"status", r.Status},
+	)
+}
+
+// GoString returns the ManufacturedItemDefinition as its Go type and JSON representation for the %#v verb.
+func (r ManufacturedItemDefinition) GoString() string {
+	return formatResourceGoString("r4b.ManufacturedItemDefinition", r)
+}
+
+// String returns a one-line summary of the Measure for logs and debugging.
+func (r Measure) String() string {
+	return formatResourceString("Measure", r.Id,
+		summaryField{"url", r.Url},
+		summaryField{"status", r.Status},
+	)
+}
+
+// GoString returns the Measure as its Go type and JSON representation for the %#v verb.
+func (r Measure) GoString() string {
+	return formatResourceGoString("r4b.Measure", r)
+}
+
+// String returns a one-line summary of the MeasureReport for logs and debugging.
+func (r MeasureReport) String() string {
+	return formatResourceString("MeasureReport", r.Id,
+		summaryField{"status", r.Status},
+	)
+}
+
+// GoString returns the MeasureReport as its Go type and JSON representation for the %#v verb.
+func (r MeasureReport) GoString() string {
+	return formatResourceGoString("r4b.MeasureReport", r)
+}
+
+// String returns a one-line summary of the Media for logs and debugging.
+func (r Media) String() string {
+	return formatResourceString("Media", r.Id,
+		summaryField{"status", r.Status},
+	)
+}
+
+// GoString returns the Media as its Go type and JSON representation for the %#v verb.
+func (r Media) GoString() string {
+	return formatResourceGoString("r4b.Media", r)
+}
+
+// String returns a one-line summary of the Medication for logs and debugging.
+func (r Medication) String() string {
+	return formatResourceString("Medication", r.Id,
+		summaryField{"status", r.Status},
+		summaryField{"code", r.Code},
+	)
+}
+
+// GoString returns the Medication as its Go type and JSON representation for the %#v verb.
+func (r Medication) GoString() string {
+	return formatResourceGoString("r4b.Medication", r)
+}
+
+// String returns a one-line summary of the MedicationAdministration for logs and debugging.
+func (r MedicationAdministration) String() string {
+	return formatResourceString("MedicationAdministration", r.Id,
+		summaryField{"status", r.Status},
+	)
+}
+
+// GoString returns the MedicationAdministration as its Go type and JSON representation for the %#v verb.
+func (r MedicationAdministration) GoString() string {
+	return formatResourceGoString("r4b.MedicationAdministration", r)
+}
+
+// String returns a one-line summary of the MedicationDispense for logs and debugging.
+func (r MedicationDispense) String() string {
+	return formatResourceString("MedicationDispense", r.Id,
+		summaryField{"status", r.Status},
+	)
+}
+
+// GoString returns the MedicationDispense as its Go type and JSON representation for the %#v verb.
+func (r MedicationDispense) GoString() string {
+	return formatResourceGoString("r4b.MedicationDispense", r)
+}
+
+// String returns a one-line summary of the MedicationKnowledge for logs and debugging.
+func (r MedicationKnowledge) String() string {
+	return formatResourceString("MedicationKnowledge", r.Id,
+		summaryField{"status", r.Status},
+		summaryField{"code", r.Code},
+	)
+}
+
+// GoString returns the MedicationKnowledge as its Go type and JSON representation for the %#v verb.
+func (r MedicationKnowledge) GoString() string {
+	return formatResourceGoString("r4b.MedicationKnowledge", r)
+}
+
+// String returns a one-line summary of the MedicationRequest for logs and debugging.
+func (r MedicationRequest) String() string {
+	return formatResourceString("MedicationRequest", r.Id,
+		summaryField{"status", r.Status},
+		summaryField{"medication", r.MedicationCodeableConcept},
+		summaryField{"medication", r.MedicationReference},
+	)
+}
+
+// GoString returns the MedicationRequest as its Go type and JSON representation for the %#v verb.
+func (r MedicationRequest) GoString() string {
+	return formatResourceGoString("r4b.MedicationRequest", r)
+}
+
+// String returns a one-line summary of the MedicationStatement for logs and debugging.
+func (r MedicationStatement) String() string {
+	return formatResourceString("MedicationStatement", r.Id,
+		summaryField{"status", r.Status},
+		summaryField{"medication", r.MedicationCodeableConcept},
+		summaryField{"medication", r.MedicationReference},
+	)
+}
+
+// GoString returns the MedicationStatement as its Go type and JSON representation for the %#v verb.
+func (r MedicationStatement) GoString() string {
+	return formatResourceGoString("r4b.MedicationStatement", r)
+}
+
+// String returns a one-line summary of the MedicinalProductDefinition for logs and debugging.
+func (r MedicinalProductDefinition) String() string {
+	return formatResourceString("MedicinalProductDefinition", r.Id,
+		summaryField{"status", r.Status},
+		summaryField{"code", r.Code},
+	)
+}
+
+// GoString returns the MedicinalProductDefinition as its Go type and JSON representation for the %#v verb.
+func (r MedicinalProductDefinition) GoString() string {
+	return formatResourceGoString("r4b.MedicinalProductDefinition", r)
+}
+
+// String returns a one-line summary of the MessageDefinition for logs and debugging.
+func (r MessageDefinition) String() string {
+	return formatResourceString("MessageDefinition", r.Id,
+		summaryField{"url", r.Url},
+		summaryField{"status", r.Status},
+	)
+}
+
+// GoString returns the MessageDefinition as its Go type and JSON representation for the %#v verb.
+func (r MessageDefinition) GoString() string {
+	return formatResourceGoString("r4b.MessageDefinition", r)
+}
+
+// String returns a one-line summary of the MessageHeader for logs and debugging.
+func (r MessageHeader) String() string {
+	return formatResourceString("MessageHeader", r.Id)
+}
+
+// GoString returns the MessageHeader as its Go type and JSON representation for the %#v verb.
+func (r MessageHeader) GoString() string {
+	return formatResourceGoString("r4b.MessageHeader", r)
+}
+
+// String returns a one-line summary of the MolecularSequence for logs and debugging.
+func (r MolecularSequence) String() string {
+	return formatResourceString("MolecularSequence", r.Id)
+}
+
+// GoString returns the MolecularSequence as its Go type and JSON representation for the %#v verb.
+func (r MolecularSequence) GoString() string {
+	return formatResourceGoString("r4b.MolecularSequence", r)
+}
+
+// String returns a one-line summary of the NamingSystem for logs and debugging.
+func (r NamingSystem) String() string {
+	return formatResourceString("NamingSystem", r.Id,
+		summaryField{"status", r.Status},
+	)
+}
+
+// GoString returns the NamingSystem as its Go type and JSON representation for the %#v verb.
+func (r NamingSystem) GoString() string {
+	return formatResourceGoString("r4b.NamingSystem", r)
+}
+
+// String returns a one-line summary of the NutritionOrder for logs and debugging.
+func (r NutritionOrder) String() string {
+	return formatResourceString("NutritionOrder", r.Id,
+		summaryField{"status", r.Status},
+	)
+}
+
+// GoString returns the NutritionOrder as its Go type and JSON representation for the %#v verb.
+func (r NutritionOrder) GoString() string {
+	return formatResourceGoString("r4b.NutritionOrder", r)
+}
+
+// String returns a one-line summary of the NutritionProduct for logs and debugging.
+func (r NutritionProduct) String() string {
+	return formatResourceString("NutritionProduct", r.Id,
+		summaryField{"status", r.Status},
+		summaryField{"code", r.Code},
+	)
+}
+
+// GoString returns the NutritionProduct as its Go type and JSON representation for the %#v verb.
+func (r NutritionProduct) GoString() string {
+	return formatResourceGoString("r4b.NutritionProduct", r)
+}
+
+// String returns a one-line summary of the Observation for logs and debugging.
+func (r Observation) String() string {
+	return formatResourceString("Observation", r.Id,
+		summaryField{"status", r.Status},
+		summaryField{"code", r.Code},
+		summaryField{"value", r.ValueQuantity},
+		summaryField{"value", r.ValueCodeableConcept},
+		summaryField{"value", r.ValueString},
+		summaryField{"value", r.ValueBoolean},
+		summaryField{"value", r.ValueInteger},
+		summaryField{"value", r.ValueRange},
+		summaryField{"value", r.ValueRatio},
+		summaryField{"value", r.ValueSampledData},
+		summaryField{"value", r.ValueTime},
+		summaryField{"value", r.ValueDateTime},
+		summaryField{"value", r.ValuePeriod},
+	)
+}
+
+// GoString returns the Observation as its Go type and JSON representation for the %#v verb.
+func (r Observation) GoString() string {
+	return formatResourceGoString("r4b.Observation", r)
+}
+
+// String returns a one-line summary of the ObservationDefinition for logs and debugging.
+func (r ObservationDefinition) String() string {
+	return formatResourceString("ObservationDefinition", r.Id,
+		summaryField{"code", r.Code},
+	)
+}
+
+// GoString returns the ObservationDefinition as its Go type and JSON representation for the %#v verb.
+func (r ObservationDefinition) GoString() string {
+	return formatResourceGoString("r4b.ObservationDefinition", r)
+}
+
+// String returns a one-line summary of the OperationDefinition for logs and debugging.
+func (r OperationDefinition) String() string {
+	return formatResourceString("OperationDefinition", r.Id,
+		summaryField{"url", r.Url},
+		summaryField{"status", r.Status},
+		summaryField{"code", r.Code},
+	)
+}
+
+// GoString returns the OperationDefinition as its Go type and JSON representation for the %#v verb.
+func (r OperationDefinition) GoString() string {
+	return formatResourceGoString("r4b.OperationDefinition", r)
+}
+
+// String returns a one-line summary of the OperationOutcome for logs and debugging.
+func (r OperationOutcome) String() string {
+	return formatResourceString("OperationOutcome", r.Id)
+}
+
+// GoString returns the OperationOutcome as its Go type and JSON representation for the %#v verb.
+func (r OperationOutcome) GoString() string {
+	return formatResourceGoString("r4b.OperationOutcome", r)
+}
+
+// String returns a one-line summary of the Organization for logs and debugging.
+func (r Organization) String() string {
+	return formatResourceString("Organization", r.Id,
+		summaryField{"name", r.Name},
+	)
+}
+
+// GoString returns the Organization as its Go type and JSON representation for the %#v verb.
+func (r Organization) GoString() string {
+	return formatResourceGoString("r4b.Organization", r)
+}
+
+// String returns a one-line summary of the OrganizationAffiliation for logs and debugging.
+func (r OrganizationAffiliation) String() string {
+	return formatResourceString("OrganizationAffiliation", r.Id,
+		summaryField{"code", r.Code},
+	)
+}
+
+// GoString returns the OrganizationAffiliation as its Go type and JSON representation for the %#v verb.
+func (r OrganizationAffiliation) GoString() string {
+	return formatResourceGoString("r4b.OrganizationAffiliation", r)
+}
+
+// String returns a one-line summary of the PackagedProductDefinition for logs and debugging.
+func (r PackagedProductDefinition) String() string {
+	return formatResourceString("PackagedProductDefinition", r.Id,
+		summaryField{"status", r.Status},
+	)
+}
+
+// GoString returns the PackagedProductDefinition as its Go type and JSON representation for the %#v verb.
+func (r PackagedProductDefinition) GoString() string {
+	return formatResourceGoString("r4b.PackagedProductDefinition", r)
+}
+
+// String returns a one-line summary of the Parameters for logs and debugging.
+func (r Parameters) String() string {
+	return formatResourceString("Parameters", r.Id)
+}
+
+// GoString returns the Parameters as its Go type and JSON representation for the %#v verb.
+func (r Parameters) GoString() string {
+	return formatResourceGoString("r4b.Parameters", r)
+}
+
+// String returns a one-line summary of the Patient for logs and debugging.
+func (r Patient) String() string {
+	return formatResourceString("Patient", r.Id,
+		summaryField{"name", r.Name},
+		summaryField{"gender", r.Gender},
+		summaryField{"birthDate", r.BirthDate},
+	)
+}
+
+// GoString returns the Patient as its Go type and JSON representation for the %#v verb.
+func (r Patient) GoString() string {
+	return formatResourceGoString("r4b.Patient", r)
+}
+
+// String returns a one-line summary of the PaymentNotice for logs and debugging.
+func (r PaymentNotice) String() string {
+	return formatResourceString("PaymentNotice", r.Id,
+		summaryField{"status", r.Status},
+	)
+}
+
+// GoString returns the PaymentNotice as its Go type and JSON representation for the %#v verb.
+func (r PaymentNotice) GoString() string {
+	return formatResourceGoString("r4b.PaymentNotice", r)
+}
+
+// String returns a one-line summary of the PaymentReconciliation for logs and debugging.
+func (r PaymentReconciliation) String() string {
+	return formatResourceString("PaymentReconciliation", r.Id,
+		summaryField{"status", r.Status},
+	)
+}
+
+// GoString returns the PaymentReconciliation as its Go type and JSON representation for the %#v verb.
+func (r PaymentReconciliation) GoString() string {
+	return formatResourceGoString("r4b.PaymentReconciliation", r)
+}
+
+// String returns a one-line summary of the Person for logs and debugging.
+func (r Person) String() string {
+	return formatResourceString("Person", r.Id,
+		summaryField{"name", r.Name},
+	)
+}
+
+// GoString returns the Person as its Go type and JSON representation for the %#v verb.
+func (r Person) GoString() string {
+	return formatResourceGoString("r4b.Person", r)
+}
+
+// String returns a one-line summary of the PlanDefinition for logs and debugging.
+func (r PlanDefinition) String() string {
+	return formatResourceString("PlanDefinition", r.Id,
+		summaryField{"url", r.Url},
+		summaryField{"status", r.Status},
+	)
+}
+
+// GoString returns the PlanDefinition as its Go type and JSON representation for the %#v verb.
+func (r PlanDefinition) GoString() string {
+	return formatResourceGoString("r4b.PlanDefinition", r)
+}
+
+// String returns a one-line summary of the Practitioner for logs and debugging.
+func (r Practitioner) String() string {
+	return formatResourceString("Practitioner", r.Id,
+		summaryField{"name", r.Name},
+	)
+}
+
+// GoString returns the Practitioner as its Go type and JSON representation for the %#v verb.
+func (r Practitioner) GoString() string {
+	return formatResourceGoString("r4b.Practitioner", r)
+}
+
+// String returns a one-line summary of the PractitionerRole for logs and debugging.
+func (r PractitionerRole) String() string {
+	return formatResourceString("PractitionerRole", r.Id,
+		summaryField{"code", r.Code},
+	)
+}
+
+// GoString returns the PractitionerRole as its Go type and JSON representation for the %#v verb.
+func (r PractitionerRole) GoString() string {
+	return formatResourceGoString("r4b.PractitionerRole", r)
+}
+
+// String returns a one-line summary of the Procedure for logs and debugging.
+func (r Procedure) String() string {
+	return formatResourceString("Procedure", r.Id,
+		summaryField{"status", r.Status},
+		summaryField{"code", r.Code},
+	)
+}
+
+// GoString returns the Procedure as its Go type and JSON representation for the %#v verb.
+func (r Procedure) GoString() string {
+	return formatResourceGoString("r4b.Procedure", r)
+}
+
+// String returns a one-line summary of the Provenance for logs and debugging.
+func (r Provenance) String() string {
+	return formatResourceString("Provenance", r.Id)
+}
+
+// GoString returns the Provenance as its Go type and JSON representation for the %#v verb.
+func (r Provenance) GoString() string {
+	return formatResourceGoString("r4b.Provenance", r)
+}
+
+// String returns a one-line summary of the Questionnaire for logs and debugging.
+func (r Questionnaire) String() string {
+	return formatResourceString("Questionnaire", r.Id,
+		summaryField{"url", r.Url},
+		summaryField{"status", r.Status},
+		summaryField{"code", r.Code},
+	)
+}
+
+// GoString returns the Questionnaire as its Go type and JSON representation for the %#v verb.
+func (r Questionnaire) GoString() string {
+	return formatResourceGoString("r4b.Questionnaire", r)
+}
+
+// String returns a one-line summary of the QuestionnaireResponse for logs and debugging.
+func (r QuestionnaireResponse) String() string {
+	return formatResourceString("QuestionnaireResponse", r.Id,
+		summaryField{"status", r.Status},
+	)
+}
+
+// GoString returns the QuestionnaireResponse as its Go type and JSON representation for the %#v verb.
+func (r QuestionnaireResponse) GoString() string {
+	return formatResourceGoString("r4b.QuestionnaireResponse", r)
+}
+
+// String returns a one-line summary of the RegulatedAuthorization for logs and debugging.
+func (r RegulatedAuthorization) String() string {
+	return formatResourceString("RegulatedAuthorization", r.Id,
+		summaryField{"status", r.Status},
+	)
+}
+
+// GoString returns the RegulatedAuthorization as its Go type and JSON representation for the %#v verb.
+func (r RegulatedAuthorization) GoString() string {
+	return formatResourceGoString("r4b.RegulatedAuthorization", r)
+}
+
+// String returns a one-line summary of the RelatedPerson for logs and debugging.
+func (r RelatedPerson) String() string {
+	return formatResourceString("RelatedPerson", r.Id,
+		summaryField{"name", r.Name},
+		summaryField{"relationship", r.Relationship},
+	)
+}
+
+// GoString returns the RelatedPerson as its Go type and JSON representation for the %#v verb.
+func (r RelatedPerson) GoString() string {
+	return formatResourceGoString("r4b.RelatedPerson", r)
+}
+
+// String returns a one-line summary of the RequestGroup for logs and debugging.
+func (r RequestGroup) String() string {
+	return formatResourceString("RequestGroup", r.Id,
+		summaryField{"status", r.Status},
+		summaryField{"code", r.Code},
+	)
+}
+
+// GoString returns the RequestGroup as its Go type and JSON representation for the %#v verb.
+func (r RequestGroup) GoString() string {
+	return formatResourceGoString("r4b.RequestGroup", r)
+}
+
+// String returns a one-line summary of the ResearchDefinition for logs and debugging.
+func (r ResearchDefinition) String() string {
+	return formatResourceString("ResearchDefinition", r.Id,
+		summaryField{"url", r.Url},
+		summaryField{"status", r.Status},
+	)
+}
+
+// GoString returns the ResearchDefinition as its Go type and JSON representation for the %#v verb.
+func (r ResearchDefinition) GoString() string {
+	return formatResourceGoString("r4b.ResearchDefinition", r)
+}
+
+// String returns a one-line summary of the ResearchElementDefinition for logs and debugging.
+func (r ResearchElementDefinition) String() string {
+	return formatResourceString("ResearchElementDefinition", r.Id,
+		summaryField{"url", r.Url},
+		summaryField{"status", r.Status},
+	)
+}
+
+// GoString returns the ResearchElementDefinition as its Go type and JSON representation for the %#v verb.
+func (r ResearchElementDefinition) GoString() string {
+	return formatResourceGoString("r4b.ResearchElementDefinition", r)
+}
+
+// String returns a one-line summary of the ResearchStudy for logs and debugging.
+func (r ResearchStudy) String() string {
+	return formatResourceString("ResearchStudy", r.Id,
+		summaryField{"status", r.Status},
+	)
+}
+
+// GoString returns the ResearchStudy as its Go type and JSON representation for the %#v verb.
+func (r ResearchStudy) GoString() string {
+	return formatResourceGoString("r4b.ResearchStudy", r)
+}
+
+// String returns a one-line summary of the ResearchSubject for logs and debugging.
+func (r ResearchSubject) String() string {
+	return formatResourceString("ResearchSubject", r.Id,
+		summaryField{"status", r.Status},
+	)
+}
+
+// GoString returns the ResearchSubject as its Go type and JSON representation for the %#v verb.
+func (r ResearchSubject) GoString() string {
+	return formatResourceGoString("r4b.ResearchSubject", r)
+}
+
+// String returns a one-line summary of the RiskAssessment for logs and debugging.
+func (r RiskAssessment) String() string {
+	return formatResourceString("RiskAssessment", r.Id,
+		summaryField{"status", r.Status},
+		summaryField{"code", r.Code},
+	)
+}
+
+// GoString returns the RiskAssessment as its Go type and JSON representation for the %#v verb.
+func (r RiskAssessment) GoString() string {
+	return formatResourceGoString("r4b.RiskAssessment", r)
+}
+
+// String returns a one-line summary of the Schedule for logs and debugging.
+func (r Schedule) String() string {
+	return formatResourceString("Schedule", r.Id)
+}
+
+// GoString returns the Schedule as its Go type and JSON representation for the %#v verb.
+func (r Schedule) GoString() string {
+	return formatResourceGoString("r4b.Schedule", r)
+}
+
+// String returns a one-line summary of the SearchParameter for logs and debugging.
+func (r SearchParameter) String() string {
+	return formatResourceString("SearchParameter", r.Id,
+		summaryField{"url", r.Url},
+		summaryField{"status", r.Status},
+		summaryField{"code", r.Code},
+	)
+}
+
+// GoString returns the SearchParameter as its Go type and JSON representation for the %#v verb.
+func (r SearchParameter) GoString() string {
+	return formatResourceGoString("r4b.SearchParameter", r)
+}
+
+// String returns a one-line summary of the ServiceRequest for logs and debugging.
+func (r ServiceRequest) String() string {
+	return formatResourceString("ServiceRequest", r.Id,
+		summaryField{"status", r.Status},
+		summaryField{"code", r.Code},
+	)
+}
+
+// GoString returns the ServiceRequest as its Go type and JSON representation for the %#v verb.
+func (r ServiceRequest) GoString() string {
+	return formatResourceGoString("r4b.ServiceRequest", r)
+}
+
+// String returns a one-line summary of the Slot for logs and debugging.
+func (r Slot) String() string {
+	return formatResourceString("Slot", r.Id,
+		summaryField{"status", r.Status},
+	)
+}
+
+// GoString returns the Slot as its Go type and JSON representation for the %#v verb.
+func (r Slot) GoString() string {
+	return formatResourceGoString("r4b.Slot", r)
+}
+
+// String returns a one-line summary of the Specimen for logs and debugging.
+func (r Specimen) String() string {
+	return formatResourceString("Specimen", r.Id,
+		summaryField{"status", r.Status},
+	)
+}
+
+// GoString returns the Specimen as its Go type and JSON representation for the %#v verb.
+func (r Specimen) GoString() string {
+	return formatResourceGoString("r4b.Specimen", r)
+}
+
+// String returns a one-line summary of the SpecimenDefinition for logs and debugging.
+func (r SpecimenDefinition) String() string {
+	return formatResourceString("SpecimenDefinition", r.Id)
+}
+
+// GoString returns the SpecimenDefinition as its Go type and JSON representation for the %#v verb.
+func (r SpecimenDefinition) GoString() string {
+	return formatResourceGoString("r4b.SpecimenDefinition", r)
+}
+
+// String returns a one-line summary of the StructureDefinition for logs and debugging.
+func (r StructureDefinition) String() string {
+	return formatResourceString("StructureDefinition", r.Id,
+		summaryField{"url", r.Url},
+		summaryField{"status", r.Status},
+	)
+}
+
+// GoString returns the StructureDefinition as its Go type and JSON representation for the %#v verb.
+func (r StructureDefinition) GoString() string {
+	return formatResourceGoString("r4b.StructureDefinition", r)
+}
+
+// String returns a one-line summary of the StructureMap for logs and debugging.
+func (r StructureMap) String() string {
+	return formatResourceString("StructureMap", r.Id,
+		summaryField{"url", r.Url},
+		summaryField{"status", r.Status},
+	)
+}
+
+// GoString returns the StructureMap as its Go type and JSON representation for the %#v verb.
+func (r StructureMap) GoString() string {
+	return formatResourceGoString("r4b.StructureMap", r)
+}
+
+// String returns a one-line summary of the Subscription for logs and debugging.
+func (r Subscription) String() string {
+	return formatResourceString("Subscription", r.Id,
+		summaryField{"status", r.Status},
+	)
+}
+
+// GoString returns the Subscription as its Go type and JSON representation for the %#v verb.
+func (r Subscription) GoString() string {
+	return formatResourceGoString("r4b.Subscription", r)
+}
+
+// String returns a one-line summary of the SubscriptionStatus for logs and debugging.
+func (r SubscriptionStatus) String() string {
+	return formatResourceString("SubscriptionStatus", r.Id,
+		summaryField{"status", r.Status},
+	)
+}
+
+// GoString returns the SubscriptionStatus as its Go type and JSON representation for the %#v verb.
+func (r SubscriptionStatus) GoString() string {
+	return formatResourceGoString("r4b.SubscriptionStatus", r)
+}
+
+// String returns a one-line summary of the SubscriptionTopic for logs and debugging.
+func (r SubscriptionTopic) String() string {
+	return formatResourceString("SubscriptionTopic", r.Id,
+		summaryField{"url", r.Url},
+		summaryField{"status", r.Status},
+	)
+}
+
+// GoString returns the SubscriptionTopic as its Go type and JSON representation for the %#v verb.
+func (r SubscriptionTopic) GoString() string {
+	return formatResourceGoString("r4b.SubscriptionTopic", r)
+}
+
+// String returns a one-line summary of the Substance for logs and debugging.
+func (r Substance) String() string {
+	return formatResourceString("Substance", r.Id,
+		summaryField{"status", r.Status},
+		summaryField{"code", r.Code},
+	)
+}
+
+// GoString returns the Substance as its Go type and JSON representation for the %#v verb.
+func (r Substance) GoString() string {
+	return formatResourceGoString("r4b.Substance", r)
+}
+
+// String returns a one-line summary of the SubstanceDefinition for logs and debugging.
+func (r SubstanceDefinition) String() string {
+	return formatResourceString("SubstanceDefinition", r.Id,
+		summaryField{"status", r.Status},
+		summaryField{"code", r.Code},
+	)
+}
+
+// GoString returns the SubstanceDefinition as its Go type and JSON representation for the %#v verb.
+func (r SubstanceDefinition) GoString() string {
+	return formatResourceGoString("r4b.SubstanceDefinition", r)
+}
+
+// String returns a one-line summary of the SupplyDelivery for logs and debugging.
+func (r SupplyDelivery) String() string {
+	return formatResourceString("SupplyDelivery", r.Id,
+		summaryField{"status", r.Status},
+	)
+}
+
+// GoString returns the SupplyDelivery as its Go type and JSON representation for the %#v verb.
+func (r SupplyDelivery) GoString() string {
+	return formatResourceGoString("r4b.SupplyDelivery", r)
+}
+
+// String returns a one-line summary of the SupplyRequest for logs and debugging.
+func (r SupplyRequest) String() string {
+	return formatResourceString("SupplyRequest", r.Id,
+		summaryField{"status", r.Status},
+	)
+}
+
+// GoString returns the SupplyRequest as its Go type and JSON representation for the %#v verb.
+func (r SupplyRequest) GoString() string {
+	return formatResourceGoString("r4b.SupplyRequest", r)
+}
+
+// String returns a one-line summary of the Task for logs and debugging.
+func (r Task) String() string {
+	return formatResourceString("Task", r.Id,
+		summaryField{"status", r.Status},
+		summaryField{"code", r.Code},
+	)
+}
+
+// GoString returns the Task as its Go type and JSON representation for the %#v verb.
+func (r Task) GoString() string {
+	return formatResourceGoString("r4b.Task", r)
+}
+
+// String returns a one-line summary of the TerminologyCapabilities for logs and debugging.
+func (r TerminologyCapabilities) String() string {
+	return formatResourceString("TerminologyCapabilities", r.Id,
+		summaryField{"url", r.Url},
+		summaryField{"status", r.Status},
+	)
+}
+
+// GoString returns the TerminologyCapabilities as its Go type and JSON representation for the %#v verb.
+func (r TerminologyCapabilities) GoString() string {
+	return formatResourceGoString("r4b.TerminologyCapabilities", r)
+}
+
+// String returns a one-line summary of the TestReport for logs and debugging.
+func (r TestReport) String() string {
+	return formatResourceString("TestReport", r.Id,
+		summaryField{"status", r.Status},
+	)
+}
+
+// GoString returns the TestReport as its Go type and JSON representation for the %#v verb.
+func (r TestReport) GoString() string {
+	return formatResourceGoString("r4b.TestReport", r)
+}
+
+// String returns a one-line summary of the TestScript for logs and debugging.
+func (r TestScript) String() string {
+	return formatResourceString("TestScript", r.Id,
+		summaryField{"url", r.Url},
+		summaryField{"status", r.Status},
+	)
+}
+
+// GoString returns the TestScript as its Go type and JSON representation for the %#v verb.
+func (r TestScript) GoString() string {
+	return formatResourceGoString("r4b.TestScript", r)
+}
+
+// String returns a one-line summary of the ValueSet for logs and debugging.
+func (r ValueSet) String() string {
+	return formatResourceString("ValueSet", r.Id,
+		summaryField{"url", r.Url},
+		summaryField{"status", r.Status},
+	)
+}
+
+// GoString returns the ValueSet as its Go type and JSON representation for the %#v verb.
+func (r ValueSet) GoString() string {
+	return formatResourceGoString("r4b.ValueSet", r)
+}
+
+// String returns a one-line summary of the VerificationResult for logs and debugging.
+func (r VerificationResult) String() string {
+	return formatResourceString("VerificationResult", r.Id,
+		summaryField{"status", r.Status},
+	)
+}
+
+// GoString returns the VerificationResult as its Go type and JSON representation for the %#v verb.
+func (r VerificationResult) GoString() string {
+	return formatResourceGoString("r4b.VerificationResult", r)
+}
+
+// String returns a one-line summary of the VisionPrescription for logs and debugging.
+func (r VisionPrescription) String() string {
+	return formatResourceString("VisionPrescription", r.Id,
+		summaryField{"status", r.Status},
+	)
+}
+
+// GoString returns the VisionPrescription as its Go type and JSON representation for the %#v verb.
+func (r VisionPrescription) GoString() string {
+	return formatResourceGoString("r4b.VisionPrescription", r)
+}
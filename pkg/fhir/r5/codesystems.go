@@ -4,6 +4,26 @@
 
 package r5
 
+import (
+	"fmt"
+	"strings"
+)
+
+// parseCode returns the code of displays equal to s or, failing that, the code
+// whose value or display name matches s ignoring case.
+func parseCode[T ~string](s string, displays map[T]string) (T, error) {
+	if _, ok := displays[T(s)]; ok {
+		return T(s), nil
+	}
+	for code, display := range displays {
+		if strings.EqualFold(string(code), s) || (display != "" && strings.EqualFold(display, s)) {
+			return code, nil
+		}
+	}
+	var zero T
+	return zero, fmt.Errorf("unknown %T code: %q", zero, s)
+}
+
 // FHIRVersion represents FHIRVersion.
 type FHIRVersion string
 
@@ -125,6 +145,92 @@ const (
 	FHIRVersion500DraftFinal FHIRVersion = "5.0.0-draft-final"
 )
 
+// fHIRVersionDisplays maps each FHIRVersion code to its display name.
+var fHIRVersionDisplays = map[FHIRVersion]string{
+	FHIRVersion001:           "0.01",
+	FHIRVersion005:           "0.05",
+	FHIRVersion006:           "0.06",
+	FHIRVersion011:           "0.11",
+	FHIRVersion00:            "0.0",
+	FHIRVersion0080:          "0.0.80",
+	FHIRVersion0081:          "0.0.81",
+	FHIRVersion0082:          "0.0.82",
+	FHIRVersion04:            "0.4",
+	FHIRVersion040:           "0.4.0",
+	FHIRVersion05:            "0.5",
+	FHIRVersion050:           "0.5.0",
+	FHIRVersion10:            "1.0",
+	FHIRVersion100:           "1.0.0",
+	FHIRVersion101:           "1.0.1",
+	FHIRVersion102:           "1.0.2",
+	FHIRVersion11:            "1.1",
+	FHIRVersion110:           "1.1.0",
+	FHIRVersion14:            "1.4",
+	FHIRVersion140:           "1.4.0",
+	FHIRVersion16:            "1.6",
+	FHIRVersion160:           "1.6.0",
+	FHIRVersion18:            "1.8",
+	FHIRVersion180:           "1.8.0",
+	FHIRVersion30:            "3.0",
+	FHIRVersion300:           "3.0.0",
+	FHIRVersion301:           "3.0.1",
+	FHIRVersion302:           "3.0.2",
+	FHIRVersion33:            "3.3",
+	FHIRVersion330:           "3.3.0",
+	FHIRVersion35:            "3.5",
+	FHIRVersion350:           "3.5.0",
+	FHIRVersion40:            "4.0",
+	FHIRVersion400:           "4.0.0",
+	FHIRVersion401:           "4.0.1",
+	FHIRVersion41:            "4.1",
+	FHIRVersion410:           "4.1.0",
+	FHIRVersion42:            "4.2",
+	FHIRVersion420:           "4.2.0",
+	FHIRVersion43:            "4.3",
+	FHIRVersion430:           "4.3.0",
+	FHIRVersion430Cibuild:    "4.3.0-cibuild",
+	FHIRVersion430Snapshot1:  "4.3.0-snapshot1",
+	FHIRVersion44:            "4.4",
+	FHIRVersion440:           "4.4.0",
+	FHIRVersion45:            "4.5",
+	FHIRVersion450:           "4.5.0",
+	FHIRVersion46:            "4.6",
+	FHIRVersion460:           "4.6.0",
+	FHIRVersion50:            "5.0",
+	FHIRVersion500:           "5.0.0",
+	FHIRVersion500Cibuild:    "5.0.0-cibuild",
+	FHIRVersion500Snapshot1:  "5.0.0-snapshot1",
+	FHIRVersion500Snapshot2:  "5.0.0-snapshot2",
+	FHIRVersion500Ballot:     "5.0.0-ballot",
+	FHIRVersion500Snapshot3:  "5.0.0-snapshot3",
+	FHIRVersion500DraftFinal: "5.0.0-draft-final",
+}
+
+// Code returns the code value.
+func (c FHIRVersion) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the FHIRVersion values.
+func (c FHIRVersion) IsValid() bool {
+	_, ok := fHIRVersionDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c FHIRVersion) Display() string {
+	if display := fHIRVersionDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseFHIRVersion returns the FHIRVersion for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseFHIRVersion(s string) (FHIRVersion, error) {
+	return parseCode(s, fHIRVersionDisplays)
+}
+
 // AccountStatus represents Account Status.
 type AccountStatus string
 
@@ -142,6 +248,40 @@ const (
 	AccountStatusUnknown AccountStatus = "unknown"
 )
 
+// accountStatusDisplays maps each AccountStatus code to its display name.
+var accountStatusDisplays = map[AccountStatus]string{
+	AccountStatusActive:         "Active",
+	AccountStatusInactive:       "Inactive",
+	AccountStatusEnteredInError: "Entered in error",
+	AccountStatusOnHold:         "On Hold",
+	AccountStatusUnknown:        "Unknown",
+}
+
+// Code returns the code value.
+func (c AccountStatus) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the AccountStatus values.
+func (c AccountStatus) IsValid() bool {
+	_, ok := accountStatusDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c AccountStatus) Display() string {
+	if display := accountStatusDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseAccountStatus returns the AccountStatus for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseAccountStatus(s string) (AccountStatus, error) {
+	return parseCode(s, accountStatusDisplays)
+}
+
 // ActionCardinalityBehavior represents Action Cardinality Behavior.
 type ActionCardinalityBehavior string
 
@@ -153,6 +293,37 @@ const (
 	ActionCardinalityBehaviorMultiple ActionCardinalityBehavior = "multiple"
 )
 
+// actionCardinalityBehaviorDisplays maps each ActionCardinalityBehavior code to its display name.
+var actionCardinalityBehaviorDisplays = map[ActionCardinalityBehavior]string{
+	ActionCardinalityBehaviorSingle:   "Single",
+	ActionCardinalityBehaviorMultiple: "Multiple",
+}
+
+// Code returns the code value.
+func (c ActionCardinalityBehavior) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the ActionCardinalityBehavior values.
+func (c ActionCardinalityBehavior) IsValid() bool {
+	_, ok := actionCardinalityBehaviorDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c ActionCardinalityBehavior) Display() string {
+	if display := actionCardinalityBehaviorDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseActionCardinalityBehavior returns the ActionCardinalityBehavior for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseActionCardinalityBehavior(s string) (ActionCardinalityBehavior, error) {
+	return parseCode(s, actionCardinalityBehaviorDisplays)
+}
+
 // ActionConditionKind represents Action Condition Kind.
 type ActionConditionKind string
 
@@ -166,6 +337,38 @@ const (
 	ActionConditionKindStop ActionConditionKind = "stop"
 )
 
+// actionConditionKindDisplays maps each ActionConditionKind code to its display name.
+var actionConditionKindDisplays = map[ActionConditionKind]string{
+	ActionConditionKindApplicability: "Applicability",
+	ActionConditionKindStart:         "Start",
+	ActionConditionKindStop:          "Stop",
+}
+
+// Code returns the code value.
+func (c ActionConditionKind) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the ActionConditionKind values.
+func (c ActionConditionKind) IsValid() bool {
+	_, ok := actionConditionKindDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c ActionConditionKind) Display() string {
+	if display := actionConditionKindDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseActionConditionKind returns the ActionConditionKind for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseActionConditionKind(s string) (ActionConditionKind, error) {
+	return parseCode(s, actionConditionKindDisplays)
+}
+
 // ActionGroupingBehavior represents Action Grouping Behavior.
 type ActionGroupingBehavior string
 
@@ -179,6 +382,38 @@ const (
 	ActionGroupingBehaviorSentenceGroup ActionGroupingBehavior = "sentence-group"
 )
 
+// actionGroupingBehaviorDisplays maps each ActionGroupingBehavior code to its display name.
+var actionGroupingBehaviorDisplays = map[ActionGroupingBehavior]string{
+	ActionGroupingBehaviorVisualGroup:   "Visual Group",
+	ActionGroupingBehaviorLogicalGroup:  "Logical Group",
+	ActionGroupingBehaviorSentenceGroup: "Sentence Group",
+}
+
+// Code returns the code value.
+func (c ActionGroupingBehavior) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the ActionGroupingBehavior values.
+func (c ActionGroupingBehavior) IsValid() bool {
+	_, ok := actionGroupingBehaviorDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c ActionGroupingBehavior) Display() string {
+	if display := actionGroupingBehaviorDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseActionGroupingBehavior returns the ActionGroupingBehavior for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseActionGroupingBehavior(s string) (ActionGroupingBehavior, error) {
+	return parseCode(s, actionGroupingBehaviorDisplays)
+}
+
 // ActionParticipantType represents Action Participant Type.
 type ActionParticipantType string
 
@@ -206,6 +441,45 @@ const (
 	ActionParticipantTypeRelatedperson ActionParticipantType = "relatedperson"
 )
 
+// actionParticipantTypeDisplays maps each ActionParticipantType code to its display name.
+var actionParticipantTypeDisplays = map[ActionParticipantType]string{
+	ActionParticipantTypeCareteam:          "CareTeam",
+	ActionParticipantTypeDevice:            "Device",
+	ActionParticipantTypeGroup:             "Group",
+	ActionParticipantTypeHealthcareservice: "HealthcareService",
+	ActionParticipantTypeLocation:          "Location",
+	ActionParticipantTypeOrganization:      "Organization",
+	ActionParticipantTypePatient:           "Patient",
+	ActionParticipantTypePractitioner:      "Practitioner",
+	ActionParticipantTypePractitionerrole:  "PractitionerRole",
+	ActionParticipantTypeRelatedperson:     "RelatedPerson",
+}
+
+// Code returns the code value.
+func (c ActionParticipantType) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the ActionParticipantType values.
+func (c ActionParticipantType) IsValid() bool {
+	_, ok := actionParticipantTypeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c ActionParticipantType) Display() string {
+	if display := actionParticipantTypeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseActionParticipantType returns the ActionParticipantType for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseActionParticipantType(s string) (ActionParticipantType, error) {
+	return parseCode(s, actionParticipantTypeDisplays)
+}
+
 // ActionPrecheckBehavior represents Action Precheck Behavior.
 type ActionPrecheckBehavior string
 
@@ -217,6 +491,37 @@ const (
 	ActionPrecheckBehaviorNo ActionPrecheckBehavior = "no"
 )
 
+// actionPrecheckBehaviorDisplays maps each ActionPrecheckBehavior code to its display name.
+var actionPrecheckBehaviorDisplays = map[ActionPrecheckBehavior]string{
+	ActionPrecheckBehaviorYes: "Yes",
+	ActionPrecheckBehaviorNo:  "No",
+}
+
+// Code returns the code value.
+func (c ActionPrecheckBehavior) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the ActionPrecheckBehavior values.
+func (c ActionPrecheckBehavior) IsValid() bool {
+	_, ok := actionPrecheckBehaviorDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c ActionPrecheckBehavior) Display() string {
+	if display := actionPrecheckBehaviorDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseActionPrecheckBehavior returns the ActionPrecheckBehavior for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseActionPrecheckBehavior(s string) (ActionPrecheckBehavior, error) {
+	return parseCode(s, actionPrecheckBehaviorDisplays)
+}
+
 // ActionRelationshipType represents Action Relationship Type.
 type ActionRelationshipType string
 
@@ -242,6 +547,44 @@ const (
 	ActionRelationshipTypeAfterEnd ActionRelationshipType = "after-end"
 )
 
+// actionRelationshipTypeDisplays maps each ActionRelationshipType code to its display name.
+var actionRelationshipTypeDisplays = map[ActionRelationshipType]string{
+	ActionRelationshipTypeBefore:              "Before",
+	ActionRelationshipTypeBeforeStart:         "Before Start",
+	ActionRelationshipTypeBeforeEnd:           "Before End",
+	ActionRelationshipTypeConcurrent:          "Concurrent",
+	ActionRelationshipTypeConcurrentWithStart: "Concurrent With Start",
+	ActionRelationshipTypeConcurrentWithEnd:   "Concurrent With End",
+	ActionRelationshipTypeAfter:               "After",
+	ActionRelationshipTypeAfterStart:          "After Start",
+	ActionRelationshipTypeAfterEnd:            "After End",
+}
+
+// Code returns the code value.
+func (c ActionRelationshipType) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the ActionRelationshipType values.
+func (c ActionRelationshipType) IsValid() bool {
+	_, ok := actionRelationshipTypeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c ActionRelationshipType) Display() string {
+	if display := actionRelationshipTypeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseActionRelationshipType returns the ActionRelationshipType for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseActionRelationshipType(s string) (ActionRelationshipType, error) {
+	return parseCode(s, actionRelationshipTypeDisplays)
+}
+
 // ActionRequiredBehavior represents Action Required Behavior.
 type ActionRequiredBehavior string
 
@@ -255,6 +598,38 @@ const (
 	ActionRequiredBehaviorMustUnlessDocumented ActionRequiredBehavior = "must-unless-documented"
 )
 
+// actionRequiredBehaviorDisplays maps each ActionRequiredBehavior code to its display name.
+var actionRequiredBehaviorDisplays = map[ActionRequiredBehavior]string{
+	ActionRequiredBehaviorMust:                 "Must",
+	ActionRequiredBehaviorCould:                "Could",
+	ActionRequiredBehaviorMustUnlessDocumented: "Must Unless Documented",
+}
+
+// Code returns the code value.
+func (c ActionRequiredBehavior) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the ActionRequiredBehavior values.
+func (c ActionRequiredBehavior) IsValid() bool {
+	_, ok := actionRequiredBehaviorDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c ActionRequiredBehavior) Display() string {
+	if display := actionRequiredBehaviorDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseActionRequiredBehavior returns the ActionRequiredBehavior for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseActionRequiredBehavior(s string) (ActionRequiredBehavior, error) {
+	return parseCode(s, actionRequiredBehaviorDisplays)
+}
+
 // ActionSelectionBehavior represents Action Selection Behavior.
 type ActionSelectionBehavior string
 
@@ -274,6 +649,41 @@ const (
 	ActionSelectionBehaviorOneOrMore ActionSelectionBehavior = "one-or-more"
 )
 
+// actionSelectionBehaviorDisplays maps each ActionSelectionBehavior code to its display name.
+var actionSelectionBehaviorDisplays = map[ActionSelectionBehavior]string{
+	ActionSelectionBehaviorAny:        "Any",
+	ActionSelectionBehaviorAll:        "All",
+	ActionSelectionBehaviorAllOrNone:  "All Or None",
+	ActionSelectionBehaviorExactlyOne: "Exactly One",
+	ActionSelectionBehaviorAtMostOne:  "At Most One",
+	ActionSelectionBehaviorOneOrMore:  "One Or More",
+}
+
+// Code returns the code value.
+func (c ActionSelectionBehavior) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the ActionSelectionBehavior values.
+func (c ActionSelectionBehavior) IsValid() bool {
+	_, ok := actionSelectionBehaviorDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c ActionSelectionBehavior) Display() string {
+	if display := actionSelectionBehaviorDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseActionSelectionBehavior returns the ActionSelectionBehavior for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseActionSelectionBehavior(s string) (ActionSelectionBehavior, error) {
+	return parseCode(s, actionSelectionBehaviorDisplays)
+}
+
 // AdditionalBindingPurposeVS represents Additional Binding Purpose ValueSet.
 type AdditionalBindingPurposeVS string
 
@@ -301,6 +711,45 @@ const (
 	AdditionalBindingPurposeVSComponent AdditionalBindingPurposeVS = "component"
 )
 
+// additionalBindingPurposeVSDisplays maps each AdditionalBindingPurposeVS code to its display name.
+var additionalBindingPurposeVSDisplays = map[AdditionalBindingPurposeVS]string{
+	AdditionalBindingPurposeVSMaximum:    "Maximum Binding",
+	AdditionalBindingPurposeVSMinimum:    "Minimum Binding",
+	AdditionalBindingPurposeVSRequired:   "Required Binding",
+	AdditionalBindingPurposeVSExtensible: "Conformance Binding",
+	AdditionalBindingPurposeVSCandidate:  "Candidate Binding",
+	AdditionalBindingPurposeVSCurrent:    "Current Binding",
+	AdditionalBindingPurposeVSPreferred:  "Preferred Binding",
+	AdditionalBindingPurposeVSUi:         "UI Suggested Binding",
+	AdditionalBindingPurposeVSStarter:    "Starter Binding",
+	AdditionalBindingPurposeVSComponent:  "Component Binding",
+}
+
+// Code returns the code value.
+func (c AdditionalBindingPurposeVS) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the AdditionalBindingPurposeVS values.
+func (c AdditionalBindingPurposeVS) IsValid() bool {
+	_, ok := additionalBindingPurposeVSDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c AdditionalBindingPurposeVS) Display() string {
+	if display := additionalBindingPurposeVSDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseAdditionalBindingPurposeVS returns the AdditionalBindingPurposeVS for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseAdditionalBindingPurposeVS(s string) (AdditionalBindingPurposeVS, error) {
+	return parseCode(s, additionalBindingPurposeVSDisplays)
+}
+
 // AddressType represents AddressType.
 type AddressType string
 
@@ -314,6 +763,38 @@ const (
 	AddressTypeBoth AddressType = "both"
 )
 
+// addressTypeDisplays maps each AddressType code to its display name.
+var addressTypeDisplays = map[AddressType]string{
+	AddressTypePostal:   "Postal",
+	AddressTypePhysical: "Physical",
+	AddressTypeBoth:     "Postal & Physical",
+}
+
+// Code returns the code value.
+func (c AddressType) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the AddressType values.
+func (c AddressType) IsValid() bool {
+	_, ok := addressTypeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c AddressType) Display() string {
+	if display := addressTypeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseAddressType returns the AddressType for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseAddressType(s string) (AddressType, error) {
+	return parseCode(s, addressTypeDisplays)
+}
+
 // AddressUse represents AddressUse.
 type AddressUse string
 
@@ -331,6 +812,40 @@ const (
 	AddressUseBilling AddressUse = "billing"
 )
 
+// addressUseDisplays maps each AddressUse code to its display name.
+var addressUseDisplays = map[AddressUse]string{
+	AddressUseHome:    "Home",
+	AddressUseWork:    "Work",
+	AddressUseTemp:    "Temporary",
+	AddressUseOld:     "Old / Incorrect",
+	AddressUseBilling: "Billing",
+}
+
+// Code returns the code value.
+func (c AddressUse) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the AddressUse values.
+func (c AddressUse) IsValid() bool {
+	_, ok := addressUseDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c AddressUse) Display() string {
+	if display := addressUseDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseAddressUse returns the AddressUse for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseAddressUse(s string) (AddressUse, error) {
+	return parseCode(s, addressUseDisplays)
+}
+
 // AdministrativeGender represents AdministrativeGender.
 type AdministrativeGender string
 
@@ -346,6 +861,39 @@ const (
 	AdministrativeGenderUnknown AdministrativeGender = "unknown"
 )
 
+// administrativeGenderDisplays maps each AdministrativeGender code to its display name.
+var administrativeGenderDisplays = map[AdministrativeGender]string{
+	AdministrativeGenderMale:    "Male",
+	AdministrativeGenderFemale:  "Female",
+	AdministrativeGenderOther:   "Other",
+	AdministrativeGenderUnknown: "Unknown",
+}
+
+// Code returns the code value.
+func (c AdministrativeGender) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the AdministrativeGender values.
+func (c AdministrativeGender) IsValid() bool {
+	_, ok := administrativeGenderDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c AdministrativeGender) Display() string {
+	if display := administrativeGenderDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseAdministrativeGender returns the AdministrativeGender for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseAdministrativeGender(s string) (AdministrativeGender, error) {
+	return parseCode(s, administrativeGenderDisplays)
+}
+
 // AdverseEventActuality represents Adverse Event Actuality.
 type AdverseEventActuality string
 
@@ -357,6 +905,37 @@ const (
 	AdverseEventActualityPotential AdverseEventActuality = "potential"
 )
 
+// adverseEventActualityDisplays maps each AdverseEventActuality code to its display name.
+var adverseEventActualityDisplays = map[AdverseEventActuality]string{
+	AdverseEventActualityActual:    "Adverse Event",
+	AdverseEventActualityPotential: "Potential Adverse Event",
+}
+
+// Code returns the code value.
+func (c AdverseEventActuality) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the AdverseEventActuality values.
+func (c AdverseEventActuality) IsValid() bool {
+	_, ok := adverseEventActualityDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c AdverseEventActuality) Display() string {
+	if display := adverseEventActualityDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseAdverseEventActuality returns the AdverseEventActuality for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseAdverseEventActuality(s string) (AdverseEventActuality, error) {
+	return parseCode(s, adverseEventActualityDisplays)
+}
+
 // AdverseEventStatus represents Adverse Event Status.
 type AdverseEventStatus string
 
@@ -368,6 +947,39 @@ const (
 	AdverseEventStatusUnknown        AdverseEventStatus = "unknown"
 )
 
+// adverseEventStatusDisplays maps each AdverseEventStatus code to its display name.
+var adverseEventStatusDisplays = map[AdverseEventStatus]string{
+	AdverseEventStatusInProgress:     "",
+	AdverseEventStatusCompleted:      "",
+	AdverseEventStatusEnteredInError: "",
+	AdverseEventStatusUnknown:        "",
+}
+
+// Code returns the code value.
+func (c AdverseEventStatus) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the AdverseEventStatus values.
+func (c AdverseEventStatus) IsValid() bool {
+	_, ok := adverseEventStatusDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c AdverseEventStatus) Display() string {
+	if display := adverseEventStatusDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseAdverseEventStatus returns the AdverseEventStatus for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseAdverseEventStatus(s string) (AdverseEventStatus, error) {
+	return parseCode(s, adverseEventStatusDisplays)
+}
+
 // AllergyIntoleranceCategory represents Allergy Intolerance Category.
 type AllergyIntoleranceCategory string
 
@@ -383,6 +995,39 @@ const (
 	AllergyIntoleranceCategoryBiologic AllergyIntoleranceCategory = "biologic"
 )
 
+// allergyIntoleranceCategoryDisplays maps each AllergyIntoleranceCategory code to its display name.
+var allergyIntoleranceCategoryDisplays = map[AllergyIntoleranceCategory]string{
+	AllergyIntoleranceCategoryFood:        "Food",
+	AllergyIntoleranceCategoryMedication:  "Medication",
+	AllergyIntoleranceCategoryEnvironment: "Environment",
+	AllergyIntoleranceCategoryBiologic:    "Biologic",
+}
+
+// Code returns the code value.
+func (c AllergyIntoleranceCategory) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the AllergyIntoleranceCategory values.
+func (c AllergyIntoleranceCategory) IsValid() bool {
+	_, ok := allergyIntoleranceCategoryDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c AllergyIntoleranceCategory) Display() string {
+	if display := allergyIntoleranceCategoryDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseAllergyIntoleranceCategory returns the AllergyIntoleranceCategory for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseAllergyIntoleranceCategory(s string) (AllergyIntoleranceCategory, error) {
+	return parseCode(s, allergyIntoleranceCategoryDisplays)
+}
+
 // AllergyIntoleranceCriticality represents Allergy Intolerance Criticality.
 type AllergyIntoleranceCriticality string
 
@@ -396,6 +1041,38 @@ const (
 	AllergyIntoleranceCriticalityUnableToAssess AllergyIntoleranceCriticality = "unable-to-assess"
 )
 
+// allergyIntoleranceCriticalityDisplays maps each AllergyIntoleranceCriticality code to its display name.
+var allergyIntoleranceCriticalityDisplays = map[AllergyIntoleranceCriticality]string{
+	AllergyIntoleranceCriticalityLow:            "Low Risk",
+	AllergyIntoleranceCriticalityHigh:           "High Risk",
+	AllergyIntoleranceCriticalityUnableToAssess: "Unable to Assess Risk",
+}
+
+// Code returns the code value.
+func (c AllergyIntoleranceCriticality) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the AllergyIntoleranceCriticality values.
+func (c AllergyIntoleranceCriticality) IsValid() bool {
+	_, ok := allergyIntoleranceCriticalityDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c AllergyIntoleranceCriticality) Display() string {
+	if display := allergyIntoleranceCriticalityDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseAllergyIntoleranceCriticality returns the AllergyIntoleranceCriticality for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseAllergyIntoleranceCriticality(s string) (AllergyIntoleranceCriticality, error) {
+	return parseCode(s, allergyIntoleranceCriticalityDisplays)
+}
+
 // AppointmentResponseStatus represents Appointment Response Status.
 type AppointmentResponseStatus string
 
@@ -413,6 +1090,40 @@ const (
 	AppointmentResponseStatusEnteredInError AppointmentResponseStatus = "entered-in-error"
 )
 
+// appointmentResponseStatusDisplays maps each AppointmentResponseStatus code to its display name.
+var appointmentResponseStatusDisplays = map[AppointmentResponseStatus]string{
+	AppointmentResponseStatusAccepted:       "Accepted",
+	AppointmentResponseStatusDeclined:       "Declined",
+	AppointmentResponseStatusTentative:      "Tentative",
+	AppointmentResponseStatusNeedsAction:    "Needs Action",
+	AppointmentResponseStatusEnteredInError: "Entered in error",
+}
+
+// Code returns the code value.
+func (c AppointmentResponseStatus) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the AppointmentResponseStatus values.
+func (c AppointmentResponseStatus) IsValid() bool {
+	_, ok := appointmentResponseStatusDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c AppointmentResponseStatus) Display() string {
+	if display := appointmentResponseStatusDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseAppointmentResponseStatus returns the AppointmentResponseStatus for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseAppointmentResponseStatus(s string) (AppointmentResponseStatus, error) {
+	return parseCode(s, appointmentResponseStatusDisplays)
+}
+
 // AppointmentStatus represents Appointment Status.
 type AppointmentStatus string
 
@@ -440,6 +1151,45 @@ const (
 	AppointmentStatusWaitlist AppointmentStatus = "waitlist"
 )
 
+// appointmentStatusDisplays maps each AppointmentStatus code to its display name.
+var appointmentStatusDisplays = map[AppointmentStatus]string{
+	AppointmentStatusProposed:       "Proposed",
+	AppointmentStatusPending:        "Pending",
+	AppointmentStatusBooked:         "Booked",
+	AppointmentStatusArrived:        "Arrived",
+	AppointmentStatusFulfilled:      "Fulfilled",
+	AppointmentStatusCancelled:      "Cancelled",
+	AppointmentStatusNoshow:         "No Show",
+	AppointmentStatusEnteredInError: "Entered in error",
+	AppointmentStatusCheckedIn:      "Checked In",
+	AppointmentStatusWaitlist:       "Waitlisted",
+}
+
+// Code returns the code value.
+func (c AppointmentStatus) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the AppointmentStatus values.
+func (c AppointmentStatus) IsValid() bool {
+	_, ok := appointmentStatusDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c AppointmentStatus) Display() string {
+	if display := appointmentStatusDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseAppointmentStatus returns the AppointmentStatus for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseAppointmentStatus(s string) (AppointmentStatus, error) {
+	return parseCode(s, appointmentStatusDisplays)
+}
+
 // ArtifactAssessmentDisposition represents Artifact Assessment Disposition.
 type ArtifactAssessmentDisposition string
 
@@ -457,6 +1207,40 @@ const (
 	ArtifactAssessmentDispositionNotPersuasiveWithModification ArtifactAssessmentDisposition = "not-persuasive-with-modification"
 )
 
+// artifactAssessmentDispositionDisplays maps each ArtifactAssessmentDisposition code to its display name.
+var artifactAssessmentDispositionDisplays = map[ArtifactAssessmentDisposition]string{
+	ArtifactAssessmentDispositionUnresolved:                    "Unresolved",
+	ArtifactAssessmentDispositionNotPersuasive:                 "Not Persuasive",
+	ArtifactAssessmentDispositionPersuasive:                    "Persuasive",
+	ArtifactAssessmentDispositionPersuasiveWithModification:    "Persuasive with Modification",
+	ArtifactAssessmentDispositionNotPersuasiveWithModification: "Not Persuasive with Modification",
+}
+
+// Code returns the code value.
+func (c ArtifactAssessmentDisposition) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the ArtifactAssessmentDisposition values.
+func (c ArtifactAssessmentDisposition) IsValid() bool {
+	_, ok := artifactAssessmentDispositionDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c ArtifactAssessmentDisposition) Display() string {
+	if display := artifactAssessmentDispositionDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseArtifactAssessmentDisposition returns the ArtifactAssessmentDisposition for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseArtifactAssessmentDisposition(s string) (ArtifactAssessmentDisposition, error) {
+	return parseCode(s, artifactAssessmentDispositionDisplays)
+}
+
 // ArtifactAssessmentInformationType represents Artifact Assessment Information Type.
 type ArtifactAssessmentInformationType string
 
@@ -476,6 +1260,41 @@ const (
 	ArtifactAssessmentInformationTypeChangeRequest ArtifactAssessmentInformationType = "change-request"
 )
 
+// artifactAssessmentInformationTypeDisplays maps each ArtifactAssessmentInformationType code to its display name.
+var artifactAssessmentInformationTypeDisplays = map[ArtifactAssessmentInformationType]string{
+	ArtifactAssessmentInformationTypeComment:       "Comment",
+	ArtifactAssessmentInformationTypeClassifier:    "Classifier",
+	ArtifactAssessmentInformationTypeRating:        "Rating",
+	ArtifactAssessmentInformationTypeContainer:     "Container",
+	ArtifactAssessmentInformationTypeResponse:      "Response",
+	ArtifactAssessmentInformationTypeChangeRequest: "Change Request",
+}
+
+// Code returns the code value.
+func (c ArtifactAssessmentInformationType) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the ArtifactAssessmentInformationType values.
+func (c ArtifactAssessmentInformationType) IsValid() bool {
+	_, ok := artifactAssessmentInformationTypeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c ArtifactAssessmentInformationType) Display() string {
+	if display := artifactAssessmentInformationTypeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseArtifactAssessmentInformationType returns the ArtifactAssessmentInformationType for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseArtifactAssessmentInformationType(s string) (ArtifactAssessmentInformationType, error) {
+	return parseCode(s, artifactAssessmentInformationTypeDisplays)
+}
+
 // ArtifactAssessmentWorkflowStatus represents Artifact Assessment Workflow Status.
 type ArtifactAssessmentWorkflowStatus string
 
@@ -503,6 +1322,45 @@ const (
 	ArtifactAssessmentWorkflowStatusEnteredInError ArtifactAssessmentWorkflowStatus = "entered-in-error"
 )
 
+// artifactAssessmentWorkflowStatusDisplays maps each ArtifactAssessmentWorkflowStatus code to its display name.
+var artifactAssessmentWorkflowStatusDisplays = map[ArtifactAssessmentWorkflowStatus]string{
+	ArtifactAssessmentWorkflowStatusSubmitted:              "Submitted",
+	ArtifactAssessmentWorkflowStatusTriaged:                "Triaged",
+	ArtifactAssessmentWorkflowStatusWaitingForInput:        "Waiting for Input",
+	ArtifactAssessmentWorkflowStatusResolvedNoChange:       "Resolved - No Change",
+	ArtifactAssessmentWorkflowStatusResolvedChangeRequired: "Resolved - Change Required",
+	ArtifactAssessmentWorkflowStatusDeferred:               "Deferred",
+	ArtifactAssessmentWorkflowStatusDuplicate:              "Duplicate",
+	ArtifactAssessmentWorkflowStatusApplied:                "Applied",
+	ArtifactAssessmentWorkflowStatusPublished:              "Published",
+	ArtifactAssessmentWorkflowStatusEnteredInError:         "Entered in Error",
+}
+
+// Code returns the code value.
+func (c ArtifactAssessmentWorkflowStatus) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the ArtifactAssessmentWorkflowStatus values.
+func (c ArtifactAssessmentWorkflowStatus) IsValid() bool {
+	_, ok := artifactAssessmentWorkflowStatusDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c ArtifactAssessmentWorkflowStatus) Display() string {
+	if display := artifactAssessmentWorkflowStatusDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseArtifactAssessmentWorkflowStatus returns the ArtifactAssessmentWorkflowStatus for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseArtifactAssessmentWorkflowStatus(s string) (ArtifactAssessmentWorkflowStatus, error) {
+	return parseCode(s, artifactAssessmentWorkflowStatusDisplays)
+}
+
 // AssertionDirectionType represents Assertion Direction Type.
 type AssertionDirectionType string
 
@@ -514,6 +1372,37 @@ const (
 	AssertionDirectionTypeRequest AssertionDirectionType = "request"
 )
 
+// assertionDirectionTypeDisplays maps each AssertionDirectionType code to its display name.
+var assertionDirectionTypeDisplays = map[AssertionDirectionType]string{
+	AssertionDirectionTypeResponse: "response",
+	AssertionDirectionTypeRequest:  "request",
+}
+
+// Code returns the code value.
+func (c AssertionDirectionType) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the AssertionDirectionType values.
+func (c AssertionDirectionType) IsValid() bool {
+	_, ok := assertionDirectionTypeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c AssertionDirectionType) Display() string {
+	if display := assertionDirectionTypeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseAssertionDirectionType returns the AssertionDirectionType for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseAssertionDirectionType(s string) (AssertionDirectionType, error) {
+	return parseCode(s, assertionDirectionTypeDisplays)
+}
+
 // AssertionManualCompletionType represents Assertion Manual Completion Type.
 type AssertionManualCompletionType string
 
@@ -529,6 +1418,39 @@ const (
 	AssertionManualCompletionTypeStop AssertionManualCompletionType = "stop"
 )
 
+// assertionManualCompletionTypeDisplays maps each AssertionManualCompletionType code to its display name.
+var assertionManualCompletionTypeDisplays = map[AssertionManualCompletionType]string{
+	AssertionManualCompletionTypeFail: "Fail",
+	AssertionManualCompletionTypePass: "Pass",
+	AssertionManualCompletionTypeSkip: "Skip",
+	AssertionManualCompletionTypeStop: "Stop",
+}
+
+// Code returns the code value.
+func (c AssertionManualCompletionType) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the AssertionManualCompletionType values.
+func (c AssertionManualCompletionType) IsValid() bool {
+	_, ok := assertionManualCompletionTypeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c AssertionManualCompletionType) Display() string {
+	if display := assertionManualCompletionTypeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseAssertionManualCompletionType returns the AssertionManualCompletionType for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseAssertionManualCompletionType(s string) (AssertionManualCompletionType, error) {
+	return parseCode(s, assertionManualCompletionTypeDisplays)
+}
+
 // AssertionOperatorType represents Assertion Operator Type.
 type AssertionOperatorType string
 
@@ -560,6 +1482,47 @@ const (
 	AssertionOperatorTypeManualeval AssertionOperatorType = "manualEval"
 )
 
+// assertionOperatorTypeDisplays maps each AssertionOperatorType code to its display name.
+var assertionOperatorTypeDisplays = map[AssertionOperatorType]string{
+	AssertionOperatorTypeEquals:      "equals",
+	AssertionOperatorTypeNotequals:   "notEquals",
+	AssertionOperatorTypeIn:          "in",
+	AssertionOperatorTypeNotin:       "notIn",
+	AssertionOperatorTypeGreaterthan: "greaterThan",
+	AssertionOperatorTypeLessthan:    "lessThan",
+	AssertionOperatorTypeEmpty:       "empty",
+	AssertionOperatorTypeNotempty:    "notEmpty",
+	AssertionOperatorTypeContains:    "contains",
+	AssertionOperatorTypeNotcontains: "notContains",
+	AssertionOperatorTypeEval:        "evaluate",
+	AssertionOperatorTypeManualeval:  "manualEvaluate",
+}
+
+// Code returns the code value.
+func (c AssertionOperatorType) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the AssertionOperatorType values.
+func (c AssertionOperatorType) IsValid() bool {
+	_, ok := assertionOperatorTypeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c AssertionOperatorType) Display() string {
+	if display := assertionOperatorTypeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseAssertionOperatorType returns the AssertionOperatorType for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseAssertionOperatorType(s string) (AssertionOperatorType, error) {
+	return parseCode(s, assertionOperatorTypeDisplays)
+}
+
 // AssertionResponseTypes represents Assertion Response Types.
 type AssertionResponseTypes string
 
@@ -655,6 +1618,79 @@ const (
 	AssertionResponseTypesHttpversionnotsupported AssertionResponseTypes = "httpVersionNotSupported"
 )
 
+// assertionResponseTypesDisplays maps each AssertionResponseTypes code to its display name.
+var assertionResponseTypesDisplays = map[AssertionResponseTypes]string{
+	AssertionResponseTypesContinue:                    "Continue",
+	AssertionResponseTypesSwitchingprotocols:          "Switching Protocols",
+	AssertionResponseTypesOkay:                        "OK",
+	AssertionResponseTypesCreated:                     "Created",
+	AssertionResponseTypesAccepted:                    "Accepted",
+	AssertionResponseTypesNonauthoritativeinformation: "Non-Authoritative Information",
+	AssertionResponseTypesNocontent:                   "No Content",
+	AssertionResponseTypesResetcontent:                "Reset Content",
+	AssertionResponseTypesPartialcontent:              "Partial Content",
+	AssertionResponseTypesMultiplechoices:             "Multiple Choices",
+	AssertionResponseTypesMovedpermanently:            "Moved Permanently",
+	AssertionResponseTypesFound:                       "Found",
+	AssertionResponseTypesSeeother:                    "See Other",
+	AssertionResponseTypesNotmodified:                 "Not Modified",
+	AssertionResponseTypesUseproxy:                    "Use Proxy",
+	AssertionResponseTypesTemporaryredirect:           "Temporary Redirect",
+	AssertionResponseTypesPermanentredirect:           "Permanent Redirect",
+	AssertionResponseTypesBadrequest:                  "Bad Request",
+	AssertionResponseTypesUnauthorized:                "Unauthorized",
+	AssertionResponseTypesPaymentrequired:             "Payment Required",
+	AssertionResponseTypesForbidden:                   "Forbidden",
+	AssertionResponseTypesNotfound:                    "Not Found",
+	AssertionResponseTypesMethodnotallowed:            "Method Not Allowed",
+	AssertionResponseTypesNotacceptable:               "Not Acceptable",
+	AssertionResponseTypesProxyauthenticationrequired: "Proxy Authentication Required",
+	AssertionResponseTypesRequesttimeout:              "Request Timeout",
+	AssertionResponseTypesConflict:                    "Conflict",
+	AssertionResponseTypesGone:                        "Gone",
+	AssertionResponseTypesLengthrequired:              "Length Required",
+	AssertionResponseTypesPreconditionfailed:          "Precondition Failed",
+	AssertionResponseTypesContenttoolarge:             "Content Too Large",
+	AssertionResponseTypesUritoolong:                  "URI Too Long",
+	AssertionResponseTypesUnsupportedmediatype:        "Unsupported Media Type",
+	AssertionResponseTypesRangenotsatisfiable:         "Range Not Satisfiable",
+	AssertionResponseTypesExpectationfailed:           "Expectation Failed",
+	AssertionResponseTypesMisdirectedrequest:          "Misdirected Request",
+	AssertionResponseTypesUnprocessablecontent:        "Unprocessable Content",
+	AssertionResponseTypesUpgraderequired:             "Upgrade Required",
+	AssertionResponseTypesInternalservererror:         "Internal Server Error",
+	AssertionResponseTypesNotimplemented:              "Not Implemented",
+	AssertionResponseTypesBadgateway:                  "Bad Gateway",
+	AssertionResponseTypesServiceunavailable:          "Service Unavailable",
+	AssertionResponseTypesGatewaytimeout:              "Gateway Timeout",
+	AssertionResponseTypesHttpversionnotsupported:     "HTTP Version Not Supported",
+}
+
+// Code returns the code value.
+func (c AssertionResponseTypes) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the AssertionResponseTypes values.
+func (c AssertionResponseTypes) IsValid() bool {
+	_, ok := assertionResponseTypesDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c AssertionResponseTypes) Display() string {
+	if display := assertionResponseTypesDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseAssertionResponseTypes returns the AssertionResponseTypes for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseAssertionResponseTypes(s string) (AssertionResponseTypes, error) {
+	return parseCode(s, assertionResponseTypesDisplays)
+}
+
 // AuditEventAction represents Audit Event Action.
 type AuditEventAction string
 
@@ -672,6 +1708,40 @@ const (
 	AuditEventActionE AuditEventAction = "E"
 )
 
+// auditEventActionDisplays maps each AuditEventAction code to its display name.
+var auditEventActionDisplays = map[AuditEventAction]string{
+	AuditEventActionC: "Create",
+	AuditEventActionR: "Read",
+	AuditEventActionU: "Update",
+	AuditEventActionD: "Delete",
+	AuditEventActionE: "Execute",
+}
+
+// Code returns the code value.
+func (c AuditEventAction) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the AuditEventAction values.
+func (c AuditEventAction) IsValid() bool {
+	_, ok := auditEventActionDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c AuditEventAction) Display() string {
+	if display := auditEventActionDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseAuditEventAction returns the AuditEventAction for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseAuditEventAction(s string) (AuditEventAction, error) {
+	return parseCode(s, auditEventActionDisplays)
+}
+
 // AuditEventSeverity represents Audit Event Severity.
 type AuditEventSeverity string
 
@@ -695,6 +1765,43 @@ const (
 	AuditEventSeverityDebug AuditEventSeverity = "debug"
 )
 
+// auditEventSeverityDisplays maps each AuditEventSeverity code to its display name.
+var auditEventSeverityDisplays = map[AuditEventSeverity]string{
+	AuditEventSeverityEmergency:     "Emergency",
+	AuditEventSeverityAlert:         "Alert",
+	AuditEventSeverityCritical:      "Critical",
+	AuditEventSeverityError:         "Error",
+	AuditEventSeverityWarning:       "Warning",
+	AuditEventSeverityNotice:        "Notice",
+	AuditEventSeverityInformational: "Informational",
+	AuditEventSeverityDebug:         "Debug",
+}
+
+// Code returns the code value.
+func (c AuditEventSeverity) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the AuditEventSeverity values.
+func (c AuditEventSeverity) IsValid() bool {
+	_, ok := auditEventSeverityDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c AuditEventSeverity) Display() string {
+	if display := auditEventSeverityDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseAuditEventSeverity returns the AuditEventSeverity for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseAuditEventSeverity(s string) (AuditEventSeverity, error) {
+	return parseCode(s, auditEventSeverityDisplays)
+}
+
 // BindingStrength represents BindingStrength.
 type BindingStrength string
 
@@ -710,6 +1817,39 @@ const (
 	BindingStrengthExample BindingStrength = "example"
 )
 
+// bindingStrengthDisplays maps each BindingStrength code to its display name.
+var bindingStrengthDisplays = map[BindingStrength]string{
+	BindingStrengthRequired:   "Required",
+	BindingStrengthExtensible: "Extensible",
+	BindingStrengthPreferred:  "Preferred",
+	BindingStrengthExample:    "Example",
+}
+
+// Code returns the code value.
+func (c BindingStrength) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the BindingStrength values.
+func (c BindingStrength) IsValid() bool {
+	_, ok := bindingStrengthDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c BindingStrength) Display() string {
+	if display := bindingStrengthDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseBindingStrength returns the BindingStrength for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseBindingStrength(s string) (BindingStrength, error) {
+	return parseCode(s, bindingStrengthDisplays)
+}
+
 // BiologicallyDerivedProductDispenseCodes represents BiologicallyDerivedProductDispense Status Codes.
 type BiologicallyDerivedProductDispenseCodes string
 
@@ -733,6 +1873,43 @@ const (
 	BiologicallyDerivedProductDispenseCodesUnknown BiologicallyDerivedProductDispenseCodes = "unknown"
 )
 
+// biologicallyDerivedProductDispenseCodesDisplays maps each BiologicallyDerivedProductDispenseCodes code to its display name.
+var biologicallyDerivedProductDispenseCodesDisplays = map[BiologicallyDerivedProductDispenseCodes]string{
+	BiologicallyDerivedProductDispenseCodesPreparation:    "Preparation",
+	BiologicallyDerivedProductDispenseCodesInProgress:     "In Progress",
+	BiologicallyDerivedProductDispenseCodesAllocated:      "Allocated",
+	BiologicallyDerivedProductDispenseCodesIssued:         "Issued",
+	BiologicallyDerivedProductDispenseCodesUnfulfilled:    "Unfulfilled",
+	BiologicallyDerivedProductDispenseCodesReturned:       "Returned",
+	BiologicallyDerivedProductDispenseCodesEnteredInError: "Entered in Error",
+	BiologicallyDerivedProductDispenseCodesUnknown:        "Unknown",
+}
+
+// Code returns the code value.
+func (c BiologicallyDerivedProductDispenseCodes) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the BiologicallyDerivedProductDispenseCodes values.
+func (c BiologicallyDerivedProductDispenseCodes) IsValid() bool {
+	_, ok := biologicallyDerivedProductDispenseCodesDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c BiologicallyDerivedProductDispenseCodes) Display() string {
+	if display := biologicallyDerivedProductDispenseCodesDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseBiologicallyDerivedProductDispenseCodes returns the BiologicallyDerivedProductDispenseCodes for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseBiologicallyDerivedProductDispenseCodes(s string) (BiologicallyDerivedProductDispenseCodes, error) {
+	return parseCode(s, biologicallyDerivedProductDispenseCodesDisplays)
+}
+
 // BundleType represents Bundle Type.
 type BundleType string
 
@@ -760,6 +1937,45 @@ const (
 	BundleTypeSubscriptionNotification BundleType = "subscription-notification"
 )
 
+// bundleTypeDisplays maps each BundleType code to its display name.
+var bundleTypeDisplays = map[BundleType]string{
+	BundleTypeDocument:                 "Document",
+	BundleTypeMessage:                  "Message",
+	BundleTypeTransaction:              "Transaction",
+	BundleTypeTransactionResponse:      "Transaction Response",
+	BundleTypeBatch:                    "Batch",
+	BundleTypeBatchResponse:            "Batch Response",
+	BundleTypeHistory:                  "History List",
+	BundleTypeSearchset:                "Search Results",
+	BundleTypeCollection:               "Collection",
+	BundleTypeSubscriptionNotification: "Subscription Notification",
+}
+
+// Code returns the code value.
+func (c BundleType) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the BundleType values.
+func (c BundleType) IsValid() bool {
+	_, ok := bundleTypeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c BundleType) Display() string {
+	if display := bundleTypeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseBundleType returns the BundleType for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseBundleType(s string) (BundleType, error) {
+	return parseCode(s, bundleTypeDisplays)
+}
+
 // CapabilityStatementKind represents Capability Statement Kind.
 type CapabilityStatementKind string
 
@@ -773,6 +1989,38 @@ const (
 	CapabilityStatementKindRequirements CapabilityStatementKind = "requirements"
 )
 
+// capabilityStatementKindDisplays maps each CapabilityStatementKind code to its display name.
+var capabilityStatementKindDisplays = map[CapabilityStatementKind]string{
+	CapabilityStatementKindInstance:     "Instance",
+	CapabilityStatementKindCapability:   "Capability",
+	CapabilityStatementKindRequirements: "Requirements",
+}
+
+// Code returns the code value.
+func (c CapabilityStatementKind) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the CapabilityStatementKind values.
+func (c CapabilityStatementKind) IsValid() bool {
+	_, ok := capabilityStatementKindDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c CapabilityStatementKind) Display() string {
+	if display := capabilityStatementKindDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseCapabilityStatementKind returns the CapabilityStatementKind for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseCapabilityStatementKind(s string) (CapabilityStatementKind, error) {
+	return parseCode(s, capabilityStatementKindDisplays)
+}
+
 // CarePlanIntent represents Care Plan Intent.
 type CarePlanIntent string
 
@@ -785,6 +2033,40 @@ const (
 	CarePlanIntentDirective CarePlanIntent = "directive"
 )
 
+// carePlanIntentDisplays maps each CarePlanIntent code to its display name.
+var carePlanIntentDisplays = map[CarePlanIntent]string{
+	CarePlanIntentProposal:  "",
+	CarePlanIntentPlan:      "",
+	CarePlanIntentOrder:     "",
+	CarePlanIntentOption:    "",
+	CarePlanIntentDirective: "",
+}
+
+// Code returns the code value.
+func (c CarePlanIntent) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the CarePlanIntent values.
+func (c CarePlanIntent) IsValid() bool {
+	_, ok := carePlanIntentDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c CarePlanIntent) Display() string {
+	if display := carePlanIntentDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseCarePlanIntent returns the CarePlanIntent for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseCarePlanIntent(s string) (CarePlanIntent, error) {
+	return parseCode(s, carePlanIntentDisplays)
+}
+
 // CareTeamStatus represents Care Team Status.
 type CareTeamStatus string
 
@@ -802,6 +2084,40 @@ const (
 	CareTeamStatusEnteredInError CareTeamStatus = "entered-in-error"
 )
 
+// careTeamStatusDisplays maps each CareTeamStatus code to its display name.
+var careTeamStatusDisplays = map[CareTeamStatus]string{
+	CareTeamStatusProposed:       "Proposed",
+	CareTeamStatusActive:         "Active",
+	CareTeamStatusSuspended:      "Suspended",
+	CareTeamStatusInactive:       "Inactive",
+	CareTeamStatusEnteredInError: "Entered in Error",
+}
+
+// Code returns the code value.
+func (c CareTeamStatus) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the CareTeamStatus values.
+func (c CareTeamStatus) IsValid() bool {
+	_, ok := careTeamStatusDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c CareTeamStatus) Display() string {
+	if display := careTeamStatusDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseCareTeamStatus returns the CareTeamStatus for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseCareTeamStatus(s string) (CareTeamStatus, error) {
+	return parseCode(s, careTeamStatusDisplays)
+}
+
 // CharacteristicCombination represents Characteristic Combination.
 type CharacteristicCombination string
 
@@ -823,6 +2139,42 @@ const (
 	CharacteristicCombinationDataset CharacteristicCombination = "dataset"
 )
 
+// characteristicCombinationDisplays maps each CharacteristicCombination code to its display name.
+var characteristicCombinationDisplays = map[CharacteristicCombination]string{
+	CharacteristicCombinationAllOf:       "All of",
+	CharacteristicCombinationAnyOf:       "Any of",
+	CharacteristicCombinationAtLeast:     "At least",
+	CharacteristicCombinationAtMost:      "At most",
+	CharacteristicCombinationStatistical: "Statistical",
+	CharacteristicCombinationNetEffect:   "Net effect",
+	CharacteristicCombinationDataset:     "Dataset",
+}
+
+// Code returns the code value.
+func (c CharacteristicCombination) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the CharacteristicCombination values.
+func (c CharacteristicCombination) IsValid() bool {
+	_, ok := characteristicCombinationDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c CharacteristicCombination) Display() string {
+	if display := characteristicCombinationDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseCharacteristicCombination returns the CharacteristicCombination for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseCharacteristicCombination(s string) (CharacteristicCombination, error) {
+	return parseCode(s, characteristicCombinationDisplays)
+}
+
 // ChargeItemStatus represents Charge Item Status.
 type ChargeItemStatus string
 
@@ -844,6 +2196,42 @@ const (
 	ChargeItemStatusUnknown ChargeItemStatus = "unknown"
 )
 
+// chargeItemStatusDisplays maps each ChargeItemStatus code to its display name.
+var chargeItemStatusDisplays = map[ChargeItemStatus]string{
+	ChargeItemStatusPlanned:        "Planned",
+	ChargeItemStatusBillable:       "Billable",
+	ChargeItemStatusNotBillable:    "Not billable",
+	ChargeItemStatusAborted:        "Aborted",
+	ChargeItemStatusBilled:         "Billed",
+	ChargeItemStatusEnteredInError: "Entered in Error",
+	ChargeItemStatusUnknown:        "Unknown",
+}
+
+// Code returns the code value.
+func (c ChargeItemStatus) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the ChargeItemStatus values.
+func (c ChargeItemStatus) IsValid() bool {
+	_, ok := chargeItemStatusDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c ChargeItemStatus) Display() string {
+	if display := chargeItemStatusDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseChargeItemStatus returns the ChargeItemStatus for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseChargeItemStatus(s string) (ChargeItemStatus, error) {
+	return parseCode(s, chargeItemStatusDisplays)
+}
+
 // ClaimProcessingCodes represents Claim Processing Codes.
 type ClaimProcessingCodes string
 
@@ -859,6 +2247,39 @@ const (
 	ClaimProcessingCodesPartial ClaimProcessingCodes = "partial"
 )
 
+// claimProcessingCodesDisplays maps each ClaimProcessingCodes code to its display name.
+var claimProcessingCodesDisplays = map[ClaimProcessingCodes]string{
+	ClaimProcessingCodesQueued:   "Queued",
+	ClaimProcessingCodesComplete: "Processing Complete",
+	ClaimProcessingCodesError:    "Error",
+	ClaimProcessingCodesPartial:  "Partial Processing",
+}
+
+// Code returns the code value.
+func (c ClaimProcessingCodes) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the ClaimProcessingCodes values.
+func (c ClaimProcessingCodes) IsValid() bool {
+	_, ok := claimProcessingCodesDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c ClaimProcessingCodes) Display() string {
+	if display := claimProcessingCodesDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseClaimProcessingCodes returns the ClaimProcessingCodes for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseClaimProcessingCodes(s string) (ClaimProcessingCodes, error) {
+	return parseCode(s, claimProcessingCodesDisplays)
+}
+
 // Use represents Use.
 type Use string
 
@@ -872,6 +2293,38 @@ const (
 	UsePredetermination Use = "predetermination"
 )
 
+// useDisplays maps each Use code to its display name.
+var useDisplays = map[Use]string{
+	UseClaim:            "Claim",
+	UsePreauthorization: "Preauthorization",
+	UsePredetermination: "Predetermination",
+}
+
+// Code returns the code value.
+func (c Use) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the Use values.
+func (c Use) IsValid() bool {
+	_, ok := useDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c Use) Display() string {
+	if display := useDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseUse returns the Use for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseUse(s string) (Use, error) {
+	return parseCode(s, useDisplays)
+}
+
 // ClinicalUseDefinitionType represents Clinical Use Definition Type.
 type ClinicalUseDefinitionType string
 
@@ -889,6 +2342,40 @@ const (
 	ClinicalUseDefinitionTypeWarning ClinicalUseDefinitionType = "warning"
 )
 
+// clinicalUseDefinitionTypeDisplays maps each ClinicalUseDefinitionType code to its display name.
+var clinicalUseDefinitionTypeDisplays = map[ClinicalUseDefinitionType]string{
+	ClinicalUseDefinitionTypeIndication:        "Indication",
+	ClinicalUseDefinitionTypeContraindication:  "Contraindication",
+	ClinicalUseDefinitionTypeInteraction:       "Interaction",
+	ClinicalUseDefinitionTypeUndesirableEffect: "Undesirable Effect",
+	ClinicalUseDefinitionTypeWarning:           "Warning",
+}
+
+// Code returns the code value.
+func (c ClinicalUseDefinitionType) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the ClinicalUseDefinitionType values.
+func (c ClinicalUseDefinitionType) IsValid() bool {
+	_, ok := clinicalUseDefinitionTypeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c ClinicalUseDefinitionType) Display() string {
+	if display := clinicalUseDefinitionTypeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseClinicalUseDefinitionType returns the ClinicalUseDefinitionType for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseClinicalUseDefinitionType(s string) (ClinicalUseDefinitionType, error) {
+	return parseCode(s, clinicalUseDefinitionTypeDisplays)
+}
+
 // CodeSearchSupport represents Code Search Support.
 type CodeSearchSupport string
 
@@ -902,6 +2389,38 @@ const (
 	CodeSearchSupportInComposeOrExpansion CodeSearchSupport = "in-compose-or-expansion"
 )
 
+// codeSearchSupportDisplays maps each CodeSearchSupport code to its display name.
+var codeSearchSupportDisplays = map[CodeSearchSupport]string{
+	CodeSearchSupportInCompose:            "In Compose",
+	CodeSearchSupportInExpansion:          "In Expansion",
+	CodeSearchSupportInComposeOrExpansion: "In Compose Or Expansion",
+}
+
+// Code returns the code value.
+func (c CodeSearchSupport) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the CodeSearchSupport values.
+func (c CodeSearchSupport) IsValid() bool {
+	_, ok := codeSearchSupportDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c CodeSearchSupport) Display() string {
+	if display := codeSearchSupportDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseCodeSearchSupport returns the CodeSearchSupport for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseCodeSearchSupport(s string) (CodeSearchSupport, error) {
+	return parseCode(s, codeSearchSupportDisplays)
+}
+
 // CodeSystemContentMode represents Code System Content Mode.
 type CodeSystemContentMode string
 
@@ -919,6 +2438,40 @@ const (
 	CodeSystemContentModeSupplement CodeSystemContentMode = "supplement"
 )
 
+// codeSystemContentModeDisplays maps each CodeSystemContentMode code to its display name.
+var codeSystemContentModeDisplays = map[CodeSystemContentMode]string{
+	CodeSystemContentModeNotPresent: "Not Present",
+	CodeSystemContentModeExample:    "Example",
+	CodeSystemContentModeFragment:   "Fragment",
+	CodeSystemContentModeComplete:   "Complete",
+	CodeSystemContentModeSupplement: "Supplement",
+}
+
+// Code returns the code value.
+func (c CodeSystemContentMode) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the CodeSystemContentMode values.
+func (c CodeSystemContentMode) IsValid() bool {
+	_, ok := codeSystemContentModeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c CodeSystemContentMode) Display() string {
+	if display := codeSystemContentModeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseCodeSystemContentMode returns the CodeSystemContentMode for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseCodeSystemContentMode(s string) (CodeSystemContentMode, error) {
+	return parseCode(s, codeSystemContentModeDisplays)
+}
+
 // CodeSystemHierarchyMeaning represents Code System Hierarchy Meaning.
 type CodeSystemHierarchyMeaning string
 
@@ -934,6 +2487,39 @@ const (
 	CodeSystemHierarchyMeaningClassifiedWith CodeSystemHierarchyMeaning = "classified-with"
 )
 
+// codeSystemHierarchyMeaningDisplays maps each CodeSystemHierarchyMeaning code to its display name.
+var codeSystemHierarchyMeaningDisplays = map[CodeSystemHierarchyMeaning]string{
+	CodeSystemHierarchyMeaningGroupedBy:      "Grouped By",
+	CodeSystemHierarchyMeaningIsA:            "Is-A",
+	CodeSystemHierarchyMeaningPartOf:         "Part Of",
+	CodeSystemHierarchyMeaningClassifiedWith: "Classified With",
+}
+
+// Code returns the code value.
+func (c CodeSystemHierarchyMeaning) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the CodeSystemHierarchyMeaning values.
+func (c CodeSystemHierarchyMeaning) IsValid() bool {
+	_, ok := codeSystemHierarchyMeaningDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c CodeSystemHierarchyMeaning) Display() string {
+	if display := codeSystemHierarchyMeaningDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseCodeSystemHierarchyMeaning returns the CodeSystemHierarchyMeaning for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseCodeSystemHierarchyMeaning(s string) (CodeSystemHierarchyMeaning, error) {
+	return parseCode(s, codeSystemHierarchyMeaningDisplays)
+}
+
 // CompartmentType represents Compartment Type.
 type CompartmentType string
 
@@ -953,6 +2539,41 @@ const (
 	CompartmentTypeEpisodeofcare CompartmentType = "EpisodeOfCare"
 )
 
+// compartmentTypeDisplays maps each CompartmentType code to its display name.
+var compartmentTypeDisplays = map[CompartmentType]string{
+	CompartmentTypePatient:       "Patient",
+	CompartmentTypeEncounter:     "Encounter",
+	CompartmentTypeRelatedperson: "RelatedPerson",
+	CompartmentTypePractitioner:  "Practitioner",
+	CompartmentTypeDevice:        "Device",
+	CompartmentTypeEpisodeofcare: "EpisodeOfCare",
+}
+
+// Code returns the code value.
+func (c CompartmentType) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the CompartmentType values.
+func (c CompartmentType) IsValid() bool {
+	_, ok := compartmentTypeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c CompartmentType) Display() string {
+	if display := compartmentTypeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseCompartmentType returns the CompartmentType for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseCompartmentType(s string) (CompartmentType, error) {
+	return parseCode(s, compartmentTypeDisplays)
+}
+
 // CompositionStatus represents Composition Status.
 type CompositionStatus string
 
@@ -982,6 +2603,46 @@ const (
 	CompositionStatusUnknown CompositionStatus = "unknown"
 )
 
+// compositionStatusDisplays maps each CompositionStatus code to its display name.
+var compositionStatusDisplays = map[CompositionStatus]string{
+	CompositionStatusRegistered:     "Registered",
+	CompositionStatusPartial:        "Partial",
+	CompositionStatusPreliminary:    "Preliminary",
+	CompositionStatusFinal:          "Final",
+	CompositionStatusAmended:        "Amended",
+	CompositionStatusCorrected:      "Corrected",
+	CompositionStatusAppended:       "Appended",
+	CompositionStatusCancelled:      "Cancelled",
+	CompositionStatusEnteredInError: "Entered in Error",
+	CompositionStatusDeprecated:     "Deprecated",
+	CompositionStatusUnknown:        "Unknown",
+}
+
+// Code returns the code value.
+func (c CompositionStatus) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the CompositionStatus values.
+func (c CompositionStatus) IsValid() bool {
+	_, ok := compositionStatusDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c CompositionStatus) Display() string {
+	if display := compositionStatusDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseCompositionStatus returns the CompositionStatus for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseCompositionStatus(s string) (CompositionStatus, error) {
+	return parseCode(s, compositionStatusDisplays)
+}
+
 // ConceptMapRelationship represents ConceptMapRelationship.
 type ConceptMapRelationship string
 
@@ -999,6 +2660,40 @@ const (
 	ConceptMapRelationshipNotRelatedTo ConceptMapRelationship = "not-related-to"
 )
 
+// conceptMapRelationshipDisplays maps each ConceptMapRelationship code to its display name.
+var conceptMapRelationshipDisplays = map[ConceptMapRelationship]string{
+	ConceptMapRelationshipRelatedTo:                  "Related To",
+	ConceptMapRelationshipEquivalent:                 "Equivalent",
+	ConceptMapRelationshipSourceIsNarrowerThanTarget: "Source Is Narrower Than Target",
+	ConceptMapRelationshipSourceIsBroaderThanTarget:  "Source Is Broader Than Target",
+	ConceptMapRelationshipNotRelatedTo:               "Not Related To",
+}
+
+// Code returns the code value.
+func (c ConceptMapRelationship) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the ConceptMapRelationship values.
+func (c ConceptMapRelationship) IsValid() bool {
+	_, ok := conceptMapRelationshipDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c ConceptMapRelationship) Display() string {
+	if display := conceptMapRelationshipDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseConceptMapRelationship returns the ConceptMapRelationship for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseConceptMapRelationship(s string) (ConceptMapRelationship, error) {
+	return parseCode(s, conceptMapRelationshipDisplays)
+}
+
 // PropertyType represents Property Type.
 type PropertyType string
 
@@ -1020,6 +2715,42 @@ const (
 	PropertyTypeDecimal PropertyType = "decimal"
 )
 
+// propertyTypeDisplays maps each PropertyType code to its display name.
+var propertyTypeDisplays = map[PropertyType]string{
+	PropertyTypeCode:     "code (internal reference)",
+	PropertyTypeCoding:   "Coding (external reference)",
+	PropertyTypeString:   "string",
+	PropertyTypeInteger:  "integer",
+	PropertyTypeBoolean:  "boolean",
+	PropertyTypeDatetime: "dateTime",
+	PropertyTypeDecimal:  "decimal",
+}
+
+// Code returns the code value.
+func (c PropertyType) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the PropertyType values.
+func (c PropertyType) IsValid() bool {
+	_, ok := propertyTypeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c PropertyType) Display() string {
+	if display := propertyTypeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParsePropertyType returns the PropertyType for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParsePropertyType(s string) (PropertyType, error) {
+	return parseCode(s, propertyTypeDisplays)
+}
+
 // ConceptMapAttributeType represents ConceptMap Attribute Type.
 type ConceptMapAttributeType string
 
@@ -1037,6 +2768,40 @@ const (
 	ConceptMapAttributeTypeQuantity ConceptMapAttributeType = "Quantity"
 )
 
+// conceptMapAttributeTypeDisplays maps each ConceptMapAttributeType code to its display name.
+var conceptMapAttributeTypeDisplays = map[ConceptMapAttributeType]string{
+	ConceptMapAttributeTypeCode:     "code",
+	ConceptMapAttributeTypeCoding:   "Coding",
+	ConceptMapAttributeTypeString:   "string",
+	ConceptMapAttributeTypeBoolean:  "boolean",
+	ConceptMapAttributeTypeQuantity: "Quantity",
+}
+
+// Code returns the code value.
+func (c ConceptMapAttributeType) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the ConceptMapAttributeType values.
+func (c ConceptMapAttributeType) IsValid() bool {
+	_, ok := conceptMapAttributeTypeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c ConceptMapAttributeType) Display() string {
+	if display := conceptMapAttributeTypeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseConceptMapAttributeType returns the ConceptMapAttributeType for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseConceptMapAttributeType(s string) (ConceptMapAttributeType, error) {
+	return parseCode(s, conceptMapAttributeTypeDisplays)
+}
+
 // ConceptMapPropertyType represents ConceptMap Property Type.
 type ConceptMapPropertyType string
 
@@ -1058,6 +2823,42 @@ const (
 	ConceptMapPropertyTypeCode ConceptMapPropertyType = "code"
 )
 
+// conceptMapPropertyTypeDisplays maps each ConceptMapPropertyType code to its display name.
+var conceptMapPropertyTypeDisplays = map[ConceptMapPropertyType]string{
+	ConceptMapPropertyTypeCoding:   "Coding (external reference)",
+	ConceptMapPropertyTypeString:   "string",
+	ConceptMapPropertyTypeInteger:  "integer",
+	ConceptMapPropertyTypeBoolean:  "boolean",
+	ConceptMapPropertyTypeDatetime: "dateTime",
+	ConceptMapPropertyTypeDecimal:  "decimal",
+	ConceptMapPropertyTypeCode:     "code",
+}
+
+// Code returns the code value.
+func (c ConceptMapPropertyType) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the ConceptMapPropertyType values.
+func (c ConceptMapPropertyType) IsValid() bool {
+	_, ok := conceptMapPropertyTypeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c ConceptMapPropertyType) Display() string {
+	if display := conceptMapPropertyTypeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseConceptMapPropertyType returns the ConceptMapPropertyType for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseConceptMapPropertyType(s string) (ConceptMapPropertyType, error) {
+	return parseCode(s, conceptMapPropertyTypeDisplays)
+}
+
 // ConceptMapGroupUnmappedMode represents Concept Map Group Unmapped Mode.
 type ConceptMapGroupUnmappedMode string
 
@@ -1071,6 +2872,38 @@ const (
 	ConceptMapGroupUnmappedModeOtherMap ConceptMapGroupUnmappedMode = "other-map"
 )
 
+// conceptMapGroupUnmappedModeDisplays maps each ConceptMapGroupUnmappedMode code to its display name.
+var conceptMapGroupUnmappedModeDisplays = map[ConceptMapGroupUnmappedMode]string{
+	ConceptMapGroupUnmappedModeUseSourceCode: "Use Provided Source Code",
+	ConceptMapGroupUnmappedModeFixed:         "Fixed Code",
+	ConceptMapGroupUnmappedModeOtherMap:      "Other Map",
+}
+
+// Code returns the code value.
+func (c ConceptMapGroupUnmappedMode) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the ConceptMapGroupUnmappedMode values.
+func (c ConceptMapGroupUnmappedMode) IsValid() bool {
+	_, ok := conceptMapGroupUnmappedModeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c ConceptMapGroupUnmappedMode) Display() string {
+	if display := conceptMapGroupUnmappedModeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseConceptMapGroupUnmappedMode returns the ConceptMapGroupUnmappedMode for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseConceptMapGroupUnmappedMode(s string) (ConceptMapGroupUnmappedMode, error) {
+	return parseCode(s, conceptMapGroupUnmappedModeDisplays)
+}
+
 // ConditionPreconditionType represents Condition Precondition Type.
 type ConditionPreconditionType string
 
@@ -1082,6 +2915,37 @@ const (
 	ConditionPreconditionTypeSpecific ConditionPreconditionType = "specific"
 )
 
+// conditionPreconditionTypeDisplays maps each ConditionPreconditionType code to its display name.
+var conditionPreconditionTypeDisplays = map[ConditionPreconditionType]string{
+	ConditionPreconditionTypeSensitive: "Sensitive",
+	ConditionPreconditionTypeSpecific:  "Specific",
+}
+
+// Code returns the code value.
+func (c ConditionPreconditionType) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the ConditionPreconditionType values.
+func (c ConditionPreconditionType) IsValid() bool {
+	_, ok := conditionPreconditionTypeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c ConditionPreconditionType) Display() string {
+	if display := conditionPreconditionTypeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseConditionPreconditionType returns the ConditionPreconditionType for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseConditionPreconditionType(s string) (ConditionPreconditionType, error) {
+	return parseCode(s, conditionPreconditionTypeDisplays)
+}
+
 // ConditionQuestionnairePurpose represents Condition Questionnaire Purpose.
 type ConditionQuestionnairePurpose string
 
@@ -1095,6 +2959,38 @@ const (
 	ConditionQuestionnairePurposeOutcome ConditionQuestionnairePurpose = "outcome"
 )
 
+// conditionQuestionnairePurposeDisplays maps each ConditionQuestionnairePurpose code to its display name.
+var conditionQuestionnairePurposeDisplays = map[ConditionQuestionnairePurpose]string{
+	ConditionQuestionnairePurposePreadmit:      "Pre-admit",
+	ConditionQuestionnairePurposeDiffDiagnosis: "Diff Diagnosis",
+	ConditionQuestionnairePurposeOutcome:       "Outcome",
+}
+
+// Code returns the code value.
+func (c ConditionQuestionnairePurpose) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the ConditionQuestionnairePurpose values.
+func (c ConditionQuestionnairePurpose) IsValid() bool {
+	_, ok := conditionQuestionnairePurposeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c ConditionQuestionnairePurpose) Display() string {
+	if display := conditionQuestionnairePurposeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseConditionQuestionnairePurpose returns the ConditionQuestionnairePurpose for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseConditionQuestionnairePurpose(s string) (ConditionQuestionnairePurpose, error) {
+	return parseCode(s, conditionQuestionnairePurposeDisplays)
+}
+
 // ConditionalDeleteStatus represents Conditional Delete Status.
 type ConditionalDeleteStatus string
 
@@ -1108,6 +3004,38 @@ const (
 	ConditionalDeleteStatusMultiple ConditionalDeleteStatus = "multiple"
 )
 
+// conditionalDeleteStatusDisplays maps each ConditionalDeleteStatus code to its display name.
+var conditionalDeleteStatusDisplays = map[ConditionalDeleteStatus]string{
+	ConditionalDeleteStatusNotSupported: "Not Supported",
+	ConditionalDeleteStatusSingle:       "Single Deletes Supported",
+	ConditionalDeleteStatusMultiple:     "Multiple Deletes Supported",
+}
+
+// Code returns the code value.
+func (c ConditionalDeleteStatus) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the ConditionalDeleteStatus values.
+func (c ConditionalDeleteStatus) IsValid() bool {
+	_, ok := conditionalDeleteStatusDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c ConditionalDeleteStatus) Display() string {
+	if display := conditionalDeleteStatusDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseConditionalDeleteStatus returns the ConditionalDeleteStatus for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseConditionalDeleteStatus(s string) (ConditionalDeleteStatus, error) {
+	return parseCode(s, conditionalDeleteStatusDisplays)
+}
+
 // ConditionalReadStatus represents Conditional Read Status.
 type ConditionalReadStatus string
 
@@ -1123,6 +3051,39 @@ const (
 	ConditionalReadStatusFullSupport ConditionalReadStatus = "full-support"
 )
 
+// conditionalReadStatusDisplays maps each ConditionalReadStatus code to its display name.
+var conditionalReadStatusDisplays = map[ConditionalReadStatus]string{
+	ConditionalReadStatusNotSupported:  "Not Supported",
+	ConditionalReadStatusModifiedSince: "If-Modified-Since",
+	ConditionalReadStatusNotMatch:      "If-None-Match",
+	ConditionalReadStatusFullSupport:   "Full Support",
+}
+
+// Code returns the code value.
+func (c ConditionalReadStatus) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the ConditionalReadStatus values.
+func (c ConditionalReadStatus) IsValid() bool {
+	_, ok := conditionalReadStatusDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c ConditionalReadStatus) Display() string {
+	if display := conditionalReadStatusDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseConditionalReadStatus returns the ConditionalReadStatus for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseConditionalReadStatus(s string) (ConditionalReadStatus, error) {
+	return parseCode(s, conditionalReadStatusDisplays)
+}
+
 // ConformanceExpectation represents Conformance Expectation.
 type ConformanceExpectation string
 
@@ -1138,6 +3099,39 @@ const (
 	ConformanceExpectationShouldNot ConformanceExpectation = "SHOULD-NOT"
 )
 
+// conformanceExpectationDisplays maps each ConformanceExpectation code to its display name.
+var conformanceExpectationDisplays = map[ConformanceExpectation]string{
+	ConformanceExpectationShall:     "SHALL",
+	ConformanceExpectationShould:    "SHOULD",
+	ConformanceExpectationMay:       "MAY",
+	ConformanceExpectationShouldNot: "SHOULD-NOT",
+}
+
+// Code returns the code value.
+func (c ConformanceExpectation) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the ConformanceExpectation values.
+func (c ConformanceExpectation) IsValid() bool {
+	_, ok := conformanceExpectationDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c ConformanceExpectation) Display() string {
+	if display := conformanceExpectationDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseConformanceExpectation returns the ConformanceExpectation for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseConformanceExpectation(s string) (ConformanceExpectation, error) {
+	return parseCode(s, conformanceExpectationDisplays)
+}
+
 // ConsentDataMeaning represents Consent Data Meaning.
 type ConsentDataMeaning string
 
@@ -1153,6 +3147,39 @@ const (
 	ConsentDataMeaningAuthoredby ConsentDataMeaning = "authoredby"
 )
 
+// consentDataMeaningDisplays maps each ConsentDataMeaning code to its display name.
+var consentDataMeaningDisplays = map[ConsentDataMeaning]string{
+	ConsentDataMeaningInstance:   "Instance",
+	ConsentDataMeaningRelated:    "Related",
+	ConsentDataMeaningDependents: "Dependents",
+	ConsentDataMeaningAuthoredby: "AuthoredBy",
+}
+
+// Code returns the code value.
+func (c ConsentDataMeaning) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the ConsentDataMeaning values.
+func (c ConsentDataMeaning) IsValid() bool {
+	_, ok := consentDataMeaningDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c ConsentDataMeaning) Display() string {
+	if display := consentDataMeaningDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseConsentDataMeaning returns the ConsentDataMeaning for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseConsentDataMeaning(s string) (ConsentDataMeaning, error) {
+	return parseCode(s, consentDataMeaningDisplays)
+}
+
 // ConsentProvisionType represents Consent Provision Type.
 type ConsentProvisionType string
 
@@ -1164,6 +3191,37 @@ const (
 	ConsentProvisionTypePermit ConsentProvisionType = "permit"
 )
 
+// consentProvisionTypeDisplays maps each ConsentProvisionType code to its display name.
+var consentProvisionTypeDisplays = map[ConsentProvisionType]string{
+	ConsentProvisionTypeDeny:   "Deny",
+	ConsentProvisionTypePermit: "Permit",
+}
+
+// Code returns the code value.
+func (c ConsentProvisionType) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the ConsentProvisionType values.
+func (c ConsentProvisionType) IsValid() bool {
+	_, ok := consentProvisionTypeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c ConsentProvisionType) Display() string {
+	if display := consentProvisionTypeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseConsentProvisionType returns the ConsentProvisionType for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseConsentProvisionType(s string) (ConsentProvisionType, error) {
+	return parseCode(s, consentProvisionTypeDisplays)
+}
+
 // ConsentState represents Consent State.
 type ConsentState string
 
@@ -1183,6 +3241,41 @@ const (
 	ConsentStateUnknown ConsentState = "unknown"
 )
 
+// consentStateDisplays maps each ConsentState code to its display name.
+var consentStateDisplays = map[ConsentState]string{
+	ConsentStateDraft:          "Pending",
+	ConsentStateActive:         "Active",
+	ConsentStateInactive:       "Inactive",
+	ConsentStateNotDone:        "Abandoned",
+	ConsentStateEnteredInError: "Entered in Error",
+	ConsentStateUnknown:        "Unknown",
+}
+
+// Code returns the code value.
+func (c ConsentState) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the ConsentState values.
+func (c ConsentState) IsValid() bool {
+	_, ok := consentStateDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c ConsentState) Display() string {
+	if display := consentStateDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseConsentState returns the ConsentState for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseConsentState(s string) (ConsentState, error) {
+	return parseCode(s, consentStateDisplays)
+}
+
 // ConstraintSeverity represents ConstraintSeverity.
 type ConstraintSeverity string
 
@@ -1194,6 +3287,37 @@ const (
 	ConstraintSeverityWarning ConstraintSeverity = "warning"
 )
 
+// constraintSeverityDisplays maps each ConstraintSeverity code to its display name.
+var constraintSeverityDisplays = map[ConstraintSeverity]string{
+	ConstraintSeverityError:   "Error",
+	ConstraintSeverityWarning: "Warning",
+}
+
+// Code returns the code value.
+func (c ConstraintSeverity) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the ConstraintSeverity values.
+func (c ConstraintSeverity) IsValid() bool {
+	_, ok := constraintSeverityDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c ConstraintSeverity) Display() string {
+	if display := constraintSeverityDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseConstraintSeverity returns the ConstraintSeverity for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseConstraintSeverity(s string) (ConstraintSeverity, error) {
+	return parseCode(s, constraintSeverityDisplays)
+}
+
 // ContactPointSystem represents ContactPointSystem.
 type ContactPointSystem string
 
@@ -1215,6 +3339,42 @@ const (
 	ContactPointSystemOther ContactPointSystem = "other"
 )
 
+// contactPointSystemDisplays maps each ContactPointSystem code to its display name.
+var contactPointSystemDisplays = map[ContactPointSystem]string{
+	ContactPointSystemPhone: "Phone",
+	ContactPointSystemFax:   "Fax",
+	ContactPointSystemEmail: "Email",
+	ContactPointSystemPager: "Pager",
+	ContactPointSystemUrl:   "URL",
+	ContactPointSystemSms:   "SMS",
+	ContactPointSystemOther: "Other",
+}
+
+// Code returns the code value.
+func (c ContactPointSystem) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the ContactPointSystem values.
+func (c ContactPointSystem) IsValid() bool {
+	_, ok := contactPointSystemDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c ContactPointSystem) Display() string {
+	if display := contactPointSystemDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseContactPointSystem returns the ContactPointSystem for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseContactPointSystem(s string) (ContactPointSystem, error) {
+	return parseCode(s, contactPointSystemDisplays)
+}
+
 // ContactPointUse represents ContactPointUse.
 type ContactPointUse string
 
@@ -1232,6 +3392,40 @@ const (
 	ContactPointUseMobile ContactPointUse = "mobile"
 )
 
+// contactPointUseDisplays maps each ContactPointUse code to its display name.
+var contactPointUseDisplays = map[ContactPointUse]string{
+	ContactPointUseHome:   "Home",
+	ContactPointUseWork:   "Work",
+	ContactPointUseTemp:   "Temp",
+	ContactPointUseOld:    "Old",
+	ContactPointUseMobile: "Mobile",
+}
+
+// Code returns the code value.
+func (c ContactPointUse) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the ContactPointUse values.
+func (c ContactPointUse) IsValid() bool {
+	_, ok := contactPointUseDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c ContactPointUse) Display() string {
+	if display := contactPointUseDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseContactPointUse returns the ContactPointUse for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseContactPointUse(s string) (ContactPointUse, error) {
+	return parseCode(s, contactPointUseDisplays)
+}
+
 // ContractResourcePublicationStatusCodes represents Contract Resource Publication Status codes.
 type ContractResourcePublicationStatusCodes string
 
@@ -1269,6 +3463,50 @@ const (
 	ContractResourcePublicationStatusCodesTerminated ContractResourcePublicationStatusCodes = "terminated"
 )
 
+// contractResourcePublicationStatusCodesDisplays maps each ContractResourcePublicationStatusCodes code to its display name.
+var contractResourcePublicationStatusCodesDisplays = map[ContractResourcePublicationStatusCodes]string{
+	ContractResourcePublicationStatusCodesAmended:        "Amended",
+	ContractResourcePublicationStatusCodesAppended:       "Appended",
+	ContractResourcePublicationStatusCodesCancelled:      "Cancelled",
+	ContractResourcePublicationStatusCodesDisputed:       "Disputed",
+	ContractResourcePublicationStatusCodesEnteredInError: "Entered in Error",
+	ContractResourcePublicationStatusCodesExecutable:     "Executable",
+	ContractResourcePublicationStatusCodesExecuted:       "Executed",
+	ContractResourcePublicationStatusCodesNegotiable:     "Negotiable",
+	ContractResourcePublicationStatusCodesOffered:        "Offered",
+	ContractResourcePublicationStatusCodesPolicy:         "Policy",
+	ContractResourcePublicationStatusCodesRejected:       "Rejected",
+	ContractResourcePublicationStatusCodesRenewed:        "Renewed",
+	ContractResourcePublicationStatusCodesRevoked:        "Revoked",
+	ContractResourcePublicationStatusCodesResolved:       "Resolved",
+	ContractResourcePublicationStatusCodesTerminated:     "Terminated",
+}
+
+// Code returns the code value.
+func (c ContractResourcePublicationStatusCodes) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the ContractResourcePublicationStatusCodes values.
+func (c ContractResourcePublicationStatusCodes) IsValid() bool {
+	_, ok := contractResourcePublicationStatusCodesDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c ContractResourcePublicationStatusCodes) Display() string {
+	if display := contractResourcePublicationStatusCodesDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseContractResourcePublicationStatusCodes returns the ContractResourcePublicationStatusCodes for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseContractResourcePublicationStatusCodes(s string) (ContractResourcePublicationStatusCodes, error) {
+	return parseCode(s, contractResourcePublicationStatusCodesDisplays)
+}
+
 // ContractResourceStatusCodes represents Contract Resource Status Codes.
 type ContractResourceStatusCodes string
 
@@ -1306,6 +3544,50 @@ const (
 	ContractResourceStatusCodesTerminated ContractResourceStatusCodes = "terminated"
 )
 
+// contractResourceStatusCodesDisplays maps each ContractResourceStatusCodes code to its display name.
+var contractResourceStatusCodesDisplays = map[ContractResourceStatusCodes]string{
+	ContractResourceStatusCodesAmended:        "Amended",
+	ContractResourceStatusCodesAppended:       "Appended",
+	ContractResourceStatusCodesCancelled:      "Cancelled",
+	ContractResourceStatusCodesDisputed:       "Disputed",
+	ContractResourceStatusCodesEnteredInError: "Entered in Error",
+	ContractResourceStatusCodesExecutable:     "Executable",
+	ContractResourceStatusCodesExecuted:       "Executed",
+	ContractResourceStatusCodesNegotiable:     "Negotiable",
+	ContractResourceStatusCodesOffered:        "Offered",
+	ContractResourceStatusCodesPolicy:         "Policy",
+	ContractResourceStatusCodesRejected:       "Rejected",
+	ContractResourceStatusCodesRenewed:        "Renewed",
+	ContractResourceStatusCodesRevoked:        "Revoked",
+	ContractResourceStatusCodesResolved:       "Resolved",
+	ContractResourceStatusCodesTerminated:     "Terminated",
+}
+
+// Code returns the code value.
+func (c ContractResourceStatusCodes) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the ContractResourceStatusCodes values.
+func (c ContractResourceStatusCodes) IsValid() bool {
+	_, ok := contractResourceStatusCodesDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c ContractResourceStatusCodes) Display() string {
+	if display := contractResourceStatusCodesDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseContractResourceStatusCodes returns the ContractResourceStatusCodes for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseContractResourceStatusCodes(s string) (ContractResourceStatusCodes, error) {
+	return parseCode(s, contractResourceStatusCodesDisplays)
+}
+
 // ContributorType represents ContributorType.
 type ContributorType string
 
@@ -1321,6 +3603,39 @@ const (
 	ContributorTypeEndorser ContributorType = "endorser"
 )
 
+// contributorTypeDisplays maps each ContributorType code to its display name.
+var contributorTypeDisplays = map[ContributorType]string{
+	ContributorTypeAuthor:   "Author",
+	ContributorTypeEditor:   "Editor",
+	ContributorTypeReviewer: "Reviewer",
+	ContributorTypeEndorser: "Endorser",
+}
+
+// Code returns the code value.
+func (c ContributorType) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the ContributorType values.
+func (c ContributorType) IsValid() bool {
+	_, ok := contributorTypeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c ContributorType) Display() string {
+	if display := contributorTypeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseContributorType returns the ContributorType for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseContributorType(s string) (ContributorType, error) {
+	return parseCode(s, contributorTypeDisplays)
+}
+
 // Kind represents Kind.
 type Kind string
 
@@ -1334,6 +3649,38 @@ const (
 	KindOther Kind = "other"
 )
 
+// kindDisplays maps each Kind code to its display name.
+var kindDisplays = map[Kind]string{
+	KindInsurance: "Insurance",
+	KindSelfPay:   "Self-pay",
+	KindOther:     "Other",
+}
+
+// Code returns the code value.
+func (c Kind) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the Kind values.
+func (c Kind) IsValid() bool {
+	_, ok := kindDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c Kind) Display() string {
+	if display := kindDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseKind returns the Kind for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseKind(s string) (Kind, error) {
+	return parseCode(s, kindDisplays)
+}
+
 // DaysOfWeek represents Days Of Week.
 type DaysOfWeek string
 
@@ -1355,6 +3702,42 @@ const (
 	DaysOfWeekSun DaysOfWeek = "sun"
 )
 
+// daysOfWeekDisplays maps each DaysOfWeek code to its display name.
+var daysOfWeekDisplays = map[DaysOfWeek]string{
+	DaysOfWeekMon: "Monday",
+	DaysOfWeekTue: "Tuesday",
+	DaysOfWeekWed: "Wednesday",
+	DaysOfWeekThu: "Thursday",
+	DaysOfWeekFri: "Friday",
+	DaysOfWeekSat: "Saturday",
+	DaysOfWeekSun: "Sunday",
+}
+
+// Code returns the code value.
+func (c DaysOfWeek) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the DaysOfWeek values.
+func (c DaysOfWeek) IsValid() bool {
+	_, ok := daysOfWeekDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c DaysOfWeek) Display() string {
+	if display := daysOfWeekDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseDaysOfWeek returns the DaysOfWeek for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseDaysOfWeek(s string) (DaysOfWeek, error) {
+	return parseCode(s, daysOfWeekDisplays)
+}
+
 // DetectedIssueSeverity represents Detected Issue Severity.
 type DetectedIssueSeverity string
 
@@ -1368,6 +3751,38 @@ const (
 	DetectedIssueSeverityLow DetectedIssueSeverity = "low"
 )
 
+// detectedIssueSeverityDisplays maps each DetectedIssueSeverity code to its display name.
+var detectedIssueSeverityDisplays = map[DetectedIssueSeverity]string{
+	DetectedIssueSeverityHigh:     "High",
+	DetectedIssueSeverityModerate: "Moderate",
+	DetectedIssueSeverityLow:      "Low",
+}
+
+// Code returns the code value.
+func (c DetectedIssueSeverity) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the DetectedIssueSeverity values.
+func (c DetectedIssueSeverity) IsValid() bool {
+	_, ok := detectedIssueSeverityDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c DetectedIssueSeverity) Display() string {
+	if display := detectedIssueSeverityDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseDetectedIssueSeverity returns the DetectedIssueSeverity for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseDetectedIssueSeverity(s string) (DetectedIssueSeverity, error) {
+	return parseCode(s, detectedIssueSeverityDisplays)
+}
+
 // DetectedIssueStatus represents Detected Issue Status.
 type DetectedIssueStatus string
 
@@ -1383,6 +3798,39 @@ const (
 	DetectedIssueStatusMitigated DetectedIssueStatus = "mitigated"
 )
 
+// detectedIssueStatusDisplays maps each DetectedIssueStatus code to its display name.
+var detectedIssueStatusDisplays = map[DetectedIssueStatus]string{
+	DetectedIssueStatusPreliminary:    "Preliminary",
+	DetectedIssueStatusFinal:          "Final",
+	DetectedIssueStatusEnteredInError: "Entered in Error",
+	DetectedIssueStatusMitigated:      "Mitigated",
+}
+
+// Code returns the code value.
+func (c DetectedIssueStatus) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the DetectedIssueStatus values.
+func (c DetectedIssueStatus) IsValid() bool {
+	_, ok := detectedIssueStatusDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c DetectedIssueStatus) Display() string {
+	if display := detectedIssueStatusDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseDetectedIssueStatus returns the DetectedIssueStatus for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseDetectedIssueStatus(s string) (DetectedIssueStatus, error) {
+	return parseCode(s, detectedIssueStatusDisplays)
+}
+
 // DeviceCorrectiveActionScope represents Device Corrective Action Scope.
 type DeviceCorrectiveActionScope string
 
@@ -1396,6 +3844,38 @@ const (
 	DeviceCorrectiveActionScopeSerialNumbers DeviceCorrectiveActionScope = "serial-numbers"
 )
 
+// deviceCorrectiveActionScopeDisplays maps each DeviceCorrectiveActionScope code to its display name.
+var deviceCorrectiveActionScopeDisplays = map[DeviceCorrectiveActionScope]string{
+	DeviceCorrectiveActionScopeModel:         "Model",
+	DeviceCorrectiveActionScopeLotNumbers:    "Lot Numbers",
+	DeviceCorrectiveActionScopeSerialNumbers: "Serial Numbers",
+}
+
+// Code returns the code value.
+func (c DeviceCorrectiveActionScope) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the DeviceCorrectiveActionScope values.
+func (c DeviceCorrectiveActionScope) IsValid() bool {
+	_, ok := deviceCorrectiveActionScopeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c DeviceCorrectiveActionScope) Display() string {
+	if display := deviceCorrectiveActionScopeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseDeviceCorrectiveActionScope returns the DeviceCorrectiveActionScope for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseDeviceCorrectiveActionScope(s string) (DeviceCorrectiveActionScope, error) {
+	return parseCode(s, deviceCorrectiveActionScopeDisplays)
+}
+
 // DeviceNameType represents Device Name Type.
 type DeviceNameType string
 
@@ -1409,6 +3889,38 @@ const (
 	DeviceNameTypePatientReportedName DeviceNameType = "patient-reported-name"
 )
 
+// deviceNameTypeDisplays maps each DeviceNameType code to its display name.
+var deviceNameTypeDisplays = map[DeviceNameType]string{
+	DeviceNameTypeRegisteredName:      "Registered name",
+	DeviceNameTypeUserFriendlyName:    "User Friendly name",
+	DeviceNameTypePatientReportedName: "Patient Reported name",
+}
+
+// Code returns the code value.
+func (c DeviceNameType) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the DeviceNameType values.
+func (c DeviceNameType) IsValid() bool {
+	_, ok := deviceNameTypeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c DeviceNameType) Display() string {
+	if display := deviceNameTypeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseDeviceNameType returns the DeviceNameType for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseDeviceNameType(s string) (DeviceNameType, error) {
+	return parseCode(s, deviceNameTypeDisplays)
+}
+
 // DeviceProductionIdentifierInUDI represents Device Production Identifier In UDI.
 type DeviceProductionIdentifierInUDI string
 
@@ -1428,6 +3940,41 @@ const (
 	DeviceProductionIdentifierInUDISoftwareVersion DeviceProductionIdentifierInUDI = "software-version"
 )
 
+// deviceProductionIdentifierInUDIDisplays maps each DeviceProductionIdentifierInUDI code to its display name.
+var deviceProductionIdentifierInUDIDisplays = map[DeviceProductionIdentifierInUDI]string{
+	DeviceProductionIdentifierInUDILotNumber:        "Lot Number",
+	DeviceProductionIdentifierInUDIManufacturedDate: "Manufactured date",
+	DeviceProductionIdentifierInUDISerialNumber:     "Serial Number",
+	DeviceProductionIdentifierInUDIExpirationDate:   "Expiration date",
+	DeviceProductionIdentifierInUDIBiologicalSource: "Biological source",
+	DeviceProductionIdentifierInUDISoftwareVersion:  "Software Version",
+}
+
+// Code returns the code value.
+func (c DeviceProductionIdentifierInUDI) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the DeviceProductionIdentifierInUDI values.
+func (c DeviceProductionIdentifierInUDI) IsValid() bool {
+	_, ok := deviceProductionIdentifierInUDIDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c DeviceProductionIdentifierInUDI) Display() string {
+	if display := deviceProductionIdentifierInUDIDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseDeviceProductionIdentifierInUDI returns the DeviceProductionIdentifierInUDI for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseDeviceProductionIdentifierInUDI(s string) (DeviceProductionIdentifierInUDI, error) {
+	return parseCode(s, deviceProductionIdentifierInUDIDisplays)
+}
+
 // FHIRDeviceStatus represents FHIR Device Status.
 type FHIRDeviceStatus string
 
@@ -1441,6 +3988,38 @@ const (
 	FHIRDeviceStatusEnteredInError FHIRDeviceStatus = "entered-in-error"
 )
 
+// fHIRDeviceStatusDisplays maps each FHIRDeviceStatus code to its display name.
+var fHIRDeviceStatusDisplays = map[FHIRDeviceStatus]string{
+	FHIRDeviceStatusActive:         "Active",
+	FHIRDeviceStatusInactive:       "Inactive",
+	FHIRDeviceStatusEnteredInError: "Entered in Error",
+}
+
+// Code returns the code value.
+func (c FHIRDeviceStatus) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the FHIRDeviceStatus values.
+func (c FHIRDeviceStatus) IsValid() bool {
+	_, ok := fHIRDeviceStatusDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c FHIRDeviceStatus) Display() string {
+	if display := fHIRDeviceStatusDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseFHIRDeviceStatus returns the FHIRDeviceStatus for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseFHIRDeviceStatus(s string) (FHIRDeviceStatus, error) {
+	return parseCode(s, fHIRDeviceStatusDisplays)
+}
+
 // DeviceDefinitionRegulatoryIdentifierType represents Device Definition Regulatory Identifier Type.
 type DeviceDefinitionRegulatoryIdentifierType string
 
@@ -1454,6 +4033,38 @@ const (
 	DeviceDefinitionRegulatoryIdentifierTypeLicense DeviceDefinitionRegulatoryIdentifierType = "license"
 )
 
+// deviceDefinitionRegulatoryIdentifierTypeDisplays maps each DeviceDefinitionRegulatoryIdentifierType code to its display name.
+var deviceDefinitionRegulatoryIdentifierTypeDisplays = map[DeviceDefinitionRegulatoryIdentifierType]string{
+	DeviceDefinitionRegulatoryIdentifierTypeBasic:   "Basic",
+	DeviceDefinitionRegulatoryIdentifierTypeMaster:  "Master",
+	DeviceDefinitionRegulatoryIdentifierTypeLicense: "License",
+}
+
+// Code returns the code value.
+func (c DeviceDefinitionRegulatoryIdentifierType) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the DeviceDefinitionRegulatoryIdentifierType values.
+func (c DeviceDefinitionRegulatoryIdentifierType) IsValid() bool {
+	_, ok := deviceDefinitionRegulatoryIdentifierTypeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c DeviceDefinitionRegulatoryIdentifierType) Display() string {
+	if display := deviceDefinitionRegulatoryIdentifierTypeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseDeviceDefinitionRegulatoryIdentifierType returns the DeviceDefinitionRegulatoryIdentifierType for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseDeviceDefinitionRegulatoryIdentifierType(s string) (DeviceDefinitionRegulatoryIdentifierType, error) {
+	return parseCode(s, deviceDefinitionRegulatoryIdentifierTypeDisplays)
+}
+
 // DeviceDispenseStatusCodes represents DeviceDispense Status Codes.
 type DeviceDispenseStatusCodes string
 
@@ -1479,6 +4090,44 @@ const (
 	DeviceDispenseStatusCodesUnknown DeviceDispenseStatusCodes = "unknown"
 )
 
+// deviceDispenseStatusCodesDisplays maps each DeviceDispenseStatusCodes code to its display name.
+var deviceDispenseStatusCodesDisplays = map[DeviceDispenseStatusCodes]string{
+	DeviceDispenseStatusCodesPreparation:    "Preparation",
+	DeviceDispenseStatusCodesInProgress:     "In Progress",
+	DeviceDispenseStatusCodesCancelled:      "Cancelled",
+	DeviceDispenseStatusCodesOnHold:         "On Hold",
+	DeviceDispenseStatusCodesCompleted:      "Completed",
+	DeviceDispenseStatusCodesEnteredInError: "Entered in Error",
+	DeviceDispenseStatusCodesStopped:        "Stopped",
+	DeviceDispenseStatusCodesDeclined:       "Declined",
+	DeviceDispenseStatusCodesUnknown:        "Unknown",
+}
+
+// Code returns the code value.
+func (c DeviceDispenseStatusCodes) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the DeviceDispenseStatusCodes values.
+func (c DeviceDispenseStatusCodes) IsValid() bool {
+	_, ok := deviceDispenseStatusCodesDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c DeviceDispenseStatusCodes) Display() string {
+	if display := deviceDispenseStatusCodesDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseDeviceDispenseStatusCodes returns the DeviceDispenseStatusCodes for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseDeviceDispenseStatusCodes(s string) (DeviceDispenseStatusCodes, error) {
+	return parseCode(s, deviceDispenseStatusCodesDisplays)
+}
+
 // DeviceUsageStatus represents Device Usage Status.
 type DeviceUsageStatus string
 
@@ -1500,6 +4149,42 @@ const (
 	DeviceUsageStatusOnHold DeviceUsageStatus = "on-hold"
 )
 
+// deviceUsageStatusDisplays maps each DeviceUsageStatus code to its display name.
+var deviceUsageStatusDisplays = map[DeviceUsageStatus]string{
+	DeviceUsageStatusActive:         "Active",
+	DeviceUsageStatusCompleted:      "Completed",
+	DeviceUsageStatusNotDone:        "Not done",
+	DeviceUsageStatusEnteredInError: "Entered in Error",
+	DeviceUsageStatusIntended:       "Intended",
+	DeviceUsageStatusStopped:        "Stopped",
+	DeviceUsageStatusOnHold:         "On Hold",
+}
+
+// Code returns the code value.
+func (c DeviceUsageStatus) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the DeviceUsageStatus values.
+func (c DeviceUsageStatus) IsValid() bool {
+	_, ok := deviceUsageStatusDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c DeviceUsageStatus) Display() string {
+	if display := deviceUsageStatusDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseDeviceUsageStatus returns the DeviceUsageStatus for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseDeviceUsageStatus(s string) (DeviceUsageStatus, error) {
+	return parseCode(s, deviceUsageStatusDisplays)
+}
+
 // DiagnosticReportStatus represents Diagnostic Report Status.
 type DiagnosticReportStatus string
 
@@ -1529,6 +4214,46 @@ const (
 	DiagnosticReportStatusUnknown DiagnosticReportStatus = "unknown"
 )
 
+// diagnosticReportStatusDisplays maps each DiagnosticReportStatus code to its display name.
+var diagnosticReportStatusDisplays = map[DiagnosticReportStatus]string{
+	DiagnosticReportStatusRegistered:     "Registered",
+	DiagnosticReportStatusPartial:        "Partial",
+	DiagnosticReportStatusPreliminary:    "Preliminary",
+	DiagnosticReportStatusModified:       "Modified",
+	DiagnosticReportStatusFinal:          "Final",
+	DiagnosticReportStatusAmended:        "Amended",
+	DiagnosticReportStatusCorrected:      "Corrected",
+	DiagnosticReportStatusAppended:       "Appended",
+	DiagnosticReportStatusCancelled:      "Cancelled",
+	DiagnosticReportStatusEnteredInError: "Entered in Error",
+	DiagnosticReportStatusUnknown:        "Unknown",
+}
+
+// Code returns the code value.
+func (c DiagnosticReportStatus) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the DiagnosticReportStatus values.
+func (c DiagnosticReportStatus) IsValid() bool {
+	_, ok := diagnosticReportStatusDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c DiagnosticReportStatus) Display() string {
+	if display := diagnosticReportStatusDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseDiagnosticReportStatus returns the DiagnosticReportStatus for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseDiagnosticReportStatus(s string) (DiagnosticReportStatus, error) {
+	return parseCode(s, diagnosticReportStatusDisplays)
+}
+
 // DiscriminatorType represents DiscriminatorType.
 type DiscriminatorType string
 
@@ -1548,6 +4273,41 @@ const (
 	DiscriminatorTypePosition DiscriminatorType = "position"
 )
 
+// discriminatorTypeDisplays maps each DiscriminatorType code to its display name.
+var discriminatorTypeDisplays = map[DiscriminatorType]string{
+	DiscriminatorTypeValue:    "Value",
+	DiscriminatorTypeExists:   "Exists",
+	DiscriminatorTypePattern:  "Pattern",
+	DiscriminatorTypeType:     "Type",
+	DiscriminatorTypeProfile:  "Profile",
+	DiscriminatorTypePosition: "Position",
+}
+
+// Code returns the code value.
+func (c DiscriminatorType) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the DiscriminatorType values.
+func (c DiscriminatorType) IsValid() bool {
+	_, ok := discriminatorTypeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c DiscriminatorType) Display() string {
+	if display := discriminatorTypeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseDiscriminatorType returns the DiscriminatorType for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseDiscriminatorType(s string) (DiscriminatorType, error) {
+	return parseCode(s, discriminatorTypeDisplays)
+}
+
 // DocumentMode represents Document Mode.
 type DocumentMode string
 
@@ -1559,6 +4319,37 @@ const (
 	DocumentModeConsumer DocumentMode = "consumer"
 )
 
+// documentModeDisplays maps each DocumentMode code to its display name.
+var documentModeDisplays = map[DocumentMode]string{
+	DocumentModeProducer: "Producer",
+	DocumentModeConsumer: "Consumer",
+}
+
+// Code returns the code value.
+func (c DocumentMode) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the DocumentMode values.
+func (c DocumentMode) IsValid() bool {
+	_, ok := documentModeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c DocumentMode) Display() string {
+	if display := documentModeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseDocumentMode returns the DocumentMode for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseDocumentMode(s string) (DocumentMode, error) {
+	return parseCode(s, documentModeDisplays)
+}
+
 // DocumentReferenceStatus represents DocumentReferenceStatus.
 type DocumentReferenceStatus string
 
@@ -1572,6 +4363,38 @@ const (
 	DocumentReferenceStatusEnteredInError DocumentReferenceStatus = "entered-in-error"
 )
 
+// documentReferenceStatusDisplays maps each DocumentReferenceStatus code to its display name.
+var documentReferenceStatusDisplays = map[DocumentReferenceStatus]string{
+	DocumentReferenceStatusCurrent:        "Current",
+	DocumentReferenceStatusSuperseded:     "Superseded",
+	DocumentReferenceStatusEnteredInError: "Entered in Error",
+}
+
+// Code returns the code value.
+func (c DocumentReferenceStatus) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the DocumentReferenceStatus values.
+func (c DocumentReferenceStatus) IsValid() bool {
+	_, ok := documentReferenceStatusDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c DocumentReferenceStatus) Display() string {
+	if display := documentReferenceStatusDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseDocumentReferenceStatus returns the DocumentReferenceStatus for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseDocumentReferenceStatus(s string) (DocumentReferenceStatus, error) {
+	return parseCode(s, documentReferenceStatusDisplays)
+}
+
 // EligibilityOutcome represents Eligibility Outcome.
 type EligibilityOutcome string
 
@@ -1587,6 +4410,39 @@ const (
 	EligibilityOutcomePartial EligibilityOutcome = "partial"
 )
 
+// eligibilityOutcomeDisplays maps each EligibilityOutcome code to its display name.
+var eligibilityOutcomeDisplays = map[EligibilityOutcome]string{
+	EligibilityOutcomeQueued:   "Queued",
+	EligibilityOutcomeComplete: "Processing Complete",
+	EligibilityOutcomeError:    "Error",
+	EligibilityOutcomePartial:  "Partial Processing",
+}
+
+// Code returns the code value.
+func (c EligibilityOutcome) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the EligibilityOutcome values.
+func (c EligibilityOutcome) IsValid() bool {
+	_, ok := eligibilityOutcomeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c EligibilityOutcome) Display() string {
+	if display := eligibilityOutcomeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseEligibilityOutcome returns the EligibilityOutcome for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseEligibilityOutcome(s string) (EligibilityOutcome, error) {
+	return parseCode(s, eligibilityOutcomeDisplays)
+}
+
 // EligibilityRequestPurpose represents Eligibility Request Purpose.
 type EligibilityRequestPurpose string
 
@@ -1602,6 +4458,39 @@ const (
 	EligibilityRequestPurposeValidation EligibilityRequestPurpose = "validation"
 )
 
+// eligibilityRequestPurposeDisplays maps each EligibilityRequestPurpose code to its display name.
+var eligibilityRequestPurposeDisplays = map[EligibilityRequestPurpose]string{
+	EligibilityRequestPurposeAuthRequirements: "Coverage auth-requirements",
+	EligibilityRequestPurposeBenefits:         "Coverage benefits",
+	EligibilityRequestPurposeDiscovery:        "Coverage Discovery",
+	EligibilityRequestPurposeValidation:       "Coverage Validation",
+}
+
+// Code returns the code value.
+func (c EligibilityRequestPurpose) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the EligibilityRequestPurpose values.
+func (c EligibilityRequestPurpose) IsValid() bool {
+	_, ok := eligibilityRequestPurposeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c EligibilityRequestPurpose) Display() string {
+	if display := eligibilityRequestPurposeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseEligibilityRequestPurpose returns the EligibilityRequestPurpose for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseEligibilityRequestPurpose(s string) (EligibilityRequestPurpose, error) {
+	return parseCode(s, eligibilityRequestPurposeDisplays)
+}
+
 // EligibilityResponsePurpose represents Eligibility Response Purpose.
 type EligibilityResponsePurpose string
 
@@ -1617,6 +4506,39 @@ const (
 	EligibilityResponsePurposeValidation EligibilityResponsePurpose = "validation"
 )
 
+// eligibilityResponsePurposeDisplays maps each EligibilityResponsePurpose code to its display name.
+var eligibilityResponsePurposeDisplays = map[EligibilityResponsePurpose]string{
+	EligibilityResponsePurposeAuthRequirements: "Coverage auth-requirements",
+	EligibilityResponsePurposeBenefits:         "Coverage benefits",
+	EligibilityResponsePurposeDiscovery:        "Coverage Discovery",
+	EligibilityResponsePurposeValidation:       "Coverage Validation",
+}
+
+// Code returns the code value.
+func (c EligibilityResponsePurpose) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the EligibilityResponsePurpose values.
+func (c EligibilityResponsePurpose) IsValid() bool {
+	_, ok := eligibilityResponsePurposeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c EligibilityResponsePurpose) Display() string {
+	if display := eligibilityResponsePurposeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseEligibilityResponsePurpose returns the EligibilityResponsePurpose for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseEligibilityResponsePurpose(s string) (EligibilityResponsePurpose, error) {
+	return parseCode(s, eligibilityResponsePurposeDisplays)
+}
+
 // EncounterLocationStatus represents Encounter Location Status.
 type EncounterLocationStatus string
 
@@ -1632,6 +4554,39 @@ const (
 	EncounterLocationStatusCompleted EncounterLocationStatus = "completed"
 )
 
+// encounterLocationStatusDisplays maps each EncounterLocationStatus code to its display name.
+var encounterLocationStatusDisplays = map[EncounterLocationStatus]string{
+	EncounterLocationStatusPlanned:   "Planned",
+	EncounterLocationStatusActive:    "Active",
+	EncounterLocationStatusReserved:  "Reserved",
+	EncounterLocationStatusCompleted: "Completed",
+}
+
+// Code returns the code value.
+func (c EncounterLocationStatus) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the EncounterLocationStatus values.
+func (c EncounterLocationStatus) IsValid() bool {
+	_, ok := encounterLocationStatusDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c EncounterLocationStatus) Display() string {
+	if display := encounterLocationStatusDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseEncounterLocationStatus returns the EncounterLocationStatus for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseEncounterLocationStatus(s string) (EncounterLocationStatus, error) {
+	return parseCode(s, encounterLocationStatusDisplays)
+}
+
 // EncounterStatus represents Encounter Status.
 type EncounterStatus string
 
@@ -1657,6 +4612,44 @@ const (
 	EncounterStatusUnknown EncounterStatus = "unknown"
 )
 
+// encounterStatusDisplays maps each EncounterStatus code to its display name.
+var encounterStatusDisplays = map[EncounterStatus]string{
+	EncounterStatusPlanned:        "Planned",
+	EncounterStatusInProgress:     "In Progress",
+	EncounterStatusOnHold:         "On Hold",
+	EncounterStatusDischarged:     "Discharged",
+	EncounterStatusCompleted:      "Completed",
+	EncounterStatusCancelled:      "Cancelled",
+	EncounterStatusDiscontinued:   "Discontinued",
+	EncounterStatusEnteredInError: "Entered in Error",
+	EncounterStatusUnknown:        "Unknown",
+}
+
+// Code returns the code value.
+func (c EncounterStatus) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the EncounterStatus values.
+func (c EncounterStatus) IsValid() bool {
+	_, ok := encounterStatusDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c EncounterStatus) Display() string {
+	if display := encounterStatusDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseEncounterStatus returns the EncounterStatus for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseEncounterStatus(s string) (EncounterStatus, error) {
+	return parseCode(s, encounterStatusDisplays)
+}
+
 // EndpointStatus represents Endpoint Status.
 type EndpointStatus string
 
@@ -1674,6 +4667,40 @@ const (
 	EndpointStatusEnteredInError EndpointStatus = "entered-in-error"
 )
 
+// endpointStatusDisplays maps each EndpointStatus code to its display name.
+var endpointStatusDisplays = map[EndpointStatus]string{
+	EndpointStatusActive:         "Active",
+	EndpointStatusSuspended:      "Suspended",
+	EndpointStatusError:          "Error",
+	EndpointStatusOff:            "Off",
+	EndpointStatusEnteredInError: "Entered in error",
+}
+
+// Code returns the code value.
+func (c EndpointStatus) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the EndpointStatus values.
+func (c EndpointStatus) IsValid() bool {
+	_, ok := endpointStatusDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c EndpointStatus) Display() string {
+	if display := endpointStatusDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseEndpointStatus returns the EndpointStatus for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseEndpointStatus(s string) (EndpointStatus, error) {
+	return parseCode(s, endpointStatusDisplays)
+}
+
 // EnrollmentOutcome represents Enrollment Outcome.
 type EnrollmentOutcome string
 
@@ -1689,6 +4716,39 @@ const (
 	EnrollmentOutcomePartial EnrollmentOutcome = "partial"
 )
 
+// enrollmentOutcomeDisplays maps each EnrollmentOutcome code to its display name.
+var enrollmentOutcomeDisplays = map[EnrollmentOutcome]string{
+	EnrollmentOutcomeQueued:   "Queued",
+	EnrollmentOutcomeComplete: "Processing Complete",
+	EnrollmentOutcomeError:    "Error",
+	EnrollmentOutcomePartial:  "Partial Processing",
+}
+
+// Code returns the code value.
+func (c EnrollmentOutcome) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the EnrollmentOutcome values.
+func (c EnrollmentOutcome) IsValid() bool {
+	_, ok := enrollmentOutcomeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c EnrollmentOutcome) Display() string {
+	if display := enrollmentOutcomeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseEnrollmentOutcome returns the EnrollmentOutcome for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseEnrollmentOutcome(s string) (EnrollmentOutcome, error) {
+	return parseCode(s, enrollmentOutcomeDisplays)
+}
+
 // EpisodeOfCareStatus represents Episode Of Care Status.
 type EpisodeOfCareStatus string
 
@@ -1710,6 +4770,42 @@ const (
 	EpisodeOfCareStatusEnteredInError EpisodeOfCareStatus = "entered-in-error"
 )
 
+// episodeOfCareStatusDisplays maps each EpisodeOfCareStatus code to its display name.
+var episodeOfCareStatusDisplays = map[EpisodeOfCareStatus]string{
+	EpisodeOfCareStatusPlanned:        "Planned",
+	EpisodeOfCareStatusWaitlist:       "Waitlist",
+	EpisodeOfCareStatusActive:         "Active",
+	EpisodeOfCareStatusOnhold:         "On Hold",
+	EpisodeOfCareStatusFinished:       "Finished",
+	EpisodeOfCareStatusCancelled:      "Cancelled",
+	EpisodeOfCareStatusEnteredInError: "Entered in Error",
+}
+
+// Code returns the code value.
+func (c EpisodeOfCareStatus) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the EpisodeOfCareStatus values.
+func (c EpisodeOfCareStatus) IsValid() bool {
+	_, ok := episodeOfCareStatusDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c EpisodeOfCareStatus) Display() string {
+	if display := episodeOfCareStatusDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseEpisodeOfCareStatus returns the EpisodeOfCareStatus for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseEpisodeOfCareStatus(s string) (EpisodeOfCareStatus, error) {
+	return parseCode(s, episodeOfCareStatusDisplays)
+}
+
 // EventCapabilityMode represents Event Capability Mode.
 type EventCapabilityMode string
 
@@ -1721,6 +4817,37 @@ const (
 	EventCapabilityModeReceiver EventCapabilityMode = "receiver"
 )
 
+// eventCapabilityModeDisplays maps each EventCapabilityMode code to its display name.
+var eventCapabilityModeDisplays = map[EventCapabilityMode]string{
+	EventCapabilityModeSender:   "Sender",
+	EventCapabilityModeReceiver: "Receiver",
+}
+
+// Code returns the code value.
+func (c EventCapabilityMode) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the EventCapabilityMode values.
+func (c EventCapabilityMode) IsValid() bool {
+	_, ok := eventCapabilityModeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c EventCapabilityMode) Display() string {
+	if display := eventCapabilityModeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseEventCapabilityMode returns the EventCapabilityMode for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseEventCapabilityMode(s string) (EventCapabilityMode, error) {
+	return parseCode(s, eventCapabilityModeDisplays)
+}
+
 // EventStatus represents EventStatus.
 type EventStatus string
 
@@ -1744,6 +4871,43 @@ const (
 	EventStatusUnknown EventStatus = "unknown"
 )
 
+// eventStatusDisplays maps each EventStatus code to its display name.
+var eventStatusDisplays = map[EventStatus]string{
+	EventStatusPreparation:    "Preparation",
+	EventStatusInProgress:     "In Progress",
+	EventStatusNotDone:        "Not Done",
+	EventStatusOnHold:         "On Hold",
+	EventStatusStopped:        "Stopped",
+	EventStatusCompleted:      "Completed",
+	EventStatusEnteredInError: "Entered in Error",
+	EventStatusUnknown:        "Unknown",
+}
+
+// Code returns the code value.
+func (c EventStatus) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the EventStatus values.
+func (c EventStatus) IsValid() bool {
+	_, ok := eventStatusDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c EventStatus) Display() string {
+	if display := eventStatusDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseEventStatus returns the EventStatus for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseEventStatus(s string) (EventStatus, error) {
+	return parseCode(s, eventStatusDisplays)
+}
+
 // EventTiming represents EventTiming.
 type EventTiming string
 
@@ -1791,6 +4955,62 @@ const (
 	EventTimingPcv  EventTiming = "PCV"
 )
 
+// eventTimingDisplays maps each EventTiming code to its display name.
+var eventTimingDisplays = map[EventTiming]string{
+	EventTimingMorn:      "Morning",
+	EventTimingMornEarly: "Early Morning",
+	EventTimingMornLate:  "Late Morning",
+	EventTimingNoon:      "Noon",
+	EventTimingAft:       "Afternoon",
+	EventTimingAftEarly:  "Early Afternoon",
+	EventTimingAftLate:   "Late Afternoon",
+	EventTimingEve:       "Evening",
+	EventTimingEveEarly:  "Early Evening",
+	EventTimingEveLate:   "Late Evening",
+	EventTimingNight:     "Night",
+	EventTimingPhs:       "After Sleep",
+	EventTimingImd:       "Immediate",
+	EventTimingHs:        "",
+	EventTimingWake:      "",
+	EventTimingC:         "",
+	EventTimingCm:        "",
+	EventTimingCd:        "",
+	EventTimingCv:        "",
+	EventTimingAc:        "",
+	EventTimingAcm:       "",
+	EventTimingAcd:       "",
+	EventTimingAcv:       "",
+	EventTimingPc:        "",
+	EventTimingPcm:       "",
+	EventTimingPcd:       "",
+	EventTimingPcv:       "",
+}
+
+// Code returns the code value.
+func (c EventTiming) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the EventTiming values.
+func (c EventTiming) IsValid() bool {
+	_, ok := eventTimingDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c EventTiming) Display() string {
+	if display := eventTimingDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseEventTiming returns the EventTiming for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseEventTiming(s string) (EventTiming, error) {
+	return parseCode(s, eventTimingDisplays)
+}
+
 // ExampleScenarioActorType represents Example Scenario Actor Type.
 type ExampleScenarioActorType string
 
@@ -1802,6 +5022,37 @@ const (
 	ExampleScenarioActorTypeSystem ExampleScenarioActorType = "system"
 )
 
+// exampleScenarioActorTypeDisplays maps each ExampleScenarioActorType code to its display name.
+var exampleScenarioActorTypeDisplays = map[ExampleScenarioActorType]string{
+	ExampleScenarioActorTypePerson: "Person",
+	ExampleScenarioActorTypeSystem: "System",
+}
+
+// Code returns the code value.
+func (c ExampleScenarioActorType) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the ExampleScenarioActorType values.
+func (c ExampleScenarioActorType) IsValid() bool {
+	_, ok := exampleScenarioActorTypeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c ExampleScenarioActorType) Display() string {
+	if display := exampleScenarioActorTypeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseExampleScenarioActorType returns the ExampleScenarioActorType for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseExampleScenarioActorType(s string) (ExampleScenarioActorType, error) {
+	return parseCode(s, exampleScenarioActorTypeDisplays)
+}
+
 // ExplanationOfBenefitStatus represents Explanation Of Benefit Status.
 type ExplanationOfBenefitStatus string
 
@@ -1817,6 +5068,39 @@ const (
 	ExplanationOfBenefitStatusEnteredInError ExplanationOfBenefitStatus = "entered-in-error"
 )
 
+// explanationOfBenefitStatusDisplays maps each ExplanationOfBenefitStatus code to its display name.
+var explanationOfBenefitStatusDisplays = map[ExplanationOfBenefitStatus]string{
+	ExplanationOfBenefitStatusActive:         "Active",
+	ExplanationOfBenefitStatusCancelled:      "Cancelled",
+	ExplanationOfBenefitStatusDraft:          "Draft",
+	ExplanationOfBenefitStatusEnteredInError: "Entered In Error",
+}
+
+// Code returns the code value.
+func (c ExplanationOfBenefitStatus) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the ExplanationOfBenefitStatus values.
+func (c ExplanationOfBenefitStatus) IsValid() bool {
+	_, ok := explanationOfBenefitStatusDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c ExplanationOfBenefitStatus) Display() string {
+	if display := explanationOfBenefitStatusDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseExplanationOfBenefitStatus returns the ExplanationOfBenefitStatus for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseExplanationOfBenefitStatus(s string) (ExplanationOfBenefitStatus, error) {
+	return parseCode(s, explanationOfBenefitStatusDisplays)
+}
+
 // ExtensionContextType represents Extension Context Type.
 type ExtensionContextType string
 
@@ -1830,6 +5114,38 @@ const (
 	ExtensionContextTypeExtension ExtensionContextType = "extension"
 )
 
+// extensionContextTypeDisplays maps each ExtensionContextType code to its display name.
+var extensionContextTypeDisplays = map[ExtensionContextType]string{
+	ExtensionContextTypeFhirpath:  "FHIRPath",
+	ExtensionContextTypeElement:   "Element ID",
+	ExtensionContextTypeExtension: "Extension URL",
+}
+
+// Code returns the code value.
+func (c ExtensionContextType) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the ExtensionContextType values.
+func (c ExtensionContextType) IsValid() bool {
+	_, ok := extensionContextTypeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c ExtensionContextType) Display() string {
+	if display := extensionContextTypeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseExtensionContextType returns the ExtensionContextType for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseExtensionContextType(s string) (ExtensionContextType, error) {
+	return parseCode(s, extensionContextTypeDisplays)
+}
+
 // FilterOperator represents Filter Operator.
 type FilterOperator string
 
@@ -1859,6 +5175,46 @@ const (
 	FilterOperatorExists FilterOperator = "exists"
 )
 
+// filterOperatorDisplays maps each FilterOperator code to its display name.
+var filterOperatorDisplays = map[FilterOperator]string{
+	FilterOperatorEqual:          "Equals",
+	FilterOperatorIsA:            "Is A (by subsumption)",
+	FilterOperatorDescendentOf:   "Descendent Of (by subsumption)",
+	FilterOperatorIsNotA:         "Not (Is A) (by subsumption)",
+	FilterOperatorRegex:          "Regular Expression",
+	FilterOperatorIn:             "In Set",
+	FilterOperatorNotIn:          "Not in Set",
+	FilterOperatorGeneralizes:    "Generalizes (by Subsumption)",
+	FilterOperatorChildOf:        "Child Of",
+	FilterOperatorDescendentLeaf: "Descendent Leaf",
+	FilterOperatorExists:         "Exists",
+}
+
+// Code returns the code value.
+func (c FilterOperator) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the FilterOperator values.
+func (c FilterOperator) IsValid() bool {
+	_, ok := filterOperatorDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c FilterOperator) Display() string {
+	if display := filterOperatorDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseFilterOperator returns the FilterOperator for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseFilterOperator(s string) (FilterOperator, error) {
+	return parseCode(s, filterOperatorDisplays)
+}
+
 // FlagStatus represents Flag Status.
 type FlagStatus string
 
@@ -1872,6 +5228,38 @@ const (
 	FlagStatusEnteredInError FlagStatus = "entered-in-error"
 )
 
+// flagStatusDisplays maps each FlagStatus code to its display name.
+var flagStatusDisplays = map[FlagStatus]string{
+	FlagStatusActive:         "Active",
+	FlagStatusInactive:       "Inactive",
+	FlagStatusEnteredInError: "Entered in Error",
+}
+
+// Code returns the code value.
+func (c FlagStatus) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the FlagStatus values.
+func (c FlagStatus) IsValid() bool {
+	_, ok := flagStatusDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c FlagStatus) Display() string {
+	if display := flagStatusDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseFlagStatus returns the FlagStatus for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseFlagStatus(s string) (FlagStatus, error) {
+	return parseCode(s, flagStatusDisplays)
+}
+
 // FinancialResourceStatusCodes represents Financial Resource Status Codes.
 type FinancialResourceStatusCodes string
 
@@ -1887,6 +5275,39 @@ const (
 	FinancialResourceStatusCodesEnteredInError FinancialResourceStatusCodes = "entered-in-error"
 )
 
+// financialResourceStatusCodesDisplays maps each FinancialResourceStatusCodes code to its display name.
+var financialResourceStatusCodesDisplays = map[FinancialResourceStatusCodes]string{
+	FinancialResourceStatusCodesActive:         "Active",
+	FinancialResourceStatusCodesCancelled:      "Cancelled",
+	FinancialResourceStatusCodesDraft:          "Draft",
+	FinancialResourceStatusCodesEnteredInError: "Entered in Error",
+}
+
+// Code returns the code value.
+func (c FinancialResourceStatusCodes) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the FinancialResourceStatusCodes values.
+func (c FinancialResourceStatusCodes) IsValid() bool {
+	_, ok := financialResourceStatusCodesDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c FinancialResourceStatusCodes) Display() string {
+	if display := financialResourceStatusCodesDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseFinancialResourceStatusCodes returns the FinancialResourceStatusCodes for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseFinancialResourceStatusCodes(s string) (FinancialResourceStatusCodes, error) {
+	return parseCode(s, financialResourceStatusCodesDisplays)
+}
+
 // FormularyItemStatusCodes represents FormularyItem Status Codes.
 type FormularyItemStatusCodes string
 
@@ -1900,6 +5321,38 @@ const (
 	FormularyItemStatusCodesInactive FormularyItemStatusCodes = "inactive"
 )
 
+// formularyItemStatusCodesDisplays maps each FormularyItemStatusCodes code to its display name.
+var formularyItemStatusCodesDisplays = map[FormularyItemStatusCodes]string{
+	FormularyItemStatusCodesActive:         "Active",
+	FormularyItemStatusCodesEnteredInError: "Entered in Error",
+	FormularyItemStatusCodesInactive:       "Inactive",
+}
+
+// Code returns the code value.
+func (c FormularyItemStatusCodes) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the FormularyItemStatusCodes values.
+func (c FormularyItemStatusCodes) IsValid() bool {
+	_, ok := formularyItemStatusCodesDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c FormularyItemStatusCodes) Display() string {
+	if display := formularyItemStatusCodesDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseFormularyItemStatusCodes returns the FormularyItemStatusCodes for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseFormularyItemStatusCodes(s string) (FormularyItemStatusCodes, error) {
+	return parseCode(s, formularyItemStatusCodesDisplays)
+}
+
 // GenomicStudyStatus represents Genomic Study Status.
 type GenomicStudyStatus string
 
@@ -1917,6 +5370,40 @@ const (
 	GenomicStudyStatusUnknown GenomicStudyStatus = "unknown"
 )
 
+// genomicStudyStatusDisplays maps each GenomicStudyStatus code to its display name.
+var genomicStudyStatusDisplays = map[GenomicStudyStatus]string{
+	GenomicStudyStatusRegistered:     "Registered",
+	GenomicStudyStatusAvailable:      "Available",
+	GenomicStudyStatusCancelled:      "Cancelled",
+	GenomicStudyStatusEnteredInError: "Entered in Error",
+	GenomicStudyStatusUnknown:        "Unknown",
+}
+
+// Code returns the code value.
+func (c GenomicStudyStatus) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the GenomicStudyStatus values.
+func (c GenomicStudyStatus) IsValid() bool {
+	_, ok := genomicStudyStatusDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c GenomicStudyStatus) Display() string {
+	if display := genomicStudyStatusDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseGenomicStudyStatus returns the GenomicStudyStatus for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseGenomicStudyStatus(s string) (GenomicStudyStatus, error) {
+	return parseCode(s, genomicStudyStatusDisplays)
+}
+
 // GoalLifecycleStatus represents Goal Lifecycle Status.
 type GoalLifecycleStatus string
 
@@ -1942,6 +5429,44 @@ const (
 	GoalLifecycleStatusRejected GoalLifecycleStatus = "rejected"
 )
 
+// goalLifecycleStatusDisplays maps each GoalLifecycleStatus code to its display name.
+var goalLifecycleStatusDisplays = map[GoalLifecycleStatus]string{
+	GoalLifecycleStatusProposed:       "Proposed",
+	GoalLifecycleStatusPlanned:        "Planned",
+	GoalLifecycleStatusAccepted:       "Accepted",
+	GoalLifecycleStatusActive:         "Active",
+	GoalLifecycleStatusOnHold:         "On Hold",
+	GoalLifecycleStatusCompleted:      "Completed",
+	GoalLifecycleStatusCancelled:      "Cancelled",
+	GoalLifecycleStatusEnteredInError: "Entered in Error",
+	GoalLifecycleStatusRejected:       "Rejected",
+}
+
+// Code returns the code value.
+func (c GoalLifecycleStatus) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the GoalLifecycleStatus values.
+func (c GoalLifecycleStatus) IsValid() bool {
+	_, ok := goalLifecycleStatusDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c GoalLifecycleStatus) Display() string {
+	if display := goalLifecycleStatusDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseGoalLifecycleStatus returns the GoalLifecycleStatus for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseGoalLifecycleStatus(s string) (GoalLifecycleStatus, error) {
+	return parseCode(s, goalLifecycleStatusDisplays)
+}
+
 // GraphCompartmentRule represents Graph Compartment Rule.
 type GraphCompartmentRule string
 
@@ -1957,6 +5482,39 @@ const (
 	GraphCompartmentRuleCustom GraphCompartmentRule = "custom"
 )
 
+// graphCompartmentRuleDisplays maps each GraphCompartmentRule code to its display name.
+var graphCompartmentRuleDisplays = map[GraphCompartmentRule]string{
+	GraphCompartmentRuleIdentical: "Identical",
+	GraphCompartmentRuleMatching:  "Matching",
+	GraphCompartmentRuleDifferent: "Different",
+	GraphCompartmentRuleCustom:    "Custom",
+}
+
+// Code returns the code value.
+func (c GraphCompartmentRule) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the GraphCompartmentRule values.
+func (c GraphCompartmentRule) IsValid() bool {
+	_, ok := graphCompartmentRuleDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c GraphCompartmentRule) Display() string {
+	if display := graphCompartmentRuleDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseGraphCompartmentRule returns the GraphCompartmentRule for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseGraphCompartmentRule(s string) (GraphCompartmentRule, error) {
+	return parseCode(s, graphCompartmentRuleDisplays)
+}
+
 // GraphCompartmentUse represents Graph Compartment Use.
 type GraphCompartmentUse string
 
@@ -1968,6 +5526,37 @@ const (
 	GraphCompartmentUseRequires GraphCompartmentUse = "requires"
 )
 
+// graphCompartmentUseDisplays maps each GraphCompartmentUse code to its display name.
+var graphCompartmentUseDisplays = map[GraphCompartmentUse]string{
+	GraphCompartmentUseWhere:    "Where",
+	GraphCompartmentUseRequires: "requires",
+}
+
+// Code returns the code value.
+func (c GraphCompartmentUse) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the GraphCompartmentUse values.
+func (c GraphCompartmentUse) IsValid() bool {
+	_, ok := graphCompartmentUseDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c GraphCompartmentUse) Display() string {
+	if display := graphCompartmentUseDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseGraphCompartmentUse returns the GraphCompartmentUse for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseGraphCompartmentUse(s string) (GraphCompartmentUse, error) {
+	return parseCode(s, graphCompartmentUseDisplays)
+}
+
 // GroupMembershipBasis represents Group Membership Basis.
 type GroupMembershipBasis string
 
@@ -1979,6 +5568,37 @@ const (
 	GroupMembershipBasisEnumerated GroupMembershipBasis = "enumerated"
 )
 
+// groupMembershipBasisDisplays maps each GroupMembershipBasis code to its display name.
+var groupMembershipBasisDisplays = map[GroupMembershipBasis]string{
+	GroupMembershipBasisDefinitional: "Definitional",
+	GroupMembershipBasisEnumerated:   "Enumerated",
+}
+
+// Code returns the code value.
+func (c GroupMembershipBasis) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the GroupMembershipBasis values.
+func (c GroupMembershipBasis) IsValid() bool {
+	_, ok := groupMembershipBasisDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c GroupMembershipBasis) Display() string {
+	if display := groupMembershipBasisDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseGroupMembershipBasis returns the GroupMembershipBasis for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseGroupMembershipBasis(s string) (GroupMembershipBasis, error) {
+	return parseCode(s, groupMembershipBasisDisplays)
+}
+
 // GroupType represents Group Type.
 type GroupType string
 
@@ -2006,6 +5626,45 @@ const (
 	GroupTypeSpecimen GroupType = "specimen"
 )
 
+// groupTypeDisplays maps each GroupType code to its display name.
+var groupTypeDisplays = map[GroupType]string{
+	GroupTypePerson:            "Person",
+	GroupTypeAnimal:            "Animal",
+	GroupTypePractitioner:      "Practitioner",
+	GroupTypeDevice:            "Device",
+	GroupTypeCareteam:          "CareTeam",
+	GroupTypeHealthcareservice: "HealthcareService",
+	GroupTypeLocation:          "Location",
+	GroupTypeOrganization:      "Organization",
+	GroupTypeRelatedperson:     "RelatedPerson",
+	GroupTypeSpecimen:          "Specimen",
+}
+
+// Code returns the code value.
+func (c GroupType) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the GroupType values.
+func (c GroupType) IsValid() bool {
+	_, ok := groupTypeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c GroupType) Display() string {
+	if display := groupTypeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseGroupType returns the GroupType for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseGroupType(s string) (GroupType, error) {
+	return parseCode(s, groupTypeDisplays)
+}
+
 // GuidanceResponseStatus represents Guidance Response Status.
 type GuidanceResponseStatus string
 
@@ -2025,6 +5684,41 @@ const (
 	GuidanceResponseStatusEnteredInError GuidanceResponseStatus = "entered-in-error"
 )
 
+// guidanceResponseStatusDisplays maps each GuidanceResponseStatus code to its display name.
+var guidanceResponseStatusDisplays = map[GuidanceResponseStatus]string{
+	GuidanceResponseStatusSuccess:        "Success",
+	GuidanceResponseStatusDataRequested:  "Data Requested",
+	GuidanceResponseStatusDataRequired:   "Data Required",
+	GuidanceResponseStatusInProgress:     "In Progress",
+	GuidanceResponseStatusFailure:        "Failure",
+	GuidanceResponseStatusEnteredInError: "Entered In Error",
+}
+
+// Code returns the code value.
+func (c GuidanceResponseStatus) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the GuidanceResponseStatus values.
+func (c GuidanceResponseStatus) IsValid() bool {
+	_, ok := guidanceResponseStatusDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c GuidanceResponseStatus) Display() string {
+	if display := guidanceResponseStatusDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseGuidanceResponseStatus returns the GuidanceResponseStatus for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseGuidanceResponseStatus(s string) (GuidanceResponseStatus, error) {
+	return parseCode(s, guidanceResponseStatusDisplays)
+}
+
 // GuidePageGeneration represents Guide Page Generation.
 type GuidePageGeneration string
 
@@ -2040,6 +5734,39 @@ const (
 	GuidePageGenerationGenerated GuidePageGeneration = "generated"
 )
 
+// guidePageGenerationDisplays maps each GuidePageGeneration code to its display name.
+var guidePageGenerationDisplays = map[GuidePageGeneration]string{
+	GuidePageGenerationHtml:      "HTML",
+	GuidePageGenerationMarkdown:  "Markdown",
+	GuidePageGenerationXml:       "XML",
+	GuidePageGenerationGenerated: "Generated",
+}
+
+// Code returns the code value.
+func (c GuidePageGeneration) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the GuidePageGeneration values.
+func (c GuidePageGeneration) IsValid() bool {
+	_, ok := guidePageGenerationDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c GuidePageGeneration) Display() string {
+	if display := guidePageGenerationDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseGuidePageGeneration returns the GuidePageGeneration for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseGuidePageGeneration(s string) (GuidePageGeneration, error) {
+	return parseCode(s, guidePageGenerationDisplays)
+}
+
 // FamilyHistoryStatus represents Family History Status.
 type FamilyHistoryStatus string
 
@@ -2055,6 +5782,39 @@ const (
 	FamilyHistoryStatusHealthUnknown FamilyHistoryStatus = "health-unknown"
 )
 
+// familyHistoryStatusDisplays maps each FamilyHistoryStatus code to its display name.
+var familyHistoryStatusDisplays = map[FamilyHistoryStatus]string{
+	FamilyHistoryStatusPartial:        "Partial",
+	FamilyHistoryStatusCompleted:      "Completed",
+	FamilyHistoryStatusEnteredInError: "Entered in Error",
+	FamilyHistoryStatusHealthUnknown:  "Health Unknown",
+}
+
+// Code returns the code value.
+func (c FamilyHistoryStatus) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the FamilyHistoryStatus values.
+func (c FamilyHistoryStatus) IsValid() bool {
+	_, ok := familyHistoryStatusDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c FamilyHistoryStatus) Display() string {
+	if display := familyHistoryStatusDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseFamilyHistoryStatus returns the FamilyHistoryStatus for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseFamilyHistoryStatus(s string) (FamilyHistoryStatus, error) {
+	return parseCode(s, familyHistoryStatusDisplays)
+}
+
 // TestScriptRequestMethodCode represents Test Script Request Method Code.
 type TestScriptRequestMethodCode string
 
@@ -2076,6 +5836,42 @@ const (
 	TestScriptRequestMethodCodeHead TestScriptRequestMethodCode = "head"
 )
 
+// testScriptRequestMethodCodeDisplays maps each TestScriptRequestMethodCode code to its display name.
+var testScriptRequestMethodCodeDisplays = map[TestScriptRequestMethodCode]string{
+	TestScriptRequestMethodCodeDelete:  "DELETE",
+	TestScriptRequestMethodCodeGet:     "GET",
+	TestScriptRequestMethodCodeOptions: "OPTIONS",
+	TestScriptRequestMethodCodePatch:   "PATCH",
+	TestScriptRequestMethodCodePost:    "POST",
+	TestScriptRequestMethodCodePut:     "PUT",
+	TestScriptRequestMethodCodeHead:    "HEAD",
+}
+
+// Code returns the code value.
+func (c TestScriptRequestMethodCode) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the TestScriptRequestMethodCode values.
+func (c TestScriptRequestMethodCode) IsValid() bool {
+	_, ok := testScriptRequestMethodCodeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c TestScriptRequestMethodCode) Display() string {
+	if display := testScriptRequestMethodCodeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseTestScriptRequestMethodCode returns the TestScriptRequestMethodCode for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseTestScriptRequestMethodCode(s string) (TestScriptRequestMethodCode, error) {
+	return parseCode(s, testScriptRequestMethodCodeDisplays)
+}
+
 // HTTPVerb represents H T T P Verb.
 type HTTPVerb string
 
@@ -2095,6 +5891,41 @@ const (
 	HTTPVerbPatch HTTPVerb = "PATCH"
 )
 
+// hTTPVerbDisplays maps each HTTPVerb code to its display name.
+var hTTPVerbDisplays = map[HTTPVerb]string{
+	HTTPVerbGet:    "GET",
+	HTTPVerbHead:   "HEAD",
+	HTTPVerbPost:   "POST",
+	HTTPVerbPut:    "PUT",
+	HTTPVerbDelete: "DELETE",
+	HTTPVerbPatch:  "PATCH",
+}
+
+// Code returns the code value.
+func (c HTTPVerb) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the HTTPVerb values.
+func (c HTTPVerb) IsValid() bool {
+	_, ok := hTTPVerbDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c HTTPVerb) Display() string {
+	if display := hTTPVerbDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseHTTPVerb returns the HTTPVerb for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseHTTPVerb(s string) (HTTPVerb, error) {
+	return parseCode(s, hTTPVerbDisplays)
+}
+
 // IdentifierUse represents IdentifierUse.
 type IdentifierUse string
 
@@ -2112,6 +5943,40 @@ const (
 	IdentifierUseOld IdentifierUse = "old"
 )
 
+// identifierUseDisplays maps each IdentifierUse code to its display name.
+var identifierUseDisplays = map[IdentifierUse]string{
+	IdentifierUseUsual:     "Usual",
+	IdentifierUseOfficial:  "Official",
+	IdentifierUseTemp:      "Temp",
+	IdentifierUseSecondary: "Secondary",
+	IdentifierUseOld:       "Old",
+}
+
+// Code returns the code value.
+func (c IdentifierUse) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the IdentifierUse values.
+func (c IdentifierUse) IsValid() bool {
+	_, ok := identifierUseDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c IdentifierUse) Display() string {
+	if display := identifierUseDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseIdentifierUse returns the IdentifierUse for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseIdentifierUse(s string) (IdentifierUse, error) {
+	return parseCode(s, identifierUseDisplays)
+}
+
 // IdentityAssuranceLevel represents Identity Assurance Level.
 type IdentityAssuranceLevel string
 
@@ -2127,6 +5992,39 @@ const (
 	IdentityAssuranceLevelLevel4 IdentityAssuranceLevel = "level4"
 )
 
+// identityAssuranceLevelDisplays maps each IdentityAssuranceLevel code to its display name.
+var identityAssuranceLevelDisplays = map[IdentityAssuranceLevel]string{
+	IdentityAssuranceLevelLevel1: "Level 1",
+	IdentityAssuranceLevelLevel2: "Level 2",
+	IdentityAssuranceLevelLevel3: "Level 3",
+	IdentityAssuranceLevelLevel4: "Level 4",
+}
+
+// Code returns the code value.
+func (c IdentityAssuranceLevel) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the IdentityAssuranceLevel values.
+func (c IdentityAssuranceLevel) IsValid() bool {
+	_, ok := identityAssuranceLevelDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c IdentityAssuranceLevel) Display() string {
+	if display := identityAssuranceLevelDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseIdentityAssuranceLevel returns the IdentityAssuranceLevel for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseIdentityAssuranceLevel(s string) (IdentityAssuranceLevel, error) {
+	return parseCode(s, identityAssuranceLevelDisplays)
+}
+
 // ImagingSelection2DGraphicType represents Imaging Selection2 D Graphic Type.
 type ImagingSelection2DGraphicType string
 
@@ -2144,6 +6042,40 @@ const (
 	ImagingSelection2DGraphicTypeEllipse ImagingSelection2DGraphicType = "ellipse"
 )
 
+// imagingSelection2DGraphicTypeDisplays maps each ImagingSelection2DGraphicType code to its display name.
+var imagingSelection2DGraphicTypeDisplays = map[ImagingSelection2DGraphicType]string{
+	ImagingSelection2DGraphicTypePoint:        "POINT",
+	ImagingSelection2DGraphicTypePolyline:     "POLYLINE",
+	ImagingSelection2DGraphicTypeInterpolated: "INTERPOLATED",
+	ImagingSelection2DGraphicTypeCircle:       "CIRCLE",
+	ImagingSelection2DGraphicTypeEllipse:      "ELLIPSE",
+}
+
+// Code returns the code value.
+func (c ImagingSelection2DGraphicType) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the ImagingSelection2DGraphicType values.
+func (c ImagingSelection2DGraphicType) IsValid() bool {
+	_, ok := imagingSelection2DGraphicTypeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c ImagingSelection2DGraphicType) Display() string {
+	if display := imagingSelection2DGraphicTypeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseImagingSelection2DGraphicType returns the ImagingSelection2DGraphicType for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseImagingSelection2DGraphicType(s string) (ImagingSelection2DGraphicType, error) {
+	return parseCode(s, imagingSelection2DGraphicTypeDisplays)
+}
+
 // ImagingSelection3DGraphicType represents Imaging Selection3 D Graphic Type.
 type ImagingSelection3DGraphicType string
 
@@ -2163,6 +6095,41 @@ const (
 	ImagingSelection3DGraphicTypeEllipsoid ImagingSelection3DGraphicType = "ellipsoid"
 )
 
+// imagingSelection3DGraphicTypeDisplays maps each ImagingSelection3DGraphicType code to its display name.
+var imagingSelection3DGraphicTypeDisplays = map[ImagingSelection3DGraphicType]string{
+	ImagingSelection3DGraphicTypePoint:      "POINT",
+	ImagingSelection3DGraphicTypeMultipoint: "MULTIPOINT",
+	ImagingSelection3DGraphicTypePolyline:   "POLYLINE",
+	ImagingSelection3DGraphicTypePolygon:    "POLYGON",
+	ImagingSelection3DGraphicTypeEllipse:    "ELLIPSE",
+	ImagingSelection3DGraphicTypeEllipsoid:  "ELLIPSOID",
+}
+
+// Code returns the code value.
+func (c ImagingSelection3DGraphicType) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the ImagingSelection3DGraphicType values.
+func (c ImagingSelection3DGraphicType) IsValid() bool {
+	_, ok := imagingSelection3DGraphicTypeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c ImagingSelection3DGraphicType) Display() string {
+	if display := imagingSelection3DGraphicTypeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseImagingSelection3DGraphicType returns the ImagingSelection3DGraphicType for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseImagingSelection3DGraphicType(s string) (ImagingSelection3DGraphicType, error) {
+	return parseCode(s, imagingSelection3DGraphicTypeDisplays)
+}
+
 // ImagingSelectionStatus represents Imaging Selection Status.
 type ImagingSelectionStatus string
 
@@ -2176,6 +6143,38 @@ const (
 	ImagingSelectionStatusUnknown ImagingSelectionStatus = "unknown"
 )
 
+// imagingSelectionStatusDisplays maps each ImagingSelectionStatus code to its display name.
+var imagingSelectionStatusDisplays = map[ImagingSelectionStatus]string{
+	ImagingSelectionStatusAvailable:      "Available",
+	ImagingSelectionStatusEnteredInError: "Entered in Error",
+	ImagingSelectionStatusUnknown:        "Unknown",
+}
+
+// Code returns the code value.
+func (c ImagingSelectionStatus) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the ImagingSelectionStatus values.
+func (c ImagingSelectionStatus) IsValid() bool {
+	_, ok := imagingSelectionStatusDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c ImagingSelectionStatus) Display() string {
+	if display := imagingSelectionStatusDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseImagingSelectionStatus returns the ImagingSelectionStatus for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseImagingSelectionStatus(s string) (ImagingSelectionStatus, error) {
+	return parseCode(s, imagingSelectionStatusDisplays)
+}
+
 // ImagingStudyStatus represents Imaging Study Status.
 type ImagingStudyStatus string
 
@@ -2193,6 +6192,40 @@ const (
 	ImagingStudyStatusUnknown ImagingStudyStatus = "unknown"
 )
 
+// imagingStudyStatusDisplays maps each ImagingStudyStatus code to its display name.
+var imagingStudyStatusDisplays = map[ImagingStudyStatus]string{
+	ImagingStudyStatusRegistered:     "Registered",
+	ImagingStudyStatusAvailable:      "Available",
+	ImagingStudyStatusCancelled:      "Cancelled",
+	ImagingStudyStatusEnteredInError: "Entered in Error",
+	ImagingStudyStatusUnknown:        "Unknown",
+}
+
+// Code returns the code value.
+func (c ImagingStudyStatus) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the ImagingStudyStatus values.
+func (c ImagingStudyStatus) IsValid() bool {
+	_, ok := imagingStudyStatusDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c ImagingStudyStatus) Display() string {
+	if display := imagingStudyStatusDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseImagingStudyStatus returns the ImagingStudyStatus for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseImagingStudyStatus(s string) (ImagingStudyStatus, error) {
+	return parseCode(s, imagingStudyStatusDisplays)
+}
+
 // ImmunizationEvaluationStatusCodes represents Immunization Evaluation Status Codes.
 type ImmunizationEvaluationStatusCodes string
 
@@ -2202,6 +6235,37 @@ const (
 	ImmunizationEvaluationStatusCodesEnteredInError ImmunizationEvaluationStatusCodes = "entered-in-error"
 )
 
+// immunizationEvaluationStatusCodesDisplays maps each ImmunizationEvaluationStatusCodes code to its display name.
+var immunizationEvaluationStatusCodesDisplays = map[ImmunizationEvaluationStatusCodes]string{
+	ImmunizationEvaluationStatusCodesCompleted:      "",
+	ImmunizationEvaluationStatusCodesEnteredInError: "",
+}
+
+// Code returns the code value.
+func (c ImmunizationEvaluationStatusCodes) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the ImmunizationEvaluationStatusCodes values.
+func (c ImmunizationEvaluationStatusCodes) IsValid() bool {
+	_, ok := immunizationEvaluationStatusCodesDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c ImmunizationEvaluationStatusCodes) Display() string {
+	if display := immunizationEvaluationStatusCodesDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseImmunizationEvaluationStatusCodes returns the ImmunizationEvaluationStatusCodes for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseImmunizationEvaluationStatusCodes(s string) (ImmunizationEvaluationStatusCodes, error) {
+	return parseCode(s, immunizationEvaluationStatusCodesDisplays)
+}
+
 // ImmunizationStatusCodes represents Immunization Status Codes.
 type ImmunizationStatusCodes string
 
@@ -2212,6 +6276,38 @@ const (
 	ImmunizationStatusCodesNotDone        ImmunizationStatusCodes = "not-done"
 )
 
+// immunizationStatusCodesDisplays maps each ImmunizationStatusCodes code to its display name.
+var immunizationStatusCodesDisplays = map[ImmunizationStatusCodes]string{
+	ImmunizationStatusCodesCompleted:      "",
+	ImmunizationStatusCodesEnteredInError: "",
+	ImmunizationStatusCodesNotDone:        "",
+}
+
+// Code returns the code value.
+func (c ImmunizationStatusCodes) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the ImmunizationStatusCodes values.
+func (c ImmunizationStatusCodes) IsValid() bool {
+	_, ok := immunizationStatusCodesDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c ImmunizationStatusCodes) Display() string {
+	if display := immunizationStatusCodesDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseImmunizationStatusCodes returns the ImmunizationStatusCodes for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseImmunizationStatusCodes(s string) (ImmunizationStatusCodes, error) {
+	return parseCode(s, immunizationStatusCodesDisplays)
+}
+
 // IngredientManufacturerRole represents Ingredient Manufacturer Role.
 type IngredientManufacturerRole string
 
@@ -2225,6 +6321,38 @@ const (
 	IngredientManufacturerRoleActual IngredientManufacturerRole = "actual"
 )
 
+// ingredientManufacturerRoleDisplays maps each IngredientManufacturerRole code to its display name.
+var ingredientManufacturerRoleDisplays = map[IngredientManufacturerRole]string{
+	IngredientManufacturerRoleAllowed:  "Manufacturer is specifically allowed for this ingredient",
+	IngredientManufacturerRolePossible: "Manufacturer is known to make this ingredient in general",
+	IngredientManufacturerRoleActual:   "Manufacturer actually makes this particular ingredient",
+}
+
+// Code returns the code value.
+func (c IngredientManufacturerRole) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the IngredientManufacturerRole values.
+func (c IngredientManufacturerRole) IsValid() bool {
+	_, ok := ingredientManufacturerRoleDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c IngredientManufacturerRole) Display() string {
+	if display := ingredientManufacturerRoleDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseIngredientManufacturerRole returns the IngredientManufacturerRole for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseIngredientManufacturerRole(s string) (IngredientManufacturerRole, error) {
+	return parseCode(s, ingredientManufacturerRoleDisplays)
+}
+
 // InteractionTrigger represents Interaction Trigger.
 type InteractionTrigger string
 
@@ -2235,6 +6363,38 @@ const (
 	InteractionTriggerDelete InteractionTrigger = "delete"
 )
 
+// interactionTriggerDisplays maps each InteractionTrigger code to its display name.
+var interactionTriggerDisplays = map[InteractionTrigger]string{
+	InteractionTriggerCreate: "",
+	InteractionTriggerUpdate: "",
+	InteractionTriggerDelete: "",
+}
+
+// Code returns the code value.
+func (c InteractionTrigger) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the InteractionTrigger values.
+func (c InteractionTrigger) IsValid() bool {
+	_, ok := interactionTriggerDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c InteractionTrigger) Display() string {
+	if display := interactionTriggerDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseInteractionTrigger returns the InteractionTrigger for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseInteractionTrigger(s string) (InteractionTrigger, error) {
+	return parseCode(s, interactionTriggerDisplays)
+}
+
 // InventoryItemStatusCodes represents InventoryItem Status Codes.
 type InventoryItemStatusCodes string
 
@@ -2250,6 +6410,39 @@ const (
 	InventoryItemStatusCodesUnknown InventoryItemStatusCodes = "unknown"
 )
 
+// inventoryItemStatusCodesDisplays maps each InventoryItemStatusCodes code to its display name.
+var inventoryItemStatusCodesDisplays = map[InventoryItemStatusCodes]string{
+	InventoryItemStatusCodesActive:         "Active",
+	InventoryItemStatusCodesInactive:       "Inactive",
+	InventoryItemStatusCodesEnteredInError: "Entered in Error",
+	InventoryItemStatusCodesUnknown:        "Unknown",
+}
+
+// Code returns the code value.
+func (c InventoryItemStatusCodes) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the InventoryItemStatusCodes values.
+func (c InventoryItemStatusCodes) IsValid() bool {
+	_, ok := inventoryItemStatusCodesDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c InventoryItemStatusCodes) Display() string {
+	if display := inventoryItemStatusCodesDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseInventoryItemStatusCodes returns the InventoryItemStatusCodes for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseInventoryItemStatusCodes(s string) (InventoryItemStatusCodes, error) {
+	return parseCode(s, inventoryItemStatusCodesDisplays)
+}
+
 // InventoryCountType represents Inventory Count Type.
 type InventoryCountType string
 
@@ -2261,6 +6454,37 @@ const (
 	InventoryCountTypeDifference InventoryCountType = "difference"
 )
 
+// inventoryCountTypeDisplays maps each InventoryCountType code to its display name.
+var inventoryCountTypeDisplays = map[InventoryCountType]string{
+	InventoryCountTypeSnapshot:   "Snapshot",
+	InventoryCountTypeDifference: "Difference",
+}
+
+// Code returns the code value.
+func (c InventoryCountType) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the InventoryCountType values.
+func (c InventoryCountType) IsValid() bool {
+	_, ok := inventoryCountTypeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c InventoryCountType) Display() string {
+	if display := inventoryCountTypeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseInventoryCountType returns the InventoryCountType for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseInventoryCountType(s string) (InventoryCountType, error) {
+	return parseCode(s, inventoryCountTypeDisplays)
+}
+
 // InventoryReportStatus represents Inventory Report Status.
 type InventoryReportStatus string
 
@@ -2276,6 +6500,39 @@ const (
 	InventoryReportStatusEnteredInError InventoryReportStatus = "entered-in-error"
 )
 
+// inventoryReportStatusDisplays maps each InventoryReportStatus code to its display name.
+var inventoryReportStatusDisplays = map[InventoryReportStatus]string{
+	InventoryReportStatusDraft:          "Draft",
+	InventoryReportStatusRequested:      "Requested",
+	InventoryReportStatusActive:         "Active",
+	InventoryReportStatusEnteredInError: "Entered in Error",
+}
+
+// Code returns the code value.
+func (c InventoryReportStatus) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the InventoryReportStatus values.
+func (c InventoryReportStatus) IsValid() bool {
+	_, ok := inventoryReportStatusDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c InventoryReportStatus) Display() string {
+	if display := inventoryReportStatusDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseInventoryReportStatus returns the InventoryReportStatus for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseInventoryReportStatus(s string) (InventoryReportStatus, error) {
+	return parseCode(s, inventoryReportStatusDisplays)
+}
+
 // InvoiceStatus represents Invoice Status.
 type InvoiceStatus string
 
@@ -2293,6 +6550,40 @@ const (
 	InvoiceStatusEnteredInError InvoiceStatus = "entered-in-error"
 )
 
+// invoiceStatusDisplays maps each InvoiceStatus code to its display name.
+var invoiceStatusDisplays = map[InvoiceStatus]string{
+	InvoiceStatusDraft:          "draft",
+	InvoiceStatusIssued:         "issued",
+	InvoiceStatusBalanced:       "balanced",
+	InvoiceStatusCancelled:      "cancelled",
+	InvoiceStatusEnteredInError: "entered in error",
+}
+
+// Code returns the code value.
+func (c InvoiceStatus) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the InvoiceStatus values.
+func (c InvoiceStatus) IsValid() bool {
+	_, ok := invoiceStatusDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c InvoiceStatus) Display() string {
+	if display := invoiceStatusDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseInvoiceStatus returns the InvoiceStatus for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseInvoiceStatus(s string) (InvoiceStatus, error) {
+	return parseCode(s, invoiceStatusDisplays)
+}
+
 // IssueSeverity represents Issue Severity.
 type IssueSeverity string
 
@@ -2310,6 +6601,40 @@ const (
 	IssueSeveritySuccess IssueSeverity = "success"
 )
 
+// issueSeverityDisplays maps each IssueSeverity code to its display name.
+var issueSeverityDisplays = map[IssueSeverity]string{
+	IssueSeverityFatal:       "Fatal",
+	IssueSeverityError:       "Error",
+	IssueSeverityWarning:     "Warning",
+	IssueSeverityInformation: "Information",
+	IssueSeveritySuccess:     "Operation Successful",
+}
+
+// Code returns the code value.
+func (c IssueSeverity) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the IssueSeverity values.
+func (c IssueSeverity) IsValid() bool {
+	_, ok := issueSeverityDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c IssueSeverity) Display() string {
+	if display := issueSeverityDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseIssueSeverity returns the IssueSeverity for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseIssueSeverity(s string) (IssueSeverity, error) {
+	return parseCode(s, issueSeverityDisplays)
+}
+
 // IssueType represents Issue Type.
 type IssueType string
 
@@ -2383,6 +6708,68 @@ const (
 	IssueTypeSuccess IssueType = "success"
 )
 
+// issueTypeDisplays maps each IssueType code to its display name.
+var issueTypeDisplays = map[IssueType]string{
+	IssueTypeInvalid:         "Invalid Content",
+	IssueTypeStructure:       "Structural Issue",
+	IssueTypeRequired:        "Required element missing",
+	IssueTypeValue:           "Element value invalid",
+	IssueTypeInvariant:       "Validation rule failed",
+	IssueTypeSecurity:        "Security Problem",
+	IssueTypeLogin:           "Login Required",
+	IssueTypeUnknown:         "Unknown User",
+	IssueTypeExpired:         "Session Expired",
+	IssueTypeForbidden:       "Forbidden",
+	IssueTypeSuppressed:      "Information  Suppressed",
+	IssueTypeProcessing:      "Processing Failure",
+	IssueTypeNotSupported:    "Content not supported",
+	IssueTypeDuplicate:       "Duplicate",
+	IssueTypeMultipleMatches: "Multiple Matches",
+	IssueTypeNotFound:        "Not Found",
+	IssueTypeDeleted:         "Deleted",
+	IssueTypeTooLong:         "Content Too Long",
+	IssueTypeCodeInvalid:     "Invalid Code",
+	IssueTypeExtension:       "Unacceptable Extension",
+	IssueTypeTooCostly:       "Operation Too Costly",
+	IssueTypeBusinessRule:    "Business Rule Violation",
+	IssueTypeConflict:        "Edit Version Conflict",
+	IssueTypeLimitedFilter:   "Limited Filter Application",
+	IssueTypeTransient:       "Transient Issue",
+	IssueTypeLockError:       "Lock Error",
+	IssueTypeNoStore:         "No Store Available",
+	IssueTypeException:       "Exception",
+	IssueTypeTimeout:         "Timeout",
+	IssueTypeIncomplete:      "Incomplete Results",
+	IssueTypeThrottled:       "Throttled",
+	IssueTypeInformational:   "Informational Note",
+	IssueTypeSuccess:         "Operation Successful",
+}
+
+// Code returns the code value.
+func (c IssueType) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the IssueType values.
+func (c IssueType) IsValid() bool {
+	_, ok := issueTypeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c IssueType) Display() string {
+	if display := issueTypeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseIssueType returns the IssueType for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseIssueType(s string) (IssueType, error) {
+	return parseCode(s, issueTypeDisplays)
+}
+
 // QuestionnaireItemType represents Questionnaire Item Type.
 type QuestionnaireItemType string
 
@@ -2422,6 +6809,51 @@ const (
 	QuestionnaireItemTypeQuantity QuestionnaireItemType = "quantity"
 )
 
+// questionnaireItemTypeDisplays maps each QuestionnaireItemType code to its display name.
+var questionnaireItemTypeDisplays = map[QuestionnaireItemType]string{
+	QuestionnaireItemTypeGroup:      "Group",
+	QuestionnaireItemTypeDisplay:    "Display",
+	QuestionnaireItemTypeQuestion:   "Question",
+	QuestionnaireItemTypeBoolean:    "Boolean",
+	QuestionnaireItemTypeDecimal:    "Decimal",
+	QuestionnaireItemTypeInteger:    "Integer",
+	QuestionnaireItemTypeDate:       "Date",
+	QuestionnaireItemTypeDatetime:   "Date Time",
+	QuestionnaireItemTypeTime:       "Time",
+	QuestionnaireItemTypeString:     "String",
+	QuestionnaireItemTypeText:       "Text",
+	QuestionnaireItemTypeUrl:        "Url",
+	QuestionnaireItemTypeCoding:     "Coding",
+	QuestionnaireItemTypeAttachment: "Attachment",
+	QuestionnaireItemTypeReference:  "Reference",
+	QuestionnaireItemTypeQuantity:   "Quantity",
+}
+
+// Code returns the code value.
+func (c QuestionnaireItemType) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the QuestionnaireItemType values.
+func (c QuestionnaireItemType) IsValid() bool {
+	_, ok := questionnaireItemTypeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c QuestionnaireItemType) Display() string {
+	if display := questionnaireItemTypeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseQuestionnaireItemType returns the QuestionnaireItemType for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseQuestionnaireItemType(s string) (QuestionnaireItemType, error) {
+	return parseCode(s, questionnaireItemTypeDisplays)
+}
+
 // CommonLanguages represents Common Languages.
 type CommonLanguages string
 
@@ -2593,6 +7025,117 @@ const (
 	CommonLanguagesZhTw CommonLanguages = "zh-TW"
 )
 
+// commonLanguagesDisplays maps each CommonLanguages code to its display name.
+var commonLanguagesDisplays = map[CommonLanguages]string{
+	CommonLanguagesAr:   "Arabic",
+	CommonLanguagesBg:   "Bulgarian",
+	CommonLanguagesBgBg: "Bulgarian (Bulgaria)",
+	CommonLanguagesBn:   "Bengali",
+	CommonLanguagesCs:   "Czech",
+	CommonLanguagesCsCz: "Czech (Czechia)",
+	CommonLanguagesBs:   "Bosnian",
+	CommonLanguagesBsBa: "Bosnian (Bosnia and Herzegovina)",
+	CommonLanguagesDa:   "Danish",
+	CommonLanguagesDaDk: "Danish (Denmark)",
+	CommonLanguagesDe:   "German",
+	CommonLanguagesDeAt: "German (Austria)",
+	CommonLanguagesDeCh: "German (Switzerland)",
+	CommonLanguagesDeDe: "German (Germany)",
+	CommonLanguagesEl:   "Greek",
+	CommonLanguagesElGr: "Greek (Greece)",
+	CommonLanguagesEn:   "English",
+	CommonLanguagesEnAu: "English (Australia)",
+	CommonLanguagesEnCa: "English (Canada)",
+	CommonLanguagesEnGb: "English (Great Britain)",
+	CommonLanguagesEnIn: "English (India)",
+	CommonLanguagesEnNz: "English (New Zealand)",
+	CommonLanguagesEnSg: "English (Singapore)",
+	CommonLanguagesEnUs: "English (United States)",
+	CommonLanguagesEs:   "Spanish",
+	CommonLanguagesEsAr: "Spanish (Argentina)",
+	CommonLanguagesEsEs: "Spanish (Spain)",
+	CommonLanguagesEsUy: "Spanish (Uruguay)",
+	CommonLanguagesEt:   "Estonian",
+	CommonLanguagesEtEe: "Estonian (Estonia)",
+	CommonLanguagesFi:   "Finnish",
+	CommonLanguagesFr:   "French",
+	CommonLanguagesFrBe: "French (Belgium)",
+	CommonLanguagesFrCh: "French (Switzerland)",
+	CommonLanguagesFrFr: "French (France)",
+	CommonLanguagesFiFi: "Finnish (Finland)",
+	CommonLanguagesFrCa: "French (Canada)",
+	CommonLanguagesFy:   "Frisian",
+	CommonLanguagesFyNl: "Frisian (Netherlands)",
+	CommonLanguagesHi:   "Hindi",
+	CommonLanguagesHr:   "Croatian",
+	CommonLanguagesHrHr: "Croatian (Croatia)",
+	CommonLanguagesIs:   "Icelandic",
+	CommonLanguagesIsIs: "Icelandic (Iceland)",
+	CommonLanguagesIt:   "Italian",
+	CommonLanguagesItCh: "Italian (Switzerland)",
+	CommonLanguagesItIt: "Italian (Italy)",
+	CommonLanguagesJa:   "Japanese",
+	CommonLanguagesKo:   "Korean",
+	CommonLanguagesLt:   "Lithuanian",
+	CommonLanguagesLtLt: "Lithuanian (Lithuania)",
+	CommonLanguagesLv:   "Latvian",
+	CommonLanguagesLvLv: "Latvian (Latvia)",
+	CommonLanguagesNl:   "Dutch",
+	CommonLanguagesNlBe: "Dutch (Belgium)",
+	CommonLanguagesNlNl: "Dutch (Netherlands)",
+	CommonLanguagesNo:   "Norwegian",
+	CommonLanguagesNoNo: "Norwegian (Norway)",
+	CommonLanguagesPa:   "Punjabi",
+	CommonLanguagesPl:   "Polish",
+	CommonLanguagesPlPl: "Polish (Poland)",
+	CommonLanguagesPt:   "Portuguese",
+	CommonLanguagesPtPt: "Portuguese (Portugal)",
+	CommonLanguagesPtBr: "Portuguese (Brazil)",
+	CommonLanguagesRo:   "Romanian",
+	CommonLanguagesRoRo: "Romanian (Romania)",
+	CommonLanguagesRu:   "Russian",
+	CommonLanguagesRuRu: "Russian (Russia)",
+	CommonLanguagesSk:   "Slovakian",
+	CommonLanguagesSkSk: "Slovakian (Slovakia)",
+	CommonLanguagesSl:   "Slovenian",
+	CommonLanguagesSlSi: "Slovenian (Slovenia)",
+	CommonLanguagesSr:   "Serbian",
+	CommonLanguagesSrRs: "Serbian (Serbia)",
+	CommonLanguagesSv:   "Swedish",
+	CommonLanguagesSvSe: "Swedish (Sweden)",
+	CommonLanguagesTe:   "Telugu",
+	CommonLanguagesZh:   "Chinese",
+	CommonLanguagesZhCn: "Chinese (China)",
+	CommonLanguagesZhHk: "Chinese (Hong Kong)",
+	CommonLanguagesZhSg: "Chinese (Singapore)",
+	CommonLanguagesZhTw: "Chinese (Taiwan)",
+}
+
+// Code returns the code value.
+func (c CommonLanguages) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the CommonLanguages values.
+func (c CommonLanguages) IsValid() bool {
+	_, ok := commonLanguagesDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c CommonLanguages) Display() string {
+	if display := commonLanguagesDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseCommonLanguages returns the CommonLanguages for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseCommonLanguages(s string) (CommonLanguages, error) {
+	return parseCode(s, commonLanguagesDisplays)
+}
+
 // LinkType represents Link Type.
 type LinkType string
 
@@ -2608,6 +7151,39 @@ const (
 	LinkTypeSeealso LinkType = "seealso"
 )
 
+// linkTypeDisplays maps each LinkType code to its display name.
+var linkTypeDisplays = map[LinkType]string{
+	LinkTypeReplacedBy: "Replaced-by",
+	LinkTypeReplaces:   "Replaces",
+	LinkTypeRefer:      "Refer",
+	LinkTypeSeealso:    "See also",
+}
+
+// Code returns the code value.
+func (c LinkType) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the LinkType values.
+func (c LinkType) IsValid() bool {
+	_, ok := linkTypeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c LinkType) Display() string {
+	if display := linkTypeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseLinkType returns the LinkType for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseLinkType(s string) (LinkType, error) {
+	return parseCode(s, linkTypeDisplays)
+}
+
 // LinkageType represents Linkage Type.
 type LinkageType string
 
@@ -2621,6 +7197,38 @@ const (
 	LinkageTypeHistorical LinkageType = "historical"
 )
 
+// linkageTypeDisplays maps each LinkageType code to its display name.
+var linkageTypeDisplays = map[LinkageType]string{
+	LinkageTypeSource:     "Source of Truth",
+	LinkageTypeAlternate:  "Alternate Record",
+	LinkageTypeHistorical: "Historical/Obsolete Record",
+}
+
+// Code returns the code value.
+func (c LinkageType) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the LinkageType values.
+func (c LinkageType) IsValid() bool {
+	_, ok := linkageTypeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c LinkageType) Display() string {
+	if display := linkageTypeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseLinkageType returns the LinkageType for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseLinkageType(s string) (LinkageType, error) {
+	return parseCode(s, linkageTypeDisplays)
+}
+
 // ListMode represents List Mode.
 type ListMode string
 
@@ -2634,6 +7242,38 @@ const (
 	ListModeChanges ListMode = "changes"
 )
 
+// listModeDisplays maps each ListMode code to its display name.
+var listModeDisplays = map[ListMode]string{
+	ListModeWorking:  "Working List",
+	ListModeSnapshot: "Snapshot List",
+	ListModeChanges:  "Change List",
+}
+
+// Code returns the code value.
+func (c ListMode) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the ListMode values.
+func (c ListMode) IsValid() bool {
+	_, ok := listModeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c ListMode) Display() string {
+	if display := listModeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseListMode returns the ListMode for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseListMode(s string) (ListMode, error) {
+	return parseCode(s, listModeDisplays)
+}
+
 // ListStatus represents List Status.
 type ListStatus string
 
@@ -2647,6 +7287,38 @@ const (
 	ListStatusEnteredInError ListStatus = "entered-in-error"
 )
 
+// listStatusDisplays maps each ListStatus code to its display name.
+var listStatusDisplays = map[ListStatus]string{
+	ListStatusCurrent:        "Current",
+	ListStatusRetired:        "Retired",
+	ListStatusEnteredInError: "Entered In Error",
+}
+
+// Code returns the code value.
+func (c ListStatus) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the ListStatus values.
+func (c ListStatus) IsValid() bool {
+	_, ok := listStatusDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c ListStatus) Display() string {
+	if display := listStatusDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseListStatus returns the ListStatus for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseListStatus(s string) (ListStatus, error) {
+	return parseCode(s, listStatusDisplays)
+}
+
 // LocationMode represents Location Mode.
 type LocationMode string
 
@@ -2658,6 +7330,37 @@ const (
 	LocationModeKind LocationMode = "kind"
 )
 
+// locationModeDisplays maps each LocationMode code to its display name.
+var locationModeDisplays = map[LocationMode]string{
+	LocationModeInstance: "Instance",
+	LocationModeKind:     "Kind",
+}
+
+// Code returns the code value.
+func (c LocationMode) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the LocationMode values.
+func (c LocationMode) IsValid() bool {
+	_, ok := locationModeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c LocationMode) Display() string {
+	if display := locationModeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseLocationMode returns the LocationMode for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseLocationMode(s string) (LocationMode, error) {
+	return parseCode(s, locationModeDisplays)
+}
+
 // LocationStatus represents Location Status.
 type LocationStatus string
 
@@ -2671,6 +7374,38 @@ const (
 	LocationStatusInactive LocationStatus = "inactive"
 )
 
+// locationStatusDisplays maps each LocationStatus code to its display name.
+var locationStatusDisplays = map[LocationStatus]string{
+	LocationStatusActive:    "Active",
+	LocationStatusSuspended: "Suspended",
+	LocationStatusInactive:  "Inactive",
+}
+
+// Code returns the code value.
+func (c LocationStatus) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the LocationStatus values.
+func (c LocationStatus) IsValid() bool {
+	_, ok := locationStatusDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c LocationStatus) Display() string {
+	if display := locationStatusDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseLocationStatus returns the LocationStatus for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseLocationStatus(s string) (LocationStatus, error) {
+	return parseCode(s, locationStatusDisplays)
+}
+
 // StructureMapGroupTypeMode represents Structure Map Group Type Mode.
 type StructureMapGroupTypeMode string
 
@@ -2682,6 +7417,37 @@ const (
 	StructureMapGroupTypeModeTypeAndTypes StructureMapGroupTypeMode = "type-and-types"
 )
 
+// structureMapGroupTypeModeDisplays maps each StructureMapGroupTypeMode code to its display name.
+var structureMapGroupTypeModeDisplays = map[StructureMapGroupTypeMode]string{
+	StructureMapGroupTypeModeTypes:        "Default for Type Combination",
+	StructureMapGroupTypeModeTypeAndTypes: "Default for type + combination",
+}
+
+// Code returns the code value.
+func (c StructureMapGroupTypeMode) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the StructureMapGroupTypeMode values.
+func (c StructureMapGroupTypeMode) IsValid() bool {
+	_, ok := structureMapGroupTypeModeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c StructureMapGroupTypeMode) Display() string {
+	if display := structureMapGroupTypeModeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseStructureMapGroupTypeMode returns the StructureMapGroupTypeMode for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseStructureMapGroupTypeMode(s string) (StructureMapGroupTypeMode, error) {
+	return parseCode(s, structureMapGroupTypeModeDisplays)
+}
+
 // StructureMapInputMode represents Structure Map Input Mode.
 type StructureMapInputMode string
 
@@ -2693,6 +7459,37 @@ const (
 	StructureMapInputModeTarget StructureMapInputMode = "target"
 )
 
+// structureMapInputModeDisplays maps each StructureMapInputMode code to its display name.
+var structureMapInputModeDisplays = map[StructureMapInputMode]string{
+	StructureMapInputModeSource: "Source Instance",
+	StructureMapInputModeTarget: "Target Instance",
+}
+
+// Code returns the code value.
+func (c StructureMapInputMode) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the StructureMapInputMode values.
+func (c StructureMapInputMode) IsValid() bool {
+	_, ok := structureMapInputModeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c StructureMapInputMode) Display() string {
+	if display := structureMapInputModeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseStructureMapInputMode returns the StructureMapInputMode for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseStructureMapInputMode(s string) (StructureMapInputMode, error) {
+	return parseCode(s, structureMapInputModeDisplays)
+}
+
 // StructureMapModelMode represents Structure Map Model Mode.
 type StructureMapModelMode string
 
@@ -2708,6 +7505,39 @@ const (
 	StructureMapModelModeProduced StructureMapModelMode = "produced"
 )
 
+// structureMapModelModeDisplays maps each StructureMapModelMode code to its display name.
+var structureMapModelModeDisplays = map[StructureMapModelMode]string{
+	StructureMapModelModeSource:   "Source Structure Definition",
+	StructureMapModelModeQueried:  "Queried Structure Definition",
+	StructureMapModelModeTarget:   "Target Structure Definition",
+	StructureMapModelModeProduced: "Produced Structure Definition",
+}
+
+// Code returns the code value.
+func (c StructureMapModelMode) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the StructureMapModelMode values.
+func (c StructureMapModelMode) IsValid() bool {
+	_, ok := structureMapModelModeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c StructureMapModelMode) Display() string {
+	if display := structureMapModelModeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseStructureMapModelMode returns the StructureMapModelMode for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseStructureMapModelMode(s string) (StructureMapModelMode, error) {
+	return parseCode(s, structureMapModelModeDisplays)
+}
+
 // StructureMapSourceListMode represents Structure Map Source List Mode.
 type StructureMapSourceListMode string
 
@@ -2725,6 +7555,40 @@ const (
 	StructureMapSourceListModeOnlyOne StructureMapSourceListMode = "only_one"
 )
 
+// structureMapSourceListModeDisplays maps each StructureMapSourceListMode code to its display name.
+var structureMapSourceListModeDisplays = map[StructureMapSourceListMode]string{
+	StructureMapSourceListModeFirst:    "First",
+	StructureMapSourceListModeNotFirst: "All but the first",
+	StructureMapSourceListModeLast:     "Last",
+	StructureMapSourceListModeNotLast:  "All but the last",
+	StructureMapSourceListModeOnlyOne:  "Enforce only one",
+}
+
+// Code returns the code value.
+func (c StructureMapSourceListMode) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the StructureMapSourceListMode values.
+func (c StructureMapSourceListMode) IsValid() bool {
+	_, ok := structureMapSourceListModeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c StructureMapSourceListMode) Display() string {
+	if display := structureMapSourceListModeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseStructureMapSourceListMode returns the StructureMapSourceListMode for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseStructureMapSourceListMode(s string) (StructureMapSourceListMode, error) {
+	return parseCode(s, structureMapSourceListModeDisplays)
+}
+
 // StructureMapTargetListMode represents Structure Map Target List Mode.
 type StructureMapTargetListMode string
 
@@ -2740,6 +7604,39 @@ const (
 	StructureMapTargetListModeSingle StructureMapTargetListMode = "single"
 )
 
+// structureMapTargetListModeDisplays maps each StructureMapTargetListMode code to its display name.
+var structureMapTargetListModeDisplays = map[StructureMapTargetListMode]string{
+	StructureMapTargetListModeFirst:  "First",
+	StructureMapTargetListModeShare:  "Share",
+	StructureMapTargetListModeLast:   "Last",
+	StructureMapTargetListModeSingle: "single",
+}
+
+// Code returns the code value.
+func (c StructureMapTargetListMode) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the StructureMapTargetListMode values.
+func (c StructureMapTargetListMode) IsValid() bool {
+	_, ok := structureMapTargetListModeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c StructureMapTargetListMode) Display() string {
+	if display := structureMapTargetListModeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseStructureMapTargetListMode returns the StructureMapTargetListMode for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseStructureMapTargetListMode(s string) (StructureMapTargetListMode, error) {
+	return parseCode(s, structureMapTargetListModeDisplays)
+}
+
 // StructureMapTransform represents Structure Map Transform.
 type StructureMapTransform string
 
@@ -2781,6 +7678,52 @@ const (
 	StructureMapTransformCp StructureMapTransform = "cp"
 )
 
+// structureMapTransformDisplays maps each StructureMapTransform code to its display name.
+var structureMapTransformDisplays = map[StructureMapTransform]string{
+	StructureMapTransformCreate:    "create",
+	StructureMapTransformCopy:      "copy",
+	StructureMapTransformTruncate:  "truncate",
+	StructureMapTransformEscape:    "escape",
+	StructureMapTransformCast:      "cast",
+	StructureMapTransformAppend:    "append",
+	StructureMapTransformTranslate: "translate",
+	StructureMapTransformReference: "reference",
+	StructureMapTransformDateop:    "dateOp",
+	StructureMapTransformUuid:      "uuid",
+	StructureMapTransformPointer:   "pointer",
+	StructureMapTransformEvaluate:  "evaluate",
+	StructureMapTransformCc:        "cc",
+	StructureMapTransformC:         "c",
+	StructureMapTransformQty:       "qty",
+	StructureMapTransformId:        "id",
+	StructureMapTransformCp:        "cp",
+}
+
+// Code returns the code value.
+func (c StructureMapTransform) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the StructureMapTransform values.
+func (c StructureMapTransform) IsValid() bool {
+	_, ok := structureMapTransformDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c StructureMapTransform) Display() string {
+	if display := structureMapTransformDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseStructureMapTransform returns the StructureMapTransform for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseStructureMapTransform(s string) (StructureMapTransform, error) {
+	return parseCode(s, structureMapTransformDisplays)
+}
+
 // MeasureReportStatus represents Measure Report Status.
 type MeasureReportStatus string
 
@@ -2794,6 +7737,38 @@ const (
 	MeasureReportStatusError MeasureReportStatus = "error"
 )
 
+// measureReportStatusDisplays maps each MeasureReportStatus code to its display name.
+var measureReportStatusDisplays = map[MeasureReportStatus]string{
+	MeasureReportStatusComplete: "Complete",
+	MeasureReportStatusPending:  "Pending",
+	MeasureReportStatusError:    "Error",
+}
+
+// Code returns the code value.
+func (c MeasureReportStatus) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the MeasureReportStatus values.
+func (c MeasureReportStatus) IsValid() bool {
+	_, ok := measureReportStatusDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c MeasureReportStatus) Display() string {
+	if display := measureReportStatusDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseMeasureReportStatus returns the MeasureReportStatus for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseMeasureReportStatus(s string) (MeasureReportStatus, error) {
+	return parseCode(s, measureReportStatusDisplays)
+}
+
 // MeasureReportType represents Measure Report Type.
 type MeasureReportType string
 
@@ -2809,6 +7784,39 @@ const (
 	MeasureReportTypeDataExchange MeasureReportType = "data-exchange"
 )
 
+// measureReportTypeDisplays maps each MeasureReportType code to its display name.
+var measureReportTypeDisplays = map[MeasureReportType]string{
+	MeasureReportTypeIndividual:   "Individual",
+	MeasureReportTypeSubjectList:  "Subject List",
+	MeasureReportTypeSummary:      "Summary",
+	MeasureReportTypeDataExchange: "Data Exchange",
+}
+
+// Code returns the code value.
+func (c MeasureReportType) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the MeasureReportType values.
+func (c MeasureReportType) IsValid() bool {
+	_, ok := measureReportTypeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c MeasureReportType) Display() string {
+	if display := measureReportTypeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseMeasureReportType returns the MeasureReportType for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseMeasureReportType(s string) (MeasureReportType, error) {
+	return parseCode(s, measureReportTypeDisplays)
+}
+
 // MedicationAdministrationStatusCodes represents MedicationAdministration Status Codes.
 type MedicationAdministrationStatusCodes string
 
@@ -2830,6 +7838,42 @@ const (
 	MedicationAdministrationStatusCodesUnknown MedicationAdministrationStatusCodes = "unknown"
 )
 
+// medicationAdministrationStatusCodesDisplays maps each MedicationAdministrationStatusCodes code to its display name.
+var medicationAdministrationStatusCodesDisplays = map[MedicationAdministrationStatusCodes]string{
+	MedicationAdministrationStatusCodesInProgress:     "In Progress",
+	MedicationAdministrationStatusCodesNotDone:        "Not Done",
+	MedicationAdministrationStatusCodesOnHold:         "On Hold",
+	MedicationAdministrationStatusCodesCompleted:      "Completed",
+	MedicationAdministrationStatusCodesEnteredInError: "Entered in Error",
+	MedicationAdministrationStatusCodesStopped:        "Stopped",
+	MedicationAdministrationStatusCodesUnknown:        "Unknown",
+}
+
+// Code returns the code value.
+func (c MedicationAdministrationStatusCodes) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the MedicationAdministrationStatusCodes values.
+func (c MedicationAdministrationStatusCodes) IsValid() bool {
+	_, ok := medicationAdministrationStatusCodesDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c MedicationAdministrationStatusCodes) Display() string {
+	if display := medicationAdministrationStatusCodesDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseMedicationAdministrationStatusCodes returns the MedicationAdministrationStatusCodes for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseMedicationAdministrationStatusCodes(s string) (MedicationAdministrationStatusCodes, error) {
+	return parseCode(s, medicationAdministrationStatusCodesDisplays)
+}
+
 // MedicationStatementStatusCodes represents MedicationStatement Status Codes.
 type MedicationStatementStatusCodes string
 
@@ -2843,6 +7887,38 @@ const (
 	MedicationStatementStatusCodesDraft MedicationStatementStatusCodes = "draft"
 )
 
+// medicationStatementStatusCodesDisplays maps each MedicationStatementStatusCodes code to its display name.
+var medicationStatementStatusCodesDisplays = map[MedicationStatementStatusCodes]string{
+	MedicationStatementStatusCodesRecorded:       "Recorded",
+	MedicationStatementStatusCodesEnteredInError: "Entered in Error",
+	MedicationStatementStatusCodesDraft:          "Draft",
+}
+
+// Code returns the code value.
+func (c MedicationStatementStatusCodes) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the MedicationStatementStatusCodes values.
+func (c MedicationStatementStatusCodes) IsValid() bool {
+	_, ok := medicationStatementStatusCodesDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c MedicationStatementStatusCodes) Display() string {
+	if display := medicationStatementStatusCodesDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseMedicationStatementStatusCodes returns the MedicationStatementStatusCodes for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseMedicationStatementStatusCodes(s string) (MedicationStatementStatusCodes, error) {
+	return parseCode(s, medicationStatementStatusCodesDisplays)
+}
+
 // MedicationStatusCodes represents Medication Status Codes.
 type MedicationStatusCodes string
 
@@ -2856,6 +7932,38 @@ const (
 	MedicationStatusCodesEnteredInError MedicationStatusCodes = "entered-in-error"
 )
 
+// medicationStatusCodesDisplays maps each MedicationStatusCodes code to its display name.
+var medicationStatusCodesDisplays = map[MedicationStatusCodes]string{
+	MedicationStatusCodesActive:         "Active",
+	MedicationStatusCodesInactive:       "Inactive",
+	MedicationStatusCodesEnteredInError: "Entered in Error",
+}
+
+// Code returns the code value.
+func (c MedicationStatusCodes) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the MedicationStatusCodes values.
+func (c MedicationStatusCodes) IsValid() bool {
+	_, ok := medicationStatusCodesDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c MedicationStatusCodes) Display() string {
+	if display := medicationStatusCodesDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseMedicationStatusCodes returns the MedicationStatusCodes for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseMedicationStatusCodes(s string) (MedicationStatusCodes, error) {
+	return parseCode(s, medicationStatusCodesDisplays)
+}
+
 // MedicationDispenseStatusCodes represents MedicationDispense Status Codes.
 type MedicationDispenseStatusCodes string
 
@@ -2881,6 +7989,44 @@ const (
 	MedicationDispenseStatusCodesUnknown MedicationDispenseStatusCodes = "unknown"
 )
 
+// medicationDispenseStatusCodesDisplays maps each MedicationDispenseStatusCodes code to its display name.
+var medicationDispenseStatusCodesDisplays = map[MedicationDispenseStatusCodes]string{
+	MedicationDispenseStatusCodesPreparation:    "Preparation",
+	MedicationDispenseStatusCodesInProgress:     "In Progress",
+	MedicationDispenseStatusCodesCancelled:      "Cancelled",
+	MedicationDispenseStatusCodesOnHold:         "On Hold",
+	MedicationDispenseStatusCodesCompleted:      "Completed",
+	MedicationDispenseStatusCodesEnteredInError: "Entered in Error",
+	MedicationDispenseStatusCodesStopped:        "Stopped",
+	MedicationDispenseStatusCodesDeclined:       "Declined",
+	MedicationDispenseStatusCodesUnknown:        "Unknown",
+}
+
+// Code returns the code value.
+func (c MedicationDispenseStatusCodes) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the MedicationDispenseStatusCodes values.
+func (c MedicationDispenseStatusCodes) IsValid() bool {
+	_, ok := medicationDispenseStatusCodesDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c MedicationDispenseStatusCodes) Display() string {
+	if display := medicationDispenseStatusCodesDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseMedicationDispenseStatusCodes returns the MedicationDispenseStatusCodes for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseMedicationDispenseStatusCodes(s string) (MedicationDispenseStatusCodes, error) {
+	return parseCode(s, medicationDispenseStatusCodesDisplays)
+}
+
 // MedicationKnowledgeStatusCodes represents MedicationKnowledge Status Codes.
 type MedicationKnowledgeStatusCodes string
 
@@ -2894,6 +8040,38 @@ const (
 	MedicationKnowledgeStatusCodesInactive MedicationKnowledgeStatusCodes = "inactive"
 )
 
+// medicationKnowledgeStatusCodesDisplays maps each MedicationKnowledgeStatusCodes code to its display name.
+var medicationKnowledgeStatusCodesDisplays = map[MedicationKnowledgeStatusCodes]string{
+	MedicationKnowledgeStatusCodesActive:         "Active",
+	MedicationKnowledgeStatusCodesEnteredInError: "Entered in Error",
+	MedicationKnowledgeStatusCodesInactive:       "Inactive",
+}
+
+// Code returns the code value.
+func (c MedicationKnowledgeStatusCodes) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the MedicationKnowledgeStatusCodes values.
+func (c MedicationKnowledgeStatusCodes) IsValid() bool {
+	_, ok := medicationKnowledgeStatusCodesDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c MedicationKnowledgeStatusCodes) Display() string {
+	if display := medicationKnowledgeStatusCodesDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseMedicationKnowledgeStatusCodes returns the MedicationKnowledgeStatusCodes for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseMedicationKnowledgeStatusCodes(s string) (MedicationKnowledgeStatusCodes, error) {
+	return parseCode(s, medicationKnowledgeStatusCodesDisplays)
+}
+
 // MedicationRequestIntent represents medicationRequest Intent.
 type MedicationRequestIntent string
 
@@ -2917,6 +8095,43 @@ const (
 	MedicationRequestIntentOption MedicationRequestIntent = "option"
 )
 
+// medicationRequestIntentDisplays maps each MedicationRequestIntent code to its display name.
+var medicationRequestIntentDisplays = map[MedicationRequestIntent]string{
+	MedicationRequestIntentProposal:      "Proposal",
+	MedicationRequestIntentPlan:          "Plan",
+	MedicationRequestIntentOrder:         "Order",
+	MedicationRequestIntentOriginalOrder: "Original Order",
+	MedicationRequestIntentReflexOrder:   "Reflex Order",
+	MedicationRequestIntentFillerOrder:   "Filler Order",
+	MedicationRequestIntentInstanceOrder: "Instance Order",
+	MedicationRequestIntentOption:        "Option",
+}
+
+// Code returns the code value.
+func (c MedicationRequestIntent) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the MedicationRequestIntent values.
+func (c MedicationRequestIntent) IsValid() bool {
+	_, ok := medicationRequestIntentDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c MedicationRequestIntent) Display() string {
+	if display := medicationRequestIntentDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseMedicationRequestIntent returns the MedicationRequestIntent for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseMedicationRequestIntent(s string) (MedicationRequestIntent, error) {
+	return parseCode(s, medicationRequestIntentDisplays)
+}
+
 // MedicationrequestStatus represents medicationrequest Status.
 type MedicationrequestStatus string
 
@@ -2942,6 +8157,44 @@ const (
 	MedicationrequestStatusUnknown MedicationrequestStatus = "unknown"
 )
 
+// medicationrequestStatusDisplays maps each MedicationrequestStatus code to its display name.
+var medicationrequestStatusDisplays = map[MedicationrequestStatus]string{
+	MedicationrequestStatusActive:         "Active",
+	MedicationrequestStatusOnHold:         "On Hold",
+	MedicationrequestStatusEnded:          "Ended",
+	MedicationrequestStatusStopped:        "Stopped",
+	MedicationrequestStatusCompleted:      "Completed",
+	MedicationrequestStatusCancelled:      "Cancelled",
+	MedicationrequestStatusEnteredInError: "Entered in Error",
+	MedicationrequestStatusDraft:          "Draft",
+	MedicationrequestStatusUnknown:        "Unknown",
+}
+
+// Code returns the code value.
+func (c MedicationrequestStatus) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the MedicationrequestStatus values.
+func (c MedicationrequestStatus) IsValid() bool {
+	_, ok := medicationrequestStatusDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c MedicationrequestStatus) Display() string {
+	if display := medicationrequestStatusDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseMedicationrequestStatus returns the MedicationrequestStatus for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseMedicationrequestStatus(s string) (MedicationrequestStatus, error) {
+	return parseCode(s, medicationrequestStatusDisplays)
+}
+
 // MessageSignificanceCategory represents Message Significance Category.
 type MessageSignificanceCategory string
 
@@ -2955,6 +8208,38 @@ const (
 	MessageSignificanceCategoryNotification MessageSignificanceCategory = "notification"
 )
 
+// messageSignificanceCategoryDisplays maps each MessageSignificanceCategory code to its display name.
+var messageSignificanceCategoryDisplays = map[MessageSignificanceCategory]string{
+	MessageSignificanceCategoryConsequence:  "Consequence",
+	MessageSignificanceCategoryCurrency:     "Currency",
+	MessageSignificanceCategoryNotification: "Notification",
+}
+
+// Code returns the code value.
+func (c MessageSignificanceCategory) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the MessageSignificanceCategory values.
+func (c MessageSignificanceCategory) IsValid() bool {
+	_, ok := messageSignificanceCategoryDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c MessageSignificanceCategory) Display() string {
+	if display := messageSignificanceCategoryDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseMessageSignificanceCategory returns the MessageSignificanceCategory for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseMessageSignificanceCategory(s string) (MessageSignificanceCategory, error) {
+	return parseCode(s, messageSignificanceCategoryDisplays)
+}
+
 // MessageheaderResponseRequest represents messageheader-response-request.
 type MessageheaderResponseRequest string
 
@@ -2970,6 +8255,39 @@ const (
 	MessageheaderResponseRequestOnSuccess MessageheaderResponseRequest = "on-success"
 )
 
+// messageheaderResponseRequestDisplays maps each MessageheaderResponseRequest code to its display name.
+var messageheaderResponseRequestDisplays = map[MessageheaderResponseRequest]string{
+	MessageheaderResponseRequestAlways:    "Always",
+	MessageheaderResponseRequestOnError:   "Error/reject conditions only",
+	MessageheaderResponseRequestNever:     "Never",
+	MessageheaderResponseRequestOnSuccess: "Successful completion only",
+}
+
+// Code returns the code value.
+func (c MessageheaderResponseRequest) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the MessageheaderResponseRequest values.
+func (c MessageheaderResponseRequest) IsValid() bool {
+	_, ok := messageheaderResponseRequestDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c MessageheaderResponseRequest) Display() string {
+	if display := messageheaderResponseRequestDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseMessageheaderResponseRequest returns the MessageheaderResponseRequest for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseMessageheaderResponseRequest(s string) (MessageheaderResponseRequest, error) {
+	return parseCode(s, messageheaderResponseRequestDisplays)
+}
+
 // DeviceMetricCalibrationState represents Device Metric Calibration State.
 type DeviceMetricCalibrationState string
 
@@ -2985,6 +8303,39 @@ const (
 	DeviceMetricCalibrationStateUnspecified DeviceMetricCalibrationState = "unspecified"
 )
 
+// deviceMetricCalibrationStateDisplays maps each DeviceMetricCalibrationState code to its display name.
+var deviceMetricCalibrationStateDisplays = map[DeviceMetricCalibrationState]string{
+	DeviceMetricCalibrationStateNotCalibrated:       "Not Calibrated",
+	DeviceMetricCalibrationStateCalibrationRequired: "Calibration Required",
+	DeviceMetricCalibrationStateCalibrated:          "Calibrated",
+	DeviceMetricCalibrationStateUnspecified:         "Unspecified",
+}
+
+// Code returns the code value.
+func (c DeviceMetricCalibrationState) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the DeviceMetricCalibrationState values.
+func (c DeviceMetricCalibrationState) IsValid() bool {
+	_, ok := deviceMetricCalibrationStateDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c DeviceMetricCalibrationState) Display() string {
+	if display := deviceMetricCalibrationStateDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseDeviceMetricCalibrationState returns the DeviceMetricCalibrationState for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseDeviceMetricCalibrationState(s string) (DeviceMetricCalibrationState, error) {
+	return parseCode(s, deviceMetricCalibrationStateDisplays)
+}
+
 // DeviceMetricCalibrationType represents Device Metric Calibration Type.
 type DeviceMetricCalibrationType string
 
@@ -3000,6 +8351,39 @@ const (
 	DeviceMetricCalibrationTypeTwoPoint DeviceMetricCalibrationType = "two-point"
 )
 
+// deviceMetricCalibrationTypeDisplays maps each DeviceMetricCalibrationType code to its display name.
+var deviceMetricCalibrationTypeDisplays = map[DeviceMetricCalibrationType]string{
+	DeviceMetricCalibrationTypeUnspecified: "Unspecified",
+	DeviceMetricCalibrationTypeOffset:      "Offset",
+	DeviceMetricCalibrationTypeGain:        "Gain",
+	DeviceMetricCalibrationTypeTwoPoint:    "Two Point",
+}
+
+// Code returns the code value.
+func (c DeviceMetricCalibrationType) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the DeviceMetricCalibrationType values.
+func (c DeviceMetricCalibrationType) IsValid() bool {
+	_, ok := deviceMetricCalibrationTypeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c DeviceMetricCalibrationType) Display() string {
+	if display := deviceMetricCalibrationTypeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseDeviceMetricCalibrationType returns the DeviceMetricCalibrationType for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseDeviceMetricCalibrationType(s string) (DeviceMetricCalibrationType, error) {
+	return parseCode(s, deviceMetricCalibrationTypeDisplays)
+}
+
 // DeviceMetricCategory represents Device Metric Category.
 type DeviceMetricCategory string
 
@@ -3015,6 +8399,39 @@ const (
 	DeviceMetricCategoryUnspecified DeviceMetricCategory = "unspecified"
 )
 
+// deviceMetricCategoryDisplays maps each DeviceMetricCategory code to its display name.
+var deviceMetricCategoryDisplays = map[DeviceMetricCategory]string{
+	DeviceMetricCategoryMeasurement: "Measurement",
+	DeviceMetricCategorySetting:     "Setting",
+	DeviceMetricCategoryCalculation: "Calculation",
+	DeviceMetricCategoryUnspecified: "Unspecified",
+}
+
+// Code returns the code value.
+func (c DeviceMetricCategory) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the DeviceMetricCategory values.
+func (c DeviceMetricCategory) IsValid() bool {
+	_, ok := deviceMetricCategoryDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c DeviceMetricCategory) Display() string {
+	if display := deviceMetricCategoryDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseDeviceMetricCategory returns the DeviceMetricCategory for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseDeviceMetricCategory(s string) (DeviceMetricCategory, error) {
+	return parseCode(s, deviceMetricCategoryDisplays)
+}
+
 // DeviceMetricOperationalStatus represents Device Metric Operational Status.
 type DeviceMetricOperationalStatus string
 
@@ -3030,6 +8447,39 @@ const (
 	DeviceMetricOperationalStatusEnteredInError DeviceMetricOperationalStatus = "entered-in-error"
 )
 
+// deviceMetricOperationalStatusDisplays maps each DeviceMetricOperationalStatus code to its display name.
+var deviceMetricOperationalStatusDisplays = map[DeviceMetricOperationalStatus]string{
+	DeviceMetricOperationalStatusOn:             "On",
+	DeviceMetricOperationalStatusOff:            "Off",
+	DeviceMetricOperationalStatusStandby:        "Standby",
+	DeviceMetricOperationalStatusEnteredInError: "Entered In Error",
+}
+
+// Code returns the code value.
+func (c DeviceMetricOperationalStatus) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the DeviceMetricOperationalStatus values.
+func (c DeviceMetricOperationalStatus) IsValid() bool {
+	_, ok := deviceMetricOperationalStatusDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c DeviceMetricOperationalStatus) Display() string {
+	if display := deviceMetricOperationalStatusDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseDeviceMetricOperationalStatus returns the DeviceMetricOperationalStatus for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseDeviceMetricOperationalStatus(s string) (DeviceMetricOperationalStatus, error) {
+	return parseCode(s, deviceMetricOperationalStatusDisplays)
+}
+
 // NameUse represents NameUse.
 type NameUse string
 
@@ -3051,6 +8501,42 @@ const (
 	NameUseMaiden NameUse = "maiden"
 )
 
+// nameUseDisplays maps each NameUse code to its display name.
+var nameUseDisplays = map[NameUse]string{
+	NameUseUsual:     "Usual",
+	NameUseOfficial:  "Official",
+	NameUseTemp:      "Temp",
+	NameUseNickname:  "Nickname",
+	NameUseAnonymous: "Anonymous",
+	NameUseOld:       "Old",
+	NameUseMaiden:    "Name changed for Marriage",
+}
+
+// Code returns the code value.
+func (c NameUse) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the NameUse values.
+func (c NameUse) IsValid() bool {
+	_, ok := nameUseDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c NameUse) Display() string {
+	if display := nameUseDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseNameUse returns the NameUse for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseNameUse(s string) (NameUse, error) {
+	return parseCode(s, nameUseDisplays)
+}
+
 // NamingSystemIdentifierType represents Naming System Identifier Type.
 type NamingSystemIdentifierType string
 
@@ -3070,6 +8556,41 @@ const (
 	NamingSystemIdentifierTypeOther NamingSystemIdentifierType = "other"
 )
 
+// namingSystemIdentifierTypeDisplays maps each NamingSystemIdentifierType code to its display name.
+var namingSystemIdentifierTypeDisplays = map[NamingSystemIdentifierType]string{
+	NamingSystemIdentifierTypeOid:          "OID",
+	NamingSystemIdentifierTypeUuid:         "UUID",
+	NamingSystemIdentifierTypeUri:          "URI",
+	NamingSystemIdentifierTypeIriStem:      "IRI stem",
+	NamingSystemIdentifierTypeV2csmnemonic: "V2CSMNemonic",
+	NamingSystemIdentifierTypeOther:        "Other",
+}
+
+// Code returns the code value.
+func (c NamingSystemIdentifierType) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the NamingSystemIdentifierType values.
+func (c NamingSystemIdentifierType) IsValid() bool {
+	_, ok := namingSystemIdentifierTypeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c NamingSystemIdentifierType) Display() string {
+	if display := namingSystemIdentifierTypeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseNamingSystemIdentifierType returns the NamingSystemIdentifierType for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseNamingSystemIdentifierType(s string) (NamingSystemIdentifierType, error) {
+	return parseCode(s, namingSystemIdentifierTypeDisplays)
+}
+
 // NamingSystemType represents Naming System Type.
 type NamingSystemType string
 
@@ -3083,6 +8604,38 @@ const (
 	NamingSystemTypeRoot NamingSystemType = "root"
 )
 
+// namingSystemTypeDisplays maps each NamingSystemType code to its display name.
+var namingSystemTypeDisplays = map[NamingSystemType]string{
+	NamingSystemTypeCodesystem: "Code System",
+	NamingSystemTypeIdentifier: "Identifier",
+	NamingSystemTypeRoot:       "Root",
+}
+
+// Code returns the code value.
+func (c NamingSystemType) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the NamingSystemType values.
+func (c NamingSystemType) IsValid() bool {
+	_, ok := namingSystemTypeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c NamingSystemType) Display() string {
+	if display := namingSystemTypeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseNamingSystemType returns the NamingSystemType for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseNamingSystemType(s string) (NamingSystemType, error) {
+	return parseCode(s, namingSystemTypeDisplays)
+}
+
 // NarrativeStatus represents NarrativeStatus.
 type NarrativeStatus string
 
@@ -3098,6 +8651,39 @@ const (
 	NarrativeStatusEmpty NarrativeStatus = "empty"
 )
 
+// narrativeStatusDisplays maps each NarrativeStatus code to its display name.
+var narrativeStatusDisplays = map[NarrativeStatus]string{
+	NarrativeStatusGenerated:  "Generated",
+	NarrativeStatusExtensions: "Extensions",
+	NarrativeStatusAdditional: "Additional",
+	NarrativeStatusEmpty:      "Empty",
+}
+
+// Code returns the code value.
+func (c NarrativeStatus) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the NarrativeStatus values.
+func (c NarrativeStatus) IsValid() bool {
+	_, ok := narrativeStatusDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c NarrativeStatus) Display() string {
+	if display := narrativeStatusDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseNarrativeStatus returns the NarrativeStatus for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseNarrativeStatus(s string) (NarrativeStatus, error) {
+	return parseCode(s, narrativeStatusDisplays)
+}
+
 // NoteType represents NoteType.
 type NoteType string
 
@@ -3111,6 +8697,38 @@ const (
 	NoteTypePrintoper NoteType = "printoper"
 )
 
+// noteTypeDisplays maps each NoteType code to its display name.
+var noteTypeDisplays = map[NoteType]string{
+	NoteTypeDisplay:   "Display",
+	NoteTypePrint:     "Print (Form)",
+	NoteTypePrintoper: "Print (Operator)",
+}
+
+// Code returns the code value.
+func (c NoteType) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the NoteType values.
+func (c NoteType) IsValid() bool {
+	_, ok := noteTypeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c NoteType) Display() string {
+	if display := noteTypeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseNoteType returns the NoteType for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseNoteType(s string) (NoteType, error) {
+	return parseCode(s, noteTypeDisplays)
+}
+
 // NutritionProductStatus represents Nutrition Product Status.
 type NutritionProductStatus string
 
@@ -3124,6 +8742,38 @@ const (
 	NutritionProductStatusEnteredInError NutritionProductStatus = "entered-in-error"
 )
 
+// nutritionProductStatusDisplays maps each NutritionProductStatus code to its display name.
+var nutritionProductStatusDisplays = map[NutritionProductStatus]string{
+	NutritionProductStatusActive:         "Active",
+	NutritionProductStatusInactive:       "Inactive",
+	NutritionProductStatusEnteredInError: "Entered in Error",
+}
+
+// Code returns the code value.
+func (c NutritionProductStatus) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the NutritionProductStatus values.
+func (c NutritionProductStatus) IsValid() bool {
+	_, ok := nutritionProductStatusDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c NutritionProductStatus) Display() string {
+	if display := nutritionProductStatusDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseNutritionProductStatus returns the NutritionProductStatus for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseNutritionProductStatus(s string) (NutritionProductStatus, error) {
+	return parseCode(s, nutritionProductStatusDisplays)
+}
+
 // ObservationRangeCategory represents Observation Range Category.
 type ObservationRangeCategory string
 
@@ -3137,6 +8787,38 @@ const (
 	ObservationRangeCategoryAbsolute ObservationRangeCategory = "absolute"
 )
 
+// observationRangeCategoryDisplays maps each ObservationRangeCategory code to its display name.
+var observationRangeCategoryDisplays = map[ObservationRangeCategory]string{
+	ObservationRangeCategoryReference: "reference range",
+	ObservationRangeCategoryCritical:  "critical range",
+	ObservationRangeCategoryAbsolute:  "absolute range",
+}
+
+// Code returns the code value.
+func (c ObservationRangeCategory) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the ObservationRangeCategory values.
+func (c ObservationRangeCategory) IsValid() bool {
+	_, ok := observationRangeCategoryDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c ObservationRangeCategory) Display() string {
+	if display := observationRangeCategoryDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseObservationRangeCategory returns the ObservationRangeCategory for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseObservationRangeCategory(s string) (ObservationRangeCategory, error) {
+	return parseCode(s, observationRangeCategoryDisplays)
+}
+
 // ObservationStatus represents Observation Status.
 type ObservationStatus string
 
@@ -3160,6 +8842,43 @@ const (
 	ObservationStatusUnknown ObservationStatus = "unknown"
 )
 
+// observationStatusDisplays maps each ObservationStatus code to its display name.
+var observationStatusDisplays = map[ObservationStatus]string{
+	ObservationStatusRegistered:     "Registered",
+	ObservationStatusPreliminary:    "Preliminary",
+	ObservationStatusFinal:          "Final",
+	ObservationStatusAmended:        "Amended",
+	ObservationStatusCorrected:      "Corrected",
+	ObservationStatusCancelled:      "Cancelled",
+	ObservationStatusEnteredInError: "Entered in Error",
+	ObservationStatusUnknown:        "Unknown",
+}
+
+// Code returns the code value.
+func (c ObservationStatus) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the ObservationStatus values.
+func (c ObservationStatus) IsValid() bool {
+	_, ok := observationStatusDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c ObservationStatus) Display() string {
+	if display := observationStatusDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseObservationStatus returns the ObservationStatus for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseObservationStatus(s string) (ObservationStatus, error) {
+	return parseCode(s, observationStatusDisplays)
+}
+
 // TriggeredBytype represents triggered Bytype.
 type TriggeredBytype string
 
@@ -3173,6 +8892,38 @@ const (
 	TriggeredBytypeReRun TriggeredBytype = "re-run"
 )
 
+// triggeredBytypeDisplays maps each TriggeredBytype code to its display name.
+var triggeredBytypeDisplays = map[TriggeredBytype]string{
+	TriggeredBytypeReflex: "Reflex",
+	TriggeredBytypeRepeat: "Repeat (per policy)",
+	TriggeredBytypeReRun:  "Re-run (per policy)",
+}
+
+// Code returns the code value.
+func (c TriggeredBytype) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the TriggeredBytype values.
+func (c TriggeredBytype) IsValid() bool {
+	_, ok := triggeredBytypeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c TriggeredBytype) Display() string {
+	if display := triggeredBytypeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseTriggeredBytype returns the TriggeredBytype for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseTriggeredBytype(s string) (TriggeredBytype, error) {
+	return parseCode(s, triggeredBytypeDisplays)
+}
+
 // OperationKind represents Operation Kind.
 type OperationKind string
 
@@ -3184,6 +8935,37 @@ const (
 	OperationKindQuery OperationKind = "query"
 )
 
+// operationKindDisplays maps each OperationKind code to its display name.
+var operationKindDisplays = map[OperationKind]string{
+	OperationKindOperation: "Operation",
+	OperationKindQuery:     "Query",
+}
+
+// Code returns the code value.
+func (c OperationKind) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the OperationKind values.
+func (c OperationKind) IsValid() bool {
+	_, ok := operationKindDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c OperationKind) Display() string {
+	if display := operationKindDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseOperationKind returns the OperationKind for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseOperationKind(s string) (OperationKind, error) {
+	return parseCode(s, operationKindDisplays)
+}
+
 // OperationParameterScope represents Operation Parameter Scope.
 type OperationParameterScope string
 
@@ -3197,6 +8979,38 @@ const (
 	OperationParameterScopeSystem OperationParameterScope = "system"
 )
 
+// operationParameterScopeDisplays maps each OperationParameterScope code to its display name.
+var operationParameterScopeDisplays = map[OperationParameterScope]string{
+	OperationParameterScopeInstance: "Instance",
+	OperationParameterScopeType:     "Type",
+	OperationParameterScopeSystem:   "System",
+}
+
+// Code returns the code value.
+func (c OperationParameterScope) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the OperationParameterScope values.
+func (c OperationParameterScope) IsValid() bool {
+	_, ok := operationParameterScopeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c OperationParameterScope) Display() string {
+	if display := operationParameterScopeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseOperationParameterScope returns the OperationParameterScope for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseOperationParameterScope(s string) (OperationParameterScope, error) {
+	return parseCode(s, operationParameterScopeDisplays)
+}
+
 // OperationParameterUse represents Operation Parameter Use.
 type OperationParameterUse string
 
@@ -3208,6 +9022,37 @@ const (
 	OperationParameterUseOut OperationParameterUse = "out"
 )
 
+// operationParameterUseDisplays maps each OperationParameterUse code to its display name.
+var operationParameterUseDisplays = map[OperationParameterUse]string{
+	OperationParameterUseIn:  "In",
+	OperationParameterUseOut: "Out",
+}
+
+// Code returns the code value.
+func (c OperationParameterUse) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the OperationParameterUse values.
+func (c OperationParameterUse) IsValid() bool {
+	_, ok := operationParameterUseDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c OperationParameterUse) Display() string {
+	if display := operationParameterUseDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseOperationParameterUse returns the OperationParameterUse for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseOperationParameterUse(s string) (OperationParameterUse, error) {
+	return parseCode(s, operationParameterUseDisplays)
+}
+
 // OrientationType represents orientation Type.
 type OrientationType string
 
@@ -3219,6 +9064,37 @@ const (
 	OrientationTypeAntisense OrientationType = "antisense"
 )
 
+// orientationTypeDisplays maps each OrientationType code to its display name.
+var orientationTypeDisplays = map[OrientationType]string{
+	OrientationTypeSense:     "Sense orientation of referenceSeq",
+	OrientationTypeAntisense: "Antisense orientation of referenceSeq",
+}
+
+// Code returns the code value.
+func (c OrientationType) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the OrientationType values.
+func (c OrientationType) IsValid() bool {
+	_, ok := orientationTypeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c OrientationType) Display() string {
+	if display := orientationTypeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseOrientationType returns the OrientationType for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseOrientationType(s string) (OrientationType, error) {
+	return parseCode(s, orientationTypeDisplays)
+}
+
 // ParticipationStatus represents Participation Status.
 type ParticipationStatus string
 
@@ -3234,6 +9110,39 @@ const (
 	ParticipationStatusNeedsAction ParticipationStatus = "needs-action"
 )
 
+// participationStatusDisplays maps each ParticipationStatus code to its display name.
+var participationStatusDisplays = map[ParticipationStatus]string{
+	ParticipationStatusAccepted:    "Accepted",
+	ParticipationStatusDeclined:    "Declined",
+	ParticipationStatusTentative:   "Tentative",
+	ParticipationStatusNeedsAction: "Needs Action",
+}
+
+// Code returns the code value.
+func (c ParticipationStatus) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the ParticipationStatus values.
+func (c ParticipationStatus) IsValid() bool {
+	_, ok := participationStatusDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c ParticipationStatus) Display() string {
+	if display := participationStatusDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseParticipationStatus returns the ParticipationStatus for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseParticipationStatus(s string) (ParticipationStatus, error) {
+	return parseCode(s, participationStatusDisplays)
+}
+
 // PaymentOutcome represents Payment Outcome.
 type PaymentOutcome string
 
@@ -3249,6 +9158,39 @@ const (
 	PaymentOutcomePartial PaymentOutcome = "partial"
 )
 
+// paymentOutcomeDisplays maps each PaymentOutcome code to its display name.
+var paymentOutcomeDisplays = map[PaymentOutcome]string{
+	PaymentOutcomeQueued:   "Queued",
+	PaymentOutcomeComplete: "Processing Complete",
+	PaymentOutcomeError:    "Error",
+	PaymentOutcomePartial:  "Partial Processing",
+}
+
+// Code returns the code value.
+func (c PaymentOutcome) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the PaymentOutcome values.
+func (c PaymentOutcome) IsValid() bool {
+	_, ok := paymentOutcomeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c PaymentOutcome) Display() string {
+	if display := paymentOutcomeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParsePaymentOutcome returns the PaymentOutcome for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParsePaymentOutcome(s string) (PaymentOutcome, error) {
+	return parseCode(s, paymentOutcomeDisplays)
+}
+
 // PermissionRuleCombining represents Permission Rule Combining.
 type PermissionRuleCombining string
 
@@ -3268,6 +9210,41 @@ const (
 	PermissionRuleCombiningPermitUnlessDeny PermissionRuleCombining = "permit-unless-deny"
 )
 
+// permissionRuleCombiningDisplays maps each PermissionRuleCombining code to its display name.
+var permissionRuleCombiningDisplays = map[PermissionRuleCombining]string{
+	PermissionRuleCombiningDenyOverrides:          "Deny-overrides",
+	PermissionRuleCombiningPermitOverrides:        "Permit-overrides",
+	PermissionRuleCombiningOrderedDenyOverrides:   "Ordered-deny-overrides",
+	PermissionRuleCombiningOrderedPermitOverrides: "Ordered-permit-overrides",
+	PermissionRuleCombiningDenyUnlessPermit:       "Deny-unless-permit",
+	PermissionRuleCombiningPermitUnlessDeny:       "Permit-unless-deny",
+}
+
+// Code returns the code value.
+func (c PermissionRuleCombining) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the PermissionRuleCombining values.
+func (c PermissionRuleCombining) IsValid() bool {
+	_, ok := permissionRuleCombiningDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c PermissionRuleCombining) Display() string {
+	if display := permissionRuleCombiningDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParsePermissionRuleCombining returns the PermissionRuleCombining for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParsePermissionRuleCombining(s string) (PermissionRuleCombining, error) {
+	return parseCode(s, permissionRuleCombiningDisplays)
+}
+
 // PermissionStatus represents Permission Status.
 type PermissionStatus string
 
@@ -3283,6 +9260,39 @@ const (
 	PermissionStatusRejected PermissionStatus = "rejected"
 )
 
+// permissionStatusDisplays maps each PermissionStatus code to its display name.
+var permissionStatusDisplays = map[PermissionStatus]string{
+	PermissionStatusActive:         "Active",
+	PermissionStatusEnteredInError: "Entered in Error",
+	PermissionStatusDraft:          "Draft",
+	PermissionStatusRejected:       "Rejected",
+}
+
+// Code returns the code value.
+func (c PermissionStatus) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the PermissionStatus values.
+func (c PermissionStatus) IsValid() bool {
+	_, ok := permissionStatusDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c PermissionStatus) Display() string {
+	if display := permissionStatusDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParsePermissionStatus returns the PermissionStatus for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParsePermissionStatus(s string) (PermissionStatus, error) {
+	return parseCode(s, permissionStatusDisplays)
+}
+
 // ObservationDataType represents Observation Data Type.
 type ObservationDataType string
 
@@ -3312,6 +9322,46 @@ const (
 	ObservationDataTypePeriod ObservationDataType = "Period"
 )
 
+// observationDataTypeDisplays maps each ObservationDataType code to its display name.
+var observationDataTypeDisplays = map[ObservationDataType]string{
+	ObservationDataTypeQuantity:        "Quantity",
+	ObservationDataTypeCodeableconcept: "CodeableConcept",
+	ObservationDataTypeString:          "string",
+	ObservationDataTypeBoolean:         "boolean",
+	ObservationDataTypeInteger:         "integer",
+	ObservationDataTypeRange:           "Range",
+	ObservationDataTypeRatio:           "Ratio",
+	ObservationDataTypeSampleddata:     "SampledData",
+	ObservationDataTypeTime:            "time",
+	ObservationDataTypeDatetime:        "dateTime",
+	ObservationDataTypePeriod:          "Period",
+}
+
+// Code returns the code value.
+func (c ObservationDataType) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the ObservationDataType values.
+func (c ObservationDataType) IsValid() bool {
+	_, ok := observationDataTypeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c ObservationDataType) Display() string {
+	if display := observationDataTypeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseObservationDataType returns the ObservationDataType for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseObservationDataType(s string) (ObservationDataType, error) {
+	return parseCode(s, observationDataTypeDisplays)
+}
+
 // PriceComponentType represents Price Component Type.
 type PriceComponentType string
 
@@ -3331,6 +9381,41 @@ const (
 	PriceComponentTypeInformational PriceComponentType = "informational"
 )
 
+// priceComponentTypeDisplays maps each PriceComponentType code to its display name.
+var priceComponentTypeDisplays = map[PriceComponentType]string{
+	PriceComponentTypeBase:          "base price",
+	PriceComponentTypeSurcharge:     "surcharge",
+	PriceComponentTypeDeduction:     "deduction",
+	PriceComponentTypeDiscount:      "discount",
+	PriceComponentTypeTax:           "tax",
+	PriceComponentTypeInformational: "informational",
+}
+
+// Code returns the code value.
+func (c PriceComponentType) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the PriceComponentType values.
+func (c PriceComponentType) IsValid() bool {
+	_, ok := priceComponentTypeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c PriceComponentType) Display() string {
+	if display := priceComponentTypeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParsePriceComponentType returns the PriceComponentType for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParsePriceComponentType(s string) (PriceComponentType, error) {
+	return parseCode(s, priceComponentTypeDisplays)
+}
+
 // PropertyRepresentation represents PropertyRepresentation.
 type PropertyRepresentation string
 
@@ -3348,6 +9433,40 @@ const (
 	PropertyRepresentationXhtml PropertyRepresentation = "xhtml"
 )
 
+// propertyRepresentationDisplays maps each PropertyRepresentation code to its display name.
+var propertyRepresentationDisplays = map[PropertyRepresentation]string{
+	PropertyRepresentationXmlattr:  "XML Attribute",
+	PropertyRepresentationXmltext:  "XML Text",
+	PropertyRepresentationTypeattr: "Type Attribute",
+	PropertyRepresentationCdatext:  "CDA Text Format",
+	PropertyRepresentationXhtml:    "XHTML",
+}
+
+// Code returns the code value.
+func (c PropertyRepresentation) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the PropertyRepresentation values.
+func (c PropertyRepresentation) IsValid() bool {
+	_, ok := propertyRepresentationDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c PropertyRepresentation) Display() string {
+	if display := propertyRepresentationDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParsePropertyRepresentation returns the PropertyRepresentation for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParsePropertyRepresentation(s string) (PropertyRepresentation, error) {
+	return parseCode(s, propertyRepresentationDisplays)
+}
+
 // ProvenanceEntityRole represents Provenance Entity Role.
 type ProvenanceEntityRole string
 
@@ -3365,6 +9484,40 @@ const (
 	ProvenanceEntityRoleRemoval ProvenanceEntityRole = "removal"
 )
 
+// provenanceEntityRoleDisplays maps each ProvenanceEntityRole code to its display name.
+var provenanceEntityRoleDisplays = map[ProvenanceEntityRole]string{
+	ProvenanceEntityRoleRevision:     "Revision",
+	ProvenanceEntityRoleQuotation:    "Quotation",
+	ProvenanceEntityRoleSource:       "Source",
+	ProvenanceEntityRoleInstantiates: "Instantiates",
+	ProvenanceEntityRoleRemoval:      "Removal",
+}
+
+// Code returns the code value.
+func (c ProvenanceEntityRole) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the ProvenanceEntityRole values.
+func (c ProvenanceEntityRole) IsValid() bool {
+	_, ok := provenanceEntityRoleDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c ProvenanceEntityRole) Display() string {
+	if display := provenanceEntityRoleDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseProvenanceEntityRole returns the ProvenanceEntityRole for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseProvenanceEntityRole(s string) (ProvenanceEntityRole, error) {
+	return parseCode(s, provenanceEntityRoleDisplays)
+}
+
 // PublicationStatus represents PublicationStatus.
 type PublicationStatus string
 
@@ -3380,6 +9533,39 @@ const (
 	PublicationStatusUnknown PublicationStatus = "unknown"
 )
 
+// publicationStatusDisplays maps each PublicationStatus code to its display name.
+var publicationStatusDisplays = map[PublicationStatus]string{
+	PublicationStatusDraft:   "Draft",
+	PublicationStatusActive:  "Active",
+	PublicationStatusRetired: "Retired",
+	PublicationStatusUnknown: "Unknown",
+}
+
+// Code returns the code value.
+func (c PublicationStatus) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the PublicationStatus values.
+func (c PublicationStatus) IsValid() bool {
+	_, ok := publicationStatusDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c PublicationStatus) Display() string {
+	if display := publicationStatusDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParsePublicationStatus returns the PublicationStatus for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParsePublicationStatus(s string) (PublicationStatus, error) {
+	return parseCode(s, publicationStatusDisplays)
+}
+
 // QuantityComparator represents QuantityComparator.
 type QuantityComparator string
 
@@ -3397,6 +9583,40 @@ const (
 	QuantityComparatorAd QuantityComparator = "ad"
 )
 
+// quantityComparatorDisplays maps each QuantityComparator code to its display name.
+var quantityComparatorDisplays = map[QuantityComparator]string{
+	QuantityComparatorLessThan:       "Less than",
+	QuantityComparatorLessOrEqual:    "Less or Equal to",
+	QuantityComparatorGreaterOrEqual: "Greater or Equal to",
+	QuantityComparatorGreaterThan:    "Greater than",
+	QuantityComparatorAd:             "Sufficient to achieve this total quantity",
+}
+
+// Code returns the code value.
+func (c QuantityComparator) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the QuantityComparator values.
+func (c QuantityComparator) IsValid() bool {
+	_, ok := quantityComparatorDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c QuantityComparator) Display() string {
+	if display := quantityComparatorDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseQuantityComparator returns the QuantityComparator for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseQuantityComparator(s string) (QuantityComparator, error) {
+	return parseCode(s, quantityComparatorDisplays)
+}
+
 // QuestionnaireAnswerConstraint represents Questionnaire answer constraints value set.
 type QuestionnaireAnswerConstraint string
 
@@ -3410,6 +9630,38 @@ const (
 	QuestionnaireAnswerConstraintOptionsorstring QuestionnaireAnswerConstraint = "optionsOrString"
 )
 
+// questionnaireAnswerConstraintDisplays maps each QuestionnaireAnswerConstraint code to its display name.
+var questionnaireAnswerConstraintDisplays = map[QuestionnaireAnswerConstraint]string{
+	QuestionnaireAnswerConstraintOptionsonly:     "Options only",
+	QuestionnaireAnswerConstraintOptionsortype:   "Options or 'type'",
+	QuestionnaireAnswerConstraintOptionsorstring: "Options or string",
+}
+
+// Code returns the code value.
+func (c QuestionnaireAnswerConstraint) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the QuestionnaireAnswerConstraint values.
+func (c QuestionnaireAnswerConstraint) IsValid() bool {
+	_, ok := questionnaireAnswerConstraintDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c QuestionnaireAnswerConstraint) Display() string {
+	if display := questionnaireAnswerConstraintDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseQuestionnaireAnswerConstraint returns the QuestionnaireAnswerConstraint for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseQuestionnaireAnswerConstraint(s string) (QuestionnaireAnswerConstraint, error) {
+	return parseCode(s, questionnaireAnswerConstraintDisplays)
+}
+
 // QuestionnaireResponseStatus represents Questionnaire Response Status.
 type QuestionnaireResponseStatus string
 
@@ -3427,6 +9679,40 @@ const (
 	QuestionnaireResponseStatusStopped QuestionnaireResponseStatus = "stopped"
 )
 
+// questionnaireResponseStatusDisplays maps each QuestionnaireResponseStatus code to its display name.
+var questionnaireResponseStatusDisplays = map[QuestionnaireResponseStatus]string{
+	QuestionnaireResponseStatusInProgress:     "In Progress",
+	QuestionnaireResponseStatusCompleted:      "Completed",
+	QuestionnaireResponseStatusAmended:        "Amended",
+	QuestionnaireResponseStatusEnteredInError: "Entered in Error",
+	QuestionnaireResponseStatusStopped:        "Stopped",
+}
+
+// Code returns the code value.
+func (c QuestionnaireResponseStatus) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the QuestionnaireResponseStatus values.
+func (c QuestionnaireResponseStatus) IsValid() bool {
+	_, ok := questionnaireResponseStatusDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c QuestionnaireResponseStatus) Display() string {
+	if display := questionnaireResponseStatusDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseQuestionnaireResponseStatus returns the QuestionnaireResponseStatus for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseQuestionnaireResponseStatus(s string) (QuestionnaireResponseStatus, error) {
+	return parseCode(s, questionnaireResponseStatusDisplays)
+}
+
 // QuestionnaireItemDisabledDisplay represents Questionnaire Item Disabled Display.
 type QuestionnaireItemDisabledDisplay string
 
@@ -3438,6 +9724,37 @@ const (
 	QuestionnaireItemDisabledDisplayProtected QuestionnaireItemDisabledDisplay = "protected"
 )
 
+// questionnaireItemDisabledDisplayDisplays maps each QuestionnaireItemDisabledDisplay code to its display name.
+var questionnaireItemDisabledDisplayDisplays = map[QuestionnaireItemDisabledDisplay]string{
+	QuestionnaireItemDisabledDisplayHidden:    "Hidden",
+	QuestionnaireItemDisabledDisplayProtected: "Protected",
+}
+
+// Code returns the code value.
+func (c QuestionnaireItemDisabledDisplay) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the QuestionnaireItemDisabledDisplay values.
+func (c QuestionnaireItemDisabledDisplay) IsValid() bool {
+	_, ok := questionnaireItemDisabledDisplayDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c QuestionnaireItemDisabledDisplay) Display() string {
+	if display := questionnaireItemDisabledDisplayDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseQuestionnaireItemDisabledDisplay returns the QuestionnaireItemDisabledDisplay for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseQuestionnaireItemDisabledDisplay(s string) (QuestionnaireItemDisabledDisplay, error) {
+	return parseCode(s, questionnaireItemDisabledDisplayDisplays)
+}
+
 // EnableWhenBehavior represents Enable When Behavior.
 type EnableWhenBehavior string
 
@@ -3449,6 +9766,37 @@ const (
 	EnableWhenBehaviorAny EnableWhenBehavior = "any"
 )
 
+// enableWhenBehaviorDisplays maps each EnableWhenBehavior code to its display name.
+var enableWhenBehaviorDisplays = map[EnableWhenBehavior]string{
+	EnableWhenBehaviorAll: "All",
+	EnableWhenBehaviorAny: "Any",
+}
+
+// Code returns the code value.
+func (c EnableWhenBehavior) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the EnableWhenBehavior values.
+func (c EnableWhenBehavior) IsValid() bool {
+	_, ok := enableWhenBehaviorDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c EnableWhenBehavior) Display() string {
+	if display := enableWhenBehaviorDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseEnableWhenBehavior returns the EnableWhenBehavior for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseEnableWhenBehavior(s string) (EnableWhenBehavior, error) {
+	return parseCode(s, enableWhenBehaviorDisplays)
+}
+
 // QuestionnaireItemOperator represents Questionnaire Item Operator.
 type QuestionnaireItemOperator string
 
@@ -3470,6 +9818,42 @@ const (
 	QuestionnaireItemOperatorLessOrEqual QuestionnaireItemOperator = "<="
 )
 
+// questionnaireItemOperatorDisplays maps each QuestionnaireItemOperator code to its display name.
+var questionnaireItemOperatorDisplays = map[QuestionnaireItemOperator]string{
+	QuestionnaireItemOperatorExists:         "Exists",
+	QuestionnaireItemOperatorEqual:          "Equals",
+	QuestionnaireItemOperatorNotEqual:       "Not Equals",
+	QuestionnaireItemOperatorGreaterThan:    "Greater Than",
+	QuestionnaireItemOperatorLessThan:       "Less Than",
+	QuestionnaireItemOperatorGreaterOrEqual: "Greater or Equals",
+	QuestionnaireItemOperatorLessOrEqual:    "Less or Equals",
+}
+
+// Code returns the code value.
+func (c QuestionnaireItemOperator) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the QuestionnaireItemOperator values.
+func (c QuestionnaireItemOperator) IsValid() bool {
+	_, ok := questionnaireItemOperatorDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c QuestionnaireItemOperator) Display() string {
+	if display := questionnaireItemOperatorDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseQuestionnaireItemOperator returns the QuestionnaireItemOperator for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseQuestionnaireItemOperator(s string) (QuestionnaireItemOperator, error) {
+	return parseCode(s, questionnaireItemOperatorDisplays)
+}
+
 // AllergyIntoleranceSeverity represents Allergy Intolerance Severity.
 type AllergyIntoleranceSeverity string
 
@@ -3483,6 +9867,38 @@ const (
 	AllergyIntoleranceSeveritySevere AllergyIntoleranceSeverity = "severe"
 )
 
+// allergyIntoleranceSeverityDisplays maps each AllergyIntoleranceSeverity code to its display name.
+var allergyIntoleranceSeverityDisplays = map[AllergyIntoleranceSeverity]string{
+	AllergyIntoleranceSeverityMild:     "Mild",
+	AllergyIntoleranceSeverityModerate: "Moderate",
+	AllergyIntoleranceSeveritySevere:   "Severe",
+}
+
+// Code returns the code value.
+func (c AllergyIntoleranceSeverity) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the AllergyIntoleranceSeverity values.
+func (c AllergyIntoleranceSeverity) IsValid() bool {
+	_, ok := allergyIntoleranceSeverityDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c AllergyIntoleranceSeverity) Display() string {
+	if display := allergyIntoleranceSeverityDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseAllergyIntoleranceSeverity returns the AllergyIntoleranceSeverity for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseAllergyIntoleranceSeverity(s string) (AllergyIntoleranceSeverity, error) {
+	return parseCode(s, allergyIntoleranceSeverityDisplays)
+}
+
 // ReferenceHandlingPolicy represents Reference Handling Policy.
 type ReferenceHandlingPolicy string
 
@@ -3500,6 +9916,40 @@ const (
 	ReferenceHandlingPolicyLocal ReferenceHandlingPolicy = "local"
 )
 
+// referenceHandlingPolicyDisplays maps each ReferenceHandlingPolicy code to its display name.
+var referenceHandlingPolicyDisplays = map[ReferenceHandlingPolicy]string{
+	ReferenceHandlingPolicyLiteral:  "Literal References",
+	ReferenceHandlingPolicyLogical:  "Logical References",
+	ReferenceHandlingPolicyResolves: "Resolves References",
+	ReferenceHandlingPolicyEnforced: "Reference Integrity Enforced",
+	ReferenceHandlingPolicyLocal:    "Local References Only",
+}
+
+// Code returns the code value.
+func (c ReferenceHandlingPolicy) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the ReferenceHandlingPolicy values.
+func (c ReferenceHandlingPolicy) IsValid() bool {
+	_, ok := referenceHandlingPolicyDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c ReferenceHandlingPolicy) Display() string {
+	if display := referenceHandlingPolicyDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseReferenceHandlingPolicy returns the ReferenceHandlingPolicy for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseReferenceHandlingPolicy(s string) (ReferenceHandlingPolicy, error) {
+	return parseCode(s, referenceHandlingPolicyDisplays)
+}
+
 // ReferenceVersionRules represents ReferenceVersionRules.
 type ReferenceVersionRules string
 
@@ -3513,6 +9963,38 @@ const (
 	ReferenceVersionRulesSpecific ReferenceVersionRules = "specific"
 )
 
+// referenceVersionRulesDisplays maps each ReferenceVersionRules code to its display name.
+var referenceVersionRulesDisplays = map[ReferenceVersionRules]string{
+	ReferenceVersionRulesEither:      "Either Specific or independent",
+	ReferenceVersionRulesIndependent: "Version independent",
+	ReferenceVersionRulesSpecific:    "Version Specific",
+}
+
+// Code returns the code value.
+func (c ReferenceVersionRules) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the ReferenceVersionRules values.
+func (c ReferenceVersionRules) IsValid() bool {
+	_, ok := referenceVersionRulesDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c ReferenceVersionRules) Display() string {
+	if display := referenceVersionRulesDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseReferenceVersionRules returns the ReferenceVersionRules for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseReferenceVersionRules(s string) (ReferenceVersionRules, error) {
+	return parseCode(s, referenceVersionRulesDisplays)
+}
+
 // RelatedArtifactTypeExpanded represents Related Artifact Type All.
 type RelatedArtifactTypeExpanded string
 
@@ -3596,6 +10078,73 @@ const (
 	RelatedArtifactTypeExpandedReprintOf RelatedArtifactTypeExpanded = "reprint-of"
 )
 
+// relatedArtifactTypeExpandedDisplays maps each RelatedArtifactTypeExpanded code to its display name.
+var relatedArtifactTypeExpandedDisplays = map[RelatedArtifactTypeExpanded]string{
+	RelatedArtifactTypeExpandedDocumentation:   "Documentation",
+	RelatedArtifactTypeExpandedJustification:   "Justification",
+	RelatedArtifactTypeExpandedCitation:        "Citation",
+	RelatedArtifactTypeExpandedPredecessor:     "Predecessor",
+	RelatedArtifactTypeExpandedSuccessor:       "Successor",
+	RelatedArtifactTypeExpandedDerivedFrom:     "Derived From",
+	RelatedArtifactTypeExpandedDependsOn:       "Depends On",
+	RelatedArtifactTypeExpandedComposedOf:      "Composed Of",
+	RelatedArtifactTypeExpandedPartOf:          "Part Of",
+	RelatedArtifactTypeExpandedAmends:          "Amends",
+	RelatedArtifactTypeExpandedAmendedWith:     "Amended With",
+	RelatedArtifactTypeExpandedAppends:         "Appends",
+	RelatedArtifactTypeExpandedAppendedWith:    "Appended With",
+	RelatedArtifactTypeExpandedCites:           "Cites",
+	RelatedArtifactTypeExpandedCitedBy:         "Cited By",
+	RelatedArtifactTypeExpandedCommentsOn:      "Is Comment On",
+	RelatedArtifactTypeExpandedCommentIn:       "Has Comment In",
+	RelatedArtifactTypeExpandedContains:        "Contains",
+	RelatedArtifactTypeExpandedContainedIn:     "Contained In",
+	RelatedArtifactTypeExpandedCorrects:        "Corrects",
+	RelatedArtifactTypeExpandedCorrectionIn:    "Correction In",
+	RelatedArtifactTypeExpandedReplaces:        "Replaces",
+	RelatedArtifactTypeExpandedReplacedWith:    "Replaced With",
+	RelatedArtifactTypeExpandedRetracts:        "Retracts",
+	RelatedArtifactTypeExpandedRetractedBy:     "Retracted By",
+	RelatedArtifactTypeExpandedSigns:           "Signs",
+	RelatedArtifactTypeExpandedSimilarTo:       "Similar To",
+	RelatedArtifactTypeExpandedSupports:        "Supports",
+	RelatedArtifactTypeExpandedSupportedWith:   "Supported With",
+	RelatedArtifactTypeExpandedTransforms:      "Transforms",
+	RelatedArtifactTypeExpandedTransformedInto: "Transformed Into",
+	RelatedArtifactTypeExpandedTransformedWith: "Transformed With",
+	RelatedArtifactTypeExpandedDocuments:       "Documents",
+	RelatedArtifactTypeExpandedSpecificationOf: "Specification Of",
+	RelatedArtifactTypeExpandedCreatedWith:     "Created With",
+	RelatedArtifactTypeExpandedCiteAs:          "Cite As",
+	RelatedArtifactTypeExpandedReprint:         "Reprint",
+	RelatedArtifactTypeExpandedReprintOf:       "Reprint Of",
+}
+
+// Code returns the code value.
+func (c RelatedArtifactTypeExpanded) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the RelatedArtifactTypeExpanded values.
+func (c RelatedArtifactTypeExpanded) IsValid() bool {
+	_, ok := relatedArtifactTypeExpandedDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c RelatedArtifactTypeExpanded) Display() string {
+	if display := relatedArtifactTypeExpandedDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseRelatedArtifactTypeExpanded returns the RelatedArtifactTypeExpanded for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseRelatedArtifactTypeExpanded(s string) (RelatedArtifactTypeExpanded, error) {
+	return parseCode(s, relatedArtifactTypeExpandedDisplays)
+}
+
 // RelatedArtifactType represents RelatedArtifactType.
 type RelatedArtifactType string
 
@@ -3675,6 +10224,71 @@ const (
 	RelatedArtifactTypeCiteAs RelatedArtifactType = "cite-as"
 )
 
+// relatedArtifactTypeDisplays maps each RelatedArtifactType code to its display name.
+var relatedArtifactTypeDisplays = map[RelatedArtifactType]string{
+	RelatedArtifactTypeDocumentation:   "Documentation",
+	RelatedArtifactTypeJustification:   "Justification",
+	RelatedArtifactTypeCitation:        "Citation",
+	RelatedArtifactTypePredecessor:     "Predecessor",
+	RelatedArtifactTypeSuccessor:       "Successor",
+	RelatedArtifactTypeDerivedFrom:     "Derived From",
+	RelatedArtifactTypeDependsOn:       "Depends On",
+	RelatedArtifactTypeComposedOf:      "Composed Of",
+	RelatedArtifactTypePartOf:          "Part Of",
+	RelatedArtifactTypeAmends:          "Amends",
+	RelatedArtifactTypeAmendedWith:     "Amended With",
+	RelatedArtifactTypeAppends:         "Appends",
+	RelatedArtifactTypeAppendedWith:    "Appended With",
+	RelatedArtifactTypeCites:           "Cites",
+	RelatedArtifactTypeCitedBy:         "Cited By",
+	RelatedArtifactTypeCommentsOn:      "Is Comment On",
+	RelatedArtifactTypeCommentIn:       "Has Comment In",
+	RelatedArtifactTypeContains:        "Contains",
+	RelatedArtifactTypeContainedIn:     "Contained In",
+	RelatedArtifactTypeCorrects:        "Corrects",
+	RelatedArtifactTypeCorrectionIn:    "Correction In",
+	RelatedArtifactTypeReplaces:        "Replaces",
+	RelatedArtifactTypeReplacedWith:    "Replaced With",
+	RelatedArtifactTypeRetracts:        "Retracts",
+	RelatedArtifactTypeRetractedBy:     "Retracted By",
+	RelatedArtifactTypeSigns:           "Signs",
+	RelatedArtifactTypeSimilarTo:       "Similar To",
+	RelatedArtifactTypeSupports:        "Supports",
+	RelatedArtifactTypeSupportedWith:   "Supported With",
+	RelatedArtifactTypeTransforms:      "Transforms",
+	RelatedArtifactTypeTransformedInto: "Transformed Into",
+	RelatedArtifactTypeTransformedWith: "Transformed With",
+	RelatedArtifactTypeDocuments:       "Documents",
+	RelatedArtifactTypeSpecificationOf: "Specification Of",
+	RelatedArtifactTypeCreatedWith:     "Created With",
+	RelatedArtifactTypeCiteAs:          "Cite As",
+}
+
+// Code returns the code value.
+func (c RelatedArtifactType) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the RelatedArtifactType values.
+func (c RelatedArtifactType) IsValid() bool {
+	_, ok := relatedArtifactTypeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c RelatedArtifactType) Display() string {
+	if display := relatedArtifactTypeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseRelatedArtifactType returns the RelatedArtifactType for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseRelatedArtifactType(s string) (RelatedArtifactType, error) {
+	return parseCode(s, relatedArtifactTypeDisplays)
+}
+
 // TestReportActionResult represents Test Report Action Result.
 type TestReportActionResult string
 
@@ -3692,6 +10306,40 @@ const (
 	TestReportActionResultError TestReportActionResult = "error"
 )
 
+// testReportActionResultDisplays maps each TestReportActionResult code to its display name.
+var testReportActionResultDisplays = map[TestReportActionResult]string{
+	TestReportActionResultPass:    "Pass",
+	TestReportActionResultSkip:    "Skip",
+	TestReportActionResultFail:    "Fail",
+	TestReportActionResultWarning: "Warning",
+	TestReportActionResultError:   "Error",
+}
+
+// Code returns the code value.
+func (c TestReportActionResult) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the TestReportActionResult values.
+func (c TestReportActionResult) IsValid() bool {
+	_, ok := testReportActionResultDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c TestReportActionResult) Display() string {
+	if display := testReportActionResultDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseTestReportActionResult returns the TestReportActionResult for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseTestReportActionResult(s string) (TestReportActionResult, error) {
+	return parseCode(s, testReportActionResultDisplays)
+}
+
 // TestReportParticipantType represents Test Report Participant Type.
 type TestReportParticipantType string
 
@@ -3705,6 +10353,38 @@ const (
 	TestReportParticipantTypeServer TestReportParticipantType = "server"
 )
 
+// testReportParticipantTypeDisplays maps each TestReportParticipantType code to its display name.
+var testReportParticipantTypeDisplays = map[TestReportParticipantType]string{
+	TestReportParticipantTypeTestEngine: "Test Engine",
+	TestReportParticipantTypeClient:     "Client",
+	TestReportParticipantTypeServer:     "Server",
+}
+
+// Code returns the code value.
+func (c TestReportParticipantType) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the TestReportParticipantType values.
+func (c TestReportParticipantType) IsValid() bool {
+	_, ok := testReportParticipantTypeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c TestReportParticipantType) Display() string {
+	if display := testReportParticipantTypeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseTestReportParticipantType returns the TestReportParticipantType for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseTestReportParticipantType(s string) (TestReportParticipantType, error) {
+	return parseCode(s, testReportParticipantTypeDisplays)
+}
+
 // ReportRelationshipType represents Report Relationship Type.
 type ReportRelationshipType string
 
@@ -3728,6 +10408,43 @@ const (
 	ReportRelationshipTypeTransformedwith ReportRelationshipType = "transformedWith"
 )
 
+// reportRelationshipTypeDisplays maps each ReportRelationshipType code to its display name.
+var reportRelationshipTypeDisplays = map[ReportRelationshipType]string{
+	ReportRelationshipTypeReplaces:        "Replaces",
+	ReportRelationshipTypeAmends:          "Amends",
+	ReportRelationshipTypeAppends:         "Appends",
+	ReportRelationshipTypeTransforms:      "Transforms",
+	ReportRelationshipTypeReplacedwith:    "Replaced With",
+	ReportRelationshipTypeAmendedwith:     "Amended With",
+	ReportRelationshipTypeAppendedwith:    "Appended With",
+	ReportRelationshipTypeTransformedwith: "Transformed With",
+}
+
+// Code returns the code value.
+func (c ReportRelationshipType) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the ReportRelationshipType values.
+func (c ReportRelationshipType) IsValid() bool {
+	_, ok := reportRelationshipTypeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c ReportRelationshipType) Display() string {
+	if display := reportRelationshipTypeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseReportRelationshipType returns the ReportRelationshipType for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseReportRelationshipType(s string) (ReportRelationshipType, error) {
+	return parseCode(s, reportRelationshipTypeDisplays)
+}
+
 // TestReportResult represents Test Report Result.
 type TestReportResult string
 
@@ -3741,6 +10458,38 @@ const (
 	TestReportResultPending TestReportResult = "pending"
 )
 
+// testReportResultDisplays maps each TestReportResult code to its display name.
+var testReportResultDisplays = map[TestReportResult]string{
+	TestReportResultPass:    "Pass",
+	TestReportResultFail:    "Fail",
+	TestReportResultPending: "Pending",
+}
+
+// Code returns the code value.
+func (c TestReportResult) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the TestReportResult values.
+func (c TestReportResult) IsValid() bool {
+	_, ok := testReportResultDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c TestReportResult) Display() string {
+	if display := testReportResultDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseTestReportResult returns the TestReportResult for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseTestReportResult(s string) (TestReportResult, error) {
+	return parseCode(s, testReportResultDisplays)
+}
+
 // TestReportStatus represents Test Report Status.
 type TestReportStatus string
 
@@ -3758,6 +10507,40 @@ const (
 	TestReportStatusEnteredInError TestReportStatus = "entered-in-error"
 )
 
+// testReportStatusDisplays maps each TestReportStatus code to its display name.
+var testReportStatusDisplays = map[TestReportStatus]string{
+	TestReportStatusCompleted:      "Completed",
+	TestReportStatusInProgress:     "In Progress",
+	TestReportStatusWaiting:        "Waiting",
+	TestReportStatusStopped:        "Stopped",
+	TestReportStatusEnteredInError: "Entered In Error",
+}
+
+// Code returns the code value.
+func (c TestReportStatus) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the TestReportStatus values.
+func (c TestReportStatus) IsValid() bool {
+	_, ok := testReportStatusDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c TestReportStatus) Display() string {
+	if display := testReportStatusDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseTestReportStatus returns the TestReportStatus for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseTestReportStatus(s string) (TestReportStatus, error) {
+	return parseCode(s, testReportStatusDisplays)
+}
+
 // RequestIntent represents RequestIntent.
 type RequestIntent string
 
@@ -3783,6 +10566,44 @@ const (
 	RequestIntentOption RequestIntent = "option"
 )
 
+// requestIntentDisplays maps each RequestIntent code to its display name.
+var requestIntentDisplays = map[RequestIntent]string{
+	RequestIntentProposal:      "Proposal",
+	RequestIntentPlan:          "Plan",
+	RequestIntentDirective:     "Directive",
+	RequestIntentOrder:         "Order",
+	RequestIntentOriginalOrder: "Original Order",
+	RequestIntentReflexOrder:   "Reflex Order",
+	RequestIntentFillerOrder:   "Filler Order",
+	RequestIntentInstanceOrder: "Instance Order",
+	RequestIntentOption:        "Option",
+}
+
+// Code returns the code value.
+func (c RequestIntent) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the RequestIntent values.
+func (c RequestIntent) IsValid() bool {
+	_, ok := requestIntentDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c RequestIntent) Display() string {
+	if display := requestIntentDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseRequestIntent returns the RequestIntent for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseRequestIntent(s string) (RequestIntent, error) {
+	return parseCode(s, requestIntentDisplays)
+}
+
 // RequestPriority represents RequestPriority.
 type RequestPriority string
 
@@ -3798,6 +10619,39 @@ const (
 	RequestPriorityStat RequestPriority = "stat"
 )
 
+// requestPriorityDisplays maps each RequestPriority code to its display name.
+var requestPriorityDisplays = map[RequestPriority]string{
+	RequestPriorityRoutine: "Routine",
+	RequestPriorityUrgent:  "Urgent",
+	RequestPriorityAsap:    "ASAP",
+	RequestPriorityStat:    "STAT",
+}
+
+// Code returns the code value.
+func (c RequestPriority) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the RequestPriority values.
+func (c RequestPriority) IsValid() bool {
+	_, ok := requestPriorityDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c RequestPriority) Display() string {
+	if display := requestPriorityDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseRequestPriority returns the RequestPriority for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseRequestPriority(s string) (RequestPriority, error) {
+	return parseCode(s, requestPriorityDisplays)
+}
+
 // RequestResourceTypes represents Request Resource Types.
 type RequestResourceTypes string
 
@@ -3822,6 +10676,52 @@ const (
 	RequestResourceTypesVisionprescription         RequestResourceTypes = "VisionPrescription"
 )
 
+// requestResourceTypesDisplays maps each RequestResourceTypes code to its display name.
+var requestResourceTypesDisplays = map[RequestResourceTypes]string{
+	RequestResourceTypesAppointment:                "",
+	RequestResourceTypesAppointmentresponse:        "",
+	RequestResourceTypesCareplan:                   "",
+	RequestResourceTypesClaim:                      "",
+	RequestResourceTypesCommunicationrequest:       "",
+	RequestResourceTypesCoverageeligibilityrequest: "",
+	RequestResourceTypesDevicerequest:              "",
+	RequestResourceTypesEnrollmentrequest:          "",
+	RequestResourceTypesImmunizationrecommendation: "",
+	RequestResourceTypesMedicationrequest:          "",
+	RequestResourceTypesNutritionorder:             "",
+	RequestResourceTypesRequestorchestration:       "",
+	RequestResourceTypesServicerequest:             "",
+	RequestResourceTypesSupplyrequest:              "",
+	RequestResourceTypesTask:                       "",
+	RequestResourceTypesTransport:                  "",
+	RequestResourceTypesVisionprescription:         "",
+}
+
+// Code returns the code value.
+func (c RequestResourceTypes) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the RequestResourceTypes values.
+func (c RequestResourceTypes) IsValid() bool {
+	_, ok := requestResourceTypesDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c RequestResourceTypes) Display() string {
+	if display := requestResourceTypesDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseRequestResourceTypes returns the RequestResourceTypes for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseRequestResourceTypes(s string) (RequestResourceTypes, error) {
+	return parseCode(s, requestResourceTypesDisplays)
+}
+
 // RequestStatus represents RequestStatus.
 type RequestStatus string
 
@@ -3843,6 +10743,42 @@ const (
 	RequestStatusUnknown RequestStatus = "unknown"
 )
 
+// requestStatusDisplays maps each RequestStatus code to its display name.
+var requestStatusDisplays = map[RequestStatus]string{
+	RequestStatusDraft:          "Draft",
+	RequestStatusActive:         "Active",
+	RequestStatusOnHold:         "On Hold",
+	RequestStatusRevoked:        "Revoked",
+	RequestStatusCompleted:      "Completed",
+	RequestStatusEnteredInError: "Entered in Error",
+	RequestStatusUnknown:        "Unknown",
+}
+
+// Code returns the code value.
+func (c RequestStatus) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the RequestStatus values.
+func (c RequestStatus) IsValid() bool {
+	_, ok := requestStatusDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c RequestStatus) Display() string {
+	if display := requestStatusDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseRequestStatus returns the RequestStatus for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseRequestStatus(s string) (RequestStatus, error) {
+	return parseCode(s, requestStatusDisplays)
+}
+
 // AggregationMode represents AggregationMode.
 type AggregationMode string
 
@@ -3856,6 +10792,38 @@ const (
 	AggregationModeBundled AggregationMode = "bundled"
 )
 
+// aggregationModeDisplays maps each AggregationMode code to its display name.
+var aggregationModeDisplays = map[AggregationMode]string{
+	AggregationModeContained:  "Contained",
+	AggregationModeReferenced: "Referenced",
+	AggregationModeBundled:    "Bundled",
+}
+
+// Code returns the code value.
+func (c AggregationMode) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the AggregationMode values.
+func (c AggregationMode) IsValid() bool {
+	_, ok := aggregationModeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c AggregationMode) Display() string {
+	if display := aggregationModeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseAggregationMode returns the AggregationMode for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseAggregationMode(s string) (AggregationMode, error) {
+	return parseCode(s, aggregationModeDisplays)
+}
+
 // SlicingRules represents SlicingRules.
 type SlicingRules string
 
@@ -3869,6 +10837,38 @@ const (
 	SlicingRulesOpenatend SlicingRules = "openAtEnd"
 )
 
+// slicingRulesDisplays maps each SlicingRules code to its display name.
+var slicingRulesDisplays = map[SlicingRules]string{
+	SlicingRulesClosed:    "Closed",
+	SlicingRulesOpen:      "Open",
+	SlicingRulesOpenatend: "Open at End",
+}
+
+// Code returns the code value.
+func (c SlicingRules) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the SlicingRules values.
+func (c SlicingRules) IsValid() bool {
+	_, ok := slicingRulesDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c SlicingRules) Display() string {
+	if display := slicingRulesDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseSlicingRules returns the SlicingRules for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseSlicingRules(s string) (SlicingRules, error) {
+	return parseCode(s, slicingRulesDisplays)
+}
+
 // ResponseType represents Response Type.
 type ResponseType string
 
@@ -3882,6 +10882,38 @@ const (
 	ResponseTypeFatalError ResponseType = "fatal-error"
 )
 
+// responseTypeDisplays maps each ResponseType code to its display name.
+var responseTypeDisplays = map[ResponseType]string{
+	ResponseTypeOk:             "OK",
+	ResponseTypeTransientError: "Transient Error",
+	ResponseTypeFatalError:     "Fatal Error",
+}
+
+// Code returns the code value.
+func (c ResponseType) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the ResponseType values.
+func (c ResponseType) IsValid() bool {
+	_, ok := responseTypeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c ResponseType) Display() string {
+	if display := responseTypeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseResponseType returns the ResponseType for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseResponseType(s string) (ResponseType, error) {
+	return parseCode(s, responseTypeDisplays)
+}
+
 // RestfulCapabilityMode represents Restful Capability Mode.
 type RestfulCapabilityMode string
 
@@ -3893,6 +10925,37 @@ const (
 	RestfulCapabilityModeServer RestfulCapabilityMode = "server"
 )
 
+// restfulCapabilityModeDisplays maps each RestfulCapabilityMode code to its display name.
+var restfulCapabilityModeDisplays = map[RestfulCapabilityMode]string{
+	RestfulCapabilityModeClient: "Client",
+	RestfulCapabilityModeServer: "Server",
+}
+
+// Code returns the code value.
+func (c RestfulCapabilityMode) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the RestfulCapabilityMode values.
+func (c RestfulCapabilityMode) IsValid() bool {
+	_, ok := restfulCapabilityModeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c RestfulCapabilityMode) Display() string {
+	if display := restfulCapabilityModeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseRestfulCapabilityMode returns the RestfulCapabilityMode for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseRestfulCapabilityMode(s string) (RestfulCapabilityMode, error) {
+	return parseCode(s, restfulCapabilityModeDisplays)
+}
+
 // SearchComparator represents Search Comparator.
 type SearchComparator string
 
@@ -3918,6 +10981,44 @@ const (
 	SearchComparatorAp SearchComparator = "ap"
 )
 
+// searchComparatorDisplays maps each SearchComparator code to its display name.
+var searchComparatorDisplays = map[SearchComparator]string{
+	SearchComparatorEq: "Equals",
+	SearchComparatorNe: "Not Equals",
+	SearchComparatorGt: "Greater Than",
+	SearchComparatorLt: "Less Than",
+	SearchComparatorGe: "Greater or Equals",
+	SearchComparatorLe: "Less of Equal",
+	SearchComparatorSa: "Starts After",
+	SearchComparatorEb: "Ends Before",
+	SearchComparatorAp: "Approximately",
+}
+
+// Code returns the code value.
+func (c SearchComparator) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the SearchComparator values.
+func (c SearchComparator) IsValid() bool {
+	_, ok := searchComparatorDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c SearchComparator) Display() string {
+	if display := searchComparatorDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseSearchComparator returns the SearchComparator for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseSearchComparator(s string) (SearchComparator, error) {
+	return parseCode(s, searchComparatorDisplays)
+}
+
 // SearchEntryMode represents Search Entry Mode.
 type SearchEntryMode string
 
@@ -3931,6 +11032,38 @@ const (
 	SearchEntryModeOutcome SearchEntryMode = "outcome"
 )
 
+// searchEntryModeDisplays maps each SearchEntryMode code to its display name.
+var searchEntryModeDisplays = map[SearchEntryMode]string{
+	SearchEntryModeMatch:   "Match",
+	SearchEntryModeInclude: "Include",
+	SearchEntryModeOutcome: "Outcome",
+}
+
+// Code returns the code value.
+func (c SearchEntryMode) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the SearchEntryMode values.
+func (c SearchEntryMode) IsValid() bool {
+	_, ok := searchEntryModeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c SearchEntryMode) Display() string {
+	if display := searchEntryModeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseSearchEntryMode returns the SearchEntryMode for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseSearchEntryMode(s string) (SearchEntryMode, error) {
+	return parseCode(s, searchEntryModeDisplays)
+}
+
 // SearchModifierCode represents Search Modifier Code.
 type SearchModifierCode string
 
@@ -3968,6 +11101,50 @@ const (
 	SearchModifierCodeIterate SearchModifierCode = "iterate"
 )
 
+// searchModifierCodeDisplays maps each SearchModifierCode code to its display name.
+var searchModifierCodeDisplays = map[SearchModifierCode]string{
+	SearchModifierCodeMissing:      "Missing",
+	SearchModifierCodeExact:        "Exact",
+	SearchModifierCodeContains:     "Contains",
+	SearchModifierCodeNot:          "Not",
+	SearchModifierCodeText:         "Text",
+	SearchModifierCodeIn:           "In",
+	SearchModifierCodeNotIn:        "Not In",
+	SearchModifierCodeBelow:        "Below",
+	SearchModifierCodeAbove:        "Above",
+	SearchModifierCodeType:         "Type",
+	SearchModifierCodeIdentifier:   "Identifier",
+	SearchModifierCodeOfType:       "Of Type",
+	SearchModifierCodeCodeText:     "Code Text",
+	SearchModifierCodeTextAdvanced: "Text Advanced",
+	SearchModifierCodeIterate:      "Iterate",
+}
+
+// Code returns the code value.
+func (c SearchModifierCode) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the SearchModifierCode values.
+func (c SearchModifierCode) IsValid() bool {
+	_, ok := searchModifierCodeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c SearchModifierCode) Display() string {
+	if display := searchModifierCodeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseSearchModifierCode returns the SearchModifierCode for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseSearchModifierCode(s string) (SearchModifierCode, error) {
+	return parseCode(s, searchModifierCodeDisplays)
+}
+
 // SearchParamType represents SearchParamType.
 type SearchParamType string
 
@@ -3993,6 +11170,44 @@ const (
 	SearchParamTypeSpecial SearchParamType = "special"
 )
 
+// searchParamTypeDisplays maps each SearchParamType code to its display name.
+var searchParamTypeDisplays = map[SearchParamType]string{
+	SearchParamTypeNumber:    "Number",
+	SearchParamTypeDate:      "Date/DateTime",
+	SearchParamTypeString:    "String",
+	SearchParamTypeToken:     "Token",
+	SearchParamTypeReference: "Reference",
+	SearchParamTypeComposite: "Composite",
+	SearchParamTypeQuantity:  "Quantity",
+	SearchParamTypeUri:       "URI",
+	SearchParamTypeSpecial:   "Special",
+}
+
+// Code returns the code value.
+func (c SearchParamType) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the SearchParamType values.
+func (c SearchParamType) IsValid() bool {
+	_, ok := searchParamTypeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c SearchParamType) Display() string {
+	if display := searchParamTypeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseSearchParamType returns the SearchParamType for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseSearchParamType(s string) (SearchParamType, error) {
+	return parseCode(s, searchParamTypeDisplays)
+}
+
 // SearchProcessingModeType represents Search Processing Mode Type.
 type SearchProcessingModeType string
 
@@ -4006,6 +11221,38 @@ const (
 	SearchProcessingModeTypeOther SearchProcessingModeType = "other"
 )
 
+// searchProcessingModeTypeDisplays maps each SearchProcessingModeType code to its display name.
+var searchProcessingModeTypeDisplays = map[SearchProcessingModeType]string{
+	SearchProcessingModeTypeNormal:   "Normal",
+	SearchProcessingModeTypePhonetic: "Phonetic",
+	SearchProcessingModeTypeOther:    "Other",
+}
+
+// Code returns the code value.
+func (c SearchProcessingModeType) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the SearchProcessingModeType values.
+func (c SearchProcessingModeType) IsValid() bool {
+	_, ok := searchProcessingModeTypeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c SearchProcessingModeType) Display() string {
+	if display := searchProcessingModeTypeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseSearchProcessingModeType returns the SearchProcessingModeType for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseSearchProcessingModeType(s string) (SearchProcessingModeType, error) {
+	return parseCode(s, searchProcessingModeTypeDisplays)
+}
+
 // SequenceType represents sequence Type.
 type SequenceType string
 
@@ -4019,6 +11266,38 @@ const (
 	SequenceTypeRna SequenceType = "rna"
 )
 
+// sequenceTypeDisplays maps each SequenceType code to its display name.
+var sequenceTypeDisplays = map[SequenceType]string{
+	SequenceTypeAa:  "AA Sequence",
+	SequenceTypeDna: "DNA Sequence",
+	SequenceTypeRna: "RNA Sequence",
+}
+
+// Code returns the code value.
+func (c SequenceType) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the SequenceType values.
+func (c SequenceType) IsValid() bool {
+	_, ok := sequenceTypeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c SequenceType) Display() string {
+	if display := sequenceTypeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseSequenceType returns the SequenceType for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseSequenceType(s string) (SequenceType, error) {
+	return parseCode(s, sequenceTypeDisplays)
+}
+
 // SlotStatus represents Slot Status.
 type SlotStatus string
 
@@ -4036,6 +11315,40 @@ const (
 	SlotStatusEnteredInError SlotStatus = "entered-in-error"
 )
 
+// slotStatusDisplays maps each SlotStatus code to its display name.
+var slotStatusDisplays = map[SlotStatus]string{
+	SlotStatusBusy:            "Busy",
+	SlotStatusFree:            "Free",
+	SlotStatusBusyUnavailable: "Busy (Unavailable)",
+	SlotStatusBusyTentative:   "Busy (Tentative)",
+	SlotStatusEnteredInError:  "Entered in error",
+}
+
+// Code returns the code value.
+func (c SlotStatus) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the SlotStatus values.
+func (c SlotStatus) IsValid() bool {
+	_, ok := slotStatusDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c SlotStatus) Display() string {
+	if display := slotStatusDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseSlotStatus returns the SlotStatus for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseSlotStatus(s string) (SlotStatus, error) {
+	return parseCode(s, slotStatusDisplays)
+}
+
 // SortDirection represents SortDirection.
 type SortDirection string
 
@@ -4047,6 +11360,37 @@ const (
 	SortDirectionDescending SortDirection = "descending"
 )
 
+// sortDirectionDisplays maps each SortDirection code to its display name.
+var sortDirectionDisplays = map[SortDirection]string{
+	SortDirectionAscending:  "Ascending",
+	SortDirectionDescending: "Descending",
+}
+
+// Code returns the code value.
+func (c SortDirection) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the SortDirection values.
+func (c SortDirection) IsValid() bool {
+	_, ok := sortDirectionDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c SortDirection) Display() string {
+	if display := sortDirectionDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseSortDirection returns the SortDirection for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseSortDirection(s string) (SortDirection, error) {
+	return parseCode(s, sortDirectionDisplays)
+}
+
 // SpecimenCombined represents Specimen Combined.
 type SpecimenCombined string
 
@@ -4058,6 +11402,37 @@ const (
 	SpecimenCombinedPooled SpecimenCombined = "pooled"
 )
 
+// specimenCombinedDisplays maps each SpecimenCombined code to its display name.
+var specimenCombinedDisplays = map[SpecimenCombined]string{
+	SpecimenCombinedGrouped: "Grouped",
+	SpecimenCombinedPooled:  "Pooled",
+}
+
+// Code returns the code value.
+func (c SpecimenCombined) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the SpecimenCombined values.
+func (c SpecimenCombined) IsValid() bool {
+	_, ok := specimenCombinedDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c SpecimenCombined) Display() string {
+	if display := specimenCombinedDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseSpecimenCombined returns the SpecimenCombined for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseSpecimenCombined(s string) (SpecimenCombined, error) {
+	return parseCode(s, specimenCombinedDisplays)
+}
+
 // SpecimenContainedPreference represents Specimen Contained Preference.
 type SpecimenContainedPreference string
 
@@ -4069,6 +11444,37 @@ const (
 	SpecimenContainedPreferenceAlternate SpecimenContainedPreference = "alternate"
 )
 
+// specimenContainedPreferenceDisplays maps each SpecimenContainedPreference code to its display name.
+var specimenContainedPreferenceDisplays = map[SpecimenContainedPreference]string{
+	SpecimenContainedPreferencePreferred: "Preferred",
+	SpecimenContainedPreferenceAlternate: "Alternate",
+}
+
+// Code returns the code value.
+func (c SpecimenContainedPreference) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the SpecimenContainedPreference values.
+func (c SpecimenContainedPreference) IsValid() bool {
+	_, ok := specimenContainedPreferenceDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c SpecimenContainedPreference) Display() string {
+	if display := specimenContainedPreferenceDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseSpecimenContainedPreference returns the SpecimenContainedPreference for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseSpecimenContainedPreference(s string) (SpecimenContainedPreference, error) {
+	return parseCode(s, specimenContainedPreferenceDisplays)
+}
+
 // SpecimenStatus represents Specimen Status.
 type SpecimenStatus string
 
@@ -4084,6 +11490,39 @@ const (
 	SpecimenStatusEnteredInError SpecimenStatus = "entered-in-error"
 )
 
+// specimenStatusDisplays maps each SpecimenStatus code to its display name.
+var specimenStatusDisplays = map[SpecimenStatus]string{
+	SpecimenStatusAvailable:      "Available",
+	SpecimenStatusUnavailable:    "Unavailable",
+	SpecimenStatusUnsatisfactory: "Unsatisfactory",
+	SpecimenStatusEnteredInError: "Entered in Error",
+}
+
+// Code returns the code value.
+func (c SpecimenStatus) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the SpecimenStatus values.
+func (c SpecimenStatus) IsValid() bool {
+	_, ok := specimenStatusDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c SpecimenStatus) Display() string {
+	if display := specimenStatusDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseSpecimenStatus returns the SpecimenStatus for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseSpecimenStatus(s string) (SpecimenStatus, error) {
+	return parseCode(s, specimenStatusDisplays)
+}
+
 // StrandType represents strand Type.
 type StrandType string
 
@@ -4095,6 +11534,37 @@ const (
 	StrandTypeCrick StrandType = "crick"
 )
 
+// strandTypeDisplays maps each StrandType code to its display name.
+var strandTypeDisplays = map[StrandType]string{
+	StrandTypeWatson: "Watson strand of starting sequence",
+	StrandTypeCrick:  "Crick strand of starting sequence",
+}
+
+// Code returns the code value.
+func (c StrandType) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the StrandType values.
+func (c StrandType) IsValid() bool {
+	_, ok := strandTypeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c StrandType) Display() string {
+	if display := strandTypeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseStrandType returns the StrandType for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseStrandType(s string) (StrandType, error) {
+	return parseCode(s, strandTypeDisplays)
+}
+
 // StructureDefinitionKind represents Structure Definition Kind.
 type StructureDefinitionKind string
 
@@ -4110,6 +11580,39 @@ const (
 	StructureDefinitionKindLogical StructureDefinitionKind = "logical"
 )
 
+// structureDefinitionKindDisplays maps each StructureDefinitionKind code to its display name.
+var structureDefinitionKindDisplays = map[StructureDefinitionKind]string{
+	StructureDefinitionKindPrimitiveType: "Primitive Data Type",
+	StructureDefinitionKindComplexType:   "Complex Data Type",
+	StructureDefinitionKindResource:      "Resource",
+	StructureDefinitionKindLogical:       "Logical",
+}
+
+// Code returns the code value.
+func (c StructureDefinitionKind) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the StructureDefinitionKind values.
+func (c StructureDefinitionKind) IsValid() bool {
+	_, ok := structureDefinitionKindDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c StructureDefinitionKind) Display() string {
+	if display := structureDefinitionKindDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseStructureDefinitionKind returns the StructureDefinitionKind for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseStructureDefinitionKind(s string) (StructureDefinitionKind, error) {
+	return parseCode(s, structureDefinitionKindDisplays)
+}
+
 // SubmitDataUpdateType represents Submit Data Update Type.
 type SubmitDataUpdateType string
 
@@ -4121,6 +11624,37 @@ const (
 	SubmitDataUpdateTypeSnapshot SubmitDataUpdateType = "snapshot"
 )
 
+// submitDataUpdateTypeDisplays maps each SubmitDataUpdateType code to its display name.
+var submitDataUpdateTypeDisplays = map[SubmitDataUpdateType]string{
+	SubmitDataUpdateTypeIncremental: "Incremental",
+	SubmitDataUpdateTypeSnapshot:    "Snapshot",
+}
+
+// Code returns the code value.
+func (c SubmitDataUpdateType) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the SubmitDataUpdateType values.
+func (c SubmitDataUpdateType) IsValid() bool {
+	_, ok := submitDataUpdateTypeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c SubmitDataUpdateType) Display() string {
+	if display := submitDataUpdateTypeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseSubmitDataUpdateType returns the SubmitDataUpdateType for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseSubmitDataUpdateType(s string) (SubmitDataUpdateType, error) {
+	return parseCode(s, submitDataUpdateTypeDisplays)
+}
+
 // SubscriptionNotificationType represents Subscription Notification Type.
 type SubscriptionNotificationType string
 
@@ -4138,6 +11672,40 @@ const (
 	SubscriptionNotificationTypeQueryEvent SubscriptionNotificationType = "query-event"
 )
 
+// subscriptionNotificationTypeDisplays maps each SubscriptionNotificationType code to its display name.
+var subscriptionNotificationTypeDisplays = map[SubscriptionNotificationType]string{
+	SubscriptionNotificationTypeHandshake:         "Handshake",
+	SubscriptionNotificationTypeHeartbeat:         "Heartbeat",
+	SubscriptionNotificationTypeEventNotification: "Event Notification",
+	SubscriptionNotificationTypeQueryStatus:       "Query Status",
+	SubscriptionNotificationTypeQueryEvent:        "Query Event",
+}
+
+// Code returns the code value.
+func (c SubscriptionNotificationType) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the SubscriptionNotificationType values.
+func (c SubscriptionNotificationType) IsValid() bool {
+	_, ok := subscriptionNotificationTypeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c SubscriptionNotificationType) Display() string {
+	if display := subscriptionNotificationTypeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseSubscriptionNotificationType returns the SubscriptionNotificationType for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseSubscriptionNotificationType(s string) (SubscriptionNotificationType, error) {
+	return parseCode(s, subscriptionNotificationTypeDisplays)
+}
+
 // SubscriptionPayloadContent represents Subscription Payload Content.
 type SubscriptionPayloadContent string
 
@@ -4151,6 +11719,38 @@ const (
 	SubscriptionPayloadContentFullResource SubscriptionPayloadContent = "full-resource"
 )
 
+// subscriptionPayloadContentDisplays maps each SubscriptionPayloadContent code to its display name.
+var subscriptionPayloadContentDisplays = map[SubscriptionPayloadContent]string{
+	SubscriptionPayloadContentEmpty:        "Empty",
+	SubscriptionPayloadContentIdOnly:       "Id-only",
+	SubscriptionPayloadContentFullResource: "Full-resource",
+}
+
+// Code returns the code value.
+func (c SubscriptionPayloadContent) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the SubscriptionPayloadContent values.
+func (c SubscriptionPayloadContent) IsValid() bool {
+	_, ok := subscriptionPayloadContentDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c SubscriptionPayloadContent) Display() string {
+	if display := subscriptionPayloadContentDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseSubscriptionPayloadContent returns the SubscriptionPayloadContent for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseSubscriptionPayloadContent(s string) (SubscriptionPayloadContent, error) {
+	return parseCode(s, subscriptionPayloadContentDisplays)
+}
+
 // SubscriptionStatusCodes represents Subscription Status.
 type SubscriptionStatusCodes string
 
@@ -4168,6 +11768,40 @@ const (
 	SubscriptionStatusCodesEnteredInError SubscriptionStatusCodes = "entered-in-error"
 )
 
+// subscriptionStatusCodesDisplays maps each SubscriptionStatusCodes code to its display name.
+var subscriptionStatusCodesDisplays = map[SubscriptionStatusCodes]string{
+	SubscriptionStatusCodesRequested:      "Requested",
+	SubscriptionStatusCodesActive:         "Active",
+	SubscriptionStatusCodesError:          "Error",
+	SubscriptionStatusCodesOff:            "Off",
+	SubscriptionStatusCodesEnteredInError: "Entered in Error",
+}
+
+// Code returns the code value.
+func (c SubscriptionStatusCodes) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the SubscriptionStatusCodes values.
+func (c SubscriptionStatusCodes) IsValid() bool {
+	_, ok := subscriptionStatusCodesDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c SubscriptionStatusCodes) Display() string {
+	if display := subscriptionStatusCodesDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseSubscriptionStatusCodes returns the SubscriptionStatusCodes for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseSubscriptionStatusCodes(s string) (SubscriptionStatusCodes, error) {
+	return parseCode(s, subscriptionStatusCodesDisplays)
+}
+
 // CriteriaNotExistsBehavior represents Criteria Not Exists Behavior.
 type CriteriaNotExistsBehavior string
 
@@ -4179,6 +11813,37 @@ const (
 	CriteriaNotExistsBehaviorTestFails CriteriaNotExistsBehavior = "test-fails"
 )
 
+// criteriaNotExistsBehaviorDisplays maps each CriteriaNotExistsBehavior code to its display name.
+var criteriaNotExistsBehaviorDisplays = map[CriteriaNotExistsBehavior]string{
+	CriteriaNotExistsBehaviorTestPasses: "Test passes",
+	CriteriaNotExistsBehaviorTestFails:  "Test fails",
+}
+
+// Code returns the code value.
+func (c CriteriaNotExistsBehavior) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the CriteriaNotExistsBehavior values.
+func (c CriteriaNotExistsBehavior) IsValid() bool {
+	_, ok := criteriaNotExistsBehaviorDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c CriteriaNotExistsBehavior) Display() string {
+	if display := criteriaNotExistsBehaviorDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseCriteriaNotExistsBehavior returns the CriteriaNotExistsBehavior for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseCriteriaNotExistsBehavior(s string) (CriteriaNotExistsBehavior, error) {
+	return parseCode(s, criteriaNotExistsBehaviorDisplays)
+}
+
 // FHIRSubstanceStatus represents FHIRSubstanceStatus.
 type FHIRSubstanceStatus string
 
@@ -4192,6 +11857,38 @@ const (
 	FHIRSubstanceStatusEnteredInError FHIRSubstanceStatus = "entered-in-error"
 )
 
+// fHIRSubstanceStatusDisplays maps each FHIRSubstanceStatus code to its display name.
+var fHIRSubstanceStatusDisplays = map[FHIRSubstanceStatus]string{
+	FHIRSubstanceStatusActive:         "Active",
+	FHIRSubstanceStatusInactive:       "Inactive",
+	FHIRSubstanceStatusEnteredInError: "Entered in Error",
+}
+
+// Code returns the code value.
+func (c FHIRSubstanceStatus) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the FHIRSubstanceStatus values.
+func (c FHIRSubstanceStatus) IsValid() bool {
+	_, ok := fHIRSubstanceStatusDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c FHIRSubstanceStatus) Display() string {
+	if display := fHIRSubstanceStatusDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseFHIRSubstanceStatus returns the FHIRSubstanceStatus for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseFHIRSubstanceStatus(s string) (FHIRSubstanceStatus, error) {
+	return parseCode(s, fHIRSubstanceStatusDisplays)
+}
+
 // SupplyDeliveryStatus represents Supply Delivery Status.
 type SupplyDeliveryStatus string
 
@@ -4207,6 +11904,39 @@ const (
 	SupplyDeliveryStatusEnteredInError SupplyDeliveryStatus = "entered-in-error"
 )
 
+// supplyDeliveryStatusDisplays maps each SupplyDeliveryStatus code to its display name.
+var supplyDeliveryStatusDisplays = map[SupplyDeliveryStatus]string{
+	SupplyDeliveryStatusInProgress:     "In Progress",
+	SupplyDeliveryStatusCompleted:      "Delivered",
+	SupplyDeliveryStatusAbandoned:      "Abandoned",
+	SupplyDeliveryStatusEnteredInError: "Entered In Error",
+}
+
+// Code returns the code value.
+func (c SupplyDeliveryStatus) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the SupplyDeliveryStatus values.
+func (c SupplyDeliveryStatus) IsValid() bool {
+	_, ok := supplyDeliveryStatusDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c SupplyDeliveryStatus) Display() string {
+	if display := supplyDeliveryStatusDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseSupplyDeliveryStatus returns the SupplyDeliveryStatus for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseSupplyDeliveryStatus(s string) (SupplyDeliveryStatus, error) {
+	return parseCode(s, supplyDeliveryStatusDisplays)
+}
+
 // SupplyRequestStatus represents Supply Request Status.
 type SupplyRequestStatus string
 
@@ -4228,6 +11958,42 @@ const (
 	SupplyRequestStatusUnknown SupplyRequestStatus = "unknown"
 )
 
+// supplyRequestStatusDisplays maps each SupplyRequestStatus code to its display name.
+var supplyRequestStatusDisplays = map[SupplyRequestStatus]string{
+	SupplyRequestStatusDraft:          "Draft",
+	SupplyRequestStatusActive:         "Active",
+	SupplyRequestStatusSuspended:      "Suspended",
+	SupplyRequestStatusCancelled:      "Cancelled",
+	SupplyRequestStatusCompleted:      "Completed",
+	SupplyRequestStatusEnteredInError: "Entered in Error",
+	SupplyRequestStatusUnknown:        "Unknown",
+}
+
+// Code returns the code value.
+func (c SupplyRequestStatus) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the SupplyRequestStatus values.
+func (c SupplyRequestStatus) IsValid() bool {
+	_, ok := supplyRequestStatusDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c SupplyRequestStatus) Display() string {
+	if display := supplyRequestStatusDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseSupplyRequestStatus returns the SupplyRequestStatus for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseSupplyRequestStatus(s string) (SupplyRequestStatus, error) {
+	return parseCode(s, supplyRequestStatusDisplays)
+}
+
 // SystemRestfulInteraction represents System Restful Interaction.
 type SystemRestfulInteraction string
 
@@ -4239,6 +12005,39 @@ const (
 	SystemRestfulInteractionHistorySystem SystemRestfulInteraction = "history-system"
 )
 
+// systemRestfulInteractionDisplays maps each SystemRestfulInteraction code to its display name.
+var systemRestfulInteractionDisplays = map[SystemRestfulInteraction]string{
+	SystemRestfulInteractionTransaction:   "",
+	SystemRestfulInteractionBatch:         "",
+	SystemRestfulInteractionSearchSystem:  "",
+	SystemRestfulInteractionHistorySystem: "",
+}
+
+// Code returns the code value.
+func (c SystemRestfulInteraction) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the SystemRestfulInteraction values.
+func (c SystemRestfulInteraction) IsValid() bool {
+	_, ok := systemRestfulInteractionDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c SystemRestfulInteraction) Display() string {
+	if display := systemRestfulInteractionDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseSystemRestfulInteraction returns the SystemRestfulInteraction for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseSystemRestfulInteraction(s string) (SystemRestfulInteraction, error) {
+	return parseCode(s, systemRestfulInteractionDisplays)
+}
+
 // TaskIntent represents Task Intent.
 type TaskIntent string
 
@@ -4256,6 +12055,44 @@ const (
 	TaskIntentOption        TaskIntent = "option"
 )
 
+// taskIntentDisplays maps each TaskIntent code to its display name.
+var taskIntentDisplays = map[TaskIntent]string{
+	TaskIntentUnknown:       "Unknown",
+	TaskIntentProposal:      "",
+	TaskIntentPlan:          "",
+	TaskIntentOrder:         "",
+	TaskIntentOriginalOrder: "",
+	TaskIntentReflexOrder:   "",
+	TaskIntentFillerOrder:   "",
+	TaskIntentInstanceOrder: "",
+	TaskIntentOption:        "",
+}
+
+// Code returns the code value.
+func (c TaskIntent) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the TaskIntent values.
+func (c TaskIntent) IsValid() bool {
+	_, ok := taskIntentDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c TaskIntent) Display() string {
+	if display := taskIntentDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseTaskIntent returns the TaskIntent for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseTaskIntent(s string) (TaskIntent, error) {
+	return parseCode(s, taskIntentDisplays)
+}
+
 // TaskStatus represents Task Status.
 type TaskStatus string
 
@@ -4287,6 +12124,47 @@ const (
 	TaskStatusEnteredInError TaskStatus = "entered-in-error"
 )
 
+// taskStatusDisplays maps each TaskStatus code to its display name.
+var taskStatusDisplays = map[TaskStatus]string{
+	TaskStatusDraft:          "Draft",
+	TaskStatusRequested:      "Requested",
+	TaskStatusReceived:       "Received",
+	TaskStatusAccepted:       "Accepted",
+	TaskStatusRejected:       "Rejected",
+	TaskStatusReady:          "Ready",
+	TaskStatusCancelled:      "Cancelled",
+	TaskStatusInProgress:     "In Progress",
+	TaskStatusOnHold:         "On Hold",
+	TaskStatusFailed:         "Failed",
+	TaskStatusCompleted:      "Completed",
+	TaskStatusEnteredInError: "Entered in Error",
+}
+
+// Code returns the code value.
+func (c TaskStatus) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the TaskStatus values.
+func (c TaskStatus) IsValid() bool {
+	_, ok := taskStatusDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c TaskStatus) Display() string {
+	if display := taskStatusDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseTaskStatus returns the TaskStatus for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseTaskStatus(s string) (TaskStatus, error) {
+	return parseCode(s, taskStatusDisplays)
+}
+
 // TransportIntent represents Transport Intent.
 type TransportIntent string
 
@@ -4304,6 +12182,44 @@ const (
 	TransportIntentOption        TransportIntent = "option"
 )
 
+// transportIntentDisplays maps each TransportIntent code to its display name.
+var transportIntentDisplays = map[TransportIntent]string{
+	TransportIntentUnknown:       "Unknown",
+	TransportIntentProposal:      "",
+	TransportIntentPlan:          "",
+	TransportIntentOrder:         "",
+	TransportIntentOriginalOrder: "",
+	TransportIntentReflexOrder:   "",
+	TransportIntentFillerOrder:   "",
+	TransportIntentInstanceOrder: "",
+	TransportIntentOption:        "",
+}
+
+// Code returns the code value.
+func (c TransportIntent) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the TransportIntent values.
+func (c TransportIntent) IsValid() bool {
+	_, ok := transportIntentDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c TransportIntent) Display() string {
+	if display := transportIntentDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseTransportIntent returns the TransportIntent for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseTransportIntent(s string) (TransportIntent, error) {
+	return parseCode(s, transportIntentDisplays)
+}
+
 // TransportStatus represents Transport Status.
 type TransportStatus string
 
@@ -4323,6 +12239,41 @@ const (
 	TransportStatusEnteredInError TransportStatus = "entered-in-error"
 )
 
+// transportStatusDisplays maps each TransportStatus code to its display name.
+var transportStatusDisplays = map[TransportStatus]string{
+	TransportStatusInProgress:     "In Progress",
+	TransportStatusCompleted:      "Completed",
+	TransportStatusAbandoned:      "Abandoned",
+	TransportStatusCancelled:      "Cancelled",
+	TransportStatusPlanned:        "Planned",
+	TransportStatusEnteredInError: "Entered In Error",
+}
+
+// Code returns the code value.
+func (c TransportStatus) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the TransportStatus values.
+func (c TransportStatus) IsValid() bool {
+	_, ok := transportStatusDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c TransportStatus) Display() string {
+	if display := transportStatusDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseTransportStatus returns the TransportStatus for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseTransportStatus(s string) (TransportStatus, error) {
+	return parseCode(s, transportStatusDisplays)
+}
+
 // TriggerType represents TriggerType.
 type TriggerType string
 
@@ -4346,6 +12297,43 @@ const (
 	TriggerTypeDataAccessEnded TriggerType = "data-access-ended"
 )
 
+// triggerTypeDisplays maps each TriggerType code to its display name.
+var triggerTypeDisplays = map[TriggerType]string{
+	TriggerTypeNamedEvent:      "Named Event",
+	TriggerTypePeriodic:        "Periodic",
+	TriggerTypeDataChanged:     "Data Changed",
+	TriggerTypeDataAdded:       "Data Added",
+	TriggerTypeDataModified:    "Data Updated",
+	TriggerTypeDataRemoved:     "Data Removed",
+	TriggerTypeDataAccessed:    "Data Accessed",
+	TriggerTypeDataAccessEnded: "Data Access Ended",
+}
+
+// Code returns the code value.
+func (c TriggerType) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the TriggerType values.
+func (c TriggerType) IsValid() bool {
+	_, ok := triggerTypeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c TriggerType) Display() string {
+	if display := triggerTypeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseTriggerType returns the TriggerType for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseTriggerType(s string) (TriggerType, error) {
+	return parseCode(s, triggerTypeDisplays)
+}
+
 // TypeDerivationRule represents Type Derivation Rule.
 type TypeDerivationRule string
 
@@ -4357,6 +12345,37 @@ const (
 	TypeDerivationRuleConstraint TypeDerivationRule = "constraint"
 )
 
+// typeDerivationRuleDisplays maps each TypeDerivationRule code to its display name.
+var typeDerivationRuleDisplays = map[TypeDerivationRule]string{
+	TypeDerivationRuleSpecialization: "Specialization",
+	TypeDerivationRuleConstraint:     "Constraint",
+}
+
+// Code returns the code value.
+func (c TypeDerivationRule) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the TypeDerivationRule values.
+func (c TypeDerivationRule) IsValid() bool {
+	_, ok := typeDerivationRuleDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c TypeDerivationRule) Display() string {
+	if display := typeDerivationRuleDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseTypeDerivationRule returns the TypeDerivationRule for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseTypeDerivationRule(s string) (TypeDerivationRule, error) {
+	return parseCode(s, typeDerivationRuleDisplays)
+}
+
 // TypeRestfulInteraction represents Type Restful Interaction.
 type TypeRestfulInteraction string
 
@@ -4373,6 +12392,44 @@ const (
 	TypeRestfulInteractionSearchType      TypeRestfulInteraction = "search-type"
 )
 
+// typeRestfulInteractionDisplays maps each TypeRestfulInteraction code to its display name.
+var typeRestfulInteractionDisplays = map[TypeRestfulInteraction]string{
+	TypeRestfulInteractionRead:            "",
+	TypeRestfulInteractionVread:           "",
+	TypeRestfulInteractionUpdate:          "",
+	TypeRestfulInteractionPatch:           "",
+	TypeRestfulInteractionDelete:          "",
+	TypeRestfulInteractionHistoryInstance: "",
+	TypeRestfulInteractionHistoryType:     "",
+	TypeRestfulInteractionCreate:          "",
+	TypeRestfulInteractionSearchType:      "",
+}
+
+// Code returns the code value.
+func (c TypeRestfulInteraction) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the TypeRestfulInteraction values.
+func (c TypeRestfulInteraction) IsValid() bool {
+	_, ok := typeRestfulInteractionDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c TypeRestfulInteraction) Display() string {
+	if display := typeRestfulInteractionDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseTypeRestfulInteraction returns the TypeRestfulInteraction for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseTypeRestfulInteraction(s string) (TypeRestfulInteraction, error) {
+	return parseCode(s, typeRestfulInteractionDisplays)
+}
+
 // UDIEntryType represents UDI Entry Type.
 type UDIEntryType string
 
@@ -4394,6 +12451,42 @@ const (
 	UDIEntryTypeUnknown UDIEntryType = "unknown"
 )
 
+// uDIEntryTypeDisplays maps each UDIEntryType code to its display name.
+var uDIEntryTypeDisplays = map[UDIEntryType]string{
+	UDIEntryTypeBarcode:                "Barcode",
+	UDIEntryTypeRfid:                   "RFID",
+	UDIEntryTypeManual:                 "Manual",
+	UDIEntryTypeCard:                   "Card",
+	UDIEntryTypeSelfReported:           "Self Reported",
+	UDIEntryTypeElectronicTransmission: "Electronic Transmission",
+	UDIEntryTypeUnknown:                "Unknown",
+}
+
+// Code returns the code value.
+func (c UDIEntryType) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the UDIEntryType values.
+func (c UDIEntryType) IsValid() bool {
+	_, ok := uDIEntryTypeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c UDIEntryType) Display() string {
+	if display := uDIEntryTypeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseUDIEntryType returns the UDIEntryType for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseUDIEntryType(s string) (UDIEntryType, error) {
+	return parseCode(s, uDIEntryTypeDisplays)
+}
+
 // UnitsOfTime represents UnitsOfTime.
 type UnitsOfTime string
 
@@ -4415,6 +12508,42 @@ const (
 	UnitsOfTimeA UnitsOfTime = "a"
 )
 
+// unitsOfTimeDisplays maps each UnitsOfTime code to its display name.
+var unitsOfTimeDisplays = map[UnitsOfTime]string{
+	UnitsOfTimeS:   "second",
+	UnitsOfTimeMin: "minute",
+	UnitsOfTimeH:   "hour",
+	UnitsOfTimeD:   "day",
+	UnitsOfTimeWk:  "week",
+	UnitsOfTimeMo:  "month",
+	UnitsOfTimeA:   "year",
+}
+
+// Code returns the code value.
+func (c UnitsOfTime) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the UnitsOfTime values.
+func (c UnitsOfTime) IsValid() bool {
+	_, ok := unitsOfTimeDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c UnitsOfTime) Display() string {
+	if display := unitsOfTimeDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseUnitsOfTime returns the UnitsOfTime for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseUnitsOfTime(s string) (UnitsOfTime, error) {
+	return parseCode(s, unitsOfTimeDisplays)
+}
+
 // ValueFilterComparator represents Value Filter Comparator.
 type ValueFilterComparator string
 
@@ -4436,6 +12565,42 @@ const (
 	ValueFilterComparatorEb ValueFilterComparator = "eb"
 )
 
+// valueFilterComparatorDisplays maps each ValueFilterComparator code to its display name.
+var valueFilterComparatorDisplays = map[ValueFilterComparator]string{
+	ValueFilterComparatorEq: "Equals",
+	ValueFilterComparatorGt: "Greater Than",
+	ValueFilterComparatorLt: "Less Than",
+	ValueFilterComparatorGe: "Greater or Equals",
+	ValueFilterComparatorLe: "Less of Equal",
+	ValueFilterComparatorSa: "Starts After",
+	ValueFilterComparatorEb: "Ends Before",
+}
+
+// Code returns the code value.
+func (c ValueFilterComparator) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the ValueFilterComparator values.
+func (c ValueFilterComparator) IsValid() bool {
+	_, ok := valueFilterComparatorDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c ValueFilterComparator) Display() string {
+	if display := valueFilterComparatorDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseValueFilterComparator returns the ValueFilterComparator for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseValueFilterComparator(s string) (ValueFilterComparator, error) {
+	return parseCode(s, valueFilterComparatorDisplays)
+}
+
 // EvidenceVariableHandling represents Evidence Variable Handling.
 type EvidenceVariableHandling string
 
@@ -4451,6 +12616,39 @@ const (
 	EvidenceVariableHandlingPolychotomous EvidenceVariableHandling = "polychotomous"
 )
 
+// evidenceVariableHandlingDisplays maps each EvidenceVariableHandling code to its display name.
+var evidenceVariableHandlingDisplays = map[EvidenceVariableHandling]string{
+	EvidenceVariableHandlingContinuous:    "continuous variable",
+	EvidenceVariableHandlingDichotomous:   "dichotomous variable",
+	EvidenceVariableHandlingOrdinal:       "ordinal variable",
+	EvidenceVariableHandlingPolychotomous: "polychotomous variable",
+}
+
+// Code returns the code value.
+func (c EvidenceVariableHandling) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the EvidenceVariableHandling values.
+func (c EvidenceVariableHandling) IsValid() bool {
+	_, ok := evidenceVariableHandlingDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c EvidenceVariableHandling) Display() string {
+	if display := evidenceVariableHandlingDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseEvidenceVariableHandling returns the EvidenceVariableHandling for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseEvidenceVariableHandling(s string) (EvidenceVariableHandling, error) {
+	return parseCode(s, evidenceVariableHandlingDisplays)
+}
+
 // VerificationResultStatus represents Verification Result status.
 type VerificationResultStatus string
 
@@ -4472,6 +12670,42 @@ const (
 	VerificationResultStatusEnteredInError VerificationResultStatus = "entered-in-error"
 )
 
+// verificationResultStatusDisplays maps each VerificationResultStatus code to its display name.
+var verificationResultStatusDisplays = map[VerificationResultStatus]string{
+	VerificationResultStatusAttested:       "Attested",
+	VerificationResultStatusValidated:      "Validated",
+	VerificationResultStatusInProcess:      "In process",
+	VerificationResultStatusReqRevalid:     "Requires revalidation",
+	VerificationResultStatusValFail:        "Validation failed",
+	VerificationResultStatusRevalFail:      "Re-Validation failed",
+	VerificationResultStatusEnteredInError: "Entered in Error",
+}
+
+// Code returns the code value.
+func (c VerificationResultStatus) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the VerificationResultStatus values.
+func (c VerificationResultStatus) IsValid() bool {
+	_, ok := verificationResultStatusDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c VerificationResultStatus) Display() string {
+	if display := verificationResultStatusDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseVerificationResultStatus returns the VerificationResultStatus for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseVerificationResultStatus(s string) (VerificationResultStatus, error) {
+	return parseCode(s, verificationResultStatusDisplays)
+}
+
 // VersionIndependentResourceTypesAll represents Version Independent Resource Types (All).
 type VersionIndependentResourceTypesAll string
 
@@ -4561,6 +12795,76 @@ const (
 	VersionIndependentResourceTypesAllSubstancespecification VersionIndependentResourceTypesAll = "SubstanceSpecification"
 )
 
+// versionIndependentResourceTypesAllDisplays maps each VersionIndependentResourceTypesAll code to its display name.
+var versionIndependentResourceTypesAllDisplays = map[VersionIndependentResourceTypesAll]string{
+	VersionIndependentResourceTypesAllBodysite:                          "BodySite",
+	VersionIndependentResourceTypesAllCatalogentry:                      "CatalogEntry",
+	VersionIndependentResourceTypesAllConformance:                       "Conformance",
+	VersionIndependentResourceTypesAllDataelement:                       "DataElement",
+	VersionIndependentResourceTypesAllDevicecomponent:                   "DeviceComponent",
+	VersionIndependentResourceTypesAllDeviceuserequest:                  "DeviceUseRequest",
+	VersionIndependentResourceTypesAllDeviceusestatement:                "DeviceUseStatement",
+	VersionIndependentResourceTypesAllDiagnosticorder:                   "DiagnosticOrder",
+	VersionIndependentResourceTypesAllDocumentmanifest:                  "DocumentManifest",
+	VersionIndependentResourceTypesAllEffectevidencesynthesis:           "EffectEvidenceSynthesis",
+	VersionIndependentResourceTypesAllEligibilityrequest:                "EligibilityRequest",
+	VersionIndependentResourceTypesAllEligibilityresponse:               "EligibilityResponse",
+	VersionIndependentResourceTypesAllExpansionprofile:                  "ExpansionProfile",
+	VersionIndependentResourceTypesAllImagingmanifest:                   "ImagingManifest",
+	VersionIndependentResourceTypesAllImagingobjectselection:            "ImagingObjectSelection",
+	VersionIndependentResourceTypesAllMedia:                             "Media",
+	VersionIndependentResourceTypesAllMedicationorder:                   "MedicationOrder",
+	VersionIndependentResourceTypesAllMedicationusage:                   "MedicationUsage",
+	VersionIndependentResourceTypesAllMedicinalproduct:                  "MedicinalProduct",
+	VersionIndependentResourceTypesAllMedicinalproductauthorization:     "MedicinalProductAuthorization",
+	VersionIndependentResourceTypesAllMedicinalproductcontraindication:  "MedicinalProductContraindication",
+	VersionIndependentResourceTypesAllMedicinalproductindication:        "MedicinalProductIndication",
+	VersionIndependentResourceTypesAllMedicinalproductingredient:        "MedicinalProductIngredient",
+	VersionIndependentResourceTypesAllMedicinalproductinteraction:       "MedicinalProductInteraction",
+	VersionIndependentResourceTypesAllMedicinalproductmanufactured:      "MedicinalProductManufactured",
+	VersionIndependentResourceTypesAllMedicinalproductpackaged:          "MedicinalProductPackaged",
+	VersionIndependentResourceTypesAllMedicinalproductpharmaceutical:    "MedicinalProductPharmaceutical",
+	VersionIndependentResourceTypesAllMedicinalproductundesirableeffect: "MedicinalProductUndesirableEffect",
+	VersionIndependentResourceTypesAllOrder:                             "Order",
+	VersionIndependentResourceTypesAllOrderresponse:                     "OrderResponse",
+	VersionIndependentResourceTypesAllProcedurerequest:                  "ProcedureRequest",
+	VersionIndependentResourceTypesAllProcessrequest:                    "ProcessRequest",
+	VersionIndependentResourceTypesAllProcessresponse:                   "ProcessResponse",
+	VersionIndependentResourceTypesAllReferralrequest:                   "ReferralRequest",
+	VersionIndependentResourceTypesAllRequestgroup:                      "RequestGroup",
+	VersionIndependentResourceTypesAllResearchdefinition:                "ResearchDefinition",
+	VersionIndependentResourceTypesAllResearchelementdefinition:         "ResearchElementDefinition",
+	VersionIndependentResourceTypesAllRiskevidencesynthesis:             "RiskEvidenceSynthesis",
+	VersionIndependentResourceTypesAllSequence:                          "Sequence",
+	VersionIndependentResourceTypesAllServicedefinition:                 "ServiceDefinition",
+	VersionIndependentResourceTypesAllSubstancespecification:            "SubstanceSpecification",
+}
+
+// Code returns the code value.
+func (c VersionIndependentResourceTypesAll) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the VersionIndependentResourceTypesAll values.
+func (c VersionIndependentResourceTypesAll) IsValid() bool {
+	_, ok := versionIndependentResourceTypesAllDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c VersionIndependentResourceTypesAll) Display() string {
+	if display := versionIndependentResourceTypesAllDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseVersionIndependentResourceTypesAll returns the VersionIndependentResourceTypesAll for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseVersionIndependentResourceTypesAll(s string) (VersionIndependentResourceTypesAll, error) {
+	return parseCode(s, versionIndependentResourceTypesAllDisplays)
+}
+
 // ResourceVersionPolicy represents Resource Version Policy.
 type ResourceVersionPolicy string
 
@@ -4574,6 +12878,38 @@ const (
 	ResourceVersionPolicyVersionedUpdate ResourceVersionPolicy = "versioned-update"
 )
 
+// resourceVersionPolicyDisplays maps each ResourceVersionPolicy code to its display name.
+var resourceVersionPolicyDisplays = map[ResourceVersionPolicy]string{
+	ResourceVersionPolicyNoVersion:       "No VersionId Support",
+	ResourceVersionPolicyVersioned:       "Versioned",
+	ResourceVersionPolicyVersionedUpdate: "VersionId tracked fully",
+}
+
+// Code returns the code value.
+func (c ResourceVersionPolicy) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the ResourceVersionPolicy values.
+func (c ResourceVersionPolicy) IsValid() bool {
+	_, ok := resourceVersionPolicyDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c ResourceVersionPolicy) Display() string {
+	if display := resourceVersionPolicyDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseResourceVersionPolicy returns the ResourceVersionPolicy for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseResourceVersionPolicy(s string) (ResourceVersionPolicy, error) {
+	return parseCode(s, resourceVersionPolicyDisplays)
+}
+
 // VisionBase represents Vision Base.
 type VisionBase string
 
@@ -4589,6 +12925,39 @@ const (
 	VisionBaseOut VisionBase = "out"
 )
 
+// visionBaseDisplays maps each VisionBase code to its display name.
+var visionBaseDisplays = map[VisionBase]string{
+	VisionBaseUp:   "Up",
+	VisionBaseDown: "Down",
+	VisionBaseIn:   "In",
+	VisionBaseOut:  "Out",
+}
+
+// Code returns the code value.
+func (c VisionBase) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the VisionBase values.
+func (c VisionBase) IsValid() bool {
+	_, ok := visionBaseDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c VisionBase) Display() string {
+	if display := visionBaseDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseVisionBase returns the VisionBase for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseVisionBase(s string) (VisionBase, error) {
+	return parseCode(s, visionBaseDisplays)
+}
+
 // VisionEyes represents Vision Eyes.
 type VisionEyes string
 
@@ -4599,3 +12968,34 @@ const (
 	// VisionEyesLeft - Left Eye
 	VisionEyesLeft VisionEyes = "left"
 )
+
+// visionEyesDisplays maps each VisionEyes code to its display name.
+var visionEyesDisplays = map[VisionEyes]string{
+	VisionEyesRight: "Right Eye",
+	VisionEyesLeft:  "Left Eye",
+}
+
+// Code returns the code value.
+func (c VisionEyes) Code() string {
+	return string(c)
+}
+
+// IsValid reports whether the code is one of the VisionEyes values.
+func (c VisionEyes) IsValid() bool {
+	_, ok := visionEyesDisplays[c]
+	return ok
+}
+
+// Display returns the display name of the code, or the code itself if it has none.
+func (c VisionEyes) Display() string {
+	if display := visionEyesDisplays[c]; display != "" {
+		return display
+	}
+	return string(c)
+}
+
+// ParseVisionEyes returns the VisionEyes for a code or display name.
+// An exact code match is preferred; otherwise matching ignores case.
+func ParseVisionEyes(s string) (VisionEyes, error) {
+	return parseCode(s, visionEyesDisplays)
+}
@@ -84,6 +84,45 @@ func TestCodeSystemTypeConversions(t *testing.T) {
 	})
 }
 
+func TestCodeSystemCodeAndDisplay(t *testing.T) {
+	t.Run("code and display", func(t *testing.T) {
+		assert.Equal(t, "male", AdministrativeGenderMale.Code())
+		assert.Equal(t, "Male", AdministrativeGenderMale.Display())
+	})
+
+	t.Run("display falls back to code", func(t *testing.T) {
+		assert.Equal(t, "custom", AdministrativeGender("custom").Display())
+	})
+
+	t.Run("is valid", func(t *testing.T) {
+		assert.True(t, AdministrativeGenderMale.IsValid())
+		assert.True(t, AdministrativeGender("unknown").IsValid())
+		assert.False(t, AdministrativeGender("invalid").IsValid())
+		assert.False(t, AdministrativeGender("").IsValid())
+	})
+
+	t.Run("parse code", func(t *testing.T) {
+		gender, err := ParseAdministrativeGender("male")
+		assert.NoError(t, err)
+		assert.Equal(t, AdministrativeGenderMale, gender)
+	})
+
+	t.Run("parse ignores case and accepts display", func(t *testing.T) {
+		gender, err := ParseAdministrativeGender("FEMALE")
+		assert.NoError(t, err)
+		assert.Equal(t, AdministrativeGenderFemale, gender)
+
+		comparator, err := ParseQuantityComparator("less than")
+		assert.NoError(t, err)
+		assert.Equal(t, QuantityComparatorLessThan, comparator)
+	})
+
+	t.Run("parse unknown code", func(t *testing.T) {
+		_, err := ParseAdministrativeGender("invalid")
+		assert.Error(t, err)
+	})
+}
+
 func TestQuantityComparator(t *testing.T) {
 	t.Run("comparator constants", func(t *testing.T) {
 		assert.Equal(t, QuantityComparator("<"), QuantityComparatorLessThan)
@@ -1,6 +1,7 @@
 package r5_test
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -73,6 +74,21 @@ func TestPatientFunctionalOptions(t *testing.T) {
 		assert.Nil(t, patient.Active)
 		assert.Empty(t, patient.Name)
 	})
+
+	t.Run("apply options to decoded patient", func(t *testing.T) {
+		var patient r5.Patient
+		require.NoError(t, json.Unmarshal([]byte(`{"resourceType":"Patient","id":"p1","active":false}`), &patient))
+
+		result := patient.Apply(
+			r5.WithPatientActive(true),
+			r5.WithPatientBirthDate("1990-01-15"),
+		)
+
+		assert.Same(t, &patient, result)
+		assert.Equal(t, "p1", *patient.Id)
+		assert.True(t, *patient.Active)
+		assert.Equal(t, "1990-01-15", *patient.BirthDate)
+	})
 }
 
 func TestObservationFunctionalOptions(t *testing.T) {
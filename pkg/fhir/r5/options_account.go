@@ -20,6 +20,14 @@ func NewAccount(opts ...AccountOption) *Account {
 	return r
 }
 
+// Apply applies the given options to an existing Account, such as a decoded one, and returns it.
+func (r *Account) Apply(opts ...AccountOption) *Account {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithAccountId sets the Id field.
 func WithAccountId(v string) AccountOption {
 	return func(r *Account) {
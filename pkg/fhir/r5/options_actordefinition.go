@@ -20,6 +20,14 @@ func NewActorDefinition(opts ...ActorDefinitionOption) *ActorDefinition {
 	return r
 }
 
+// Apply applies the given options to an existing ActorDefinition, such as a decoded one, and returns it.
+func (r *ActorDefinition) Apply(opts ...ActorDefinitionOption) *ActorDefinition {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithActorDefinitionId sets the Id field.
 func WithActorDefinitionId(v string) ActorDefinitionOption {
 	return func(r *ActorDefinition) {
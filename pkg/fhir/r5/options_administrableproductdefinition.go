@@ -20,6 +20,14 @@ func NewAdministrableProductDefinition(opts ...AdministrableProductDefinitionOpt
 	return r
 }
 
+// Apply applies the given options to an existing AdministrableProductDefinition, such as a decoded one, and returns it.
+func (r *AdministrableProductDefinition) Apply(opts ...AdministrableProductDefinitionOption) *AdministrableProductDefinition {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithAdministrableProductDefinitionId sets the Id field.
 func WithAdministrableProductDefinitionId(v string) AdministrableProductDefinitionOption {
 	return func(r *AdministrableProductDefinition) {
@@ -20,6 +20,14 @@ func NewAdverseEvent(opts ...AdverseEventOption) *AdverseEvent {
 	return r
 }
 
+// Apply applies the given options to an existing AdverseEvent, such as a decoded one, and returns it.
+func (r *AdverseEvent) Apply(opts ...AdverseEventOption) *AdverseEvent {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithAdverseEventId sets the Id field.
 func WithAdverseEventId(v string) AdverseEventOption {
 	return func(r *AdverseEvent) {
@@ -20,6 +20,14 @@ func NewAllergyIntolerance(opts ...AllergyIntoleranceOption) *AllergyIntolerance
 	return r
 }
 
+// Apply applies the given options to an existing AllergyIntolerance, such as a decoded one, and returns it.
+func (r *AllergyIntolerance) Apply(opts ...AllergyIntoleranceOption) *AllergyIntolerance {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithAllergyIntoleranceId sets the Id field.
 func WithAllergyIntoleranceId(v string) AllergyIntoleranceOption {
 	return func(r *AllergyIntolerance) {
@@ -20,6 +20,14 @@ func NewArtifactAssessment(opts ...ArtifactAssessmentOption) *ArtifactAssessment
 	return r
 }
 
+// Apply applies the given options to an existing ArtifactAssessment, such as a decoded one, and returns it.
+func (r *ArtifactAssessment) Apply(opts ...ArtifactAssessmentOption) *ArtifactAssessment {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithArtifactAssessmentId sets the Id field.
 func WithArtifactAssessmentId(v string) ArtifactAssessmentOption {
 	return func(r *ArtifactAssessment) {
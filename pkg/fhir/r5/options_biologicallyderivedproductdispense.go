@@ -20,6 +20,14 @@ func NewBiologicallyDerivedProductDispense(opts ...BiologicallyDerivedProductDis
 	return r
 }
 
+// Apply applies the given options to an existing BiologicallyDerivedProductDispense, such as a decoded one, and returns it.
+func (r *BiologicallyDerivedProductDispense) Apply(opts ...BiologicallyDerivedProductDispenseOption) *BiologicallyDerivedProductDispense {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithBiologicallyDerivedProductDispenseId sets the Id field.
 func WithBiologicallyDerivedProductDispenseId(v string) BiologicallyDerivedProductDispenseOption {
 	return func(r *BiologicallyDerivedProductDispense) {
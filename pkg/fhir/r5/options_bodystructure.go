@@ -20,6 +20,14 @@ func NewBodyStructure(opts ...BodyStructureOption) *BodyStructure {
 	return r
 }
 
+// Apply applies the given options to an existing BodyStructure, such as a decoded one, and returns it.
+func (r *BodyStructure) Apply(opts ...BodyStructureOption) *BodyStructure {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithBodyStructureId sets the Id field.
 func WithBodyStructureId(v string) BodyStructureOption {
 	return func(r *BodyStructure) {
@@ -20,6 +20,14 @@ func NewCareTeam(opts ...CareTeamOption) *CareTeam {
 	return r
 }
 
+// Apply applies the given options to an existing CareTeam, such as a decoded one, and returns it.
+func (r *CareTeam) Apply(opts ...CareTeamOption) *CareTeam {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithCareTeamId sets the Id field.
 func WithCareTeamId(v string) CareTeamOption {
 	return func(r *CareTeam) {
@@ -20,6 +20,14 @@ func NewChargeItem(opts ...ChargeItemOption) *ChargeItem {
 	return r
 }
 
+// Apply applies the given options to an existing ChargeItem, such as a decoded one, and returns it.
+func (r *ChargeItem) Apply(opts ...ChargeItemOption) *ChargeItem {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithChargeItemId sets the Id field.
 func WithChargeItemId(v string) ChargeItemOption {
 	return func(r *ChargeItem) {
@@ -20,6 +20,14 @@ func NewClaim(opts ...ClaimOption) *Claim {
 	return r
 }
 
+// Apply applies the given options to an existing Claim, such as a decoded one, and returns it.
+func (r *Claim) Apply(opts ...ClaimOption) *Claim {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithClaimId sets the Id field.
 func WithClaimId(v string) ClaimOption {
 	return func(r *Claim) {
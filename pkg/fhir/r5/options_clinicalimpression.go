@@ -20,6 +20,14 @@ func NewClinicalImpression(opts ...ClinicalImpressionOption) *ClinicalImpression
 	return r
 }
 
+// Apply applies the given options to an existing ClinicalImpression, such as a decoded one, and returns it.
+func (r *ClinicalImpression) Apply(opts ...ClinicalImpressionOption) *ClinicalImpression {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithClinicalImpressionId sets the Id field.
 func WithClinicalImpressionId(v string) ClinicalImpressionOption {
 	return func(r *ClinicalImpression) {
@@ -20,6 +20,14 @@ func NewCodeSystem(opts ...CodeSystemOption) *CodeSystem {
 	return r
 }
 
+// Apply applies the given options to an existing CodeSystem, such as a decoded one, and returns it.
+func (r *CodeSystem) Apply(opts ...CodeSystemOption) *CodeSystem {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithCodeSystemId sets the Id field.
 func WithCodeSystemId(v string) CodeSystemOption {
 	return func(r *CodeSystem) {
@@ -20,6 +20,14 @@ func NewCompartmentDefinition(opts ...CompartmentDefinitionOption) *CompartmentD
 	return r
 }
 
+// Apply applies the given options to an existing CompartmentDefinition, such as a decoded one, and returns it.
+func (r *CompartmentDefinition) Apply(opts ...CompartmentDefinitionOption) *CompartmentDefinition {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithCompartmentDefinitionId sets the Id field.
 func WithCompartmentDefinitionId(v string) CompartmentDefinitionOption {
 	return func(r *CompartmentDefinition) {
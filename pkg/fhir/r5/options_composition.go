@@ -20,6 +20,14 @@ func NewComposition(opts ...CompositionOption) *Composition {
 	return r
 }
 
+// Apply applies the given options to an existing Composition, such as a decoded one, and returns it.
+func (r *Composition) Apply(opts ...CompositionOption) *Composition {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithCompositionId sets the Id field.
 func WithCompositionId(v string) CompositionOption {
 	return func(r *Composition) {
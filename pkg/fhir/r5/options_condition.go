@@ -20,6 +20,14 @@ func NewCondition(opts ...ConditionOption) *Condition {
 	return r
 }
 
+// Apply applies the given options to an existing Condition, such as a decoded one, and returns it.
+func (r *Condition) Apply(opts ...ConditionOption) *Condition {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithConditionId sets the Id field.
 func WithConditionId(v string) ConditionOption {
 	return func(r *Condition) {
@@ -20,6 +20,14 @@ func NewConditionDefinition(opts ...ConditionDefinitionOption) *ConditionDefinit
 	return r
 }
 
+// Apply applies the given options to an existing ConditionDefinition, such as a decoded one, and returns it.
+func (r *ConditionDefinition) Apply(opts ...ConditionDefinitionOption) *ConditionDefinition {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithConditionDefinitionId sets the Id field.
 func WithConditionDefinitionId(v string) ConditionDefinitionOption {
 	return func(r *ConditionDefinition) {
@@ -20,6 +20,14 @@ func NewConsent(opts ...ConsentOption) *Consent {
 	return r
 }
 
+// Apply applies the given options to an existing Consent, such as a decoded one, and returns it.
+func (r *Consent) Apply(opts ...ConsentOption) *Consent {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithConsentId sets the Id field.
 func WithConsentId(v string) ConsentOption {
 	return func(r *Consent) {
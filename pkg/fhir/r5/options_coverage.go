@@ -20,6 +20,14 @@ func NewCoverage(opts ...CoverageOption) *Coverage {
 	return r
 }
 
+// Apply applies the given options to an existing Coverage, such as a decoded one, and returns it.
+func (r *Coverage) Apply(opts ...CoverageOption) *Coverage {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithCoverageId sets the Id field.
 func WithCoverageId(v string) CoverageOption {
 	return func(r *Coverage) {
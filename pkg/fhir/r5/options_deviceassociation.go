@@ -20,6 +20,14 @@ func NewDeviceAssociation(opts ...DeviceAssociationOption) *DeviceAssociation {
 	return r
 }
 
+// Apply applies the given options to an existing DeviceAssociation, such as a decoded one, and returns it.
+func (r *DeviceAssociation) Apply(opts ...DeviceAssociationOption) *DeviceAssociation {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithDeviceAssociationId sets the Id field.
 func WithDeviceAssociationId(v string) DeviceAssociationOption {
 	return func(r *DeviceAssociation) {
@@ -20,6 +20,14 @@ func NewDeviceDispense(opts ...DeviceDispenseOption) *DeviceDispense {
 	return r
 }
 
+// Apply applies the given options to an existing DeviceDispense, such as a decoded one, and returns it.
+func (r *DeviceDispense) Apply(opts ...DeviceDispenseOption) *DeviceDispense {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithDeviceDispenseId sets the Id field.
 func WithDeviceDispenseId(v string) DeviceDispenseOption {
 	return func(r *DeviceDispense) {
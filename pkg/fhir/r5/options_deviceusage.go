@@ -20,6 +20,14 @@ func NewDeviceUsage(opts ...DeviceUsageOption) *DeviceUsage {
 	return r
 }
 
+// Apply applies the given options to an existing DeviceUsage, such as a decoded one, and returns it.
+func (r *DeviceUsage) Apply(opts ...DeviceUsageOption) *DeviceUsage {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithDeviceUsageId sets the Id field.
 func WithDeviceUsageId(v string) DeviceUsageOption {
 	return func(r *DeviceUsage) {
@@ -20,6 +20,14 @@ func NewEncounterHistory(opts ...EncounterHistoryOption) *EncounterHistory {
 	return r
 }
 
+// Apply applies the given options to an existing EncounterHistory, such as a decoded one, and returns it.
+func (r *EncounterHistory) Apply(opts ...EncounterHistoryOption) *EncounterHistory {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithEncounterHistoryId sets the Id field.
 func WithEncounterHistoryId(v string) EncounterHistoryOption {
 	return func(r *EncounterHistory) {
@@ -20,6 +20,14 @@ func NewEndpoint(opts ...EndpointOption) *Endpoint {
 	return r
 }
 
+// Apply applies the given options to an existing Endpoint, such as a decoded one, and returns it.
+func (r *Endpoint) Apply(opts ...EndpointOption) *Endpoint {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithEndpointId sets the Id field.
 func WithEndpointId(v string) EndpointOption {
 	return func(r *Endpoint) {
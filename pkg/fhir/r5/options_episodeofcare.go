@@ -20,6 +20,14 @@ func NewEpisodeOfCare(opts ...EpisodeOfCareOption) *EpisodeOfCare {
 	return r
 }
 
+// Apply applies the given options to an existing EpisodeOfCare, such as a decoded one, and returns it.
+func (r *EpisodeOfCare) Apply(opts ...EpisodeOfCareOption) *EpisodeOfCare {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithEpisodeOfCareId sets the Id field.
 func WithEpisodeOfCareId(v string) EpisodeOfCareOption {
 	return func(r *EpisodeOfCare) {
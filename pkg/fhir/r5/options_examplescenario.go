@@ -20,6 +20,14 @@ func NewExampleScenario(opts ...ExampleScenarioOption) *ExampleScenario {
 	return r
 }
 
+// Apply applies the given options to an existing ExampleScenario, such as a decoded one, and returns it.
+func (r *ExampleScenario) Apply(opts ...ExampleScenarioOption) *ExampleScenario {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithExampleScenarioId sets the Id field.
 func WithExampleScenarioId(v string) ExampleScenarioOption {
 	return func(r *ExampleScenario) {
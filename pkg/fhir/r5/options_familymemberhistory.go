@@ -20,6 +20,14 @@ func NewFamilyMemberHistory(opts ...FamilyMemberHistoryOption) *FamilyMemberHist
 	return r
 }
 
+// Apply applies the given options to an existing FamilyMemberHistory, such as a decoded one, and returns it.
+func (r *FamilyMemberHistory) Apply(opts ...FamilyMemberHistoryOption) *FamilyMemberHistory {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithFamilyMemberHistoryId sets the Id field.
 func WithFamilyMemberHistoryId(v string) FamilyMemberHistoryOption {
 	return func(r *FamilyMemberHistory) {
@@ -20,6 +20,14 @@ func NewFlag(opts ...FlagOption) *Flag {
 	return r
 }
 
+// Apply applies the given options to an existing Flag, such as a decoded one, and returns it.
+func (r *Flag) Apply(opts ...FlagOption) *Flag {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithFlagId sets the Id field.
 func WithFlagId(v string) FlagOption {
 	return func(r *Flag) {
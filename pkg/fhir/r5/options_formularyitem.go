@@ -20,6 +20,14 @@ func NewFormularyItem(opts ...FormularyItemOption) *FormularyItem {
 	return r
 }
 
+// Apply applies the given options to an existing FormularyItem, such as a decoded one, and returns it.
+func (r *FormularyItem) Apply(opts ...FormularyItemOption) *FormularyItem {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithFormularyItemId sets the Id field.
 func WithFormularyItemId(v string) FormularyItemOption {
 	return func(r *FormularyItem) {
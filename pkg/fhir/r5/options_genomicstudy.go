@@ -20,6 +20,14 @@ func NewGenomicStudy(opts ...GenomicStudyOption) *GenomicStudy {
 	return r
 }
 
+// Apply applies the given options to an existing GenomicStudy, such as a decoded one, and returns it.
+func (r *GenomicStudy) Apply(opts ...GenomicStudyOption) *GenomicStudy {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithGenomicStudyId sets the Id field.
 func WithGenomicStudyId(v string) GenomicStudyOption {
 	return func(r *GenomicStudy) {
@@ -20,6 +20,14 @@ func NewGraphDefinition(opts ...GraphDefinitionOption) *GraphDefinition {
 	return r
 }
 
+// Apply applies the given options to an existing GraphDefinition, such as a decoded one, and returns it.
+func (r *GraphDefinition) Apply(opts ...GraphDefinitionOption) *GraphDefinition {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithGraphDefinitionId sets the Id field.
 func WithGraphDefinitionId(v string) GraphDefinitionOption {
 	return func(r *GraphDefinition) {
@@ -20,6 +20,14 @@ func NewHealthcareService(opts ...HealthcareServiceOption) *HealthcareService {
 	return r
 }
 
+// Apply applies the given options to an existing HealthcareService, such as a decoded one, and returns it.
+func (r *HealthcareService) Apply(opts ...HealthcareServiceOption) *HealthcareService {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithHealthcareServiceId sets the Id field.
 func WithHealthcareServiceId(v string) HealthcareServiceOption {
 	return func(r *HealthcareService) {
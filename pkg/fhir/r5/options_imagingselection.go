@@ -20,6 +20,14 @@ func NewImagingSelection(opts ...ImagingSelectionOption) *ImagingSelection {
 	return r
 }
 
+// Apply applies the given options to an existing ImagingSelection, such as a decoded one, and returns it.
+func (r *ImagingSelection) Apply(opts ...ImagingSelectionOption) *ImagingSelection {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithImagingSelectionId sets the Id field.
 func WithImagingSelectionId(v string) ImagingSelectionOption {
 	return func(r *ImagingSelection) {
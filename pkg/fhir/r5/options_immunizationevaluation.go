@@ -20,6 +20,14 @@ func NewImmunizationEvaluation(opts ...ImmunizationEvaluationOption) *Immunizati
 	return r
 }
 
+// Apply applies the given options to an existing ImmunizationEvaluation, such as a decoded one, and returns it.
+func (r *ImmunizationEvaluation) Apply(opts ...ImmunizationEvaluationOption) *ImmunizationEvaluation {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithImmunizationEvaluationId sets the Id field.
 func WithImmunizationEvaluationId(v string) ImmunizationEvaluationOption {
 	return func(r *ImmunizationEvaluation) {
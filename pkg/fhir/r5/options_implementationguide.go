@@ -20,6 +20,14 @@ func NewImplementationGuide(opts ...ImplementationGuideOption) *ImplementationGu
 	return r
 }
 
+// Apply applies the given options to an existing ImplementationGuide, such as a decoded one, and returns it.
+func (r *ImplementationGuide) Apply(opts ...ImplementationGuideOption) *ImplementationGuide {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithImplementationGuideId sets the Id field.
 func WithImplementationGuideId(v string) ImplementationGuideOption {
 	return func(r *ImplementationGuide) {
@@ -20,6 +20,14 @@ func NewInsurancePlan(opts ...InsurancePlanOption) *InsurancePlan {
 	return r
 }
 
+// Apply applies the given options to an existing InsurancePlan, such as a decoded one, and returns it.
+func (r *InsurancePlan) Apply(opts ...InsurancePlanOption) *InsurancePlan {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithInsurancePlanId sets the Id field.
 func WithInsurancePlanId(v string) InsurancePlanOption {
 	return func(r *InsurancePlan) {
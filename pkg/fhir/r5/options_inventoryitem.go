@@ -20,6 +20,14 @@ func NewInventoryItem(opts ...InventoryItemOption) *InventoryItem {
 	return r
 }
 
+// Apply applies the given options to an existing InventoryItem, such as a decoded one, and returns it.
+func (r *InventoryItem) Apply(opts ...InventoryItemOption) *InventoryItem {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithInventoryItemId sets the Id field.
 func WithInventoryItemId(v string) InventoryItemOption {
 	return func(r *InventoryItem) {
@@ -20,6 +20,14 @@ func NewInventoryReport(opts ...InventoryReportOption) *InventoryReport {
 	return r
 }
 
+// Apply applies the given options to an existing InventoryReport, such as a decoded one, and returns it.
+func (r *InventoryReport) Apply(opts ...InventoryReportOption) *InventoryReport {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithInventoryReportId sets the Id field.
 func WithInventoryReportId(v string) InventoryReportOption {
 	return func(r *InventoryReport) {
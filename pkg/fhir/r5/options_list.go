@@ -20,6 +20,14 @@ func NewList(opts ...ListOption) *List {
 	return r
 }
 
+// Apply applies the given options to an existing List, such as a decoded one, and returns it.
+func (r *List) Apply(opts ...ListOption) *List {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithListId sets the Id field.
 func WithListId(v string) ListOption {
 	return func(r *List) {
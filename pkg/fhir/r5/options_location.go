@@ -20,6 +20,14 @@ func NewLocation(opts ...LocationOption) *Location {
 	return r
 }
 
+// Apply applies the given options to an existing Location, such as a decoded one, and returns it.
+func (r *Location) Apply(opts ...LocationOption) *Location {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithLocationId sets the Id field.
 func WithLocationId(v string) LocationOption {
 	return func(r *Location) {
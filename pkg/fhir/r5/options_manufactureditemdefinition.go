@@ -20,6 +20,14 @@ func NewManufacturedItemDefinition(opts ...ManufacturedItemDefinitionOption) *Ma
 	return r
 }
 
+// Apply applies the given options to an existing ManufacturedItemDefinition, such as a decoded one, and returns it.
+func (r *ManufacturedItemDefinition) Apply(opts ...ManufacturedItemDefinitionOption) *ManufacturedItemDefinition {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithManufacturedItemDefinitionId sets the Id field.
 func WithManufacturedItemDefinitionId(v string) ManufacturedItemDefinitionOption {
 	return func(r *ManufacturedItemDefinition) {
@@ -20,6 +20,14 @@ func NewMeasure(opts ...MeasureOption) *Measure {
 	return r
 }
 
+// Apply applies the given options to an existing Measure, such as a decoded one, and returns it.
+func (r *Measure) Apply(opts ...MeasureOption) *Measure {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithMeasureId sets the Id field.
 func WithMeasureId(v string) MeasureOption {
 	return func(r *Measure) {
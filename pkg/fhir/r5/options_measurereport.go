@@ -20,6 +20,14 @@ func NewMeasureReport(opts ...MeasureReportOption) *MeasureReport {
 	return r
 }
 
+// Apply applies the given options to an existing MeasureReport, such as a decoded one, and returns it.
+func (r *MeasureReport) Apply(opts ...MeasureReportOption) *MeasureReport {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithMeasureReportId sets the Id field.
 func WithMeasureReportId(v string) MeasureReportOption {
 	return func(r *MeasureReport) {
@@ -20,6 +20,14 @@ func NewMedicationKnowledge(opts ...MedicationKnowledgeOption) *MedicationKnowle
 	return r
 }
 
+// Apply applies the given options to an existing MedicationKnowledge, such as a decoded one, and returns it.
+func (r *MedicationKnowledge) Apply(opts ...MedicationKnowledgeOption) *MedicationKnowledge {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithMedicationKnowledgeId sets the Id field.
 func WithMedicationKnowledgeId(v string) MedicationKnowledgeOption {
 	return func(r *MedicationKnowledge) {
@@ -20,6 +20,14 @@ func NewMedicinalProductDefinition(opts ...MedicinalProductDefinitionOption) *Me
 	return r
 }
 
+// Apply applies the given options to an existing MedicinalProductDefinition, such as a decoded one, and returns it.
+func (r *MedicinalProductDefinition) Apply(opts ...MedicinalProductDefinitionOption) *MedicinalProductDefinition {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithMedicinalProductDefinitionId sets the Id field.
 func WithMedicinalProductDefinitionId(v string) MedicinalProductDefinitionOption {
 	return func(r *MedicinalProductDefinition) {
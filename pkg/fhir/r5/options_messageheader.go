@@ -20,6 +20,14 @@ func NewMessageHeader(opts ...MessageHeaderOption) *MessageHeader {
 	return r
 }
 
+// Apply applies the given options to an existing MessageHeader, such as a decoded one, and returns it.
+func (r *MessageHeader) Apply(opts ...MessageHeaderOption) *MessageHeader {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithMessageHeaderId sets the Id field.
 func WithMessageHeaderId(v string) MessageHeaderOption {
 	return func(r *MessageHeader) {
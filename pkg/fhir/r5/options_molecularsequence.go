@@ -20,6 +20,14 @@ func NewMolecularSequence(opts ...MolecularSequenceOption) *MolecularSequence {
 	return r
 }
 
+// Apply applies the given options to an existing MolecularSequence, such as a decoded one, and returns it.
+func (r *MolecularSequence) Apply(opts ...MolecularSequenceOption) *MolecularSequence {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithMolecularSequenceId sets the Id field.
 func WithMolecularSequenceId(v string) MolecularSequenceOption {
 	return func(r *MolecularSequence) {
@@ -20,6 +20,14 @@ func NewNutritionIntake(opts ...NutritionIntakeOption) *NutritionIntake {
 	return r
 }
 
+// Apply applies the given options to an existing NutritionIntake, such as a decoded one, and returns it.
+func (r *NutritionIntake) Apply(opts ...NutritionIntakeOption) *NutritionIntake {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithNutritionIntakeId sets the Id field.
 func WithNutritionIntakeId(v string) NutritionIntakeOption {
 	return func(r *NutritionIntake) {
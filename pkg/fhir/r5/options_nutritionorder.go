@@ -20,6 +20,14 @@ func NewNutritionOrder(opts ...NutritionOrderOption) *NutritionOrder {
 	return r
 }
 
+// Apply applies the given options to an existing NutritionOrder, such as a decoded one, and returns it.
+func (r *NutritionOrder) Apply(opts ...NutritionOrderOption) *NutritionOrder {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithNutritionOrderId sets the Id field.
 func WithNutritionOrderId(v string) NutritionOrderOption {
 	return func(r *NutritionOrder) {
@@ -20,6 +20,14 @@ func NewNutritionProduct(opts ...NutritionProductOption) *NutritionProduct {
 	return r
 }
 
+// Apply applies the given options to an existing NutritionProduct, such as a decoded one, and returns it.
+func (r *NutritionProduct) Apply(opts ...NutritionProductOption) *NutritionProduct {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithNutritionProductId sets the Id field.
 func WithNutritionProductId(v string) NutritionProductOption {
 	return func(r *NutritionProduct) {
@@ -20,6 +20,14 @@ func NewOperationOutcome(opts ...OperationOutcomeOption) *OperationOutcome {
 	return r
 }
 
+// Apply applies the given options to an existing OperationOutcome, such as a decoded one, and returns it.
+func (r *OperationOutcome) Apply(opts ...OperationOutcomeOption) *OperationOutcome {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithOperationOutcomeId sets the Id field.
 func WithOperationOutcomeId(v string) OperationOutcomeOption {
 	return func(r *OperationOutcome) {
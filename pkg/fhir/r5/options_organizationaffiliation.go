@@ -20,6 +20,14 @@ func NewOrganizationAffiliation(opts ...OrganizationAffiliationOption) *Organiza
 	return r
 }
 
+// Apply applies the given options to an existing OrganizationAffiliation, such as a decoded one, and returns it.
+func (r *OrganizationAffiliation) Apply(opts ...OrganizationAffiliationOption) *OrganizationAffiliation {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithOrganizationAffiliationId sets the Id field.
 func WithOrganizationAffiliationId(v string) OrganizationAffiliationOption {
 	return func(r *OrganizationAffiliation) {
@@ -20,6 +20,14 @@ func NewPackagedProductDefinition(opts ...PackagedProductDefinitionOption) *Pack
 	return r
 }
 
+// Apply applies the given options to an existing PackagedProductDefinition, such as a decoded one, and returns it.
+func (r *PackagedProductDefinition) Apply(opts ...PackagedProductDefinitionOption) *PackagedProductDefinition {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithPackagedProductDefinitionId sets the Id field.
 func WithPackagedProductDefinitionId(v string) PackagedProductDefinitionOption {
 	return func(r *PackagedProductDefinition) {
@@ -20,6 +20,14 @@ func NewPermission(opts ...PermissionOption) *Permission {
 	return r
 }
 
+// Apply applies the given options to an existing Permission, such as a decoded one, and returns it.
+func (r *Permission) Apply(opts ...PermissionOption) *Permission {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithPermissionId sets the Id field.
 func WithPermissionId(v string) PermissionOption {
 	return func(r *Permission) {
@@ -20,6 +20,14 @@ func NewPractitioner(opts ...PractitionerOption) *Practitioner {
 	return r
 }
 
+// Apply applies the given options to an existing Practitioner, such as a decoded one, and returns it.
+func (r *Practitioner) Apply(opts ...PractitionerOption) *Practitioner {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithPractitionerId sets the Id field.
 func WithPractitionerId(v string) PractitionerOption {
 	return func(r *Practitioner) {
@@ -20,6 +20,14 @@ func NewProvenance(opts ...ProvenanceOption) *Provenance {
 	return r
 }
 
+// Apply applies the given options to an existing Provenance, such as a decoded one, and returns it.
+func (r *Provenance) Apply(opts ...ProvenanceOption) *Provenance {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithProvenanceId sets the Id field.
 func WithProvenanceId(v string) ProvenanceOption {
 	return func(r *Provenance) {
@@ -20,6 +20,14 @@ func NewRelatedPerson(opts ...RelatedPersonOption) *RelatedPerson {
 	return r
 }
 
+// Apply applies the given options to an existing RelatedPerson, such as a decoded one, and returns it.
+func (r *RelatedPerson) Apply(opts ...RelatedPersonOption) *RelatedPerson {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithRelatedPersonId sets the Id field.
 func WithRelatedPersonId(v string) RelatedPersonOption {
 	return func(r *RelatedPerson) {
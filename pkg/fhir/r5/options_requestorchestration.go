@@ -20,6 +20,14 @@ func NewRequestOrchestration(opts ...RequestOrchestrationOption) *RequestOrchest
 	return r
 }
 
+// Apply applies the given options to an existing RequestOrchestration, such as a decoded one, and returns it.
+func (r *RequestOrchestration) Apply(opts ...RequestOrchestrationOption) *RequestOrchestration {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithRequestOrchestrationId sets the Id field.
 func WithRequestOrchestrationId(v string) RequestOrchestrationOption {
 	return func(r *RequestOrchestration) {
@@ -20,6 +20,14 @@ func NewRequirements(opts ...RequirementsOption) *Requirements {
 	return r
 }
 
+// Apply applies the given options to an existing Requirements, such as a decoded one, and returns it.
+func (r *Requirements) Apply(opts ...RequirementsOption) *Requirements {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithRequirementsId sets the Id field.
 func WithRequirementsId(v string) RequirementsOption {
 	return func(r *Requirements) {
@@ -20,6 +20,14 @@ func NewSearchParameter(opts ...SearchParameterOption) *SearchParameter {
 	return r
 }
 
+// Apply applies the given options to an existing SearchParameter, such as a decoded one, and returns it.
+func (r *SearchParameter) Apply(opts ...SearchParameterOption) *SearchParameter {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithSearchParameterId sets the Id field.
 func WithSearchParameterId(v string) SearchParameterOption {
 	return func(r *SearchParameter) {
@@ -20,6 +20,14 @@ func NewSlot(opts ...SlotOption) *Slot {
 	return r
 }
 
+// Apply applies the given options to an existing Slot, such as a decoded one, and returns it.
+func (r *Slot) Apply(opts ...SlotOption) *Slot {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithSlotId sets the Id field.
 func WithSlotId(v string) SlotOption {
 	return func(r *Slot) {
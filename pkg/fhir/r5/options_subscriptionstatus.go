@@ -20,6 +20,14 @@ func NewSubscriptionStatus(opts ...SubscriptionStatusOption) *SubscriptionStatus
 	return r
 }
 
+// Apply applies the given options to an existing SubscriptionStatus, such as a decoded one, and returns it.
+func (r *SubscriptionStatus) Apply(opts ...SubscriptionStatusOption) *SubscriptionStatus {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithSubscriptionStatusId sets the Id field.
 func WithSubscriptionStatusId(v string) SubscriptionStatusOption {
 	return func(r *SubscriptionStatus) {
@@ -20,6 +20,14 @@ func NewSubstanceDefinition(opts ...SubstanceDefinitionOption) *SubstanceDefinit
 	return r
 }
 
+// Apply applies the given options to an existing SubstanceDefinition, such as a decoded one, and returns it.
+func (r *SubstanceDefinition) Apply(opts ...SubstanceDefinitionOption) *SubstanceDefinition {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithSubstanceDefinitionId sets the Id field.
 func WithSubstanceDefinitionId(v string) SubstanceDefinitionOption {
 	return func(r *SubstanceDefinition) {
@@ -20,6 +20,14 @@ func NewSubstancePolymer(opts ...SubstancePolymerOption) *SubstancePolymer {
 	return r
 }
 
+// Apply applies the given options to an existing SubstancePolymer, such as a decoded one, and returns it.
+func (r *SubstancePolymer) Apply(opts ...SubstancePolymerOption) *SubstancePolymer {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithSubstancePolymerId sets the Id field.
 func WithSubstancePolymerId(v string) SubstancePolymerOption {
 	return func(r *SubstancePolymer) {
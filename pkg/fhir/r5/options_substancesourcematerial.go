@@ -20,6 +20,14 @@ func NewSubstanceSourceMaterial(opts ...SubstanceSourceMaterialOption) *Substanc
 	return r
 }
 
+// Apply applies the given options to an existing SubstanceSourceMaterial, such as a decoded one, and returns it.
+func (r *SubstanceSourceMaterial) Apply(opts ...SubstanceSourceMaterialOption) *SubstanceSourceMaterial {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithSubstanceSourceMaterialId sets the Id field.
 func WithSubstanceSourceMaterialId(v string) SubstanceSourceMaterialOption {
 	return func(r *SubstanceSourceMaterial) {
@@ -20,6 +20,14 @@ func NewSupplyRequest(opts ...SupplyRequestOption) *SupplyRequest {
 	return r
 }
 
+// Apply applies the given options to an existing SupplyRequest, such as a decoded one, and returns it.
+func (r *SupplyRequest) Apply(opts ...SupplyRequestOption) *SupplyRequest {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithSupplyRequestId sets the Id field.
 func WithSupplyRequestId(v string) SupplyRequestOption {
 	return func(r *SupplyRequest) {
@@ -20,6 +20,14 @@ func NewTestPlan(opts ...TestPlanOption) *TestPlan {
 	return r
 }
 
+// Apply applies the given options to an existing TestPlan, such as a decoded one, and returns it.
+func (r *TestPlan) Apply(opts ...TestPlanOption) *TestPlan {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithTestPlanId sets the Id field.
 func WithTestPlanId(v string) TestPlanOption {
 	return func(r *TestPlan) {
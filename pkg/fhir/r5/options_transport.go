@@ -20,6 +20,14 @@ func NewTransport(opts ...TransportOption) *Transport {
 	return r
 }
 
+// Apply applies the given options to an existing Transport, such as a decoded one, and returns it.
+func (r *Transport) Apply(opts ...TransportOption) *Transport {
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
 // WithTransportId sets the Id field.
 func WithTransportId(v string) TransportOption {
 	return func(r *Transport) {
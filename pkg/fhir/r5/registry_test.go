@@ -2,6 +2,7 @@ package r5_test
 
 import (
 	"encoding/json"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -170,6 +171,28 @@ func TestUnmarshalResourceRoundTrip(t *testing.T) {
 	assert.Equal(t, []string{"John"}, patient.Name[0].Given)
 }
 
+func TestUnmarshalResourcePreservesUnknownFields(t *testing.T) {
+	data := []byte(`{"resourceType":"Patient","id":"p1","vendorScore":{"value":42},"active":true}`)
+
+	resource, err := r5.UnmarshalResourceWithOptions(data, r5.UnmarshalOptions{PreserveUnknownFields: true})
+	require.NoError(t, err)
+
+	patient, ok := resource.(*r5.Patient)
+	require.True(t, ok, "expected *r5.Patient, got %T", resource)
+	assert.Equal(t, "p1", *patient.Id)
+	require.Len(t, patient.Extra, 1)
+	assert.JSONEq(t, `{"value":42}`, string(patient.Extra["vendorScore"]))
+
+	out, err := json.Marshal(patient)
+	require.NoError(t, err)
+	assert.JSONEq(t, string(data), string(out))
+
+	// Without the option unknown fields are dropped
+	resource, err = r5.UnmarshalResourceWithOptions(data, r5.UnmarshalOptions{})
+	require.NoError(t, err)
+	assert.Empty(t, resource.(*r5.Patient).Extra)
+}
+
 func TestGetResourceType(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -259,6 +282,22 @@ func TestAllResourceTypes(t *testing.T) {
 	assert.True(t, typeSet["Medication"], "should include Medication")
 }
 
+func TestResourceTypeConstants(t *testing.T) {
+	// One constant per generated resource file
+	files, err := filepath.Glob("resource_*.go")
+	require.NoError(t, err)
+	assert.Len(t, r5.ResourceTypes, len(files))
+	assert.Len(t, r5.AllResourceTypes(), len(r5.ResourceTypes))
+
+	assert.Equal(t, r5.ResourceType("Patient"), r5.ResourceTypePatient)
+
+	for _, rt := range r5.ResourceTypes {
+		resource, err := r5.NewResource(string(rt))
+		require.NoError(t, err)
+		assert.Equal(t, string(rt), resource.GetResourceType())
+	}
+}
+
 // Helper functions
 func ptrString(s string) *string {
 	return &s
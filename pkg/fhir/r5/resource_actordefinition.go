@@ -115,11 +115,13 @@ type ActorDefinition struct {
 	DerivedFrom []string `json:"derivedFrom,omitempty"`
 	// Extension for DerivedFrom
 	DerivedFromExt []Element `json:"_derivedFrom,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *ActorDefinition) GetResourceType() string {
-	return "ActorDefinition"
+	return string(ResourceTypeActorDefinition)
 }
 
 // GetId returns the resource's logical ID.
@@ -167,11 +169,31 @@ func (r *ActorDefinition) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// GetUrl returns the resource's canonical URL.
+func (r *ActorDefinition) GetUrl() *string {
+	return r.Url
+}
+
+// GetVersion returns the resource's business version.
+func (r *ActorDefinition) GetVersion() *string {
+	return r.Version
+}
+
+// GetStatus returns the resource's publication status.
+func (r *ActorDefinition) GetStatus() *PublicationStatus {
+	return r.Status
+}
+
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r ActorDefinition) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "ActorDefinition"
+	r.ResourceType = string(ResourceTypeActorDefinition)
 	type Alias ActorDefinition
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
@@ -97,11 +97,13 @@ type AdverseEvent struct {
 	SupportingInfo []AdverseEventSupportingInfo `json:"supportingInfo,omitempty"`
 	// Comment on adverse event
 	Note []Annotation `json:"note,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *AdverseEvent) GetResourceType() string {
-	return "AdverseEvent"
+	return string(ResourceTypeAdverseEvent)
 }
 
 // GetId returns the resource's logical ID.
@@ -149,11 +151,16 @@ func (r *AdverseEvent) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r AdverseEvent) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "AdverseEvent"
+	r.ResourceType = string(ResourceTypeAdverseEvent)
 	type Alias AdverseEvent
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
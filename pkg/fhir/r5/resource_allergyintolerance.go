@@ -83,11 +83,13 @@ type AllergyIntolerance struct {
 	Note []Annotation `json:"note,omitempty"`
 	// Adverse Reaction Events linked to exposure to substance
 	Reaction []AllergyIntoleranceReaction `json:"reaction,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *AllergyIntolerance) GetResourceType() string {
-	return "AllergyIntolerance"
+	return string(ResourceTypeAllergyIntolerance)
 }
 
 // GetId returns the resource's logical ID.
@@ -135,11 +137,16 @@ func (r *AllergyIntolerance) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r AllergyIntolerance) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "AllergyIntolerance"
+	r.ResourceType = string(ResourceTypeAllergyIntolerance)
 	type Alias AllergyIntolerance
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
@@ -115,11 +115,13 @@ type Appointment struct {
 	OccurrenceChangedExt *Element `json:"_occurrenceChanged,omitempty"`
 	// Details of the recurrence pattern/template used to generate occurrences
 	RecurrenceTemplate []AppointmentRecurrenceTemplate `json:"recurrenceTemplate,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *Appointment) GetResourceType() string {
-	return "Appointment"
+	return string(ResourceTypeAppointment)
 }
 
 // GetId returns the resource's logical ID.
@@ -167,11 +169,16 @@ func (r *Appointment) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r Appointment) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "Appointment"
+	r.ResourceType = string(ResourceTypeAppointment)
 	type Alias Appointment
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
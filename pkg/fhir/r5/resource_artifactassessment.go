@@ -81,11 +81,13 @@ type ArtifactAssessment struct {
 	Disposition *ArtifactAssessmentDisposition `json:"disposition,omitempty"`
 	// Extension for Disposition
 	DispositionExt *Element `json:"_disposition,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *ArtifactAssessment) GetResourceType() string {
-	return "ArtifactAssessment"
+	return string(ResourceTypeArtifactAssessment)
 }
 
 // GetId returns the resource's logical ID.
@@ -133,11 +135,16 @@ func (r *ArtifactAssessment) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r ArtifactAssessment) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "ArtifactAssessment"
+	r.ResourceType = string(ResourceTypeArtifactAssessment)
 	type Alias ArtifactAssessment
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
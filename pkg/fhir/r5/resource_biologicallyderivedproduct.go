@@ -63,11 +63,13 @@ type BiologicallyDerivedProduct struct {
 	StorageTempRequirements *Range `json:"storageTempRequirements,omitempty"`
 	// A property that is specific to this BiologicallyDerviedProduct instance
 	Property []BiologicallyDerivedProductProperty `json:"property,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *BiologicallyDerivedProduct) GetResourceType() string {
-	return "BiologicallyDerivedProduct"
+	return string(ResourceTypeBiologicallyDerivedProduct)
 }
 
 // GetId returns the resource's logical ID.
@@ -115,11 +117,16 @@ func (r *BiologicallyDerivedProduct) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r BiologicallyDerivedProduct) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "BiologicallyDerivedProduct"
+	r.ResourceType = string(ResourceTypeBiologicallyDerivedProduct)
 	type Alias BiologicallyDerivedProduct
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
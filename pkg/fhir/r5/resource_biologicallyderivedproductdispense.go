@@ -73,11 +73,13 @@ type BiologicallyDerivedProductDispense struct {
 	UsageInstruction *string `json:"usageInstruction,omitempty"`
 	// Extension for UsageInstruction
 	UsageInstructionExt *Element `json:"_usageInstruction,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *BiologicallyDerivedProductDispense) GetResourceType() string {
-	return "BiologicallyDerivedProductDispense"
+	return string(ResourceTypeBiologicallyDerivedProductDispense)
 }
 
 // GetId returns the resource's logical ID.
@@ -125,11 +127,16 @@ func (r *BiologicallyDerivedProductDispense) GetModifierExtension() []Extension
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r BiologicallyDerivedProductDispense) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "BiologicallyDerivedProductDispense"
+	r.ResourceType = string(ResourceTypeBiologicallyDerivedProductDispense)
 	type Alias BiologicallyDerivedProductDispense
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
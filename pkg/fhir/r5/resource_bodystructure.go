@@ -53,11 +53,13 @@ type BodyStructure struct {
 	Image []Attachment `json:"image,omitempty"`
 	// Who this is about
 	Patient Reference `json:"patient"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *BodyStructure) GetResourceType() string {
-	return "BodyStructure"
+	return string(ResourceTypeBodyStructure)
 }
 
 // GetId returns the resource's logical ID.
@@ -105,11 +107,16 @@ func (r *BodyStructure) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r BodyStructure) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "BodyStructure"
+	r.ResourceType = string(ResourceTypeBodyStructure)
 	type Alias BodyStructure
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
@@ -45,12 +45,14 @@ type Bundle struct {
 	// Digital Signature
 	Signature *Signature `json:"signature,omitempty"`
 	// Issues with the Bundle
-	Issues Resource `json:"issues"`
+	Issues Resource `json:"issues,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *Bundle) GetResourceType() string {
-	return "Bundle"
+	return string(ResourceTypeBundle)
 }
 
 // GetId returns the resource's logical ID.
@@ -73,9 +75,14 @@ func (r *Bundle) SetMeta(m *Meta) {
 	r.Meta = m
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r Bundle) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "Bundle"
+	r.ResourceType = string(ResourceTypeBundle)
 	type Alias Bundle
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
@@ -137,11 +137,13 @@ type CapabilityStatement struct {
 	Messaging []CapabilityStatementMessaging `json:"messaging,omitempty"`
 	// Document definition
 	Document []CapabilityStatementDocument `json:"document,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *CapabilityStatement) GetResourceType() string {
-	return "CapabilityStatement"
+	return string(ResourceTypeCapabilityStatement)
 }
 
 // GetId returns the resource's logical ID.
@@ -189,11 +191,31 @@ func (r *CapabilityStatement) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// GetUrl returns the resource's canonical URL.
+func (r *CapabilityStatement) GetUrl() *string {
+	return r.Url
+}
+
+// GetVersion returns the resource's business version.
+func (r *CapabilityStatement) GetVersion() *string {
+	return r.Version
+}
+
+// GetStatus returns the resource's publication status.
+func (r *CapabilityStatement) GetStatus() *PublicationStatus {
+	return r.Status
+}
+
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r CapabilityStatement) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "CapabilityStatement"
+	r.ResourceType = string(ResourceTypeCapabilityStatement)
 	type Alias CapabilityStatement
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
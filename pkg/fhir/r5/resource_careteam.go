@@ -59,11 +59,13 @@ type CareTeam struct {
 	Telecom []ContactPoint `json:"telecom,omitempty"`
 	// Comments made about the CareTeam
 	Note []Annotation `json:"note,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *CareTeam) GetResourceType() string {
-	return "CareTeam"
+	return string(ResourceTypeCareTeam)
 }
 
 // GetId returns the resource's logical ID.
@@ -111,11 +113,16 @@ func (r *CareTeam) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r CareTeam) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "CareTeam"
+	r.ResourceType = string(ResourceTypeCareTeam)
 	type Alias CareTeam
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
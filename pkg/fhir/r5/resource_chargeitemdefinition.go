@@ -123,11 +123,13 @@ type ChargeItemDefinition struct {
 	Applicability []ChargeItemDefinitionApplicability `json:"applicability,omitempty"`
 	// Group of properties which are applicable under the same conditions
 	PropertyGroup []ChargeItemDefinitionPropertyGroup `json:"propertyGroup,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *ChargeItemDefinition) GetResourceType() string {
-	return "ChargeItemDefinition"
+	return string(ResourceTypeChargeItemDefinition)
 }
 
 // GetId returns the resource's logical ID.
@@ -175,11 +177,31 @@ func (r *ChargeItemDefinition) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// GetUrl returns the resource's canonical URL.
+func (r *ChargeItemDefinition) GetUrl() *string {
+	return r.Url
+}
+
+// GetVersion returns the resource's business version.
+func (r *ChargeItemDefinition) GetVersion() *string {
+	return r.Version
+}
+
+// GetStatus returns the resource's publication status.
+func (r *ChargeItemDefinition) GetStatus() *PublicationStatus {
+	return r.Status
+}
+
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r ChargeItemDefinition) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "ChargeItemDefinition"
+	r.ResourceType = string(ResourceTypeChargeItemDefinition)
 	type Alias ChargeItemDefinition
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
@@ -103,11 +103,13 @@ type Claim struct {
 	Item []ClaimItem `json:"item,omitempty"`
 	// Total claim cost
 	Total *Money `json:"total,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *Claim) GetResourceType() string {
-	return "Claim"
+	return string(ResourceTypeClaim)
 }
 
 // GetId returns the resource's logical ID.
@@ -155,11 +157,16 @@ func (r *Claim) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r Claim) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "Claim"
+	r.ResourceType = string(ResourceTypeClaim)
 	type Alias Claim
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
@@ -61,11 +61,13 @@ type ClinicalUseDefinition struct {
 	UndesirableEffect *ClinicalUseDefinitionUndesirableEffect `json:"undesirableEffect,omitempty"`
 	// Critical environmental, health or physical risks or hazards. For example 'Do not operate heavy machinery', 'May cause drowsiness'
 	Warning *ClinicalUseDefinitionWarning `json:"warning,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *ClinicalUseDefinition) GetResourceType() string {
-	return "ClinicalUseDefinition"
+	return string(ResourceTypeClinicalUseDefinition)
 }
 
 // GetId returns the resource's logical ID.
@@ -113,11 +115,16 @@ func (r *ClinicalUseDefinition) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r ClinicalUseDefinition) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "ClinicalUseDefinition"
+	r.ResourceType = string(ResourceTypeClinicalUseDefinition)
 	type Alias ClinicalUseDefinition
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
@@ -155,11 +155,13 @@ type CodeSystem struct {
 	Property []CodeSystemProperty `json:"property,omitempty"`
 	// Concepts in the code system
 	Concept []CodeSystemConcept `json:"concept,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *CodeSystem) GetResourceType() string {
-	return "CodeSystem"
+	return string(ResourceTypeCodeSystem)
 }
 
 // GetId returns the resource's logical ID.
@@ -207,11 +209,31 @@ func (r *CodeSystem) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// GetUrl returns the resource's canonical URL.
+func (r *CodeSystem) GetUrl() *string {
+	return r.Url
+}
+
+// GetVersion returns the resource's business version.
+func (r *CodeSystem) GetVersion() *string {
+	return r.Version
+}
+
+// GetStatus returns the resource's publication status.
+func (r *CodeSystem) GetStatus() *PublicationStatus {
+	return r.Status
+}
+
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r CodeSystem) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "CodeSystem"
+	r.ResourceType = string(ResourceTypeCodeSystem)
 	type Alias CodeSystem
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
@@ -91,11 +91,13 @@ type CommunicationRequest struct {
 	Reason []CodeableReference `json:"reason,omitempty"`
 	// Comments made about communication request
 	Note []Annotation `json:"note,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *CommunicationRequest) GetResourceType() string {
-	return "CommunicationRequest"
+	return string(ResourceTypeCommunicationRequest)
 }
 
 // GetId returns the resource's logical ID.
@@ -143,11 +145,16 @@ func (r *CommunicationRequest) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r CommunicationRequest) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "CommunicationRequest"
+	r.ResourceType = string(ResourceTypeCommunicationRequest)
 	type Alias CommunicationRequest
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
@@ -93,11 +93,13 @@ type CompartmentDefinition struct {
 	SearchExt *Element `json:"_search,omitempty"`
 	// How a resource is related to the compartment
 	Resource []CompartmentDefinitionResource `json:"resource,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *CompartmentDefinition) GetResourceType() string {
-	return "CompartmentDefinition"
+	return string(ResourceTypeCompartmentDefinition)
 }
 
 // GetId returns the resource's logical ID.
@@ -145,11 +147,31 @@ func (r *CompartmentDefinition) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// GetUrl returns the resource's canonical URL.
+func (r *CompartmentDefinition) GetUrl() *string {
+	return r.Url
+}
+
+// GetVersion returns the resource's business version.
+func (r *CompartmentDefinition) GetVersion() *string {
+	return r.Version
+}
+
+// GetStatus returns the resource's publication status.
+func (r *CompartmentDefinition) GetStatus() *PublicationStatus {
+	return r.Status
+}
+
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r CompartmentDefinition) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "CompartmentDefinition"
+	r.ResourceType = string(ResourceTypeCompartmentDefinition)
 	type Alias CompartmentDefinition
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
@@ -83,11 +83,13 @@ type Composition struct {
 	Event []CompositionEvent `json:"event,omitempty"`
 	// Composition is broken into sections
 	Section []CompositionSection `json:"section,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *Composition) GetResourceType() string {
-	return "Composition"
+	return string(ResourceTypeComposition)
 }
 
 // GetId returns the resource's logical ID.
@@ -135,11 +137,16 @@ func (r *Composition) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r Composition) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "Composition"
+	r.ResourceType = string(ResourceTypeComposition)
 	type Alias Composition
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
@@ -139,11 +139,13 @@ type ConceptMap struct {
 	TargetScopeCanonicalExt *Element `json:"_targetScopeCanonical,omitempty"`
 	// Same source and target systems
 	Group []ConceptMapGroup `json:"group,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *ConceptMap) GetResourceType() string {
-	return "ConceptMap"
+	return string(ResourceTypeConceptMap)
 }
 
 // GetId returns the resource's logical ID.
@@ -191,11 +193,31 @@ func (r *ConceptMap) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// GetUrl returns the resource's canonical URL.
+func (r *ConceptMap) GetUrl() *string {
+	return r.Url
+}
+
+// GetVersion returns the resource's business version.
+func (r *ConceptMap) GetVersion() *string {
+	return r.Version
+}
+
+// GetStatus returns the resource's publication status.
+func (r *ConceptMap) GetStatus() *PublicationStatus {
+	return r.Status
+}
+
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r ConceptMap) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "ConceptMap"
+	r.ResourceType = string(ResourceTypeConceptMap)
 	type Alias ConceptMap
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
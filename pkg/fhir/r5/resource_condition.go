@@ -91,11 +91,13 @@ type Condition struct {
 	Evidence []CodeableReference `json:"evidence,omitempty"`
 	// Additional information about the Condition
 	Note []Annotation `json:"note,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *Condition) GetResourceType() string {
-	return "Condition"
+	return string(ResourceTypeCondition)
 }
 
 // GetId returns the resource's logical ID.
@@ -143,11 +145,16 @@ func (r *Condition) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r Condition) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "Condition"
+	r.ResourceType = string(ResourceTypeCondition)
 	type Alias Condition
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
@@ -123,11 +123,13 @@ type ConditionDefinition struct {
 	Questionnaire []ConditionDefinitionQuestionnaire `json:"questionnaire,omitempty"`
 	// Plan that is appropriate
 	Plan []ConditionDefinitionPlan `json:"plan,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *ConditionDefinition) GetResourceType() string {
-	return "ConditionDefinition"
+	return string(ResourceTypeConditionDefinition)
 }
 
 // GetId returns the resource's logical ID.
@@ -175,11 +177,31 @@ func (r *ConditionDefinition) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// GetUrl returns the resource's canonical URL.
+func (r *ConditionDefinition) GetUrl() *string {
+	return r.Url
+}
+
+// GetVersion returns the resource's business version.
+func (r *ConditionDefinition) GetVersion() *string {
+	return r.Version
+}
+
+// GetStatus returns the resource's publication status.
+func (r *ConditionDefinition) GetStatus() *PublicationStatus {
+	return r.Status
+}
+
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r ConditionDefinition) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "ConditionDefinition"
+	r.ResourceType = string(ResourceTypeConditionDefinition)
 	type Alias ConditionDefinition
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
@@ -75,11 +75,13 @@ type Consent struct {
 	DecisionExt *Element `json:"_decision,omitempty"`
 	// Constraints to the base Consent.policyRule/Consent.policy
 	Provision []ConsentProvision `json:"provision,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *Consent) GetResourceType() string {
-	return "Consent"
+	return string(ResourceTypeConsent)
 }
 
 // GetId returns the resource's logical ID.
@@ -127,11 +129,16 @@ func (r *Consent) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r Consent) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "Consent"
+	r.ResourceType = string(ResourceTypeConsent)
 	type Alias Consent
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
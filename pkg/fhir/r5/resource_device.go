@@ -117,11 +117,13 @@ type Device struct {
 	Safety []CodeableConcept `json:"safety,omitempty"`
 	// The higher level or encompassing device that this device is a logical part of
 	Parent *Reference `json:"parent,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *Device) GetResourceType() string {
-	return "Device"
+	return string(ResourceTypeDevice)
 }
 
 // GetId returns the resource's logical ID.
@@ -169,11 +171,16 @@ func (r *Device) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r Device) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "Device"
+	r.ResourceType = string(ResourceTypeDevice)
 	type Alias Device
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
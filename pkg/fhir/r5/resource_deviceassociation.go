@@ -51,11 +51,13 @@ type DeviceAssociation struct {
 	Period *Period `json:"period,omitempty"`
 	// The details about the device when it is in use to describe its operation
 	Operation []DeviceAssociationOperation `json:"operation,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *DeviceAssociation) GetResourceType() string {
-	return "DeviceAssociation"
+	return string(ResourceTypeDeviceAssociation)
 }
 
 // GetId returns the resource's logical ID.
@@ -103,11 +105,16 @@ func (r *DeviceAssociation) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r DeviceAssociation) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "DeviceAssociation"
+	r.ResourceType = string(ResourceTypeDeviceAssociation)
 	type Alias DeviceAssociation
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
@@ -93,11 +93,13 @@ type DeviceDefinition struct {
 	CorrectiveAction *DeviceDefinitionCorrectiveAction `json:"correctiveAction,omitempty"`
 	// Billing code or reference associated with the device
 	ChargeItem []DeviceDefinitionChargeItem `json:"chargeItem,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *DeviceDefinition) GetResourceType() string {
-	return "DeviceDefinition"
+	return string(ResourceTypeDeviceDefinition)
 }
 
 // GetId returns the resource's logical ID.
@@ -145,11 +147,16 @@ func (r *DeviceDefinition) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r DeviceDefinition) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "DeviceDefinition"
+	r.ResourceType = string(ResourceTypeDeviceDefinition)
 	type Alias DeviceDefinition
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
@@ -83,11 +83,13 @@ type DeviceDispense struct {
 	UsageInstructionExt *Element `json:"_usageInstruction,omitempty"`
 	// A list of relevant lifecycle events
 	EventHistory []Reference `json:"eventHistory,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *DeviceDispense) GetResourceType() string {
-	return "DeviceDispense"
+	return string(ResourceTypeDeviceDispense)
 }
 
 // GetId returns the resource's logical ID.
@@ -135,11 +137,16 @@ func (r *DeviceDispense) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r DeviceDispense) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "DeviceDispense"
+	r.ResourceType = string(ResourceTypeDeviceDispense)
 	type Alias DeviceDispense
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
@@ -109,11 +109,13 @@ type DeviceRequest struct {
 	Note []Annotation `json:"note,omitempty"`
 	// Request provenance
 	RelevantHistory []Reference `json:"relevantHistory,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *DeviceRequest) GetResourceType() string {
-	return "DeviceRequest"
+	return string(ResourceTypeDeviceRequest)
 }
 
 // GetId returns the resource's logical ID.
@@ -161,11 +163,16 @@ func (r *DeviceRequest) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r DeviceRequest) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "DeviceRequest"
+	r.ResourceType = string(ResourceTypeDeviceRequest)
 	type Alias DeviceRequest
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
@@ -85,11 +85,13 @@ type DiagnosticReport struct {
 	ConclusionCode []CodeableConcept `json:"conclusionCode,omitempty"`
 	// Entire report as issued
 	PresentedForm []Attachment `json:"presentedForm,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *DiagnosticReport) GetResourceType() string {
-	return "DiagnosticReport"
+	return string(ResourceTypeDiagnosticReport)
 }
 
 // GetId returns the resource's logical ID.
@@ -137,11 +139,16 @@ func (r *DiagnosticReport) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r DiagnosticReport) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "DiagnosticReport"
+	r.ResourceType = string(ResourceTypeDiagnosticReport)
 	type Alias DiagnosticReport
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
@@ -65,11 +65,13 @@ type EncounterHistory struct {
 	Length *Duration `json:"length,omitempty"`
 	// Location of the patient at this point in the encounter
 	Location []EncounterHistoryLocation `json:"location,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *EncounterHistory) GetResourceType() string {
-	return "EncounterHistory"
+	return string(ResourceTypeEncounterHistory)
 }
 
 // GetId returns the resource's logical ID.
@@ -117,11 +119,16 @@ func (r *EncounterHistory) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r EncounterHistory) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "EncounterHistory"
+	r.ResourceType = string(ResourceTypeEncounterHistory)
 	type Alias EncounterHistory
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
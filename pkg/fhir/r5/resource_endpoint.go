@@ -67,11 +67,13 @@ type Endpoint struct {
 	Header []string `json:"header,omitempty"`
 	// Extension for Header
 	HeaderExt []Element `json:"_header,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *Endpoint) GetResourceType() string {
-	return "Endpoint"
+	return string(ResourceTypeEndpoint)
 }
 
 // GetId returns the resource's logical ID.
@@ -119,11 +121,16 @@ func (r *Endpoint) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r Endpoint) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "Endpoint"
+	r.ResourceType = string(ResourceTypeEndpoint)
 	type Alias Endpoint
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
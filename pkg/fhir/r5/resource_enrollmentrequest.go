@@ -51,11 +51,13 @@ type EnrollmentRequest struct {
 	Candidate *Reference `json:"candidate,omitempty"`
 	// Insurance information
 	Coverage *Reference `json:"coverage,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *EnrollmentRequest) GetResourceType() string {
-	return "EnrollmentRequest"
+	return string(ResourceTypeEnrollmentRequest)
 }
 
 // GetId returns the resource's logical ID.
@@ -103,11 +105,16 @@ func (r *EnrollmentRequest) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r EnrollmentRequest) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "EnrollmentRequest"
+	r.ResourceType = string(ResourceTypeEnrollmentRequest)
 	type Alias EnrollmentRequest
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
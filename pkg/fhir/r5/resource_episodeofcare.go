@@ -61,11 +61,13 @@ type EpisodeOfCare struct {
 	CareTeam []Reference `json:"careTeam,omitempty"`
 	// The set of accounts that may be used for billing for this EpisodeOfCare
 	Account []Reference `json:"account,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *EpisodeOfCare) GetResourceType() string {
-	return "EpisodeOfCare"
+	return string(ResourceTypeEpisodeOfCare)
 }
 
 // GetId returns the resource's logical ID.
@@ -113,11 +115,16 @@ func (r *EpisodeOfCare) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r EpisodeOfCare) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "EpisodeOfCare"
+	r.ResourceType = string(ResourceTypeEpisodeOfCare)
 	type Alias EpisodeOfCare
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
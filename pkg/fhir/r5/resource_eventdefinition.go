@@ -131,11 +131,13 @@ type EventDefinition struct {
 	RelatedArtifact []RelatedArtifact `json:"relatedArtifact,omitempty"`
 	// "when" the event occurs (multiple = 'or')
 	Trigger []TriggerDefinition `json:"trigger,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *EventDefinition) GetResourceType() string {
-	return "EventDefinition"
+	return string(ResourceTypeEventDefinition)
 }
 
 // GetId returns the resource's logical ID.
@@ -183,11 +185,31 @@ func (r *EventDefinition) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// GetUrl returns the resource's canonical URL.
+func (r *EventDefinition) GetUrl() *string {
+	return r.Url
+}
+
+// GetVersion returns the resource's business version.
+func (r *EventDefinition) GetVersion() *string {
+	return r.Version
+}
+
+// GetStatus returns the resource's publication status.
+func (r *EventDefinition) GetStatus() *PublicationStatus {
+	return r.Status
+}
+
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r EventDefinition) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "EventDefinition"
+	r.ResourceType = string(ResourceTypeEventDefinition)
 	type Alias EventDefinition
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
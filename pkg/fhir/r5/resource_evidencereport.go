@@ -79,11 +79,13 @@ type EvidenceReport struct {
 	RelatesTo []EvidenceReportRelatesTo `json:"relatesTo,omitempty"`
 	// Composition is broken into sections
 	Section []EvidenceReportSection `json:"section,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *EvidenceReport) GetResourceType() string {
-	return "EvidenceReport"
+	return string(ResourceTypeEvidenceReport)
 }
 
 // GetId returns the resource's logical ID.
@@ -131,11 +133,16 @@ func (r *EvidenceReport) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r EvidenceReport) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "EvidenceReport"
+	r.ResourceType = string(ResourceTypeEvidenceReport)
 	type Alias EvidenceReport
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
@@ -131,11 +131,13 @@ type EvidenceVariable struct {
 	HandlingExt *Element `json:"_handling,omitempty"`
 	// A grouping for ordinal or polychotomous variables
 	Category []EvidenceVariableCategory `json:"category,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *EvidenceVariable) GetResourceType() string {
-	return "EvidenceVariable"
+	return string(ResourceTypeEvidenceVariable)
 }
 
 // GetId returns the resource's logical ID.
@@ -183,11 +185,31 @@ func (r *EvidenceVariable) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// GetUrl returns the resource's canonical URL.
+func (r *EvidenceVariable) GetUrl() *string {
+	return r.Url
+}
+
+// GetVersion returns the resource's business version.
+func (r *EvidenceVariable) GetVersion() *string {
+	return r.Version
+}
+
+// GetStatus returns the resource's publication status.
+func (r *EvidenceVariable) GetStatus() *PublicationStatus {
+	return r.Status
+}
+
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r EvidenceVariable) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "EvidenceVariable"
+	r.ResourceType = string(ResourceTypeEvidenceVariable)
 	type Alias EvidenceVariable
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
@@ -101,11 +101,13 @@ type ExampleScenario struct {
 	Instance []ExampleScenarioInstance `json:"instance,omitempty"`
 	// Major process within scenario
 	Process []ExampleScenarioProcess `json:"process,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *ExampleScenario) GetResourceType() string {
-	return "ExampleScenario"
+	return string(ResourceTypeExampleScenario)
 }
 
 // GetId returns the resource's logical ID.
@@ -153,11 +155,31 @@ func (r *ExampleScenario) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// GetUrl returns the resource's canonical URL.
+func (r *ExampleScenario) GetUrl() *string {
+	return r.Url
+}
+
+// GetVersion returns the resource's business version.
+func (r *ExampleScenario) GetVersion() *string {
+	return r.Version
+}
+
+// GetStatus returns the resource's publication status.
+func (r *ExampleScenario) GetStatus() *PublicationStatus {
+	return r.Status
+}
+
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r ExampleScenario) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "ExampleScenario"
+	r.ResourceType = string(ResourceTypeExampleScenario)
 	type Alias ExampleScenario
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
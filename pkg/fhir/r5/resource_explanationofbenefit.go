@@ -145,11 +145,13 @@ type ExplanationOfBenefit struct {
 	BenefitPeriod *Period `json:"benefitPeriod,omitempty"`
 	// Balance by Benefit Category
 	BenefitBalance []ExplanationOfBenefitBenefitBalance `json:"benefitBalance,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *ExplanationOfBenefit) GetResourceType() string {
-	return "ExplanationOfBenefit"
+	return string(ResourceTypeExplanationOfBenefit)
 }
 
 // GetId returns the resource's logical ID.
@@ -197,11 +199,16 @@ func (r *ExplanationOfBenefit) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r ExplanationOfBenefit) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "ExplanationOfBenefit"
+	r.ResourceType = string(ResourceTypeExplanationOfBenefit)
 	type Alias ExplanationOfBenefit
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
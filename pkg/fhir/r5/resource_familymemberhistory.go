@@ -111,11 +111,13 @@ type FamilyMemberHistory struct {
 	Condition []FamilyMemberHistoryCondition `json:"condition,omitempty"`
 	// Procedures that the related person had
 	Procedure []FamilyMemberHistoryProcedure `json:"procedure,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *FamilyMemberHistory) GetResourceType() string {
-	return "FamilyMemberHistory"
+	return string(ResourceTypeFamilyMemberHistory)
 }
 
 // GetId returns the resource's logical ID.
@@ -163,11 +165,16 @@ func (r *FamilyMemberHistory) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r FamilyMemberHistory) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "FamilyMemberHistory"
+	r.ResourceType = string(ResourceTypeFamilyMemberHistory)
 	type Alias FamilyMemberHistory
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
@@ -41,11 +41,13 @@ type FormularyItem struct {
 	Status *FormularyItemStatusCodes `json:"status,omitempty"`
 	// Extension for Status
 	StatusExt *Element `json:"_status,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *FormularyItem) GetResourceType() string {
-	return "FormularyItem"
+	return string(ResourceTypeFormularyItem)
 }
 
 // GetId returns the resource's logical ID.
@@ -93,11 +95,16 @@ func (r *FormularyItem) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r FormularyItem) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "FormularyItem"
+	r.ResourceType = string(ResourceTypeFormularyItem)
 	type Alias FormularyItem
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
@@ -73,11 +73,13 @@ type GenomicStudy struct {
 	DescriptionExt *Element `json:"_description,omitempty"`
 	// Genomic Analysis Event
 	Analysis []GenomicStudyAnalysis `json:"analysis,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *GenomicStudy) GetResourceType() string {
-	return "GenomicStudy"
+	return string(ResourceTypeGenomicStudy)
 }
 
 // GetId returns the resource's logical ID.
@@ -125,11 +127,16 @@ func (r *GenomicStudy) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r GenomicStudy) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "GenomicStudy"
+	r.ResourceType = string(ResourceTypeGenomicStudy)
 	type Alias GenomicStudy
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
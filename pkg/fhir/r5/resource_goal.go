@@ -77,11 +77,13 @@ type Goal struct {
 	Note []Annotation `json:"note,omitempty"`
 	// What result was achieved regarding the goal?
 	Outcome []CodeableReference `json:"outcome,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *Goal) GetResourceType() string {
-	return "Goal"
+	return string(ResourceTypeGoal)
 }
 
 // GetId returns the resource's logical ID.
@@ -129,11 +131,16 @@ func (r *Goal) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r Goal) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "Goal"
+	r.ResourceType = string(ResourceTypeGoal)
 	type Alias Goal
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
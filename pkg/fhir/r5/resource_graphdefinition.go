@@ -103,11 +103,13 @@ type GraphDefinition struct {
 	Node []GraphDefinitionNode `json:"node,omitempty"`
 	// Links this graph makes rules about
 	Link []GraphDefinitionLink `json:"link,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *GraphDefinition) GetResourceType() string {
-	return "GraphDefinition"
+	return string(ResourceTypeGraphDefinition)
 }
 
 // GetId returns the resource's logical ID.
@@ -155,11 +157,31 @@ func (r *GraphDefinition) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// GetUrl returns the resource's canonical URL.
+func (r *GraphDefinition) GetUrl() *string {
+	return r.Url
+}
+
+// GetVersion returns the resource's business version.
+func (r *GraphDefinition) GetVersion() *string {
+	return r.Version
+}
+
+// GetStatus returns the resource's publication status.
+func (r *GraphDefinition) GetStatus() *PublicationStatus {
+	return r.Status
+}
+
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r GraphDefinition) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "GraphDefinition"
+	r.ResourceType = string(ResourceTypeGraphDefinition)
 	type Alias GraphDefinition
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
@@ -67,11 +67,13 @@ type Group struct {
 	Characteristic []GroupCharacteristic `json:"characteristic,omitempty"`
 	// Who or what is in group
 	Member []GroupMember `json:"member,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *Group) GetResourceType() string {
-	return "Group"
+	return string(ResourceTypeGroup)
 }
 
 // GetId returns the resource's logical ID.
@@ -119,11 +121,16 @@ func (r *Group) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r Group) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "Group"
+	r.ResourceType = string(ResourceTypeGroup)
 	type Alias Group
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
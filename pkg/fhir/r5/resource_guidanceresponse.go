@@ -73,11 +73,13 @@ type GuidanceResponse struct {
 	Result []Reference `json:"result,omitempty"`
 	// Additional required data
 	DataRequirement []DataRequirement `json:"dataRequirement,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *GuidanceResponse) GetResourceType() string {
-	return "GuidanceResponse"
+	return string(ResourceTypeGuidanceResponse)
 }
 
 // GetId returns the resource's logical ID.
@@ -125,11 +127,16 @@ func (r *GuidanceResponse) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r GuidanceResponse) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "GuidanceResponse"
+	r.ResourceType = string(ResourceTypeGuidanceResponse)
 	type Alias GuidanceResponse
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
@@ -79,11 +79,13 @@ type ImagingSelection struct {
 	Focus []Reference `json:"focus,omitempty"`
 	// The selected instances
 	Instance []ImagingSelectionInstance `json:"instance,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *ImagingSelection) GetResourceType() string {
-	return "ImagingSelection"
+	return string(ResourceTypeImagingSelection)
 }
 
 // GetId returns the resource's logical ID.
@@ -131,11 +133,16 @@ func (r *ImagingSelection) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r ImagingSelection) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "ImagingSelection"
+	r.ResourceType = string(ResourceTypeImagingSelection)
 	type Alias ImagingSelection
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
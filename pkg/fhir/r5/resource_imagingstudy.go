@@ -79,11 +79,13 @@ type ImagingStudy struct {
 	DescriptionExt *Element `json:"_description,omitempty"`
 	// Each study has one or more series of instances
 	Series []ImagingStudySeries `json:"series,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *ImagingStudy) GetResourceType() string {
-	return "ImagingStudy"
+	return string(ResourceTypeImagingStudy)
 }
 
 // GetId returns the resource's logical ID.
@@ -131,11 +133,16 @@ func (r *ImagingStudy) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r ImagingStudy) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "ImagingStudy"
+	r.ResourceType = string(ResourceTypeImagingStudy)
 	type Alias ImagingStudy
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
@@ -45,11 +45,13 @@ type ImmunizationRecommendation struct {
 	Authority *Reference `json:"authority,omitempty"`
 	// Vaccine administration recommendations
 	Recommendation []ImmunizationRecommendationRecommendation `json:"recommendation,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *ImmunizationRecommendation) GetResourceType() string {
-	return "ImmunizationRecommendation"
+	return string(ResourceTypeImmunizationRecommendation)
 }
 
 // GetId returns the resource's logical ID.
@@ -97,11 +99,16 @@ func (r *ImmunizationRecommendation) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r ImmunizationRecommendation) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "ImmunizationRecommendation"
+	r.ResourceType = string(ResourceTypeImmunizationRecommendation)
 	type Alias ImmunizationRecommendation
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
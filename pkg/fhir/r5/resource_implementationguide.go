@@ -115,11 +115,13 @@ type ImplementationGuide struct {
 	Definition *ImplementationGuideDefinition `json:"definition,omitempty"`
 	// Information about an assembled IG
 	Manifest *ImplementationGuideManifest `json:"manifest,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *ImplementationGuide) GetResourceType() string {
-	return "ImplementationGuide"
+	return string(ResourceTypeImplementationGuide)
 }
 
 // GetId returns the resource's logical ID.
@@ -167,11 +169,31 @@ func (r *ImplementationGuide) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// GetUrl returns the resource's canonical URL.
+func (r *ImplementationGuide) GetUrl() *string {
+	return r.Url
+}
+
+// GetVersion returns the resource's business version.
+func (r *ImplementationGuide) GetVersion() *string {
+	return r.Version
+}
+
+// GetStatus returns the resource's publication status.
+func (r *ImplementationGuide) GetStatus() *PublicationStatus {
+	return r.Status
+}
+
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r ImplementationGuide) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "ImplementationGuide"
+	r.ResourceType = string(ResourceTypeImplementationGuide)
 	type Alias ImplementationGuide
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
@@ -63,11 +63,13 @@ type InventoryItem struct {
 	Instance *InventoryItemInstance `json:"instance,omitempty"`
 	// Link to a product resource used in clinical workflows
 	ProductReference *Reference `json:"productReference,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *InventoryItem) GetResourceType() string {
-	return "InventoryItem"
+	return string(ResourceTypeInventoryItem)
 }
 
 // GetId returns the resource's logical ID.
@@ -115,11 +117,16 @@ func (r *InventoryItem) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r InventoryItem) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "InventoryItem"
+	r.ResourceType = string(ResourceTypeInventoryItem)
 	type Alias InventoryItem
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
@@ -59,11 +59,13 @@ type InventoryReport struct {
 	InventoryListing []InventoryReportInventoryListing `json:"inventoryListing,omitempty"`
 	// A note associated with the InventoryReport
 	Note []Annotation `json:"note,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *InventoryReport) GetResourceType() string {
-	return "InventoryReport"
+	return string(ResourceTypeInventoryReport)
 }
 
 // GetId returns the resource's logical ID.
@@ -111,11 +113,16 @@ func (r *InventoryReport) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r InventoryReport) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "InventoryReport"
+	r.ResourceType = string(ResourceTypeInventoryReport)
 	type Alias InventoryReport
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
@@ -83,11 +83,13 @@ type Invoice struct {
 	PaymentTermsExt *Element `json:"_paymentTerms,omitempty"`
 	// Comments made about the invoice
 	Note []Annotation `json:"note,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *Invoice) GetResourceType() string {
-	return "Invoice"
+	return string(ResourceTypeInvoice)
 }
 
 // GetId returns the resource's logical ID.
@@ -135,11 +137,16 @@ func (r *Invoice) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r Invoice) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "Invoice"
+	r.ResourceType = string(ResourceTypeInvoice)
 	type Alias Invoice
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
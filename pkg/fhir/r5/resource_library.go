@@ -137,11 +137,13 @@ type Library struct {
 	DataRequirement []DataRequirement `json:"dataRequirement,omitempty"`
 	// Contents of the library, either embedded or referenced
 	Content []Attachment `json:"content,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *Library) GetResourceType() string {
-	return "Library"
+	return string(ResourceTypeLibrary)
 }
 
 // GetId returns the resource's logical ID.
@@ -189,11 +191,31 @@ func (r *Library) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// GetUrl returns the resource's canonical URL.
+func (r *Library) GetUrl() *string {
+	return r.Url
+}
+
+// GetVersion returns the resource's business version.
+func (r *Library) GetVersion() *string {
+	return r.Version
+}
+
+// GetStatus returns the resource's publication status.
+func (r *Library) GetStatus() *PublicationStatus {
+	return r.Status
+}
+
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r Library) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "Library"
+	r.ResourceType = string(ResourceTypeLibrary)
 	type Alias Library
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
@@ -67,11 +67,13 @@ type List struct {
 	Entry []ListEntry `json:"entry,omitempty"`
 	// Why list is empty
 	EmptyReason *CodeableConcept `json:"emptyReason,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *List) GetResourceType() string {
-	return "List"
+	return string(ResourceTypeList)
 }
 
 // GetId returns the resource's logical ID.
@@ -119,11 +121,16 @@ func (r *List) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r List) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "List"
+	r.ResourceType = string(ResourceTypeList)
 	type Alias List
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
@@ -57,11 +57,13 @@ type ManufacturedItemDefinition struct {
 	Property []ManufacturedItemDefinitionProperty `json:"property,omitempty"`
 	// Physical parts of the manufactured item, that it is intrisically made from. This is distinct from the ingredients that are part of its chemical makeup
 	Component []ManufacturedItemDefinitionComponent `json:"component,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *ManufacturedItemDefinition) GetResourceType() string {
-	return "ManufacturedItemDefinition"
+	return string(ResourceTypeManufacturedItemDefinition)
 }
 
 // GetId returns the resource's logical ID.
@@ -109,11 +111,16 @@ func (r *ManufacturedItemDefinition) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r ManufacturedItemDefinition) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "ManufacturedItemDefinition"
+	r.ResourceType = string(ResourceTypeManufacturedItemDefinition)
 	type Alias ManufacturedItemDefinition
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
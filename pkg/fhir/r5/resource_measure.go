@@ -177,11 +177,13 @@ type Measure struct {
 	Group []MeasureGroup `json:"group,omitempty"`
 	// What other data should be reported with the measure
 	SupplementalData []MeasureSupplementalData `json:"supplementalData,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *Measure) GetResourceType() string {
-	return "Measure"
+	return string(ResourceTypeMeasure)
 }
 
 // GetId returns the resource's logical ID.
@@ -229,11 +231,31 @@ func (r *Measure) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// GetUrl returns the resource's canonical URL.
+func (r *Measure) GetUrl() *string {
+	return r.Url
+}
+
+// GetVersion returns the resource's business version.
+func (r *Measure) GetVersion() *string {
+	return r.Version
+}
+
+// GetStatus returns the resource's publication status.
+func (r *Measure) GetStatus() *PublicationStatus {
+	return r.Status
+}
+
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r Measure) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "Measure"
+	r.ResourceType = string(ResourceTypeMeasure)
 	type Alias Measure
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
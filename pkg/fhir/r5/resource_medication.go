@@ -53,11 +53,13 @@ type Medication struct {
 	Batch *MedicationBatch `json:"batch,omitempty"`
 	// Knowledge about this medication
 	Definition *Reference `json:"definition,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *Medication) GetResourceType() string {
-	return "Medication"
+	return string(ResourceTypeMedication)
 }
 
 // GetId returns the resource's logical ID.
@@ -105,11 +107,16 @@ func (r *Medication) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r Medication) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "Medication"
+	r.ResourceType = string(ResourceTypeMedication)
 	type Alias Medication
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
@@ -87,11 +87,13 @@ type MedicationAdministration struct {
 	Dosage *MedicationAdministrationDosage `json:"dosage,omitempty"`
 	// A list of events of interest in the lifecycle
 	EventHistory []Reference `json:"eventHistory,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *MedicationAdministration) GetResourceType() string {
-	return "MedicationAdministration"
+	return string(ResourceTypeMedicationAdministration)
 }
 
 // GetId returns the resource's logical ID.
@@ -139,11 +141,16 @@ func (r *MedicationAdministration) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r MedicationAdministration) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "MedicationAdministration"
+	r.ResourceType = string(ResourceTypeMedicationAdministration)
 	type Alias MedicationAdministration
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
@@ -99,11 +99,13 @@ type MedicationDispense struct {
 	Substitution *MedicationDispenseSubstitution `json:"substitution,omitempty"`
 	// A list of relevant lifecycle events
 	EventHistory []Reference `json:"eventHistory,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *MedicationDispense) GetResourceType() string {
-	return "MedicationDispense"
+	return string(ResourceTypeMedicationDispense)
 }
 
 // GetId returns the resource's logical ID.
@@ -151,11 +153,16 @@ func (r *MedicationDispense) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r MedicationDispense) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "MedicationDispense"
+	r.ResourceType = string(ResourceTypeMedicationDispense)
 	type Alias MedicationDispense
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
@@ -79,11 +79,13 @@ type MedicationKnowledge struct {
 	Regulatory []MedicationKnowledgeRegulatory `json:"regulatory,omitempty"`
 	// Minimal definition information about the medication
 	Definitional *MedicationKnowledgeDefinitional `json:"definitional,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *MedicationKnowledge) GetResourceType() string {
-	return "MedicationKnowledge"
+	return string(ResourceTypeMedicationKnowledge)
 }
 
 // GetId returns the resource's logical ID.
@@ -131,11 +133,16 @@ func (r *MedicationKnowledge) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r MedicationKnowledge) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "MedicationKnowledge"
+	r.ResourceType = string(ResourceTypeMedicationKnowledge)
 	type Alias MedicationKnowledge
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
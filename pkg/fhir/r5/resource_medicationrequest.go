@@ -115,11 +115,13 @@ type MedicationRequest struct {
 	Substitution *MedicationRequestSubstitution `json:"substitution,omitempty"`
 	// A list of events of interest in the lifecycle
 	EventHistory []Reference `json:"eventHistory,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *MedicationRequest) GetResourceType() string {
-	return "MedicationRequest"
+	return string(ResourceTypeMedicationRequest)
 }
 
 // GetId returns the resource's logical ID.
@@ -167,11 +169,16 @@ func (r *MedicationRequest) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r MedicationRequest) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "MedicationRequest"
+	r.ResourceType = string(ResourceTypeMedicationRequest)
 	type Alias MedicationRequest
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
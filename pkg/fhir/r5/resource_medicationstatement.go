@@ -79,11 +79,13 @@ type MedicationStatement struct {
 	Dosage []Dosage `json:"dosage,omitempty"`
 	// Indicates whether the medication is or is not being consumed or administered
 	Adherence *MedicationStatementAdherence `json:"adherence,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *MedicationStatement) GetResourceType() string {
-	return "MedicationStatement"
+	return string(ResourceTypeMedicationStatement)
 }
 
 // GetId returns the resource's logical ID.
@@ -131,11 +133,16 @@ func (r *MedicationStatement) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r MedicationStatement) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "MedicationStatement"
+	r.ResourceType = string(ResourceTypeMedicationStatement)
 	type Alias MedicationStatement
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
@@ -99,11 +99,13 @@ type MedicinalProductDefinition struct {
 	Operation []MedicinalProductDefinitionOperation `json:"operation,omitempty"`
 	// Key product features such as "sugar free", "modified release"
 	Characteristic []MedicinalProductDefinitionCharacteristic `json:"characteristic,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *MedicinalProductDefinition) GetResourceType() string {
-	return "MedicinalProductDefinition"
+	return string(ResourceTypeMedicinalProductDefinition)
 }
 
 // GetId returns the resource's logical ID.
@@ -151,11 +153,16 @@ func (r *MedicinalProductDefinition) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r MedicinalProductDefinition) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "MedicinalProductDefinition"
+	r.ResourceType = string(ResourceTypeMedicinalProductDefinition)
 	type Alias MedicinalProductDefinition
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
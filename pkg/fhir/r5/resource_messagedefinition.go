@@ -129,11 +129,13 @@ type MessageDefinition struct {
 	Graph *string `json:"graph,omitempty"`
 	// Extension for Graph
 	GraphExt *Element `json:"_graph,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *MessageDefinition) GetResourceType() string {
-	return "MessageDefinition"
+	return string(ResourceTypeMessageDefinition)
 }
 
 // GetId returns the resource's logical ID.
@@ -181,11 +183,31 @@ func (r *MessageDefinition) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// GetUrl returns the resource's canonical URL.
+func (r *MessageDefinition) GetUrl() *string {
+	return r.Url
+}
+
+// GetVersion returns the resource's business version.
+func (r *MessageDefinition) GetVersion() *string {
+	return r.Version
+}
+
+// GetStatus returns the resource's publication status.
+func (r *MessageDefinition) GetStatus() *PublicationStatus {
+	return r.Status
+}
+
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r MessageDefinition) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "MessageDefinition"
+	r.ResourceType = string(ResourceTypeMessageDefinition)
 	type Alias MessageDefinition
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
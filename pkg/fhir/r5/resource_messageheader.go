@@ -59,11 +59,13 @@ type MessageHeader struct {
 	Definition *string `json:"definition,omitempty"`
 	// Extension for Definition
 	DefinitionExt *Element `json:"_definition,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *MessageHeader) GetResourceType() string {
-	return "MessageHeader"
+	return string(ResourceTypeMessageHeader)
 }
 
 // GetId returns the resource's logical ID.
@@ -111,11 +113,16 @@ func (r *MessageHeader) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r MessageHeader) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "MessageHeader"
+	r.ResourceType = string(ResourceTypeMessageHeader)
 	type Alias MessageHeader
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
@@ -133,11 +133,13 @@ type NamingSystem struct {
 	UsageExt *Element `json:"_usage,omitempty"`
 	// Unique identifiers used for system
 	UniqueId []NamingSystemUniqueId `json:"uniqueId,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *NamingSystem) GetResourceType() string {
-	return "NamingSystem"
+	return string(ResourceTypeNamingSystem)
 }
 
 // GetId returns the resource's logical ID.
@@ -185,11 +187,31 @@ func (r *NamingSystem) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// GetUrl returns the resource's canonical URL.
+func (r *NamingSystem) GetUrl() *string {
+	return r.Url
+}
+
+// GetVersion returns the resource's business version.
+func (r *NamingSystem) GetVersion() *string {
+	return r.Version
+}
+
+// GetStatus returns the resource's publication status.
+func (r *NamingSystem) GetStatus() *PublicationStatus {
+	return r.Status
+}
+
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r NamingSystem) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "NamingSystem"
+	r.ResourceType = string(ResourceTypeNamingSystem)
 	type Alias NamingSystem
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
@@ -89,11 +89,13 @@ type NutritionIntake struct {
 	Reason []CodeableReference `json:"reason,omitempty"`
 	// Further information about the consumption
 	Note []Annotation `json:"note,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *NutritionIntake) GetResourceType() string {
-	return "NutritionIntake"
+	return string(ResourceTypeNutritionIntake)
 }
 
 // GetId returns the resource's logical ID.
@@ -141,11 +143,16 @@ func (r *NutritionIntake) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r NutritionIntake) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "NutritionIntake"
+	r.ResourceType = string(ResourceTypeNutritionIntake)
 	type Alias NutritionIntake
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
@@ -139,11 +139,13 @@ type Observation struct {
 	DerivedFrom []Reference `json:"derivedFrom,omitempty"`
 	// Component results
 	Component []ObservationComponent `json:"component,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *Observation) GetResourceType() string {
-	return "Observation"
+	return string(ResourceTypeObservation)
 }
 
 // GetId returns the resource's logical ID.
@@ -191,11 +193,16 @@ func (r *Observation) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r Observation) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "Observation"
+	r.ResourceType = string(ResourceTypeObservation)
 	type Alias Observation
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
@@ -149,11 +149,13 @@ type ObservationDefinition struct {
 	HasMember []Reference `json:"hasMember,omitempty"`
 	// Component results
 	Component []ObservationDefinitionComponent `json:"component,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *ObservationDefinition) GetResourceType() string {
-	return "ObservationDefinition"
+	return string(ResourceTypeObservationDefinition)
 }
 
 // GetId returns the resource's logical ID.
@@ -201,11 +203,31 @@ func (r *ObservationDefinition) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// GetUrl returns the resource's canonical URL.
+func (r *ObservationDefinition) GetUrl() *string {
+	return r.Url
+}
+
+// GetVersion returns the resource's business version.
+func (r *ObservationDefinition) GetVersion() *string {
+	return r.Version
+}
+
+// GetStatus returns the resource's publication status.
+func (r *ObservationDefinition) GetStatus() *PublicationStatus {
+	return r.Status
+}
+
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r ObservationDefinition) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "ObservationDefinition"
+	r.ResourceType = string(ResourceTypeObservationDefinition)
 	type Alias ObservationDefinition
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
@@ -143,11 +143,13 @@ type OperationDefinition struct {
 	Parameter []OperationDefinitionParameter `json:"parameter,omitempty"`
 	// Define overloaded variants for when  generating code
 	Overload []OperationDefinitionOverload `json:"overload,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *OperationDefinition) GetResourceType() string {
-	return "OperationDefinition"
+	return string(ResourceTypeOperationDefinition)
 }
 
 // GetId returns the resource's logical ID.
@@ -195,11 +197,31 @@ func (r *OperationDefinition) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// GetUrl returns the resource's canonical URL.
+func (r *OperationDefinition) GetUrl() *string {
+	return r.Url
+}
+
+// GetVersion returns the resource's business version.
+func (r *OperationDefinition) GetVersion() *string {
+	return r.Version
+}
+
+// GetStatus returns the resource's publication status.
+func (r *OperationDefinition) GetStatus() *PublicationStatus {
+	return r.Status
+}
+
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r OperationDefinition) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "OperationDefinition"
+	r.ResourceType = string(ResourceTypeOperationDefinition)
 	type Alias OperationDefinition
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
@@ -61,11 +61,13 @@ type Organization struct {
 	Endpoint []Reference `json:"endpoint,omitempty"`
 	// Qualifications, certifications, accreditations, licenses, training, etc. pertaining to the provision of care
 	Qualification []OrganizationQualification `json:"qualification,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *Organization) GetResourceType() string {
-	return "Organization"
+	return string(ResourceTypeOrganization)
 }
 
 // GetId returns the resource's logical ID.
@@ -113,11 +115,16 @@ func (r *Organization) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r Organization) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "Organization"
+	r.ResourceType = string(ResourceTypeOrganization)
 	type Alias Organization
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
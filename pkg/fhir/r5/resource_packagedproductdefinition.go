@@ -71,11 +71,13 @@ type PackagedProductDefinition struct {
 	Packaging *PackagedProductDefinitionPackaging `json:"packaging,omitempty"`
 	// Allows the key features to be recorded, such as "hospital pack", "nurse prescribable"
 	Characteristic []PackagedProductDefinitionPackagingProperty `json:"characteristic,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *PackagedProductDefinition) GetResourceType() string {
-	return "PackagedProductDefinition"
+	return string(ResourceTypePackagedProductDefinition)
 }
 
 // GetId returns the resource's logical ID.
@@ -123,11 +125,16 @@ func (r *PackagedProductDefinition) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r PackagedProductDefinition) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "PackagedProductDefinition"
+	r.ResourceType = string(ResourceTypePackagedProductDefinition)
 	type Alias PackagedProductDefinition
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
@@ -83,11 +83,13 @@ type Patient struct {
 	ManagingOrganization *Reference `json:"managingOrganization,omitempty"`
 	// Link to a Patient or RelatedPerson resource that concerns the same actual individual
 	Link []PatientLink `json:"link,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *Patient) GetResourceType() string {
-	return "Patient"
+	return string(ResourceTypePatient)
 }
 
 // GetId returns the resource's logical ID.
@@ -135,11 +137,16 @@ func (r *Patient) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r Patient) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "Patient"
+	r.ResourceType = string(ResourceTypePatient)
 	type Alias Patient
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
@@ -113,11 +113,13 @@ type PaymentReconciliation struct {
 	FormCode *CodeableConcept `json:"formCode,omitempty"`
 	// Note concerning processing
 	ProcessNote []PaymentReconciliationProcessNote `json:"processNote,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *PaymentReconciliation) GetResourceType() string {
-	return "PaymentReconciliation"
+	return string(ResourceTypePaymentReconciliation)
 }
 
 // GetId returns the resource's logical ID.
@@ -165,11 +167,16 @@ func (r *PaymentReconciliation) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r PaymentReconciliation) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "PaymentReconciliation"
+	r.ResourceType = string(ResourceTypePaymentReconciliation)
 	type Alias PaymentReconciliation
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
@@ -53,11 +53,13 @@ type Permission struct {
 	CombiningExt *Element `json:"_combining,omitempty"`
 	// Constraints to the Permission
 	Rule []PermissionRule `json:"rule,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *Permission) GetResourceType() string {
-	return "Permission"
+	return string(ResourceTypePermission)
 }
 
 // GetId returns the resource's logical ID.
@@ -105,11 +107,16 @@ func (r *Permission) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r Permission) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "Permission"
+	r.ResourceType = string(ResourceTypePermission)
 	type Alias Permission
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
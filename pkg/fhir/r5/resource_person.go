@@ -71,11 +71,13 @@ type Person struct {
 	ManagingOrganization *Reference `json:"managingOrganization,omitempty"`
 	// Link to a resource that concerns the same actual person
 	Link []PersonLink `json:"link,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *Person) GetResourceType() string {
-	return "Person"
+	return string(ResourceTypePerson)
 }
 
 // GetId returns the resource's logical ID.
@@ -123,11 +125,16 @@ func (r *Person) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r Person) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "Person"
+	r.ResourceType = string(ResourceTypePerson)
 	type Alias Person
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
@@ -61,11 +61,13 @@ type QuestionnaireResponse struct {
 	Source *Reference `json:"source,omitempty"`
 	// Groups and questions
 	Item []QuestionnaireResponseItem `json:"item,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *QuestionnaireResponse) GetResourceType() string {
-	return "QuestionnaireResponse"
+	return string(ResourceTypeQuestionnaireResponse)
 }
 
 // GetId returns the resource's logical ID.
@@ -113,11 +115,16 @@ func (r *QuestionnaireResponse) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r QuestionnaireResponse) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "QuestionnaireResponse"
+	r.ResourceType = string(ResourceTypeQuestionnaireResponse)
 	type Alias QuestionnaireResponse
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
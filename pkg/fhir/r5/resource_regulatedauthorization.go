@@ -67,11 +67,13 @@ type RegulatedAuthorization struct {
 	AttachedDocument []Reference `json:"attachedDocument,omitempty"`
 	// The case or regulatory procedure for granting or amending a regulated authorization. Note: This area is subject to ongoing review and the workgroup is seeking implementer feedback on its use (see link at bottom of page)
 	Case *RegulatedAuthorizationCase `json:"case,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *RegulatedAuthorization) GetResourceType() string {
-	return "RegulatedAuthorization"
+	return string(ResourceTypeRegulatedAuthorization)
 }
 
 // GetId returns the resource's logical ID.
@@ -119,11 +121,16 @@ func (r *RegulatedAuthorization) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r RegulatedAuthorization) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "RegulatedAuthorization"
+	r.ResourceType = string(ResourceTypeRegulatedAuthorization)
 	type Alias RegulatedAuthorization
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
@@ -63,11 +63,13 @@ type RelatedPerson struct {
 	Period *Period `json:"period,omitempty"`
 	// A language which may be used to communicate with the related person about the patient's health
 	Communication []RelatedPersonCommunication `json:"communication,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *RelatedPerson) GetResourceType() string {
-	return "RelatedPerson"
+	return string(ResourceTypeRelatedPerson)
 }
 
 // GetId returns the resource's logical ID.
@@ -115,11 +117,16 @@ func (r *RelatedPerson) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r RelatedPerson) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "RelatedPerson"
+	r.ResourceType = string(ResourceTypeRelatedPerson)
 	type Alias RelatedPerson
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
@@ -109,11 +109,13 @@ type Requirements struct {
 	ActorExt []Element `json:"_actor,omitempty"`
 	// Actual statement as markdown
 	Statement []RequirementsStatement `json:"statement,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *Requirements) GetResourceType() string {
-	return "Requirements"
+	return string(ResourceTypeRequirements)
 }
 
 // GetId returns the resource's logical ID.
@@ -161,11 +163,31 @@ func (r *Requirements) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// GetUrl returns the resource's canonical URL.
+func (r *Requirements) GetUrl() *string {
+	return r.Url
+}
+
+// GetVersion returns the resource's business version.
+func (r *Requirements) GetVersion() *string {
+	return r.Version
+}
+
+// GetStatus returns the resource's publication status.
+func (r *Requirements) GetStatus() *PublicationStatus {
+	return r.Status
+}
+
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r Requirements) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "Requirements"
+	r.ResourceType = string(ResourceTypeRequirements)
 	type Alias Requirements
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
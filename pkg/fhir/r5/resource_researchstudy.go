@@ -113,11 +113,13 @@ type ResearchStudy struct {
 	OutcomeMeasure []ResearchStudyOutcomeMeasure `json:"outcomeMeasure,omitempty"`
 	// Link to results generated during the study
 	Result []Reference `json:"result,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *ResearchStudy) GetResourceType() string {
-	return "ResearchStudy"
+	return string(ResourceTypeResearchStudy)
 }
 
 // GetId returns the resource's logical ID.
@@ -165,11 +167,31 @@ func (r *ResearchStudy) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// GetUrl returns the resource's canonical URL.
+func (r *ResearchStudy) GetUrl() *string {
+	return r.Url
+}
+
+// GetVersion returns the resource's business version.
+func (r *ResearchStudy) GetVersion() *string {
+	return r.Version
+}
+
+// GetStatus returns the resource's publication status.
+func (r *ResearchStudy) GetStatus() *PublicationStatus {
+	return r.Status
+}
+
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r ResearchStudy) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "ResearchStudy"
+	r.ResourceType = string(ResourceTypeResearchStudy)
 	type Alias ResearchStudy
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
@@ -149,11 +149,13 @@ type SearchParameter struct {
 	ChainExt []Element `json:"_chain,omitempty"`
 	// For Composite resources to define the parts
 	Component []SearchParameterComponent `json:"component,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *SearchParameter) GetResourceType() string {
-	return "SearchParameter"
+	return string(ResourceTypeSearchParameter)
 }
 
 // GetId returns the resource's logical ID.
@@ -201,11 +203,31 @@ func (r *SearchParameter) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// GetUrl returns the resource's canonical URL.
+func (r *SearchParameter) GetUrl() *string {
+	return r.Url
+}
+
+// GetVersion returns the resource's business version.
+func (r *SearchParameter) GetVersion() *string {
+	return r.Version
+}
+
+// GetStatus returns the resource's publication status.
+func (r *SearchParameter) GetStatus() *PublicationStatus {
+	return r.Status
+}
+
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r SearchParameter) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "SearchParameter"
+	r.ResourceType = string(ResourceTypeSearchParameter)
 	type Alias SearchParameter
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
@@ -127,11 +127,13 @@ type ServiceRequest struct {
 	PatientInstruction []ServiceRequestPatientInstruction `json:"patientInstruction,omitempty"`
 	// Request provenance
 	RelevantHistory []Reference `json:"relevantHistory,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *ServiceRequest) GetResourceType() string {
-	return "ServiceRequest"
+	return string(ResourceTypeServiceRequest)
 }
 
 // GetId returns the resource's logical ID.
@@ -179,11 +181,16 @@ func (r *ServiceRequest) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r ServiceRequest) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "ServiceRequest"
+	r.ResourceType = string(ResourceTypeServiceRequest)
 	type Alias ServiceRequest
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
@@ -129,11 +129,13 @@ type SpecimenDefinition struct {
 	Collection []CodeableConcept `json:"collection,omitempty"`
 	// Specimen in container intended for testing by lab
 	TypeTested []SpecimenDefinitionTypeTested `json:"typeTested,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *SpecimenDefinition) GetResourceType() string {
-	return "SpecimenDefinition"
+	return string(ResourceTypeSpecimenDefinition)
 }
 
 // GetId returns the resource's logical ID.
@@ -181,11 +183,31 @@ func (r *SpecimenDefinition) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// GetUrl returns the resource's canonical URL.
+func (r *SpecimenDefinition) GetUrl() *string {
+	return r.Url
+}
+
+// GetVersion returns the resource's business version.
+func (r *SpecimenDefinition) GetVersion() *string {
+	return r.Version
+}
+
+// GetStatus returns the resource's publication status.
+func (r *SpecimenDefinition) GetStatus() *PublicationStatus {
+	return r.Status
+}
+
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r SpecimenDefinition) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "SpecimenDefinition"
+	r.ResourceType = string(ResourceTypeSpecimenDefinition)
 	type Alias SpecimenDefinition
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
@@ -105,11 +105,13 @@ type StructureMap struct {
 	Const []StructureMapConst `json:"const,omitempty"`
 	// Named sections for reader convenience
 	Group []StructureMapGroup `json:"group,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *StructureMap) GetResourceType() string {
-	return "StructureMap"
+	return string(ResourceTypeStructureMap)
 }
 
 // GetId returns the resource's logical ID.
@@ -157,11 +159,31 @@ func (r *StructureMap) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// GetUrl returns the resource's canonical URL.
+func (r *StructureMap) GetUrl() *string {
+	return r.Url
+}
+
+// GetVersion returns the resource's business version.
+func (r *StructureMap) GetVersion() *string {
+	return r.Version
+}
+
+// GetStatus returns the resource's publication status.
+func (r *StructureMap) GetStatus() *PublicationStatus {
+	return r.Status
+}
+
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r StructureMap) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "StructureMap"
+	r.ResourceType = string(ResourceTypeStructureMap)
 	type Alias StructureMap
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
@@ -89,11 +89,13 @@ type Subscription struct {
 	MaxCount *uint32 `json:"maxCount,omitempty"`
 	// Extension for MaxCount
 	MaxCountExt *Element `json:"_maxCount,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *Subscription) GetResourceType() string {
-	return "Subscription"
+	return string(ResourceTypeSubscription)
 }
 
 // GetId returns the resource's logical ID.
@@ -141,11 +143,16 @@ func (r *Subscription) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r Subscription) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "Subscription"
+	r.ResourceType = string(ResourceTypeSubscription)
 	type Alias Subscription
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
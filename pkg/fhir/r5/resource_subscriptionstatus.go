@@ -55,11 +55,13 @@ type SubscriptionStatus struct {
 	TopicExt *Element `json:"_topic,omitempty"`
 	// List of errors on the subscription
 	Error []CodeableConcept `json:"error,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *SubscriptionStatus) GetResourceType() string {
-	return "SubscriptionStatus"
+	return string(ResourceTypeSubscriptionStatus)
 }
 
 // GetId returns the resource's logical ID.
@@ -107,11 +109,16 @@ func (r *SubscriptionStatus) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r SubscriptionStatus) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "SubscriptionStatus"
+	r.ResourceType = string(ResourceTypeSubscriptionStatus)
 	type Alias SubscriptionStatus
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
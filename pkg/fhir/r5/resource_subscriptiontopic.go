@@ -117,11 +117,13 @@ type SubscriptionTopic struct {
 	CanFilterBy []SubscriptionTopicCanFilterBy `json:"canFilterBy,omitempty"`
 	// Properties for describing the shape of notifications generated by this topic
 	NotificationShape []SubscriptionTopicNotificationShape `json:"notificationShape,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *SubscriptionTopic) GetResourceType() string {
-	return "SubscriptionTopic"
+	return string(ResourceTypeSubscriptionTopic)
 }
 
 // GetId returns the resource's logical ID.
@@ -169,11 +171,31 @@ func (r *SubscriptionTopic) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// GetUrl returns the resource's canonical URL.
+func (r *SubscriptionTopic) GetUrl() *string {
+	return r.Url
+}
+
+// GetVersion returns the resource's business version.
+func (r *SubscriptionTopic) GetVersion() *string {
+	return r.Version
+}
+
+// GetStatus returns the resource's publication status.
+func (r *SubscriptionTopic) GetStatus() *PublicationStatus {
+	return r.Status
+}
+
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r SubscriptionTopic) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "SubscriptionTopic"
+	r.ResourceType = string(ResourceTypeSubscriptionTopic)
 	type Alias SubscriptionTopic
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
@@ -59,11 +59,13 @@ type Substance struct {
 	Quantity *Quantity `json:"quantity,omitempty"`
 	// Composition information about the substance
 	Ingredient []SubstanceIngredient `json:"ingredient,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *Substance) GetResourceType() string {
-	return "Substance"
+	return string(ResourceTypeSubstance)
 }
 
 // GetId returns the resource's logical ID.
@@ -111,11 +113,16 @@ func (r *Substance) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r Substance) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "Substance"
+	r.ResourceType = string(ResourceTypeSubstance)
 	type Alias Substance
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
@@ -47,11 +47,13 @@ type SubstanceNucleicAcid struct {
 	OligoNucleotideType *CodeableConcept `json:"oligoNucleotideType,omitempty"`
 	// Subunits are listed in order of decreasing length; sequences of the same length will be ordered by molecular weight; subunits that have identical sequences will be repeated multiple times
 	Subunit []SubstanceNucleicAcidSubunit `json:"subunit,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *SubstanceNucleicAcid) GetResourceType() string {
-	return "SubstanceNucleicAcid"
+	return string(ResourceTypeSubstanceNucleicAcid)
 }
 
 // GetId returns the resource's logical ID.
@@ -99,11 +101,16 @@ func (r *SubstanceNucleicAcid) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r SubstanceNucleicAcid) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "SubstanceNucleicAcid"
+	r.ResourceType = string(ResourceTypeSubstanceNucleicAcid)
 	type Alias SubstanceNucleicAcid
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
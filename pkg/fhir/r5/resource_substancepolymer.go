@@ -49,11 +49,13 @@ type SubstancePolymer struct {
 	MonomerSet []SubstancePolymerMonomerSet `json:"monomerSet,omitempty"`
 	// Specifies and quantifies the repeated units and their configuration
 	Repeat []SubstancePolymerRepeat `json:"repeat,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *SubstancePolymer) GetResourceType() string {
-	return "SubstancePolymer"
+	return string(ResourceTypeSubstancePolymer)
 }
 
 // GetId returns the resource's logical ID.
@@ -101,11 +103,16 @@ func (r *SubstancePolymer) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r SubstancePolymer) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "SubstancePolymer"
+	r.ResourceType = string(ResourceTypeSubstancePolymer)
 	type Alias SubstancePolymer
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
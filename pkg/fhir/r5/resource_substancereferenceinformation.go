@@ -43,11 +43,13 @@ type SubstanceReferenceInformation struct {
 	GeneElement []SubstanceReferenceInformationGeneElement `json:"geneElement,omitempty"`
 	// Todo
 	Target []SubstanceReferenceInformationTarget `json:"target,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *SubstanceReferenceInformation) GetResourceType() string {
-	return "SubstanceReferenceInformation"
+	return string(ResourceTypeSubstanceReferenceInformation)
 }
 
 // GetId returns the resource's logical ID.
@@ -95,11 +97,16 @@ func (r *SubstanceReferenceInformation) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r SubstanceReferenceInformation) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "SubstanceReferenceInformation"
+	r.ResourceType = string(ResourceTypeSubstanceReferenceInformation)
 	type Alias SubstanceReferenceInformation
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
@@ -65,11 +65,13 @@ type SubstanceSourceMaterial struct {
 	Organism *SubstanceSourceMaterialOrganism `json:"organism,omitempty"`
 	// To do
 	PartDescription []SubstanceSourceMaterialPartDescription `json:"partDescription,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *SubstanceSourceMaterial) GetResourceType() string {
-	return "SubstanceSourceMaterial"
+	return string(ResourceTypeSubstanceSourceMaterial)
 }
 
 // GetId returns the resource's logical ID.
@@ -117,11 +119,16 @@ func (r *SubstanceSourceMaterial) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r SubstanceSourceMaterial) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "SubstanceSourceMaterial"
+	r.ResourceType = string(ResourceTypeSubstanceSourceMaterial)
 	type Alias SubstanceSourceMaterial
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
@@ -117,11 +117,13 @@ type Task struct {
 	Input []TaskInput `json:"input,omitempty"`
 	// Information produced as part of task
 	Output []TaskOutput `json:"output,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *Task) GetResourceType() string {
-	return "Task"
+	return string(ResourceTypeTask)
 }
 
 // GetId returns the resource's logical ID.
@@ -169,11 +171,16 @@ func (r *Task) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r Task) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "Task"
+	r.ResourceType = string(ResourceTypeTask)
 	type Alias Task
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
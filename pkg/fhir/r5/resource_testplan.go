@@ -111,11 +111,13 @@ type TestPlan struct {
 	ExitCriteriaExt *Element `json:"_exitCriteria,omitempty"`
 	// The test cases that constitute this plan
 	TestCase []TestPlanTestCase `json:"testCase,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *TestPlan) GetResourceType() string {
-	return "TestPlan"
+	return string(ResourceTypeTestPlan)
 }
 
 // GetId returns the resource's logical ID.
@@ -163,11 +165,31 @@ func (r *TestPlan) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// GetUrl returns the resource's canonical URL.
+func (r *TestPlan) GetUrl() *string {
+	return r.Url
+}
+
+// GetVersion returns the resource's business version.
+func (r *TestPlan) GetVersion() *string {
+	return r.Version
+}
+
+// GetStatus returns the resource's publication status.
+func (r *TestPlan) GetStatus() *PublicationStatus {
+	return r.Status
+}
+
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r TestPlan) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "TestPlan"
+	r.ResourceType = string(ResourceTypeTestPlan)
 	type Alias TestPlan
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
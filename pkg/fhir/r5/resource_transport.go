@@ -115,11 +115,13 @@ type Transport struct {
 	Reason *CodeableReference `json:"reason,omitempty"`
 	// Parent (or preceding) transport
 	History *Reference `json:"history,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *Transport) GetResourceType() string {
-	return "Transport"
+	return string(ResourceTypeTransport)
 }
 
 // GetId returns the resource's logical ID.
@@ -167,11 +169,16 @@ func (r *Transport) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r Transport) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "Transport"
+	r.ResourceType = string(ResourceTypeTransport)
 	type Alias Transport
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
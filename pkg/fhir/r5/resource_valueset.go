@@ -127,11 +127,13 @@ type ValueSet struct {
 	Expansion *ValueSetExpansion `json:"expansion,omitempty"`
 	// Description of the semantic space the Value Set Expansion is intended to cover and should further clarify the text in ValueSet.description
 	Scope *ValueSetScope `json:"scope,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *ValueSet) GetResourceType() string {
-	return "ValueSet"
+	return string(ResourceTypeValueSet)
 }
 
 // GetId returns the resource's logical ID.
@@ -179,11 +181,31 @@ func (r *ValueSet) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// GetUrl returns the resource's canonical URL.
+func (r *ValueSet) GetUrl() *string {
+	return r.Url
+}
+
+// GetVersion returns the resource's business version.
+func (r *ValueSet) GetVersion() *string {
+	return r.Version
+}
+
+// GetStatus returns the resource's publication status.
+func (r *ValueSet) GetStatus() *PublicationStatus {
+	return r.Status
+}
+
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r ValueSet) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "ValueSet"
+	r.ResourceType = string(ResourceTypeValueSet)
 	type Alias ValueSet
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
@@ -71,11 +71,13 @@ type VerificationResult struct {
 	Attestation *VerificationResultAttestation `json:"attestation,omitempty"`
 	// Information about the entity validating information
 	Validator []VerificationResultValidator `json:"validator,omitempty"`
+	// Unknown JSON fields preserved by UnmarshalResourceWithOptions and written back by MarshalJSON
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetResourceType returns the FHIR resource type.
 func (r *VerificationResult) GetResourceType() string {
-	return "VerificationResult"
+	return string(ResourceTypeVerificationResult)
 }
 
 // GetId returns the resource's logical ID.
@@ -123,11 +125,16 @@ func (r *VerificationResult) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
-// MarshalJSON ensures resourceType is always included in JSON output.
+// MarshalJSON ensures resourceType is always included in JSON output, as the first key.
+// Fields follow the FHIR element order (id, meta, ...) and preserved unknown fields come last.
 func (r VerificationResult) MarshalJSON() ([]byte, error) {
-	r.ResourceType = "VerificationResult"
+	r.ResourceType = string(ResourceTypeVerificationResult)
 	type Alias VerificationResult
-	return json.Marshal((Alias)(r))
+	data, err := json.Marshal((Alias)(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+	return appendExtraFields(data, r.Extra)
 }
 
 // UnmarshalJSON handles deserialization of polymorphic contained resources.
@@ -1,9 +1,12 @@
 package r5
 
 import (
+	"bytes"
 	"encoding/json"
+	"fmt"
 	"testing"
 
+	"github.com/robertoaraneda/gofhir/pkg/common"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -108,3 +111,198 @@ func TestResourceInterface(t *testing.T) {
 		}
 	})
 }
+
+func TestResourceString(t *testing.T) {
+	t.Run("patient summary", func(t *testing.T) {
+		var patient Patient
+		require.NoError(t, json.Unmarshal([]byte(`{
+			"resourceType": "Patient",
+			"id": "123",
+			"name": [{"family": "Doe", "given": ["John"]}, {"text": "Johnny"}],
+			"gender": "male",
+			"birthDate": "1990-01-15"
+		}`), &patient))
+
+		assert.Equal(t, `Patient/123 name="John Doe" (+1) gender=male birthDate=1990-01-15`, patient.String())
+		assert.Equal(t, patient.String(), fmt.Sprint(&patient))
+	})
+
+	t.Run("observation summary", func(t *testing.T) {
+		var obs Observation
+		require.NoError(t, json.Unmarshal([]byte(`{
+			"resourceType": "Observation",
+			"id": "hr",
+			"status": "final",
+			"code": {"coding": [{"system": "http://loinc.org", "code": "8867-4", "display": "Heart rate"}]},
+			"valueQuantity": {"value": 72, "unit": "beats/minute", "code": "/min"}
+		}`), &obs))
+
+		assert.Equal(t, `Observation/hr status=final code=8867-4 "Heart rate" value=72 '/min'`, obs.String())
+	})
+
+	t.Run("empty fields are omitted", func(t *testing.T) {
+		id := "b1"
+		assert.Equal(t, "Patient", Patient{}.String())
+		assert.Equal(t, "Binary/b1", Binary{Id: &id}.String())
+	})
+
+	t.Run("go string", func(t *testing.T) {
+		id := "123"
+		patient := Patient{Id: &id}
+		assert.Equal(t, `r5.Patient({"resourceType":"Patient","id":"123"})`, fmt.Sprintf("%#v", patient))
+	})
+}
+
+// topLevelKeys returns the keys of a JSON object in the order they appear.
+func topLevelKeys(t *testing.T, data []byte) []string {
+	t.Helper()
+	dec := json.NewDecoder(bytes.NewReader(data))
+	_, err := dec.Token() // opening brace
+	require.NoError(t, err)
+
+	var keys []string
+	for dec.More() {
+		tok, err := dec.Token()
+		require.NoError(t, err)
+		keys = append(keys, tok.(string))
+		var skip json.RawMessage
+		require.NoError(t, dec.Decode(&skip))
+	}
+	return keys
+}
+
+func TestResourceJSONKeyOrder(t *testing.T) {
+	t.Run("resourceType, id and meta come first", func(t *testing.T) {
+		gender := AdministrativeGenderFemale
+		id := "p1"
+		versionID := "2"
+		active := true
+		patient := Patient{
+			Gender: &gender,
+			Active: &active,
+			Meta:   &Meta{VersionId: &versionID},
+			Id:     &id,
+		}
+
+		data, err := json.Marshal(patient)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"resourceType", "id", "meta", "active", "gender"}, topLevelKeys(t, data))
+	})
+
+	t.Run("contained resources and unknown fields", func(t *testing.T) {
+		input := []byte(`{"zzz": true, "status": "final", "code": {"text": "x"}, "id": "o1", "resourceType": "Observation",` +
+			`"contained": [{"name": [{"family": "Doe"}], "id": "p1", "resourceType": "Patient"}]}`)
+		resource, err := UnmarshalResourceWithOptions(input, UnmarshalOptions{PreserveUnknownFields: true})
+		require.NoError(t, err)
+
+		data, err := json.Marshal(resource)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"resourceType", "id", "contained", "status", "code", "zzz"}, topLevelKeys(t, data))
+
+		var obs struct {
+			Contained []json.RawMessage `json:"contained"`
+		}
+		require.NoError(t, json.Unmarshal(data, &obs))
+		require.Len(t, obs.Contained, 1)
+		assert.Equal(t, []string{"resourceType", "id", "name"}, topLevelKeys(t, obs.Contained[0]))
+	})
+}
+
+func TestZeroValuePrimitivesRoundTrip(t *testing.T) {
+	t.Run("false, 0 and empty string are serialized", func(t *testing.T) {
+		patient := Patient{
+			Active:               common.Bool(false),
+			DeceasedBoolean:      common.Bool(false),
+			MultipleBirthInteger: common.Int(0),
+			Name:                 []HumanName{{Family: common.String(""), Given: []string{""}}},
+		}
+
+		data, err := json.Marshal(patient)
+		require.NoError(t, err)
+
+		var raw map[string]interface{}
+		require.NoError(t, json.Unmarshal(data, &raw))
+		assert.Equal(t, false, raw["active"])
+		assert.Equal(t, false, raw["deceasedBoolean"])
+		assert.Equal(t, float64(0), raw["multipleBirthInteger"])
+		assert.Contains(t, string(data), `"name":[{"family":"","given":[""]}]`)
+
+		var decoded Patient
+		require.NoError(t, json.Unmarshal(data, &decoded))
+		require.NotNil(t, decoded.Active)
+		assert.False(t, *decoded.Active)
+		require.NotNil(t, decoded.DeceasedBoolean)
+		assert.False(t, *decoded.DeceasedBoolean)
+		require.NotNil(t, decoded.MultipleBirthInteger)
+		assert.Equal(t, 0, *decoded.MultipleBirthInteger)
+		require.Len(t, decoded.Name, 1)
+		require.NotNil(t, decoded.Name[0].Family)
+		assert.Equal(t, "", *decoded.Name[0].Family)
+		assert.Equal(t, []string{""}, decoded.Name[0].Given)
+	})
+
+	t.Run("nil pointers are omitted", func(t *testing.T) {
+		data, err := json.Marshal(Patient{Id: common.String("p1")})
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"resourceType": "Patient", "id": "p1"}`, string(data))
+
+		var decoded Patient
+		require.NoError(t, json.Unmarshal(data, &decoded))
+		assert.Nil(t, decoded.Active)
+		assert.Nil(t, decoded.MultipleBirthInteger)
+	})
+
+	t.Run("zero values survive a resource round trip", func(t *testing.T) {
+		input := []byte(`{"resourceType": "Observation", "status": "final", "code": {"text": ""},` +
+			`"valueQuantity": {"value": 0, "unit": ""}, "component": [` +
+			`{"code": {"text": "flag"}, "valueBoolean": false},` +
+			`{"code": {"text": "count"}, "valueInteger": 0},` +
+			`{"code": {"text": "note"}, "valueString": ""}]}`)
+
+		resource, err := UnmarshalResource(input)
+		require.NoError(t, err)
+
+		data, err := json.Marshal(resource)
+		require.NoError(t, err)
+		assert.JSONEq(t, string(input), string(data))
+	})
+}
+
+// canonicalKey is a generic function over canonical resources.
+func canonicalKey[T CanonicalResource](r T) string {
+	key := ""
+	if url := r.GetUrl(); url != nil {
+		key = *url
+	}
+	if version := r.GetVersion(); version != nil {
+		key += "|" + *version
+	}
+	return key
+}
+
+func TestResourceCategoryInterfaces(t *testing.T) {
+	url := "http://example.org/ValueSet/colors"
+	version := "1.0.0"
+	status := PublicationStatusActive
+	valueSet := &ValueSet{Url: &url, Version: &version, Status: &status}
+
+	assert.Equal(t, "http://example.org/ValueSet/colors|1.0.0", canonicalKey(valueSet))
+	require.NotNil(t, valueSet.GetStatus())
+	assert.Equal(t, PublicationStatusActive, *valueSet.GetStatus())
+
+	categorize := func(r Resource) string {
+		switch r.(type) {
+		case CanonicalResource:
+			return "canonical"
+		case DomainResource:
+			return "domain"
+		default:
+			return "resource"
+		}
+	}
+	assert.Equal(t, "canonical", categorize(valueSet))
+	assert.Equal(t, "canonical", categorize(&StructureDefinition{}))
+	assert.Equal(t, "domain", categorize(&Patient{}))
+	assert.Equal(t, "resource", categorize(&Bundle{}))
+	assert.Equal(t, "resource", categorize(&Parameters{}))
+}
@@ -2,6 +2,7 @@ package fhirpath
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/antlr4-go/antlr/v4"
 
@@ -25,7 +26,7 @@ func compile(expr string) (*Expression, error) {
 	}
 
 	// Create lexer
-	input := antlr.NewInputStream(expr)
+	input := antlr.NewInputStream(quoteEnvironmentConstants(expr))
 	lexer := grammar.NewfhirpathLexer(input)
 
 	// Set up error listener for lexer
@@ -58,3 +59,48 @@ func compile(expr string) (*Expression, error) {
 		tree:   tree.(*grammar.EntireExpressionContext),
 	}, nil
 }
+
+// quoteEnvironmentConstants delimits %vs-[name] and %ext-[name] constants with
+// backticks (%`vs-[name]`), since their dashes would otherwise be parsed as
+// subtractions. String literals and delimited names are left unchanged.
+func quoteEnvironmentConstants(expr string) string {
+	if !strings.Contains(expr, "%vs-") && !strings.Contains(expr, "%ext-") {
+		return expr
+	}
+
+	var sb strings.Builder
+	for i := 0; i < len(expr); i++ {
+		c := expr[i]
+		switch {
+		case c == '\'' || c == '`':
+			// Copy the literal or delimited identifier up to its closing quote
+			end := i + 1
+			for end < len(expr) && expr[end] != c {
+				if expr[end] == '\\' {
+					end++
+				}
+				end++
+			}
+			if end >= len(expr) {
+				end = len(expr) - 1
+			}
+			sb.WriteString(expr[i : end+1])
+			i = end
+		case c == '%' && (strings.HasPrefix(expr[i+1:], "vs-") || strings.HasPrefix(expr[i+1:], "ext-")):
+			end := i + 1
+			for end < len(expr) && isConstantNameByte(expr[end]) {
+				end++
+			}
+			sb.WriteString("%`" + expr[i+1:end] + "`")
+			i = end - 1
+		default:
+			sb.WriteByte(c)
+		}
+	}
+	return sb.String()
+}
+
+// isConstantNameByte reports whether c can be part of a %vs- or %ext- name.
+func isConstantNameByte(c byte) bool {
+	return c == '-' || c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
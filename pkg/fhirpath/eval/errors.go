@@ -27,6 +27,8 @@ const (
 	ErrInvalidOperation
 	// ErrInvalidExpression indicates an invalid expression.
 	ErrInvalidExpression
+	// ErrLimitExceeded indicates that an evaluation limit (collection size or recursion depth) was exceeded.
+	ErrLimitExceeded
 )
 
 // String returns the string representation of the error type.
@@ -52,6 +54,8 @@ func (t ErrorType) String() string {
 		return "InvalidOperationError"
 	case ErrInvalidExpression:
 		return "InvalidExpressionError"
+	case ErrLimitExceeded:
+		return "LimitExceededError"
 	default:
 		return "UnknownError"
 	}
@@ -80,7 +84,7 @@ func (e *EvalError) Error() string {
 		return fmt.Sprintf("%s at %d:%d: %s", e.Type, e.Position.Line, e.Position.Column, e.Message)
 	}
 	if e.Path != "" {
-		return fmt.Sprintf("%s in '%s': %s", e.Type, e.Path, e.Message)
+		return fmt.Sprintf("%s: %s at %s", e.Type, e.Message, e.Path)
 	}
 	return fmt.Sprintf("%s: %s", e.Type, e.Message)
 }
@@ -143,6 +147,11 @@ func FunctionNotFoundError(name string) *EvalError {
 	return NewEvalError(ErrFunctionNotFound, fmt.Sprintf("unknown function '%s'", name))
 }
 
+// FunctionNotAvailableError creates an error for a function that does not exist in a FHIR version.
+func FunctionNotAvailableError(name string, version FHIRVersion) *EvalError {
+	return NewEvalError(ErrFunctionNotFound, fmt.Sprintf("function '%s' is not available in FHIR %s", name, version))
+}
+
 // InvalidArgumentsError creates an invalid arguments error.
 func InvalidArgumentsError(funcName string, expected, actual int) *EvalError {
 	return NewEvalError(ErrInvalidArguments, fmt.Sprintf("function '%s' expects %d arguments, got %d", funcName, expected, actual))
@@ -162,3 +171,9 @@ func InvalidPathError(path string) *EvalError {
 func InvalidOperationError(op, leftType, rightType string) *EvalError {
 	return NewEvalError(ErrInvalidOperation, fmt.Sprintf("cannot apply '%s' to %s and %s", op, leftType, rightType))
 }
+
+// LimitExceededError creates an error for an evaluation limit that was exceeded
+// (e.g., "collection size 10001 exceeds maximum allowed 10000").
+func LimitExceededError(limit string, value, maxValue int) *EvalError {
+	return NewEvalError(ErrLimitExceeded, "%s %d exceeds maximum allowed %d", limit, value, maxValue)
+}
@@ -2,6 +2,7 @@ package eval
 
 import (
 	"context"
+	"errors"
 	"strconv"
 	"strings"
 
@@ -20,6 +21,21 @@ type FuncDef struct {
 	MinArgs int
 	MaxArgs int
 	Fn      FuncImpl
+	// Versions lists the FHIR versions the function is available in (all versions when empty)
+	Versions []FHIRVersion
+}
+
+// AvailableIn reports whether the function can be used with resources of the given FHIR version.
+func (d FuncDef) AvailableIn(version FHIRVersion) bool {
+	if len(d.Versions) == 0 {
+		return true
+	}
+	for _, v := range d.Versions {
+		if v == version {
+			return true
+		}
+	}
+	return false
 }
 
 // FuncRegistry is an interface for function lookup.
@@ -39,16 +55,30 @@ type Evaluator struct {
 	funcs FuncRegistry
 }
 
+// Default evaluation limits, used when a context has no limit set. They are
+// generous for real resources, but bound the work of untrusted expressions.
+const (
+	// DefaultMaxCollectionSize is the default maximum size of a collection.
+	DefaultMaxCollectionSize = 100000
+	// DefaultMaxRecursionDepth is the default maximum depth of descendants()
+	// and number of passes of repeat().
+	DefaultMaxRecursionDepth = 100
+)
+
 // Context holds the evaluation state.
 type Context struct {
 	root      types.Collection
 	this      types.Collection
 	index     int
-	total     types.Value
+	total     types.Collection
 	variables map[string]types.Collection
 	limits    map[string]int
 	goCtx     context.Context
 	resolver  Resolver
+	// fhirVersion selects version-specific type mappings (defaults to R4)
+	fhirVersion FHIRVersion
+	// roundingMode selects how round() resolves ties (defaults to half away from zero)
+	roundingMode RoundingMode
 }
 
 // NewContext creates a new evaluation context.
@@ -76,6 +106,15 @@ func NewContext(resource []byte) *Context {
 	}
 }
 
+// NewContextWithVersion creates a new evaluation context for a resource of the given
+// FHIR version, which selects the version's type mappings (e.g., integer64 in R5)
+// and the functions available to expressions.
+func NewContextWithVersion(resource []byte, version FHIRVersion) *Context {
+	ctx := NewContext(resource)
+	ctx.SetFHIRVersion(version)
+	return ctx
+}
+
 // SetLimit sets a limit value (e.g., maxDepth, maxCollectionSize).
 func (c *Context) SetLimit(name string, value int) {
 	if c.limits == nil {
@@ -115,6 +154,34 @@ func (c *Context) GetResolver() Resolver {
 	return c.resolver
 }
 
+// SetFHIRVersion sets the FHIR version of the resource being evaluated.
+func (c *Context) SetFHIRVersion(version FHIRVersion) {
+	c.fhirVersion = version
+}
+
+// FHIRVersion returns the FHIR version of the resource being evaluated.
+// Returns FHIRVersionR4 if no version has been set.
+func (c *Context) FHIRVersion() FHIRVersion {
+	if c.fhirVersion == "" {
+		return FHIRVersionR4
+	}
+	return c.fhirVersion
+}
+
+// SetRoundingMode sets how round() resolves ties.
+func (c *Context) SetRoundingMode(mode RoundingMode) {
+	c.roundingMode = mode
+}
+
+// RoundingMode returns how round() resolves ties.
+// Returns RoundHalfAwayFromZero, as the FHIRPath specification requires, if no mode has been set.
+func (c *Context) RoundingMode() RoundingMode {
+	if c.roundingMode == "" {
+		return RoundHalfAwayFromZero
+	}
+	return c.roundingMode
+}
+
 // CheckCancellation checks if the context has been canceled.
 func (c *Context) CheckCancellation() error {
 	if c.goCtx == nil {
@@ -128,13 +195,49 @@ func (c *Context) CheckCancellation() error {
 	}
 }
 
+// MaxCollectionSize returns the maximum size of the collections built during
+// evaluation: the "maxCollectionSize" limit, DefaultMaxCollectionSize if it is
+// not set, or 0 (no limit) if it is negative.
+func (c *Context) MaxCollectionSize() int {
+	return c.limitOrDefault("maxCollectionSize", DefaultMaxCollectionSize)
+}
+
+// MaxRecursionDepth returns the maximum depth of descendants() and number of
+// passes of repeat(): the "maxDepth" limit, DefaultMaxRecursionDepth if it is
+// not set, or 0 (no limit) if it is negative.
+func (c *Context) MaxRecursionDepth() int {
+	return c.limitOrDefault("maxDepth", DefaultMaxRecursionDepth)
+}
+
+// limitOrDefault returns the named limit, defaultValue if it is not set, or 0 if it is negative.
+func (c *Context) limitOrDefault(name string, defaultValue int) int {
+	value := c.GetLimit(name)
+	switch {
+	case value == 0:
+		return defaultValue
+	case value < 0:
+		return 0
+	default:
+		return value
+	}
+}
+
 // CheckCollectionSize validates that a collection doesn't exceed the maximum size.
-// Returns an error if the collection is too large.
+// Returns an ErrLimitExceeded error if the collection is too large.
 func (c *Context) CheckCollectionSize(col types.Collection) error {
-	maxSize := c.GetLimit("maxCollectionSize")
+	maxSize := c.MaxCollectionSize()
 	if maxSize > 0 && len(col) > maxSize {
-		return NewEvalError(ErrInvalidExpression,
-			"collection size %d exceeds maximum allowed %d", len(col), maxSize)
+		return LimitExceededError("collection size", len(col), maxSize)
+	}
+	return nil
+}
+
+// CheckRecursionDepth validates that depth doesn't exceed the maximum recursion depth.
+// Returns an ErrLimitExceeded error if the recursion is too deep.
+func (c *Context) CheckRecursionDepth(depth int) error {
+	maxDepth := c.MaxRecursionDepth()
+	if maxDepth > 0 && depth > maxDepth {
+		return LimitExceededError("recursion depth", depth, maxDepth)
 	}
 	return nil
 }
@@ -142,7 +245,7 @@ func (c *Context) CheckCollectionSize(col types.Collection) error {
 // EnforceCollectionLimit truncates a collection if it exceeds the maximum size.
 // Returns the (possibly truncated) collection and whether truncation occurred.
 func (c *Context) EnforceCollectionLimit(col types.Collection) (types.Collection, bool) {
-	maxSize := c.GetLimit("maxCollectionSize")
+	maxSize := c.MaxCollectionSize()
 	if maxSize > 0 && len(col) > maxSize {
 		return col[:maxSize], true
 	}
@@ -251,9 +354,34 @@ func (e *Evaluator) VisitExternalConstant(ctx *grammar.ExternalConstantContext)
 	if value, ok := e.ctx.GetVariable(name); ok {
 		return value
 	}
+	if url, ok := environmentConstant(name); ok {
+		return types.Collection{types.NewString(url)}
+	}
 	return NewEvalError(ErrInvalidPath, "undefined variable: %"+name)
 }
 
+// environmentConstant returns the URL of a FHIR environment constant that is
+// not a variable: %ucum, %sct and %loinc name their code systems,
+// %vs-[name] expands to http://hl7.org/fhir/ValueSet/[name] and %ext-[name] to
+// http://hl7.org/fhir/StructureDefinition/[name].
+func environmentConstant(name string) (string, bool) {
+	switch name {
+	case "ucum":
+		return "http://unitsofmeasure.org", true
+	case "sct":
+		return "http://snomed.info/sct", true
+	case "loinc":
+		return "http://loinc.org", true
+	}
+	if id, ok := strings.CutPrefix(name, "vs-"); ok && id != "" {
+		return "http://hl7.org/fhir/ValueSet/" + id, true
+	}
+	if id, ok := strings.CutPrefix(name, "ext-"); ok && id != "" {
+		return "http://hl7.org/fhir/StructureDefinition/" + id, true
+	}
+	return "", false
+}
+
 // Literal visitors
 
 // VisitNullLiteral visits a null literal {}.
@@ -349,7 +477,11 @@ func (e *Evaluator) VisitQuantityLiteral(ctx *grammar.QuantityLiteralContext) in
 // VisitMemberInvocation visits a member access.
 func (e *Evaluator) VisitMemberInvocation(ctx *grammar.MemberInvocationContext) interface{} {
 	name := stripBackticks(ctx.Identifier().GetText())
-	return e.navigateMember(e.ctx.This(), name)
+	result := e.navigateMember(e.ctx.This(), name)
+	if err := e.ctx.CheckCollectionSize(result); err != nil {
+		return err
+	}
+	return result
 }
 
 // VisitFunctionInvocation visits a function call.
@@ -362,6 +494,9 @@ func (e *Evaluator) VisitFunctionInvocation(ctx *grammar.FunctionInvocationConte
 	if !ok {
 		return FunctionNotFoundError(name)
 	}
+	if !fn.AvailableIn(e.ctx.FHIRVersion()) {
+		return FunctionNotAvailableError(name, e.ctx.FHIRVersion())
+	}
 
 	// Validate argument count
 	paramList := funcCtx.ParamList()
@@ -410,6 +545,14 @@ func (e *Evaluator) VisitFunctionInvocation(ctx *grammar.FunctionInvocationConte
 		if argCount > 0 {
 			return e.evaluateOfType(input, argExprs[0])
 		}
+	case "repeat":
+		if argCount > 0 {
+			return e.evaluateRepeat(input, argExprs[0])
+		}
+	case "aggregate":
+		if argCount > 0 {
+			return e.evaluateAggregate(input, argExprs)
+		}
 	case "iif":
 		// iif requires lazy evaluation - only evaluate the branch that matches
 		if argCount >= 2 {
@@ -432,6 +575,9 @@ func (e *Evaluator) VisitFunctionInvocation(ctx *grammar.FunctionInvocationConte
 	if err != nil {
 		return err
 	}
+	if err := e.ctx.CheckCollectionSize(result); err != nil {
+		return err
+	}
 	return result
 }
 
@@ -611,6 +757,124 @@ func (e *Evaluator) evaluateSelect(input types.Collection, projection grammar.IE
 	return result
 }
 
+// evaluateRepeat evaluates repeat() - applies the projection to the input, then
+// to its results, and so on, until no new items are found. $index is the zero-based
+// position of the item in the collection it is projected from: the input for the
+// first pass, then the new items found by the previous pass.
+func (e *Evaluator) evaluateRepeat(input types.Collection, projection grammar.IExpressionContext) interface{} {
+	result := types.Collection{}
+
+	// Check collection size limit
+	if err := e.ctx.CheckCollectionSize(input); err != nil {
+		return err
+	}
+
+	current := input
+	for pass := 0; !current.Empty(); pass++ {
+		// Each pass goes one level deeper
+		if err := e.ctx.CheckRecursionDepth(pass + 1); err != nil {
+			return err
+		}
+		next := types.Collection{}
+		for i, item := range current {
+			// Check for cancellation periodically
+			if i%100 == 0 {
+				if err := e.ctx.CheckCancellation(); err != nil {
+					return err
+				}
+			}
+
+			// Set $this to current item and $index to its position
+			oldThis := e.ctx.this
+			oldIndex := e.ctx.index
+			e.ctx.this = types.Collection{item}
+			e.ctx.index = i
+
+			// Evaluate the projection
+			projResult := e.Visit(projection)
+
+			// Restore context
+			e.ctx.this = oldThis
+			e.ctx.index = oldIndex
+
+			if err, ok := projResult.(error); ok {
+				return err
+			}
+
+			// Keep only new items, which are projected in the next pass
+			if col, ok := projResult.(types.Collection); ok {
+				for _, v := range col {
+					if !result.Contains(v) {
+						result = append(result, v)
+						next = append(next, v)
+					}
+				}
+			}
+		}
+
+		// Check if result is getting too large
+		if err := e.ctx.CheckCollectionSize(result); err != nil {
+			return err
+		}
+		current = next
+	}
+
+	return result
+}
+
+// evaluateAggregate evaluates aggregate(aggregator [, init]) - evaluates the
+// aggregator for each item with $total set to the result of the previous item
+// (init, or empty, for the first one) and returns the last result. $index is the
+// iteration number, which is also the position of the item in the input.
+func (e *Evaluator) evaluateAggregate(input types.Collection, argExprs []grammar.IExpressionContext) interface{} {
+	total := types.Collection{}
+	if len(argExprs) > 1 {
+		initResult := e.Visit(argExprs[1])
+		if err, ok := initResult.(error); ok {
+			return err
+		}
+		if col, ok := initResult.(types.Collection); ok {
+			total = col
+		}
+	}
+
+	for i, item := range input {
+		// Check for cancellation periodically
+		if i%100 == 0 {
+			if err := e.ctx.CheckCancellation(); err != nil {
+				return err
+			}
+		}
+
+		// Set $this to current item, $index and $total
+		oldThis := e.ctx.this
+		oldIndex := e.ctx.index
+		oldTotal := e.ctx.total
+		e.ctx.this = types.Collection{item}
+		e.ctx.index = i
+		e.ctx.total = total
+
+		// Evaluate the aggregator
+		aggResult := e.Visit(argExprs[0])
+
+		// Restore context
+		e.ctx.this = oldThis
+		e.ctx.index = oldIndex
+		e.ctx.total = oldTotal
+
+		if err, ok := aggResult.(error); ok {
+			return err
+		}
+
+		total = types.Collection{}
+		if col, ok := aggResult.(types.Collection); ok {
+			total = col
+		}
+	}
+
+	return total
+}
+
 // evaluateIsFunction evaluates is() function - checks if input is of specified type.
 // This handles is(Type) where Type is an identifier like Composition, Patient, etc.
 func (e *Evaluator) evaluateIsFunction(input types.Collection, typeExpr grammar.IExpressionContext) interface{} {
@@ -630,10 +894,7 @@ func (e *Evaluator) evaluateIsFunction(input types.Collection, typeExpr grammar.
 		return InvalidArgumentsError("is", 1, 0)
 	}
 
-	// Get actual type - Type() already returns resourceType for ObjectValue
-	actualType := input[0].Type()
-
-	matches := TypeMatches(actualType, typeName)
+	matches := ValueMatchesType(e.ctx.FHIRVersion(), input[0], typeName)
 	return types.Collection{types.NewBoolean(matches)}
 }
 
@@ -656,10 +917,7 @@ func (e *Evaluator) evaluateAsFunction(input types.Collection, typeExpr grammar.
 		return InvalidArgumentsError("as", 1, 0)
 	}
 
-	// Get actual type - Type() already returns resourceType for ObjectValue
-	actualType := input[0].Type()
-
-	if TypeMatches(actualType, typeName) {
+	if ValueMatchesType(e.ctx.FHIRVersion(), input[0], typeName) {
 		return input
 	}
 
@@ -693,15 +951,7 @@ func (e *Evaluator) evaluateOfType(input types.Collection, typeExpr grammar.IExp
 
 	result := types.Collection{}
 	for _, item := range input {
-		actualType := item.Type()
-
-		// For ObjectValue, also check if it's a FHIR type matching the request
-		if obj, ok := item.(*types.ObjectValue); ok {
-			// Try to get more specific type information
-			actualType = obj.Type()
-		}
-
-		if TypeMatches(actualType, typeName) {
+		if ValueMatchesType(e.ctx.FHIRVersion(), item, typeName) {
 			result = append(result, item)
 		}
 	}
@@ -773,7 +1023,7 @@ func (e *Evaluator) VisitIndexInvocation(ctx *grammar.IndexInvocationContext) in
 // VisitTotalInvocation visits $total.
 func (e *Evaluator) VisitTotalInvocation(ctx *grammar.TotalInvocationContext) interface{} {
 	if e.ctx.total != nil {
-		return types.Collection{e.ctx.total}
+		return e.ctx.total
 	}
 	return types.Collection{}
 }
@@ -794,8 +1044,31 @@ func (e *Evaluator) VisitInvocationExpression(ctx *grammar.InvocationExpressionC
 	e.ctx.this = baseCol
 	defer func() { e.ctx.this = oldThis }()
 
-	// Evaluate the invocation
-	return e.Visit(ctx.Invocation())
+	// Evaluate the invocation, reporting the base expression of a function that
+	// expected a single value (e.g., "Patient.name" for "Patient.name.single()")
+	return withSingletonPath(e.Visit(ctx.Invocation()), ctx.Expression().GetText())
+}
+
+// withSingletonPath sets path on a singleton error result that has no path yet,
+// so that errors raised deep in an expression keep their innermost path.
+func withSingletonPath(result interface{}, path string) interface{} {
+	var evalErr *EvalError
+	if err, ok := result.(error); ok && errors.As(err, &evalErr) && evalErr.Type == ErrSingletonExpected && evalErr.Path == "" {
+		evalErr.WithPath(path)
+	}
+	return result
+}
+
+// singletonOperandsError returns a singleton error for the first operand of a
+// binary operator that does not evaluate to a single value, with its path.
+func singletonOperandsError(left, right grammar.IExpressionContext, leftCol, rightCol types.Collection) error {
+	if len(leftCol) != 1 {
+		return SingletonError(len(leftCol)).WithPath(left.GetText())
+	}
+	if len(rightCol) != 1 {
+		return SingletonError(len(rightCol)).WithPath(right.GetText())
+	}
+	return nil
 }
 
 // VisitIndexerExpression visits expr[index].
@@ -842,7 +1115,7 @@ func (e *Evaluator) VisitPolarityExpression(ctx *grammar.PolarityExpressionConte
 		return col
 	}
 	if len(col) != 1 {
-		return SingletonError(len(col))
+		return SingletonError(len(col)).WithPath(ctx.Expression().GetText())
 	}
 
 	// Check if it's negation
@@ -877,8 +1150,8 @@ func (e *Evaluator) VisitMultiplicativeExpression(ctx *grammar.MultiplicativeExp
 	}
 
 	// Singleton check
-	if len(leftCol) != 1 || len(rightCol) != 1 {
-		return SingletonError(len(leftCol) + len(rightCol))
+	if err := singletonOperandsError(ctx.Expression(0), ctx.Expression(1), leftCol, rightCol); err != nil {
+		return err
 	}
 
 	op := ctx.GetChild(1).(antlr.TerminalNode).GetText()
@@ -930,8 +1203,8 @@ func (e *Evaluator) VisitAdditiveExpression(ctx *grammar.AdditiveExpressionConte
 	}
 
 	// Singleton check
-	if len(leftCol) != 1 || len(rightCol) != 1 {
-		return SingletonError(len(leftCol) + len(rightCol))
+	if err := singletonOperandsError(ctx.Expression(0), ctx.Expression(1), leftCol, rightCol); err != nil {
+		return err
 	}
 
 	var result types.Value
@@ -987,8 +1260,8 @@ func (e *Evaluator) VisitInequalityExpression(ctx *grammar.InequalityExpressionC
 	}
 
 	// Singleton check
-	if len(leftCol) != 1 || len(rightCol) != 1 {
-		return SingletonError(len(leftCol) + len(rightCol))
+	if err := singletonOperandsError(ctx.Expression(0), ctx.Expression(1), leftCol, rightCol); err != nil {
+		return err
 	}
 
 	op := ctx.GetChild(1).(antlr.TerminalNode).GetText()
@@ -1147,16 +1420,14 @@ func (e *Evaluator) VisitTypeExpression(ctx *grammar.TypeExpressionContext) inte
 	}
 
 	if len(leftCol) != 1 {
-		return SingletonError(len(leftCol))
+		return SingletonError(len(leftCol)).WithPath(ctx.Expression().GetText())
 	}
 
-	actualType := leftCol[0].Type()
-
 	switch op {
 	case "is":
-		return types.Collection{types.NewBoolean(TypeMatches(actualType, typeName))}
+		return types.Collection{types.NewBoolean(ValueMatchesType(e.ctx.FHIRVersion(), leftCol[0], typeName))}
 	case "as":
-		if TypeMatches(actualType, typeName) {
+		if ValueMatchesType(e.ctx.FHIRVersion(), leftCol[0], typeName) {
 			return leftCol
 		}
 		return types.Collection{}
@@ -1224,11 +1495,11 @@ func isPossibleResourceType(typeName string) bool {
 
 	// Primitive types are not resources
 	primitiveTypes := map[string]bool{
-		"Boolean": true, "String": true, "Integer": true, "Decimal": true,
+		"Boolean": true, "String": true, "Integer": true, "Integer64": true, "Decimal": true,
 		"Date": true, "DateTime": true, "Time": true, "Quantity": true,
 		"Object": true,
 	}
-	if primitiveTypes[typeName] {
+	if primitiveTypes[typeName] || complexDatatypes[typeName] {
 		return false
 	}
 
@@ -1236,10 +1507,146 @@ func isPossibleResourceType(typeName string) bool {
 	return typeName[0] >= 'A' && typeName[0] <= 'Z'
 }
 
-// TypeMatches checks if actualType matches the requested typeName.
-// Handles case-insensitive comparison and FHIR type aliases.
-// This function is exported for use by the is() function implementation.
+// complexDatatypes lists the FHIR complex datatypes of all supported versions.
+// They are Elements, not resources, although their names are PascalCase too.
+var complexDatatypes = map[string]bool{
+	"Address": true, "Age": true, "Annotation": true, "Attachment": true, "Availability": true,
+	"BackboneElement": true, "BackboneType": true, "CodeableConcept": true, "CodeableReference": true,
+	"Coding": true, "ContactDetail": true, "ContactPoint": true, "Contributor": true, "Count": true,
+	"DataRequirement": true, "Distance": true, "Dosage": true, "Duration": true, "Element": true,
+	"ElementDefinition": true, "Expression": true, "ExtendedContactDetail": true, "Extension": true,
+	"HumanName": true, "Identifier": true, "MarketingStatus": true, "Meta": true, "MonetaryComponent": true,
+	"Money": true, "MoneyQuantity": true, "Narrative": true, "ParameterDefinition": true, "Period": true,
+	"Population": true, "ProdCharacteristic": true, "ProductShelfLife": true, "Range": true, "Ratio": true,
+	"RatioRange": true, "Reference": true, "RelatedArtifact": true, "SampledData": true, "Signature": true,
+	"SimpleQuantity": true, "SubstanceAmount": true, "Timing": true, "TriggerDefinition": true,
+	"UsageContext": true, "VirtualServiceDetail": true,
+}
+
+// ValueMatchesType checks if value is of the requested type, as used by is, as and ofType.
+//
+// Besides the name-based matching of TypeMatchesVersion, it handles the abstract FHIR
+// types Element and BackboneElement, which no value reports as its own type: every
+// complex value that is not a resource is an Element, and complex values that are
+// neither resources nor a recognized datatype are taken to be BackboneElements.
+// Primitive values are System types here and are not Elements. Quantities whose
+// FHIR datatype is known (e.g. from an onsetAge choice element) only match their
+// own Quantity subtype: an Age is a Quantity but not a Duration.
+func ValueMatchesType(version FHIRVersion, value types.Value, typeName string) bool {
+	name := strings.TrimPrefix(strings.ToLower(typeName), "fhir.")
+	switch name {
+	case "element":
+		obj, ok := value.(*types.ObjectValue)
+		return ok && !isPossibleResourceType(obj.Type())
+	case "backboneelement":
+		obj, ok := value.(*types.ObjectValue)
+		return ok && obj.Type() == "Object"
+	case "age", "count", "distance", "duration":
+		// A Quantity of known datatype only matches its own subtype
+		if datatype := quantityDatatype(value); datatype != "" {
+			return strings.EqualFold(datatype, name)
+		}
+	}
+	return TypeMatchesVersion(version, value.Type(), typeName)
+}
+
+// quantityDatatype returns the FHIR datatype a Quantity value came from, or
+// empty when the value is not a Quantity or its datatype is unknown.
+func quantityDatatype(value types.Value) string {
+	switch v := value.(type) {
+	case types.Quantity:
+		return v.Datatype()
+	case *types.ObjectValue:
+		if q, ok := v.ToQuantity(); ok {
+			return q.Datatype()
+		}
+	}
+	return ""
+}
+
+// FHIRVersion identifies the FHIR release a resource belongs to.
+type FHIRVersion string
+
+// Supported FHIR versions.
+const (
+	FHIRVersionR4  FHIRVersion = "R4"
+	FHIRVersionR4B FHIRVersion = "R4B"
+	FHIRVersionR5  FHIRVersion = "R5"
+)
+
+// RoundingMode selects how round() resolves ties.
+type RoundingMode string
+
+// Supported rounding modes.
+const (
+	// RoundHalfAwayFromZero rounds ties away from zero (2.5 -> 3, -2.5 -> -3), per the FHIRPath specification.
+	RoundHalfAwayFromZero RoundingMode = "half-away-from-zero"
+	// RoundHalfEven rounds ties to the even neighbor (2.5 -> 2, 3.5 -> 4), also known as banker's rounding.
+	RoundHalfEven RoundingMode = "half-even"
+)
+
+// r4PrimitiveTypes maps FHIR primitive and Quantity-derived types (lowercase) to
+// their FHIRPath System types for R4 and R4B.
+var r4PrimitiveTypes = map[string]string{
+	"boolean":        "Boolean",
+	"string":         "String",
+	"integer":        "Integer",
+	"decimal":        "Decimal",
+	"date":           "Date",
+	"datetime":       "DateTime",
+	"time":           "Time",
+	"instant":        "DateTime",
+	"uri":            "String",
+	"url":            "String",
+	"canonical":      "String",
+	"base64binary":   "String",
+	"code":           "String",
+	"id":             "String",
+	"markdown":       "String",
+	"oid":            "String",
+	"uuid":           "String",
+	"positiveint":    "Integer",
+	"unsignedint":    "Integer",
+	"quantity":       "Quantity",
+	"simplequantity": "Quantity",
+	"age":            "Quantity",
+	"count":          "Quantity",
+	"distance":       "Quantity",
+	"duration":       "Quantity",
+	"money":          "Quantity",
+}
+
+// r5PrimitiveTypes extends the R4 mappings with the primitive types added in R5.
+var r5PrimitiveTypes = func() map[string]string {
+	m := make(map[string]string, len(r4PrimitiveTypes)+1)
+	for k, v := range r4PrimitiveTypes {
+		m[k] = v
+	}
+	m["integer64"] = "Integer"
+	return m
+}()
+
+// primitiveTypeMappings returns the FHIR to FHIRPath type mappings for a FHIR version.
+func primitiveTypeMappings(version FHIRVersion) map[string]string {
+	if version == FHIRVersionR5 {
+		return r5PrimitiveTypes
+	}
+	return r4PrimitiveTypes
+}
+
+// TypeMatches checks if actualType matches the requested typeName without
+// regard to the FHIR version: the primitive types of every supported version
+// are mapped, so integer64 matches Integer. See TypeMatchesVersion to apply
+// the mappings of a single FHIR version.
 func TypeMatches(actualType, typeName string) bool {
+	return TypeMatchesVersion(FHIRVersionR5, actualType, typeName)
+}
+
+// TypeMatchesVersion checks if actualType matches the requested typeName.
+// Handles case-insensitive comparison and FHIR type aliases; FHIR primitive
+// types are mapped to FHIRPath types as defined by the given FHIR version
+// (e.g. integer64 only exists in R5).
+func TypeMatchesVersion(version FHIRVersion, actualType, typeName string) bool {
 	// Direct match
 	if actualType == typeName {
 		return true
@@ -1260,35 +1667,7 @@ func TypeMatches(actualType, typeName string) bool {
 	}
 
 	// FHIR primitive type mappings (FHIR uses lowercase, FHIRPath uses PascalCase)
-	fhirToFHIRPath := map[string]string{
-		"boolean":        "Boolean",
-		"string":         "String",
-		"integer":        "Integer",
-		"decimal":        "Decimal",
-		"date":           "Date",
-		"datetime":       "DateTime",
-		"time":           "Time",
-		"instant":        "DateTime",
-		"uri":            "String",
-		"url":            "String",
-		"canonical":      "String",
-		"base64binary":   "String",
-		"code":           "String",
-		"id":             "String",
-		"markdown":       "String",
-		"oid":            "String",
-		"uuid":           "String",
-		"positiveint":    "Integer",
-		"unsignedint":    "Integer",
-		"integer64":      "Integer",
-		"quantity":       "Quantity",
-		"simplequantity": "Quantity",
-		"age":            "Quantity",
-		"count":          "Quantity",
-		"distance":       "Quantity",
-		"duration":       "Quantity",
-		"money":          "Quantity",
-	}
+	fhirToFHIRPath := primitiveTypeMappings(version)
 
 	// Check if requesting a FHIR type that maps to a FHIRPath type
 	if fhirPathType, ok := fhirToFHIRPath[typeNameLower]; ok {
@@ -1342,11 +1721,20 @@ var polymorphicTypeSuffixes = []string{
 	"ParameterDefinition", "RelatedArtifact", "TriggerDefinition", "UsageContext",
 }
 
+// quantityDatatypes are Quantity and the FHIR datatypes derived from it. Values
+// of choice elements of these types keep their type so that is(Age) can tell an
+// Age from a Duration or a plain Quantity.
+var quantityDatatypes = map[string]bool{
+	"Quantity": true, "Age": true, "Count": true, "Distance": true, "Duration": true,
+}
+
 // navigateMember navigates to a member of objects in the collection.
 // Supports FHIR polymorphic elements (value[x] pattern) by automatically
 // resolving element names like "value" to their typed variants.
+// The members of each object are flattened one level into the result, so
+// name.given over several names is a single collection of strings.
 func (e *Evaluator) navigateMember(input types.Collection, name string) types.Collection {
-	result := types.Collection{}
+	members := make([]types.Collection, 0, len(input))
 
 	for _, item := range input {
 		obj, ok := item.(*types.ObjectValue)
@@ -1357,25 +1745,30 @@ func (e *Evaluator) navigateMember(input types.Collection, name string) types.Co
 		// Check if name matches resourceType (for FHIR resources)
 		// Uses IsSubtypeOf to handle Resource and DomainResource base types
 		if IsSubtypeOf(obj.Type(), name) {
-			result = append(result, obj)
+			members = append(members, types.Collection{obj})
 			continue
 		}
 
 		// Try direct field access first
 		children := obj.GetCollection(name)
 		if len(children) > 0 {
-			result = append(result, children...)
+			if strings.HasSuffix(name, types.TypeNameInteger64) {
+				children = parseInteger64Values(children)
+			}
+			if typeName := choiceQuantityType(name); typeName != "" {
+				children = withDeclaredType(children, typeName)
+			}
+			members = append(members, children)
 			continue
 		}
 
 		// If direct access failed, try polymorphic element resolution
 		// This handles FHIR's value[x] pattern where "value" can resolve to
 		// "valueQuantity", "valueString", "valueCodeableConcept", etc.
-		polymorphicChildren := e.resolvePolymorphicField(obj, name)
-		result = append(result, polymorphicChildren...)
+		members = append(members, e.resolvePolymorphicField(obj, name))
 	}
 
-	return result
+	return types.Collection{}.Flatten(members...)
 }
 
 // resolvePolymorphicField attempts to resolve a polymorphic FHIR element.
@@ -1388,6 +1781,12 @@ func (e *Evaluator) resolvePolymorphicField(obj *types.ObjectValue, name string)
 		fieldName := name + suffix
 		children := obj.GetCollection(fieldName)
 		if len(children) > 0 {
+			if suffix == types.TypeNameInteger64 {
+				children = parseInteger64Values(children)
+			}
+			if quantityDatatypes[suffix] {
+				children = withDeclaredType(children, suffix)
+			}
 			result = append(result, children...)
 			// Return on first match - polymorphic elements have only one variant
 			return result
@@ -1397,6 +1796,44 @@ func (e *Evaluator) resolvePolymorphicField(obj *types.ObjectValue, name string)
 	return result
 }
 
+// choiceQuantityType returns the Quantity datatype a choice element name such as
+// "onsetAge" is declared with, or "" if name has no such type suffix.
+func choiceQuantityType(name string) string {
+	for typeName := range quantityDatatypes {
+		if len(name) > len(typeName) && strings.HasSuffix(name, typeName) {
+			return typeName
+		}
+	}
+	return ""
+}
+
+// withDeclaredType tags the objects in values with their declared FHIR type.
+func withDeclaredType(values types.Collection, typeName string) types.Collection {
+	result := make(types.Collection, len(values))
+	for i, v := range values {
+		result[i] = v
+		if obj, ok := v.(*types.ObjectValue); ok {
+			result[i] = obj.WithType(typeName)
+		}
+	}
+	return result
+}
+
+// parseInteger64Values converts the string values of an integer64 element into
+// Integer64 values. FHIR JSON encodes integer64 as a string to preserve precision.
+func parseInteger64Values(values types.Collection) types.Collection {
+	result := make(types.Collection, len(values))
+	for i, v := range values {
+		result[i] = v
+		if s, ok := v.(types.String); ok {
+			if i64, err := types.ParseInteger64(s.Value()); err == nil {
+				result[i] = i64
+			}
+		}
+	}
+	return result
+}
+
 // unquoteString removes quotes and handles escape sequences.
 func unquoteString(s string) string {
 	if len(s) < 2 {
@@ -1,6 +1,8 @@
 package eval
 
 import (
+	"errors"
+	"math"
 	"testing"
 
 	"github.com/robertoaraneda/gofhir/pkg/fhirpath/types"
@@ -55,6 +57,7 @@ func TestErrors(t *testing.T) {
 			{ErrTimeout, "TimeoutError"},
 			{ErrInvalidOperation, "InvalidOperationError"},
 			{ErrInvalidExpression, "InvalidExpressionError"},
+			{ErrLimitExceeded, "LimitExceededError"},
 		}
 
 		for _, tt := range tests {
@@ -116,6 +119,9 @@ func TestErrors(t *testing.T) {
 		if err.Path != "Patient.name" {
 			t.Error("expected path to be set")
 		}
+		if err.Error() != "TypeError: test message at Patient.name" {
+			t.Errorf("unexpected error message: %s", err.Error())
+		}
 
 		err = err.WithPosition(10, 5)
 		if err.Position.Line != 10 || err.Position.Column != 5 {
@@ -576,6 +582,70 @@ func TestMixedTypeArithmetic(t *testing.T) {
 	})
 }
 
+func TestInteger64Arithmetic(t *testing.T) {
+	big := types.NewInteger64(math.MaxInt64 - 1)
+
+	t.Run("integer operands are widened", func(t *testing.T) {
+		result, err := Add(big, types.NewInteger(1))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if result.Type() != "Integer64" || result.(types.Integer64).Value() != math.MaxInt64 {
+			t.Errorf("expected Integer64 %d, got %s %v", int64(math.MaxInt64), result.Type(), result)
+		}
+	})
+
+	t.Run("overflow is an error", func(t *testing.T) {
+		ops := map[string]func(types.Value, types.Value) (types.Value, error){
+			"+": Add,
+			"*": Multiply,
+		}
+		for op, fn := range ops {
+			if _, err := fn(types.NewInteger(2), big); !errors.Is(err, types.ErrIntegerOverflow) {
+				t.Errorf("2 %s %d: expected overflow error, got %v", op, big.Value(), err)
+			}
+		}
+		if _, err := Subtract(types.NewInteger64(math.MinInt64), types.NewInteger(1)); !errors.Is(err, types.ErrIntegerOverflow) {
+			t.Errorf("expected overflow error, got %v", err)
+		}
+	})
+
+	t.Run("decimal operands", func(t *testing.T) {
+		result, err := Add(types.NewInteger64(1), types.NewDecimalFromFloat(0.5))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if result.Type() != "Decimal" || result.String() != "1.5" {
+			t.Errorf("expected Decimal 1.5, got %s %v", result.Type(), result)
+		}
+		result, err = Divide(types.NewInteger64(1), types.NewInteger(4))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if result.String() != "0.25" {
+			t.Errorf("expected 0.25, got %v", result)
+		}
+	})
+
+	t.Run("div and mod", func(t *testing.T) {
+		result, err := IntegerDivide(big, types.NewInteger(2))
+		if err != nil || result.(types.Integer64).Value() != (math.MaxInt64-1)/2 {
+			t.Errorf("div = %v, %v", result, err)
+		}
+		result, err = Modulo(big, types.NewInteger64(10))
+		if err != nil || result.(types.Integer64).Value() != (math.MaxInt64-1)%10 {
+			t.Errorf("mod = %v, %v", result, err)
+		}
+	})
+
+	t.Run("comparison", func(t *testing.T) {
+		cmp, err := Compare(types.NewInteger(5), big)
+		if err != nil || cmp != -1 {
+			t.Errorf("Compare() = %d, %v; want -1", cmp, err)
+		}
+	})
+}
+
 func TestOperatorErrors(t *testing.T) {
 	t.Run("add type errors", func(t *testing.T) {
 		_, err := Add(types.NewBoolean(true), types.NewInteger(5))
@@ -1221,6 +1291,33 @@ func TestTypeMatches(t *testing.T) {
 	}
 }
 
+func TestTypeMatchesVersion(t *testing.T) {
+	tests := []struct {
+		name       string
+		version    FHIRVersion
+		actualType string
+		typeName   string
+		expected   bool
+	}{
+		{"R4 integer", FHIRVersionR4, "Integer", "integer", true},
+		{"R4 rejects integer64", FHIRVersionR4, "Integer", "integer64", false},
+		{"R4B rejects integer64", FHIRVersionR4B, "Integer", "integer64", false},
+		{"R5 integer64", FHIRVersionR5, "Integer", "integer64", true},
+		{"R5 integer64 reverse", FHIRVersionR5, "integer64", "Integer", true},
+		{"R5 keeps R4 mappings", FHIRVersionR5, "String", "code", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := TypeMatchesVersion(tt.version, tt.actualType, tt.typeName)
+			if result != tt.expected {
+				t.Errorf("TypeMatchesVersion(%s, %q, %q) = %v, expected %v",
+					tt.version, tt.actualType, tt.typeName, result, tt.expected)
+			}
+		})
+	}
+}
+
 func TestIsSubtypeOf(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -1436,6 +1533,67 @@ func TestDateTimeArithmetic(t *testing.T) {
 	}
 }
 
+func TestTimeArithmetic(t *testing.T) {
+	tests := []struct {
+		name     string
+		time     string
+		value    int
+		unit     string
+		expected string
+		subtract bool
+	}{
+		{"time plus 30 minutes", "10:00:00", 30, "minutes", "10:30:00", false},
+		{"time plus 1 hour", "10:15", 1, "hour", "11:15", false},
+		{"time plus 45 seconds", "10:00:30", 45, "seconds", "10:01:15", false},
+		{"time plus 250 milliseconds", "10:00:00.900", 250, "milliseconds", "10:00:01.150", false},
+		{"time minus 90 minutes", "10:00:00", 90, "minutes", "08:30:00", true},
+
+		// Time has no date, so arithmetic wraps around midnight
+		{"time plus hours crossing midnight", "23:00", 2, "hours", "01:00", false},
+		{"time minus hours crossing midnight", "01:00", 2, "hours", "23:00", true},
+		{"time plus a whole day", "10:00:00", 24, "hours", "10:00:00", false},
+
+		// The result keeps the precision of the time
+		{"time plus seconds at minute precision", "10:00", 90, "seconds", "10:01", false},
+
+		// Calendar units are not supported for a time
+		{"time plus 1 day", "10:00:00", 1, "day", "10:00:00", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tm, err := types.NewTime(tt.time)
+			if err != nil {
+				t.Fatalf("failed to create time: %v", err)
+			}
+
+			quantity := types.NewQuantityFromDecimal(
+				types.NewDecimalFromInt(int64(tt.value)).Value(),
+				tt.unit,
+			)
+
+			var result types.Value
+			if tt.subtract {
+				result, err = Subtract(tm, quantity)
+			} else {
+				result, err = Add(tm, quantity)
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			resultTime, ok := result.(types.Time)
+			if !ok {
+				t.Fatalf("expected Time, got %T", result)
+			}
+
+			if resultTime.String() != tt.expected {
+				t.Errorf("expected %s, got %s", tt.expected, resultTime.String())
+			}
+		})
+	}
+}
+
 func TestQuantityArithmetic(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -1594,3 +1752,142 @@ func TestQuantityComparison(t *testing.T) {
 		})
 	}
 }
+
+func TestQuantityComparatorComparison(t *testing.T) {
+	tests := []struct {
+		name    string
+		q1      types.Quantity
+		q2      types.Quantity
+		op      string
+		want    bool
+		wantNil bool // indeterminate comparison returns empty
+	}{
+		{"<5 mg < 5 mg", quantityWithComparator(5, "mg", "<"), quantityWithComparator(5, "mg", ""), "<", true, false},
+		{"<5 mg >= 5 mg", quantityWithComparator(5, "mg", "<"), quantityWithComparator(5, "mg", ""), ">=", false, false},
+		{"5 mg > <5 mg", quantityWithComparator(5, "mg", ""), quantityWithComparator(5, "mg", "<"), ">", true, false},
+		{">10 mg > 5 mg", quantityWithComparator(10, "mg", ">"), quantityWithComparator(5, "mg", ""), ">", true, false},
+		{"<5 mg < >=5 mg", quantityWithComparator(5, "mg", "<"), quantityWithComparator(5, "mg", ">="), "<", true, false},
+		{"<5 mg < 1 g (UCUM)", quantityWithComparator(5, "mg", "<"), quantityWithComparator(1, "g", ""), "<", true, false},
+
+		// Indeterminate: the comparator ranges overlap
+		{"<5 mg < 3 mg", quantityWithComparator(5, "mg", "<"), quantityWithComparator(3, "mg", ""), "<", false, true},
+		{"<=5 mg < 5 mg", quantityWithComparator(5, "mg", "<="), quantityWithComparator(5, "mg", ""), "<", false, true},
+		{">=5 mg > 5 mg", quantityWithComparator(5, "mg", ">="), quantityWithComparator(5, "mg", ""), ">", false, true},
+		{"<5 mg > <10 mg", quantityWithComparator(5, "mg", "<"), quantityWithComparator(10, "mg", "<"), ">", false, true},
+		{">5 mg <= <10 mg", quantityWithComparator(5, "mg", ">"), quantityWithComparator(10, "mg", "<"), "<=", false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var result types.Collection
+			var err error
+
+			switch tt.op {
+			case ">":
+				result, err = GreaterThan(tt.q1, tt.q2)
+			case "<":
+				result, err = LessThan(tt.q1, tt.q2)
+			case ">=":
+				result, err = GreaterOrEqual(tt.q1, tt.q2)
+			case "<=":
+				result, err = LessOrEqual(tt.q1, tt.q2)
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if tt.wantNil {
+				if !result.Empty() {
+					t.Errorf("expected empty collection, got %v", result)
+				}
+				return
+			}
+			if result.Empty() {
+				t.Fatalf("expected result, got empty collection")
+			}
+			if result[0].(types.Boolean).Bool() != tt.want {
+				t.Errorf("expected %v, got %v", tt.want, result[0])
+			}
+		})
+	}
+}
+
+func TestQuantityComparatorEquality(t *testing.T) {
+	tests := []struct {
+		name           string
+		q1             types.Quantity
+		q2             types.Quantity
+		wantEqual      []bool // empty when = is indeterminate
+		wantEquivalent bool
+	}{
+		{"5 mg and 5 mg", quantityWithComparator(5, "mg", ""), quantityWithComparator(5, "mg", ""), []bool{true}, true},
+		{"<5 mg and 5 mg", quantityWithComparator(5, "mg", "<"), quantityWithComparator(5, "mg", ""), nil, false},
+		{"5 mg and >=5 mg", quantityWithComparator(5, "mg", ""), quantityWithComparator(5, "mg", ">="), nil, false},
+		{"<5 mg and <5 mg", quantityWithComparator(5, "mg", "<"), quantityWithComparator(5, "mg", "<"), nil, false},
+		{"<5 mg and 5000 ug", quantityWithComparator(5, "mg", "<"), quantityWithComparator(5000, "ug", ""), nil, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := Equal(types.Collection{tt.q1}, types.Collection{tt.q2})
+			if len(result) != len(tt.wantEqual) {
+				t.Fatalf("= returned %v, want %v", result, tt.wantEqual)
+			}
+			if len(result) == 1 && result[0].(types.Boolean).Bool() != tt.wantEqual[0] {
+				t.Errorf("= returned %v, want %v", result[0], tt.wantEqual[0])
+			}
+			if empty := NotEqual(types.Collection{tt.q1}, types.Collection{tt.q2}).Empty(); empty != (tt.wantEqual == nil) {
+				t.Errorf("!= empty = %v, want it empty exactly when = is empty", empty)
+			}
+
+			result = Equivalent(types.Collection{tt.q1}, types.Collection{tt.q2})
+			if result[0].(types.Boolean).Bool() != tt.wantEquivalent {
+				t.Errorf("~ returned %v, want %v", result[0], tt.wantEquivalent)
+			}
+		})
+	}
+}
+
+func TestQuantityComparatorFromObject(t *testing.T) {
+	obj := types.NewObjectValue([]byte(`{"value": 5, "comparator": "<", "unit": "mg"}`))
+	five := quantityWithComparator(5, "mg", "")
+	three := quantityWithComparator(3, "mg", "")
+
+	result, err := LessThan(obj, five)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Empty() || !result[0].(types.Boolean).Bool() {
+		t.Errorf("expected <5 mg < 5 mg to be true, got %v", result)
+	}
+
+	result, err = GreaterThan(three, obj)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !result.Empty() {
+		t.Errorf("expected 3 mg > <5 mg to be empty, got %v", result)
+	}
+}
+
+func quantityWithComparator(value int64, unit, comparator string) types.Quantity {
+	return types.NewQuantityWithComparator(types.NewDecimalFromInt(value).Value(), unit, comparator)
+}
+
+func TestFuncDefAvailableIn(t *testing.T) {
+	all := FuncDef{Name: "first"}
+	r5Only := FuncDef{Name: "comparable", Versions: []FHIRVersion{FHIRVersionR5}}
+
+	for _, version := range []FHIRVersion{FHIRVersionR4, FHIRVersionR4B, FHIRVersionR5} {
+		if !all.AvailableIn(version) {
+			t.Errorf("function without versions should be available in %s", version)
+		}
+		if got, want := r5Only.AvailableIn(version), version == FHIRVersionR5; got != want {
+			t.Errorf("r5Only.AvailableIn(%s) = %v, want %v", version, got, want)
+		}
+	}
+
+	if got := NewContextWithVersion([]byte(`{}`), FHIRVersionR4B).FHIRVersion(); got != FHIRVersionR4B {
+		t.Errorf("NewContextWithVersion().FHIRVersion() = %s, want R4B", got)
+	}
+}
@@ -1,6 +1,8 @@
 package eval
 
 import (
+	"errors"
+
 	"github.com/robertoaraneda/gofhir/pkg/fhirpath/types"
 )
 
@@ -8,6 +10,9 @@ import (
 
 // Add performs addition on two values.
 func Add(left, right types.Value) (types.Value, error) {
+	if l, r, ok := integer64Operands(left, right); ok {
+		return l.Add(r)
+	}
 	switch l := left.(type) {
 	case types.Integer:
 		switch r := right.(type) {
@@ -16,10 +21,16 @@ func Add(left, right types.Value) (types.Value, error) {
 		case types.Decimal:
 			return l.ToDecimal().Add(r), nil
 		}
+	case types.Integer64:
+		if r, ok := right.(types.Decimal); ok {
+			return l.ToDecimal().Add(r), nil
+		}
 	case types.Decimal:
 		switch r := right.(type) {
 		case types.Integer:
 			return l.Add(r.ToDecimal()), nil
+		case types.Integer64:
+			return l.Add(r.ToDecimal()), nil
 		case types.Decimal:
 			return l.Add(r), nil
 		}
@@ -39,6 +50,12 @@ func Add(left, right types.Value) (types.Value, error) {
 			value := int(q.Value().IntPart())
 			return l.AddDuration(value, q.Unit()), nil
 		}
+	case types.Time:
+		if q, ok := right.(types.Quantity); ok {
+			// Time + Quantity (duration), wrapping around midnight
+			value := int(q.Value().IntPart())
+			return l.AddDuration(value, q.Unit()), nil
+		}
 	case types.Quantity:
 		if r, ok := right.(types.Quantity); ok {
 			// Quantity + Quantity
@@ -50,6 +67,9 @@ func Add(left, right types.Value) (types.Value, error) {
 
 // Subtract performs subtraction on two values.
 func Subtract(left, right types.Value) (types.Value, error) {
+	if l, r, ok := integer64Operands(left, right); ok {
+		return l.Subtract(r)
+	}
 	switch l := left.(type) {
 	case types.Integer:
 		switch r := right.(type) {
@@ -58,10 +78,16 @@ func Subtract(left, right types.Value) (types.Value, error) {
 		case types.Decimal:
 			return l.ToDecimal().Subtract(r), nil
 		}
+	case types.Integer64:
+		if r, ok := right.(types.Decimal); ok {
+			return l.ToDecimal().Subtract(r), nil
+		}
 	case types.Decimal:
 		switch r := right.(type) {
 		case types.Integer:
 			return l.Subtract(r.ToDecimal()), nil
+		case types.Integer64:
+			return l.Subtract(r.ToDecimal()), nil
 		case types.Decimal:
 			return l.Subtract(r), nil
 		}
@@ -77,6 +103,12 @@ func Subtract(left, right types.Value) (types.Value, error) {
 			value := int(q.Value().IntPart())
 			return l.SubtractDuration(value, q.Unit()), nil
 		}
+	case types.Time:
+		if q, ok := right.(types.Quantity); ok {
+			// Time - Quantity (duration), wrapping around midnight
+			value := int(q.Value().IntPart())
+			return l.SubtractDuration(value, q.Unit()), nil
+		}
 	case types.Quantity:
 		if r, ok := right.(types.Quantity); ok {
 			// Quantity - Quantity
@@ -88,6 +120,9 @@ func Subtract(left, right types.Value) (types.Value, error) {
 
 // Multiply performs multiplication on two values.
 func Multiply(left, right types.Value) (types.Value, error) {
+	if l, r, ok := integer64Operands(left, right); ok {
+		return l.Multiply(r)
+	}
 	switch l := left.(type) {
 	case types.Integer:
 		switch r := right.(type) {
@@ -96,10 +131,16 @@ func Multiply(left, right types.Value) (types.Value, error) {
 		case types.Decimal:
 			return l.ToDecimal().Multiply(r), nil
 		}
+	case types.Integer64:
+		if r, ok := right.(types.Decimal); ok {
+			return l.ToDecimal().Multiply(r), nil
+		}
 	case types.Decimal:
 		switch r := right.(type) {
 		case types.Integer:
 			return l.Multiply(r.ToDecimal()), nil
+		case types.Integer64:
+			return l.Multiply(r.ToDecimal()), nil
 		case types.Decimal:
 			return l.Multiply(r), nil
 		}
@@ -114,6 +155,8 @@ func Divide(left, right types.Value) (types.Value, error) {
 	switch l := left.(type) {
 	case types.Integer:
 		lDec = l.ToDecimal()
+	case types.Integer64:
+		lDec = l.ToDecimal()
 	case types.Decimal:
 		lDec = l
 	default:
@@ -123,6 +166,8 @@ func Divide(left, right types.Value) (types.Value, error) {
 	switch r := right.(type) {
 	case types.Integer:
 		rDec = r.ToDecimal()
+	case types.Integer64:
+		rDec = r.ToDecimal()
 	case types.Decimal:
 		rDec = r
 	default:
@@ -134,6 +179,9 @@ func Divide(left, right types.Value) (types.Value, error) {
 
 // IntegerDivide performs integer division (div operator).
 func IntegerDivide(left, right types.Value) (types.Value, error) {
+	if l, r, ok := integer64Operands(left, right); ok {
+		return l.Div(r)
+	}
 	l, ok := left.(types.Integer)
 	if !ok {
 		return nil, InvalidOperationError("div", left.Type(), right.Type())
@@ -147,6 +195,9 @@ func IntegerDivide(left, right types.Value) (types.Value, error) {
 
 // Modulo performs modulo operation (mod operator).
 func Modulo(left, right types.Value) (types.Value, error) {
+	if l, r, ok := integer64Operands(left, right); ok {
+		return l.Mod(r)
+	}
 	l, ok := left.(types.Integer)
 	if !ok {
 		return nil, InvalidOperationError("mod", left.Type(), right.Type())
@@ -163,16 +214,42 @@ func Negate(value types.Value) (types.Value, error) {
 	switch v := value.(type) {
 	case types.Integer:
 		return v.Negate(), nil
+	case types.Integer64:
+		return v.Negate()
 	case types.Decimal:
 		return v.Negate(), nil
 	}
 	return nil, NewEvalError(ErrType, "cannot negate "+value.Type())
 }
 
+// integer64Operands returns both operands as Integer64 when at least one of them is an
+// Integer64 and the other is an Integer or Integer64. Integer operands are widened so
+// that mixed arithmetic keeps the overflow checks of Integer64.
+func integer64Operands(left, right types.Value) (l, r types.Integer64, ok bool) {
+	l, leftIs64 := left.(types.Integer64)
+	r, rightIs64 := right.(types.Integer64)
+	if !leftIs64 && !rightIs64 {
+		return l, r, false
+	}
+	if i, isInt := left.(types.Integer); isInt {
+		l = types.NewInteger64(i.Value())
+	} else if !leftIs64 {
+		return l, r, false
+	}
+	if i, isInt := right.(types.Integer); isInt {
+		r = types.NewInteger64(i.Value())
+	} else if !rightIs64 {
+		return l, r, false
+	}
+	return l, r, true
+}
+
 // Comparison operators
 
 // Compare compares two values and returns -1, 0, or 1.
 func Compare(left, right types.Value) (int, error) {
+	left, right = temporalOperands(left, right)
+
 	// Try to convert ObjectValue to Quantity if comparing with Quantity
 	if obj, ok := left.(*types.ObjectValue); ok {
 		if _, isRightQuantity := right.(types.Quantity); isRightQuantity {
@@ -197,9 +274,48 @@ func Compare(left, right types.Value) (int, error) {
 	return 0, InvalidOperationError("compare", left.Type(), right.Type())
 }
 
+// temporalOperands converts a String operand to the Date, DateTime or Time type
+// of the other operand. FHIR date, dateTime, instant and time elements are
+// strings in JSON, so this lets them be compared with temporal literals
+// (birthDate < @2000-01-01). Operands are returned unchanged otherwise.
+func temporalOperands(left, right types.Value) (l, r types.Value) {
+	if s, ok := left.(types.String); ok {
+		if v, ok := parseTemporalLike(s.Value(), right); ok {
+			return v, right
+		}
+	}
+	if s, ok := right.(types.String); ok {
+		if v, ok := parseTemporalLike(s.Value(), left); ok {
+			return left, v
+		}
+	}
+	return left, right
+}
+
+// parseTemporalLike parses s as a value of the temporal type of like.
+func parseTemporalLike(s string, like types.Value) (types.Value, bool) {
+	var v types.Value
+	var err error
+	switch like.(type) {
+	case types.Date:
+		v, err = types.NewDate(s)
+	case types.DateTime:
+		v, err = types.NewDateTime(s)
+	case types.Time:
+		v, err = types.NewTime(s)
+	default:
+		return nil, false
+	}
+	return v, err == nil
+}
+
 // LessThan returns true if left < right.
+// Returns empty when the ordering is indeterminate (e.g. <5 mg vs 3 mg).
 func LessThan(left, right types.Value) (types.Collection, error) {
 	cmp, err := Compare(left, right)
+	if errors.Is(err, types.ErrIndeterminateComparison) {
+		return types.EmptyCollection, nil
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -212,6 +328,9 @@ func LessThan(left, right types.Value) (types.Collection, error) {
 // LessOrEqual returns true if left <= right.
 func LessOrEqual(left, right types.Value) (types.Collection, error) {
 	cmp, err := Compare(left, right)
+	if errors.Is(err, types.ErrIndeterminateComparison) {
+		return types.EmptyCollection, nil
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -224,6 +343,9 @@ func LessOrEqual(left, right types.Value) (types.Collection, error) {
 // GreaterThan returns true if left > right.
 func GreaterThan(left, right types.Value) (types.Collection, error) {
 	cmp, err := Compare(left, right)
+	if errors.Is(err, types.ErrIndeterminateComparison) {
+		return types.EmptyCollection, nil
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -236,6 +358,9 @@ func GreaterThan(left, right types.Value) (types.Collection, error) {
 // GreaterOrEqual returns true if left >= right.
 func GreaterOrEqual(left, right types.Value) (types.Collection, error) {
 	cmp, err := Compare(left, right)
+	if errors.Is(err, types.ErrIndeterminateComparison) {
+		return types.EmptyCollection, nil
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -259,7 +384,40 @@ func Equal(left, right types.Collection) types.Collection {
 		return types.EmptyCollection
 	}
 
-	if left[0].Equal(right[0]) {
+	// Quantities with incompatible units have no equality (5 'kg' = 5 'm' is empty),
+	// nor do quantities with a comparator, which are ranges (<5 'mg' = 5 'mg' is empty)
+	if lq, ok := left[0].(types.Quantity); ok {
+		if rq, ok := right[0].(types.Quantity); ok &&
+			(!lq.UnitsCompatible(rq) || lq.Comparator() != "" || rq.Comparator() != "") {
+			return types.EmptyCollection
+		}
+	}
+
+	l, r := temporalOperands(left[0], right[0])
+
+	// Datetimes of different precisions, or with and without a timezone, have no equality
+	if ld, ok := l.(types.DateTime); ok {
+		if rd, ok := r.(types.DateTime); ok {
+			cmp, err := ld.Compare(rd)
+			if err != nil {
+				return types.EmptyCollection
+			}
+			return types.Collection{types.NewBoolean(cmp == 0)}
+		}
+	}
+
+	// Times of different precisions have no equality either (@T10:30 = @T10:30:00 is empty)
+	if lt, ok := l.(types.Time); ok {
+		if rt, ok := r.(types.Time); ok {
+			cmp, err := lt.Compare(rt)
+			if err != nil {
+				return types.EmptyCollection
+			}
+			return types.Collection{types.NewBoolean(cmp == 0)}
+		}
+	}
+
+	if l.Equal(r) {
 		return types.TrueCollection
 	}
 	return types.FalseCollection
@@ -292,7 +450,8 @@ func Equivalent(left, right types.Collection) types.Collection {
 		return types.FalseCollection
 	}
 
-	if left[0].Equivalent(right[0]) {
+	l, r := temporalOperands(left[0], right[0])
+	if l.Equivalent(r) {
 		return types.TrueCollection
 	}
 	return types.FalseCollection
@@ -128,3 +128,17 @@ func BenchmarkEvaluateEmpty(b *testing.B) {
 		_, _ = expr.Evaluate(patient)
 	}
 }
+
+func BenchmarkDescendantsOfTypeExtension(b *testing.B) {
+	expr := MustCompile("descendants().ofType(Extension)")
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = expr.Evaluate(patientWithExtensions)
+	}
+}
+
+func BenchmarkAllExtensions(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_, _ = AllExtensions(patientWithExtensions)
+	}
+}
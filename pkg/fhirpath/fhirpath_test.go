@@ -65,6 +65,26 @@ func TestCompile(t *testing.T) {
 	})
 }
 
+func TestQuoteEnvironmentConstants(t *testing.T) {
+	tests := []struct {
+		expr string
+		want string
+	}{
+		{"%vs-administrative-gender", "%`vs-administrative-gender`"},
+		{"extension(%ext-patient-birthTime).value", "extension(%`ext-patient-birthTime`).value"},
+		{"%`vs-administrative-gender`", "%`vs-administrative-gender`"},
+		{"'%vs-administrative-gender' = %vs-x", "'%vs-administrative-gender' = %`vs-x`"},
+		{`'it\'s %ext-a'`, `'it\'s %ext-a'`},
+		{"%vsx - 1", "%vsx - 1"},
+		{"%resource.id", "%resource.id"},
+	}
+	for _, tt := range tests {
+		if got := quoteEnvironmentConstants(tt.expr); got != tt.want {
+			t.Errorf("quoteEnvironmentConstants(%q) = %q, want %q", tt.expr, got, tt.want)
+		}
+	}
+}
+
 func TestLiterals(t *testing.T) {
 	t.Run("boolean true", func(t *testing.T) {
 		result, err := Evaluate(simpleJSON, "true")
@@ -159,6 +179,35 @@ func TestNavigation(t *testing.T) {
 		}
 	})
 
+	t.Run("flattens one level per step", func(t *testing.T) {
+		resource := []byte(`{"resourceType": "Patient", "name": [
+			{"given": ["Ana", "Maria", "Jose"]},
+			{"family": "Lopez"},
+			{"given": ["Ana"]},
+			{"given": ["Pepa", "Pepita"]}]}`)
+
+		result, err := Evaluate(resource, "Patient.name.given")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		want := []string{"Ana", "Maria", "Jose", "Ana", "Pepa", "Pepita"}
+		if result.Count() != len(want) {
+			t.Fatalf("expected %d given names, got %d: %v", len(want), result.Count(), result)
+		}
+		for i, name := range want {
+			s, ok := result[i].(types.String)
+			if !ok || s.Value() != name {
+				t.Errorf("given[%d] = %v, want %q", i, result[i], name)
+			}
+		}
+
+		result, err = Evaluate(resource, "name.given.count()")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		assertIntegerResult(t, result, int64(len(want)))
+	})
+
 	t.Run("non-existent path", func(t *testing.T) {
 		result, err := Evaluate(patientJSON, "Patient.nonexistent")
 		if err != nil {
@@ -387,6 +436,39 @@ func TestBooleanOperators(t *testing.T) {
 		}
 		assertBooleanResult(t, result, false)
 	})
+
+	t.Run("not function", func(t *testing.T) {
+		result, err := Evaluate(simpleJSON, "true.not()")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		assertBooleanResult(t, result, false)
+
+		result, err = Evaluate(patientJSON, "active.not()")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		assertBooleanResult(t, result, false)
+	})
+
+	t.Run("not function on empty", func(t *testing.T) {
+		result, err := Evaluate(simpleJSON, "{}.not()")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !result.Empty() {
+			t.Errorf("expected empty, got %v", result)
+		}
+	})
+
+	t.Run("not function on non-Boolean", func(t *testing.T) {
+		if _, err := Evaluate(simpleJSON, "items.not()"); err == nil {
+			t.Error("expected error for not() on multiple items")
+		}
+		if _, err := Evaluate(simpleJSON, "text.not()"); err == nil {
+			t.Error("expected error for not() on String")
+		}
+	})
 }
 
 func TestCollectionOperators(t *testing.T) {
@@ -401,6 +483,26 @@ func TestCollectionOperators(t *testing.T) {
 		}
 	})
 
+	t.Run("distinct and union of objects with different key orders", func(t *testing.T) {
+		observation := []byte(`{"resourceType": "Observation", "code": {"coding": [
+			{"system": "http://loinc.org", "code": "8867-4", "display": "Heart rate"},
+			{"display": "Heart rate", "code": "8867-4", "system": "http://loinc.org"},
+			{"system": "http://loinc.org", "code": "8867-4"}]}}`)
+
+		for _, expr := range []string{
+			"Observation.code.coding.distinct()",
+			"Observation.code.coding | Observation.code.coding",
+		} {
+			result, err := Evaluate(observation, expr)
+			if err != nil {
+				t.Fatalf("%s: unexpected error: %v", expr, err)
+			}
+			if result.Count() != 2 {
+				t.Errorf("%s: expected 2 distinct Codings, got %d: %v", expr, result.Count(), result)
+			}
+		}
+	})
+
 	t.Run("in membership", func(t *testing.T) {
 		result, err := Evaluate(simpleJSON, "2 in (1 | 2 | 3)")
 		if err != nil {
@@ -734,6 +836,93 @@ func TestOfTypeFunction(t *testing.T) {
 	})
 }
 
+// patientWithExtensions has extensions at the resource, datatype, nested
+// extension, primitive element and contained resource levels.
+var patientWithExtensions = []byte(`{
+	"resourceType": "Patient",
+	"extension": [
+		{"url": "http://example.org/race", "extension": [
+			{"url": "ombCategory", "valueCoding": {"system": "urn:oid:2.16.840.1.113883.6.238", "code": "2106-3"}},
+			{"url": "text", "valueString": "White"}
+		]},
+		{"url": "http://example.org/weight", "valueQuantity": {"value": 70, "unit": "kg"}}
+	],
+	"modifierExtension": [{"url": "http://example.org/confidential", "valueBoolean": true}],
+	"name": [{"family": "Doe", "extension": [{"url": "http://example.org/prefix", "valueString": "van"}]}],
+	"birthDate": "1990-01-15",
+	"_birthDate": {"extension": [{"url": "http://example.org/birthTime", "valueDateTime": "1990-01-15T10:30:00Z"}]},
+	"contained": [{"resourceType": "Organization", "extension": [{"url": "http://example.org/org", "valueCode": "x"}]}]
+}`)
+
+func TestAllExtensions(t *testing.T) {
+	extensions, err := AllExtensions(patientWithExtensions)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []struct {
+		path      string
+		url       string
+		valueType string
+	}{
+		{"Patient.extension[0]", "http://example.org/race", ""},
+		{"Patient.extension[0].extension[0]", "ombCategory", "Coding"},
+		{"Patient.extension[0].extension[1]", "text", "String"},
+		{"Patient.extension[1]", "http://example.org/weight", "Quantity"},
+		{"Patient.modifierExtension[0]", "http://example.org/confidential", "Boolean"},
+		{"Patient.name[0].extension[0]", "http://example.org/prefix", "String"},
+		{"Patient._birthDate.extension[0]", "http://example.org/birthTime", "DateTime"},
+		{"Patient.contained[0].extension[0]", "http://example.org/org", "String"},
+	}
+	if len(extensions) != len(want) {
+		t.Fatalf("expected %d extensions, got %d: %v", len(want), len(extensions), extensions)
+	}
+	for i, w := range want {
+		ext := extensions[i]
+		if ext.Path != w.path || ext.URL != w.url {
+			t.Errorf("extension %d = %s %s, want %s %s", i, ext.Path, ext.URL, w.path, w.url)
+		}
+		valueType := ""
+		if ext.Value != nil {
+			valueType = ext.Value.Type()
+		}
+		if valueType != w.valueType {
+			t.Errorf("extension %d value type = %q, want %q", i, valueType, w.valueType)
+		}
+		if ext.Modifier != (w.path == "Patient.modifierExtension[0]") {
+			t.Errorf("extension %d Modifier = %v", i, ext.Modifier)
+		}
+	}
+
+	t.Run("same extensions as descendants().ofType(Extension)", func(t *testing.T) {
+		result, err := Evaluate(patientWithExtensions, "descendants().ofType(Extension)")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if result.Count() != len(extensions) {
+			t.Errorf("descendants().ofType(Extension) found %d extensions, AllExtensions %d", result.Count(), len(extensions))
+		}
+	})
+
+	t.Run("resource without extensions", func(t *testing.T) {
+		extensions, err := AllExtensions(patientJSON)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(extensions) != 0 {
+			t.Errorf("expected no extensions, got %v", extensions)
+		}
+	})
+
+	t.Run("invalid JSON", func(t *testing.T) {
+		for _, resource := range []string{`[1, 2]`, `{"extension": [`} {
+			if _, err := AllExtensions([]byte(resource)); err == nil {
+				t.Errorf("AllExtensions(%s) error = nil, want an error", resource)
+			}
+		}
+	})
+}
+
 // Helper functions
 
 func assertBooleanResult(t *testing.T, result types.Collection, expected bool) {
@@ -837,13 +1026,39 @@ func TestUCUMQuantityComparison(t *testing.T) {
 		assertBooleanResult(t, result, true)
 	})
 
-	t.Run("incompatible units return false", func(t *testing.T) {
+	t.Run("incompatible units return empty", func(t *testing.T) {
 		result, err := Evaluate(simpleJSON, "1 'kg' = 1 'm'")
 		if err != nil {
 			t.Fatalf("unexpected error: %v", err)
 		}
-		// Per FHIRPath spec: if units cannot be converted to same canonical form, result is false
-		assertBooleanResult(t, result, false)
+		// Per FHIRPath spec: if units cannot be converted to same canonical form, equality is empty
+		if !result.Empty() {
+			t.Errorf("expected empty, got %v", result)
+		}
+	})
+
+	t.Run("5 kg equals 5000 g", func(t *testing.T) {
+		result, err := Evaluate(simpleJSON, "5 'kg' = 5000 'g'")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		assertBooleanResult(t, result, true)
+	})
+
+	t.Run("0.1 kg equals 100 g", func(t *testing.T) {
+		result, err := Evaluate(simpleJSON, "0.1 'kg' = 100 'g'")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		assertBooleanResult(t, result, true)
+	})
+
+	t.Run("5 kg not equal to 500 g", func(t *testing.T) {
+		result, err := Evaluate(simpleJSON, "5 'kg' != 500 'g'")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		assertBooleanResult(t, result, true)
 	})
 }
 
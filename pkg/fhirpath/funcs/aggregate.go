@@ -84,9 +84,8 @@ func fnAggregate(ctx *eval.Context, input types.Collection, args []interface{})
 		return nil, eval.InvalidArgumentsError("aggregate", 1, 0)
 	}
 
-	// For now, aggregate requires special handling in the evaluator
-	// This is a placeholder that will be enhanced with proper lambda support
-	// The evaluator should iterate over the collection, maintaining $total
+	// The aggregator is evaluated per item, maintaining $total and $index
+	// This is handled specially in the evaluator
 
 	// If we have an initial value, use it
 	if len(args) > 1 {
@@ -113,12 +112,13 @@ func fnChildren(_ *eval.Context, input types.Collection, _ []interface{}) (types
 }
 
 // fnDescendants returns all descendants of the input (recursive children).
-func fnDescendants(_ *eval.Context, input types.Collection, _ []interface{}) (types.Collection, error) {
+// The recursion depth and the size of the result are bounded by the context limits.
+func fnDescendants(ctx *eval.Context, input types.Collection, _ []interface{}) (types.Collection, error) {
 	result := types.Collection{}
 	seen := make(map[types.Value]bool)
 
-	var collect func(items types.Collection)
-	collect = func(items types.Collection) {
+	var collect func(items types.Collection, depth int) error
+	collect = func(items types.Collection, depth int) error {
 		for _, item := range items {
 			if seen[item] {
 				continue
@@ -127,27 +127,44 @@ func fnDescendants(_ *eval.Context, input types.Collection, _ []interface{}) (ty
 
 			if obj, ok := item.(*types.ObjectValue); ok {
 				children := obj.Children()
+				if len(children) == 0 {
+					continue
+				}
+				if err := ctx.CheckRecursionDepth(depth); err != nil {
+					return err
+				}
 				result = append(result, children...)
-				collect(children)
+				if err := ctx.CheckCollectionSize(result); err != nil {
+					return err
+				}
+				if err := collect(children, depth+1); err != nil {
+					return err
+				}
 			}
 		}
+		return nil
 	}
 
-	collect(input)
+	if err := collect(input, 1); err != nil {
+		return nil, err
+	}
 	return result, nil
 }
 
-// fnNot returns the boolean negation.
+// fnNot returns the boolean negation, with the same logic as the not operator.
+// An empty input returns empty; a non-Boolean or multi-item input is an error.
 func fnNot(_ *eval.Context, input types.Collection, _ []interface{}) (types.Collection, error) {
 	if input.Empty() {
 		return types.Collection{}, nil
 	}
-
-	if b, ok := input[0].(types.Boolean); ok {
-		return types.Collection{types.NewBoolean(!b.Bool())}, nil
+	if len(input) > 1 {
+		return nil, eval.SingletonError(len(input))
+	}
+	if _, ok := input[0].(types.Boolean); !ok {
+		return nil, eval.TypeError("Boolean", input[0].Type(), "not")
 	}
 
-	return types.Collection{}, nil
+	return eval.Not(input), nil
 }
 
 // fnHasValue returns true if the input has a primitive value.
@@ -159,7 +176,7 @@ func fnHasValue(_ *eval.Context, input types.Collection, _ []interface{}) (types
 	// Check if any element has a primitive value
 	for _, item := range input {
 		switch item.(type) {
-		case types.Boolean, types.String, types.Integer, types.Decimal,
+		case types.Boolean, types.String, types.Integer, types.Integer64, types.Decimal,
 			types.Date, types.DateTime, types.Time:
 			return types.Collection{types.NewBoolean(true)}, nil
 		}
@@ -178,7 +195,7 @@ func fnGetValue(_ *eval.Context, input types.Collection, _ []interface{}) (types
 	result := types.Collection{}
 	for _, item := range input {
 		switch v := item.(type) {
-		case types.Boolean, types.String, types.Integer, types.Decimal,
+		case types.Boolean, types.String, types.Integer, types.Integer64, types.Decimal,
 			types.Date, types.DateTime, types.Time:
 			result = append(result, v)
 		}
@@ -175,6 +175,14 @@ func TestTypeFunctions(t *testing.T) {
 		if !result.Empty() {
 			t.Error("expected not empty = empty")
 		}
+
+		// not on a non-Boolean or multi-item collection is an error
+		if _, err := fn.Fn(ctx, types.Collection{types.NewString("true")}, nil); err == nil {
+			t.Error("expected error for not on String")
+		}
+		if _, err := fn.Fn(ctx, types.Collection{types.NewBoolean(true), types.NewBoolean(false)}, nil); err == nil {
+			t.Error("expected error for not on multiple items")
+		}
 	})
 
 	t.Run("aggregate", func(t *testing.T) {
@@ -1,6 +1,8 @@
 package funcs
 
 import (
+	"bytes"
+	"encoding/json"
 	"strconv"
 	"strings"
 
@@ -76,6 +78,14 @@ func init() {
 		Fn:      fnConvertsToString,
 	})
 
+	// toJson is an extension: toString() is empty for complex types per spec
+	Register(FuncDef{
+		Name:    "toJson",
+		MinArgs: 0,
+		MaxArgs: 0,
+		Fn:      fnToJSON,
+	})
+
 	Register(FuncDef{
 		Name:    "toDate",
 		MinArgs: 0,
@@ -252,6 +262,8 @@ func fnToInteger(_ *eval.Context, input types.Collection, _ []interface{}) (type
 	switch v := item.(type) {
 	case types.Integer:
 		return types.Collection{v}, nil
+	case types.Integer64:
+		return types.Collection{v.ToInteger()}, nil
 	case types.Boolean:
 		if v.Bool() {
 			return types.Collection{types.NewInteger(1)}, nil
@@ -279,7 +291,7 @@ func fnConvertsToInteger(_ *eval.Context, input types.Collection, _ []interface{
 	item := input[0]
 
 	switch v := item.(type) {
-	case types.Integer:
+	case types.Integer, types.Integer64:
 		return types.Collection{types.NewBoolean(true)}, nil
 	case types.Boolean:
 		return types.Collection{types.NewBoolean(true)}, nil
@@ -306,6 +318,8 @@ func fnToDecimal(_ *eval.Context, input types.Collection, _ []interface{}) (type
 		return types.Collection{v}, nil
 	case types.Integer:
 		return types.Collection{types.NewDecimalFromInt(v.Value())}, nil
+	case types.Integer64:
+		return types.Collection{v.ToDecimal()}, nil
 	case types.Boolean:
 		if v.Bool() {
 			return types.Collection{types.NewDecimalFromInt(1)}, nil
@@ -331,7 +345,7 @@ func fnConvertsToDecimal(_ *eval.Context, input types.Collection, _ []interface{
 	item := input[0]
 
 	switch v := item.(type) {
-	case types.Decimal, types.Integer, types.Boolean:
+	case types.Decimal, types.Integer, types.Integer64, types.Boolean:
 		return types.Collection{types.NewBoolean(true)}, nil
 	case types.String:
 		_, err := decimal.NewFromString(v.Value())
@@ -341,13 +355,87 @@ func fnConvertsToDecimal(_ *eval.Context, input types.Collection, _ []interface{
 	}
 }
 
+// calendarDurationUnits are the FHIRPath calendar duration keywords, which are
+// written without quotes in the string form of a quantity.
+var calendarDurationUnits = map[string]bool{
+	"year": true, "years": true, "month": true, "months": true,
+	"week": true, "weeks": true, "day": true, "days": true,
+	"hour": true, "hours": true, "minute": true, "minutes": true,
+	"second": true, "seconds": true, "millisecond": true, "milliseconds": true,
+}
+
 // fnToString converts the input to a string.
 func fnToString(_ *eval.Context, input types.Collection, _ []interface{}) (types.Collection, error) {
 	if input.Empty() {
 		return types.Collection{}, nil
 	}
 
-	return types.Collection{types.NewString(input[0].String())}, nil
+	switch v := input[0].(type) {
+	case types.Decimal:
+		return types.Collection{types.NewString(types.FormatDecimalPrecision(v.Value()))}, nil
+	case types.Quantity:
+		return types.Collection{types.NewString(quantityToString(v))}, nil
+	case *types.ObjectValue:
+		// Complex types have no string representation
+		return types.Collection{}, nil
+	default:
+		// Date, DateTime and Time render without the @ prefix, keeping their precision
+		return types.Collection{types.NewString(v.String())}, nil
+	}
+}
+
+// quantityToString renders a quantity as value followed by its quoted UCUM unit
+// (5 'mg'), or by the bare keyword for calendar durations (4 days).
+func quantityToString(q types.Quantity) string {
+	value := q.Comparator() + types.FormatDecimalPrecision(q.Value())
+	unit := q.Unit()
+	switch {
+	case unit == "":
+		return value
+	case calendarDurationUnits[unit]:
+		return value + " " + unit
+	default:
+		return value + " '" + unit + "'"
+	}
+}
+
+// fnToJSON renders the input as compact JSON. Complex values are rendered as
+// their FHIR JSON; primitives as JSON strings, numbers or booleans.
+func fnToJSON(_ *eval.Context, input types.Collection, _ []interface{}) (types.Collection, error) {
+	if input.Empty() {
+		return types.Collection{}, nil
+	}
+
+	var data []byte
+	var err error
+	switch v := input[0].(type) {
+	case *types.ObjectValue:
+		var buf bytes.Buffer
+		err = json.Compact(&buf, v.Data())
+		data = buf.Bytes()
+	case types.Boolean:
+		data, err = json.Marshal(v.Bool())
+	case types.Integer:
+		data, err = json.Marshal(v.Value())
+	case types.Decimal:
+		data = []byte(types.FormatDecimalPrecision(v.Value()))
+	case types.Quantity:
+		quantity := map[string]interface{}{"value": json.Number(types.FormatDecimalPrecision(v.Value()))}
+		if v.Comparator() != "" {
+			quantity["comparator"] = v.Comparator()
+		}
+		if v.Unit() != "" {
+			quantity["unit"] = v.Unit()
+		}
+		data, err = json.Marshal(quantity)
+	default:
+		data, err = json.Marshal(v.String())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return types.Collection{types.NewString(string(data))}, nil
 }
 
 // fnConvertsToString returns true if the input can be converted to string.
@@ -358,7 +446,8 @@ func fnConvertsToString(_ *eval.Context, input types.Collection, _ []interface{}
 
 	// All primitive types can be converted to string
 	switch input[0].(type) {
-	case types.String, types.Boolean, types.Integer, types.Decimal:
+	case types.String, types.Boolean, types.Integer, types.Integer64, types.Decimal,
+		types.Date, types.DateTime, types.Time, types.Quantity:
 		return types.Collection{types.NewBoolean(true)}, nil
 	default:
 		return types.Collection{types.NewBoolean(false)}, nil
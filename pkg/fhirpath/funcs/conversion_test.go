@@ -717,3 +717,57 @@ func TestQuantityConversion(t *testing.T) {
 		}
 	})
 }
+
+func TestToStringAllTypes(t *testing.T) {
+	ctx := eval.NewContext([]byte(`{}`))
+	fn, _ := Get("toString")
+
+	mustValue := func(v types.Value, err error) types.Value {
+		t.Helper()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return v
+	}
+	quantity := func(s string) types.Value {
+		q, err := types.NewQuantity(s)
+		return mustValue(q, err)
+	}
+
+	tests := []struct {
+		name  string
+		input types.Value
+		want  string
+	}{
+		{"string", types.NewString("abc"), "abc"},
+		{"boolean", types.NewBoolean(false), "false"},
+		{"integer", types.NewInteger(-7), "-7"},
+		{"decimal", mustValue(types.NewDecimal("3.14")), "3.14"},
+		{"decimal keeps trailing zeros", mustValue(types.NewDecimal("1.50")), "1.50"},
+		{"date year", mustValue(types.NewDate("2020")), "2020"},
+		{"date month", mustValue(types.NewDate("2020-01")), "2020-01"},
+		{"date day", mustValue(types.NewDate("2020-01-15")), "2020-01-15"},
+		{"datetime", mustValue(types.NewDateTime("2020-01-15T10:30:00")), "2020-01-15T10:30:00"},
+		{"datetime with timezone", mustValue(types.NewDateTime("2020-01-15T10:30:00.123Z")), "2020-01-15T10:30:00.123Z"},
+		{"time", mustValue(types.NewTime("10:30")), "10:30"},
+		{"quantity UCUM unit", quantity("5 'mg'"), "5 'mg'"},
+		{"quantity decimal", quantity("2.50 'mg/dL'"), "2.50 'mg/dL'"},
+		{"quantity calendar unit", quantity("4 days"), "4 days"},
+		{"quantity without unit", quantity("10"), "10"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := fn.Fn(ctx, types.Collection{tt.input}, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(result) != 1 {
+				t.Fatalf("expected 1 result, got %d", len(result))
+			}
+			if got := result[0].(types.String).Value(); got != tt.want {
+				t.Errorf("expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
@@ -43,6 +43,15 @@ func init() {
 		MaxArgs: 1,
 		Fn:      fnGetReferenceKey,
 	})
+
+	// comparable() was added to the FHIR-specific functions in R5
+	Register(FuncDef{
+		Name:     "comparable",
+		MinArgs:  1,
+		MaxArgs:  1,
+		Fn:       fnComparable,
+		Versions: []eval.FHIRVersion{eval.FHIRVersionR5},
+	})
 }
 
 // fnResolve resolves a FHIR reference to the referenced resource.
@@ -258,3 +267,37 @@ func fnGetReferenceKey(ctx *eval.Context, input types.Collection, args []interfa
 
 	return result, nil
 }
+
+// fnComparable returns true if the input quantity can be compared with the argument
+// quantity, i.e. the comparison operators would return a result for them.
+// Returns empty if either side is not a single quantity.
+func fnComparable(_ *eval.Context, input types.Collection, args []interface{}) (types.Collection, error) {
+	if len(input) != 1 || len(args) == 0 {
+		return types.Collection{}, nil
+	}
+	other, ok := args[0].(types.Collection)
+	if !ok || len(other) != 1 {
+		return types.Collection{}, nil
+	}
+
+	left, ok := asQuantity(input[0])
+	if !ok {
+		return types.Collection{}, nil
+	}
+	right, ok := asQuantity(other[0])
+	if !ok {
+		return types.Collection{}, nil
+	}
+	return types.Collection{types.NewBoolean(left.UnitsCompatible(right))}, nil
+}
+
+// asQuantity returns v as a Quantity, converting FHIR Quantity elements.
+func asQuantity(v types.Value) (types.Quantity, bool) {
+	switch q := v.(type) {
+	case types.Quantity:
+		return q, true
+	case *types.ObjectValue:
+		return q.ToQuantity()
+	}
+	return types.Quantity{}, false
+}
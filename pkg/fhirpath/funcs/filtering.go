@@ -86,8 +86,8 @@ func fnRepeat(ctx *eval.Context, input types.Collection, args []interface{}) (ty
 		return nil, eval.InvalidArgumentsError("repeat", 1, 0)
 	}
 
-	// This requires special handling in the evaluator for recursive evaluation
-	// For now, return the input
+	// The expression is evaluated recursively for each new item
+	// This is handled specially in the evaluator
 	return input, nil
 }
 
@@ -118,7 +118,7 @@ func fnOfType(ctx *eval.Context, input types.Collection, args []interface{}) (ty
 
 	result := types.Collection{}
 	for _, item := range input {
-		if item.Type() == typeName {
+		if eval.ValueMatchesType(ctx.FHIRVersion(), item, typeName) {
 			result = append(result, item)
 		}
 	}
@@ -1,6 +1,7 @@
 package funcs
 
 import (
+	"math"
 	"testing"
 
 	"github.com/robertoaraneda/gofhir/pkg/fhirpath/eval"
@@ -453,6 +454,44 @@ func TestSubsettingFunctions(t *testing.T) {
 		}
 	})
 
+	t.Run("skip and take bounds", func(t *testing.T) {
+		skip, _ := Get("skip")
+		take, _ := Get("take")
+		input := types.Collection{types.NewInteger(1), types.NewInteger(2), types.NewInteger(3)}
+
+		tests := []struct {
+			fn        string
+			n         int64
+			wantCount int
+		}{
+			{"skip", 0, 3},
+			{"skip", -1, 3},
+			{"skip", 3, 0},
+			{"skip", 4, 0},
+			{"skip", math.MaxInt64, 0},
+			{"skip", math.MinInt64, 3},
+			{"take", 0, 0},
+			{"take", -1, 0},
+			{"take", 3, 3},
+			{"take", 4, 3},
+			{"take", math.MaxInt64, 3},
+			{"take", math.MinInt64, 0},
+		}
+		for _, tt := range tests {
+			fn := skip
+			if tt.fn == "take" {
+				fn = take
+			}
+			result, err := fn.Fn(ctx, input, []interface{}{types.Collection{types.NewInteger(tt.n)}})
+			if err != nil {
+				t.Fatalf("%s(%d): %v", tt.fn, tt.n, err)
+			}
+			if result.Count() != tt.wantCount {
+				t.Errorf("%s(%d): expected %d items, got %d", tt.fn, tt.n, tt.wantCount, result.Count())
+			}
+		}
+	})
+
 	t.Run("single", func(t *testing.T) {
 		fn, _ := Get("single")
 
@@ -826,9 +865,13 @@ func TestAdditionalSubsettingFunctions(t *testing.T) {
 	t.Run("single empty", func(t *testing.T) {
 		fn, _ := Get("single")
 
-		_, err := fn.Fn(ctx, types.Collection{}, nil)
-		if err == nil {
-			t.Error("expected error for empty collection")
+		// Per the FHIRPath spec, single() of an empty collection is empty
+		result, err := fn.Fn(ctx, types.Collection{}, nil)
+		if err != nil {
+			t.Fatalf("unexpected error for empty collection: %v", err)
+		}
+		if !result.Empty() {
+			t.Errorf("expected empty result, got %v", result)
 		}
 	})
 
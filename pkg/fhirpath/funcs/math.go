@@ -1,6 +1,7 @@
 package funcs
 
 import (
+	"fmt"
 	"math"
 
 	"github.com/shopspring/decimal"
@@ -124,6 +125,12 @@ func fnAbs(_ *eval.Context, input types.Collection, _ []interface{}) (types.Coll
 			val = -val
 		}
 		return types.Collection{types.NewInteger(val)}, nil
+	case types.Integer64:
+		abs, err := v.Abs()
+		if err != nil {
+			return nil, err
+		}
+		return types.Collection{abs}, nil
 	case types.Decimal:
 		return types.Collection{types.NewDecimalFromFloat(math.Abs(v.Value().InexactFloat64()))}, nil
 	default:
@@ -242,10 +249,20 @@ func fnPower(_ *eval.Context, input types.Collection, args []interface{}) (types
 		return types.Collection{}, nil
 	}
 
+	if base, exp, ok := integer64PowerOperands(input[0], args[0]); ok {
+		result, err := powerInteger64(base, exp)
+		if err != nil {
+			return nil, err
+		}
+		return types.Collection{result}, nil
+	}
+
 	var base float64
 	switch v := input[0].(type) {
 	case types.Integer:
 		base = float64(v.Value())
+	case types.Integer64:
+		base = float64(v.Value())
 	case types.Decimal:
 		base = v.Value().InexactFloat64()
 	default:
@@ -267,8 +284,57 @@ func fnPower(_ *eval.Context, input types.Collection, args []interface{}) (types
 	return types.Collection{types.NewDecimalFromFloat(result)}, nil
 }
 
-// fnRound rounds to the specified number of decimal places.
-func fnRound(_ *eval.Context, input types.Collection, args []interface{}) (types.Collection, error) {
+// integer64PowerOperands returns the base and exponent of power() as Integer64 when at
+// least one of them is an Integer64, the other is an Integer or Integer64, and the
+// exponent is not negative. Integer operands are promoted to Integer64.
+func integer64PowerOperands(input types.Value, arg interface{}) (base, exp types.Integer64, ok bool) {
+	if c, isColl := arg.(types.Collection); isColl {
+		if len(c) != 1 {
+			return base, exp, false
+		}
+		arg = c[0]
+	}
+	base, baseIs64 := input.(types.Integer64)
+	exp, expIs64 := arg.(types.Integer64)
+	if !baseIs64 && !expIs64 {
+		return base, exp, false
+	}
+	if i, isInt := input.(types.Integer); isInt {
+		base = types.NewInteger64(i.Value())
+	} else if !baseIs64 {
+		return base, exp, false
+	}
+	if i, isInt := arg.(types.Integer); isInt {
+		exp = types.NewInteger64(i.Value())
+	} else if !expIs64 {
+		return base, exp, false
+	}
+	return base, exp, exp.Value() >= 0
+}
+
+// powerInteger64 raises base to a non-negative exponent by repeated squaring,
+// reporting ErrIntegerOverflow if the result does not fit in an integer64.
+func powerInteger64(base, exp types.Integer64) (types.Integer64, error) {
+	result := types.NewInteger64(1)
+	var err error
+	for e := exp.Value(); e > 0; e >>= 1 {
+		if e&1 == 1 {
+			if result, err = result.Multiply(base); err != nil {
+				return types.Integer64{}, err
+			}
+		}
+		if e > 1 {
+			if base, err = base.Multiply(base); err != nil {
+				return types.Integer64{}, err
+			}
+		}
+	}
+	return result, nil
+}
+
+// fnRound rounds to the specified number of decimal places. Ties are rounded
+// away from zero, per the specification, unless the context selects RoundHalfEven.
+func fnRound(ctx *eval.Context, input types.Collection, args []interface{}) (types.Collection, error) {
 	if input.Empty() {
 		return types.Collection{}, nil
 	}
@@ -292,12 +358,10 @@ func fnRound(_ *eval.Context, input types.Collection, args []interface{}) (types
 	case types.Integer:
 		return types.Collection{v}, nil
 	case types.Decimal:
-		rounded := v.Value().Round(precision)
-		d, err := types.NewDecimal(rounded.String())
-		if err != nil {
-			return types.Collection{}, nil
+		if ctx != nil && ctx.RoundingMode() == eval.RoundHalfEven {
+			return types.Collection{v.RoundHalfEven(precision)}, nil
 		}
-		return types.Collection{d}, nil
+		return types.Collection{v.Round(precision)}, nil
 	default:
 		return types.Collection{}, nil
 	}
@@ -353,6 +417,8 @@ func toFloat(arg interface{}) (float64, error) {
 		return toFloat(v[0])
 	case types.Integer:
 		return float64(v.Value()), nil
+	case types.Integer64:
+		return float64(v.Value()), nil
 	case types.Decimal:
 		return v.Value().InexactFloat64(), nil
 	case int64:
@@ -380,11 +446,15 @@ func fnSum(ctx *eval.Context, input types.Collection, _ []interface{}) (types.Co
 
 	var sum decimal.Decimal
 	hasDecimal := false
+	hasInteger64 := false
 
 	for _, item := range input {
 		switch v := item.(type) {
 		case types.Integer:
 			sum = sum.Add(decimal.NewFromInt(v.Value()))
+		case types.Integer64:
+			sum = sum.Add(decimal.NewFromInt(v.Value()))
+			hasInteger64 = true
 		case types.Decimal:
 			sum = sum.Add(v.Value())
 			hasDecimal = true
@@ -394,7 +464,8 @@ func fnSum(ctx *eval.Context, input types.Collection, _ []interface{}) (types.Co
 		}
 	}
 
-	// Return Integer if all inputs were Integer, otherwise Decimal
+	// Return Integer if all inputs were Integer, Integer64 if some of them were
+	// Integer64, otherwise Decimal
 	if hasDecimal {
 		d, err := types.NewDecimal(sum.String())
 		if err != nil {
@@ -402,6 +473,12 @@ func fnSum(ctx *eval.Context, input types.Collection, _ []interface{}) (types.Co
 		}
 		return types.Collection{d}, nil
 	}
+	if hasInteger64 {
+		if sum.GreaterThan(decimal.NewFromInt(math.MaxInt64)) || sum.LessThan(decimal.NewFromInt(math.MinInt64)) {
+			return nil, fmt.Errorf("%w: sum() = %s", types.ErrIntegerOverflow, sum.String())
+		}
+		return types.Collection{types.NewInteger64(sum.IntPart())}, nil
+	}
 	return types.Collection{types.NewInteger(sum.IntPart())}, nil
 }
 
@@ -418,17 +495,10 @@ func findExtreme(ctx *eval.Context, input types.Collection, findMin bool) (types
 	}
 
 	var extremeVal types.Value
-	var extremeFloat float64
+	var extremeNum decimal.Decimal
 	first := true
 	isNumeric := false
-
-	// compareFn returns true if newVal should replace current extreme
-	compareFn := func(newVal, currentVal float64) bool {
-		if findMin {
-			return newVal < currentVal
-		}
-		return newVal > currentVal
-	}
+	hasInteger64 := false
 
 	// compareStrFn returns true if newStr should replace current extreme
 	compareStrFn := func(newStr, currentStr string) bool {
@@ -448,26 +518,24 @@ func findExtreme(ctx *eval.Context, input types.Collection, findMin bool) (types
 
 	for _, item := range input {
 		switch v := item.(type) {
-		case types.Integer:
-			val := float64(v.Value())
-			if first {
-				extremeFloat = val
-				extremeVal = item
-				first = false
-				isNumeric = true
-			} else if isNumeric && compareFn(val, extremeFloat) {
-				extremeFloat = val
-				extremeVal = item
+		case types.Integer, types.Integer64, types.Decimal:
+			var val decimal.Decimal
+			switch n := v.(type) {
+			case types.Integer:
+				val = decimal.NewFromInt(n.Value())
+			case types.Integer64:
+				val = decimal.NewFromInt(n.Value())
+				hasInteger64 = true
+			case types.Decimal:
+				val = n.Value()
 			}
-		case types.Decimal:
-			val := v.Value().InexactFloat64()
 			if first {
-				extremeFloat = val
+				extremeNum = val
 				extremeVal = item
 				first = false
 				isNumeric = true
-			} else if isNumeric && compareFn(val, extremeFloat) {
-				extremeFloat = val
+			} else if isNumeric && compareCmpFn(val.Cmp(extremeNum)) {
+				extremeNum = val
 				extremeVal = item
 			}
 		case types.String:
@@ -517,6 +585,10 @@ func findExtreme(ctx *eval.Context, input types.Collection, findMin bool) (types
 	if extremeVal == nil {
 		return types.Collection{}, nil
 	}
+	// Mixed Integer and Integer64 inputs are promoted to Integer64
+	if i, ok := extremeVal.(types.Integer); ok && hasInteger64 {
+		extremeVal = types.NewInteger64(i.Value())
+	}
 	return types.Collection{extremeVal}, nil
 }
 
@@ -552,6 +624,9 @@ func fnAvg(ctx *eval.Context, input types.Collection, _ []interface{}) (types.Co
 		case types.Integer:
 			sum = sum.Add(decimal.NewFromInt(v.Value()))
 			count++
+		case types.Integer64:
+			sum = sum.Add(decimal.NewFromInt(v.Value()))
+			count++
 		case types.Decimal:
 			sum = sum.Add(v.Value())
 			count++
@@ -1,6 +1,7 @@
 package funcs
 
 import (
+	"errors"
 	"math"
 	"testing"
 
@@ -406,3 +407,49 @@ func TestAdditionalMathFunctions(t *testing.T) {
 		}
 	})
 }
+
+func TestInteger64MathFunctions(t *testing.T) {
+	ctx := eval.NewContext([]byte(`{}`))
+	i64 := types.NewInteger64
+
+	tests := []struct {
+		name     string
+		fn       string
+		input    types.Collection
+		args     []interface{}
+		want     types.Value
+		overflow bool
+	}{
+		{"abs", "abs", types.Collection{i64(-5)}, nil, i64(5), false},
+		{"abs min int64", "abs", types.Collection{i64(math.MinInt64)}, nil, nil, true},
+		{"sum", "sum", types.Collection{i64(math.MaxInt64 - 1), i64(1)}, nil, i64(math.MaxInt64), false},
+		{"sum promotes integer", "sum", types.Collection{types.NewInteger(2), i64(3)}, nil, i64(5), false},
+		{"sum overflow", "sum", types.Collection{i64(math.MaxInt64), types.NewInteger(1)}, nil, nil, true},
+		{"min", "min", types.Collection{i64(math.MaxInt64), i64(math.MaxInt64 - 1)}, nil, i64(math.MaxInt64 - 1), false},
+		{"max", "max", types.Collection{i64(math.MaxInt64 - 1), i64(math.MaxInt64)}, nil, i64(math.MaxInt64), false},
+		{"max promotes integer", "max", types.Collection{i64(1), types.NewInteger(7)}, nil, i64(7), false},
+		{"avg", "avg", types.Collection{i64(1), types.NewInteger(2)}, nil, types.NewDecimalFromFloat(1.5), false},
+		{"power", "power", types.Collection{i64(2)}, []interface{}{types.NewInteger(62)}, i64(1 << 62), false},
+		{"power promotes integer", "power", types.Collection{types.NewInteger(3)}, []interface{}{i64(3)}, i64(27), false},
+		{"power overflow", "power", types.Collection{i64(2)}, []interface{}{i64(63)}, nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fn, _ := Get(tt.fn)
+			result, err := fn.Fn(ctx, tt.input, tt.args)
+			if tt.overflow {
+				if !errors.Is(err, types.ErrIntegerOverflow) {
+					t.Fatalf("expected ErrIntegerOverflow, got %v (%v)", err, result)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(result) != 1 || result[0].Type() != tt.want.Type() || !result[0].Equal(tt.want) {
+				t.Errorf("expected %v (%s), got %v", tt.want, tt.want.Type(), result)
+			}
+		})
+	}
+}
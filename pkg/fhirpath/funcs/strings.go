@@ -2,6 +2,7 @@ package funcs
 
 import (
 	"strings"
+	"unicode/utf8"
 
 	"github.com/robertoaraneda/gofhir/pkg/fhirpath/eval"
 	"github.com/robertoaraneda/gofhir/pkg/fhirpath/types"
@@ -58,6 +59,13 @@ func init() {
 		Fn:      fnIndexOf,
 	})
 
+	Register(FuncDef{
+		Name:    "lastIndexOf",
+		MinArgs: 1,
+		MaxArgs: 1,
+		Fn:      fnLastIndexOf,
+	})
+
 	Register(FuncDef{
 		Name:    "substring",
 		MinArgs: 1,
@@ -254,7 +262,8 @@ func fnReplaceMatches(ctx *eval.Context, input types.Collection, args []interfac
 	return types.Collection{types.NewString(result)}, nil
 }
 
-// fnIndexOf returns the index of the first occurrence of substring.
+// fnIndexOf returns the 0-based character index of the first occurrence of
+// substring, or -1 if it is not found.
 func fnIndexOf(_ *eval.Context, input types.Collection, args []interface{}) (types.Collection, error) {
 	if input.Empty() {
 		return types.Collection{}, nil
@@ -270,11 +279,43 @@ func fnIndexOf(_ *eval.Context, input types.Collection, args []interface{}) (typ
 		return types.Collection{}, nil
 	}
 
-	idx := strings.Index(str, substr)
-	return types.Collection{types.NewInteger(int64(idx))}, nil
+	return types.Collection{types.NewInteger(runeIndex(str, strings.Index(str, substr)))}, nil
+}
+
+// fnLastIndexOf returns the 0-based character index of the last occurrence of
+// substring, or -1 if it is not found. An empty substring returns 0.
+func fnLastIndexOf(_ *eval.Context, input types.Collection, args []interface{}) (types.Collection, error) {
+	if input.Empty() {
+		return types.Collection{}, nil
+	}
+
+	str, ok := toString(input)
+	if !ok {
+		return types.Collection{}, nil
+	}
+
+	substr, ok := toStringArg(args[0])
+	if !ok {
+		return types.Collection{}, nil
+	}
+
+	if substr == "" {
+		return types.Collection{types.NewInteger(0)}, nil
+	}
+	return types.Collection{types.NewInteger(runeIndex(str, strings.LastIndex(str, substr)))}, nil
+}
+
+// runeIndex converts a byte offset in s to a character offset, keeping -1 (not found).
+func runeIndex(s string, byteIdx int) int64 {
+	if byteIdx < 0 {
+		return -1
+	}
+	return int64(utf8.RuneCountInString(s[:byteIdx]))
 }
 
-// fnSubstring returns a substring starting at the given index.
+// fnSubstring returns the part of the string starting at character index start,
+// up to length characters (or to the end if length is omitted). An out-of-range
+// start or a negative length returns empty.
 func fnSubstring(_ *eval.Context, input types.Collection, args []interface{}) (types.Collection, error) {
 	if input.Empty() {
 		return types.Collection{}, nil
@@ -290,24 +331,27 @@ func fnSubstring(_ *eval.Context, input types.Collection, args []interface{}) (t
 		return nil, err
 	}
 
-	if start < 0 || int(start) >= len(str) {
+	runes := []rune(str)
+	if start < 0 || start >= int64(len(runes)) {
 		return types.Collection{}, nil
 	}
 
 	// Optional length parameter
+	end := int64(len(runes))
 	if len(args) > 1 {
 		length, err := toInteger(args[1])
 		if err != nil {
 			return nil, err
 		}
-		end := int(start + length)
-		if end > len(str) {
-			end = len(str)
+		if length < 0 {
+			return types.Collection{}, nil
+		}
+		if length < end-start {
+			end = start + length
 		}
-		return types.Collection{types.NewString(str[start:end])}, nil
 	}
 
-	return types.Collection{types.NewString(str[start:])}, nil
+	return types.Collection{types.NewString(string(runes[start:end]))}, nil
 }
 
 // fnLower converts string to lowercase.
@@ -432,7 +476,7 @@ func fnLength(_ *eval.Context, input types.Collection, _ []interface{}) (types.C
 		return types.Collection{}, nil
 	}
 
-	return types.Collection{types.NewInteger(int64(len(str)))}, nil
+	return types.Collection{types.NewInteger(int64(utf8.RuneCountInString(str)))}, nil
 }
 
 // Helper functions
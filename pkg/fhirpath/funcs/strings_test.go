@@ -457,3 +457,111 @@ func TestAdditionalStringFunctions(t *testing.T) {
 		}
 	})
 }
+
+func TestSubstringBounds(t *testing.T) {
+	ctx := eval.NewContext([]byte(`{}`))
+	fn, _ := Get("substring")
+
+	tests := []struct {
+		name  string
+		input string
+		args  []int64
+		want  string
+		empty bool
+	}{
+		{"prefix", "Hello", []int64{0, 2}, "He", false},
+		{"to end", "Hello", []int64{2}, "llo", false},
+		{"length past end", "Hello", []int64{3, 10}, "lo", false},
+		{"zero length", "Hello", []int64{1, 0}, "", false},
+		{"last character", "Hello", []int64{4}, "o", false},
+		{"start at length", "Hello", []int64{5}, "", true},
+		{"start past end", "Hello", []int64{10, 2}, "", true},
+		{"negative start", "Hello", []int64{-1, 2}, "", true},
+		{"negative length", "Hello", []int64{1, -1}, "", true},
+		{"empty string", "", []int64{0}, "", true},
+		{"multibyte", "héllo wörld", []int64{1, 4}, "éllo", false},
+		{"multibyte to end", "日本語テキスト", []int64{3}, "テキスト", false},
+		{"multibyte start past end", "日本語", []int64{3}, "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			args := make([]interface{}, len(tt.args))
+			for i, a := range tt.args {
+				args[i] = types.Collection{types.NewInteger(a)}
+			}
+			result, err := fn.Fn(ctx, types.Collection{types.NewString(tt.input)}, args)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tt.empty {
+				if !result.Empty() {
+					t.Errorf("expected empty, got %v", result)
+				}
+				return
+			}
+			if len(result) != 1 {
+				t.Fatalf("expected 1 result, got %d", len(result))
+			}
+			if got := result[0].(types.String).Value(); got != tt.want {
+				t.Errorf("expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestIndexOfFunctions(t *testing.T) {
+	ctx := eval.NewContext([]byte(`{}`))
+
+	tests := []struct {
+		fn     string
+		input  string
+		substr string
+		want   int64
+	}{
+		{"indexOf", "Hello", "l", 2},
+		{"indexOf", "Hello", "Hello", 0},
+		{"indexOf", "Hello", "", 0},
+		{"indexOf", "Hello", "xyz", -1},
+		{"indexOf", "", "a", -1},
+		{"indexOf", "héllo wörld", "wö", 6},
+		{"indexOf", "日本語日本語", "語", 2},
+		{"lastIndexOf", "Hello", "l", 3},
+		{"lastIndexOf", "Hello", "H", 0},
+		{"lastIndexOf", "Hello", "", 0},
+		{"lastIndexOf", "Hello", "xyz", -1},
+		{"lastIndexOf", "héllo wörld", "l", 9},
+		{"lastIndexOf", "日本語日本語", "語", 5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.fn+"("+tt.input+","+tt.substr+")", func(t *testing.T) {
+			fn, ok := Get(tt.fn)
+			if !ok {
+				t.Fatalf("%s not registered", tt.fn)
+			}
+			result, err := fn.Fn(ctx, types.Collection{types.NewString(tt.input)},
+				[]interface{}{types.Collection{types.NewString(tt.substr)}})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(result) != 1 {
+				t.Fatalf("expected 1 result, got %d", len(result))
+			}
+			if got := result[0].(types.Integer).Value(); got != tt.want {
+				t.Errorf("expected %d, got %d", tt.want, got)
+			}
+		})
+	}
+
+	t.Run("length counts characters", func(t *testing.T) {
+		fn, _ := Get("length")
+		result, err := fn.Fn(ctx, types.Collection{types.NewString("héllo")}, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := result[0].(types.Integer).Value(); got != 5 {
+			t.Errorf("expected 5, got %d", got)
+		}
+	})
+}
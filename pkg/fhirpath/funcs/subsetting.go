@@ -85,7 +85,8 @@ func fnTail(_ *eval.Context, input types.Collection, _ []interface{}) (types.Col
 	return input.Tail(), nil
 }
 
-// fnSkip returns elements after skipping the first n.
+// fnSkip returns elements after skipping the first n. A zero or negative n
+// returns the whole input, and n beyond the input length returns empty.
 func fnSkip(_ *eval.Context, input types.Collection, args []interface{}) (types.Collection, error) {
 	if len(args) == 0 {
 		return nil, eval.InvalidArgumentsError("skip", 1, 0)
@@ -96,10 +97,11 @@ func fnSkip(_ *eval.Context, input types.Collection, args []interface{}) (types.
 		return nil, err
 	}
 
-	return input.Skip(int(n)), nil
+	return input.Skip(clampCount(n, len(input))), nil
 }
 
-// fnTake returns the first n elements.
+// fnTake returns the first n elements. A zero or negative n returns empty, and
+// n beyond the input length returns the whole input.
 func fnTake(_ *eval.Context, input types.Collection, args []interface{}) (types.Collection, error) {
 	if len(args) == 0 {
 		return nil, eval.InvalidArgumentsError("take", 1, 0)
@@ -110,14 +112,18 @@ func fnTake(_ *eval.Context, input types.Collection, args []interface{}) (types.
 		return nil, err
 	}
 
-	return input.Take(int(n)), nil
+	return input.Take(clampCount(n, len(input))), nil
 }
 
-// fnSingle returns the single element or errors if not exactly one.
+// fnSingle returns the single element, empty for an empty input, or an error
+// if the input has more than one element.
 func fnSingle(_ *eval.Context, input types.Collection, _ []interface{}) (types.Collection, error) {
 	single, err := input.Single()
 	if err != nil {
-		return nil, eval.NewEvalError(eval.ErrSingletonExpected, err.Error())
+		return nil, eval.NewEvalError(eval.ErrSingletonExpected, err.Error()).WithUnderlying(err)
+	}
+	if single == nil {
+		return types.Collection{}, nil
 	}
 	return types.Collection{single}, nil
 }
@@ -150,6 +156,18 @@ func fnExclude(_ *eval.Context, input types.Collection, args []interface{}) (typ
 	return input.Exclude(other), nil
 }
 
+// clampCount limits a skip/take count to [0, length]. Negative counts act as
+// zero, and large computed counts do not overflow int on 32-bit platforms.
+func clampCount(n int64, length int) int {
+	if n < 0 {
+		return 0
+	}
+	if n > int64(length) {
+		return length
+	}
+	return int(n)
+}
+
 // toInteger converts an argument to int64.
 func toInteger(arg interface{}) (int64, error) {
 	switch v := arg.(type) {
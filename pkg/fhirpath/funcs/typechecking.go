@@ -35,7 +35,7 @@ func init() {
 // fnIsType is the function implementation for is().
 // Note: This is typically not called directly - the evaluator handles is() specially
 // to extract type names from the AST. This stub exists for completeness.
-func fnIsType(_ *eval.Context, input types.Collection, args []interface{}) (types.Collection, error) {
+func fnIsType(ctx *eval.Context, input types.Collection, args []interface{}) (types.Collection, error) {
 	if len(args) == 0 {
 		return nil, eval.InvalidArgumentsError("is", 1, 0)
 	}
@@ -56,11 +56,8 @@ func fnIsType(_ *eval.Context, input types.Collection, args []interface{}) (type
 		return types.Collection{}, nil
 	}
 
-	// Get actual type
-	actualType := input[0].Type()
-
-	// Use the exported TypeMatches function from eval package
-	matches := eval.TypeMatches(actualType, typeName)
+	// Use the exported ValueMatchesType function from eval package
+	matches := eval.ValueMatchesType(ctx.FHIRVersion(), input[0], typeName)
 	return types.Collection{types.NewBoolean(matches)}, nil
 }
 
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"io"
 	"os"
+	"strings"
 	"sync"
 	"time"
 
@@ -23,6 +24,8 @@ type TraceEntry struct {
 	Input      interface{} `json:"input"`
 	Projection interface{} `json:"projection,omitempty"`
 	Count      int         `json:"count"`
+	// Paths are the locations of the input elements that are objects
+	Paths []string `json:"paths,omitempty"`
 }
 
 // DefaultTraceLogger logs trace entries to stderr in JSON format.
@@ -59,6 +62,10 @@ func (l *DefaultTraceLogger) Log(entry TraceEntry) {
 		}
 		io.WriteString(l.writer, formatCollection(entry.Input))
 		io.WriteString(l.writer, "\n")
+		if len(entry.Paths) > 0 {
+			io.WriteString(l.writer, "[trace] "+entry.Name+" paths: "+strings.Join(entry.Paths, ", "))
+			io.WriteString(l.writer, "\n")
+		}
 		if entry.Projection != nil {
 			io.WriteString(l.writer, "[trace] "+entry.Name+" projection: ")
 			io.WriteString(l.writer, formatCollection(entry.Projection))
@@ -166,6 +173,7 @@ func fnTrace(_ *eval.Context, input types.Collection, args []interface{}) (types
 		Name:      name,
 		Input:     collectionToInterface(input),
 		Count:     len(input),
+		Paths:     collectionPaths(input),
 	}
 
 	// If a projection is provided, include it
@@ -181,6 +189,17 @@ func fnTrace(_ *eval.Context, input types.Collection, args []interface{}) (types
 	return input, nil
 }
 
+// collectionPaths returns the paths of the objects in col.
+func collectionPaths(col types.Collection) []string {
+	var paths []string
+	for _, item := range col {
+		if obj, ok := item.(*types.ObjectValue); ok && obj.Path() != "" {
+			paths = append(paths, obj.Path())
+		}
+	}
+	return paths
+}
+
 // collectionToInterface converts a Collection to a slice of interface{} for JSON serialization.
 func collectionToInterface(col types.Collection) interface{} {
 	if col.Empty() {
@@ -1,13 +1,20 @@
 package fhirpath_test
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/robertoaraneda/gofhir/pkg/fhir/r4"
 	"github.com/robertoaraneda/gofhir/pkg/fhirpath"
+	"github.com/robertoaraneda/gofhir/pkg/fhirpath/eval"
+	"github.com/robertoaraneda/gofhir/pkg/fhirpath/funcs"
+	"github.com/robertoaraneda/gofhir/pkg/fhirpath/types"
 )
 
 // Test evaluating FHIRPath against JSON bytes
@@ -266,6 +273,141 @@ func TestEvaluateWithOptions(t *testing.T) {
 	}
 }
 
+// Test the collection size and recursion depth limits
+func TestEvaluationLimits(t *testing.T) {
+	patient := []byte(`{
+		"resourceType": "Patient",
+		"name": [{"given": ["A", "B"]}, {"given": ["C", "D"]}],
+		"contact": [{"name": {"given": ["E"]}, "telecom": [{"period": {"start": "2020"}}]}]
+	}`)
+	questionnaire := []byte(`{"resourceType": "Questionnaire", "item": [{"linkId": "1", "item": [{"linkId": "1.1", "item": [{"linkId": "1.1.1"}]}]}]}`)
+
+	tests := []struct {
+		name     string
+		resource []byte
+		expr     string
+		opts     []fhirpath.EvalOption
+		wantErr  bool
+	}{
+		{"within limits", patient, "descendants().count()", nil, false},
+		{"collection size", patient, "name.given", []fhirpath.EvalOption{fhirpath.WithMaxCollectionSize(3)}, true},
+		{"collection size of a function", patient, "name.given.combine(%resource.name.given)", []fhirpath.EvalOption{fhirpath.WithMaxCollectionSize(6)}, true},
+		{"descendants depth", patient, "descendants()", []fhirpath.EvalOption{fhirpath.WithMaxRecursionDepth(3)}, true},
+		{"descendants without depth limit", patient, "descendants()", []fhirpath.EvalOption{fhirpath.WithMaxRecursionDepth(-1)}, false},
+		{"repeat passes", questionnaire, "item.repeat(item)", []fhirpath.EvalOption{fhirpath.WithMaxRecursionDepth(2)}, true},
+		{"repeat within passes", questionnaire, "item.repeat(item)", []fhirpath.EvalOption{fhirpath.WithMaxRecursionDepth(3)}, false},
+		{"unbounded repeat", patient, "(1).repeat($this + 1)", nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := fhirpath.MustCompile(tt.expr).EvaluateWithOptions(tt.resource, tt.opts...)
+			if !tt.wantErr {
+				if err != nil {
+					t.Fatalf("error = %v", err)
+				}
+				return
+			}
+			var evalErr *eval.EvalError
+			if !errors.As(err, &evalErr) || evalErr.Type != eval.ErrLimitExceeded {
+				t.Errorf("error = %v, want a LimitExceededError", err)
+			}
+		})
+	}
+
+	// Contexts without limits use the defaults
+	_, err := fhirpath.Evaluate(patient, "(1).repeat($this + 1)")
+	var evalErr *eval.EvalError
+	if !errors.As(err, &evalErr) || evalErr.Type != eval.ErrLimitExceeded {
+		t.Errorf("error = %v, want a LimitExceededError", err)
+	}
+}
+
+// Test exposing Parameters values as %variables
+func TestEvaluateWithParameters(t *testing.T) {
+	observation := []byte(`{
+		"resourceType": "Observation",
+		"id": "params-test",
+		"valueQuantity": {"value": 7, "unit": "mmol/L"}
+	}`)
+	params := []byte(`{
+		"resourceType": "Parameters",
+		"parameter": [
+			{"name": "threshold", "valueInteger": 5},
+			{"name": "onset", "valueDate": "2024-01-15"},
+			{"name": "patient", "resource": {"resourceType": "Patient", "id": "123"}}
+		]
+	}`)
+
+	tests := []struct {
+		expr string
+		want bool
+	}{
+		{"Observation.valueQuantity.value > %threshold", true},
+		{"Observation.valueQuantity.value > %threshold + 5", false},
+		{"%onset < @2024-02-01", true},
+		{"%patient.id = '123'", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			result, err := fhirpath.EvaluateWithParameters(observation, tt.expr, params)
+			if err != nil {
+				t.Fatalf("EvaluateWithParameters() error = %v", err)
+			}
+			if len(result) != 1 || result[0].String() != fmt.Sprint(tt.want) {
+				t.Errorf("got %v, want %v", result, tt.want)
+			}
+		})
+	}
+
+	t.Run("rejects non-Parameters resource", func(t *testing.T) {
+		_, err := fhirpath.EvaluateWithParameters(observation, "%threshold", observation)
+		if err == nil {
+			t.Error("expected error for non-Parameters resource")
+		}
+	})
+}
+
+// Test the offending path reported in singleton errors
+func TestSingletonErrorPath(t *testing.T) {
+	patient := []byte(`{
+		"resourceType": "Patient",
+		"name": [{"family": "Doe", "given": ["A", "B"]}, {"family": "Roe"}],
+		"birthDate": "1970-01-01"
+	}`)
+
+	tests := []struct {
+		expr string
+		path string
+	}{
+		{"Patient.name.single()", "Patient.name"},
+		{"Patient.name.given.single().length()", "Patient.name.given"},
+		{"Patient.name.where(given.single() = 'A')", "given"},
+		{"Patient.name.family + 'x'", "Patient.name.family"},
+		{"1 + Patient.name.given.count() * Patient.name.given", "Patient.name.given"},
+		{"Patient.birthDate < Patient.name.family", "Patient.name.family"},
+		{"-Patient.name.given", "Patient.name.given"},
+		{"Patient.name.family is String", "Patient.name.family"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			_, err := fhirpath.Evaluate(patient, tt.expr)
+			var evalErr *eval.EvalError
+			if !errors.As(err, &evalErr) || evalErr.Type != eval.ErrSingletonExpected {
+				t.Fatalf("error = %v, want a SingletonExpectedError", err)
+			}
+			if evalErr.Path != tt.path {
+				t.Errorf("Path = %q, want %q", evalErr.Path, tt.path)
+			}
+			if !strings.HasSuffix(err.Error(), " at "+tt.path) {
+				t.Errorf("error = %q, want it to end with the path", err.Error())
+			}
+		})
+	}
+}
+
 // Test helper functions
 func TestHelperFunctions(t *testing.T) {
 	patient := []byte(`{
@@ -295,6 +437,16 @@ func TestHelperFunctions(t *testing.T) {
 		}
 	})
 
+	t.Run("EvaluateToString single value semantics", func(t *testing.T) {
+		result, err := fhirpath.EvaluateToString(patient, "Patient.gender")
+		if err != nil || result != "" {
+			t.Errorf("got %q, %v; want empty string without error for a missing element", result, err)
+		}
+		if _, err := fhirpath.EvaluateToString(patient, "Patient.name.family"); !errors.Is(err, types.ErrSingletonExpected) {
+			t.Errorf("expected ErrSingletonExpected for several values, got %v", err)
+		}
+	})
+
 	t.Run("EvaluateToStrings", func(t *testing.T) {
 		result, err := fhirpath.EvaluateToStrings(patient, "Patient.name.family")
 		if err != nil {
@@ -416,7 +568,11 @@ func TestComparison(t *testing.T) {
 		{"5 = 5", true},
 		{"5 != 10", true},
 		{"'abc' = 'abc'", true},
-		{"'ABC' ~ 'abc'", true}, // equivalence is case-insensitive
+		{"'ABC' ~ 'abc'", true},                      // equivalence is case-insensitive
+		{"'Hello  World' ~ 'hello world'", true},     // whitespace runs collapse
+		{`'  hello\tworld\n' ~ 'Hello World'`, true}, // tabs and newlines are whitespace, ends are trimmed
+		{"'Hello  World' = 'hello world'", false},
+		{"'helloworld' ~ 'hello world'", false},
 	}
 
 	for _, tt := range tests {
@@ -870,6 +1026,740 @@ func TestResourceBaseTypeIntegration(t *testing.T) {
 	})
 }
 
+// Test FHIR version-specific type mappings
+func TestFHIRVersionTypeMatching(t *testing.T) {
+	observation := []byte(`{"resourceType": "Observation", "valueInteger": 42}`)
+	expr := fhirpath.MustCompile("Observation.value is integer64")
+
+	tests := []struct {
+		name string
+		opts []fhirpath.EvalOption
+		want bool
+	}{
+		{"default is R4", nil, false},
+		{"R4", []fhirpath.EvalOption{fhirpath.WithFHIRVersion(eval.FHIRVersionR4)}, false},
+		{"R5", []fhirpath.EvalOption{fhirpath.WithFHIRVersion(eval.FHIRVersionR5)}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := expr.EvaluateWithOptions(observation, tt.opts...)
+			if err != nil {
+				t.Fatalf("error = %v", err)
+			}
+			if len(result) != 1 || result[0].(types.Boolean).Bool() != tt.want {
+				t.Errorf("got %v, want %v", result, tt.want)
+			}
+		})
+	}
+}
+
+// Test round() tie-breaking, half away from zero by default as in the spec
+func TestRoundingMode(t *testing.T) {
+	bankers := fhirpath.WithRoundingMode(eval.RoundHalfEven)
+
+	tests := []struct {
+		expr    string
+		want    string
+		bankers string
+	}{
+		{"2.5.round()", "3", "2"},
+		{"3.5.round()", "4", "4"},
+		{"2.345.round(2)", "2.35", "2.34"},
+		{"(-2.5).round()", "-3", "-2"},
+		{"2.4.round()", "2", "2"},
+		{"3.14159.round(3)", "3.142", "3.142"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			expr := fhirpath.MustCompile(tt.expr)
+
+			result, err := expr.Evaluate([]byte(`{}`))
+			if err != nil {
+				t.Fatalf("error = %v", err)
+			}
+			if len(result) != 1 || result[0].String() != tt.want {
+				t.Errorf("got %v, want %s", result, tt.want)
+			}
+
+			result, err = expr.EvaluateWithOptions([]byte(`{}`), bankers)
+			if err != nil {
+				t.Fatalf("error = %v", err)
+			}
+			if len(result) != 1 || result[0].String() != tt.bankers {
+				t.Errorf("half-even: got %v, want %s", result, tt.bankers)
+			}
+		})
+	}
+}
+
+// Test R5 integer64 values, which FHIR JSON encodes as strings
+func TestInteger64Values(t *testing.T) {
+	observation := []byte(`{"resourceType": "Observation", "valueInteger64": "9223372036854775806"}`)
+	r5 := fhirpath.WithFHIRVersion(eval.FHIRVersionR5)
+
+	tests := []struct {
+		expr string
+		want string
+	}{
+		{"Observation.value.toInteger()", "9223372036854775806"},
+		{"Observation.value is Integer", "true"},
+		{"Observation.value is integer64", "true"},
+		{"Observation.value + 1", "9223372036854775807"},
+		{"Observation.valueInteger64 > 9223372036854775805", "true"},
+		{"Observation.value = 9223372036854775806", "true"},
+		{"Observation.value.toDecimal() / 2", "4611686018427387903"},
+		{"Observation.value.toString()", "9223372036854775806"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			result, err := fhirpath.MustCompile(tt.expr).EvaluateWithOptions(observation, r5)
+			if err != nil {
+				t.Fatalf("error = %v", err)
+			}
+			if len(result) != 1 || result[0].String() != tt.want {
+				t.Errorf("got %v, want %s", result, tt.want)
+			}
+		})
+	}
+
+	t.Run("overflow", func(t *testing.T) {
+		_, err := fhirpath.MustCompile("Observation.value + 2").EvaluateWithOptions(observation, r5)
+		if !errors.Is(err, types.ErrIntegerOverflow) {
+			t.Errorf("expected integer overflow error, got %v", err)
+		}
+	})
+}
+
+// Test filtering by the abstract FHIR types Element, BackboneElement, Resource and DomainResource
+func TestOfTypeAbstractTypes(t *testing.T) {
+	patient := []byte(`{
+		"resourceType": "Patient",
+		"id": "p1",
+		"active": true,
+		"name": [{"family": "Doe"}],
+		"contact": [{"relationship": [{"text": "Emergency"}]}],
+		"contained": [{"resourceType": "Practitioner", "id": "pr1"}]
+	}`)
+
+	tests := []struct {
+		expr string
+		want string
+	}{
+		{"Patient.children().ofType(Element).count()", "2"},
+		{"Patient.children().ofType(FHIR.Element).count()", "2"},
+		{"Patient.children().ofType(Element).first().family", "Doe"},
+		{"Patient.children().ofType(BackboneElement).count()", "1"},
+		{"Patient.children().ofType(BackboneElement).relationship.text", "Emergency"},
+		{"Patient.children().ofType(Resource).count()", "1"},
+		{"Patient.children().ofType(DomainResource).id", "pr1"},
+		{"Patient.children().ofType(HumanName).count()", "1"},
+		{"Patient.name.first() is Element", "true"},
+		{"Patient.contained.first() is Element", "false"},
+		{"Patient.active is Element", "false"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			result, err := fhirpath.Evaluate(patient, tt.expr)
+			if err != nil {
+				t.Fatalf("error = %v", err)
+			}
+			if len(result) != 1 || result[0].String() != tt.want {
+				t.Errorf("got %v, want %s", result, tt.want)
+			}
+		})
+	}
+}
+
+// Test that decimals read from JSON keep their precision
+func TestDecimalPrecision(t *testing.T) {
+	observation := []byte(`{
+		"resourceType": "Observation",
+		"valueQuantity": {"value": 1.50, "unit": "mg"},
+		"component": [{"valueDecimal": 2.000}]
+	}`)
+
+	tests := []struct {
+		expr string
+		want string
+	}{
+		{"Observation.value.value", "1.50"},
+		{"Observation.value.value.toString()", "1.50"},
+		{"Observation.value.value = 1.5", "true"},
+		{"Observation.component.value", "2.000"},
+		{"Observation.component.value = 2", "true"},
+		{"1.50", "1.50"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			result, err := fhirpath.Evaluate(observation, tt.expr)
+			if err != nil {
+				t.Fatalf("error = %v", err)
+			}
+			if len(result) != 1 || result[0].String() != tt.want {
+				t.Errorf("got %v, want %s", result, tt.want)
+			}
+		})
+	}
+}
+
+// Test that equivalence of complex values normalizes their strings
+func TestComplexEquivalence(t *testing.T) {
+	patient := []byte(`{
+		"resourceType": "Patient",
+		"name": [
+			{"family": "Van  Der Berg", "given": ["Jo", "Anne"]},
+			{"family": "van der berg ", "given": ["ANNE", "jo"]},
+			{"family": "Smith", "given": ["Jo"]}
+		]
+	}`)
+
+	tests := []struct {
+		expr string
+		want bool
+	}{
+		{"Patient.name[0] ~ Patient.name[1]", true},
+		{"Patient.name[0] = Patient.name[1]", false},
+		{"Patient.name[0] ~ Patient.name[2]", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			result, err := fhirpath.EvaluateToBoolean(patient, tt.expr)
+			if err != nil {
+				t.Fatalf("error = %v", err)
+			}
+			if result != tt.want {
+				t.Errorf("got %v, want %v", result, tt.want)
+			}
+		})
+	}
+}
+
+// Test @ date, dateTime and time literals and their comparison with FHIR elements
+func TestTemporalLiterals(t *testing.T) {
+	patient := []byte(`{
+		"resourceType": "Patient",
+		"birthDate": "1990-05-01",
+		"meta": {"lastUpdated": "2024-03-01T10:30:00Z"},
+		"extension": [{"url": "http://example.org/time", "valueTime": "08:15:00"}]
+	}`)
+
+	t.Run("literal values", func(t *testing.T) {
+		tests := []struct {
+			expr     string
+			wantType string
+			want     string
+		}{
+			{"@2020-01-01", "Date", "2020-01-01"},
+			{"@2020-01", "Date", "2020-01"},
+			{"@2020", "Date", "2020"},
+			{"@2020-01-01T10:00:00Z", "DateTime", "2020-01-01T10:00:00Z"},
+			{"@2020-01-01T10:00:00.123+02:00", "DateTime", "2020-01-01T10:00:00.123+02:00"},
+			{"@T10:00:00", "Time", "10:00:00"},
+			{"@T10:00", "Time", "10:00"},
+		}
+		for _, tt := range tests {
+			t.Run(tt.expr, func(t *testing.T) {
+				expr, err := fhirpath.Compile(tt.expr)
+				if err != nil {
+					t.Fatalf("Compile() error = %v", err)
+				}
+				result, err := expr.Evaluate(patient)
+				if err != nil {
+					t.Fatalf("Evaluate() error = %v", err)
+				}
+				if len(result) != 1 || result[0].Type() != tt.wantType || result[0].String() != tt.want {
+					t.Errorf("got %v, want %s %s", result, tt.wantType, tt.want)
+				}
+			})
+		}
+	})
+
+	t.Run("comparisons", func(t *testing.T) {
+		tests := []struct {
+			expr string
+			want string
+		}{
+			{"Patient.birthDate < @2000-01-01", "true"},
+			{"@2000-01-01 > Patient.birthDate", "true"},
+			{"Patient.birthDate = @1990-05-01", "true"},
+			{"Patient.birthDate ~ @1990-05-01", "true"},
+			{"Patient.birthDate != @1990-05-02", "true"},
+			{"Patient.meta.lastUpdated >= @2024-01-01T00:00:00Z", "true"},
+			{"Patient.meta.lastUpdated = @2024-03-01T10:30:00Z", "true"},
+			{"Patient.extension.value < @T09:00:00", "true"},
+			{"Patient.extension.value = @T08:15:00", "true"},
+			{"@T10:00:00 + 30 minutes > @T10:15:00", "true"},
+			{"@T23:00 + 2 hours = @T01:00", "true"},
+			{"@T23:00 + 2 hours < @T23:00", "true"},
+			{"@T00:30:00 - 1 hour = @T23:30:00", "true"},
+			{"'not a date' = @2020-01-01", "false"},
+		}
+		for _, tt := range tests {
+			t.Run(tt.expr, func(t *testing.T) {
+				result, err := fhirpath.Evaluate(patient, tt.expr)
+				if err != nil {
+					t.Fatalf("error = %v", err)
+				}
+				if len(result) != 1 || result[0].String() != tt.want {
+					t.Errorf("got %v, want %s", result, tt.want)
+				}
+			})
+		}
+	})
+
+	// Times are compared down to the less precise operand, with seconds and
+	// milliseconds as a single precision; otherwise the result is empty
+	t.Run("time precision", func(t *testing.T) {
+		tests := []struct {
+			expr string
+			want string
+		}{
+			{"@T10 < @T11:30", "[true]"},
+			{"@T10:45 > @T10:30:15", "[true]"},
+			{"@T10 < @T10:30", "[]"},
+			{"@T10:30 >= @T10:30:00", "[]"},
+			{"@T10:30 = @T10:30:00", "[]"},
+			{"@T10:30 != @T10:30:00", "[]"},
+			{"@T10:30 ~ @T10:30:00", "[false]"},
+			{"@T09 = @T10:30", "[false]"},
+			{"@T10:30:00 = @T10:30:00.000", "[true]"},
+			{"@T10:30:00 ~ @T10:30:00.000", "[true]"},
+			{"@T10:30:00 < @T10:30:00.500", "[true]"},
+			{"@T10:30 + 45 minutes = @T11:15", "[true]"},
+			{"Patient.extension.value > @T08", "[]"},
+			{"Patient.extension.value < @T09", "[true]"},
+		}
+		for _, tt := range tests {
+			t.Run(tt.expr, func(t *testing.T) {
+				result, err := fhirpath.Evaluate(patient, tt.expr)
+				if err != nil {
+					t.Fatalf("error = %v", err)
+				}
+				if got := result.String(); got != tt.want {
+					t.Errorf("got %s, want %s", got, tt.want)
+				}
+			})
+		}
+	})
+
+	t.Run("timezone offsets", func(t *testing.T) {
+		tests := []struct {
+			expr string
+			want string
+		}{
+			{"@2024-01-15T10:00:00+01:00 = @2024-01-15T09:00:00Z", "[true]"},
+			{"@2024-01-15T10:00:00+01:00 != @2024-01-15T09:00:00Z", "[false]"},
+			{"@2024-01-15T10:00:00+01:00 ~ @2024-01-15T09:00:00Z", "[true]"},
+			{"@2024-01-15T10:00:00-05:00 > @2024-01-15T14:00:00Z", "[true]"},
+			{"Patient.meta.lastUpdated = @2024-03-01T07:30:00-03:00", "[true]"},
+			{"@2024-01-15T10:00:00 = @2024-01-15T10:00:00Z", "[]"},
+			{"@2024-01-15T10:00:00 < @2024-01-15T11:00:00Z", "[]"},
+			{"@2024-01-15T10:00:00 ~ @2024-01-15T10:00:00Z", "[false]"},
+			{"@2024-01-15T10:00:00 = @2024-01-15T10:00:00", "[true]"},
+		}
+		for _, tt := range tests {
+			t.Run(tt.expr, func(t *testing.T) {
+				result, err := fhirpath.Evaluate(patient, tt.expr)
+				if err != nil {
+					t.Fatalf("error = %v", err)
+				}
+				if result.String() != tt.want {
+					t.Errorf("got %v, want %s", result, tt.want)
+				}
+			})
+		}
+	})
+}
+
+// Test that Quantity subtypes of choice elements keep their type
+func TestQuantitySubtypes(t *testing.T) {
+	condition := []byte(`{
+		"resourceType": "Condition",
+		"onsetAge": {"value": 52, "unit": "a", "system": "http://unitsofmeasure.org", "code": "a"},
+		"extension": [
+			{"url": "http://example.org/duration", "valueDuration": {"value": 3, "unit": "wk", "system": "http://unitsofmeasure.org", "code": "wk"}},
+			{"url": "http://example.org/quantity", "valueQuantity": {"value": 5, "unit": "mg"}}
+		]
+	}`)
+
+	tests := []struct {
+		expr string
+		want string
+	}{
+		{"Condition.onset is Age", "true"},
+		{"Condition.onset is FHIR.Age", "true"},
+		{"Condition.onset is Duration", "false"},
+		{"Condition.onsetAge is Age", "true"},
+		{"Condition.onsetAge is Duration", "false"},
+		{"Condition.onsetAge.ofType(Duration).exists()", "false"},
+		{"Condition.onset is Quantity", "true"},
+		{"Condition.onset.is(Age)", "true"},
+		{"Condition.onset.as(Age).value", "52"},
+		{"Condition.onset.ofType(Duration).exists()", "false"},
+		{"Condition.onset > 50 'a'", "true"},
+		{"Condition.extension.value.ofType(Duration).count()", "1"},
+		{"Condition.extension.value.ofType(Duration).value", "3"},
+		{"Condition.extension.value.ofType(Age).count()", "0"},
+		{"Condition.extension.value.ofType(Quantity).count()", "2"},
+		{"Condition.extension[0].value is Duration", "true"},
+		{"Condition.extension[0].value is Age", "false"},
+		{"Condition.extension[0].valueDuration is Duration", "true"},
+		{"Condition.extension[0].valueDuration is Age", "false"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			result, err := fhirpath.Evaluate(condition, tt.expr)
+			if err != nil {
+				t.Fatalf("error = %v", err)
+			}
+			if len(result) != 1 || result[0].String() != tt.want {
+				t.Errorf("got %v, want %s", result, tt.want)
+			}
+		})
+	}
+}
+
+// Test aggregate() and repeat() over nested collections
+func TestAggregateAndRepeat(t *testing.T) {
+	questionnaire := []byte(`{
+		"resourceType": "Questionnaire",
+		"item": [
+			{"linkId": "1", "item": [
+				{"linkId": "1.1", "item": [{"linkId": "1.1.1"}]},
+				{"linkId": "1.2"}
+			]},
+			{"linkId": "2"}
+		]
+	}`)
+
+	tests := []struct {
+		name string
+		expr string
+		want string
+	}{
+		{"sum", "(1 | 2 | 3 | 4).aggregate($total + $this, 0)", "[10]"},
+		{"without init", "(1 | 2 | 3).aggregate(iif($total.empty(), $this, $total * $this))", "[6]"},
+		{"empty input returns init", "{}.aggregate($total + $this, 5)", "[5]"},
+		{"index in aggregate", "(10 | 20 | 30).aggregate($total + $index, 0)", "[3]"},
+		{"weighted by index", "(5 | 7 | 9).aggregate($total + $this * $index, 0)", "[25]"},
+		{"repeat", "item.repeat(item).linkId", "[1.1, 1.2, 1.1.1]"},
+		{"repeat includes only new items", "(1 | 2).repeat(iif($this < 5, $this + 1, {}))", "[2, 3, 4, 5]"},
+		{"index in repeat is the position", "item.repeat(iif($index = 0, item, {})).linkId", "[1.1, 1.2, 1.1.1]"},
+		{"repeat($index) only follows the second item", "item.repeat(iif($index = 1, item, {})).linkId", "[]"},
+		{"index in repeat restarts each pass", "item.first().repeat(iif($index = 1, {}, item)).linkId", "[1.1, 1.2, 1.1.1]"},
+		{"repeat without results", "item.repeat(code)", "[]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := fhirpath.Evaluate(questionnaire, tt.expr)
+			if err != nil {
+				t.Fatalf("Evaluate(%q) error = %v", tt.expr, err)
+			}
+			if got := result.String(); got != tt.want {
+				t.Errorf("Evaluate(%q) = %s, want %s", tt.expr, got, tt.want)
+			}
+		})
+	}
+}
+
+// Test $index, the position of the item, in iteration functions
+func TestIndexInIteration(t *testing.T) {
+	patient := []byte(`{
+		"resourceType": "Patient",
+		"name": [
+			{"use": "official", "given": ["Ana"]},
+			{"use": "nickname", "given": ["Pepa"]},
+			{"use": "old", "given": ["Josefa"]}
+		]
+	}`)
+
+	tests := []struct {
+		name string
+		expr string
+		want string
+	}{
+		{"where first", "name.where($index = 0).use", "[official]"},
+		{"where after first", "name.where($index > 0).use", "[nickname, old]"},
+		{"where with this", "name.where($index < 2 and $this.use != 'official').use", "[nickname]"},
+		{"select", "name.select($index)", "[0, 1, 2]"},
+		{"select position and value", "name.select(given.first() + $index.toString())", "[Ana0, Pepa1, Josefa2]"},
+		{"exists", "name.exists($index = 2 and use = 'old')", "[true]"},
+		{"all", "name.all($index < 3)", "[true]"},
+		{"all false", "name.all($index < 2)", "[false]"},
+		{"nested where rebinds index", "name.select(given.where($index = 0))", "[Ana, Pepa, Josefa]"},
+		{"index restored after nested call", "name.select(given.where(true).count() + $index)", "[1, 2, 3]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := fhirpath.Evaluate(patient, tt.expr)
+			if err != nil {
+				t.Fatalf("Evaluate(%q) error = %v", tt.expr, err)
+			}
+			if got := result.String(); got != tt.want {
+				t.Errorf("Evaluate(%q) = %s, want %s", tt.expr, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSkipAndTakeBounds(t *testing.T) {
+	patient := []byte(`{"resourceType": "Patient", "name": [{"given": ["A", "B", "C"]}]}`)
+
+	tests := []struct {
+		expr string
+		want string
+	}{
+		{"name.given.skip(0)", "[A, B, C]"},
+		{"name.given.skip(-1)", "[A, B, C]"},
+		{"name.given.skip(2 - 5)", "[A, B, C]"},
+		{"name.given.skip(3)", "[]"},
+		{"name.given.skip(10)", "[]"},
+		{"name.given.take(0)", "[]"},
+		{"name.given.take(-1)", "[]"},
+		{"name.given.take(2 - 3 * 2)", "[]"},
+		{"name.given.take(10)", "[A, B, C]"},
+		{"name.given.take(9223372036854775807)", "[A, B, C]"},
+		{"name.given.skip(1).take(1)", "[B]"},
+		{"name.family.skip(-1)", "[]"},
+		{"name.family.take(-1)", "[]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			result, err := fhirpath.Evaluate(patient, tt.expr)
+			if err != nil {
+				t.Fatalf("Evaluate(%q) error = %v", tt.expr, err)
+			}
+			if got := result.String(); got != tt.want {
+				t.Errorf("Evaluate(%q) = %s, want %s", tt.expr, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEnvironmentConstants(t *testing.T) {
+	patient := []byte(`{
+		"resourceType": "Patient",
+		"extension": [{
+			"url": "http://hl7.org/fhir/StructureDefinition/patient-mothersMaidenName",
+			"valueString": "Smith"
+		}],
+		"gender": "female"
+	}`)
+
+	tests := []struct {
+		expr string
+		want string
+	}{
+		{"%vs-administrative-gender", "[http://hl7.org/fhir/ValueSet/administrative-gender]"},
+		{"%`vs-administrative-gender`", "[http://hl7.org/fhir/ValueSet/administrative-gender]"},
+		{"%'vs-administrative-gender'", "[http://hl7.org/fhir/ValueSet/administrative-gender]"},
+		{"%ext-patient-birthTime", "[http://hl7.org/fhir/StructureDefinition/patient-birthTime]"},
+		{"extension(%ext-patient-mothersMaidenName).value", "[Smith]"},
+		{"Patient.extension(%`ext-patient-mothersMaidenName`).exists()", "[true]"},
+		{"extension.where(url = %ext-patient-mothersMaidenName).value", "[Smith]"},
+		{"%ucum", "[http://unitsofmeasure.org]"},
+		{"%sct", "[http://snomed.info/sct]"},
+		{"%loinc", "[http://loinc.org]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			result, err := fhirpath.Evaluate(patient, tt.expr)
+			if err != nil {
+				t.Fatalf("Evaluate(%q) error = %v", tt.expr, err)
+			}
+			if got := result.String(); got != tt.want {
+				t.Errorf("Evaluate(%q) = %s, want %s", tt.expr, got, tt.want)
+			}
+		})
+	}
+
+	t.Run("variables take precedence", func(t *testing.T) {
+		expr := fhirpath.MustCompile("%ucum")
+		result, err := expr.EvaluateWithOptions(patient, fhirpath.WithVariable("ucum", types.Collection{types.NewString("custom")}))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := result.String(); got != "[custom]" {
+			t.Errorf("got %s, want [custom]", got)
+		}
+	})
+
+	t.Run("unknown constant", func(t *testing.T) {
+		if _, err := fhirpath.Evaluate(patient, "%`vs-`"); err == nil {
+			t.Error("expected error for an empty value set name")
+		}
+	})
+}
+
+func TestObjectValuePath(t *testing.T) {
+	bundle := []byte(`{
+		"resourceType": "Bundle",
+		"entry": [
+			{"resource": {"resourceType": "Patient", "name": [{"use": "usual", "given": ["Jo"]}, {"use": "official", "family": "Doe"}]}},
+			{"resource": {"resourceType": "Observation", "valueQuantity": {"value": 5}}}
+		]
+	}`)
+
+	tests := []struct {
+		expr string
+		want []string
+	}{
+		{"Bundle", []string{"Bundle"}},
+		{"entry.resource", []string{"Bundle.entry[0].resource", "Bundle.entry[1].resource"}},
+		{"entry.resource.ofType(Patient).name.where(use = 'official')", []string{"Bundle.entry[0].resource.name[1]"}},
+		{"entry.resource.ofType(Observation).value", []string{"Bundle.entry[1].resource.valueQuantity"}},
+		{"entry.resource.children().ofType(HumanName)", []string{"Bundle.entry[0].resource.name[0]", "Bundle.entry[0].resource.name[1]"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			result, err := fhirpath.Evaluate(bundle, tt.expr)
+			if err != nil {
+				t.Fatalf("Evaluate() error = %v", err)
+			}
+			var paths []string
+			for _, item := range result {
+				obj, ok := item.(*types.ObjectValue)
+				if !ok {
+					t.Fatalf("result item %v is not an object", item)
+				}
+				paths = append(paths, obj.Path())
+			}
+			if strings.Join(paths, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("paths = %v, want %v", paths, tt.want)
+			}
+		})
+	}
+
+	t.Run("trace reports paths", func(t *testing.T) {
+		var buf bytes.Buffer
+		defer funcs.SetTraceLogger(funcs.GetTraceLogger())
+		funcs.SetTraceLogger(funcs.NewDefaultTraceLogger(&buf, true))
+
+		if _, err := fhirpath.Evaluate(bundle, "entry.resource.name.trace('names')"); err != nil {
+			t.Fatalf("Evaluate() error = %v", err)
+		}
+		var entry funcs.TraceEntry
+		if err := json.Unmarshal(buf.Bytes(), &entry); err != nil {
+			t.Fatalf("trace output %q: %v", buf.String(), err)
+		}
+		want := []string{"Bundle.entry[0].resource.name[0]", "Bundle.entry[0].resource.name[1]"}
+		if strings.Join(entry.Paths, ",") != strings.Join(want, ",") {
+			t.Errorf("trace paths = %v, want %v", entry.Paths, want)
+		}
+	})
+}
+
+// Test function availability and type mappings selected by a versioned context
+func TestNewContextWithVersion(t *testing.T) {
+	observation := []byte(`{
+		"resourceType": "Observation",
+		"valueQuantity": {"value": 5, "unit": "mg", "system": "http://unitsofmeasure.org", "code": "mg"}
+	}`)
+	comparable := fhirpath.MustCompile("Observation.value.comparable(1 'g')")
+
+	t.Run("R5 function", func(t *testing.T) {
+		result, err := comparable.EvaluateWithContext(eval.NewContextWithVersion(observation, eval.FHIRVersionR5))
+		if err != nil {
+			t.Fatalf("error = %v", err)
+		}
+		if len(result) != 1 || !result[0].(types.Boolean).Bool() {
+			t.Errorf("got %v, want true", result)
+		}
+
+		result, err = fhirpath.MustCompile("Observation.value.comparable(1 'm')").
+			EvaluateWithContext(eval.NewContextWithVersion(observation, eval.FHIRVersionR5))
+		if err != nil {
+			t.Fatalf("error = %v", err)
+		}
+		if len(result) != 1 || result[0].(types.Boolean).Bool() {
+			t.Errorf("got %v, want false for incompatible units", result)
+		}
+	})
+
+	for _, version := range []eval.FHIRVersion{eval.FHIRVersionR4, eval.FHIRVersionR4B} {
+		t.Run(string(version)+" rejects R5 function", func(t *testing.T) {
+			_, err := comparable.EvaluateWithContext(eval.NewContextWithVersion(observation, version))
+			var evalErr *eval.EvalError
+			if !errors.As(err, &evalErr) || evalErr.Type != eval.ErrFunctionNotFound {
+				t.Fatalf("expected FunctionNotFoundError, got %v", err)
+			}
+		})
+	}
+
+	t.Run("type mappings", func(t *testing.T) {
+		resource := []byte(`{"resourceType": "Observation", "valueInteger": 42}`)
+		isInteger64 := fhirpath.MustCompile("Observation.value is integer64")
+		for version, want := range map[eval.FHIRVersion]bool{eval.FHIRVersionR4: false, eval.FHIRVersionR5: true} {
+			result, err := isInteger64.EvaluateWithContext(eval.NewContextWithVersion(resource, version))
+			if err != nil {
+				t.Fatalf("%s: error = %v", version, err)
+			}
+			if len(result) != 1 || result[0].(types.Boolean).Bool() != want {
+				t.Errorf("%s: got %v, want %v", version, result, want)
+			}
+		}
+	})
+}
+
+// Test toString() versus the toJson() extension on complex types
+func TestToStringVersusToJSON(t *testing.T) {
+	patient := []byte(`{
+		"resourceType": "Patient",
+		"name": [{"use": "official", "family": "Doe", "given": ["John"]}]
+	}`)
+
+	t.Run("toString on HumanName is empty", func(t *testing.T) {
+		result, err := fhirpath.Evaluate(patient, "Patient.name.first().toString()")
+		if err != nil {
+			t.Fatalf("error = %v", err)
+		}
+		if !result.Empty() {
+			t.Errorf("expected empty, got %v", result)
+		}
+	})
+
+	t.Run("toString on primitive", func(t *testing.T) {
+		result, err := fhirpath.Evaluate(patient, "Patient.name.first().family.toString()")
+		if err != nil {
+			t.Fatalf("error = %v", err)
+		}
+		if len(result) != 1 || result[0].String() != "Doe" {
+			t.Errorf("expected 'Doe', got %v", result)
+		}
+	})
+
+	t.Run("toJson on HumanName", func(t *testing.T) {
+		result, err := fhirpath.Evaluate(patient, "Patient.name.first().toJson()")
+		if err != nil {
+			t.Fatalf("error = %v", err)
+		}
+		if len(result) != 1 {
+			t.Fatalf("expected 1 result, got %v", result)
+		}
+		want := `{"use":"official","family":"Doe","given":["John"]}`
+		if result[0].String() != want {
+			t.Errorf("got %s, want %s", result[0].String(), want)
+		}
+	})
+
+	t.Run("toJson on primitive", func(t *testing.T) {
+		result, err := fhirpath.Evaluate(patient, "Patient.name.first().family.toJson()")
+		if err != nil {
+			t.Fatalf("error = %v", err)
+		}
+		if len(result) != 1 || result[0].String() != `"Doe"` {
+			t.Errorf(`expected "Doe" as JSON string, got %v`, result)
+		}
+	})
+}
+
 // Helper functions
 func strPtr(s string) *string {
 	return &s
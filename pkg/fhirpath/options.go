@@ -16,10 +16,17 @@ type EvalOptions struct {
 	// Timeout for evaluation (0 means no timeout)
 	Timeout time.Duration
 
-	// MaxDepth limits recursion depth for descendants() (0 means default of 100)
+	// MaxRecursionDepth limits the depth of descendants() and the passes of repeat()
+	// (0 means eval.DefaultMaxRecursionDepth, negative means no limit)
+	MaxRecursionDepth int
+
+	// MaxDepth is the former name of MaxRecursionDepth; when set, it takes precedence.
+	//
+	// Deprecated: Use MaxRecursionDepth.
 	MaxDepth int
 
-	// MaxCollectionSize limits output collection size (0 means no limit)
+	// MaxCollectionSize limits the size of the collections built during evaluation
+	// (0 means eval.DefaultMaxCollectionSize, negative means no limit)
 	MaxCollectionSize int
 
 	// Variables are external variables accessible via %name
@@ -27,6 +34,12 @@ type EvalOptions struct {
 
 	// Resolver handles reference resolution for resolve() function
 	Resolver ReferenceResolver
+
+	// FHIRVersion of the resource, used for version-specific type mappings (default R4)
+	FHIRVersion eval.FHIRVersion
+
+	// RoundingMode selects how round() resolves ties (default half away from zero, per the spec)
+	RoundingMode eval.RoundingMode
 }
 
 // DefaultOptions returns default evaluation options suitable for production.
@@ -34,8 +47,8 @@ func DefaultOptions() *EvalOptions {
 	return &EvalOptions{
 		Ctx:               context.Background(),
 		Timeout:           5 * time.Second,
-		MaxDepth:          100,
-		MaxCollectionSize: 10000,
+		MaxRecursionDepth: eval.DefaultMaxRecursionDepth,
+		MaxCollectionSize: eval.DefaultMaxCollectionSize,
 		Variables:         make(map[string]types.Collection),
 	}
 }
@@ -57,14 +70,25 @@ func WithTimeout(d time.Duration) EvalOption {
 	}
 }
 
-// WithMaxDepth sets the maximum recursion depth.
-func WithMaxDepth(depth int) EvalOption {
+// WithMaxRecursionDepth sets the maximum depth of descendants() and number of
+// passes of repeat(). Evaluation fails with an eval.ErrLimitExceeded error
+// when it is exceeded.
+func WithMaxRecursionDepth(depth int) EvalOption {
 	return func(o *EvalOptions) {
-		o.MaxDepth = depth
+		o.MaxRecursionDepth = depth
 	}
 }
 
-// WithMaxCollectionSize sets the maximum output collection size.
+// WithMaxDepth sets the maximum recursion depth.
+//
+// Deprecated: Use WithMaxRecursionDepth.
+func WithMaxDepth(depth int) EvalOption {
+	return WithMaxRecursionDepth(depth)
+}
+
+// WithMaxCollectionSize sets the maximum size of the collections built during
+// evaluation. Evaluation fails with an eval.ErrLimitExceeded error when a
+// navigation step or function returns a larger collection.
 func WithMaxCollectionSize(size int) EvalOption {
 	return func(o *EvalOptions) {
 		o.MaxCollectionSize = size
@@ -88,6 +112,22 @@ func WithResolver(r ReferenceResolver) EvalOption {
 	}
 }
 
+// WithFHIRVersion sets the FHIR version of the resource being evaluated.
+func WithFHIRVersion(version eval.FHIRVersion) EvalOption {
+	return func(o *EvalOptions) {
+		o.FHIRVersion = version
+	}
+}
+
+// WithRoundingMode sets how round() resolves ties. The default,
+// eval.RoundHalfAwayFromZero, follows the FHIRPath specification;
+// eval.RoundHalfEven selects banker's rounding.
+func WithRoundingMode(mode eval.RoundingMode) EvalOption {
+	return func(o *EvalOptions) {
+		o.RoundingMode = mode
+	}
+}
+
 // ReferenceResolver resolves FHIR references for the resolve() function.
 type ReferenceResolver interface {
 	// Resolve takes a reference string (e.g., "Patient/123") and returns the resource.
@@ -118,9 +158,15 @@ func (e *Expression) EvaluateWithOptions(resource []byte, opts ...EvalOption) (t
 	}
 
 	// Set limits in context
-	evalCtx.SetLimit("maxDepth", options.MaxDepth)
+	maxDepth := options.MaxRecursionDepth
+	if options.MaxDepth != 0 {
+		maxDepth = options.MaxDepth
+	}
+	evalCtx.SetLimit("maxDepth", maxDepth)
 	evalCtx.SetLimit("maxCollectionSize", options.MaxCollectionSize)
 	evalCtx.SetContext(ctx)
+	evalCtx.SetFHIRVersion(options.FHIRVersion)
+	evalCtx.SetRoundingMode(options.RoundingMode)
 
 	// Set resolver if provided
 	if options.Resolver != nil {
@@ -0,0 +1,123 @@
+package fhirpath
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/robertoaraneda/gofhir/pkg/fhirpath/types"
+)
+
+// EvaluateWithParameters evaluates a FHIRPath expression against a JSON resource,
+// exposing each parameter of a FHIR Parameters resource as the variable %name.
+//
+// Primitive values (valueInteger, valueString, valueDate, ...) are converted to the
+// corresponding FHIRPath types, complex values (valueQuantity, ...) and resource
+// parameters are exposed as objects, and parameters with parts expose the parameter
+// itself so that %name.part can be navigated. Repeated names are collected together.
+func EvaluateWithParameters(resource []byte, expr string, params []byte) (types.Collection, error) {
+	variables, err := parametersToVariables(params)
+	if err != nil {
+		return nil, err
+	}
+
+	compiled, err := Compile(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := make([]EvalOption, 0, len(variables))
+	for name, value := range variables {
+		opts = append(opts, WithVariable(name, value))
+	}
+	return compiled.EvaluateWithOptions(resource, opts...)
+}
+
+// parametersToVariables converts a Parameters resource into FHIRPath variables keyed by parameter name.
+func parametersToVariables(params []byte) (map[string]types.Collection, error) {
+	var parameters struct {
+		ResourceType string                       `json:"resourceType"`
+		Parameter    []map[string]json.RawMessage `json:"parameter"`
+	}
+	if err := json.Unmarshal(params, &parameters); err != nil {
+		return nil, fmt.Errorf("invalid Parameters resource: %w", err)
+	}
+	if parameters.ResourceType != "Parameters" {
+		return nil, fmt.Errorf("expected resourceType Parameters, got %q", parameters.ResourceType)
+	}
+
+	variables := make(map[string]types.Collection, len(parameters.Parameter))
+	for i, param := range parameters.Parameter {
+		var name string
+		if err := json.Unmarshal(param["name"], &name); err != nil || name == "" {
+			return nil, fmt.Errorf("parameter[%d] has no name", i)
+		}
+
+		value, err := parameterValue(param)
+		if err != nil {
+			return nil, fmt.Errorf("parameter %q: %w", name, err)
+		}
+		variables[name] = append(variables[name], value...)
+	}
+	return variables, nil
+}
+
+// parameterValue returns the value of a single Parameters.parameter entry.
+func parameterValue(param map[string]json.RawMessage) (types.Collection, error) {
+	if raw, ok := param["resource"]; ok {
+		return types.JSONToCollection(raw)
+	}
+
+	for key, raw := range param {
+		typeName, ok := strings.CutPrefix(key, "value")
+		if !ok || typeName == "" {
+			continue
+		}
+		return primitiveParameterValue(typeName, raw)
+	}
+
+	if _, ok := param["part"]; ok {
+		data, err := json.Marshal(param)
+		if err != nil {
+			return nil, err
+		}
+		return types.Collection{types.NewObjectValue(data)}, nil
+	}
+	return types.Collection{}, nil
+}
+
+// primitiveParameterValue converts a value[x] element, using the type suffix to
+// recognise temporal types that are encoded as JSON strings.
+func primitiveParameterValue(typeName string, raw json.RawMessage) (types.Collection, error) {
+	var s string
+	switch typeName {
+	case "Date":
+		if err := json.Unmarshal(raw, &s); err != nil {
+			return nil, err
+		}
+		d, err := types.NewDate(s)
+		if err != nil {
+			return nil, err
+		}
+		return types.Collection{d}, nil
+	case "DateTime", "Instant":
+		if err := json.Unmarshal(raw, &s); err != nil {
+			return nil, err
+		}
+		dt, err := types.NewDateTime(s)
+		if err != nil {
+			return nil, err
+		}
+		return types.Collection{dt}, nil
+	case "Time":
+		if err := json.Unmarshal(raw, &s); err != nil {
+			return nil, err
+		}
+		t, err := types.NewTime(s)
+		if err != nil {
+			return nil, err
+		}
+		return types.Collection{t}, nil
+	}
+	return types.JSONToCollection(raw)
+}
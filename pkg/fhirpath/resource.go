@@ -92,16 +92,14 @@ func EvaluateToBoolean(resource []byte, expr string) (bool, error) {
 	if err != nil {
 		return false, err
 	}
-	if result.Empty() {
-		return false, nil
-	}
-	if len(result) != 1 {
-		return false, fmt.Errorf("expected single value, got %d", len(result))
+	value, err := result.Single()
+	if err != nil || value == nil {
+		return false, err
 	}
-	if b, ok := result[0].(types.Boolean); ok {
+	if b, ok := value.(types.Boolean); ok {
 		return b.Bool(), nil
 	}
-	return false, fmt.Errorf("expected Boolean, got %s", result[0].Type())
+	return false, fmt.Errorf("expected Boolean, got %s", value.Type())
 }
 
 // EvaluateToString evaluates an expression and returns a string result.
@@ -110,17 +108,15 @@ func EvaluateToString(resource []byte, expr string) (string, error) {
 	if err != nil {
 		return "", err
 	}
-	if result.Empty() {
-		return "", nil
-	}
-	if len(result) != 1 {
-		return "", fmt.Errorf("expected single value, got %d", len(result))
+	value, err := result.Single()
+	if err != nil || value == nil {
+		return "", err
 	}
-	if s, ok := result[0].(types.String); ok {
+	if s, ok := value.(types.String); ok {
 		return s.Value(), nil
 	}
 	// Try to convert to string
-	return result[0].String(), nil
+	return value.String(), nil
 }
 
 // EvaluateToStrings evaluates an expression and returns all results as strings.
@@ -35,16 +35,18 @@ func (c Collection) Last() (Value, bool) {
 	return c[len(c)-1], true
 }
 
-// Single returns the single element if the collection has exactly one element.
-// Returns an error if empty or has more than one element.
+// Single returns the element of a collection with exactly one element.
+// Like the FHIRPath single() function, an empty collection yields nil without
+// an error, and a collection with more than one element yields an error
+// wrapping ErrSingletonExpected.
 func (c Collection) Single() (Value, error) {
 	switch len(c) {
 	case 0:
-		return nil, fmt.Errorf("expected single value, got empty collection")
+		return nil, nil
 	case 1:
 		return c[0], nil
 	default:
-		return nil, fmt.Errorf("expected single value, got %d elements", len(c))
+		return nil, fmt.Errorf("%w, got %d elements", ErrSingletonExpected, len(c))
 	}
 }
 
@@ -112,15 +114,38 @@ func (c Collection) IsDistinct() bool {
 // Duplicates are removed.
 func (c Collection) Union(other Collection) Collection {
 	result := make(Collection, 0, len(c)+len(other))
-	result = append(result, c...)
-	for _, item := range other {
-		if !result.Contains(item) {
-			result = append(result, item)
+	for _, items := range []Collection{c, other} {
+		for _, item := range items {
+			if !result.Contains(item) {
+				result = append(result, item)
+			}
 		}
 	}
 	return result
 }
 
+// Flatten returns a single flat collection with the items of c followed by the
+// items of each of others, in order. FHIRPath collections never nest: a
+// navigation step yields a collection for each input item (e.g., the given
+// names of each name in name.given), and the step result is these collections
+// flattened one level. Duplicates are kept.
+func (c Collection) Flatten(others ...Collection) Collection {
+	return flatten(append([]Collection{c}, others...))
+}
+
+// flatten concatenates collections, in order, into a single flat collection.
+func flatten(collections []Collection) Collection {
+	size := 0
+	for _, c := range collections {
+		size += len(c)
+	}
+	result := make(Collection, 0, size)
+	for _, c := range collections {
+		result = append(result, c...)
+	}
+	return result
+}
+
 // Combine returns a new collection that combines c and other.
 // Unlike Union, duplicates are preserved.
 func (c Collection) Combine(other Collection) Collection {
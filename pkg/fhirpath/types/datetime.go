@@ -173,10 +173,13 @@ func (dt DateTime) Type() string {
 	return "DateTime"
 }
 
-// Equal checks equality with another value.
+// Equal checks equality with another value: whether both datetimes are the
+// same instant, once normalized to UTC. Datetimes whose comparison is
+// indeterminate (see Compare) are not equal.
 func (dt DateTime) Equal(other Value) bool {
 	if o, ok := other.(DateTime); ok {
-		return dt.ToTime().Equal(o.ToTime())
+		cmp, err := dt.Compare(o)
+		return err == nil && cmp == 0
 	}
 	return false
 }
@@ -252,6 +255,27 @@ func (dt DateTime) ToTime() time.Time {
 	return time.Date(dt.year, time.Month(month), day, dt.hour, dt.minute, dt.second, dt.millis*1000000, loc)
 }
 
+// hasTime reports whether the datetime has a time part, to which a timezone applies.
+func (dt DateTime) hasTime() bool {
+	return dt.precision >= DTHourPrecision
+}
+
+// inUTC returns the datetime converted to UTC, keeping its precision.
+func (dt DateTime) inUTC() DateTime {
+	t := dt.ToTime().UTC()
+	return DateTime{
+		year:      t.Year(),
+		month:     int(t.Month()),
+		day:       t.Day(),
+		hour:      t.Hour(),
+		minute:    t.Minute(),
+		second:    t.Second(),
+		millis:    t.Nanosecond() / 1000000,
+		hasTZ:     true,
+		precision: dt.precision,
+	}
+}
+
 // Accessors
 func (dt DateTime) Year() int        { return dt.year }
 func (dt DateTime) Month() int       { return dt.month }
@@ -331,6 +355,10 @@ func (dt DateTime) SubtractDuration(value int, unit string) DateTime {
 
 // Compare compares two datetimes. Returns -1, 0, or 1.
 // Implements the Comparable interface.
+// Datetimes with a time and a timezone are normalized to UTC before comparing,
+// so 2024-01-15T10:00:00+01:00 equals 2024-01-15T09:00:00Z. Comparing a
+// datetime with a timezone to one without returns ErrIndeterminateComparison,
+// as the offset of the latter is unknown.
 // Returns error if precisions differ and comparison is ambiguous.
 func (dt DateTime) Compare(other Value) (int, error) {
 	otherDT, ok := other.(DateTime)
@@ -338,6 +366,15 @@ func (dt DateTime) Compare(other Value) (int, error) {
 		return 0, fmt.Errorf("cannot compare DateTime with %s", other.Type())
 	}
 
+	if dt.hasTime() && otherDT.hasTime() {
+		if dt.hasTZ != otherDT.hasTZ {
+			return 0, fmt.Errorf("%w: datetimes with and without timezone", ErrIndeterminateComparison)
+		}
+		if dt.hasTZ {
+			dt, otherDT = dt.inUTC(), otherDT.inUTC()
+		}
+	}
+
 	// Check for ambiguous comparison due to different precisions
 	if dt.precision != otherDT.precision {
 		// Compare at the lowest common precision
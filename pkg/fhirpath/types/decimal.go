@@ -13,15 +13,19 @@ const TypeNameDecimal = "Decimal"
 // Decimal represents a FHIRPath decimal value with arbitrary precision.
 type Decimal struct {
 	value decimal.Decimal
+	// lexical is set for decimals parsed from text (JSON or a literal), whose
+	// trailing zeros are significant and kept by String
+	lexical bool
 }
 
-// NewDecimal creates a new Decimal from a string.
+// NewDecimal creates a new Decimal from a string, keeping its precision:
+// NewDecimal("1.50") has two decimal places and its String is "1.50".
 func NewDecimal(s string) (Decimal, error) {
 	d, err := decimal.NewFromString(s)
 	if err != nil {
 		return Decimal{}, fmt.Errorf("invalid decimal: %s", s)
 	}
-	return Decimal{value: d}, nil
+	return Decimal{value: d, lexical: true}, nil
 }
 
 // NewDecimalFromInt creates a new Decimal from an int64.
@@ -60,6 +64,8 @@ func (d Decimal) Equal(other Value) bool {
 		return d.value.Equal(o.value)
 	case Integer:
 		return d.value.Equal(decimal.NewFromInt(o.value))
+	case Integer64:
+		return d.value.Equal(decimal.NewFromInt(o.value))
 	}
 	return false
 }
@@ -69,11 +75,24 @@ func (d Decimal) Equivalent(other Value) bool {
 	return d.Equal(other)
 }
 
-// String returns the decimal string representation.
+// String returns the decimal string representation. Decimals parsed from text
+// keep their trailing zeros (1.50); computed decimals do not.
 func (d Decimal) String() string {
+	if d.lexical {
+		return FormatDecimalPrecision(d.value)
+	}
 	return d.value.String()
 }
 
+// FormatDecimalPrecision formats a decimal preserving its precision, including
+// trailing zeros that decimal.String would drop (1.50 stays 1.50).
+func FormatDecimalPrecision(d decimal.Decimal) string {
+	if d.Exponent() < 0 {
+		return d.StringFixed(-d.Exponent())
+	}
+	return d.String()
+}
+
 // IsEmpty returns false for decimal values.
 func (d Decimal) IsEmpty() bool {
 	return false
@@ -91,6 +110,8 @@ func (d Decimal) Compare(other Value) (int, error) {
 		return d.value.Cmp(o.value), nil
 	case Integer:
 		return d.value.Cmp(decimal.NewFromInt(o.value)), nil
+	case Integer64:
+		return d.value.Cmp(decimal.NewFromInt(o.value)), nil
 	}
 	return 0, NewTypeError(TypeNameDecimal, other.Type(), "comparison")
 }
@@ -144,11 +165,18 @@ func (d Decimal) Truncate() Integer {
 	return NewInteger(d.value.Truncate(0).IntPart())
 }
 
-// Round rounds to the given precision.
+// Round rounds to the given precision, rounding ties away from zero
+// (2.5 -> 3, -2.5 -> -3).
 func (d Decimal) Round(precision int32) Decimal {
 	return Decimal{value: d.value.Round(precision)}
 }
 
+// RoundHalfEven rounds to the given precision, rounding ties to the even
+// neighbor (2.5 -> 2, 3.5 -> 4).
+func (d Decimal) RoundHalfEven(precision int32) Decimal {
+	return Decimal{value: d.value.RoundBank(precision)}
+}
+
 // Power returns d raised to the given power.
 func (d Decimal) Power(exp Decimal) Decimal {
 	// Convert to float64 for power operation
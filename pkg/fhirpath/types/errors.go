@@ -1,6 +1,19 @@
 package types
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrIndeterminateComparison is returned by Compare when the ordering of two values
+// cannot be determined, such as quantities with comparators whose ranges overlap.
+var ErrIndeterminateComparison = errors.New("indeterminate comparison")
+
+// ErrSingletonExpected is returned by Collection.Single for collections with more than one element.
+var ErrSingletonExpected = errors.New("expected single value")
+
+// ErrIntegerOverflow is returned by Integer64 arithmetic when the result does not fit in 64 bits.
+var ErrIntegerOverflow = errors.New("integer overflow")
 
 // TypeError represents a type mismatch error.
 type TypeError struct {
@@ -27,12 +27,14 @@ func (i Integer) Type() string {
 	return "Integer"
 }
 
-// Equal returns true if other is an Integer with the same value,
+// Equal returns true if other is an Integer or Integer64 with the same value,
 // or a Decimal with an equivalent integer value.
 func (i Integer) Equal(other Value) bool {
 	switch o := other.(type) {
 	case Integer:
 		return i.value == o.value
+	case Integer64:
+		return i.value == o.value
 	case Decimal:
 		// Compare as decimals for cross-type equality
 		return i.ToDecimal().Equal(o)
@@ -71,6 +73,8 @@ func (i Integer) Compare(other Value) (int, error) {
 			return 1, nil
 		}
 		return 0, nil
+	case Integer64:
+		return -o.compareInt(i.value), nil
 	case Decimal:
 		return i.ToDecimal().Compare(o)
 	}
@@ -0,0 +1,207 @@
+package types
+
+import (
+	"fmt"
+	"math"
+	"math/bits"
+	"strconv"
+
+	"github.com/shopspring/decimal"
+)
+
+// TypeNameInteger64 is the FHIRPath type name for integer64 values.
+const TypeNameInteger64 = "Integer64"
+
+// Integer64 represents a FHIR R5 integer64 value.
+// Unlike Integer, its arithmetic reports ErrIntegerOverflow instead of wrapping around.
+type Integer64 struct {
+	value int64
+}
+
+// NewInteger64 creates a new Integer64 value.
+func NewInteger64(v int64) Integer64 {
+	return Integer64{value: v}
+}
+
+// ParseInteger64 parses an integer64 from its string form.
+// FHIR JSON represents integer64 values as strings (e.g., "9223372036854775807").
+func ParseInteger64(s string) (Integer64, error) {
+	v, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return Integer64{}, fmt.Errorf("invalid integer64: %s", s)
+	}
+	return Integer64{value: v}, nil
+}
+
+// Value returns the underlying int64 value.
+func (i Integer64) Value() int64 {
+	return i.value
+}
+
+// Type returns "Integer64".
+func (i Integer64) Type() string {
+	return TypeNameInteger64
+}
+
+// Equal returns true if other is an Integer64 or Integer with the same value,
+// or a Decimal with an equivalent integer value.
+func (i Integer64) Equal(other Value) bool {
+	switch o := other.(type) {
+	case Integer64:
+		return i.value == o.value
+	case Integer:
+		return i.value == o.value
+	case Decimal:
+		return i.ToDecimal().Equal(o)
+	}
+	return false
+}
+
+// Equivalent is the same as Equal for integer64 values.
+func (i Integer64) Equivalent(other Value) bool {
+	return i.Equal(other)
+}
+
+// String returns the decimal string representation.
+func (i Integer64) String() string {
+	return strconv.FormatInt(i.value, 10)
+}
+
+// IsEmpty returns false for integer64 values.
+func (i Integer64) IsEmpty() bool {
+	return false
+}
+
+// ToDecimal converts the integer64 to a Decimal.
+func (i Integer64) ToDecimal() Decimal {
+	return Decimal{value: decimal.NewFromInt(i.value)}
+}
+
+// ToInteger converts the integer64 to an Integer.
+func (i Integer64) ToInteger() Integer {
+	return NewInteger(i.value)
+}
+
+// Compare compares two numeric values.
+func (i Integer64) Compare(other Value) (int, error) {
+	switch o := other.(type) {
+	case Integer64:
+		return i.compareInt(o.value), nil
+	case Integer:
+		return i.compareInt(o.value), nil
+	case Decimal:
+		return i.ToDecimal().Compare(o)
+	}
+	return 0, NewTypeError(TypeNameInteger64, other.Type(), "comparison")
+}
+
+// compareInt compares the integer64 with an int64 value.
+func (i Integer64) compareInt(v int64) int {
+	switch {
+	case i.value < v:
+		return -1
+	case i.value > v:
+		return 1
+	}
+	return 0
+}
+
+// Add returns the sum of two integer64 values.
+func (i Integer64) Add(other Integer64) (Integer64, error) {
+	sum := i.value + other.value
+	// Overflow occurred if both operands have the same sign and the sum's sign differs
+	if (i.value >= 0) == (other.value >= 0) && (sum >= 0) != (i.value >= 0) {
+		return Integer64{}, overflowError("+", i, other)
+	}
+	return Integer64{value: sum}, nil
+}
+
+// Subtract returns the difference of two integer64 values.
+func (i Integer64) Subtract(other Integer64) (Integer64, error) {
+	diff := i.value - other.value
+	// Overflow occurred if the operands have different signs and the result's sign differs from i
+	if (i.value >= 0) != (other.value >= 0) && (diff >= 0) != (i.value >= 0) {
+		return Integer64{}, overflowError("-", i, other)
+	}
+	return Integer64{value: diff}, nil
+}
+
+// Multiply returns the product of two integer64 values.
+func (i Integer64) Multiply(other Integer64) (Integer64, error) {
+	a, b := i.value, other.value
+	if a == 0 || b == 0 {
+		return Integer64{}, nil
+	}
+	hi, lo := bits.Mul64(absUint64(a), absUint64(b))
+	negative := (a < 0) != (b < 0)
+	limit := uint64(math.MaxInt64)
+	if negative {
+		limit++
+	}
+	if hi != 0 || lo > limit {
+		return Integer64{}, overflowError("*", i, other)
+	}
+	if negative {
+		return Integer64{value: int64(-lo)}, nil //nolint:gosec // lo <= 2^63 was checked above
+	}
+	return Integer64{value: int64(lo)}, nil //nolint:gosec // lo <= MaxInt64 was checked above
+}
+
+// Divide returns the result of division as a Decimal.
+func (i Integer64) Divide(other Integer64) (Decimal, error) {
+	if other.value == 0 {
+		return Decimal{}, fmt.Errorf("division by zero")
+	}
+	return i.ToDecimal().Divide(other.ToDecimal())
+}
+
+// Div returns the integer division result.
+func (i Integer64) Div(other Integer64) (Integer64, error) {
+	if other.value == 0 {
+		return Integer64{}, fmt.Errorf("division by zero")
+	}
+	if i.value == math.MinInt64 && other.value == -1 {
+		return Integer64{}, overflowError("div", i, other)
+	}
+	return Integer64{value: i.value / other.value}, nil
+}
+
+// Mod returns the modulo result.
+func (i Integer64) Mod(other Integer64) (Integer64, error) {
+	if other.value == 0 {
+		return Integer64{}, fmt.Errorf("division by zero")
+	}
+	if other.value == -1 {
+		return Integer64{}, nil
+	}
+	return Integer64{value: i.value % other.value}, nil
+}
+
+// Negate returns the negation of the integer64.
+func (i Integer64) Negate() (Integer64, error) {
+	if i.value == math.MinInt64 {
+		return Integer64{}, fmt.Errorf("%w: -(%d)", ErrIntegerOverflow, i.value)
+	}
+	return Integer64{value: -i.value}, nil
+}
+
+// Abs returns the absolute value.
+func (i Integer64) Abs() (Integer64, error) {
+	if i.value < 0 {
+		return i.Negate()
+	}
+	return i, nil
+}
+
+// absUint64 returns the magnitude of v, which is representable for math.MinInt64 as well.
+func absUint64(v int64) uint64 {
+	if v < 0 {
+		return uint64(-(v + 1)) + 1 //nolint:gosec // -(v+1) is non-negative
+	}
+	return uint64(v)
+}
+
+// overflowError reports an integer64 operation whose result is out of range.
+func overflowError(op string, left, right Integer64) error {
+	return fmt.Errorf("%w: %d %s %d", ErrIntegerOverflow, left.value, op, right.value)
+}
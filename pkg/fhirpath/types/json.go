@@ -0,0 +1,92 @@
+package types
+
+import (
+	"encoding/json"
+)
+
+// MarshalJSON methods serialize values to their FHIR JSON representation, so
+// that json.Marshal of a Collection yields a JSON array usable in FHIR content.
+
+// ucumSystem is the code system of UCUM units in FHIR Quantity values.
+const ucumSystem = "http://unitsofmeasure.org"
+
+// calendarDurationUnits are the FHIRPath calendar duration keywords, which are
+// not UCUM codes.
+var calendarDurationUnits = map[string]bool{
+	"year": true, "years": true, "month": true, "months": true,
+	"week": true, "weeks": true, "day": true, "days": true,
+	"hour": true, "hours": true, "minute": true, "minutes": true,
+	"second": true, "seconds": true, "millisecond": true, "milliseconds": true,
+}
+
+// MarshalJSON encodes the boolean as a JSON boolean.
+func (b Boolean) MarshalJSON() ([]byte, error) {
+	return json.Marshal(b.Bool())
+}
+
+// MarshalJSON encodes the integer as a JSON number.
+func (i Integer) MarshalJSON() ([]byte, error) {
+	return json.Marshal(i.Value())
+}
+
+// MarshalJSON encodes the integer64 as a JSON string, as FHIR JSON does, so that
+// values beyond 2^53 survive JavaScript number parsing.
+func (i Integer64) MarshalJSON() ([]byte, error) {
+	return json.Marshal(i.String())
+}
+
+// MarshalJSON encodes the decimal as a JSON number, keeping the precision of
+// decimals read from FHIR content (98.60).
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// MarshalJSON encodes the string as a JSON string.
+func (s String) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.Value())
+}
+
+// MarshalJSON encodes the date as its FHIR string form (2024-01-15).
+func (d Date) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+// MarshalJSON encodes the datetime as its FHIR string form (2024-01-15T10:30:00Z).
+func (dt DateTime) MarshalJSON() ([]byte, error) {
+	return json.Marshal(dt.String())
+}
+
+// MarshalJSON encodes the time as its FHIR string form (10:30:00).
+func (t Time) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.String())
+}
+
+// quantityJSON is the FHIR JSON representation of a Quantity.
+type quantityJSON struct {
+	Value      json.Number `json:"value"`
+	Comparator string      `json:"comparator,omitempty"`
+	Unit       string      `json:"unit,omitempty"`
+	System     string      `json:"system,omitempty"`
+	Code       string      `json:"code,omitempty"`
+}
+
+// MarshalJSON encodes the quantity as a FHIR Quantity object. UCUM units are
+// coded with the UCUM system; calendar duration units (e.g., 'days') are only
+// given as the unit.
+func (q Quantity) MarshalJSON() ([]byte, error) {
+	out := quantityJSON{
+		Value:      json.Number(FormatDecimalPrecision(q.value)),
+		Comparator: q.comparator,
+		Unit:       q.unit,
+	}
+	if q.unit != "" && !calendarDurationUnits[q.unit] {
+		out.System = ucumSystem
+		out.Code = q.unit
+	}
+	return json.Marshal(out)
+}
+
+// MarshalJSON returns the JSON object the value was read from.
+func (o *ObjectValue) MarshalJSON() ([]byte, error) {
+	return o.data, nil
+}
@@ -0,0 +1,81 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestMarshalJSON(t *testing.T) {
+	mustDecimal := func(s string) Decimal {
+		d, err := NewDecimal(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return d
+	}
+	mustQuantity := func(s string) Quantity {
+		q, err := NewQuantity(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return q
+	}
+	date, _ := NewDate("2024-01-15")
+	dateTime, _ := NewDateTime("2024-01-15T10:30:00+01:00")
+	tm, _ := NewTime("10:30:00")
+
+	tests := []struct {
+		name  string
+		value Value
+		want  string
+	}{
+		{"boolean", NewBoolean(true), `true`},
+		{"integer", NewInteger(42), `42`},
+		{"integer64", NewInteger64(9007199254740993), `"9007199254740993"`},
+		{"decimal keeps its precision", mustDecimal("98.60"), `98.60`},
+		{"computed decimal", NewDecimalFromFloat(0.5), `0.5`},
+		{"string", NewString(`say "hi"`), `"say \"hi\""`},
+		{"date", date, `"2024-01-15"`},
+		{"datetime", dateTime, `"2024-01-15T10:30:00+01:00"`},
+		{"time", tm, `"10:30:00"`},
+		{"ucum quantity", mustQuantity("1.50 'mg'"), `{"value":1.50,"unit":"mg","system":"http://unitsofmeasure.org","code":"mg"}`},
+		{"calendar duration", mustQuantity("3 days"), `{"value":3,"unit":"days"}`},
+		{"comparator", NewQuantityWithComparator(decimal.NewFromInt(5), "mg", "<"), `{"value":5,"comparator":"\u003c","unit":"mg","system":"http://unitsofmeasure.org","code":"mg"}`},
+		{"object", NewObjectValue([]byte(`{"family": "Doe", "given": ["John"]}`)), `{"family":"Doe","given":["John"]}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := json.Marshal(tt.value)
+			if err != nil {
+				t.Fatalf("json.Marshal() error = %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("json.Marshal() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("collection", func(t *testing.T) {
+		patient := NewObjectValue([]byte(`{"resourceType": "Patient", "name": [{"family": "Doe"}], "birthDate": "1990-05-01"}`))
+		col := append(patient.GetCollection("name"), NewInteger(1), mustDecimal("2.0"), date)
+		got, err := json.Marshal(col)
+		if err != nil {
+			t.Fatalf("json.Marshal() error = %v", err)
+		}
+		want := `[{"family":"Doe"},1,2.0,"2024-01-15"]`
+		if string(got) != want {
+			t.Errorf("json.Marshal() = %s, want %s", got, want)
+		}
+
+		// The output reads back as the same values
+		back, err := JSONToCollection(got)
+		if err != nil {
+			t.Fatalf("JSONToCollection() error = %v", err)
+		}
+		if len(back) != len(col) || !back[0].Equivalent(col[0]) || !back[1].Equal(col[1]) || back[2].String() != "2.0" {
+			t.Errorf("JSONToCollection() = %v, want %v", back, col)
+		}
+	})
+}
@@ -3,6 +3,7 @@ package types
 import (
 	"bytes"
 	"encoding/json"
+	"strconv"
 	"strings"
 
 	"github.com/buger/jsonparser"
@@ -13,6 +14,12 @@ import (
 type ObjectValue struct {
 	data   []byte
 	fields map[string]Value // Cache of accessed fields
+	// typeName is the declared FHIR type, when known from a choice element
+	typeName string
+	// path is the location of the object from the root, when navigated to
+	path string
+	// canonical caches the canonical JSON of data (see canonicalJSON)
+	canonical []byte
 }
 
 // NewObjectValue creates a new ObjectValue from JSON bytes.
@@ -23,6 +30,61 @@ func NewObjectValue(data []byte) *ObjectValue {
 	}
 }
 
+// WithType returns a copy of o with a declared FHIR type, e.g. "Age" for the
+// value of an onsetAge choice element. A Quantity converted from the copy
+// keeps the declared type as its datatype. The declared type takes precedence over
+// the type inferred from the object's structure.
+func (o *ObjectValue) WithType(typeName string) *ObjectValue {
+	return &ObjectValue{
+		data:      o.data,
+		fields:    make(map[string]Value),
+		typeName:  typeName,
+		path:      o.path,
+		canonical: o.canonical,
+	}
+}
+
+// Path returns the location of the object from the root of the evaluated
+// resource (e.g., "Patient.name[1]" or "Bundle.entry[0].resource"), using the
+// JSON property names (e.g., "Observation.valueQuantity"). Array items are
+// indexed and single values are not. The path accumulates as the object is
+// navigated to with Get, GetCollection or Children; a root object reports its
+// resourceType, or "" when it has none.
+func (o *ObjectValue) Path() string {
+	if o.path != "" {
+		return o.path
+	}
+	rt, _ := jsonparser.GetString(o.data, "resourceType")
+	return rt
+}
+
+// childPath returns the path of the field of o, with index for array items
+// (a negative index for single values).
+func (o *ObjectValue) childPath(field string, index int) string {
+	path := field
+	if base := o.Path(); base != "" {
+		path = base + "." + field
+	}
+	if index >= 0 {
+		path += "[" + strconv.Itoa(index) + "]"
+	}
+	return path
+}
+
+// child sets the path of v, a value of field of o, if it is an object (with index
+// for array items, a negative index for single values). The items of extension
+// fields are declared Extensions, as their type can't be inferred from their
+// structure.
+func (o *ObjectValue) child(v Value, field string, index int) Value {
+	if obj, ok := v.(*ObjectValue); ok {
+		obj.path = o.childPath(field, index)
+		if field == "extension" || field == "modifierExtension" {
+			obj.typeName = typeExtension
+		}
+	}
+	return v
+}
+
 // FHIR type constants for type inference.
 const (
 	typeQuantity        = "Quantity"
@@ -38,17 +100,23 @@ const (
 	typeAddress         = "Address"
 	typeContactPoint    = "ContactPoint"
 	typeAnnotation      = "Annotation"
+	typeExtension       = "Extension"
 	typeObject          = "Object"
 )
 
 // Type returns the FHIR type of this object.
-// First checks resourceType, then attempts to infer common FHIR types from structure.
+// First checks resourceType, then the declared type, then attempts to infer
+// common FHIR types from structure.
 func (o *ObjectValue) Type() string {
 	// First, check for explicit resourceType (FHIR resources)
 	if rt, err := jsonparser.GetString(o.data, "resourceType"); err == nil {
 		return rt
 	}
 
+	if o.typeName != "" {
+		return o.typeName
+	}
+
 	// Try to infer type from structure for common FHIR complex types
 	return o.inferType()
 }
@@ -194,17 +262,163 @@ func (o *ObjectValue) hasAnnotationFields() bool {
 	return o.hasField("time") || o.hasField("authorReference") || o.hasField("authorString")
 }
 
-// Equal returns true if the JSON data is identical.
+// Equal returns true if the objects have the same properties and values: their
+// JSON is compared in canonical form, so the order of the properties and
+// insignificant whitespace don't matter (e.g., for distinct() and union of
+// Codings written with different key orders).
 func (o *ObjectValue) Equal(other Value) bool {
-	if ov, ok := other.(*ObjectValue); ok {
-		return bytes.Equal(o.data, ov.data)
+	ov, ok := other.(*ObjectValue)
+	if !ok {
+		return false
 	}
-	return false
+	if bytes.Equal(o.data, ov.data) {
+		return true
+	}
+	left, right := o.canonicalJSON(), ov.canonicalJSON()
+	return left != nil && right != nil && bytes.Equal(left, right)
+}
+
+// canonicalJSON returns the JSON of the object with its properties sorted by
+// name at every level and without insignificant whitespace, or nil if it is not
+// valid JSON. Numbers are kept as written. It is computed once per object.
+func (o *ObjectValue) canonicalJSON() []byte {
+	if o.canonical == nil {
+		var v interface{}
+		if decodeJSON(o.data, &v) != nil {
+			return nil
+		}
+		// encoding/json writes map keys in sorted order
+		o.canonical, _ = json.Marshal(v)
+	}
+	return o.canonical
 }
 
-// Equivalent is the same as Equal for objects.
+// Equivalent returns true if the objects are equivalent.
+// Codings are equivalent when their system and code match (display and
+// userSelected are ignored). CodeableConcepts are equivalent when they contain
+// the same set of codings. Other objects are equivalent when their properties
+// are equivalent recursively: strings are compared with FHIRPath string
+// normalization (case and whitespace), numbers by value and arrays regardless
+// of order.
 func (o *ObjectValue) Equivalent(other Value) bool {
-	return o.Equal(other)
+	ov, ok := other.(*ObjectValue)
+	if !ok {
+		return false
+	}
+
+	if o.isCodingLike() && ov.isCodingLike() {
+		return o.codingKey() == ov.codingKey()
+	}
+
+	if o.hasArrayField("coding") && ov.hasArrayField("coding") {
+		left, right := o.codingKeys(), ov.codingKeys()
+		if len(left) > 0 || len(right) > 0 {
+			return sameKeySet(left, right)
+		}
+	}
+
+	if o.Equal(other) {
+		return true
+	}
+	var left, right interface{}
+	if decodeJSON(o.data, &left) != nil || decodeJSON(ov.data, &right) != nil {
+		return false
+	}
+	return jsonEquivalent(left, right)
+}
+
+// decodeJSON decodes data into v, keeping numbers as json.Number.
+func decodeJSON(data []byte, v interface{}) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	return dec.Decode(v)
+}
+
+// jsonEquivalent reports whether two decoded JSON values are equivalent.
+func jsonEquivalent(a, b interface{}) bool {
+	switch x := a.(type) {
+	case string:
+		y, ok := b.(string)
+		return ok && normalizeString(x) == normalizeString(y)
+	case json.Number:
+		y, ok := b.(json.Number)
+		if !ok {
+			return false
+		}
+		dx, errX := decimal.NewFromString(x.String())
+		dy, errY := decimal.NewFromString(y.String())
+		return errX == nil && errY == nil && dx.Equal(dy)
+	case map[string]interface{}:
+		y, ok := b.(map[string]interface{})
+		if !ok || len(x) != len(y) {
+			return false
+		}
+		for key, value := range x {
+			if other, ok := y[key]; !ok || !jsonEquivalent(value, other) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		y, ok := b.([]interface{})
+		if !ok || len(x) != len(y) {
+			return false
+		}
+		matched := make([]bool, len(y))
+		for _, item := range x {
+			found := false
+			for i, other := range y {
+				if !matched[i] && jsonEquivalent(item, other) {
+					matched[i] = true
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+		return true
+	default:
+		return a == b
+	}
+}
+
+// isCodingLike checks if the object looks like a Coding (has a string code and
+// is not a Quantity or CodeableConcept).
+func (o *ObjectValue) isCodingLike() bool {
+	return o.hasStringField("code") && !o.hasField("value") && !o.hasField("coding") && !o.hasField("resourceType")
+}
+
+// codingKey returns the system|code identity of a Coding.
+func (o *ObjectValue) codingKey() string {
+	system, _ := jsonparser.GetString(o.data, "system")
+	code, _ := jsonparser.GetString(o.data, "code")
+	return system + "|" + code
+}
+
+// codingKeys returns the set of system|code identities of a CodeableConcept's codings.
+func (o *ObjectValue) codingKeys() map[string]bool {
+	keys := make(map[string]bool)
+	for _, item := range o.GetCollection("coding") {
+		if coding, ok := item.(*ObjectValue); ok {
+			keys[coding.codingKey()] = true
+		}
+	}
+	return keys
+}
+
+// sameKeySet checks if two sets contain the same keys.
+func sameKeySet(a, b map[string]bool) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for key := range a {
+		if !b[key] {
+			return false
+		}
+	}
+	return true
 }
 
 // String returns the JSON representation.
@@ -236,7 +450,7 @@ func (o *ObjectValue) Get(field string) (Value, bool) {
 	}
 
 	// Convert to Value and cache
-	v := jsonValueToFHIRValue(value, dataType)
+	v := o.child(jsonValueToFHIRValue(value, dataType), field, -1)
 	o.fields[field] = v
 
 	return v, true
@@ -252,14 +466,43 @@ func (o *ObjectValue) GetCollection(field string) Collection {
 	}
 
 	if dataType == jsonparser.Array {
-		return jsonArrayToCollection(value)
+		return o.arrayChildren(field, value)
 	}
 
 	v := jsonValueToFHIRValue(value, dataType)
 	if v == nil {
 		return Collection{}
 	}
-	return Collection{v}
+	return Collection{o.child(v, field, -1)}
+}
+
+// arrayChildren converts the JSON array of field to a Collection of values
+// located at the indexed field path. As collections don't nest, the items of
+// arrays nested in the array are flattened in place, at the index of the
+// nested array.
+func (o *ObjectValue) arrayChildren(field string, data []byte) Collection {
+	var result Collection
+	index := 0
+	//nolint:errcheck // ArrayEach only returns errors for non-arrays; data is already validated as array
+	jsonparser.ArrayEach(data, func(value []byte, dataType jsonparser.ValueType, _ int, _ error) {
+		for _, v := range jsonArrayItemValues(value, dataType) {
+			result = append(result, o.child(v, field, index))
+		}
+		index++
+	})
+	return result
+}
+
+// jsonArrayItemValues converts an item of a JSON array to values: none for
+// null, the flattened items of a nested array, or the item value.
+func jsonArrayItemValues(data []byte, dataType jsonparser.ValueType) Collection {
+	if dataType == jsonparser.Array {
+		return jsonArrayToCollection(data)
+	}
+	if v := jsonValueToFHIRValue(data, dataType); v != nil {
+		return Collection{v}
+	}
+	return nil
 }
 
 // Keys returns all field names in the object.
@@ -277,13 +520,13 @@ func (o *ObjectValue) Keys() []string {
 func (o *ObjectValue) Children() Collection {
 	var result Collection
 	//nolint:errcheck // ObjectEach only returns errors for non-objects; o.data is always a valid object
-	jsonparser.ObjectEach(o.data, func(_ []byte, value []byte, dataType jsonparser.ValueType, _ int) error {
+	jsonparser.ObjectEach(o.data, func(key []byte, value []byte, dataType jsonparser.ValueType, _ int) error {
 		if dataType == jsonparser.Array {
-			result = append(result, jsonArrayToCollection(value)...)
+			result = append(result, o.arrayChildren(string(key), value)...)
 		} else {
 			v := jsonValueToFHIRValue(value, dataType)
 			if v != nil {
-				result = append(result, v)
+				result = append(result, o.child(v, string(key), -1))
 			}
 		}
 		return nil
@@ -338,15 +581,13 @@ func jsonValueToFHIRValue(data []byte, dataType jsonparser.ValueType) Value {
 	return nil
 }
 
-// jsonArrayToCollection converts a JSON array to a Collection.
+// jsonArrayToCollection converts a JSON array to a Collection, flattening
+// nested arrays.
 func jsonArrayToCollection(data []byte) Collection {
 	var result Collection
 	//nolint:errcheck // ArrayEach only returns errors for non-arrays; data is already validated as array
 	jsonparser.ArrayEach(data, func(value []byte, dataType jsonparser.ValueType, _ int, _ error) {
-		v := jsonValueToFHIRValue(value, dataType)
-		if v != nil {
-			result = append(result, v)
-		}
+		result = append(result, jsonArrayItemValues(value, dataType)...)
 	})
 	return result
 }
@@ -406,5 +647,10 @@ func (o *ObjectValue) ToQuantity() (Quantity, bool) {
 		unit = string(codeBytes)
 	}
 
-	return NewQuantityFromDecimal(val, unit), true
+	comparator := ""
+	if compBytes, _, _, err := jsonparser.Get(o.data, "comparator"); err == nil {
+		comparator = string(compBytes)
+	}
+
+	return NewQuantityWithComparator(val, unit, comparator).WithDatatype(o.typeName), true
 }
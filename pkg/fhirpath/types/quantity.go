@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"math"
 	"regexp"
 	"strings"
 
@@ -14,6 +15,11 @@ import (
 type Quantity struct {
 	value decimal.Decimal
 	unit  string
+	// comparator is the FHIR Quantity.comparator (<, <=, >=, >), empty when absent
+	comparator string
+	// datatype is the FHIR datatype the quantity came from (Quantity, Age,
+	// Count, Distance, Duration), empty for literals
+	datatype string
 }
 
 // Quantity regex pattern: number followed by optional unit
@@ -46,6 +52,12 @@ func NewQuantityFromDecimal(value decimal.Decimal, unit string) Quantity {
 	return Quantity{value: value, unit: unit}
 }
 
+// NewQuantityWithComparator creates a Quantity from a decimal value, unit and
+// FHIR comparator (<, <=, >=, >).
+func NewQuantityWithComparator(value decimal.Decimal, unit, comparator string) Quantity {
+	return Quantity{value: value, unit: unit, comparator: comparator}
+}
+
 // Type returns the type name.
 func (q Quantity) Type() string {
 	return "Quantity"
@@ -53,9 +65,11 @@ func (q Quantity) Type() string {
 
 // Equal checks equality with another value.
 // For quantities with different units, uses UCUM normalization per FHIRPath spec.
+// Quantities with a comparator (<5 mg) are ranges whose equality is indeterminate,
+// so they are never equal; the = operator returns empty for them.
 func (q Quantity) Equal(other Value) bool {
 	o, ok := other.(Quantity)
-	if !ok {
+	if !ok || q.comparator != "" || o.comparator != "" {
 		return false
 	}
 
@@ -69,7 +83,7 @@ func (q Quantity) Equal(other Value) bool {
 		return q.value.Equal(o.value)
 	}
 
-	// Different units - use UCUM normalization
+	// Different units - use UCUM normalization (5 'kg' = 5000 'g')
 	norm1 := q.Normalize()
 	norm2 := o.Normalize()
 
@@ -78,18 +92,26 @@ func (q Quantity) Equal(other Value) bool {
 		return false
 	}
 
-	// Compare normalized values
-	val1 := decimal.NewFromFloat(norm1.Value)
-	val2 := decimal.NewFromFloat(norm2.Value)
-	return val1.Equal(val2)
+	return normalizedValuesEqual(norm1.Value, norm2.Value)
+}
+
+// UnitsCompatible reports whether q and other can be compared: their units are
+// equal, one of them has no unit, or both normalize to the same UCUM unit.
+// Equality (=) of quantities with incompatible units is empty per the FHIRPath spec.
+func (q Quantity) UnitsCompatible(other Quantity) bool {
+	if q.unit == other.unit || q.unit == "" || other.unit == "" {
+		return true
+	}
+	return q.Normalize().Code == other.Normalize().Code
 }
 
 // Equivalent checks equivalence with another value.
 // For quantities, this uses UCUM normalization to compare values with different units.
 // Per FHIRPath spec: quantities are equivalent if their canonical normalized forms are equal.
+// Quantities with a comparator (<5 mg) are not equivalent to any quantity.
 func (q Quantity) Equivalent(other Value) bool {
 	o, ok := other.(Quantity)
-	if !ok {
+	if !ok || q.comparator != "" || o.comparator != "" {
 		return false
 	}
 
@@ -112,16 +134,14 @@ func (q Quantity) Equivalent(other Value) bool {
 		return false
 	}
 
-	// Compare normalized values with tolerance for floating point
-	diff := norm1.Value - norm2.Value
-	if diff < 0 {
-		diff = -diff
-	}
-	// Use relative tolerance for comparison
-	maxVal := norm1.Value
-	if norm2.Value > maxVal {
-		maxVal = norm2.Value
-	}
+	return normalizedValuesEqual(norm1.Value, norm2.Value)
+}
+
+// normalizedValuesEqual compares UCUM-normalized values with a relative tolerance,
+// absorbing floating point error from the unit conversion (0.1 'kg' vs 100 'g').
+func normalizedValuesEqual(a, b float64) bool {
+	diff := math.Abs(a - b)
+	maxVal := math.Max(math.Abs(a), math.Abs(b))
 	if maxVal == 0 {
 		return diff == 0
 	}
@@ -129,15 +149,18 @@ func (q Quantity) Equivalent(other Value) bool {
 }
 
 // String returns the string representation.
+// The value keeps its precision (8.50 stays 8.50), the comparator is prefixed
+// when present (>5 mg) and no unit is appended when the unit is empty.
 func (q Quantity) String() string {
+	value := q.comparator + FormatDecimalPrecision(q.value)
 	if q.unit == "" {
-		return q.value.String()
+		return value
 	}
 	// Use quotes if unit contains spaces
 	if strings.Contains(q.unit, " ") {
-		return fmt.Sprintf("%s '%s'", q.value.String(), q.unit)
+		return fmt.Sprintf("%s '%s'", value, q.unit)
 	}
-	return fmt.Sprintf("%s %s", q.value.String(), q.unit)
+	return fmt.Sprintf("%s %s", value, q.unit)
 }
 
 // IsEmpty returns false for Quantity.
@@ -155,9 +178,33 @@ func (q Quantity) Unit() string {
 	return q.unit
 }
 
+// Comparator returns the FHIR comparator (<, <=, >=, >), or empty if none.
+func (q Quantity) Comparator() string {
+	return q.comparator
+}
+
+// Datatype returns the FHIR datatype the quantity came from (e.g., "Age"), or
+// empty when it is unknown, as for quantity literals.
+func (q Quantity) Datatype() string {
+	return q.datatype
+}
+
+// WithDatatype returns a copy of q tagged with the FHIR datatype it came from
+// (Quantity, Age, Count, Distance, Duration). The datatype is used by is, as
+// and ofType; it does not affect comparisons or arithmetic.
+func (q Quantity) WithDatatype(datatype string) Quantity {
+	q.datatype = datatype
+	return q
+}
+
 // Compare compares two quantities.
 // Returns -1, 0, or 1 if units are compatible, or error if not.
 // Uses UCUM normalization to compare quantities with different but compatible units.
+// Quantities with a comparator (<5 mg) are treated as ranges: the result is only
+// returned when it holds for every value in the ranges, otherwise
+// ErrIndeterminateComparison is returned (e.g. <5 mg vs 3 mg). Ranges that only
+// touch at an exclusive bound are ordered, so <5 mg < 5 mg is true: every value
+// below 5 mg is less than 5 mg. <=5 mg vs 5 mg is indeterminate, as both may be 5 mg.
 // Implements the Comparable interface.
 func (q Quantity) Compare(other Value) (int, error) {
 	otherQ, ok := other.(Quantity)
@@ -165,6 +212,26 @@ func (q Quantity) Compare(other Value) (int, error) {
 		return 0, fmt.Errorf("cannot compare Quantity with %s", other.Type())
 	}
 
+	cmp, err := q.compareValues(otherQ)
+	if err != nil {
+		return 0, err
+	}
+	if q.comparator == "" && otherQ.comparator == "" {
+		return cmp, nil
+	}
+
+	switch {
+	case rangeBelow(q.comparator, otherQ.comparator, cmp):
+		return -1, nil
+	case rangeBelow(otherQ.comparator, q.comparator, -cmp):
+		return 1, nil
+	default:
+		return 0, ErrIndeterminateComparison
+	}
+}
+
+// compareValues compares the numeric values of two quantities, ignoring comparators.
+func (q Quantity) compareValues(otherQ Quantity) (int, error) {
 	// If units are the same (or one is empty), compare directly
 	if q.unit == otherQ.unit || q.unit == "" || otherQ.unit == "" {
 		return q.value.Cmp(otherQ.value), nil
@@ -185,6 +252,21 @@ func (q Quantity) Compare(other Value) (int, error) {
 	return val1.Cmp(val2), nil
 }
 
+// rangeBelow reports whether every value of the range described by comparator a
+// is strictly less than every value of the range described by comparator b,
+// where cmp is the comparison of the two quantity values.
+func rangeBelow(a, b string, cmp int) bool {
+	// A range unbounded above (>, >=) or another unbounded below (<, <=) always overlap
+	if a == ">" || a == ">=" || b == "<" || b == "<=" {
+		return false
+	}
+	if cmp < 0 {
+		return true
+	}
+	// Touching bounds are disjoint when either is exclusive (<5 vs 5, 5 vs >5)
+	return cmp == 0 && (a == "<" || b == ">")
+}
+
 // Normalize returns the UCUM-normalized form of this quantity.
 func (q Quantity) Normalize() ucum.NormalizedQuantity {
 	val, _ := q.value.Float64()
@@ -1,8 +1,11 @@
 package types
 
 import (
+	"errors"
 	"testing"
 	"time"
+
+	"github.com/shopspring/decimal"
 )
 
 func TestDate(t *testing.T) {
@@ -436,6 +439,56 @@ func TestDateTime(t *testing.T) {
 			t.Error("expected equal times in different timezones")
 		}
 	})
+
+	t.Run("equality across offsets", func(t *testing.T) {
+		dt1, _ := NewDateTime("2024-01-15T10:00:00+01:00")
+		dt2, _ := NewDateTime("2024-01-15T09:00:00Z")
+		dt3, _ := NewDateTime("2024-01-15T10:00:00Z")
+
+		if !dt1.Equal(dt2) || !dt2.Equal(dt1) {
+			t.Error("expected 10:00+01:00 to equal 09:00Z")
+		}
+		if dt1.Equal(dt3) {
+			t.Error("expected 10:00+01:00 not to equal 10:00Z")
+		}
+	})
+
+	t.Run("different precision normalized to UTC", func(t *testing.T) {
+		// 2024-01-16T00:30+01:00 is 2024-01-15T23:30Z
+		dt1, _ := NewDateTime("2024-01-16T00:30+01:00")
+		dt2, _ := NewDateTime("2024-01-15T23:45:00Z")
+
+		cmp, err := dt1.Compare(dt2)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if cmp != -1 {
+			t.Errorf("expected 2024-01-16T00:30+01:00 < 2024-01-15T23:45:00Z, got %d", cmp)
+		}
+	})
+
+	t.Run("missing timezone is indeterminate", func(t *testing.T) {
+		dt1, _ := NewDateTime("2024-01-15T10:00:00")
+		dt2, _ := NewDateTime("2024-01-15T10:00:00Z")
+		dt3, _ := NewDateTime("2024-01-15T10:00:00")
+
+		if _, err := dt1.Compare(dt2); !errors.Is(err, ErrIndeterminateComparison) {
+			t.Errorf("expected ErrIndeterminateComparison, got %v", err)
+		}
+		if dt1.Equal(dt2) {
+			t.Error("expected datetimes with and without timezone not to be equal")
+		}
+		if !dt1.Equal(dt3) {
+			t.Error("expected equal datetimes without timezone to be equal")
+		}
+
+		// Without a time, the timezone does not matter
+		d1, _ := NewDateTime("2024-01-15")
+		d2, _ := NewDateTime("2024-01-16")
+		if cmp, err := d1.Compare(d2); err != nil || cmp != -1 {
+			t.Errorf("expected 2024-01-15 < 2024-01-16, got %d, %v", cmp, err)
+		}
+	})
 }
 
 func TestTime(t *testing.T) {
@@ -614,13 +667,33 @@ func TestTime(t *testing.T) {
 		}
 	})
 
-	t.Run("compare different precision - second vs millisecond ambiguous", func(t *testing.T) {
+	t.Run("compare different precision - seconds and milliseconds are one precision", func(t *testing.T) {
 		t1, _ := NewTime("10:30:45")
 		t2, _ := NewTime("10:30:45.100")
+		t3, _ := NewTime("10:30:45.000")
+
+		cmp, err := t1.Compare(t2)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if cmp != -1 {
+			t.Error("expected 10:30:45 < 10:30:45.100")
+		}
+		if !t1.Equal(t3) {
+			t.Error("expected 10:30:45 = 10:30:45.000")
+		}
+	})
+
+	t.Run("compare different precision - indeterminate", func(t *testing.T) {
+		t1, _ := NewTime("10:30")
+		t2, _ := NewTime("10:30:00")
 
 		_, err := t1.Compare(t2)
-		if err == nil {
-			t.Error("expected ambiguous comparison error")
+		if !errors.Is(err, ErrIndeterminateComparison) {
+			t.Errorf("expected ErrIndeterminateComparison, got %v", err)
+		}
+		if t1.Equal(t2) {
+			t.Error("expected times of different precisions not to be equal")
 		}
 	})
 
@@ -781,4 +854,35 @@ func TestQuantity(t *testing.T) {
 			t.Errorf("expected '5', got '%s'", q2.String())
 		}
 	})
+
+	t.Run("string representation preserves precision", func(t *testing.T) {
+		q, _ := NewQuantity("8.50 mg")
+		if q.String() != "8.50 mg" {
+			t.Errorf("expected '8.50 mg', got '%s'", q.String())
+		}
+	})
+
+	t.Run("string representation with comparator", func(t *testing.T) {
+		tests := []struct {
+			value      string
+			unit       string
+			comparator string
+			expected   string
+		}{
+			{"5", "mg", ">", ">5 mg"},
+			{"5.0", "mg", "<=", "<=5.0 mg"},
+			{"10", "", "<", "<10"},
+			{"3", "mm[Hg]", "", "3 mm[Hg]"},
+		}
+
+		for _, tt := range tests {
+			q := NewQuantityWithComparator(decimal.RequireFromString(tt.value), tt.unit, tt.comparator)
+			if q.String() != tt.expected {
+				t.Errorf("expected '%s', got '%s'", tt.expected, q.String())
+			}
+			if q.Comparator() != tt.comparator {
+				t.Errorf("expected comparator '%s', got '%s'", tt.comparator, q.Comparator())
+			}
+		}
+	})
 }
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"cmp"
 	"fmt"
 	"regexp"
 	"strconv"
@@ -105,25 +106,12 @@ func (t Time) Type() string {
 	return "Time"
 }
 
-// Equal checks equality with another value.
+// Equal checks equality with another value. Times of different precisions
+// are not equal, except seconds and milliseconds (@T10:30:00 = @T10:30:00.000).
 func (t Time) Equal(other Value) bool {
 	if o, ok := other.(Time); ok {
-		if t.precision != o.precision {
-			return false
-		}
-		if t.hour != o.hour {
-			return false
-		}
-		if t.precision >= MinutePrecision && t.minute != o.minute {
-			return false
-		}
-		if t.precision >= SecondPrecision && t.second != o.second {
-			return false
-		}
-		if t.precision >= MillisPrecision && t.millis != o.millis {
-			return false
-		}
-		return true
+		c, err := t.Compare(o)
+		return err == nil && c == 0
 	}
 	return false
 }
@@ -161,93 +149,106 @@ func (t Time) Minute() int      { return t.minute }
 func (t Time) Second() int      { return t.second }
 func (t Time) Millisecond() int { return t.millis }
 
+// millisPerDay is the period of time arithmetic, which wraps around midnight.
+const millisPerDay = 24 * 60 * 60 * 1000
+
+// AddDuration adds a duration to the time. Hours, minutes, seconds and
+// milliseconds are supported; for other units the time is returned unchanged.
+// As a Time has no date, the result wraps around midnight: 23:00 + 2 hours is
+// 01:00, and 01:00 - 2 hours is 23:00. The result keeps the precision of t, so
+// components beyond it are dropped (10:00 + 90 seconds is 10:01).
+func (t Time) AddDuration(value int, unit string) Time {
+	var unitMillis int64
+	switch unit {
+	case "hour", "hours", "'hour'", "'hours'":
+		unitMillis = 60 * 60 * 1000
+	case "minute", "minutes", "'minute'", "'minutes'":
+		unitMillis = 60 * 1000
+	case "second", "seconds", "'second'", "'seconds'":
+		unitMillis = 1000
+	case "millisecond", "milliseconds", "'millisecond'", "'milliseconds'", "ms":
+		unitMillis = 1
+	default:
+		// For unsupported units, return unchanged
+		return t
+	}
+
+	total := int64(t.hour)*60*60*1000 + int64(t.minute)*60*1000 + int64(t.second)*1000 + int64(t.millis)
+	total = (total + int64(value)*unitMillis) % millisPerDay
+	if total < 0 {
+		total += millisPerDay
+	}
+
+	result := Time{
+		hour:      int(total / (60 * 60 * 1000)),
+		minute:    int(total / (60 * 1000) % 60),
+		second:    int(total / 1000 % 60),
+		millis:    int(total % 1000),
+		precision: t.precision,
+	}
+
+	// Adjust precision - zero out components beyond precision
+	if t.precision < MinutePrecision {
+		result.minute = 0
+	}
+	if t.precision < SecondPrecision {
+		result.second = 0
+	}
+	if t.precision < MillisPrecision {
+		result.millis = 0
+	}
+
+	return result
+}
+
+// SubtractDuration subtracts a duration from the time, wrapping around midnight.
+func (t Time) SubtractDuration(value int, unit string) Time {
+	return t.AddDuration(-value, unit)
+}
+
 // Compare compares two times. Returns -1, 0, or 1.
 // Implements the Comparable interface.
-// Returns error if precisions differ and comparison is ambiguous.
+// Times are compared component by component down to the precision of the less
+// precise one, with seconds and milliseconds as a single precision (a decimal
+// number of seconds), as the FHIRPath specification requires. When they are
+// equal up to that precision but their precisions differ, the ordering is
+// unknown and ErrIndeterminateComparison is returned: @T10 < @T11:30 is true
+// but @T10 < @T10:30 is empty.
 func (t Time) Compare(other Value) (int, error) {
 	otherTime, ok := other.(Time)
 	if !ok {
 		return 0, fmt.Errorf("cannot compare Time with %s", other.Type())
 	}
 
-	// Check for ambiguous comparison due to different precisions
-	if t.precision != otherTime.precision {
-		// Compare at the lowest common precision
-		minPrecision := t.precision
-		if otherTime.precision < minPrecision {
-			minPrecision = otherTime.precision
-		}
-
-		// Compare hour
-		if t.hour != otherTime.hour {
-			if t.hour < otherTime.hour {
-				return -1, nil
-			}
-			return 1, nil
-		}
-
-		// Compare minute if both have at least minute precision
-		if minPrecision >= MinutePrecision {
-			if t.minute != otherTime.minute {
-				if t.minute < otherTime.minute {
-					return -1, nil
-				}
-				return 1, nil
-			}
-		} else {
-			return 0, fmt.Errorf("ambiguous comparison between times with different precisions")
-		}
-
-		// Compare second if both have at least second precision
-		if minPrecision >= SecondPrecision {
-			if t.second != otherTime.second {
-				if t.second < otherTime.second {
-					return -1, nil
-				}
-				return 1, nil
-			}
-		} else {
-			return 0, fmt.Errorf("ambiguous comparison between times with different precisions")
-		}
+	precision := t.comparisonPrecision()
+	otherPrecision := otherTime.comparisonPrecision()
+	minPrecision := min(precision, otherPrecision)
 
-		// If we get here, comparison is ambiguous at milliseconds level
-		return 0, fmt.Errorf("ambiguous comparison between times with different precisions")
+	if c := cmp.Compare(t.hour, otherTime.hour); c != 0 {
+		return c, nil
 	}
-
-	// Same precision - direct comparison
-	if t.hour < otherTime.hour {
-		return -1, nil
-	}
-	if t.hour > otherTime.hour {
-		return 1, nil
-	}
-
-	if t.precision >= MinutePrecision {
-		if t.minute < otherTime.minute {
-			return -1, nil
-		}
-		if t.minute > otherTime.minute {
-			return 1, nil
+	if minPrecision >= MinutePrecision {
+		if c := cmp.Compare(t.minute, otherTime.minute); c != 0 {
+			return c, nil
 		}
 	}
-
-	if t.precision >= SecondPrecision {
-		if t.second < otherTime.second {
-			return -1, nil
-		}
-		if t.second > otherTime.second {
-			return 1, nil
+	if minPrecision >= SecondPrecision {
+		if c := cmp.Compare(t.second*1000+t.millis, otherTime.second*1000+otherTime.millis); c != 0 {
+			return c, nil
 		}
 	}
 
-	if t.precision >= MillisPrecision {
-		if t.millis < otherTime.millis {
-			return -1, nil
-		}
-		if t.millis > otherTime.millis {
-			return 1, nil
-		}
+	if precision != otherPrecision {
+		return 0, fmt.Errorf("%w: times with different precisions", ErrIndeterminateComparison)
 	}
-
 	return 0, nil
 }
+
+// comparisonPrecision returns the precision at which t is compared, where
+// seconds and milliseconds are a single precision (@T10:30:00 = @T10:30:00.000).
+func (t Time) comparisonPrecision() TimePrecision {
+	if t.precision == MillisPrecision {
+		return SecondPrecision
+	}
+	return t.precision
+}
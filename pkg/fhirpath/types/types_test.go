@@ -1,6 +1,9 @@
 package types
 
 import (
+	"errors"
+	"math"
+	"strings"
 	"testing"
 )
 
@@ -71,6 +74,15 @@ func TestString(t *testing.T) {
 		if !s2.Equivalent(s3) {
 			t.Error("expected hello ~ '  hello  '")
 		}
+		if !NewString("Hello  World").Equivalent(NewString("hello world")) {
+			t.Error("expected 'Hello  World' ~ 'hello world'")
+		}
+		if !NewString("hello\t\r\nworld").Equivalent(NewString("HELLO WORLD")) {
+			t.Error("expected tabs and newlines to be normalized to a single space")
+		}
+		if NewString("helloworld").Equivalent(NewString("hello world")) {
+			t.Error("expected whitespace to be collapsed, not removed")
+		}
 	})
 
 	t.Run("methods", func(t *testing.T) {
@@ -157,6 +169,99 @@ func TestInteger(t *testing.T) {
 	})
 }
 
+func TestInteger64(t *testing.T) {
+	t.Run("parse", func(t *testing.T) {
+		i, err := ParseInteger64("9223372036854775807")
+		if err != nil {
+			t.Fatalf("ParseInteger64() error = %v", err)
+		}
+		if i.Value() != math.MaxInt64 {
+			t.Errorf("expected MaxInt64, got %d", i.Value())
+		}
+		if i.Type() != "Integer64" {
+			t.Errorf("expected Integer64, got %s", i.Type())
+		}
+		if _, err := ParseInteger64("9223372036854775808"); err == nil {
+			t.Error("expected error for out-of-range integer64")
+		}
+	})
+
+	t.Run("large value arithmetic", func(t *testing.T) {
+		big := NewInteger64(4_000_000_000_000_000_000)
+
+		sum, err := big.Add(NewInteger64(5_000_000_000_000_000_000))
+		if err != nil || sum.Value() != 9_000_000_000_000_000_000 {
+			t.Errorf("Add() = %v, %v", sum, err)
+		}
+		diff, err := NewInteger64(math.MinInt64).Subtract(NewInteger64(-1))
+		if err != nil || diff.Value() != math.MinInt64+1 {
+			t.Errorf("Subtract() = %v, %v", diff, err)
+		}
+		product, err := NewInteger64(-3_000_000_000).Multiply(NewInteger64(3_000_000_000))
+		if err != nil || product.Value() != -9_000_000_000_000_000_000 {
+			t.Errorf("Multiply() = %v, %v", product, err)
+		}
+		minimum, err := NewInteger64(math.MinInt64 / 2).Multiply(NewInteger64(2))
+		if err != nil || minimum.Value() != math.MinInt64 {
+			t.Errorf("Multiply() = %v, %v", minimum, err)
+		}
+	})
+
+	t.Run("overflow", func(t *testing.T) {
+		maxValue := NewInteger64(math.MaxInt64)
+		minValue := NewInteger64(math.MinInt64)
+
+		checks := map[string]func() (Integer64, error){
+			"add":      func() (Integer64, error) { return maxValue.Add(NewInteger64(1)) },
+			"subtract": func() (Integer64, error) { return minValue.Subtract(NewInteger64(1)) },
+			"multiply": func() (Integer64, error) { return maxValue.Multiply(NewInteger64(2)) },
+			"multiply negative": func() (Integer64, error) {
+				return minValue.Multiply(NewInteger64(-1))
+			},
+			"div":    func() (Integer64, error) { return minValue.Div(NewInteger64(-1)) },
+			"negate": minValue.Negate,
+		}
+		for name, check := range checks {
+			if _, err := check(); !errors.Is(err, ErrIntegerOverflow) {
+				t.Errorf("%s: expected ErrIntegerOverflow, got %v", name, err)
+			}
+		}
+	})
+
+	t.Run("comparison", func(t *testing.T) {
+		big := NewInteger64(math.MaxInt64)
+
+		if cmp, _ := big.Compare(NewInteger64(math.MaxInt64 - 1)); cmp != 1 {
+			t.Errorf("expected MaxInt64 > MaxInt64-1, got %d", cmp)
+		}
+		if cmp, _ := big.Compare(NewInteger(10)); cmp != 1 {
+			t.Errorf("expected integer64 > integer, got %d", cmp)
+		}
+		if cmp, _ := NewInteger(10).Compare(big); cmp != -1 {
+			t.Errorf("expected integer < integer64, got %d", cmp)
+		}
+		if cmp, _ := big.Compare(MustDecimal("9223372036854775807.5")); cmp != -1 {
+			t.Errorf("expected integer64 < decimal, got %d", cmp)
+		}
+		if _, err := big.Compare(NewString("1")); err == nil {
+			t.Error("expected type error comparing with String")
+		}
+	})
+
+	t.Run("equality", func(t *testing.T) {
+		i := NewInteger64(42)
+		if !i.Equal(NewInteger64(42)) || !i.Equal(NewInteger(42)) || !NewInteger(42).Equal(i) {
+			t.Error("expected 42 == 42 across integer types")
+		}
+		if !i.Equal(MustDecimal("42.0")) || !MustDecimal("42").Equal(i) {
+			t.Error("expected integer64 == decimal with the same value")
+		}
+		if i.Equal(NewInteger64(43)) {
+			t.Error("expected 42 != 43")
+		}
+	})
+}
+
 func TestDecimal(t *testing.T) {
 	t.Run("creation", func(t *testing.T) {
 		d, err := NewDecimal("3.14")
@@ -168,6 +273,38 @@ func TestDecimal(t *testing.T) {
 		}
 	})
 
+	t.Run("lexical precision", func(t *testing.T) {
+		d := MustDecimal("1.50")
+		if d.String() != "1.50" {
+			t.Errorf("expected 1.50, got %s", d.String())
+		}
+		if d.Value().Exponent() != -2 {
+			t.Errorf("expected 2 decimal places, got exponent %d", d.Value().Exponent())
+		}
+		if !d.Equal(MustDecimal("1.5")) {
+			t.Error("expected 1.50 = 1.5")
+		}
+		if got := d.Add(MustDecimal("0.5")).String(); got != "2" {
+			t.Errorf("expected computed decimals to drop trailing zeros, got %s", got)
+		}
+		for _, want := range []string{"1.50", "0.000", "100", "-2.10"} {
+			if got := FormatDecimalPrecision(MustDecimal(want).Value()); got != want {
+				t.Errorf("FormatDecimalPrecision(%s) = %s", want, got)
+			}
+		}
+
+		obj := NewObjectValue([]byte(`{"value": 1.50, "exponent": 1.0e2}`))
+		for field, want := range map[string]string{"value": "1.50", "exponent": "100"} {
+			v, ok := obj.Get(field)
+			if !ok {
+				t.Fatalf("expected field %s", field)
+			}
+			if v.String() != want {
+				t.Errorf("%s: expected %s, got %s", field, want, v.String())
+			}
+		}
+	})
+
 	t.Run("precision", func(t *testing.T) {
 		d1 := MustDecimal("0.1")
 		d2 := MustDecimal("0.2")
@@ -200,6 +337,13 @@ func TestDecimal(t *testing.T) {
 		if d.Floor().Value() != 3 {
 			t.Errorf("expected floor 3, got %d", d.Floor().Value())
 		}
+
+		if got := MustDecimal("2.5").Round(0).String(); got != "3" {
+			t.Errorf("expected 2.5 rounded half away from zero to be 3, got %s", got)
+		}
+		if got := MustDecimal("2.5").RoundHalfEven(0).String(); got != "2" {
+			t.Errorf("expected 2.5 rounded half to even to be 2, got %s", got)
+		}
 	})
 
 	t.Run("cross-type equality", func(t *testing.T) {
@@ -238,6 +382,12 @@ func TestCollection(t *testing.T) {
 		if !ok || last.(Integer).Value() != 3 {
 			t.Error("expected last = 3")
 		}
+		if v, ok := (Collection{}).First(); ok || v != nil {
+			t.Error("expected no first value for empty collection")
+		}
+		if v, ok := (Collection{}).Last(); ok || v != nil {
+			t.Error("expected no last value for empty collection")
+		}
 	})
 
 	t.Run("single", func(t *testing.T) {
@@ -247,16 +397,17 @@ func TestCollection(t *testing.T) {
 			t.Error("expected single = 42")
 		}
 
+		// Like single(), an empty collection has no value but is not an error
 		c2 := Collection{}
-		_, err = c2.Single()
-		if err == nil {
-			t.Error("expected error for empty collection")
+		single, err = c2.Single()
+		if err != nil || single != nil {
+			t.Errorf("expected nil value without error for empty collection, got %v, %v", single, err)
 		}
 
 		c3 := Collection{NewInteger(1), NewInteger(2)}
 		_, err = c3.Single()
-		if err == nil {
-			t.Error("expected error for multiple elements")
+		if !errors.Is(err, ErrSingletonExpected) {
+			t.Errorf("expected ErrSingletonExpected for multiple elements, got %v", err)
 		}
 	})
 
@@ -298,6 +449,24 @@ func TestCollection(t *testing.T) {
 		}
 	})
 
+	t.Run("flatten", func(t *testing.T) {
+		flat := Collection{NewString("a")}.Flatten(Collection{NewString("b")}, nil, Collection{NewString("a")})
+		if flat.Count() != 3 {
+			t.Fatalf("expected 3 values, got %d: %v", flat.Count(), flat)
+		}
+		for i, want := range []string{"a", "b", "a"} {
+			if s, ok := flat[i].(String); !ok || s.Value() != want {
+				t.Errorf("flat[%d] = %v, want %q", i, flat[i], want)
+			}
+		}
+		if !(Collection{}).Flatten().Empty() {
+			t.Error("expected empty collection when flattening nothing")
+		}
+		if c := (Collection{NewString("a")}).Flatten(); c.Count() != 1 {
+			t.Errorf("expected a flat collection to flatten to itself, got %v", c)
+		}
+	})
+
 	t.Run("boolean aggregation", func(t *testing.T) {
 		c := Collection{NewBoolean(true), NewBoolean(true), NewBoolean(true)}
 		if !c.AllTrue() {
@@ -315,6 +484,75 @@ func TestCollection(t *testing.T) {
 }
 
 func TestObjectValue(t *testing.T) {
+	t.Run("equivalence", func(t *testing.T) {
+		a := NewObjectValue([]byte(`{"family": "Van  Der Berg", "given": ["Jo", "Anne"], "period": {"start": "2020"}}`))
+		b := NewObjectValue([]byte(`{"given": ["anne", "JO"], "family": " van der berg", "period": {"start": "2020"}}`))
+		c := NewObjectValue([]byte(`{"family": "Van Der Berg", "given": ["Jo"]}`))
+		d := NewObjectValue([]byte(`{"value": 1.50, "unit": "mg"}`))
+		e := NewObjectValue([]byte(`{"value": 1.5, "unit": "MG"}`))
+
+		if !a.Equivalent(b) {
+			t.Error("expected objects with normalized-equal strings to be equivalent")
+		}
+		if a.Equivalent(c) {
+			t.Error("expected objects with different properties not to be equivalent")
+		}
+		if !d.Equivalent(e) {
+			t.Error("expected numbers to be compared by value")
+		}
+	})
+
+	t.Run("equality", func(t *testing.T) {
+		a := NewObjectValue([]byte(`{"system": "http://loinc.org", "code": "8867-4", "period": {"start": "2020", "end": "2021"}}`))
+		b := NewObjectValue([]byte(`{"period":{"end":"2021","start":"2020"},"code":"8867-4","system":"http://loinc.org"}`))
+		c := NewObjectValue([]byte(`{"system": "http://loinc.org", "code": "8867-4", "display": "Heart rate"}`))
+
+		if !a.Equal(b) || !b.Equal(a) {
+			t.Error("expected objects with different key orders to be equal")
+		}
+		if a.Equal(c) {
+			t.Error("expected objects with different properties not to be equal")
+		}
+		if distinct := (Collection{a, c, b}).Distinct(); len(distinct) != 2 {
+			t.Errorf("Distinct() = %v, want 2 objects", distinct)
+		}
+	})
+
+	t.Run("path", func(t *testing.T) {
+		obj := NewObjectValue([]byte(`{"resourceType": "Patient", "name": [{"family": "Doe"}, {"given": ["Jo"]}], "contact": {"name": {"family": "Roe"}}}`))
+		if obj.Path() != "Patient" {
+			t.Errorf("root path = %q, want Patient", obj.Path())
+		}
+
+		names := obj.GetCollection("name")
+		if len(names) != 2 || names[1].(*ObjectValue).Path() != "Patient.name[1]" {
+			t.Errorf("name paths = %v, want Patient.name[1] for the second item", names)
+		}
+		contact, _ := obj.Get("contact")
+		contactName := contact.(*ObjectValue).GetCollection("name")[0].(*ObjectValue)
+		if contactName.Path() != "Patient.contact.name" {
+			t.Errorf("contact name path = %q, want Patient.contact.name", contactName.Path())
+		}
+		if typed := contactName.WithType("HumanName"); typed.Path() != contactName.Path() {
+			t.Errorf("WithType() path = %q, want %q", typed.Path(), contactName.Path())
+		}
+
+		var childPaths []string
+		for _, child := range obj.Children() {
+			if o, ok := child.(*ObjectValue); ok {
+				childPaths = append(childPaths, o.Path())
+			}
+		}
+		want := []string{"Patient.name[0]", "Patient.name[1]", "Patient.contact"}
+		if strings.Join(childPaths, ",") != strings.Join(want, ",") {
+			t.Errorf("Children() paths = %v, want %v", childPaths, want)
+		}
+
+		if p := NewObjectValue([]byte(`{"value": 1}`)).Path(); p != "" {
+			t.Errorf("path without resourceType = %q, want empty", p)
+		}
+	})
+
 	t.Run("creation", func(t *testing.T) {
 		json := []byte(`{"name": "John", "age": 30}`)
 		obj := NewObjectValue(json)
@@ -409,6 +647,27 @@ func TestObjectValue(t *testing.T) {
 		}
 	})
 
+	t.Run("declared type", func(t *testing.T) {
+		obj := NewObjectValue([]byte(`{"value": 52, "unit": "a"}`)).WithType("Age")
+		if obj.Type() != "Age" {
+			t.Errorf("expected Age, got %s", obj.Type())
+		}
+
+		q, ok := obj.ToQuantity()
+		if !ok {
+			t.Fatal("expected ToQuantity to succeed")
+		}
+		if q.Datatype() != "Age" {
+			t.Errorf("expected datatype Age, got %q", q.Datatype())
+		}
+		if q.Type() != "Quantity" {
+			t.Errorf("expected type Quantity, got %s", q.Type())
+		}
+		if !q.Equal(NewQuantityFromDecimal(q.Value(), "a")) {
+			t.Error("expected the datatype to be ignored by Equal")
+		}
+	})
+
 	t.Run("toQuantity without unit", func(t *testing.T) {
 		json := []byte(`{"value": 42}`)
 		obj := NewObjectValue(json)
@@ -510,6 +769,43 @@ func TestObjectValue(t *testing.T) {
 			t.Error("expected 120 mm[Hg] > 90 mm[Hg]")
 		}
 	})
+
+	t.Run("coding equivalence ignores display", func(t *testing.T) {
+		a := NewObjectValue([]byte(`{"system": "http://loinc.org", "code": "8480-6", "display": "Systolic"}`))
+		b := NewObjectValue([]byte(`{"system": "http://loinc.org", "code": "8480-6", "display": "Systolic BP", "userSelected": true}`))
+		c := NewObjectValue([]byte(`{"system": "http://loinc.org", "code": "8462-4"}`))
+
+		if !a.Equivalent(b) {
+			t.Error("expected codings differing only in display to be equivalent")
+		}
+		if a.Equal(b) {
+			t.Error("expected codings differing in display not to be equal")
+		}
+		if a.Equivalent(c) {
+			t.Error("expected codings with different codes not to be equivalent")
+		}
+	})
+
+	t.Run("codeableConcept equivalence compares coding sets", func(t *testing.T) {
+		a := NewObjectValue([]byte(`{"coding": [
+			{"system": "http://loinc.org", "code": "8480-6"},
+			{"system": "http://snomed.info/sct", "code": "271649006", "display": "Systolic"}
+		], "text": "Systolic"}`))
+		b := NewObjectValue([]byte(`{"coding": [
+			{"system": "http://snomed.info/sct", "code": "271649006"},
+			{"system": "http://loinc.org", "code": "8480-6", "display": "Systolic blood pressure"}
+		]}`))
+		c := NewObjectValue([]byte(`{"coding": [
+			{"system": "http://loinc.org", "code": "8480-6"}
+		]}`))
+
+		if !a.Equivalent(b) {
+			t.Error("expected concepts with the same codings in different order to be equivalent")
+		}
+		if a.Equivalent(c) {
+			t.Error("expected concepts with different coding sets not to be equivalent")
+		}
+	})
 }
 
 func TestJSONToCollection(t *testing.T) {
@@ -535,6 +831,28 @@ func TestJSONToCollection(t *testing.T) {
 		}
 	})
 
+	t.Run("nested array", func(t *testing.T) {
+		json := []byte(`[1, [2, [3, null]], 4]`)
+		c, err := JSONToCollection(json)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if c.Count() != 4 {
+			t.Fatalf("expected 4 flattened elements, got %d: %v", c.Count(), c)
+		}
+		for i, v := range c {
+			if n, ok := v.(Integer); !ok || n.Value() != int64(i+1) {
+				t.Errorf("c[%d] = %v, want %d", i, v, i+1)
+			}
+		}
+
+		obj := NewObjectValue([]byte(`{"given": [["John", "James"], "Johnny"]}`))
+		given := obj.GetCollection("given")
+		if given.Count() != 3 {
+			t.Errorf("expected 3 flattened children, got %d: %v", given.Count(), given)
+		}
+	})
+
 	t.Run("null", func(t *testing.T) {
 		json := []byte(`null`)
 		c, err := JSONToCollection(json)
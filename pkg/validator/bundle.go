@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"regexp"
 	"strings"
 )
 
@@ -62,6 +63,23 @@ var bundleTypesAllowingSearch = map[string]bool{
 	BundleTypeSearchset: true,
 }
 
+// requestTypeRegex matches a resource type in a request url path.
+var requestTypeRegex = regexp.MustCompile(`^[A-Z][A-Za-z]*$`)
+
+// conditionalRequestFields lists the conditional entry.request fields and the
+// HTTP methods each one applies to, in the order they are checked.
+var conditionalRequestFields = []struct {
+	name    string
+	methods map[string]bool
+}{
+	// Conditional create
+	{"ifNoneExist", map[string]bool{"POST": true}},
+	// Version-aware update and delete
+	{"ifMatch", map[string]bool{"PUT": true, "PATCH": true, "DELETE": true}},
+	// Conditional read, or update/delete when the version differs
+	{"ifNoneMatch", map[string]bool{"GET": true, "HEAD": true, "PUT": true, "PATCH": true, "DELETE": true}},
+}
+
 // validateBundle performs Bundle-specific validation after standard validation.
 // This method is called automatically by Validate() when resourceType is "Bundle".
 func (v *Validator) validateBundle(ctx context.Context, vctx *validationContext, result *ValidationResult) {
@@ -106,6 +124,10 @@ func (v *Validator) validateBundleConstraints(_ context.Context, bundle map[stri
 		}
 	}
 
+	if bundleType == BundleTypeSearchset && v.options.ValidateBestPractices {
+		v.validateSearchsetTotal(bundle, result)
+	}
+
 	// bdl-9: A document must have an identifier with a system and a value
 	if bundleType == BundleTypeDocument {
 		v.validateDocumentIdentifier(bundle, result)
@@ -124,6 +146,38 @@ func (v *Validator) validateBundleConstraints(_ context.Context, bundle map[stri
 	}
 }
 
+// validateSearchsetTotal warns when a searchset Bundle.total is lower than the
+// number of entries with search.mode = match. The total is the server-wide match
+// count, so it may exceed the entries in a page but can never be smaller.
+func (v *Validator) validateSearchsetTotal(bundle map[string]interface{}, result *ValidationResult) {
+	total, ok := bundle["total"].(float64)
+	if !ok {
+		return
+	}
+
+	matches := 0
+	entries, _ := bundle["entry"].([]interface{})
+	for _, entry := range entries {
+		entryMap, ok := entry.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		search, _ := entryMap["search"].(map[string]interface{})
+		if mode, _ := search["mode"].(string); mode == "match" {
+			matches++
+		}
+	}
+
+	if int(total) < matches {
+		result.AddIssue(ValidationIssue{
+			Severity:    SeverityWarning,
+			Code:        IssueCodeValue,
+			Diagnostics: fmt.Sprintf("Bundle.total (%d) is lower than the number of entries with search.mode 'match' (%d)", int(total), matches),
+			Expression:  []string{"Bundle.total"},
+		})
+	}
+}
+
 // validateDocumentIdentifier validates bdl-9: document identifier requirements.
 func (v *Validator) validateDocumentIdentifier(bundle map[string]interface{}, result *ValidationResult) {
 	identifier, hasIdentifier := bundle["identifier"]
@@ -310,7 +364,7 @@ func (v *Validator) validateEntryRequest(_ map[string]interface{}, entryPath, bu
 
 	// Validate request content if present
 	if hasRequest && request != nil {
-		v.validateRequestContent(request, entryPath, result)
+		v.validateRequestContent(request, entryPath, bundleType, result)
 	}
 }
 
@@ -340,8 +394,9 @@ func (v *Validator) validateEntryResponse(_ map[string]interface{}, entryPath, b
 	}
 }
 
-// validateRequestContent validates entry.request required fields.
-func (v *Validator) validateRequestContent(request map[string]interface{}, entryPath string, result *ValidationResult) {
+// validateRequestContent validates entry.request required fields, and the form of
+// the url for the method in batch and transaction bundles.
+func (v *Validator) validateRequestContent(request map[string]interface{}, entryPath, bundleType string, result *ValidationResult) {
 	method, hasMethod := request["method"].(string)
 	requestURL, hasURL := request["url"].(string)
 
@@ -365,6 +420,8 @@ func (v *Validator) validateRequestContent(request map[string]interface{}, entry
 				Diagnostics: fmt.Sprintf("Invalid request method: '%s'", method),
 				Expression:  []string{entryPath + ".request.method"},
 			})
+		} else {
+			v.validateConditionalRequest(request, method, entryPath, result)
 		}
 	}
 
@@ -375,6 +432,122 @@ func (v *Validator) validateRequestContent(request map[string]interface{}, entry
 			Diagnostics: "Bundle.entry.request.url is required",
 			Expression:  []string{entryPath + ".request.url"},
 		})
+		return
+	}
+
+	if bundleType != BundleTypeBatch && bundleType != BundleTypeTransaction {
+		return
+	}
+	if problem := requestURLProblem(method, requestURL); problem != "" {
+		result.AddIssue(ValidationIssue{
+			Severity:    SeverityError,
+			Code:        IssueCodeInvariant,
+			Diagnostics: fmt.Sprintf("Bundle.entry.request.url '%s' is not valid for method '%s': %s", requestURL, method, problem),
+			Expression:  []string{entryPath + ".request.url"},
+		})
+	}
+}
+
+// requestURLProblem checks the form of a batch or transaction request url, which
+// is relative to the server base, for its HTTP method. It returns a description
+// of the problem, or "" if the url is well-formed. GET and HEAD may read or search
+// (Patient/123, Patient?name=x), POST creates (Patient), PUT, PATCH and DELETE
+// target an instance (Patient/123) or are conditional (Patient?identifier=x), and
+// operations ($everything) may be invoked with GET or POST. Absolute urls and
+// unknown methods are not checked.
+func requestURLProblem(method, requestURL string) string {
+	if strings.Contains(requestURL, "://") {
+		return ""
+	}
+	path, query, hasQuery := strings.Cut(requestURL, "?")
+	var segments []string
+	if path != "" {
+		segments = strings.Split(path, "/")
+	}
+	for _, segment := range segments {
+		if segment == "" {
+			return "the path has an empty segment"
+		}
+	}
+
+	// System ($meta), type (Patient/$validate) and instance (Patient/123/$everything) operations
+	if n := len(segments); n > 0 && strings.HasPrefix(segments[n-1], "$") {
+		switch {
+		case method == "PUT" || method == "PATCH" || method == "DELETE":
+			return "operations are invoked with GET or POST"
+		case n > 3 || (n > 1 && !requestTypeRegex.MatchString(segments[0])) || (n == 3 && !idRegex.MatchString(segments[1])):
+			return "expected '$operation', 'Type/$operation' or 'Type/id/$operation'"
+		}
+		return ""
+	}
+
+	switch method {
+	case "GET", "HEAD":
+		return readOrSearchURLProblem(segments, hasQuery)
+	case "POST":
+		if hasQuery || len(segments) == 0 || len(segments) > 2 || !requestTypeRegex.MatchString(segments[0]) ||
+			(len(segments) == 2 && segments[1] != "_search") {
+			return "expected a create on 'Type' or a search on 'Type/_search'"
+		}
+	case "PUT", "PATCH", "DELETE":
+		instance := len(segments) == 2 && !hasQuery && idRegex.MatchString(segments[1])
+		conditional := len(segments) == 1 && hasQuery && query != ""
+		if (!instance && !conditional) || !requestTypeRegex.MatchString(segments[0]) {
+			return "expected 'Type/id' or a conditional 'Type?parameters'"
+		}
+	}
+	return ""
+}
+
+// readOrSearchURLProblem checks the path segments of a GET or HEAD request url:
+// a search on the system or a type, a read or version read of an instance, its
+// history, or a compartment search (Patient/123/Observation).
+func readOrSearchURLProblem(segments []string, hasQuery bool) string {
+	if len(segments) == 0 {
+		if !hasQuery {
+			return "expected a search or a read"
+		}
+		return ""
+	}
+	if len(segments) == 1 && (segments[0] == "metadata" || segments[0] == "_history") {
+		return ""
+	}
+	if !requestTypeRegex.MatchString(segments[0]) {
+		return fmt.Sprintf("'%s' is not a resource type", segments[0])
+	}
+
+	switch len(segments) {
+	case 1:
+		return ""
+	case 2:
+		if segments[1] == "_history" || idRegex.MatchString(segments[1]) {
+			return ""
+		}
+	case 3:
+		if idRegex.MatchString(segments[1]) && (segments[2] == "_history" || requestTypeRegex.MatchString(segments[2])) {
+			return ""
+		}
+	case 4:
+		if idRegex.MatchString(segments[1]) && segments[2] == "_history" && idRegex.MatchString(segments[3]) {
+			return ""
+		}
+	}
+	return "expected 'Type', 'Type/id', 'Type/id/_history/vid' or a search"
+}
+
+// validateConditionalRequest checks that conditional request fields are only
+// used with the HTTP methods they apply to (e.g., ifNoneExist only with POST).
+func (v *Validator) validateConditionalRequest(request map[string]interface{}, method, entryPath string, result *ValidationResult) {
+	for _, field := range conditionalRequestFields {
+		if _, present := request[field.name]; !present || field.methods[method] {
+			continue
+		}
+		result.AddIssue(ValidationIssue{
+			Severity:    SeverityError,
+			Code:        IssueCodeInvariant,
+			Diagnostics: fmt.Sprintf("Bundle.entry.request.%s is not allowed with method '%s'", field.name, method),
+			Expression:  []string{entryPath + ".request." + field.name},
+		})
 	}
 }
 
@@ -436,7 +609,7 @@ func (v *Validator) validateEntryResource(ctx context.Context, vctx *validationC
 	}
 
 	// Get StructureDefinition for the resource type
-	sd, err := v.registry.GetByType(ctx, resourceType)
+	sd, err := v.structureDefByType(ctx, resourceType)
 	if err != nil {
 		result.AddIssue(ValidationIssue{
 			Severity:    SeverityError,
@@ -448,7 +621,7 @@ func (v *Validator) validateEntryResource(ctx context.Context, vctx *validationC
 	}
 
 	// Create a new validation context for the nested resource
-	nestedIndex := v.buildElementIndex(sd)
+	nestedIndex := v.elementIndexFor(sd)
 	nestedVctx := &validationContext{
 		raw:          vctx.raw, // Keep original raw for reference resolution
 		parsed:       resource,
@@ -472,6 +645,7 @@ func (v *Validator) validateEntryResource(ctx context.Context, vctx *validationC
 	// Validate constraints if enabled
 	if v.options.ValidateConstraints {
 		v.validateNestedConstraints(ctx, nestedVctx, entryPath, result)
+		v.validateContainedRules(ctx, nestedVctx, entryPath+".resource", result)
 	}
 
 	// Validate terminology if enabled
@@ -484,54 +658,55 @@ func (v *Validator) validateEntryResource(ctx context.Context, vctx *validationC
 		v.validateExtensions(ctx, nestedVctx, result)
 	}
 
+	// Validate identifiers if enabled
+	if v.options.ValidateIdentifiers {
+		v.validateIdentifiers(ctx, nestedVctx, entryPath+".resource", result)
+	}
+
+	if v.options.ValidateBestPractices && resourceType == resourceTypeObservation {
+		v.validateReferenceRangeUnits(resource, entryPath+".resource", result)
+	}
+
 	// Recursively validate nested Bundles
 	if resourceType == ResourceTypeBundle {
 		v.validateBundle(ctx, nestedVctx, result)
 	}
+
+	// Custom validators
+	v.runResourceValidators(ctx, nestedVctx, entryPath+".resource", result)
 }
 
 // validateNestedConstraints validates FHIRPath constraints for nested resources.
 func (v *Validator) validateNestedConstraints(_ context.Context, vctx *validationContext, basePath string, result *ValidationResult) {
-	for _, elem := range vctx.sd.Snapshot {
-		for _, constraint := range elem.Constraints {
-			if constraint.Expression == "" {
-				continue
-			}
-
-			if constraint.Source != "" && constraint.Source != vctx.sd.URL {
-				continue
-			}
+	for _, sc := range snapshotConstraints(vctx) {
+		elemPath, constraint := sc.path, sc.constraint
 
-			if elem.Path != vctx.resourceType && !elementExistsInResource(vctx.parsed, elem.Path, vctx.resourceType) {
-				continue
-			}
+		// For nested resources, we need to marshal back to JSON for FHIRPath evaluation
+		// This is a performance tradeoff for correctness
+		valid, err := v.evaluateConstraintOnParsed(vctx.parsed, elemPath, vctx.resourceType, constraint)
+		if err != nil {
+			result.AddIssue(ValidationIssue{
+				Severity:    SeverityWarning,
+				Code:        IssueCodeProcessing,
+				Diagnostics: fmt.Sprintf("Failed to evaluate constraint %s on %s: %v", constraint.Key, basePath+"."+elemPath, err),
+				Expression:  []string{basePath + "." + elemPath},
+			})
+			continue
+		}
 
-			// For nested resources, we need to marshal back to JSON for FHIRPath evaluation
-			// This is a performance tradeoff for correctness
-			valid, err := v.evaluateConstraintOnParsed(vctx.parsed, elem.Path, vctx.resourceType, constraint)
-			if err != nil {
-				result.AddIssue(ValidationIssue{
-					Severity:    SeverityWarning,
-					Code:        IssueCodeProcessing,
-					Diagnostics: fmt.Sprintf("Failed to evaluate constraint %s on %s: %v", constraint.Key, basePath+"."+elem.Path, err),
-					Expression:  []string{basePath + "." + elem.Path},
-				})
-				continue
+		if !valid {
+			severity := SeverityError
+			if constraint.Severity == "warning" {
+				severity = SeverityWarning
 			}
 
-			if !valid {
-				severity := SeverityError
-				if constraint.Severity == "warning" {
-					severity = SeverityWarning
-				}
-
-				result.AddIssue(ValidationIssue{
-					Severity:    severity,
-					Code:        IssueCodeInvariant,
-					Diagnostics: fmt.Sprintf("Constraint %s violated: %s", constraint.Key, constraint.Human),
-					Expression:  []string{basePath + "." + elem.Path},
-				})
-			}
+			result.AddIssue(ValidationIssue{
+				Severity:    severity,
+				Code:        IssueCodeInvariant,
+				Diagnostics: fmt.Sprintf("Constraint %s violated: %s", constraint.Key, constraint.Human),
+				Expression:  []string{basePath + "." + elemPath},
+				Constraint:  issueConstraint(constraint, vctx.sd.URL),
+			})
 		}
 	}
 }
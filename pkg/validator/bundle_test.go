@@ -1003,6 +1003,145 @@ func TestValidateEntryRequestContent(t *testing.T) {
 	}
 }
 
+func TestValidateConditionalRequestMethod(t *testing.T) {
+	v := newMinimalValidator(t, ValidatorOptions{}, bundleTestSD)
+	ctx := context.Background()
+
+	tests := []struct {
+		name        string
+		request     string
+		expectField string
+	}{
+		{"POST with ifNoneExist", `{"method": "POST", "url": "Patient", "ifNoneExist": "identifier=123"}`, ""},
+		{"PUT with ifMatch", `{"method": "PUT", "url": "Patient/1", "ifMatch": "W/\"1\""}`, ""},
+		{"DELETE with ifMatch", `{"method": "DELETE", "url": "Patient/1", "ifMatch": "W/\"1\""}`, ""},
+		{"GET with ifNoneMatch", `{"method": "GET", "url": "Patient/1", "ifNoneMatch": "W/\"1\""}`, ""},
+		{"POST with ifMatch", `{"method": "POST", "url": "Patient", "ifMatch": "W/\"1\""}`, "ifMatch"},
+		{"PUT with ifNoneExist", `{"method": "PUT", "url": "Patient/1", "ifNoneExist": "identifier=123"}`, "ifNoneExist"},
+		{"POST with ifNoneMatch", `{"method": "POST", "url": "Patient", "ifNoneMatch": "W/\"1\""}`, "ifNoneMatch"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bundle := []byte(`{
+				"resourceType": "Bundle",
+				"id": "test-conditional",
+				"type": "transaction",
+				"entry": [{"request": ` + tt.request + `}]
+			}`)
+
+			result, err := v.Validate(ctx, bundle)
+			if err != nil {
+				t.Fatalf("Validate returned error: %v", err)
+			}
+
+			var fields []string
+			for _, issue := range result.Issues {
+				if issue.Code == IssueCodeInvariant && strings.Contains(issue.Diagnostics, "is not allowed with method") {
+					fields = append(fields, issue.Expression[0])
+				}
+			}
+
+			if tt.expectField == "" {
+				if len(fields) > 0 {
+					t.Errorf("Unexpected conditional request errors: %v", fields)
+				}
+				return
+			}
+			want := "Bundle.entry[0].request." + tt.expectField
+			if len(fields) != 1 || fields[0] != want {
+				t.Errorf("Expected error on %s, got %v", want, fields)
+			}
+		})
+	}
+}
+
+func TestValidateRequestURL(t *testing.T) {
+	v := newMinimalValidator(t, ValidatorOptions{}, bundleTestSD)
+	ctx := context.Background()
+
+	tests := []struct {
+		method    string
+		url       string
+		expectErr bool
+	}{
+		{"GET", "Patient", false},
+		{"GET", "Patient?name=smith&_count=10", false},
+		{"GET", "Patient/123", false},
+		{"GET", "Patient/123/_history/2", false},
+		{"GET", "Patient/123/Observation?code=8310-5", false},
+		{"GET", "?_type=Patient", false},
+		{"GET", "metadata", false},
+		{"GET", "Patient/123/$everything", false},
+		{"HEAD", "Patient/123", false},
+		{"POST", "Patient", false},
+		{"POST", "Patient/_search", false},
+		{"POST", "Patient/$validate", false},
+		{"POST", "$process-message", false},
+		{"PUT", "Patient/123", false},
+		{"PUT", "Patient?identifier=http://example.org|123", false},
+		{"PATCH", "Patient/123", false},
+		{"DELETE", "Patient/123", false},
+		{"DELETE", "Patient?identifier=123", false},
+		{"POST", "https://example.org/fhir/Patient", false},
+		{"GET", "patient/123", true},
+		{"GET", "Patient//123", true},
+		{"GET", "Patient/123/_history/2/extra", true},
+		{"GET", "Patient/bad_id", true},
+		{"POST", "Patient/123", true},
+		{"POST", "Patient?name=smith", true},
+		{"PUT", "Patient", true},
+		{"DELETE", "Patient", true},
+		{"DELETE", "Patient/123/_history/2", true},
+		{"DELETE", "Patient/$everything", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.method+" "+tt.url, func(t *testing.T) {
+			bundle := []byte(`{
+				"resourceType": "Bundle",
+				"type": "batch",
+				"entry": [{"request": {"method": "` + tt.method + `", "url": "` + tt.url + `"}}]
+			}`)
+
+			result, err := v.Validate(ctx, bundle)
+			if err != nil {
+				t.Fatalf("Validate returned error: %v", err)
+			}
+
+			var urlIssues []ValidationIssue
+			for _, issue := range result.Issues {
+				if issue.Code == IssueCodeInvariant && issue.Expression[0] == "Bundle.entry[0].request.url" {
+					urlIssues = append(urlIssues, issue)
+				}
+			}
+			if tt.expectErr && len(urlIssues) != 1 {
+				t.Errorf("Expected one request.url error, got %v", result.Issues)
+			}
+			if !tt.expectErr && len(urlIssues) > 0 {
+				t.Errorf("Unexpected request.url errors: %v", urlIssues)
+			}
+		})
+	}
+
+	t.Run("not checked in history bundles", func(t *testing.T) {
+		bundle := []byte(`{
+			"resourceType": "Bundle",
+			"type": "history",
+			"entry": [{"request": {"method": "POST", "url": "Patient/123"}, "response": {"status": "201"}}]
+		}`)
+		result, err := v.Validate(ctx, bundle)
+		if err != nil {
+			t.Fatalf("Validate returned error: %v", err)
+		}
+		for _, issue := range result.Issues {
+			if strings.Contains(issue.Diagnostics, "request.url") {
+				t.Errorf("Unexpected request.url issue: %v", issue)
+			}
+		}
+	})
+}
+
 func TestValidateEntryResponseContent(t *testing.T) {
 	v := setupTestValidator(t)
 	ctx := context.Background()
@@ -1531,6 +1670,84 @@ func TestValidateCompleteSearchsetBundle(t *testing.T) {
 	}
 }
 
+// ============================================================================
+// Best practice: searchset total vs match entries
+// ============================================================================
+
+// bundleTestSD is a minimal Bundle definition, so Bundle-specific checks can run
+// without the FHIR specification files.
+var bundleTestSD = &StructureDef{
+	URL:  "http://hl7.org/fhir/StructureDefinition/Bundle",
+	Name: "Bundle",
+	Type: "Bundle",
+	Kind: "resource",
+	Snapshot: []ElementDef{
+		{Path: "Bundle", Min: 0, Max: "*"},
+		{Path: "Bundle.id", Min: 0, Max: "1", Types: []TypeRef{{Code: "id"}}},
+		{Path: "Bundle.type", Min: 1, Max: "1", Types: []TypeRef{{Code: "code"}}},
+		{Path: "Bundle.total", Min: 0, Max: "1", Types: []TypeRef{{Code: "unsignedInt"}}},
+		{Path: "Bundle.entry", Min: 0, Max: "*", Types: []TypeRef{{Code: "BackboneElement"}}},
+	},
+}
+
+func TestValidateSearchsetTotal(t *testing.T) {
+	ctx := context.Background()
+
+	entry := func(mode string) string {
+		return `{"search": {"mode": "` + mode + `"}}`
+	}
+
+	tests := []struct {
+		name          string
+		bestPractices bool
+		total         string
+		entries       []string
+		expectWarning bool
+	}{
+		{"total equals matches", true, "2", []string{entry("match"), entry("match")}, false},
+		{"total exceeds matches (paged)", true, "50", []string{entry("match"), entry("match")}, false},
+		{"includes are not counted", true, "1", []string{entry("match"), entry("include"), entry("include")}, false},
+		{"total lower than matches", true, "1", []string{entry("match"), entry("match")}, true},
+		{"no total", true, "", []string{entry("match")}, false},
+		{"best practices disabled", false, "1", []string{entry("match"), entry("match")}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := newMinimalValidator(t, ValidatorOptions{ValidateBestPractices: tt.bestPractices}, bundleTestSD)
+
+			totalField := ""
+			if tt.total != "" {
+				totalField = `"total": ` + tt.total + `,`
+			}
+			bundle := []byte(`{
+				"resourceType": "Bundle",
+				"id": "test-total",
+				"type": "searchset",
+				` + totalField + `
+				"entry": [` + strings.Join(tt.entries, ",") + `]
+			}`)
+
+			result, err := v.Validate(ctx, bundle)
+			if err != nil {
+				t.Fatalf("Validate returned error: %v", err)
+			}
+
+			hasWarning := false
+			for _, issue := range result.Issues {
+				if issue.Severity == SeverityWarning && strings.Contains(issue.Diagnostics, "Bundle.total") {
+					hasWarning = true
+					break
+				}
+			}
+
+			if hasWarning != tt.expectWarning {
+				t.Errorf("Expected total warning = %v, got issues: %v", tt.expectWarning, result.Issues)
+			}
+		})
+	}
+}
+
 // ============================================================================
 // Benchmarks
 // ============================================================================
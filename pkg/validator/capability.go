@@ -0,0 +1,206 @@
+// Package validator provides FHIR resource validation based on StructureDefinitions.
+package validator
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/robertoaraneda/gofhir/pkg/fhir/r4"
+	"github.com/robertoaraneda/gofhir/pkg/fhir/r4b"
+	"github.com/robertoaraneda/gofhir/pkg/fhir/r5"
+)
+
+// resourceTypeCapabilityStatement is the FHIR resource type for CapabilityStatement.
+const resourceTypeCapabilityStatement = "CapabilityStatement"
+
+// capabilityModeServer is the rest mode of the capabilities of a server.
+const capabilityModeServer = "server"
+
+// systemInteractions are the restful interactions performed on the whole system
+// (rest.interaction) rather than on a resource type (rest.resource.interaction).
+var systemInteractions = map[string]bool{
+	"transaction":    true,
+	"batch":          true,
+	"search-system":  true,
+	"history-system": true,
+}
+
+// CapabilityStatement is a simplified representation of the REST capabilities
+// of a FHIR CapabilityStatement, common to R4, R4B and R5.
+type CapabilityStatement struct {
+	URL         string
+	FHIRVersion string
+	Rest        []CapabilityRest
+}
+
+// CapabilityRest is a rest entry of a CapabilityStatement.
+type CapabilityRest struct {
+	// Mode is "server" or "client"
+	Mode string
+	// Resources are the resource types served and their interactions
+	Resources []CapabilityResource
+	// Interactions are the system-level interactions (e.g., "transaction")
+	Interactions []string
+}
+
+// CapabilityResource is a resource type of a CapabilityStatement rest entry.
+type CapabilityResource struct {
+	Type string
+	// Interactions are the type-level interactions (e.g., "read", "search-type")
+	Interactions []string
+}
+
+// ParseCapabilityStatement parses the REST capabilities of a CapabilityStatement
+// from JSON. The resource is decoded with the generated CapabilityStatement type
+// of its fhirVersion (R4 when the version is absent or unknown).
+func ParseCapabilityStatement(data []byte) (*CapabilityStatement, error) {
+	var header struct {
+		ResourceType string `json:"resourceType"`
+		FHIRVersion  string `json:"fhirVersion"`
+	}
+	if err := json.Unmarshal(data, &header); err != nil {
+		return nil, fmt.Errorf("failed to parse CapabilityStatement: %w", err)
+	}
+	if header.ResourceType != resourceTypeCapabilityStatement {
+		return nil, fmt.Errorf("expected CapabilityStatement, got %s", header.ResourceType)
+	}
+
+	switch capabilityFHIRVersion(header.FHIRVersion) {
+	case FHIRVersionR5:
+		var cs r5.CapabilityStatement
+		if err := json.Unmarshal(data, &cs); err != nil {
+			return nil, fmt.Errorf("failed to parse CapabilityStatement: %w", err)
+		}
+		return capabilityStatementR5(&cs), nil
+	case FHIRVersionR4B:
+		var cs r4b.CapabilityStatement
+		if err := json.Unmarshal(data, &cs); err != nil {
+			return nil, fmt.Errorf("failed to parse CapabilityStatement: %w", err)
+		}
+		return capabilityStatementR4B(&cs), nil
+	default:
+		var cs r4.CapabilityStatement
+		if err := json.Unmarshal(data, &cs); err != nil {
+			return nil, fmt.Errorf("failed to parse CapabilityStatement: %w", err)
+		}
+		return capabilityStatementR4(&cs), nil
+	}
+}
+
+// capabilityFHIRVersion returns the FHIR release of a CapabilityStatement
+// fhirVersion (e.g., "4.3.0" -> R4B).
+func capabilityFHIRVersion(version string) FHIRVersion {
+	switch {
+	case strings.HasPrefix(version, "5."):
+		return FHIRVersionR5
+	case strings.HasPrefix(version, "4.3."), strings.HasPrefix(version, "4.1."):
+		return FHIRVersionR4B
+	default:
+		return FHIRVersionR4
+	}
+}
+
+// capabilityStatementR4 adapts an R4 CapabilityStatement.
+func capabilityStatementR4(cs *r4.CapabilityStatement) *CapabilityStatement {
+	result := &CapabilityStatement{URL: codeValue(cs.Url), FHIRVersion: codeValue(cs.FhirVersion)}
+	for _, rest := range cs.Rest {
+		capRest := CapabilityRest{Mode: codeValue(rest.Mode)}
+		for _, interaction := range rest.Interaction {
+			capRest.Interactions = append(capRest.Interactions, codeValue(interaction.Code))
+		}
+		for _, resource := range rest.Resource {
+			capResource := CapabilityResource{Type: codeValue(resource.Type)}
+			for _, interaction := range resource.Interaction {
+				capResource.Interactions = append(capResource.Interactions, codeValue(interaction.Code))
+			}
+			capRest.Resources = append(capRest.Resources, capResource)
+		}
+		result.Rest = append(result.Rest, capRest)
+	}
+	return result
+}
+
+// capabilityStatementR4B adapts an R4B CapabilityStatement.
+func capabilityStatementR4B(cs *r4b.CapabilityStatement) *CapabilityStatement {
+	result := &CapabilityStatement{URL: codeValue(cs.Url), FHIRVersion: codeValue(cs.FhirVersion)}
+	for _, rest := range cs.Rest {
+		capRest := CapabilityRest{Mode: codeValue(rest.Mode)}
+		for _, interaction := range rest.Interaction {
+			capRest.Interactions = append(capRest.Interactions, codeValue(interaction.Code))
+		}
+		for _, resource := range rest.Resource {
+			capResource := CapabilityResource{Type: codeValue(resource.Type)}
+			for _, interaction := range resource.Interaction {
+				capResource.Interactions = append(capResource.Interactions, codeValue(interaction.Code))
+			}
+			capRest.Resources = append(capRest.Resources, capResource)
+		}
+		result.Rest = append(result.Rest, capRest)
+	}
+	return result
+}
+
+// capabilityStatementR5 adapts an R5 CapabilityStatement.
+func capabilityStatementR5(cs *r5.CapabilityStatement) *CapabilityStatement {
+	result := &CapabilityStatement{URL: codeValue(cs.Url), FHIRVersion: codeValue(cs.FhirVersion)}
+	for _, rest := range cs.Rest {
+		capRest := CapabilityRest{Mode: codeValue(rest.Mode)}
+		for _, interaction := range rest.Interaction {
+			capRest.Interactions = append(capRest.Interactions, codeValue(interaction.Code))
+		}
+		for _, resource := range rest.Resource {
+			capResource := CapabilityResource{Type: codeValue(resource.Type)}
+			for _, interaction := range resource.Interaction {
+				capResource.Interactions = append(capResource.Interactions, codeValue(interaction.Code))
+			}
+			capRest.Resources = append(capRest.Resources, capResource)
+		}
+		result.Rest = append(result.Rest, capRest)
+	}
+	return result
+}
+
+// codeValue returns the value of an optional string or code, or "" when unset.
+func codeValue[T ~string](v *T) string {
+	if v == nil {
+		return ""
+	}
+	return string(*v)
+}
+
+// CheckInteraction reports whether the server rest entries of cs permit interaction
+// on resourceType. Type-level interactions (read, vread, update, patch, delete,
+// history-instance, history-type, create, search-type) must be listed for the
+// resource type; system-level interactions (transaction, batch, search-system,
+// history-system) must be listed for the rest entry, and resourceType is ignored.
+//
+// Usage:
+//
+//	cs, err := validator.ParseCapabilityStatement(data)
+//	if !validator.CheckInteraction(cs, "Patient", "delete") {
+//	    // respond 405 Method Not Allowed
+//	}
+func CheckInteraction(cs *CapabilityStatement, resourceType, interaction string) bool {
+	if cs == nil {
+		return false
+	}
+	for _, rest := range cs.Rest {
+		if rest.Mode != capabilityModeServer {
+			continue
+		}
+		if systemInteractions[interaction] {
+			if slices.Contains(rest.Interactions, interaction) {
+				return true
+			}
+			continue
+		}
+		for _, resource := range rest.Resources {
+			if resource.Type == resourceType && slices.Contains(resource.Interactions, interaction) {
+				return true
+			}
+		}
+	}
+	return false
+}
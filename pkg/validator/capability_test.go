@@ -0,0 +1,86 @@
+package validator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckInteraction(t *testing.T) {
+	cs, err := ParseCapabilityStatement([]byte(`{
+		"resourceType": "CapabilityStatement",
+		"url": "http://example.org/CapabilityStatement/server",
+		"fhirVersion": "4.0.1",
+		"rest": [
+			{"mode": "server",
+				"resource": [
+					{"type": "Patient", "interaction": [{"code": "read"}, {"code": "search-type"}, {"code": "create"}]},
+					{"type": "Observation", "interaction": [{"code": "read"}]}],
+				"interaction": [{"code": "transaction"}]},
+			{"mode": "client",
+				"resource": [{"type": "Patient", "interaction": [{"code": "delete"}]}],
+				"interaction": [{"code": "batch"}]}
+		]
+	}`))
+	require.NoError(t, err)
+	assert.Equal(t, "4.0.1", cs.FHIRVersion)
+	require.Len(t, cs.Rest, 2)
+
+	tests := []struct {
+		resourceType string
+		interaction  string
+		want         bool
+	}{
+		{"Patient", "read", true},
+		{"Patient", "create", true},
+		{"Observation", "read", true},
+		{"Observation", "create", false},
+		{"Encounter", "read", false},
+		{"Patient", "delete", false}, // client capability only
+		{"", "transaction", true},
+		{"Patient", "transaction", true},
+		{"", "batch", false}, // client capability only
+		{"Patient", "history-system", false},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, CheckInteraction(cs, tt.resourceType, tt.interaction),
+			"CheckInteraction(%q, %q)", tt.resourceType, tt.interaction)
+	}
+
+	assert.False(t, CheckInteraction(nil, "Patient", "read"))
+}
+
+func TestParseCapabilityStatement_Invalid(t *testing.T) {
+	_, err := ParseCapabilityStatement([]byte(`{"resourceType": "Patient"}`))
+	assert.Error(t, err)
+
+	_, err = ParseCapabilityStatement([]byte(`{"resourceType": "CapabilityStatement", "rest": {}}`))
+	assert.Error(t, err)
+}
+
+func TestParseCapabilityStatement_Versions(t *testing.T) {
+	for _, fhirVersion := range []string{"4.0.1", "4.3.0", "5.0.0", ""} {
+		t.Run(fhirVersion, func(t *testing.T) {
+			cs, err := ParseCapabilityStatement([]byte(`{
+				"resourceType": "CapabilityStatement",
+				"url": "http://example.org/CapabilityStatement/server",
+				"fhirVersion": "` + fhirVersion + `",
+				"rest": [{"mode": "server",
+					"resource": [{"type": "Patient", "interaction": [{"code": "read"}]}],
+					"interaction": [{"code": "batch"}]}]
+			}`))
+			require.NoError(t, err)
+			assert.Equal(t, "http://example.org/CapabilityStatement/server", cs.URL)
+			assert.Equal(t, fhirVersion, cs.FHIRVersion)
+			assert.True(t, CheckInteraction(cs, "Patient", "read"))
+			assert.True(t, CheckInteraction(cs, "", "batch"))
+			assert.False(t, CheckInteraction(cs, "Patient", "delete"))
+		})
+	}
+
+	assert.Equal(t, FHIRVersionR4, capabilityFHIRVersion("4.0.1"))
+	assert.Equal(t, FHIRVersionR4B, capabilityFHIRVersion("4.3.0"))
+	assert.Equal(t, FHIRVersionR5, capabilityFHIRVersion("5.0.0"))
+	assert.Equal(t, FHIRVersionR4, capabilityFHIRVersion(""))
+}
@@ -0,0 +1,85 @@
+// Package validator provides FHIR resource validation based on StructureDefinitions.
+package validator
+
+import (
+	"context"
+	"fmt"
+)
+
+// domainResourceURL is the StructureDefinition the contained resource invariants come from.
+const domainResourceURL = "http://hl7.org/fhir/StructureDefinition/DomainResource"
+
+// Contained resource invariants of DomainResource. As invariants of an abstract base
+// type they are not evaluated from the snapshots, and are checked by
+// validateContainedRules instead.
+var (
+	dom2Constraint = ElementConstraint{
+		Key:        "dom-2",
+		Severity:   "error",
+		Human:      "If the resource is contained in another resource, it SHALL NOT contain nested Resources",
+		Expression: "contained.contained.empty()",
+		Source:     domainResourceURL,
+	}
+	dom4Constraint = ElementConstraint{
+		Key:        "dom-4",
+		Severity:   "error",
+		Human:      "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated",
+		Expression: "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()",
+		Source:     domainResourceURL,
+	}
+	dom5Constraint = ElementConstraint{
+		Key:        "dom-5",
+		Severity:   "error",
+		Human:      "If a resource is contained in another resource, it SHALL NOT have a security label",
+		Expression: "contained.meta.security.empty()",
+		Source:     domainResourceURL,
+	}
+)
+
+// validateContainedRules checks the resources contained in the resource of vctx
+// against the DomainResource invariants, reporting issues under basePath (e.g.,
+// "Patient" or "Bundle.entry[0].resource"): a contained resource can't contain
+// resources itself (dom-2), and has no meta.versionId or meta.lastUpdated (dom-4)
+// nor security labels (dom-5), which belong to the containing resource.
+func (v *Validator) validateContainedRules(_ context.Context, vctx *validationContext, basePath string, result *ValidationResult) {
+	contained, ok := vctx.parsed["contained"].([]interface{})
+	if !ok {
+		return
+	}
+
+	for i, item := range contained {
+		resource, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		path := fmt.Sprintf("%s.contained[%d]", basePath, i)
+
+		if _, nested := resource["contained"]; nested {
+			addContainedIssue(dom2Constraint, path+".contained", result)
+		}
+
+		meta, ok := resource["meta"].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for _, field := range []string{"versionId", "lastUpdated"} {
+			if _, present := meta[field]; present {
+				addContainedIssue(dom4Constraint, path+".meta."+field, result)
+			}
+		}
+		if _, present := meta["security"]; present {
+			addContainedIssue(dom5Constraint, path+".meta.security", result)
+		}
+	}
+}
+
+// addContainedIssue reports a violation of a contained resource invariant at path.
+func addContainedIssue(constraint ElementConstraint, path string, result *ValidationResult) {
+	result.AddIssue(ValidationIssue{
+		Severity:    SeverityError,
+		Code:        IssueCodeInvariant,
+		Diagnostics: fmt.Sprintf("Constraint %s violated: %s", constraint.Key, constraint.Human),
+		Expression:  []string{path},
+		Constraint:  &constraint,
+	})
+}
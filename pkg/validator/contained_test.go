@@ -0,0 +1,125 @@
+package validator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// containedTestDefs are minimal Patient, Organization and Bundle definitions whose
+// resources may contain resources.
+var containedTestDefs = []*StructureDef{
+	{
+		URL:  "http://hl7.org/fhir/StructureDefinition/Patient",
+		Name: "Patient",
+		Type: "Patient",
+		Kind: "resource",
+		Snapshot: []ElementDef{
+			{Path: "Patient", Min: 0, Max: "*"},
+			{Path: "Patient.id", Min: 0, Max: "1", Types: []TypeRef{{Code: "id"}}},
+			{Path: "Patient.meta", Min: 0, Max: "1", Types: []TypeRef{{Code: "Meta"}}},
+			{Path: "Patient.contained", Min: 0, Max: "*", Types: []TypeRef{{Code: "Resource"}}},
+		},
+	},
+	{
+		URL:  "http://hl7.org/fhir/StructureDefinition/Organization",
+		Name: "Organization",
+		Type: "Organization",
+		Kind: "resource",
+		Snapshot: []ElementDef{
+			{Path: "Organization", Min: 0, Max: "*"},
+			{Path: "Organization.id", Min: 0, Max: "1", Types: []TypeRef{{Code: "id"}}},
+			{Path: "Organization.meta", Min: 0, Max: "1", Types: []TypeRef{{Code: "Meta"}}},
+			{Path: "Organization.contained", Min: 0, Max: "*", Types: []TypeRef{{Code: "Resource"}}},
+		},
+	},
+	{
+		URL:  "http://hl7.org/fhir/StructureDefinition/Bundle",
+		Name: "Bundle",
+		Type: "Bundle",
+		Kind: "resource",
+		Snapshot: []ElementDef{
+			{Path: "Bundle", Min: 0, Max: "*"},
+			{Path: "Bundle.type", Min: 1, Max: "1", Types: []TypeRef{{Code: "code"}}},
+			{Path: "Bundle.entry", Min: 0, Max: "*", Types: []TypeRef{{Code: "BackboneElement"}}},
+			{Path: "Bundle.entry.fullUrl", Min: 0, Max: "1", Types: []TypeRef{{Code: "uri"}}},
+			{Path: "Bundle.entry.resource", Min: 0, Max: "1", Types: []TypeRef{{Code: "Resource"}}},
+		},
+	},
+}
+
+// containedIssues returns the constraint keys and paths of the dom-* issues.
+func containedIssues(result *ValidationResult) []string {
+	var issues []string
+	for _, issue := range result.Issues {
+		if issue.Constraint != nil && issue.Constraint.Source == domainResourceURL {
+			issues = append(issues, issue.Constraint.Key+" "+issue.Expression[0])
+		}
+	}
+	return issues
+}
+
+func TestValidateContainedRules(t *testing.T) {
+	v := newMinimalValidator(t, ValidatorOptions{ValidateConstraints: true}, containedTestDefs...)
+
+	tests := []struct {
+		name     string
+		resource string
+		want     []string
+	}{
+		{
+			name:     "contained resource",
+			resource: `{"resourceType": "Patient", "contained": [{"resourceType": "Organization", "id": "org"}]}`,
+		},
+		{
+			name: "doubly nested containment",
+			resource: `{"resourceType": "Patient", "contained": [{"resourceType": "Organization", "id": "org",
+				"contained": [{"resourceType": "Organization", "id": "parent"}]}]}`,
+			want: []string{"dom-2 Patient.contained[0].contained"},
+		},
+		{
+			name: "contained resource versions",
+			resource: `{"resourceType": "Patient", "meta": {"versionId": "3"}, "contained": [
+				{"resourceType": "Organization", "id": "a", "meta": {"versionId": "1"}},
+				{"resourceType": "Organization", "id": "b", "meta": {"lastUpdated": "2024-01-15T10:30:00Z"}}]}`,
+			want: []string{"dom-4 Patient.contained[0].meta.versionId", "dom-4 Patient.contained[1].meta.lastUpdated"},
+		},
+		{
+			name: "contained resource security label",
+			resource: `{"resourceType": "Patient", "contained": [{"resourceType": "Organization", "id": "org",
+				"meta": {"security": [{"system": "http://terminology.hl7.org/CodeSystem/v3-Confidentiality", "code": "R"}]}}]}`,
+			want: []string{"dom-5 Patient.contained[0].meta.security"},
+		},
+		{
+			name: "bundle entry resource",
+			resource: `{"resourceType": "Bundle", "type": "collection", "entry": [{"fullUrl": "urn:uuid:1",
+				"resource": {"resourceType": "Patient", "contained": [{"resourceType": "Organization", "id": "org",
+					"contained": [{"resourceType": "Organization", "id": "parent"}]}]}}]}`,
+			want: []string{"dom-2 Bundle.entry[0].resource.contained[0].contained"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := v.Validate(context.Background(), []byte(tt.resource))
+			require.NoError(t, err)
+
+			issues := containedIssues(result)
+			assert.ElementsMatch(t, tt.want, issues, "Issues: %v", result.Issues)
+			if len(tt.want) > 0 {
+				assert.False(t, result.Valid)
+			}
+		})
+	}
+}
+
+func TestValidateContainedRules_ConstraintsDisabled(t *testing.T) {
+	v := newMinimalValidator(t, ValidatorOptions{}, containedTestDefs...)
+
+	result, err := v.Validate(context.Background(), []byte(`{"resourceType": "Patient", "contained": [
+		{"resourceType": "Organization", "id": "org", "contained": [{"resourceType": "Organization", "id": "parent"}]}]}`))
+	require.NoError(t, err)
+	assert.Empty(t, containedIssues(result))
+}
@@ -4,6 +4,7 @@ package validator
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 )
 
@@ -28,6 +29,154 @@ type ParsedExtension struct {
 func (v *Validator) validateExtensions(ctx context.Context, vctx *validationContext, result *ValidationResult) {
 	// Recursively find and validate all extensions
 	v.validateExtensionsInNode(ctx, vctx, vctx.parsed, vctx.resourceType, result)
+
+	// Enforce the extension slices defined by the profile
+	v.validateExtensionSlices(vctx, result)
+}
+
+// validateExtensionSlices enforces the extension slices of the StructureDefinition.
+// Extensions are sliced by url: each slice applies to the extensions at its path
+// whose url is the slice's extension profile, constraining their cardinality and,
+// when the profile constrains value[x], their value type.
+func (v *Validator) validateExtensionSlices(vctx *validationContext, result *ValidationResult) {
+	for i := range vctx.sd.Snapshot {
+		slice := &vctx.sd.Snapshot[i]
+		url := extensionSliceURL(slice)
+		if url == "" {
+			continue
+		}
+
+		parentPath := getParentPath(slice.Path)
+		field := slice.Path[len(parentPath)+1:]
+		var parts []string
+		if parentPath != vctx.resourceType {
+			parts = strings.Split(strings.TrimPrefix(parentPath, vctx.resourceType+"."), ".")
+		}
+
+		for _, parent := range collectPathNodes(vctx.parsed, vctx.resourceType, parts) {
+			extensions, _ := parent.node[field].([]interface{})
+			v.validateExtensionSlice(vctx.sd, slice, url, extensions, parent.path+"."+field, result)
+		}
+	}
+}
+
+// validateExtensionSlice checks the extensions at path against a single slice.
+func (v *Validator) validateExtensionSlice(sd *StructureDef, slice *ElementDef, url string, extensions []interface{}, path string, result *ValidationResult) {
+	var matches []int
+	for i, ext := range extensions {
+		if extMap, ok := ext.(map[string]interface{}); ok && extMap["url"] == url {
+			matches = append(matches, i)
+		}
+	}
+
+	if len(matches) < slice.Min {
+		result.AddIssue(ValidationIssue{
+			Severity:    SeverityError,
+			Code:        IssueCodeRequired,
+			Diagnostics: fmt.Sprintf("Extension slice '%s' requires at least %d extension(s) with url '%s', found %d", slice.SliceName, slice.Min, url, len(matches)),
+			Expression:  []string{path},
+			Details:     NewIssueDetails(DetailsCardinalityMin),
+		})
+	}
+	if maxCount, err := strconv.Atoi(slice.Max); err == nil && len(matches) > maxCount {
+		result.AddIssue(ValidationIssue{
+			Severity:    SeverityError,
+			Code:        IssueCodeStructure,
+			Diagnostics: fmt.Sprintf("Extension slice '%s' allows at most %d extension(s) with url '%s', found %d", slice.SliceName, maxCount, url, len(matches)),
+			Expression:  []string{path},
+			Details:     NewIssueDetails(DetailsCardinalityMax),
+		})
+	}
+
+	allowedTypes := extensionSliceValueTypes(sd, slice)
+	if len(allowedTypes) == 0 {
+		return
+	}
+	for _, i := range matches {
+		valueType := getExtensionValueType(extensions[i].(map[string]interface{}))
+		if valueType == "" || containsFold(allowedTypes, valueType) {
+			continue
+		}
+		result.AddIssue(ValidationIssue{
+			Severity:    SeverityError,
+			Code:        IssueCodeValue,
+			Diagnostics: fmt.Sprintf("Extension slice '%s' value type '%s' not allowed; expected one of: %s", slice.SliceName, valueType, strings.Join(allowedTypes, ", ")),
+			Expression:  []string{fmt.Sprintf("%s[%d]", path, i)},
+		})
+	}
+}
+
+// extensionSliceURL returns the extension URL discriminating an extension slice,
+// or "" if elem is not a slice of extension or modifierExtension.
+func extensionSliceURL(elem *ElementDef) string {
+	if elem.SliceName == "" || len(elem.Types) == 0 || len(elem.Types[0].Profile) == 0 {
+		return ""
+	}
+	if !strings.HasSuffix(elem.Path, ".extension") && !strings.HasSuffix(elem.Path, ".modifierExtension") {
+		return ""
+	}
+	url, _, _ := strings.Cut(elem.Types[0].Profile[0], "|")
+	return url
+}
+
+// extensionSliceValueTypes returns the value[x] types allowed by the profile for
+// an extension slice (e.g., from "Patient.extension:race.value[x]").
+func extensionSliceValueTypes(sd *StructureDef, slice *ElementDef) []string {
+	sliceID := slice.ID
+	if sliceID == "" {
+		sliceID = slice.Path + ":" + slice.SliceName
+	}
+
+	for _, elem := range sd.Snapshot {
+		if elem.ID != sliceID+".value[x]" {
+			continue
+		}
+		types := make([]string, 0, len(elem.Types))
+		for _, t := range elem.Types {
+			types = append(types, t.Code)
+		}
+		return types
+	}
+	return nil
+}
+
+// pathNode is an object in the resource together with its indexed path.
+type pathNode struct {
+	node map[string]interface{}
+	path string
+}
+
+// collectPathNodes returns the objects reached by following parts from node,
+// expanding arrays (e.g., "name" yields Patient.name[0], Patient.name[1]).
+func collectPathNodes(node map[string]interface{}, path string, parts []string) []pathNode {
+	if len(parts) == 0 {
+		return []pathNode{{node: node, path: path}}
+	}
+
+	childPath := path + "." + parts[0]
+	switch child := node[parts[0]].(type) {
+	case map[string]interface{}:
+		return collectPathNodes(child, childPath, parts[1:])
+	case []interface{}:
+		var nodes []pathNode
+		for i, item := range child {
+			if itemMap, ok := item.(map[string]interface{}); ok {
+				nodes = append(nodes, collectPathNodes(itemMap, fmt.Sprintf("%s[%d]", childPath, i), parts[1:])...)
+			}
+		}
+		return nodes
+	}
+	return nil
+}
+
+// containsFold reports whether values contains s, ignoring case.
+func containsFold(values []string, s string) bool {
+	for _, value := range values {
+		if strings.EqualFold(value, s) {
+			return true
+		}
+	}
+	return false
 }
 
 // validateExtensionsInNode recursively validates extensions in a node.
@@ -264,6 +264,82 @@ func TestValidateExtensions_NestedInElement(t *testing.T) {
 	assert.Equal(t, 0, extErrors, "Should not have extension errors. Issues: %v", result.Issues)
 }
 
+func TestValidateExtensions_ProfileSlices(t *testing.T) {
+	const birthSexURL = "http://example.org/StructureDefinition/birthsex"
+	const profileURL = "http://example.org/StructureDefinition/my-patient"
+
+	registry := NewRegistry(FHIRVersionR4)
+	require.NoError(t, registry.Register(&StructureDef{
+		URL:            profileURL,
+		Name:           "MyPatient",
+		Type:           "Patient",
+		Kind:           "resource",
+		BaseDefinition: "http://hl7.org/fhir/StructureDefinition/Patient",
+		Snapshot: []ElementDef{
+			{ID: "Patient", Path: "Patient", Min: 0, Max: "*"},
+			{ID: "Patient.id", Path: "Patient.id", Min: 0, Max: "1", Types: []TypeRef{{Code: "id"}}},
+			{ID: "Patient.extension", Path: "Patient.extension", Min: 0, Max: "*", Types: []TypeRef{{Code: "Extension"}}},
+			{
+				ID:        "Patient.extension:birthsex",
+				Path:      "Patient.extension",
+				SliceName: "birthsex",
+				Min:       1,
+				Max:       "1",
+				Types:     []TypeRef{{Code: "Extension", Profile: []string{birthSexURL}}},
+			},
+			{ID: "Patient.extension:birthsex.value[x]", Path: "Patient.extension.value[x]", Min: 1, Max: "1", Types: []TypeRef{{Code: "code"}}},
+		},
+	}))
+
+	v := NewValidator(registry, ValidatorOptions{ValidateExtensions: true, Profile: profileURL})
+
+	tests := []struct {
+		name       string
+		extensions string
+		wantErrors []string
+	}{
+		{
+			name:       "exactly one birthsex extension",
+			extensions: `[{"url": "` + birthSexURL + `", "valueCode": "F"}]`,
+		},
+		{
+			name:       "other extensions are not counted",
+			extensions: `[{"url": "http://example.org/other", "valueString": "x"}, {"url": "` + birthSexURL + `", "valueCode": "F"}]`,
+		},
+		{
+			name:       "missing birthsex extension",
+			extensions: `[{"url": "http://example.org/other", "valueString": "x"}]`,
+			wantErrors: []string{"Patient.extension"},
+		},
+		{
+			name:       "repeated birthsex extension",
+			extensions: `[{"url": "` + birthSexURL + `", "valueCode": "F"}, {"url": "` + birthSexURL + `", "valueCode": "M"}]`,
+			wantErrors: []string{"Patient.extension"},
+		},
+		{
+			name:       "wrong value type",
+			extensions: `[{"url": "` + birthSexURL + `", "valueString": "F"}]`,
+			wantErrors: []string{"Patient.extension[0]"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resource := []byte(`{"resourceType": "Patient", "id": "p1", "extension": ` + tt.extensions + `}`)
+			result, err := v.Validate(context.Background(), resource)
+			require.NoError(t, err)
+
+			var paths []string
+			for _, issue := range result.Issues {
+				if issue.Severity == SeverityError && containsString(issue.Diagnostics, "slice 'birthsex'") {
+					paths = append(paths, issue.Expression[0])
+				}
+			}
+			assert.Equal(t, tt.wantErrors, paths, "Issues: %v", result.Issues)
+		})
+	}
+}
+
 func TestIsValidExtensionURL(t *testing.T) {
 	tests := []struct {
 		url   string
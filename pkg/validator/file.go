@@ -0,0 +1,38 @@
+package validator
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+)
+
+// ErrUnsupportedFormat is returned by ValidateFile for files whose content is
+// not a FHIR JSON resource.
+var ErrUnsupportedFormat = errors.New("unsupported resource format")
+
+// utf8BOM is the byte order mark some editors write at the start of UTF-8 files.
+var utf8BOM = []byte{0xEF, 0xBB, 0xBF}
+
+// ValidateFile reads the resource in the file at path and validates it. The
+// format is detected from the content rather than the file extension: JSON
+// starts with '{' and XML with '<', after any byte order mark and whitespace.
+// FHIR XML is detected but not supported yet; it and unrecognized content
+// return an error wrapping ErrUnsupportedFormat.
+func (v *Validator) ValidateFile(ctx context.Context, path string) (*ValidationResult, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file %s: %w", path, err)
+	}
+
+	content := bytes.TrimLeft(bytes.TrimPrefix(data, utf8BOM), " \t\r\n")
+	switch {
+	case len(content) > 0 && content[0] == '{':
+		return v.Validate(ctx, content)
+	case len(content) > 0 && content[0] == '<':
+		return nil, fmt.Errorf("%w: %s is FHIR XML, which is not supported; convert it to JSON", ErrUnsupportedFormat, path)
+	default:
+		return nil, fmt.Errorf("%w: %s is neither a JSON nor an XML resource", ErrUnsupportedFormat, path)
+	}
+}
@@ -0,0 +1,54 @@
+package validator
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestValidateFile(t *testing.T) {
+	v := newMinimalValidator(t, ValidatorOptions{}, genderRequiredPatientSD)
+	dir := t.TempDir()
+
+	tests := []struct {
+		name      string
+		content   string
+		wantValid bool
+		wantErr   error
+	}{
+		{"json", `{"resourceType": "Patient", "id": "p1", "gender": "male"}`, true, nil},
+		{"json with BOM and whitespace", "\xEF\xBB\xBF\n  {\"resourceType\": \"Patient\", \"id\": \"p1\"}", false, nil},
+		{"xml", `<?xml version="1.0"?><Patient xmlns="http://hl7.org/fhir"><id value="p1"/></Patient>`, false, ErrUnsupportedFormat},
+		{"unrecognized", `resourceType: Patient`, false, ErrUnsupportedFormat},
+		{"empty", "", false, ErrUnsupportedFormat},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(dir, tt.name)
+			if err := os.WriteFile(path, []byte(tt.content), 0o600); err != nil {
+				t.Fatal(err)
+			}
+
+			result, err := v.ValidateFile(context.Background(), path)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("ValidateFile() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ValidateFile() error = %v", err)
+			}
+			if result.Valid != tt.wantValid {
+				t.Errorf("Valid = %v, want %v: %v", result.Valid, tt.wantValid, result.Issues)
+			}
+		})
+	}
+
+	if _, err := v.ValidateFile(context.Background(), filepath.Join(dir, "missing.json")); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("ValidateFile() error = %v, want os.ErrNotExist", err)
+	}
+}
@@ -0,0 +1,138 @@
+// Package validator provides FHIR resource validation based on StructureDefinitions.
+package validator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// identifierAssignerType is the resource type Identifier.assigner may reference.
+const identifierAssignerType = "Organization"
+
+// validateIdentifiers applies best-practice checks to the Identifier elements of
+// the resource in vctx, reporting issues under basePath (e.g., "Patient" or
+// "Bundle.entry[0].resource"). An Identifier should have both a system and a
+// value, and its assigner should reference an Organization. Issues are warnings;
+// a profile requiring system or value (min 1) is enforced as an error by the
+// structure validation instead, and with ValidateReferences the assigner target
+// type is checked by the reference validation.
+func (v *Validator) validateIdentifiers(ctx context.Context, vctx *validationContext, basePath string, result *ValidationResult) {
+	v.validateIdentifiersInNode(ctx, vctx, vctx.parsed, vctx.resourceType, basePath, result)
+}
+
+// validateIdentifiersInNode recursively finds Identifier elements in a node.
+// elemPath is the element path without array indices used to find definitions,
+// and path the location reported in issues.
+func (v *Validator) validateIdentifiersInNode(ctx context.Context, vctx *validationContext, node interface{}, elemPath, path string, result *ValidationResult) {
+	if v.options.MaxErrors > 0 && result.ErrorCount() >= v.options.MaxErrors {
+		return
+	}
+
+	switch val := node.(type) {
+	case map[string]interface{}:
+		for key, child := range val {
+			if key == resourceTypeKey || key == "contained" || strings.HasPrefix(key, "_") {
+				continue
+			}
+			childElemPath := elemPath + "." + key
+			if v.isIdentifierElement(ctx, vctx, childElemPath, key) {
+				v.validateIdentifierValues(ctx, vctx, child, childElemPath, path+"."+key, result)
+				continue
+			}
+			v.validateIdentifiersInNode(ctx, vctx, child, childElemPath, path+"."+key, result)
+		}
+
+	case []interface{}:
+		for i, item := range val {
+			v.validateIdentifiersInNode(ctx, vctx, item, elemPath, fmt.Sprintf("%s[%d]", path, i), result)
+		}
+	}
+}
+
+// isIdentifierElement reports whether the element at elemPath is an Identifier,
+// including the Identifier variant of a choice element (e.g., valueIdentifier).
+func (v *Validator) isIdentifierElement(ctx context.Context, vctx *validationContext, elemPath, key string) bool {
+	elemDef := v.findElementDefWithContext(ctx, vctx.index, elemPath)
+	if elemDef == nil {
+		return false
+	}
+	if len(elemDef.Types) == 1 {
+		return elemDef.Types[0].Code == "Identifier"
+	}
+	if !strings.HasSuffix(key, "Identifier") {
+		return false
+	}
+	for _, t := range elemDef.Types {
+		if t.Code == "Identifier" {
+			return true
+		}
+	}
+	return false
+}
+
+// validateIdentifierValues validates an Identifier element, or each of them if it repeats.
+func (v *Validator) validateIdentifierValues(ctx context.Context, vctx *validationContext, node interface{}, elemPath, path string, result *ValidationResult) {
+	switch val := node.(type) {
+	case map[string]interface{}:
+		v.validateIdentifier(ctx, vctx, val, elemPath, path, result)
+	case []interface{}:
+		for i, item := range val {
+			if identifier, ok := item.(map[string]interface{}); ok {
+				v.validateIdentifier(ctx, vctx, identifier, elemPath, fmt.Sprintf("%s[%d]", path, i), result)
+			}
+		}
+	}
+}
+
+// validateIdentifier checks the system, value and assigner of an Identifier.
+func (v *Validator) validateIdentifier(ctx context.Context, vctx *validationContext, identifier map[string]interface{}, elemPath, path string, result *ValidationResult) {
+	for _, field := range []string{"system", "value"} {
+		if _, present := identifier[field]; present {
+			continue
+		}
+		// A profile requiring the field is enforced by the structure validation
+		if elemDef := v.findElementDefWithContext(ctx, vctx.index, elemPath+"."+field); elemDef != nil && elemDef.Min > 0 {
+			continue
+		}
+		result.AddIssue(ValidationIssue{
+			Severity:    SeverityWarning,
+			Code:        IssueCodeBusinessRule,
+			Diagnostics: fmt.Sprintf("Identifier should have a %s", field),
+			Expression:  []string{path},
+		})
+	}
+
+	if v.options.ValidateReferences {
+		return
+	}
+	assigner, ok := identifier["assigner"].(map[string]interface{})
+	if !ok {
+		return
+	}
+	refStr, ok := assigner["reference"].(string)
+	if !ok {
+		return
+	}
+	parsed := ParseReference(refStr)
+	targetType := parsed.ResourceType
+	if parsed.Type == RefTypeContained {
+		targetType = v.extractContainedIDs(vctx.parsed)[parsed.ID]
+	}
+	switch {
+	case !parsed.Valid:
+		result.AddIssue(ValidationIssue{
+			Severity:    SeverityWarning,
+			Code:        IssueCodeValue,
+			Diagnostics: fmt.Sprintf("Invalid Identifier.assigner reference format: '%s'", refStr),
+			Expression:  []string{path + ".assigner.reference"},
+		})
+	case targetType != "" && targetType != identifierAssignerType:
+		result.AddIssue(ValidationIssue{
+			Severity:    SeverityWarning,
+			Code:        IssueCodeValue,
+			Diagnostics: fmt.Sprintf("Identifier.assigner should reference an %s, not '%s'", identifierAssignerType, targetType),
+			Expression:  []string{path + ".assigner.reference"},
+		})
+	}
+}
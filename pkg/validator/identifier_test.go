@@ -0,0 +1,174 @@
+package validator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// identifierTestDefs are minimal Patient, Organization, Identifier and Reference
+// definitions, and a Patient profile requiring identifier.system.
+var identifierTestDefs = []*StructureDef{
+	{
+		URL:  "http://hl7.org/fhir/StructureDefinition/Patient",
+		Name: "Patient",
+		Type: "Patient",
+		Kind: "resource",
+		Snapshot: []ElementDef{
+			{Path: "Patient", Min: 0, Max: "*"},
+			{Path: "Patient.id", Min: 0, Max: "1", Types: []TypeRef{{Code: "id"}}},
+			{Path: "Patient.contained", Min: 0, Max: "*", Types: []TypeRef{{Code: "Resource"}}},
+			{Path: "Patient.identifier", Min: 0, Max: "*", Types: []TypeRef{{Code: "Identifier"}}},
+		},
+	},
+	{
+		URL:            "http://example.org/StructureDefinition/identified-patient",
+		Name:           "IdentifiedPatient",
+		Type:           "Patient",
+		Kind:           "resource",
+		BaseDefinition: "http://hl7.org/fhir/StructureDefinition/Patient",
+		Snapshot: []ElementDef{
+			{Path: "Patient", Min: 0, Max: "*"},
+			{Path: "Patient.id", Min: 0, Max: "1", Types: []TypeRef{{Code: "id"}}},
+			{Path: "Patient.identifier", Min: 1, Max: "*", Types: []TypeRef{{Code: "Identifier"}}},
+			{Path: "Patient.identifier.system", Min: 1, Max: "1", Types: []TypeRef{{Code: "uri"}}},
+			{Path: "Patient.identifier.value", Min: 0, Max: "1", Types: []TypeRef{{Code: "string"}}},
+		},
+	},
+	{
+		URL:  "http://hl7.org/fhir/StructureDefinition/Organization",
+		Name: "Organization",
+		Type: "Organization",
+		Kind: "resource",
+		Snapshot: []ElementDef{
+			{Path: "Organization", Min: 0, Max: "*"},
+			{Path: "Organization.id", Min: 0, Max: "1", Types: []TypeRef{{Code: "id"}}},
+		},
+	},
+	{
+		URL:  "http://hl7.org/fhir/StructureDefinition/Identifier",
+		Name: "Identifier",
+		Type: "Identifier",
+		Kind: "complex-type",
+		Snapshot: []ElementDef{
+			{Path: "Identifier", Min: 0, Max: "*"},
+			{Path: "Identifier.system", Min: 0, Max: "1", Types: []TypeRef{{Code: "uri"}}},
+			{Path: "Identifier.value", Min: 0, Max: "1", Types: []TypeRef{{Code: "string"}}},
+			{Path: "Identifier.assigner", Min: 0, Max: "1", Types: []TypeRef{{
+				Code:          "Reference",
+				TargetProfile: []string{"http://hl7.org/fhir/StructureDefinition/Organization"},
+			}}},
+		},
+	},
+	{
+		URL:  "http://hl7.org/fhir/StructureDefinition/Reference",
+		Name: "Reference",
+		Type: "Reference",
+		Kind: "complex-type",
+		Snapshot: []ElementDef{
+			{Path: "Reference", Min: 0, Max: "*"},
+			{Path: "Reference.reference", Min: 0, Max: "1", Types: []TypeRef{{Code: "string"}}},
+			{Path: "Reference.display", Min: 0, Max: "1", Types: []TypeRef{{Code: "string"}}},
+		},
+	},
+}
+
+// identifierIssues returns the issues reported for Identifier elements.
+func identifierIssues(result *ValidationResult) []ValidationIssue {
+	var issues []ValidationIssue
+	for _, issue := range result.Issues {
+		if issue.Code == IssueCodeBusinessRule || containsString(issue.Diagnostics, "assigner") {
+			issues = append(issues, issue)
+		}
+	}
+	return issues
+}
+
+func TestValidateIdentifiers(t *testing.T) {
+	v := newMinimalValidator(t, ValidatorOptions{ValidateIdentifiers: true}, identifierTestDefs...)
+
+	tests := []struct {
+		name      string
+		resource  string
+		wantPaths []string
+	}{
+		{
+			name:     "system and value",
+			resource: `{"resourceType": "Patient", "identifier": [{"system": "http://example.org/mrn", "value": "123"}]}`,
+		},
+		{
+			name:      "missing value",
+			resource:  `{"resourceType": "Patient", "identifier": [{"system": "http://example.org/mrn", "value": "1"}, {"system": "http://example.org/mrn"}]}`,
+			wantPaths: []string{"Patient.identifier[1]"},
+		},
+		{
+			name:      "missing system and value",
+			resource:  `{"resourceType": "Patient", "identifier": [{"use": "official"}]}`,
+			wantPaths: []string{"Patient.identifier[0]", "Patient.identifier[0]"},
+		},
+		{
+			name: "assigner referencing an Organization",
+			resource: `{"resourceType": "Patient", "identifier": [{"system": "http://example.org/mrn", "value": "1",
+				"assigner": {"reference": "Organization/hosp"}}]}`,
+		},
+		{
+			name: "assigner referencing a Patient",
+			resource: `{"resourceType": "Patient", "identifier": [{"system": "http://example.org/mrn", "value": "1",
+				"assigner": {"reference": "Patient/2"}}]}`,
+			wantPaths: []string{"Patient.identifier[0].assigner.reference"},
+		},
+		{
+			name: "assigner referencing a contained Organization",
+			resource: `{"resourceType": "Patient", "contained": [{"resourceType": "Organization", "id": "org"}],
+				"identifier": [{"system": "http://example.org/mrn", "value": "1", "assigner": {"reference": "#org"}}]}`,
+		},
+		{
+			name: "assigner with an invalid reference",
+			resource: `{"resourceType": "Patient", "identifier": [{"system": "http://example.org/mrn", "value": "1",
+				"assigner": {"reference": "not a reference"}}]}`,
+			wantPaths: []string{"Patient.identifier[0].assigner.reference"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := v.Validate(context.Background(), []byte(tt.resource))
+			require.NoError(t, err)
+
+			var paths []string
+			for _, issue := range identifierIssues(result) {
+				assert.Equal(t, SeverityWarning, issue.Severity, issue.Diagnostics)
+				paths = append(paths, issue.Expression[0])
+			}
+			assert.Equal(t, tt.wantPaths, paths, "Issues: %v", result.Issues)
+			assert.True(t, result.Valid)
+		})
+	}
+}
+
+func TestValidateIdentifiers_ProfileRequiredSystem(t *testing.T) {
+	v := newMinimalValidator(t, ValidatorOptions{
+		ValidateIdentifiers: true,
+		Profile:             "http://example.org/StructureDefinition/identified-patient",
+	}, identifierTestDefs...)
+
+	result, err := v.Validate(context.Background(), []byte(`{"resourceType": "Patient", "identifier": [{"use": "official"}]}`))
+	require.NoError(t, err)
+
+	// The profile makes the missing system an error; the missing value is only a warning
+	assert.False(t, result.Valid)
+	issues := identifierIssues(result)
+	require.Len(t, issues, 1, "Issues: %v", result.Issues)
+	assert.Contains(t, issues[0].Diagnostics, "value")
+}
+
+func TestValidateIdentifiers_Disabled(t *testing.T) {
+	v := newMinimalValidator(t, ValidatorOptions{}, identifierTestDefs...)
+
+	result, err := v.Validate(context.Background(), []byte(`{"resourceType": "Patient", "identifier": [{"use": "official",
+		"assigner": {"reference": "Patient/2"}}]}`))
+	require.NoError(t, err)
+	assert.Empty(t, identifierIssues(result))
+}
@@ -15,7 +15,7 @@ type ReferenceResolver interface {
 }
 
 // TerminologyService allows validating codes against ValueSets and CodeSystems.
-// Implementations: LocalTerminologyService, RemoteTerminologyService (tx.fhir.org)
+// Implementations: LocalTerminologyService, EmbeddedTerminologyService, HTTPTerminologyService (tx.fhir.org)
 type TerminologyService interface {
 	// ValidateCode checks if a code is valid in the given ValueSet.
 	ValidateCode(ctx context.Context, system, code, valueSetURL string) (bool, error)
@@ -25,6 +25,42 @@ type TerminologyService interface {
 
 	// LookupCode returns information about a specific code.
 	LookupCode(ctx context.Context, system, code string) (*CodeInfo, error)
+
+	// Lookup returns the canonical display of a code ($lookup).
+	// ok is false when the CodeSystem is unknown or does not contain the code.
+	Lookup(ctx context.Context, system, code string) (display string, ok bool, err error)
+
+	// Translate maps a code to codes in other systems using a ConceptMap.
+	// If conceptMapURL is empty, all available ConceptMaps are searched.
+	// An empty result with a nil error means no mapping exists for the code.
+	Translate(ctx context.Context, system, code, conceptMapURL string) ([]Coding, error)
+}
+
+// CodeStatusProvider is implemented by terminology services that track the
+// concept status of codes. The validator uses it to warn about codes that are
+// valid members of a ValueSet but deprecated or retired in their CodeSystem.
+type CodeStatusProvider interface {
+	// CodeStatus returns the status of a code in the given ValueSet (e.g., "deprecated"
+	// or "retired"), or "" if the code is active or its status is unknown.
+	CodeStatus(ctx context.Context, system, code, valueSetURL string) (string, error)
+}
+
+// TerminologyCache stores ValidateCode results so that the same code is not
+// re-validated repeatedly. Implementations must be safe for concurrent use and
+// may be shared across validator instances (e.g., an in-memory LRU or Redis).
+type TerminologyCache interface {
+	// Get returns the cached validity of key and whether it was found.
+	Get(key string) (valid bool, found bool)
+
+	// Set stores the validity of key.
+	Set(key string, valid bool)
+}
+
+// Coding is a code from a code system, as returned by Translate.
+type Coding struct {
+	System  string `json:"system"`
+	Code    string `json:"code"`
+	Display string `json:"display,omitempty"`
 }
 
 // CodeInfo contains information about a terminology code.
@@ -33,6 +69,8 @@ type CodeInfo struct {
 	Code    string `json:"code"`
 	Display string `json:"display,omitempty"`
 	Active  bool   `json:"active"`
+	// Status is the concept status (e.g., "deprecated", "retired"); empty when active or unknown
+	Status string `json:"status,omitempty"`
 }
 
 // StructureDefinitionProvider allows loading StructureDefinitions from different sources.
@@ -73,3 +111,30 @@ func (n *NoopTerminologyService) ExpandValueSet(ctx context.Context, valueSetURL
 func (n *NoopTerminologyService) LookupCode(ctx context.Context, system, code string) (*CodeInfo, error) {
 	return nil, nil
 }
+
+// Lookup returns not found (no CodeSystems available).
+func (n *NoopTerminologyService) Lookup(ctx context.Context, system, code string) (string, bool, error) {
+	return "", false, nil
+}
+
+// Translate returns empty (no mappings available).
+func (n *NoopTerminologyService) Translate(ctx context.Context, system, code, conceptMapURL string) ([]Coding, error) {
+	return nil, nil
+}
+
+// ResourceContext describes the resource passed to a ResourceValidatorFunc.
+type ResourceContext struct {
+	// ResourceType is the type of the resource (e.g., "Composition").
+	ResourceType string
+	// Path is the FHIRPath location of the resource: its type for the validated
+	// resource, or e.g. "Bundle.entry[0].resource" for a Bundle entry.
+	Path string
+	// Resource is the parsed JSON of the resource.
+	Resource map[string]interface{}
+	// StructureDefinition is the definition the resource was validated against.
+	StructureDefinition *StructureDef
+}
+
+// ResourceValidatorFunc adds custom validation for a resource type.
+// It reports problems by adding issues to result.
+type ResourceValidatorFunc func(ctx context.Context, resource *ResourceContext, result *ValidationResult)
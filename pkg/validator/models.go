@@ -1,6 +1,15 @@
 // Package validator provides FHIR resource validation based on StructureDefinitions.
 package validator
 
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+)
+
 // StructureDef is a version-agnostic internal model for StructureDefinition.
 // It extracts only the fields needed for validation, working across R4, R4B, and R5.
 type StructureDef struct {
@@ -22,6 +31,57 @@ type StructureDef struct {
 	Snapshot []ElementDef `json:"snapshot,omitempty"`
 	// Differential contains only the changed elements (for profiles)
 	Differential []ElementDef `json:"differential,omitempty"`
+
+	// elementsOnce guards elements, the element index built by the first call to Element
+	elementsOnce sync.Once
+	elements     elementIndex
+}
+
+// Element returns the snapshot element at path (e.g., "Patient.name.family"),
+// looked up in the validator's element index. Slices are skipped, so the
+// element returned for a sliced path is the base definition, and typed choice
+// names (e.g., "Patient.deceasedBoolean") return the choice element restricted
+// to that type.
+//
+// The index is built on the first call and reused afterwards, so the snapshot
+// must not be modified once Element has been called.
+func (sd *StructureDef) Element(path string) (*ElementDef, bool) {
+	sd.elementsOnce.Do(func() {
+		sd.elements = buildElementIndex(sd)
+	})
+	elem := sd.elements.lookup(path)
+	return elem, elem != nil
+}
+
+// RequiredElements returns the snapshot elements with a minimum cardinality of
+// at least 1, in snapshot order. Slices are skipped, as they are checked per
+// slice; an element is only required where its parent element is present.
+func (sd *StructureDef) RequiredElements() []*ElementDef {
+	var required []*ElementDef
+	for i := range sd.Snapshot {
+		elem := &sd.Snapshot[i]
+		if elem.Min > 0 && elem.Path != sd.Type && !isSliceElement(elem) {
+			required = append(required, elem)
+		}
+	}
+	return required
+}
+
+// Constraints returns the constraints of all snapshot elements, in snapshot
+// order. Constraints repeated on several elements (e.g., "ele-1") are returned once.
+func (sd *StructureDef) Constraints() []ElementConstraint {
+	var constraints []ElementConstraint
+	seen := make(map[string]bool)
+	for _, elem := range sd.Snapshot {
+		for _, constraint := range elem.Constraints {
+			if seen[constraint.Key] {
+				continue
+			}
+			seen[constraint.Key] = true
+			constraints = append(constraints, constraint)
+		}
+	}
+	return constraints
 }
 
 // ElementDef is a version-agnostic internal model for ElementDefinition.
@@ -47,6 +107,12 @@ type ElementDef struct {
 	Fixed interface{} `json:"fixed,omitempty"`
 	// Pattern value (if element must match pattern)
 	Pattern interface{} `json:"pattern,omitempty"`
+	// MaxLength is the maximum length of a string value (0 = no limit)
+	MaxLength int `json:"maxLength,omitempty"`
+	// MinValue is the inclusive lower bound from minValue[x] (number or date/time string)
+	MinValue interface{} `json:"minValue,omitempty"`
+	// MaxValue is the inclusive upper bound from maxValue[x] (number or date/time string)
+	MaxValue interface{} `json:"maxValue,omitempty"`
 	// Binding to a ValueSet
 	Binding *ElementBinding `json:"binding,omitempty"`
 	// Constraints (FHIRPath invariants)
@@ -108,6 +174,88 @@ type ValidationIssue struct {
 	Location []string `json:"location,omitempty"`
 	// Expression (FHIRPath) that identifies the element
 	Expression []string `json:"expression,omitempty"`
+	// Constraint is the invariant that produced this issue (invariant issues only).
+	// Source is set to the URL of the StructureDefinition that declares it.
+	Constraint *ElementConstraint `json:"constraint,omitempty"`
+	// Details is a stable code categorizing the issue (e.g., GOFHIR-CARD-MIN),
+	// set by AddIssue when not given
+	Details *IssueDetails `json:"details,omitempty"`
+	// LineColumn is the position in the JSON source of the element the issue
+	// refers to (set with ValidatorOptions.TrackSourcePositions)
+	LineColumn *SourcePosition `json:"lineColumn,omitempty"`
+}
+
+// IssueDetailsSystem is the code system of the IssueDetails codes.
+const IssueDetailsSystem = "https://github.com/robertoaraneda/gofhir/validator/issue-details"
+
+// Details codes of validation issues. Issues without a specific code get
+// "GOFHIR-" followed by their upper-cased Code (e.g., GOFHIR-CODE-INVALID).
+const (
+	DetailsCardinalityMin       = "GOFHIR-CARD-MIN"               // Missing element or too few values
+	DetailsCardinalityMax       = "GOFHIR-CARD-MAX"               // Too many values
+	DetailsUnknownElement       = "GOFHIR-UNKNOWN-ELEMENT"        // Element not defined by the StructureDefinition
+	DetailsMustSupport          = "GOFHIR-MUST-SUPPORT"           // Must-support element not populated
+	DetailsMustSupportExtension = "GOFHIR-MUST-SUPPORT-EXTENSION" // Must-support extension not populated
+)
+
+// issueDetailsText describes the specific details codes.
+var issueDetailsText = map[string]string{
+	DetailsCardinalityMin:       "Element has fewer values than its minimum cardinality",
+	DetailsCardinalityMax:       "Element has more values than its maximum cardinality",
+	DetailsUnknownElement:       "Element is not defined by the StructureDefinition",
+	DetailsMustSupport:          "Must-support element is not populated",
+	DetailsMustSupportExtension: "Must-support extension is not populated",
+}
+
+// IssueDetails is a coded categorization of a ValidationIssue, for consumers to
+// filter issues programmatically. It maps to OperationOutcome.issue.details and
+// is encoded as a CodeableConcept with a single coding.
+type IssueDetails struct {
+	// System is the code system of Code (IssueDetailsSystem for the validator's codes)
+	System string
+	// Code identifies the kind of issue
+	Code string
+	// Text describes the kind of issue
+	Text string
+}
+
+// NewIssueDetails returns the details of a validator code, e.g. DetailsCardinalityMin.
+func NewIssueDetails(code string) *IssueDetails {
+	return &IssueDetails{System: IssueDetailsSystem, Code: code, Text: issueDetailsText[code]}
+}
+
+// issueDetailsCoding is the JSON form of the coding of IssueDetails.
+type issueDetailsCoding struct {
+	System string `json:"system,omitempty"`
+	Code   string `json:"code,omitempty"`
+}
+
+// issueDetailsJSON is the CodeableConcept JSON form of IssueDetails.
+type issueDetailsJSON struct {
+	Coding []issueDetailsCoding `json:"coding,omitempty"`
+	Text   string               `json:"text,omitempty"`
+}
+
+// MarshalJSON encodes the details as a CodeableConcept.
+func (d IssueDetails) MarshalJSON() ([]byte, error) {
+	out := issueDetailsJSON{Text: d.Text}
+	if d.System != "" || d.Code != "" {
+		out.Coding = []issueDetailsCoding{{System: d.System, Code: d.Code}}
+	}
+	return json.Marshal(out)
+}
+
+// UnmarshalJSON decodes the details from a CodeableConcept, keeping its first coding.
+func (d *IssueDetails) UnmarshalJSON(data []byte) error {
+	var in issueDetailsJSON
+	if err := json.Unmarshal(data, &in); err != nil {
+		return err
+	}
+	*d = IssueDetails{Text: in.Text}
+	if len(in.Coding) > 0 {
+		d.System, d.Code = in.Coding[0].System, in.Coding[0].Code
+	}
+	return nil
 }
 
 // ValidationResult contains the result of validating a resource.
@@ -128,15 +276,17 @@ const (
 
 // Issue code constants (subset of OperationOutcome issue types)
 const (
-	IssueCodeStructure   = "structure"    // Structural issue
-	IssueCodeRequired    = "required"     // Required element missing
-	IssueCodeValue       = "value"        // Invalid value
-	IssueCodeInvariant   = "invariant"    // Invariant/constraint violation
-	IssueCodeProcessing  = "processing"   // Processing error
-	IssueCodeInvalid     = "invalid"      // Invalid content
-	IssueCodeNotFound    = "not-found"    // Reference not found
-	IssueCodeCodeInvalid = "code-invalid" // Invalid code
-	IssueCodeExtension   = "extension"    // Extension error
+	IssueCodeStructure     = "structure"     // Structural issue
+	IssueCodeRequired      = "required"      // Required element missing
+	IssueCodeValue         = "value"         // Invalid value
+	IssueCodeInvariant     = "invariant"     // Invariant/constraint violation
+	IssueCodeProcessing    = "processing"    // Processing error
+	IssueCodeInvalid       = "invalid"       // Invalid content
+	IssueCodeNotFound      = "not-found"     // Reference not found
+	IssueCodeCodeInvalid   = "code-invalid"  // Invalid code
+	IssueCodeExtension     = "extension"     // Extension error
+	IssueCodeBusinessRule  = "business-rule" // Business rule issue (e.g., use of a deprecated code)
+	IssueCodeInformational = "informational" // Informational note (e.g., an unpopulated must-support element)
 )
 
 // HasErrors returns true if there are any fatal or error severity issues.
@@ -181,14 +331,49 @@ func (r *ValidationResult) WarningCount() int {
 	return count
 }
 
-// AddIssue adds a validation issue to the result.
+// AddIssue adds a validation issue to the result. An issue without Details gets
+// the details code of its Code (e.g., GOFHIR-REQUIRED).
 func (r *ValidationResult) AddIssue(issue ValidationIssue) {
+	if issue.Details == nil && issue.Code != "" {
+		issue.Details = NewIssueDetails("GOFHIR-" + strings.ToUpper(issue.Code))
+	}
 	r.Issues = append(r.Issues, issue)
 	if issue.Severity == SeverityFatal || issue.Severity == SeverityError {
 		r.Valid = false
 	}
 }
 
+// severityOrder ranks issue severities from the most to the least severe.
+var severityOrder = map[string]int{
+	SeverityFatal:       0,
+	SeverityError:       1,
+	SeverityWarning:     2,
+	SeverityInformation: 3,
+}
+
+// sortIssues orders the issues by the path of their expression, then by
+// severity, code and diagnostics, so that the issues of a resource are reported
+// in the same order regardless of map iteration order. Issues without an
+// expression come first.
+func (r *ValidationResult) sortIssues() {
+	slices.SortStableFunc(r.Issues, func(a, b ValidationIssue) int {
+		return cmp.Or(
+			cmp.Compare(issuePath(a), issuePath(b)),
+			cmp.Compare(severityOrder[a.Severity], severityOrder[b.Severity]),
+			cmp.Compare(a.Code, b.Code),
+			cmp.Compare(a.Diagnostics, b.Diagnostics),
+		)
+	})
+}
+
+// issuePath returns the first expression of an issue, or "" if it has none.
+func issuePath(issue ValidationIssue) string {
+	if len(issue.Expression) == 0 {
+		return ""
+	}
+	return issue.Expression[0]
+}
+
 // NewValidationResult creates a new validation result (initially valid).
 func NewValidationResult() *ValidationResult {
 	return &ValidationResult{
@@ -197,6 +382,124 @@ func NewValidationResult() *ValidationResult {
 	}
 }
 
+// maxErrorSummaryIssues is the number of issues summarized in the message of a
+// ValidationError.
+const maxErrorSummaryIssues = 3
+
+// Error formats the issue as "severity [code] path: diagnostics", so that an
+// issue can be used as an error.
+func (i ValidationIssue) Error() string {
+	location := ""
+	if len(i.Expression) > 0 {
+		location = " " + i.Expression[0]
+	}
+	return fmt.Sprintf("%s [%s]%s: %s", i.Severity, i.Code, location, i.Diagnostics)
+}
+
+// ValidationError is the error returned by ValidationResult.AsError. It holds
+// the fatal and error issues of the result; Unwrap exposes each of them, so
+// errors.As can retrieve the first one as a ValidationIssue.
+type ValidationError struct {
+	Issues []ValidationIssue
+}
+
+// Error summarizes the first issues, e.g. "validation failed with 2 errors:
+// error [required] Patient.status: ...; error [value] Patient.gender: ...".
+func (e *ValidationError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "validation failed with %d error", len(e.Issues))
+	if len(e.Issues) != 1 {
+		b.WriteString("s")
+	}
+	for i, issue := range e.Issues {
+		if i == maxErrorSummaryIssues {
+			fmt.Fprintf(&b, " (and %d more)", len(e.Issues)-i)
+			break
+		}
+		if i == 0 {
+			b.WriteString(": ")
+		} else {
+			b.WriteString("; ")
+		}
+		b.WriteString(issue.Error())
+	}
+	return b.String()
+}
+
+// Unwrap returns the issues as errors.
+func (e *ValidationError) Unwrap() []error {
+	errs := make([]error, len(e.Issues))
+	for i, issue := range e.Issues {
+		errs[i] = issue
+	}
+	return errs
+}
+
+// AsError returns nil if the result is valid, or a *ValidationError with its
+// fatal and error issues otherwise, for idiomatic Go error handling:
+//
+//	if err := result.AsError(); err != nil {
+//	    return fmt.Errorf("invalid patient: %w", err)
+//	}
+func (r *ValidationResult) AsError() error {
+	if r.Valid {
+		return nil
+	}
+	var issues []ValidationIssue
+	for _, issue := range r.Issues {
+		if issue.Severity == SeverityFatal || issue.Severity == SeverityError {
+			issues = append(issues, issue)
+		}
+	}
+	return &ValidationError{Issues: issues}
+}
+
+// OperationOutcome is the FHIR JSON form of a ValidationResult, the same in every
+// FHIR version.
+type OperationOutcome struct {
+	ResourceType string                  `json:"resourceType"`
+	Issue        []OperationOutcomeIssue `json:"issue"`
+}
+
+// OperationOutcomeIssue is an OperationOutcome.issue.
+type OperationOutcomeIssue struct {
+	Severity    string        `json:"severity"`
+	Code        string        `json:"code"`
+	Details     *IssueDetails `json:"details,omitempty"`
+	Diagnostics string        `json:"diagnostics,omitempty"`
+	Location    []string      `json:"location,omitempty"`
+	Expression  []string      `json:"expression,omitempty"`
+}
+
+// ToOperationOutcome converts the result to an OperationOutcome, keeping the
+// Details of the issues. Constraint has no OperationOutcome equivalent and is
+// dropped. A result without issues gets an informational "All OK" issue, since an
+// OperationOutcome must have at least one issue.
+func (r *ValidationResult) ToOperationOutcome() *OperationOutcome {
+	outcome := &OperationOutcome{
+		ResourceType: "OperationOutcome",
+		Issue:        make([]OperationOutcomeIssue, 0, len(r.Issues)),
+	}
+	for _, issue := range r.Issues {
+		outcome.Issue = append(outcome.Issue, OperationOutcomeIssue{
+			Severity:    issue.Severity,
+			Code:        issue.Code,
+			Details:     issue.Details,
+			Diagnostics: issue.Diagnostics,
+			Location:    issue.Location,
+			Expression:  issue.Expression,
+		})
+	}
+	if len(outcome.Issue) == 0 {
+		outcome.Issue = append(outcome.Issue, OperationOutcomeIssue{
+			Severity:    SeverityInformation,
+			Code:        IssueCodeInformational,
+			Diagnostics: "All OK",
+		})
+	}
+	return outcome
+}
+
 // Merge combines another validation result into this one.
 func (r *ValidationResult) Merge(other *ValidationResult) {
 	if other == nil {
@@ -0,0 +1,92 @@
+// Package validator provides FHIR resource validation based on StructureDefinitions.
+package validator
+
+import (
+	"fmt"
+	"strings"
+)
+
+// reportMustSupport reports the must-support elements and extensions of the
+// StructureDefinition that the resource does not populate, as informational
+// issues. An element is only reported where its parent is present, and elements
+// with a minimum cardinality are left to cardinality validation. Must-support
+// extensions are the extension slices flagged mustSupport; they are reported
+// with their url and DetailsMustSupportExtension rather than DetailsMustSupport.
+func (v *Validator) reportMustSupport(vctx *validationContext, result *ValidationResult) {
+	for i := range vctx.sd.Snapshot {
+		elem := &vctx.sd.Snapshot[i]
+		if !elem.MustSupport || elem.Min > 0 || elem.Path == vctx.resourceType {
+			continue
+		}
+
+		url := extensionSliceURL(elem)
+		if url == "" && (elem.SliceName != "" || strings.Contains(elem.ID, ":")) {
+			// Other slices and their children share the path of the sliced element
+			continue
+		}
+
+		parentPath := getParentPath(elem.Path)
+		field := elem.Path[len(parentPath)+1:]
+		var parts []string
+		if parentPath != vctx.resourceType {
+			parts = strings.Split(strings.TrimPrefix(parentPath, vctx.resourceType+"."), ".")
+		}
+
+		for _, parent := range collectPathNodes(vctx.parsed, vctx.resourceType, parts) {
+			if url != "" {
+				if !hasExtensionURL(parent.node[field], url) {
+					result.AddIssue(ValidationIssue{
+						Severity:    SeverityInformation,
+						Code:        IssueCodeInformational,
+						Diagnostics: fmt.Sprintf("Must-support extension '%s' (%s) is not populated", elem.SliceName, url),
+						Expression:  []string{parent.path + "." + field},
+						Details:     NewIssueDetails(DetailsMustSupportExtension),
+					})
+				}
+				continue
+			}
+
+			if !hasElement(parent.node, field) {
+				result.AddIssue(ValidationIssue{
+					Severity:    SeverityInformation,
+					Code:        IssueCodeInformational,
+					Diagnostics: fmt.Sprintf("Must-support element %s is not populated", elem.Path),
+					Expression:  []string{parent.path + "." + field},
+					Details:     NewIssueDetails(DetailsMustSupport),
+				})
+			}
+		}
+	}
+}
+
+// hasExtensionURL reports whether extensions (an extension array) contains an
+// extension with the given url.
+func hasExtensionURL(extensions interface{}, url string) bool {
+	items, _ := extensions.([]interface{})
+	for _, item := range items {
+		if ext, ok := item.(map[string]interface{}); ok && ext["url"] == url {
+			return true
+		}
+	}
+	return false
+}
+
+// hasElement reports whether node populates field, matching any type of a
+// choice element (e.g., "value[x]" matches "valueQuantity"). A primitive
+// represented only by its "_field" element counts as populated.
+func hasElement(node map[string]interface{}, field string) bool {
+	if prefix, ok := strings.CutSuffix(field, "[x]"); ok {
+		for key := range node {
+			name := strings.TrimPrefix(key, "_")
+			if len(name) > len(prefix) && strings.HasPrefix(name, prefix) {
+				return true
+			}
+		}
+		return false
+	}
+	if _, ok := node[field]; ok {
+		return true
+	}
+	_, ok := node["_"+field]
+	return ok
+}
@@ -0,0 +1,111 @@
+package validator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mustSupportTestSD is a Patient profile with a must-support birthsex extension
+// and must-support elements.
+var mustSupportTestSD = &StructureDef{
+	URL:  "http://example.org/fhir/StructureDefinition/ms-patient",
+	Name: "MSPatient",
+	Type: "Patient",
+	Kind: "resource",
+	Snapshot: []ElementDef{
+		{ID: "Patient", Path: "Patient", Min: 0, Max: "*"},
+		{ID: "Patient.id", Path: "Patient.id", Min: 0, Max: "1", Types: []TypeRef{{Code: "id"}}},
+		{ID: "Patient.extension", Path: "Patient.extension", Min: 0, Max: "*", Types: []TypeRef{{Code: "Extension"}}},
+		{
+			ID: "Patient.extension:birthsex", Path: "Patient.extension", SliceName: "birthsex", Min: 0, Max: "1", MustSupport: true,
+			Types: []TypeRef{{Code: "Extension", Profile: []string{"http://example.org/fhir/StructureDefinition/birthsex"}}},
+		},
+		{ID: "Patient.gender", Path: "Patient.gender", Min: 0, Max: "1", MustSupport: true, Types: []TypeRef{{Code: "code"}}},
+		{ID: "Patient.birthDate", Path: "Patient.birthDate", Min: 1, Max: "1", MustSupport: true, Types: []TypeRef{{Code: "date"}}},
+		{ID: "Patient.deceased[x]", Path: "Patient.deceased[x]", Min: 0, Max: "1", MustSupport: true, Types: []TypeRef{{Code: "boolean"}, {Code: "dateTime"}}},
+		{ID: "Patient.name", Path: "Patient.name", Min: 0, Max: "*", Types: []TypeRef{{Code: "HumanName"}}},
+		{ID: "Patient.name.family", Path: "Patient.name.family", Min: 0, Max: "1", MustSupport: true, Types: []TypeRef{{Code: "string"}}},
+	},
+}
+
+func TestReportMustSupport(t *testing.T) {
+	v := newMinimalValidator(t, ValidatorOptions{
+		Profile:           mustSupportTestSD.URL,
+		ReportMustSupport: true,
+	}, mustSupportTestSD)
+
+	tests := []struct {
+		name          string
+		resource      string
+		wantElements  []string
+		wantExtension []string
+	}{
+		{
+			name: "all populated",
+			resource: `{"resourceType": "Patient", "birthDate": "1970-01-01", "gender": "female", "deceasedBoolean": false,
+				"extension": [{"url": "http://example.org/fhir/StructureDefinition/birthsex", "valueCode": "F"}],
+				"name": [{"family": "Doe"}]}`,
+		},
+		{
+			name:          "extension absent",
+			resource:      `{"resourceType": "Patient", "birthDate": "1970-01-01", "gender": "female", "deceasedBoolean": false}`,
+			wantExtension: []string{"Patient.extension"},
+		},
+		{
+			name: "other extension only",
+			resource: `{"resourceType": "Patient", "birthDate": "1970-01-01", "gender": "female", "deceasedDateTime": "2020-01-01",
+				"extension": [{"url": "http://example.org/fhir/StructureDefinition/other", "valueString": "x"}]}`,
+			wantExtension: []string{"Patient.extension"},
+		},
+		{
+			name: "elements absent",
+			resource: `{"resourceType": "Patient", "birthDate": "1970-01-01",
+				"extension": [{"url": "http://example.org/fhir/StructureDefinition/birthsex", "valueCode": "F"}],
+				"name": [{"family": "Doe"}, {"given": ["Jane"]}]}`,
+			wantElements: []string{"Patient.gender", "Patient.deceased[x]", "Patient.name[1].family"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := v.Validate(context.Background(), []byte(tt.resource))
+			require.NoError(t, err)
+
+			var elements, extensions []string
+			for _, issue := range result.Issues {
+				if issue.Severity != SeverityInformation {
+					continue
+				}
+				require.NotNil(t, issue.Details)
+				assert.Equal(t, IssueCodeInformational, issue.Code)
+				switch issue.Details.Code {
+				case DetailsMustSupport:
+					elements = append(elements, issue.Expression...)
+				case DetailsMustSupportExtension:
+					extensions = append(extensions, issue.Expression...)
+					assert.Contains(t, issue.Diagnostics, "http://example.org/fhir/StructureDefinition/birthsex")
+				}
+			}
+			assert.ElementsMatch(t, tt.wantElements, elements, "Issues: %v", result.Issues)
+			assert.ElementsMatch(t, tt.wantExtension, extensions, "Issues: %v", result.Issues)
+			assert.True(t, result.Valid, "Issues: %v", result.Issues)
+		})
+	}
+}
+
+func TestReportMustSupport_Disabled(t *testing.T) {
+	v := newMinimalValidator(t, ValidatorOptions{
+		Profile:           mustSupportTestSD.URL,
+		ReportMustSupport: true,
+	}, mustSupportTestSD)
+	v.options.ReportMustSupport = false
+
+	result, err := v.Validate(context.Background(), []byte(`{"resourceType": "Patient", "birthDate": "1970-01-01"}`))
+	require.NoError(t, err)
+	for _, issue := range result.Issues {
+		assert.NotEqual(t, SeverityInformation, issue.Severity, "Unexpected issue: %v", issue)
+	}
+}
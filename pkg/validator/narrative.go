@@ -0,0 +1,166 @@
+// Package validator provides FHIR resource validation based on StructureDefinitions.
+package validator
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// xhtmlNamespace is the namespace required for Narrative.div.
+// https://www.hl7.org/fhir/narrative.html#xhtml
+const xhtmlNamespace = "http://www.w3.org/1999/xhtml"
+
+// disallowedNarrativeElements lists XHTML elements that may not appear in a narrative (txt-1).
+var disallowedNarrativeElements = map[string]bool{
+	"html":     true,
+	"head":     true,
+	"body":     true,
+	"script":   true,
+	"form":     true,
+	"input":    true,
+	"button":   true,
+	"base":     true,
+	"link":     true,
+	"meta":     true,
+	"frame":    true,
+	"frameset": true,
+	"iframe":   true,
+	"object":   true,
+	"embed":    true,
+	"applet":   true,
+}
+
+// validateNarrative validates the XHTML content of all Narrative elements in the resource.
+func (v *Validator) validateNarrative(_ context.Context, vctx *validationContext, result *ValidationResult) {
+	v.validateNarrativeInNode(vctx.parsed, vctx.resourceType, result)
+}
+
+// validateNarrativeInNode recursively finds Narrative elements (text.div) in a node.
+func (v *Validator) validateNarrativeInNode(node interface{}, path string, result *ValidationResult) {
+	if v.options.MaxErrors > 0 && result.ErrorCount() >= v.options.MaxErrors {
+		return
+	}
+
+	switch val := node.(type) {
+	case map[string]interface{}:
+		for key, child := range val {
+			childPath := path + "." + key
+			if key == "text" {
+				if text, ok := child.(map[string]interface{}); ok {
+					if div, ok := text["div"].(string); ok {
+						validateNarrativeDiv(div, childPath+".div", result)
+					}
+				}
+			}
+			v.validateNarrativeInNode(child, childPath, result)
+		}
+
+	case []interface{}:
+		for i, item := range val {
+			itemPath := fmt.Sprintf("%s[%d]", path, i)
+			v.validateNarrativeInNode(item, itemPath, result)
+		}
+	}
+}
+
+// validateNarrativeDiv checks that div is well-formed XHTML rooted at a <div> in the
+// XHTML namespace and that it contains no active content.
+func validateNarrativeDiv(div, path string, result *ValidationResult) {
+	decoder := xml.NewDecoder(strings.NewReader(div))
+	decoder.Strict = true
+	decoder.Entity = xml.HTMLEntity
+
+	depth := 0
+	for {
+		tok, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			result.AddIssue(ValidationIssue{
+				Severity:    SeverityError,
+				Code:        IssueCodeInvalid,
+				Diagnostics: fmt.Sprintf("Narrative div is not well-formed XHTML: %v", err),
+				Expression:  []string{path},
+			})
+			return
+		}
+
+		switch t := tok.(type) {
+		case xml.StartElement:
+			if depth == 0 {
+				if t.Name.Local != "div" {
+					result.AddIssue(ValidationIssue{
+						Severity:    SeverityError,
+						Code:        IssueCodeInvalid,
+						Diagnostics: fmt.Sprintf("Narrative root element must be <div>, found <%s>", t.Name.Local),
+						Expression:  []string{path},
+					})
+				}
+				if t.Name.Space != xhtmlNamespace {
+					result.AddIssue(ValidationIssue{
+						Severity:    SeverityError,
+						Code:        IssueCodeInvalid,
+						Diagnostics: fmt.Sprintf("Narrative div must be in the XHTML namespace '%s'", xhtmlNamespace),
+						Expression:  []string{path},
+					})
+				}
+			}
+			depth++
+			validateNarrativeElement(t, path, result)
+
+		case xml.EndElement:
+			depth--
+
+		case xml.CharData:
+			if depth == 0 && strings.TrimSpace(string(t)) != "" {
+				result.AddIssue(ValidationIssue{
+					Severity:    SeverityError,
+					Code:        IssueCodeInvalid,
+					Diagnostics: "Narrative div must not contain text outside the root element",
+					Expression:  []string{path},
+				})
+				return
+			}
+		}
+	}
+}
+
+// validateNarrativeElement reports active content on a single XHTML element:
+// disallowed elements, event handler attributes and javascript: URLs.
+func validateNarrativeElement(elem xml.StartElement, path string, result *ValidationResult) {
+	name := strings.ToLower(elem.Name.Local)
+	if disallowedNarrativeElements[name] {
+		result.AddIssue(ValidationIssue{
+			Severity:    SeverityError,
+			Code:        IssueCodeInvalid,
+			Diagnostics: fmt.Sprintf("Narrative must not contain <%s> elements", name),
+			Expression:  []string{path},
+		})
+	}
+
+	for _, attr := range elem.Attr {
+		attrName := strings.ToLower(attr.Name.Local)
+		if strings.HasPrefix(attrName, "on") {
+			result.AddIssue(ValidationIssue{
+				Severity:    SeverityError,
+				Code:        IssueCodeInvalid,
+				Diagnostics: fmt.Sprintf("Narrative must not contain event handler attribute '%s' on <%s>", attr.Name.Local, name),
+				Expression:  []string{path},
+			})
+			continue
+		}
+		if strings.HasPrefix(strings.ToLower(strings.TrimSpace(attr.Value)), "javascript:") {
+			result.AddIssue(ValidationIssue{
+				Severity:    SeverityError,
+				Code:        IssueCodeInvalid,
+				Diagnostics: fmt.Sprintf("Narrative must not contain javascript: URL in attribute '%s' on <%s>", attr.Name.Local, name),
+				Expression:  []string{path},
+			})
+		}
+	}
+}
@@ -0,0 +1,105 @@
+package validator
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// narrativeTestSD is a minimal Patient definition with a text element.
+var narrativeTestSD = &StructureDef{
+	URL:  "http://hl7.org/fhir/StructureDefinition/Patient",
+	Name: "Patient",
+	Type: "Patient",
+	Kind: "resource",
+	Snapshot: []ElementDef{
+		{Path: "Patient", Min: 0, Max: "*"},
+		{Path: "Patient.id", Min: 0, Max: "1", Types: []TypeRef{{Code: "id"}}},
+		{Path: "Patient.text", Min: 0, Max: "1", Types: []TypeRef{{Code: "Narrative"}}},
+	},
+}
+
+func narrativePatient(div string) []byte {
+	return []byte(fmt.Sprintf(`{
+		"resourceType": "Patient",
+		"id": "test",
+		"text": {"status": "generated", "div": %q}
+	}`, div))
+}
+
+// countNarrativeErrors counts errors reported on Narrative.div.
+func countNarrativeErrors(result *ValidationResult) int {
+	count := 0
+	for _, issue := range result.Issues {
+		if issue.Severity == SeverityError && len(issue.Expression) > 0 && issue.Expression[0] == "Patient.text.div" {
+			count++
+		}
+	}
+	return count
+}
+
+func TestValidateNarrative(t *testing.T) {
+	v := newMinimalValidator(t, ValidatorOptions{ValidateNarrative: true}, narrativeTestSD)
+
+	tests := []struct {
+		name       string
+		div        string
+		wantErrors int
+	}{
+		{
+			name:       "valid narrative",
+			div:        `<div xmlns="http://www.w3.org/1999/xhtml"><p>John <b>Doe</b>&nbsp;<a href="http://example.org">link</a></p></div>`,
+			wantErrors: 0,
+		},
+		{
+			name:       "missing namespace",
+			div:        `<div><p>John Doe</p></div>`,
+			wantErrors: 1,
+		},
+		{
+			name:       "embedded script",
+			div:        `<div xmlns="http://www.w3.org/1999/xhtml"><p>John Doe</p><script>alert(1)</script></div>`,
+			wantErrors: 1,
+		},
+		{
+			name:       "event handler attribute",
+			div:        `<div xmlns="http://www.w3.org/1999/xhtml"><p onclick="alert(1)">John Doe</p></div>`,
+			wantErrors: 1,
+		},
+		{
+			name:       "javascript url",
+			div:        `<div xmlns="http://www.w3.org/1999/xhtml"><a href="javascript:alert(1)">x</a></div>`,
+			wantErrors: 1,
+		},
+		{
+			name:       "malformed xhtml",
+			div:        `<div xmlns="http://www.w3.org/1999/xhtml"><p>John Doe</div>`,
+			wantErrors: 1,
+		},
+		{
+			name:       "wrong root element",
+			div:        `<p xmlns="http://www.w3.org/1999/xhtml">John Doe</p>`,
+			wantErrors: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := v.Validate(context.Background(), narrativePatient(tt.div))
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantErrors, countNarrativeErrors(result), "Issues: %v", result.Issues)
+		})
+	}
+}
+
+func TestValidateNarrative_Disabled(t *testing.T) {
+	v := newMinimalValidator(t, ValidatorOptions{ValidateNarrative: true}, narrativeTestSD)
+	v.options.ValidateNarrative = false
+
+	result, err := v.Validate(context.Background(), narrativePatient(`<div><script>alert(1)</script></div>`))
+	require.NoError(t, err)
+	assert.Equal(t, 0, countNarrativeErrors(result))
+}
@@ -0,0 +1,59 @@
+package validator
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+)
+
+// maxNDJSONLineSize is the largest resource accepted on a single NDJSON line.
+const maxNDJSONLineSize = 64 * 1024 * 1024
+
+// NDJSONResult is the validation result of one line of an NDJSON stream.
+type NDJSONResult struct {
+	// Line is the 1-based line number of the resource in the stream
+	Line int
+	// Resource is the raw JSON of the resource
+	Resource []byte
+	// Result is the validation result of the resource
+	Result *ValidationResult
+}
+
+// ValidateNDJSON validates a stream of newline-delimited JSON resources (as produced
+// by FHIR Bulk Data export), one resource per line. Blank lines are skipped.
+//
+// Resources are read and validated one at a time, so the stream is never held in
+// memory. fn is called with the result of each line in order; returning an error
+// from fn stops validation and the error is returned.
+func (v *Validator) ValidateNDJSON(ctx context.Context, r io.Reader, fn func(NDJSONResult) error) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxNDJSONLineSize)
+
+	line := 0
+	for scanner.Scan() {
+		line++
+		data := bytes.TrimSpace(scanner.Bytes())
+		if len(data) == 0 {
+			continue
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		// The scanner reuses its buffer, so keep a copy for the callback
+		resource := bytes.Clone(data)
+		result, err := v.Validate(ctx, resource)
+		if err != nil {
+			return fmt.Errorf("line %d: %w", line, err)
+		}
+		if err := fn(NDJSONResult{Line: line, Resource: resource, Result: result}); err != nil {
+			return err
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to read NDJSON line %d: %w", line+1, err)
+	}
+	return nil
+}
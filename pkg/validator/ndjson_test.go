@@ -0,0 +1,71 @@
+package validator
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+)
+
+// genderRequiredPatientSD is a minimal Patient definition with a required gender.
+var genderRequiredPatientSD = &StructureDef{
+	URL:  "http://hl7.org/fhir/StructureDefinition/Patient",
+	Name: "Patient",
+	Type: "Patient",
+	Kind: "resource",
+	Snapshot: []ElementDef{
+		{Path: "Patient", Min: 0, Max: "*"},
+		{Path: "Patient.id", Min: 0, Max: "1", Types: []TypeRef{{Code: "id"}}},
+		{Path: "Patient.gender", Min: 1, Max: "1", Types: []TypeRef{{Code: "code"}}},
+	},
+}
+
+func TestValidateNDJSON(t *testing.T) {
+	v := newMinimalValidator(t, ValidatorOptions{}, genderRequiredPatientSD)
+	input := `{"resourceType": "Patient", "id": "p1", "gender": "male"}
+
+{"resourceType": "Patient", "id": "p2"}
+not json
+`
+
+	var results []NDJSONResult
+	err := v.ValidateNDJSON(context.Background(), strings.NewReader(input), func(r NDJSONResult) error {
+		results = append(results, r)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("ValidateNDJSON() error = %v", err)
+	}
+
+	if len(results) != 3 {
+		t.Fatalf("got %d results, want 3 (blank lines are skipped)", len(results))
+	}
+	wantLines := []int{1, 3, 4}
+	wantValid := []bool{true, false, false}
+	for i, r := range results {
+		if r.Line != wantLines[i] {
+			t.Errorf("results[%d].Line = %d, want %d", i, r.Line, wantLines[i])
+		}
+		if r.Result.Valid != wantValid[i] {
+			t.Errorf("line %d: Valid = %v, want %v: %v", r.Line, r.Result.Valid, wantValid[i], r.Result.Issues)
+		}
+	}
+	if !strings.Contains(string(results[1].Resource), `"id": "p2"`) {
+		t.Errorf("results[1].Resource = %s, want the second resource", results[1].Resource)
+	}
+
+	t.Run("callback error stops validation", func(t *testing.T) {
+		stop := errors.New("stop")
+		calls := 0
+		err := v.ValidateNDJSON(context.Background(), strings.NewReader(input), func(NDJSONResult) error {
+			calls++
+			return stop
+		})
+		if !errors.Is(err, stop) {
+			t.Errorf("ValidateNDJSON() error = %v, want %v", err, stop)
+		}
+		if calls != 1 {
+			t.Errorf("callback called %d times, want 1", calls)
+		}
+	})
+}
@@ -0,0 +1,119 @@
+// Package validator provides FHIR resource validation based on StructureDefinitions.
+package validator
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/robertoaraneda/gofhir/pkg/ucum"
+)
+
+// resourceTypeObservation is the FHIR resource type for Observation.
+const resourceTypeObservation = "Observation"
+
+// ucumSystem is the code system of UCUM units in FHIR Quantity values.
+const ucumSystem = "http://unitsofmeasure.org"
+
+// validateReferenceRangeUnits warns when the low or high of an Observation
+// referenceRange has a unit that is not UCUM-compatible with the unit of the
+// valueQuantity, which makes the range unusable for interpreting the value
+// (e.g., a value in mmol/L with a range in mg/dL). Components are checked
+// against their own referenceRange. observation is reported under basePath.
+func (v *Validator) validateReferenceRangeUnits(observation map[string]interface{}, basePath string, result *ValidationResult) {
+	v.validateRangeUnits(observation, basePath, result)
+
+	components, _ := observation["component"].([]interface{})
+	for i, item := range components {
+		if component, ok := item.(map[string]interface{}); ok {
+			v.validateRangeUnits(component, fmt.Sprintf("%s.component[%d]", basePath, i), result)
+		}
+	}
+}
+
+// validateRangeUnits compares the referenceRange units of an Observation or
+// Observation.component with its valueQuantity unit.
+func (v *Validator) validateRangeUnits(node map[string]interface{}, path string, result *ValidationResult) {
+	value, ok := node["valueQuantity"].(map[string]interface{})
+	if !ok {
+		return
+	}
+	valueUnit, ok := quantityUnit(value)
+	if !ok {
+		return
+	}
+
+	ranges, _ := node["referenceRange"].([]interface{})
+	for i, item := range ranges {
+		referenceRange, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for _, bound := range []string{"low", "high"} {
+			quantity, ok := referenceRange[bound].(map[string]interface{})
+			if !ok {
+				continue
+			}
+			unit, ok := quantityUnit(quantity)
+			if !ok || unitsCompatible(valueUnit, unit) {
+				continue
+			}
+			result.AddIssue(ValidationIssue{
+				Severity: SeverityWarning,
+				Code:     IssueCodeValue,
+				Diagnostics: fmt.Sprintf("referenceRange.%s unit '%s' is not compatible with the valueQuantity unit '%s'",
+					bound, unit, valueUnit),
+				Expression: []string{fmt.Sprintf("%s.referenceRange[%d].%s", path, i, bound)},
+			})
+		}
+	}
+}
+
+// quantityUnit returns the UCUM unit of a Quantity: its code, or its unit when
+// there is no code. Quantities coded in another system have no UCUM unit.
+func quantityUnit(quantity map[string]interface{}) (string, bool) {
+	if system, ok := quantity["system"].(string); ok && system != ucumSystem {
+		return "", false
+	}
+	if code, ok := quantity["code"].(string); ok && code != "" {
+		return code, true
+	}
+	unit, ok := quantity["unit"].(string)
+	return unit, ok && unit != ""
+}
+
+// unitsCompatible reports whether two UCUM units may describe the same kind of
+// quantity. Units that are the same once their {annotations} are removed are
+// compatible, and so are units the UCUM package does not know (e.g., kg/m2 or U/L),
+// as they cannot be compared; known units must convert to the same canonical unit
+// (e.g., mg/dL and g/L).
+func unitsCompatible(a, b string) bool {
+	a, b = stripUnitAnnotations(a), stripUnitAnnotations(b)
+	if a == b {
+		return true
+	}
+	if !ucum.IsKnownUnit(a) || !ucum.IsKnownUnit(b) {
+		return true
+	}
+	return ucum.GetCanonicalUnit(a) == ucum.GetCanonicalUnit(b)
+}
+
+// stripUnitAnnotations removes the {annotations} of a UCUM unit, which carry no
+// meaning for conversion: {beats}/min is /min, and a lone {score} is the unity 1.
+func stripUnitAnnotations(unit string) string {
+	var sb strings.Builder
+	depth := 0
+	for _, r := range unit {
+		switch {
+		case r == '{':
+			depth++
+		case r == '}' && depth > 0:
+			depth--
+		case depth == 0:
+			sb.WriteRune(r)
+		}
+	}
+	if sb.Len() == 0 && unit != "" {
+		return "1"
+	}
+	return sb.String()
+}
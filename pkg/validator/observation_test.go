@@ -0,0 +1,139 @@
+package validator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// observationTestSD is a minimal Observation definition.
+var observationTestSD = &StructureDef{
+	URL:  "http://hl7.org/fhir/StructureDefinition/Observation",
+	Name: "Observation",
+	Type: "Observation",
+	Kind: "resource",
+	Snapshot: []ElementDef{
+		{Path: "Observation", Min: 0, Max: "*"},
+		{Path: "Observation.status", Min: 0, Max: "1", Types: []TypeRef{{Code: "code"}}},
+		{Path: "Observation.value[x]", Min: 0, Max: "1", Types: []TypeRef{{Code: "Quantity"}, {Code: "string"}}},
+		{Path: "Observation.referenceRange", Min: 0, Max: "*", Types: []TypeRef{{Code: "BackboneElement"}}},
+		{Path: "Observation.referenceRange.low", Min: 0, Max: "1", Types: []TypeRef{{Code: "Quantity"}}},
+		{Path: "Observation.referenceRange.high", Min: 0, Max: "1", Types: []TypeRef{{Code: "Quantity"}}},
+		{Path: "Observation.component", Min: 0, Max: "*", Types: []TypeRef{{Code: "BackboneElement"}}},
+		{Path: "Observation.component.value[x]", Min: 0, Max: "1", Types: []TypeRef{{Code: "Quantity"}}},
+		{Path: "Observation.component.referenceRange", Min: 0, Max: "*"},
+	},
+}
+
+// referenceRangeIssues returns the paths of the reference range unit warnings.
+func referenceRangeIssues(result *ValidationResult) []string {
+	var paths []string
+	for _, issue := range result.Issues {
+		if containsString(issue.Diagnostics, "referenceRange") && len(issue.Expression) > 0 {
+			paths = append(paths, issue.Expression[0])
+		}
+	}
+	return paths
+}
+
+func TestValidateReferenceRangeUnits(t *testing.T) {
+	v := newMinimalValidator(t, ValidatorOptions{ValidateBestPractices: true}, observationTestSD)
+
+	tests := []struct {
+		name      string
+		resource  string
+		wantPaths []string
+	}{
+		{
+			name: "same unit",
+			resource: `{"resourceType": "Observation", "status": "final",
+				"valueQuantity": {"value": 5.4, "unit": "mmol/L", "system": "http://unitsofmeasure.org", "code": "mmol/L"},
+				"referenceRange": [{"low": {"value": 3.9, "system": "http://unitsofmeasure.org", "code": "mmol/L"},
+					"high": {"value": 5.6, "system": "http://unitsofmeasure.org", "code": "mmol/L"}}]}`,
+		},
+		{
+			name: "convertible units",
+			resource: `{"resourceType": "Observation", "status": "final",
+				"valueQuantity": {"value": 1.2, "system": "http://unitsofmeasure.org", "code": "g/L"},
+				"referenceRange": [{"low": {"value": 70, "system": "http://unitsofmeasure.org", "code": "mg/dL"},
+					"high": {"value": 110, "unit": "mg/dL"}}]}`,
+		},
+		{
+			name: "incompatible units",
+			resource: `{"resourceType": "Observation", "status": "final",
+				"valueQuantity": {"value": 5.4, "system": "http://unitsofmeasure.org", "code": "mmol/L"},
+				"referenceRange": [{"low": {"value": 70, "system": "http://unitsofmeasure.org", "code": "mg/dL"},
+					"high": {"value": 5.6, "system": "http://unitsofmeasure.org", "code": "mmol/L"}}]}`,
+			wantPaths: []string{"Observation.referenceRange[0].low"},
+		},
+		{
+			name: "unknown units are not compared",
+			resource: `{"resourceType": "Observation", "status": "final",
+				"valueQuantity": {"value": 7.1, "system": "http://unitsofmeasure.org", "code": "10*9/L"},
+				"referenceRange": [{"high": {"value": 11, "unit": "thou/uL"}}]}`,
+		},
+		{
+			name: "annotations are ignored",
+			resource: `{"resourceType": "Observation", "status": "final",
+				"valueQuantity": {"value": 72, "system": "http://unitsofmeasure.org", "code": "{beats}/min"},
+				"referenceRange": [{"low": {"value": 60, "system": "http://unitsofmeasure.org", "code": "/min"},
+					"high": {"value": 100, "system": "http://unitsofmeasure.org", "code": "/min"}}]}`,
+		},
+		{
+			name: "units unknown to UCUM package",
+			resource: `{"resourceType": "Observation", "status": "final",
+				"valueQuantity": {"value": 24, "system": "http://unitsofmeasure.org", "code": "kg/m2"},
+				"referenceRange": [{"low": {"value": 18.5, "system": "http://unitsofmeasure.org", "code": "kg/m2"}},
+					{"high": {"value": 40, "system": "http://unitsofmeasure.org", "code": "U/L"}}]}`,
+		},
+		{
+			name: "annotated unit with a known incompatible unit",
+			resource: `{"resourceType": "Observation", "status": "final",
+				"valueQuantity": {"value": 72, "system": "http://unitsofmeasure.org", "code": "{beats}/min"},
+				"referenceRange": [{"high": {"value": 100, "system": "http://unitsofmeasure.org", "code": "mg/dL"}}]}`,
+			wantPaths: []string{"Observation.referenceRange[0].high"},
+		},
+		{
+			name: "non-UCUM system is not compared",
+			resource: `{"resourceType": "Observation", "status": "final",
+				"valueQuantity": {"value": 2, "system": "http://example.org/units", "code": "tab"},
+				"referenceRange": [{"high": {"value": 4, "system": "http://unitsofmeasure.org", "code": "mg"}}]}`,
+		},
+		{
+			name: "components",
+			resource: `{"resourceType": "Observation", "status": "final",
+				"component": [
+					{"valueQuantity": {"value": 120, "system": "http://unitsofmeasure.org", "code": "mm[Hg]"},
+						"referenceRange": [{"high": {"value": 140, "system": "http://unitsofmeasure.org", "code": "mm[Hg]"}}]},
+					{"valueQuantity": {"value": 80, "system": "http://unitsofmeasure.org", "code": "mm[Hg]"},
+						"referenceRange": [{"high": {"value": 90, "system": "http://unitsofmeasure.org", "code": "/min"}}]}
+				]}`,
+			wantPaths: []string{"Observation.component[1].referenceRange[0].high"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := v.Validate(context.Background(), []byte(tt.resource))
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantPaths, referenceRangeIssues(result), "Issues: %v", result.Issues)
+			for _, issue := range result.Issues {
+				if containsString(issue.Diagnostics, "referenceRange") {
+					assert.Equal(t, SeverityWarning, issue.Severity)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateReferenceRangeUnits_Disabled(t *testing.T) {
+	v := newMinimalValidator(t, ValidatorOptions{}, observationTestSD)
+
+	result, err := v.Validate(context.Background(), []byte(`{"resourceType": "Observation", "status": "final",
+		"valueQuantity": {"value": 5.4, "system": "http://unitsofmeasure.org", "code": "mmol/L"},
+		"referenceRange": [{"low": {"value": 70, "system": "http://unitsofmeasure.org", "code": "mg/dL"}}]}`))
+	require.NoError(t, err)
+	assert.Empty(t, referenceRangeIssues(result))
+}
@@ -0,0 +1,103 @@
+// Package validator provides FHIR resource validation based on StructureDefinitions.
+package validator
+
+import (
+	"fmt"
+	"strings"
+)
+
+// resourceTypeParameters is the FHIR resource type for Parameters.
+const resourceTypeParameters = "Parameters"
+
+// parametersPartPrefix is the path prefix of the elements of Parameters.parameter.part,
+// which is defined by a content reference to Parameters.parameter.
+const parametersPartPrefix = "Parameters.parameter.part."
+
+// parametersInv1Constraint is the Parameters invariant on the content of a parameter.
+// The snapshot constraint is only evaluated on the top-level parameters, so
+// validateParameters checks it on the nested parts.
+var parametersInv1Constraint = ElementConstraint{
+	Key:        "inv-1",
+	Severity:   "error",
+	Human:      "A parameter must have one and only one of (value, resource, part)",
+	Expression: "(part.exists() and value.empty() and resource.empty()) or (part.empty() and (value.exists() xor resource.exists()))",
+	Source:     "http://hl7.org/fhir/StructureDefinition/Parameters",
+}
+
+// parametersElementPath maps the path of an element of a (nested) part to the path
+// of the same element of Parameters.parameter, which defines it
+// (e.g., "Parameters.parameter.part.part.valueString" -> "Parameters.parameter.valueString").
+// Other paths are returned unchanged.
+func parametersElementPath(path string) string {
+	rest, ok := strings.CutPrefix(path, parametersPartPrefix)
+	if !ok {
+		return path
+	}
+	for {
+		next, ok := strings.CutPrefix(rest, "part.")
+		if !ok {
+			break
+		}
+		rest = next
+	}
+	return "Parameters.parameter." + rest
+}
+
+// validateParameters checks the nested parts of a Parameters resource, which the
+// snapshot only describes through Parameters.parameter: each part has a name,
+// and with ValidateConstraints, one and only one of value[x], resource or part (inv-1).
+func (v *Validator) validateParameters(vctx *validationContext, result *ValidationResult) {
+	parameters, _ := vctx.parsed["parameter"].([]interface{})
+	for i, item := range parameters {
+		if parameter, ok := item.(map[string]interface{}); ok {
+			v.validateParameterParts(parameter, fmt.Sprintf("Parameters.parameter[%d]", i), result)
+		}
+	}
+}
+
+// validateParameterParts checks the parts of the parameter (or part) at path, recursively.
+func (v *Validator) validateParameterParts(parameter map[string]interface{}, path string, result *ValidationResult) {
+	parts, _ := parameter["part"].([]interface{})
+	for i, item := range parts {
+		part, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		partPath := fmt.Sprintf("%s.part[%d]", path, i)
+
+		if _, ok := part["name"]; !ok {
+			result.AddIssue(ValidationIssue{
+				Severity:    SeverityError,
+				Code:        IssueCodeRequired,
+				Diagnostics: fmt.Sprintf("Missing required element: %s.name (min=1)", partPath),
+				Expression:  []string{partPath + ".name"},
+				Details:     NewIssueDetails(DetailsCardinalityMin),
+			})
+		}
+
+		if v.options.ValidateConstraints && !hasSingleParameterContent(part) {
+			constraint := parametersInv1Constraint
+			result.AddIssue(ValidationIssue{
+				Severity:    SeverityError,
+				Code:        IssueCodeInvariant,
+				Diagnostics: fmt.Sprintf("Constraint %s violated: %s", constraint.Key, constraint.Human),
+				Expression:  []string{partPath},
+				Constraint:  &constraint,
+			})
+		}
+
+		v.validateParameterParts(part, partPath, result)
+	}
+}
+
+// hasSingleParameterContent reports whether a parameter or part has one and only
+// one of value[x], resource or part.
+func hasSingleParameterContent(parameter map[string]interface{}) bool {
+	count := 0
+	for key := range parameter {
+		if key == "resource" || key == "part" || (strings.HasPrefix(key, "value") && len(key) > len("value")) {
+			count++
+		}
+	}
+	return count == 1
+}
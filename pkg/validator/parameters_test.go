@@ -0,0 +1,97 @@
+package validator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateParameters(t *testing.T) {
+	// As in the FHIR specification, Parameters.parameter.part has no definition
+	// of its own elements.
+	parameters := &StructureDef{
+		URL:  "http://hl7.org/fhir/StructureDefinition/Parameters",
+		Name: "Parameters",
+		Type: "Parameters",
+		Kind: "resource",
+		Snapshot: []ElementDef{
+			{Path: "Parameters", Min: 0, Max: "*"},
+			{Path: "Parameters.parameter", Min: 0, Max: "*", Types: []TypeRef{{Code: "BackboneElement"}}},
+			{Path: "Parameters.parameter.name", Min: 1, Max: "1", Types: []TypeRef{{Code: "string"}}},
+			{Path: "Parameters.parameter.value[x]", Min: 0, Max: "1", Types: []TypeRef{{Code: "string"}, {Code: "boolean"}, {Code: "integer"}}},
+			{Path: "Parameters.parameter.resource", Min: 0, Max: "1", Types: []TypeRef{{Code: "Resource"}}},
+			{Path: "Parameters.parameter.part", Min: 0, Max: "*"},
+		},
+	}
+	patient := &StructureDef{
+		URL:  "http://hl7.org/fhir/StructureDefinition/Patient",
+		Name: "Patient",
+		Type: "Patient",
+		Kind: "resource",
+		Snapshot: []ElementDef{
+			{Path: "Patient", Min: 0, Max: "*"},
+			{Path: "Patient.id", Min: 0, Max: "1", Types: []TypeRef{{Code: "id"}}},
+			{Path: "Patient.active", Min: 0, Max: "1", Types: []TypeRef{{Code: "boolean"}}},
+		},
+	}
+	v := newMinimalValidator(t, ValidatorOptions{ValidateConstraints: true}, parameters, patient)
+
+	tests := []struct {
+		name     string
+		resource string
+		want     []string
+	}{
+		{
+			name: "resource parameter and nested parts",
+			resource: `{"resourceType": "Parameters", "parameter": [
+				{"name": "patient", "resource": {"resourceType": "Patient", "id": "p1", "active": true}},
+				{"name": "match", "part": [
+					{"name": "score", "valueInteger": 1},
+					{"name": "detail", "part": [
+						{"name": "exact", "valueBoolean": true},
+						{"name": "candidate", "resource": {"resourceType": "Patient", "id": "p2"}}]}]}]}`,
+		},
+		{
+			name: "invalid resource in a part",
+			resource: `{"resourceType": "Parameters", "parameter": [{"name": "match", "part": [
+				{"name": "candidate", "resource": {"resourceType": "Patient", "gender": "male"}}]}]}`,
+			want: []string{"Patient.gender"},
+		},
+		{
+			name: "part without a name",
+			resource: `{"resourceType": "Parameters", "parameter": [{"name": "match", "part": [
+				{"name": "detail", "part": [{"valueString": "x"}]}]}]}`,
+			want: []string{"Parameters.parameter[0].part[0].part[0].name"},
+		},
+		{
+			name: "part with a value and parts",
+			resource: `{"resourceType": "Parameters", "parameter": [{"name": "match", "part": [
+				{"name": "detail", "valueString": "x", "part": [{"name": "exact", "valueBoolean": true}]}]}]}`,
+			want: []string{"Parameters.parameter[0].part[0]"},
+		},
+		{
+			name: "part without content",
+			resource: `{"resourceType": "Parameters", "parameter": [{"name": "match", "part": [
+				{"name": "detail", "valueString": "x"}, {"name": "empty", "extension": [{"url": "http://example.org/x", "valueString": "y"}]}]}]}`,
+			want: []string{"Parameters.parameter[0].part[1]"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := v.Validate(context.Background(), []byte(tt.resource))
+			require.NoError(t, err)
+
+			var paths []string
+			for _, issue := range result.Issues {
+				if issue.Severity == SeverityError {
+					paths = append(paths, issue.Expression...)
+				}
+			}
+			assert.ElementsMatch(t, tt.want, paths, "Issues: %v", result.Issues)
+			assert.Equal(t, len(tt.want) == 0, result.Valid)
+		})
+	}
+}
@@ -170,7 +170,8 @@ func (v *Validator) validateReferencesInNode(ctx context.Context, vctx *validati
 	case map[string]interface{}:
 		// Check if this is a Reference type (has "reference" field)
 		if refStr, ok := val["reference"].(string); ok {
-			v.validateSingleReference(ctx, vctx, refStr, path, containedIDs, result)
+			refType, _ := val["type"].(string)
+			v.validateSingleReference(ctx, vctx, refStr, refType, path, containedIDs, result)
 		}
 
 		// Recursively check children
@@ -191,8 +192,9 @@ func (v *Validator) validateReferencesInNode(ctx context.Context, vctx *validati
 	}
 }
 
-// validateSingleReference validates a single reference string.
-func (v *Validator) validateSingleReference(ctx context.Context, vctx *validationContext, refStr, path string, containedIDs map[string]string, result *ValidationResult) {
+// validateSingleReference validates a single reference string and its type hint
+// (Reference.type, empty if absent).
+func (v *Validator) validateSingleReference(ctx context.Context, vctx *validationContext, refStr, refType, path string, containedIDs map[string]string, result *ValidationResult) {
 	parsed := ParseReference(refStr)
 
 	// 1. Validate format
@@ -206,22 +208,34 @@ func (v *Validator) validateSingleReference(ctx context.Context, vctx *validatio
 		return
 	}
 
-	// 2. Validate contained references
+	// Reference.type must agree with the type of the referenced resource
+	if refType != "" {
+		targetType := parsed.ResourceType
+		if parsed.Type == RefTypeContained {
+			targetType = containedIDs[parsed.ID]
+		}
+		v.validateReferenceTypeHint(refStr, refType, targetType, path, result)
+	}
+
+	// 2. Validate contained references, whose type is that of the contained resource
 	if parsed.Type == RefTypeContained {
-		if _, exists := containedIDs[parsed.ID]; !exists {
+		containedType, exists := containedIDs[parsed.ID]
+		if !exists {
 			result.AddIssue(ValidationIssue{
 				Severity:    SeverityError,
 				Code:        IssueCodeNotFound,
 				Diagnostics: fmt.Sprintf("Contained resource not found: '%s'", refStr),
 				Expression:  []string{path + ".reference"},
 			})
+			return
 		}
+		v.validateReferenceTargetType(ctx, vctx, containedType, path, result)
 		return
 	}
 
 	// 3. Validate target type against allowed types (if we have type info in the path)
 	if parsed.ResourceType != "" {
-		v.validateReferenceTargetType(vctx, parsed, path, result)
+		v.validateReferenceTargetType(ctx, vctx, parsed.ResourceType, path, result)
 	}
 
 	// 4. Optional: resolve reference if resolver is configured
@@ -239,8 +253,28 @@ func (v *Validator) validateSingleReference(ctx context.Context, vctx *validatio
 	}
 }
 
-// validateReferenceTargetType validates that the referenced resource type is allowed.
-func (v *Validator) validateReferenceTargetType(vctx *validationContext, parsed *ParsedReference, path string, result *ValidationResult) {
+// validateReferenceTypeHint validates that Reference.type names the type of the
+// referenced resource (e.g., "Patient" for "Patient/1"). Reference.type is a URI,
+// relative to http://hl7.org/fhir/StructureDefinition/ for FHIR resource types;
+// other absolute URIs (logical models) and references without a known target type
+// (e.g., urn:uuid) are not checked.
+func (v *Validator) validateReferenceTypeHint(refStr, refType, targetType, path string, result *ValidationResult) {
+	typeName := strings.TrimPrefix(refType, "http://hl7.org/fhir/StructureDefinition/")
+	if targetType == "" || strings.Contains(typeName, "/") || typeName == targetType {
+		return
+	}
+	result.AddIssue(ValidationIssue{
+		Severity:    SeverityError,
+		Code:        IssueCodeValue,
+		Diagnostics: fmt.Sprintf("Reference type '%s' does not match the type '%s' of reference '%s'", refType, targetType, refStr),
+		Expression:  []string{path + ".type"},
+	})
+}
+
+// validateReferenceTargetType validates that the referenced resource type is allowed
+// by the targetProfile of the Reference element (e.g., Patient.link.other only
+// allows Patient and RelatedPerson).
+func (v *Validator) validateReferenceTargetType(ctx context.Context, vctx *validationContext, resourceType, path string, result *ValidationResult) {
 	// Find the element definition for this reference
 	elemPath := pathWithoutArrayIndices(path)
 	elemDef := v.findElementDef(vctx.index, elemPath, vctx.resourceType)
@@ -259,10 +293,8 @@ func (v *Validator) validateReferenceTargetType(vctx *validationContext, parsed
 
 			// Check if the referenced type matches any allowed target
 			for _, profile := range typeRef.TargetProfile {
-				// Extract resource type from profile URL
-				// e.g., "http://hl7.org/fhir/StructureDefinition/Patient" -> "Patient"
-				allowedType := extractResourceTypeFromProfile(profile)
-				if allowedType == parsed.ResourceType || allowedType == "Resource" {
+				allowedType := v.targetProfileType(ctx, profile)
+				if allowedType == resourceType || allowedType == "Resource" {
 					return // Match found
 				}
 			}
@@ -271,7 +303,7 @@ func (v *Validator) validateReferenceTargetType(vctx *validationContext, parsed
 			result.AddIssue(ValidationIssue{
 				Severity:    SeverityError,
 				Code:        IssueCodeValue,
-				Diagnostics: fmt.Sprintf("Reference to '%s' not allowed; expected one of: %s", parsed.ResourceType, formatAllowedTypes(typeRef.TargetProfile)),
+				Diagnostics: fmt.Sprintf("Reference to '%s' not allowed; expected one of: %s", resourceType, formatAllowedTypes(typeRef.TargetProfile)),
 				Expression:  []string{path + ".reference"},
 			})
 			return
@@ -279,6 +311,17 @@ func (v *Validator) validateReferenceTargetType(vctx *validationContext, parsed
 	}
 }
 
+// targetProfileType returns the resource type constrained by a target profile.
+// Profiles known to the registry (e.g., US Core Patient) resolve to their base type;
+// other URLs fall back to the last segment of the canonical URL.
+func (v *Validator) targetProfileType(ctx context.Context, profile string) string {
+	if sd, err := v.registry.Get(ctx, profile); err == nil && sd != nil && sd.Type != "" {
+		return sd.Type
+	}
+	// e.g., "http://hl7.org/fhir/StructureDefinition/Patient" -> "Patient"
+	return extractResourceTypeFromProfile(profile)
+}
+
 // pathWithoutArrayIndices removes array indices from a path.
 // e.g., "Patient.contact[0].reference" -> "Patient.contact.reference"
 func pathWithoutArrayIndices(path string) string {
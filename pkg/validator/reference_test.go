@@ -318,6 +318,159 @@ func TestValidateReferences_RelativeReferences(t *testing.T) {
 	}
 }
 
+func TestValidateReferences_TargetType(t *testing.T) {
+	registry := NewRegistry(FHIRVersionR4)
+	require.NoError(t, registry.Register(&StructureDef{
+		URL:  "http://hl7.org/fhir/StructureDefinition/Patient",
+		Name: "Patient",
+		Type: "Patient",
+		Kind: "resource",
+		Snapshot: []ElementDef{
+			{Path: "Patient", Min: 0, Max: "*"},
+			{Path: "Patient.id", Min: 0, Max: "1", Types: []TypeRef{{Code: "id"}}},
+			{Path: "Patient.contained", Min: 0, Max: "*", Types: []TypeRef{{Code: "Resource"}}},
+			{Path: "Patient.link", Min: 0, Max: "*", Types: []TypeRef{{Code: "BackboneElement"}}},
+			{Path: "Patient.link.other", Min: 1, Max: "1", Types: []TypeRef{{
+				Code: "Reference",
+				TargetProfile: []string{
+					"http://hl7.org/fhir/StructureDefinition/Patient",
+					"http://hl7.org/fhir/StructureDefinition/RelatedPerson",
+				},
+			}}},
+			{Path: "Patient.link.type", Min: 1, Max: "1", Types: []TypeRef{{Code: "code"}}},
+			{Path: "Patient.generalPractitioner", Min: 0, Max: "*", Types: []TypeRef{{
+				Code:          "Reference",
+				TargetProfile: []string{"http://example.org/StructureDefinition/my-practitioner"},
+			}}},
+		},
+	}))
+	require.NoError(t, registry.Register(&StructureDef{
+		URL:  "http://example.org/StructureDefinition/my-practitioner",
+		Name: "MyPractitioner",
+		Type: "Practitioner",
+		Kind: "resource",
+	}))
+
+	v := NewValidator(registry, ValidatorOptions{ValidateReferences: true})
+
+	tests := []struct {
+		name     string
+		resource string
+		wantPath string
+	}{
+		{
+			name:     "link.other referencing Patient",
+			resource: `{"resourceType": "Patient", "link": [{"other": {"reference": "Patient/2"}, "type": "seealso"}]}`,
+		},
+		{
+			name:     "link.other referencing RelatedPerson",
+			resource: `{"resourceType": "Patient", "link": [{"other": {"reference": "RelatedPerson/2"}, "type": "seealso"}]}`,
+		},
+		{
+			name:     "link.other referencing Observation",
+			resource: `{"resourceType": "Patient", "link": [{"other": {"reference": "Observation/2"}, "type": "seealso"}]}`,
+			wantPath: "Patient.link[0].other.reference",
+		},
+		{
+			name: "link.other referencing contained Observation",
+			resource: `{"resourceType": "Patient", "contained": [{"resourceType": "Observation", "id": "o1"}],
+				"link": [{"other": {"reference": "#o1"}, "type": "seealso"}]}`,
+			wantPath: "Patient.link[0].other.reference",
+		},
+		{
+			name:     "target profile resolved to its base type",
+			resource: `{"resourceType": "Patient", "generalPractitioner": [{"reference": "Practitioner/1"}]}`,
+		},
+		{
+			name:     "target profile rejecting other types",
+			resource: `{"resourceType": "Patient", "generalPractitioner": [{"reference": "Organization/1"}]}`,
+			wantPath: "Patient.generalPractitioner[0].reference",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := v.Validate(context.Background(), []byte(tt.resource))
+			require.NoError(t, err)
+
+			var paths []string
+			for _, issue := range result.Issues {
+				if issue.Severity == SeverityError && containsString(issue.Diagnostics, "not allowed") {
+					paths = append(paths, issue.Expression[0])
+				}
+			}
+			if tt.wantPath == "" {
+				assert.Empty(t, paths, "Issues: %v", result.Issues)
+			} else {
+				assert.Equal(t, []string{tt.wantPath}, paths, "Issues: %v", result.Issues)
+			}
+		})
+	}
+}
+
+func TestValidateReferences_TypeHint(t *testing.T) {
+	registry := NewRegistry(FHIRVersionR4)
+	require.NoError(t, registry.Register(&StructureDef{
+		URL:  "http://hl7.org/fhir/StructureDefinition/Observation",
+		Name: "Observation",
+		Type: "Observation",
+		Kind: "resource",
+		Snapshot: []ElementDef{
+			{Path: "Observation", Min: 0, Max: "*"},
+			{Path: "Observation.contained", Min: 0, Max: "*", Types: []TypeRef{{Code: "Resource"}}},
+			{Path: "Observation.subject", Min: 0, Max: "1", Types: []TypeRef{{Code: "Reference"}}},
+		},
+	}))
+
+	v := NewValidator(registry, ValidatorOptions{ValidateReferences: true})
+
+	tests := []struct {
+		name      string
+		reference string
+		contained string
+		wantError bool
+	}{
+		{name: "matching type", reference: `{"reference": "Patient/1", "type": "Patient"}`},
+		{name: "matching absolute type", reference: `{"reference": "Patient/1", "type": "http://hl7.org/fhir/StructureDefinition/Patient"}`},
+		{name: "mismatched type", reference: `{"reference": "Patient/1", "type": "Observation"}`, wantError: true},
+		{name: "mismatched absolute reference", reference: `{"reference": "http://example.org/fhir/Group/1", "type": "Patient"}`, wantError: true},
+		{
+			name:      "contained reference",
+			reference: `{"reference": "#p1", "type": "Patient"}`,
+			contained: `{"resourceType": "Patient", "id": "p1"}`,
+		},
+		{
+			name:      "mismatched contained reference",
+			reference: `{"reference": "#p1", "type": "Group"}`,
+			contained: `{"resourceType": "Patient", "id": "p1"}`,
+			wantError: true,
+		},
+		{name: "type without target type", reference: `{"reference": "urn:uuid:9d4b5e2a-3c1f-4b8e-9a6d-2f7c8e1b0a34", "type": "Patient"}`},
+		{name: "logical model type", reference: `{"reference": "Patient/1", "type": "http://example.org/StructureDefinition/Person"}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resource := `{"resourceType": "Observation", "subject": ` + tt.reference
+			if tt.contained != "" {
+				resource += `, "contained": [` + tt.contained + `]`
+			}
+			resource += `}`
+			result, err := v.Validate(context.Background(), []byte(resource))
+			require.NoError(t, err)
+
+			var found bool
+			for _, issue := range result.Issues {
+				if issue.Code == IssueCodeValue && len(issue.Expression) > 0 && issue.Expression[0] == "Observation.subject.type" {
+					assert.Equal(t, SeverityError, issue.Severity)
+					found = true
+				}
+			}
+			assert.Equal(t, tt.wantError, found, "Issues: %v", result.Issues)
+		})
+	}
+}
+
 func TestExtractResourceTypeFromProfile(t *testing.T) {
 	tests := []struct {
 		profile  string
@@ -2,15 +2,20 @@
 package validator
 
 import (
+	"archive/tar"
+	"compress/gzip"
 	"context"
 	"embed"
 	"encoding/json"
 	"fmt"
+	"io"
 	"io/fs"
 	"os"
+	"path"
 	"path/filepath"
 	"strings"
 	"sync"
+	"sync/atomic"
 )
 
 // FHIRVersion represents a FHIR specification version.
@@ -35,6 +40,17 @@ type Registry struct {
 	byType map[string]*StructureDef
 	// version is the FHIR version for this registry
 	version FHIRVersion
+	// base is the registry this one was cloned from; lookups that miss
+	// the local maps fall back to it
+	base *Registry
+	// generation is incremented whenever definitions change
+	generation atomic.Uint64
+	// terminology receives the ValueSets and CodeSystems of loaded packages
+	terminology *LocalTerminologyService
+	// searchParams maps canonical URL to SearchParameter
+	searchParams map[string]*SearchParam
+	// resolved caches definitions with snapshots generated from their differential
+	resolved map[string]resolvedDef
 }
 
 // NewRegistry creates a new empty registry.
@@ -46,40 +62,87 @@ func NewRegistry(version FHIRVersion) *Registry {
 	}
 }
 
-// Get returns a StructureDefinition by canonical URL.
+// Clone returns a registry that shares this registry's definitions but keeps
+// its own overlay for anything registered afterwards. Definitions registered on
+// the clone are not visible to the parent, which makes it cheap to create an
+// isolated registry per request without re-loading the base definitions.
+func (r *Registry) Clone() *Registry {
+	return &Registry{
+		byURL:   make(map[string]*StructureDef),
+		byType:  make(map[string]*StructureDef),
+		version: r.version,
+		base:    r,
+	}
+}
+
+// Get returns a StructureDefinition by canonical URL. For a profile published
+// without a snapshot, the returned definition has the effective snapshot
+// resolved through its baseDefinition chain.
 func (r *Registry) Get(ctx context.Context, url string) (*StructureDef, error) {
+	sd, ok := r.lookupURL(url)
+	if !ok {
+		return nil, fmt.Errorf("StructureDefinition not found: %s", url)
+	}
+	return r.resolveSnapshot(sd, nil)
+}
+
+// lookupURL finds a StructureDef by URL in this registry or its base chain.
+func (r *Registry) lookupURL(url string) (*StructureDef, bool) {
 	r.mu.RLock()
-	defer r.mu.RUnlock()
+	sd, ok := r.byURL[url]
+	r.mu.RUnlock()
 
-	if sd, ok := r.byURL[url]; ok {
-		return sd, nil
+	if !ok && r.base != nil {
+		return r.base.lookupURL(url)
 	}
-	return nil, fmt.Errorf("StructureDefinition not found: %s", url)
+	return sd, ok
 }
 
 // GetByType returns the base StructureDefinition for a resource type.
 func (r *Registry) GetByType(ctx context.Context, resourceType string) (*StructureDef, error) {
-	r.mu.RLock()
-	defer r.mu.RUnlock()
-
-	if sd, ok := r.byType[resourceType]; ok {
+	if sd, ok := r.lookupType(resourceType); ok {
 		return sd, nil
 	}
 	return nil, fmt.Errorf("StructureDefinition not found for type: %s", resourceType)
 }
 
+// lookupType finds a base StructureDef by type in this registry or its base chain.
+func (r *Registry) lookupType(resourceType string) (*StructureDef, bool) {
+	r.mu.RLock()
+	sd, ok := r.byType[resourceType]
+	r.mu.RUnlock()
+
+	if !ok && r.base != nil {
+		return r.base.lookupType(resourceType)
+	}
+	return sd, ok
+}
+
 // List returns all available StructureDefinition URLs.
 func (r *Registry) List(ctx context.Context) ([]string, error) {
-	r.mu.RLock()
-	defer r.mu.RUnlock()
+	seen := r.collectURLs(make(map[string]bool))
 
-	urls := make([]string, 0, len(r.byURL))
-	for url := range r.byURL {
+	urls := make([]string, 0, len(seen))
+	for url := range seen {
 		urls = append(urls, url)
 	}
 	return urls, nil
 }
 
+// collectURLs adds the URLs of this registry and its base chain to seen.
+func (r *Registry) collectURLs(seen map[string]bool) map[string]bool {
+	r.mu.RLock()
+	for url := range r.byURL {
+		seen[url] = true
+	}
+	r.mu.RUnlock()
+
+	if r.base != nil {
+		r.base.collectURLs(seen)
+	}
+	return seen
+}
+
 // Register adds a StructureDefinition to the registry.
 func (r *Registry) Register(sd *StructureDef) error {
 	if sd == nil {
@@ -93,6 +156,7 @@ func (r *Registry) Register(sd *StructureDef) error {
 	defer r.mu.Unlock()
 
 	r.byURL[sd.URL] = sd
+	r.generation.Add(1)
 
 	// Also index by type for base definitions (non-profiles)
 	if sd.Type != "" && sd.Kind == "resource" && !strings.Contains(sd.URL, "/profile/") {
@@ -107,6 +171,62 @@ func (r *Registry) Register(sd *StructureDef) error {
 	return nil
 }
 
+// Generation returns a number that changes whenever definitions are added to or
+// reloaded in this registry or its base chain. Validators use it to invalidate cached profiles.
+func (r *Registry) Generation() uint64 {
+	generation := r.generation.Load()
+	if r.base != nil {
+		generation += r.base.Generation()
+	}
+	return generation
+}
+
+// Reload replaces the definitions of the registry with the ones loader registers
+// on a fresh, empty registry of the same FHIR version. The new definitions are
+// swapped in atomically once loader returns, and the generation changes so that
+// validators drop their cached profiles and element indexes. If loader fails,
+// the registry is left unchanged.
+//
+// Reload never mutates the current definitions: validations in flight keep
+// using the definitions they already looked up, while lookups made after the
+// swap see the new ones. The ValueSets and CodeSystems loaded by loader replace
+// the registry's terminology service only if loader uses it; pass the new
+// Terminology() to validators that should see them.
+//
+// Usage:
+//
+//	err := registry.Reload(func(fresh *validator.Registry) error {
+//	    _, err := fresh.LoadPackage(ctx, "hl7.fhir.us.core-6.1.0.tgz")
+//	    return err
+//	})
+func (r *Registry) Reload(loader func(*Registry) error) error {
+	if loader == nil {
+		return fmt.Errorf("cannot reload registry without a loader")
+	}
+
+	fresh := NewRegistry(r.version)
+	if err := loader(fresh); err != nil {
+		return fmt.Errorf("failed to reload registry: %w", err)
+	}
+
+	fresh.mu.RLock()
+	defer fresh.mu.RUnlock()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.byURL = fresh.byURL
+	r.byType = fresh.byType
+	r.searchParams = fresh.searchParams
+	r.resolved = nil
+	if fresh.terminology != nil {
+		r.terminology = fresh.terminology
+	}
+	r.generation.Add(1)
+
+	return nil
+}
+
 // isCanonicalURL checks if URL is the canonical HL7 FHIR URL for a type
 func isCanonicalURL(url, resourceType string) bool {
 	canonical := "http://hl7.org/fhir/StructureDefinition/" + resourceType
@@ -115,13 +235,16 @@ func isCanonicalURL(url, resourceType string) bool {
 
 // Size returns the number of registered StructureDefinitions.
 func (r *Registry) Size() int {
-	r.mu.RLock()
-	defer r.mu.RUnlock()
-	return len(r.byURL)
+	if r.base == nil {
+		r.mu.RLock()
+		defer r.mu.RUnlock()
+		return len(r.byURL)
+	}
+	return len(r.collectURLs(make(map[string]bool)))
 }
 
-// LoadFromBundle loads StructureDefinitions from a FHIR Bundle JSON.
-// This is the format used in profiles-resources.json, profiles-types.json, etc.
+// LoadFromBundle loads StructureDefinitions and SearchParameters from a FHIR Bundle JSON.
+// This is the format used in profiles-resources.json, search-parameters.json, etc.
 func (r *Registry) LoadFromBundle(data []byte) (int, error) {
 	var bundle struct {
 		Entry []struct {
@@ -142,19 +265,22 @@ func (r *Registry) LoadFromBundle(data []byte) (int, error) {
 		if err := json.Unmarshal(entry.Resource, &resourceType); err != nil {
 			continue
 		}
-		if resourceType.ResourceType != resourceTypeStructureDefinition {
-			continue
-		}
-
-		sd, err := ParseStructureDefinition(entry.Resource)
-		if err != nil {
-			continue // Skip invalid entries
-		}
-
-		if err := r.Register(sd); err != nil {
-			continue
+		switch resourceType.ResourceType {
+		case resourceTypeStructureDefinition:
+			sd, err := ParseStructureDefinition(entry.Resource)
+			if err != nil {
+				continue // Skip invalid entries
+			}
+			if err := r.Register(sd); err != nil {
+				continue
+			}
+			count++
+		case resourceTypeSearchParameter:
+			if err := r.loadSearchParam(entry.Resource); err != nil {
+				continue
+			}
+			count++
 		}
-		count++
 	}
 
 	return count, nil
@@ -194,11 +320,25 @@ func (r *Registry) LoadFromJSON(data []byte) (int, error) {
 			return 0, err
 		}
 		return 1, nil
+	case resourceTypeSearchParameter:
+		if err := r.loadSearchParam(data); err != nil {
+			return 0, err
+		}
+		return 1, nil
 	default:
 		return 0, fmt.Errorf("unsupported resourceType: %s", probe.ResourceType)
 	}
 }
 
+// loadSearchParam parses and registers a SearchParameter.
+func (r *Registry) loadSearchParam(data []byte) error {
+	sp, err := ParseSearchParameter(data)
+	if err != nil {
+		return err
+	}
+	return r.RegisterSearchParam(sp)
+}
+
 // LoadFromDirectory loads all JSON files from a directory.
 func (r *Registry) LoadFromDirectory(dirPath string) (int, error) {
 	total := 0
@@ -249,6 +389,134 @@ func (r *Registry) LoadFromFS(fsys embed.FS, root string) (int, error) {
 	return total, err
 }
 
+// WithTerminology sets the terminology service that receives the ValueSets and
+// CodeSystems of packages loaded with LoadPackage, so that they can be loaded
+// alongside other terminology (e.g., the specification valuesets.json).
+func (r *Registry) WithTerminology(ts *LocalTerminologyService) *Registry {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.terminology = ts
+	return r
+}
+
+// Terminology returns the terminology service holding the ValueSets and
+// CodeSystems of loaded packages. Pass it to Validator.WithTerminologyService to
+// validate bindings to them.
+func (r *Registry) Terminology() *LocalTerminologyService {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.terminology == nil {
+		r.terminology = NewLocalTerminologyService()
+	}
+	return r.terminology
+}
+
+// LoadPackage loads the conformance resources of a FHIR NPM package tarball
+// (.tgz), the format Implementation Guides are distributed in. StructureDefinitions
+// and SearchParameters in the package/ folder are registered, and ValueSets and
+// CodeSystems are loaded into the registry's terminology service (see
+// Terminology). Other files, such as package.json and the examples subfolder,
+// are ignored.
+// Returns the number of resources loaded.
+func (r *Registry) LoadPackage(ctx context.Context, path string) (int, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, fmt.Errorf("failed to open package %s: %w", path, err)
+	}
+	defer f.Close()
+
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		return 0, fmt.Errorf("failed to read package %s: %w", path, err)
+	}
+	defer gz.Close()
+
+	count := 0
+	var terminology []json.RawMessage
+	tr := tar.NewReader(gz)
+	for {
+		if err := ctx.Err(); err != nil {
+			return count, err
+		}
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return count, fmt.Errorf("failed to read package %s: %w", path, err)
+		}
+		if !isPackageResourceFile(hdr) {
+			continue
+		}
+
+		data, err := io.ReadAll(tr)
+		if err != nil {
+			return count, fmt.Errorf("failed to read %s from package %s: %w", hdr.Name, path, err)
+		}
+		var probe struct {
+			ResourceType string `json:"resourceType"`
+		}
+		if err := json.Unmarshal(data, &probe); err != nil {
+			continue // Skip invalid files
+		}
+
+		switch probe.ResourceType {
+		case resourceTypeStructureDefinition:
+			sd, err := ParseStructureDefinition(data)
+			if err != nil {
+				continue
+			}
+			if err := r.Register(sd); err != nil {
+				continue
+			}
+			count++
+		case resourceTypeSearchParameter:
+			if err := r.loadSearchParam(data); err != nil {
+				continue
+			}
+			count++
+		case "ValueSet", "CodeSystem":
+			terminology = append(terminology, data)
+		}
+	}
+
+	if len(terminology) > 0 {
+		if err := r.Terminology().LoadFromBundle(terminologyBundle(terminology)); err != nil {
+			return count, fmt.Errorf("failed to load terminology from package %s: %w", path, err)
+		}
+		count += len(terminology)
+	}
+	return count, nil
+}
+
+// isPackageResourceFile reports whether a tarball entry is a JSON file directly
+// in the package/ folder, where NPM packages keep their conformance resources.
+func isPackageResourceFile(hdr *tar.Header) bool {
+	if hdr.Typeflag != tar.TypeReg {
+		return false
+	}
+	name := strings.TrimPrefix(hdr.Name, "./")
+	return path.Dir(name) == "package" && strings.HasSuffix(name, ".json") && path.Base(name) != "package.json"
+}
+
+// terminologyBundle wraps resources in a collection Bundle, so that CodeSystems
+// are loaded before the ValueSets that include them.
+func terminologyBundle(resources []json.RawMessage) []byte {
+	type entry struct {
+		Resource json.RawMessage `json:"resource"`
+	}
+	bundle := struct {
+		ResourceType string  `json:"resourceType"`
+		Type         string  `json:"type"`
+		Entry        []entry `json:"entry"`
+	}{ResourceType: "Bundle", Type: "collection"}
+	for _, resource := range resources {
+		bundle.Entry = append(bundle.Entry, entry{Resource: resource})
+	}
+	data, _ := json.Marshal(bundle)
+	return data
+}
+
 // ParseStructureDefinition parses a single StructureDefinition from JSON.
 // Works with any FHIR version (R4, R4B, R5) by extracting common fields.
 func ParseStructureDefinition(data []byte) (*StructureDef, error) {
@@ -310,6 +578,9 @@ func parseElements(elements []interface{}) []ElementDef {
 			ed.Min = int(minVal)
 		}
 		ed.Max, _ = elemMap["max"].(string)
+		if maxLength, ok := elemMap["maxLength"].(float64); ok {
+			ed.MaxLength = int(maxLength)
+		}
 
 		ed.Short, _ = elemMap["short"].(string)
 		ed.Definition, _ = elemMap["definition"].(string)
@@ -332,7 +603,7 @@ func parseElements(elements []interface{}) []ElementDef {
 			ed.Constraints = parseConstraints(constraints)
 		}
 
-		// Handle fixed[x] and pattern[x] values
+		// Handle fixed[x], pattern[x], minValue[x] and maxValue[x] values
 		for key, val := range elemMap {
 			if strings.HasPrefix(key, "fixed") {
 				ed.Fixed = val
@@ -340,6 +611,12 @@ func parseElements(elements []interface{}) []ElementDef {
 			if strings.HasPrefix(key, "pattern") {
 				ed.Pattern = val
 			}
+			if strings.HasPrefix(key, "minValue") {
+				ed.MinValue = val
+			}
+			if strings.HasPrefix(key, "maxValue") {
+				ed.MaxValue = val
+			}
 		}
 
 		result = append(result, ed)
@@ -1,9 +1,14 @@
 package validator
 
 import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
 	"context"
 	"os"
 	"path/filepath"
+	"slices"
+	"sync"
 	"testing"
 )
 
@@ -72,6 +77,353 @@ func TestRegistryNotFound(t *testing.T) {
 	}
 }
 
+func TestRegistryClone(t *testing.T) {
+	parent := NewRegistry(FHIRVersionR4)
+	ctx := context.Background()
+
+	base := &StructureDef{
+		URL:  "http://hl7.org/fhir/StructureDefinition/Patient",
+		Name: "Patient",
+		Type: "Patient",
+		Kind: "resource",
+	}
+	if err := parent.Register(base); err != nil {
+		t.Fatalf("Register failed: %v", err)
+	}
+
+	clone := parent.Clone()
+
+	// Base definitions are shared with the clone
+	retrieved, err := clone.GetByType(ctx, "Patient")
+	if err != nil {
+		t.Fatalf("GetByType on clone failed: %v", err)
+	}
+	if retrieved != base {
+		t.Error("Expected clone to share the parent's StructureDef")
+	}
+
+	profile := &StructureDef{
+		URL:            "http://example.org/fhir/StructureDefinition/my-patient",
+		Name:           "MyPatient",
+		Type:           "Patient",
+		Kind:           "resource",
+		BaseDefinition: base.URL,
+	}
+	if err := clone.Register(profile); err != nil {
+		t.Fatalf("Register on clone failed: %v", err)
+	}
+
+	// Profile is visible in the clone
+	if _, err := clone.Get(ctx, profile.URL); err != nil {
+		t.Errorf("Expected profile in clone: %v", err)
+	}
+	if clone.Size() != 2 {
+		t.Errorf("Expected clone size 2, got %d", clone.Size())
+	}
+	urls, _ := clone.List(ctx)
+	if len(urls) != 2 {
+		t.Errorf("Expected 2 URLs in clone, got %d", len(urls))
+	}
+
+	// Profile does not leak into the parent
+	if _, err := parent.Get(ctx, profile.URL); err == nil {
+		t.Error("Expected profile registered on clone to be absent from parent")
+	}
+	if parent.Size() != 1 {
+		t.Errorf("Expected parent size 1, got %d", parent.Size())
+	}
+}
+
+func TestRegistryReload(t *testing.T) {
+	ctx := context.Background()
+	patientDef := func(genderMin int) *StructureDef {
+		return &StructureDef{
+			URL:  "http://hl7.org/fhir/StructureDefinition/Patient",
+			Name: "Patient",
+			Type: "Patient",
+			Kind: "resource",
+			Snapshot: []ElementDef{
+				{Path: "Patient", Min: 0, Max: "*"},
+				{Path: "Patient.id", Min: 0, Max: "1", Types: []TypeRef{{Code: "id"}}},
+				{Path: "Patient.gender", Min: genderMin, Max: "1", Types: []TypeRef{{Code: "code"}}},
+			},
+		}
+	}
+
+	registry := NewRegistry(FHIRVersionR4)
+	if err := registry.Register(patientDef(1)); err != nil {
+		t.Fatalf("Register failed: %v", err)
+	}
+	v := NewValidator(registry, ValidatorOptions{})
+	resource := []byte(`{"resourceType": "Patient", "id": "1"}`)
+
+	result, err := v.Validate(ctx, resource)
+	if err != nil {
+		t.Fatalf("Validate failed: %v", err)
+	}
+	if result.Valid {
+		t.Fatal("Expected Patient without gender to be invalid before reload")
+	}
+
+	// A failing loader leaves the registry unchanged
+	generation := registry.Generation()
+	err = registry.Reload(func(fresh *Registry) error {
+		if err := fresh.Register(patientDef(0)); err != nil {
+			return err
+		}
+		return os.ErrNotExist
+	})
+	if err == nil {
+		t.Fatal("Expected error from failing loader")
+	}
+	if registry.Generation() != generation {
+		t.Error("Expected generation to be unchanged after a failed reload")
+	}
+	if result, _ := v.Validate(ctx, resource); result.Valid {
+		t.Error("Expected the old definitions to be kept after a failed reload")
+	}
+
+	// A successful reload replaces the definitions and invalidates the validator caches
+	old, _ := registry.GetByType(ctx, "Patient")
+	err = registry.Reload(func(fresh *Registry) error {
+		return fresh.Register(patientDef(0))
+	})
+	if err != nil {
+		t.Fatalf("Reload failed: %v", err)
+	}
+	if registry.Generation() == generation {
+		t.Error("Expected generation to change after reload")
+	}
+	if registry.Size() != 1 {
+		t.Errorf("Expected 1 definition after reload, got %d", registry.Size())
+	}
+	result, err = v.Validate(ctx, resource)
+	if err != nil {
+		t.Fatalf("Validate failed: %v", err)
+	}
+	if !result.Valid {
+		t.Errorf("Expected Patient without gender to be valid after reload, got %v", result.Issues)
+	}
+
+	// Definitions looked up before the reload are not modified
+	if old.Snapshot[2].Min != 1 {
+		t.Error("Expected the previous definition to be left untouched")
+	}
+
+	if err := registry.Reload(nil); err == nil {
+		t.Error("Expected error for nil loader")
+	}
+}
+
+// mutableProvider is a StructureDefinitionProvider whose definitions can be
+// replaced, and which does not report a generation.
+type mutableProvider struct {
+	mu     sync.Mutex
+	byType map[string]*StructureDef
+}
+
+func (p *mutableProvider) Get(_ context.Context, url string) (*StructureDef, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, sd := range p.byType {
+		if sd.URL == url {
+			return sd, nil
+		}
+	}
+	return nil, os.ErrNotExist
+}
+
+func (p *mutableProvider) GetByType(_ context.Context, resourceType string) (*StructureDef, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if sd, ok := p.byType[resourceType]; ok {
+		return sd, nil
+	}
+	return nil, os.ErrNotExist
+}
+
+func (p *mutableProvider) List(_ context.Context) ([]string, error) {
+	return nil, nil
+}
+
+func (p *mutableProvider) set(sd *StructureDef) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.byType[sd.Type] = sd
+}
+
+func TestValidatorProviderWithoutGeneration(t *testing.T) {
+	ctx := context.Background()
+	patientDef := func(genderMin int) *StructureDef {
+		return &StructureDef{
+			URL:  "http://hl7.org/fhir/StructureDefinition/Patient",
+			Name: "Patient",
+			Type: "Patient",
+			Kind: "resource",
+			Snapshot: []ElementDef{
+				{Path: "Patient", Min: 0, Max: "*"},
+				{Path: "Patient.id", Min: 0, Max: "1", Types: []TypeRef{{Code: "id"}}},
+				{Path: "Patient.gender", Min: genderMin, Max: "1", Types: []TypeRef{{Code: "code"}}},
+			},
+		}
+	}
+
+	provider := &mutableProvider{byType: make(map[string]*StructureDef)}
+	provider.set(patientDef(1))
+	v := NewValidator(provider, ValidatorOptions{})
+	resource := []byte(`{"resourceType": "Patient", "id": "1"}`)
+
+	result, err := v.Validate(ctx, resource)
+	if err != nil {
+		t.Fatalf("Validate failed: %v", err)
+	}
+	if result.Valid {
+		t.Fatal("Expected Patient without gender to be invalid")
+	}
+
+	// Without a generation to invalidate the caches, the validator must see the change
+	provider.set(patientDef(0))
+	result, err = v.Validate(ctx, resource)
+	if err != nil {
+		t.Fatalf("Validate failed: %v", err)
+	}
+	if !result.Valid {
+		t.Errorf("Expected the replaced definition to be used, got issues: %v", result.Issues)
+	}
+
+	// Definitions changed in place are seen as well
+	sd, _ := provider.GetByType(ctx, "Patient")
+	sd.Snapshot[2].Min = 1
+	if result, _ := v.Validate(ctx, resource); result.Valid {
+		t.Error("Expected the definition changed in place to be used")
+	}
+}
+
+func TestRegistryReload_Concurrent(t *testing.T) {
+	ctx := context.Background()
+	registry := NewRegistry(FHIRVersionR4)
+	patient := &StructureDef{
+		URL:      "http://hl7.org/fhir/StructureDefinition/Patient",
+		Name:     "Patient",
+		Type:     "Patient",
+		Kind:     "resource",
+		Snapshot: []ElementDef{{Path: "Patient", Min: 0, Max: "*"}},
+	}
+	if err := registry.Register(patient); err != nil {
+		t.Fatalf("Register failed: %v", err)
+	}
+	v := NewValidator(registry, ValidatorOptions{})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 50; j++ {
+				if _, err := v.Validate(ctx, []byte(`{"resourceType": "Patient"}`)); err != nil {
+					t.Errorf("Validate failed during reload: %v", err)
+					return
+				}
+			}
+		}()
+	}
+	for i := 0; i < 10; i++ {
+		if err := registry.Reload(func(fresh *Registry) error { return fresh.Register(patient) }); err != nil {
+			t.Fatalf("Reload failed: %v", err)
+		}
+	}
+	wg.Wait()
+}
+
+func TestStructureDefAccessors(t *testing.T) {
+	ele1 := ElementConstraint{Key: "ele-1", Severity: "error", Expression: "hasValue() or (children().count() > id.count())"}
+	pat1 := ElementConstraint{Key: "pat-1", Severity: "error", Expression: "name.exists() or telecom.exists()"}
+	sd := &StructureDef{
+		URL:  "http://example.org/fhir/StructureDefinition/my-patient",
+		Type: "Patient",
+		Kind: "resource",
+		Snapshot: []ElementDef{
+			{ID: "Patient", Path: "Patient", Min: 0, Max: "*", Constraints: []ElementConstraint{pat1}},
+			{ID: "Patient.identifier", Path: "Patient.identifier", Min: 1, Max: "*", Constraints: []ElementConstraint{ele1}},
+			{ID: "Patient.identifier:mrn", Path: "Patient.identifier", SliceName: "mrn", Min: 1, Max: "1", Constraints: []ElementConstraint{ele1}},
+			{ID: "Patient.identifier:mrn.system", Path: "Patient.identifier.system", Min: 1, Max: "1"},
+			{ID: "Patient.identifier.system", Path: "Patient.identifier.system", Min: 0, Max: "1"},
+			{ID: "Patient.name", Path: "Patient.name", Min: 0, Max: "*", Constraints: []ElementConstraint{ele1}},
+			{ID: "Patient.name.family", Path: "Patient.name.family", Min: 1, Max: "1"},
+			{ID: "Patient.deceased[x]", Path: "Patient.deceased[x]", Min: 0, Max: "1", Types: []TypeRef{{Code: "boolean"}, {Code: "dateTime"}}},
+		},
+	}
+
+	elem, ok := sd.Element("Patient.identifier")
+	if !ok || elem.SliceName != "" || elem != &sd.Snapshot[1] {
+		t.Errorf("Element(Patient.identifier) = %v, %v; want the base definition", elem, ok)
+	}
+	if elem, ok := sd.Element("Patient.identifier.system"); !ok || elem.Min != 0 {
+		t.Errorf("Element(Patient.identifier.system) = %v, %v; want the element outside the slice", elem, ok)
+	}
+	if _, ok := sd.Element("Patient.gender"); ok {
+		t.Error("Expected no element for Patient.gender")
+	}
+	if elem, ok := sd.Element("Patient.deceasedBoolean"); !ok || elem.Path != "Patient.deceasedBoolean" || len(elem.Types) != 1 || elem.Types[0].Code != "boolean" {
+		t.Errorf("Element(Patient.deceasedBoolean) = %v, %v; want the choice element restricted to boolean", elem, ok)
+	}
+	if elem, ok := sd.Element("Patient.deceasedDateTime"); !ok || elem.Types[0].Code != "dateTime" {
+		t.Errorf("Element(Patient.deceasedDateTime) = %v, %v; want the choice element restricted to dateTime", elem, ok)
+	}
+	if elem, ok := sd.Element("Patient.deceased[x]"); !ok || elem != &sd.Snapshot[len(sd.Snapshot)-1] {
+		t.Errorf("Element(Patient.deceased[x]) = %v, %v; want the choice element", elem, ok)
+	}
+
+	var required []string
+	for _, elem := range sd.RequiredElements() {
+		required = append(required, elem.ID)
+	}
+	if want := []string{"Patient.identifier", "Patient.name.family"}; !slices.Equal(required, want) {
+		t.Errorf("RequiredElements() = %v, want %v", required, want)
+	}
+
+	var keys []string
+	for _, constraint := range sd.Constraints() {
+		keys = append(keys, constraint.Key)
+	}
+	if want := []string{"pat-1", "ele-1"}; !slices.Equal(keys, want) {
+		t.Errorf("Constraints() = %v, want %v", keys, want)
+	}
+}
+
+func TestStructureDefElementIndexCached(t *testing.T) {
+	sd := &StructureDef{
+		Type: "Patient",
+		Snapshot: []ElementDef{
+			{Path: "Patient", Min: 0, Max: "*"},
+			{Path: "Patient.name", Min: 0, Max: "*"},
+			{Path: "Patient.name.family", Min: 1, Max: "1"},
+		},
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, ok := sd.Element("Patient.name"); !ok {
+				t.Error("Expected an element for Patient.name")
+			}
+		}()
+	}
+	wg.Wait()
+
+	// Later calls look up the index built by the first one
+	allocs := testing.AllocsPerRun(100, func() {
+		if _, ok := sd.Element("Patient.name.family"); !ok {
+			t.Error("Expected an element for Patient.name.family")
+		}
+	})
+	if allocs != 0 {
+		t.Errorf("Element() allocated %v times per call, want the cached index", allocs)
+	}
+}
+
 func TestParseStructureDefinition(t *testing.T) {
 	json := `{
 		"resourceType": "StructureDefinition",
@@ -238,6 +590,121 @@ func TestLoadFromBundle(t *testing.T) {
 	}
 }
 
+// writeTestPackage writes a gzipped tarball with the given files to dir and
+// returns its path.
+func writeTestPackage(t *testing.T, dir string, files map[string]string) string {
+	t.Helper()
+
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	for name, content := range files {
+		hdr := &tar.Header{Name: name, Mode: 0o644, Size: int64(len(content)), Typeflag: tar.TypeReg}
+		if err := tw.WriteHeader(hdr); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := tw.Write([]byte(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := gz.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	path := filepath.Join(dir, "example.fhir.ig-1.0.0.tgz")
+	if err := os.WriteFile(path, buf.Bytes(), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoadPackage(t *testing.T) {
+	path := writeTestPackage(t, t.TempDir(), map[string]string{
+		"package/package.json": `{"name": "example.fhir.ig", "version": "1.0.0"}`,
+		"package/StructureDefinition-my-patient.json": `{
+			"resourceType": "StructureDefinition",
+			"url": "http://example.org/StructureDefinition/my-patient",
+			"name": "MyPatient",
+			"type": "Patient",
+			"kind": "resource",
+			"baseDefinition": "http://hl7.org/fhir/StructureDefinition/Patient"
+		}`,
+		"package/CodeSystem-colors.json": `{
+			"resourceType": "CodeSystem",
+			"url": "http://example.org/CodeSystem/colors",
+			"status": "active",
+			"content": "complete",
+			"concept": [{"code": "red", "display": "Red"}, {"code": "blue", "display": "Blue"}]
+		}`,
+		"package/ValueSet-colors.json": `{
+			"resourceType": "ValueSet",
+			"url": "http://example.org/ValueSet/colors",
+			"status": "active",
+			"compose": {"include": [{"system": "http://example.org/CodeSystem/colors"}]}
+		}`,
+		"package/ImplementationGuide-example.json": `{"resourceType": "ImplementationGuide", "url": "http://example.org/ImplementationGuide/example"}`,
+		"package/other/StructureDefinition-ignored.json": `{
+			"resourceType": "StructureDefinition",
+			"url": "http://example.org/StructureDefinition/ignored",
+			"name": "Ignored",
+			"type": "Patient",
+			"kind": "resource"
+		}`,
+		"package/.index.json": `{"index-version": 1, "files": []}`,
+	})
+
+	reg := NewRegistry(FHIRVersionR4)
+	count, err := reg.LoadPackage(context.Background(), path)
+	if err != nil {
+		t.Fatalf("LoadPackage failed: %v", err)
+	}
+	if count != 3 {
+		t.Errorf("Expected 3 resources loaded, got %d", count)
+	}
+
+	if _, err := reg.Get(context.Background(), "http://example.org/StructureDefinition/my-patient"); err != nil {
+		t.Errorf("Expected profile to be registered: %v", err)
+	}
+	if _, err := reg.Get(context.Background(), "http://example.org/StructureDefinition/ignored"); err == nil {
+		t.Error("Expected definitions outside package/ to be ignored")
+	}
+
+	ts := reg.Terminology()
+	if !ts.HasCodeSystem("http://example.org/CodeSystem/colors") {
+		t.Error("Expected CodeSystem to be loaded")
+	}
+	valid, err := ts.ValidateCode(context.Background(), "http://example.org/CodeSystem/colors", "red", "http://example.org/ValueSet/colors")
+	if err != nil || !valid {
+		t.Errorf("Expected 'red' to be in the package ValueSet, got %v, %v", valid, err)
+	}
+}
+
+func TestLoadPackage_Errors(t *testing.T) {
+	reg := NewRegistry(FHIRVersionR4)
+
+	if _, err := reg.LoadPackage(context.Background(), filepath.Join(t.TempDir(), "missing.tgz")); err == nil {
+		t.Error("Expected error for missing package")
+	}
+
+	notGzip := filepath.Join(t.TempDir(), "package.tgz")
+	if err := os.WriteFile(notGzip, []byte(`{"resourceType": "Bundle"}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := reg.LoadPackage(context.Background(), notGzip); err == nil {
+		t.Error("Expected error for a file that is not a tarball")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	path := writeTestPackage(t, t.TempDir(), map[string]string{"package/package.json": `{}`})
+	if _, err := reg.LoadPackage(ctx, path); err == nil {
+		t.Error("Expected error for a canceled context")
+	}
+}
+
 func TestLoadFromSpecsR4(t *testing.T) {
 	// Find the specs directory
 	specsPath := filepath.Join("..", "..", "specs", "r4", "profiles-resources.json")
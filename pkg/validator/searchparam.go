@@ -0,0 +1,258 @@
+// Package validator provides FHIR resource validation based on StructureDefinitions.
+package validator
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// resourceTypeSearchParameter is the FHIR resource type for SearchParameter.
+const resourceTypeSearchParameter = "SearchParameter"
+
+// SearchParam is a simplified representation of a FHIR SearchParameter.
+type SearchParam struct {
+	URL        string
+	Name       string
+	Code       string
+	Base       []string
+	Type       string
+	Expression string
+}
+
+// SearchParamConflict reports search parameters that overlap on a base resource
+// type: either they share the same code, or their expressions index the same path.
+type SearchParamConflict struct {
+	// Base is the resource type the parameters apply to
+	Base string
+	// Reason is "code" for a shared code, or "path" for a shared indexed path
+	Reason string
+	// Value is the shared code or path (e.g., "Patient.name")
+	Value string
+	// URLs are the canonical URLs of the conflicting parameters, sorted
+	URLs []string
+}
+
+// String returns a one-line description of the conflict.
+func (c SearchParamConflict) String() string {
+	return fmt.Sprintf("%s: %s %q shared by %s", c.Base, c.Reason, c.Value, strings.Join(c.URLs, ", "))
+}
+
+// ParseSearchParameter parses a SearchParameter from JSON.
+func ParseSearchParameter(data []byte) (*SearchParam, error) {
+	var raw struct {
+		ResourceType string   `json:"resourceType"`
+		URL          string   `json:"url"`
+		Name         string   `json:"name"`
+		Code         string   `json:"code"`
+		Base         []string `json:"base"`
+		Type         string   `json:"type"`
+		Expression   string   `json:"expression"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("failed to parse SearchParameter: %w", err)
+	}
+	if raw.ResourceType != resourceTypeSearchParameter {
+		return nil, fmt.Errorf("expected SearchParameter, got %s", raw.ResourceType)
+	}
+	return &SearchParam{
+		URL:        raw.URL,
+		Name:       raw.Name,
+		Code:       raw.Code,
+		Base:       raw.Base,
+		Type:       raw.Type,
+		Expression: raw.Expression,
+	}, nil
+}
+
+// RegisterSearchParam adds a SearchParameter to the registry.
+func (r *Registry) RegisterSearchParam(sp *SearchParam) error {
+	if sp == nil {
+		return fmt.Errorf("cannot register nil SearchParameter")
+	}
+	if sp.URL == "" {
+		return fmt.Errorf("SearchParameter must have a URL")
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.searchParams == nil {
+		r.searchParams = make(map[string]*SearchParam)
+	}
+	r.searchParams[sp.URL] = sp
+	return nil
+}
+
+// SearchParams returns the SearchParameters of this registry and its base chain,
+// sorted by URL.
+func (r *Registry) SearchParams() []*SearchParam {
+	byURL := r.collectSearchParams(make(map[string]*SearchParam))
+	params := make([]*SearchParam, 0, len(byURL))
+	for _, sp := range byURL {
+		params = append(params, sp)
+	}
+	sort.Slice(params, func(i, j int) bool { return params[i].URL < params[j].URL })
+	return params
+}
+
+// collectSearchParams adds the SearchParameters of this registry and its base
+// chain to byURL, with definitions in this registry taking precedence.
+func (r *Registry) collectSearchParams(byURL map[string]*SearchParam) map[string]*SearchParam {
+	r.mu.RLock()
+	for url, sp := range r.searchParams {
+		if _, ok := byURL[url]; !ok {
+			byURL[url] = sp
+		}
+	}
+	r.mu.RUnlock()
+
+	if r.base != nil {
+		r.base.collectSearchParams(byURL)
+	}
+	return byURL
+}
+
+// FindConflictingSearchParams compares the loaded SearchParameters and reports
+// those that overlap on the same base resource type: parameters sharing a code,
+// and parameters whose expressions index the same path. Expressions are split
+// into their union ("|") branches and compared after collapsing whitespace and
+// removing enclosing parentheses, so "Patient.name" in "Patient.name | Person.name" and
+// in "(Patient.name)" is the same path. Conflicts are sorted by base, reason and
+// value.
+func (r *Registry) FindConflictingSearchParams() []SearchParamConflict {
+	type key struct{ base, reason, value string }
+	urls := make(map[key][]string)
+
+	for _, sp := range r.SearchParams() {
+		for _, base := range sp.Base {
+			if sp.Code != "" {
+				k := key{base, "code", sp.Code}
+				urls[k] = append(urls[k], sp.URL)
+			}
+		}
+		for base, paths := range searchParamPaths(sp) {
+			for _, path := range paths {
+				k := key{base, "path", path}
+				urls[k] = append(urls[k], sp.URL)
+			}
+		}
+	}
+
+	var conflicts []SearchParamConflict
+	for k, list := range urls {
+		if len(list) < 2 {
+			continue
+		}
+		sort.Strings(list)
+		conflicts = append(conflicts, SearchParamConflict{Base: k.base, Reason: k.reason, Value: k.value, URLs: list})
+	}
+	sort.Slice(conflicts, func(i, j int) bool {
+		a, b := conflicts[i], conflicts[j]
+		if a.Base != b.Base {
+			return a.Base < b.Base
+		}
+		if a.Reason != b.Reason {
+			return a.Reason < b.Reason
+		}
+		return a.Value < b.Value
+	})
+	return conflicts
+}
+
+// searchParamPaths returns the normalized paths indexed by a SearchParameter
+// expression, grouped by base type. A branch starting with one of the bases
+// (e.g., "Practitioner.name" in a parameter on Patient and Practitioner) belongs
+// to that base only; other branches belong to every base.
+func searchParamPaths(sp *SearchParam) map[string][]string {
+	paths := make(map[string][]string)
+	for _, branch := range splitUnion(sp.Expression) {
+		path := normalizeSearchPath(branch)
+		if path == "" {
+			continue
+		}
+		owner := ""
+		for _, base := range sp.Base {
+			if path == base || strings.HasPrefix(path, base+".") {
+				owner = base
+				break
+			}
+		}
+		if owner != "" {
+			paths[owner] = appendUnique(paths[owner], path)
+			continue
+		}
+		for _, base := range sp.Base {
+			paths[base] = appendUnique(paths[base], path)
+		}
+	}
+	return paths
+}
+
+// splitUnion splits an expression on its top-level union operators, ignoring
+// "|" inside parentheses and string literals.
+func splitUnion(expression string) []string {
+	var parts []string
+	depth, start := 0, 0
+	inString := false
+	for i := 0; i < len(expression); i++ {
+		switch c := expression[i]; {
+		case c == '\\' && inString:
+			i++
+		case c == '\'':
+			inString = !inString
+		case inString:
+		case c == '(':
+			depth++
+		case c == ')':
+			depth--
+		case c == '|' && depth == 0:
+			parts = append(parts, expression[start:i])
+			start = i + 1
+		}
+	}
+	return append(parts, expression[start:])
+}
+
+// normalizeSearchPath collapses whitespace in an expression branch and removes
+// enclosing parentheses.
+func normalizeSearchPath(branch string) string {
+	path := strings.Join(strings.Fields(branch), " ")
+	for strings.HasPrefix(path, "(") && strings.HasSuffix(path, ")") && balanced(path[1:len(path)-1]) {
+		path = strings.TrimSpace(path[1 : len(path)-1])
+	}
+	return path
+}
+
+// balanced reports whether the parentheses of s outside string literals are balanced.
+func balanced(s string) bool {
+	depth := 0
+	inString := false
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case c == '\\' && inString:
+			i++
+		case c == '\'':
+			inString = !inString
+		case inString:
+		case c == '(':
+			depth++
+		case c == ')':
+			depth--
+			if depth < 0 {
+				return false
+			}
+		}
+	}
+	return depth == 0
+}
+
+// appendUnique appends s to list unless it is already present.
+func appendUnique(list []string, s string) []string {
+	for _, item := range list {
+		if item == s {
+			return list
+		}
+	}
+	return append(list, s)
+}
@@ -0,0 +1,92 @@
+package validator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindConflictingSearchParams(t *testing.T) {
+	bundle := `{
+		"resourceType": "Bundle",
+		"entry": [
+			{"resource": {"resourceType": "SearchParameter", "url": "http://hl7.org/fhir/SearchParameter/individual-given",
+				"code": "given", "base": ["Patient", "Practitioner"], "type": "string",
+				"expression": "Patient.name.given | Practitioner.name.given"}},
+			{"resource": {"resourceType": "SearchParameter", "url": "http://example.org/SearchParameter/patient-first-name",
+				"code": "first-name", "base": ["Patient"], "type": "string",
+				"expression": "(Patient.name.given)"}},
+			{"resource": {"resourceType": "SearchParameter", "url": "http://hl7.org/fhir/SearchParameter/Patient-gender",
+				"code": "gender", "base": ["Patient"], "type": "token", "expression": "Patient.gender"}},
+			{"resource": {"resourceType": "SearchParameter", "url": "http://example.org/SearchParameter/patient-sex",
+				"code": "gender", "base": ["Patient"], "type": "token",
+				"expression": "Patient.extension.where(url = 'http://example.org/sex | gender').value"}},
+			{"resource": {"resourceType": "SearchParameter", "url": "http://hl7.org/fhir/SearchParameter/Practitioner-active",
+				"code": "active", "base": ["Practitioner"], "type": "token", "expression": "Practitioner.active"}}
+		]
+	}`
+
+	reg := NewRegistry(FHIRVersionR4)
+	count, err := reg.LoadFromBundle([]byte(bundle))
+	require.NoError(t, err)
+	assert.Equal(t, 5, count)
+	require.Len(t, reg.SearchParams(), 5)
+
+	assert.Equal(t, []SearchParamConflict{
+		{
+			Base:   "Patient",
+			Reason: "code",
+			Value:  "gender",
+			URLs: []string{
+				"http://example.org/SearchParameter/patient-sex",
+				"http://hl7.org/fhir/SearchParameter/Patient-gender",
+			},
+		},
+		{
+			Base:   "Patient",
+			Reason: "path",
+			Value:  "Patient.name.given",
+			URLs: []string{
+				"http://example.org/SearchParameter/patient-first-name",
+				"http://hl7.org/fhir/SearchParameter/individual-given",
+			},
+		},
+	}, reg.FindConflictingSearchParams())
+}
+
+func TestFindConflictingSearchParams_Clone(t *testing.T) {
+	base := NewRegistry(FHIRVersionR4)
+	require.NoError(t, base.RegisterSearchParam(&SearchParam{
+		URL: "http://hl7.org/fhir/SearchParameter/Observation-code", Code: "code",
+		Base: []string{"Observation"}, Expression: "Observation.code",
+	}))
+	assert.Empty(t, base.FindConflictingSearchParams())
+
+	clone := base.Clone()
+	_, err := clone.LoadFromJSON([]byte(`{"resourceType": "SearchParameter",
+		"url": "http://example.org/SearchParameter/obs-code", "code": "obs-code",
+		"base": ["Observation"], "expression": "Observation.value as CodeableConcept | Observation.code"}`))
+	require.NoError(t, err)
+
+	conflicts := clone.FindConflictingSearchParams()
+	require.Len(t, conflicts, 1)
+	assert.Equal(t, "Observation.code", conflicts[0].Value)
+	assert.Equal(t, `Observation: path "Observation.code" shared by http://example.org/SearchParameter/obs-code, http://hl7.org/fhir/SearchParameter/Observation-code`, conflicts[0].String())
+	assert.Empty(t, base.FindConflictingSearchParams(), "definitions registered on the clone must not leak into the base")
+}
+
+func TestRegisterSearchParam_Errors(t *testing.T) {
+	reg := NewRegistry(FHIRVersionR4)
+	assert.Error(t, reg.RegisterSearchParam(nil))
+	assert.Error(t, reg.RegisterSearchParam(&SearchParam{Code: "name"}))
+
+	_, err := ParseSearchParameter([]byte(`{"resourceType": "Patient"}`))
+	assert.Error(t, err)
+}
+
+func TestSplitUnion(t *testing.T) {
+	assert.Equal(t, []string{"a.b ", " (c | d) ", " e.where(x = '|')"}, splitUnion("a.b | (c | d) | e.where(x = '|')"))
+	assert.Equal(t, "Observation.value as Quantity", normalizeSearchPath(" (Observation.value\n  as Quantity) "))
+	assert.Equal(t, "(a) | (b)", normalizeSearchPath("(a) | (b)"))
+}
@@ -0,0 +1,233 @@
+// Package validator provides FHIR resource validation based on StructureDefinitions.
+package validator
+
+import (
+	"fmt"
+	"strings"
+)
+
+// resolveSnapshot returns sd with an effective snapshot. Definitions that have a
+// snapshot are returned as is; a profile that only has a differential gets one
+// generated by applying its differential to the snapshot of its baseDefinition,
+// which is itself resolved first, so that a profile of a profile inherits every
+// constraint along the chain. A definition whose base is not registered is
+// returned as is. The registered definition is never modified.
+func (r *Registry) resolveSnapshot(sd *StructureDef, chain []string) (*StructureDef, error) {
+	if len(sd.Snapshot) > 0 || sd.BaseDefinition == "" {
+		return sd, nil
+	}
+	for _, url := range chain {
+		if url == sd.URL {
+			return nil, fmt.Errorf("circular baseDefinition chain: %s -> %s", strings.Join(chain, " -> "), sd.URL)
+		}
+	}
+
+	generation := r.Generation()
+	r.mu.RLock()
+	cached, ok := r.resolved[sd.URL]
+	r.mu.RUnlock()
+	if ok && cached.generation == generation {
+		return cached.sd, nil
+	}
+
+	base, ok := r.lookupURL(sd.BaseDefinition)
+	if !ok {
+		// Nothing to inherit from; the definition is used as registered
+		return sd, nil
+	}
+	base, err := r.resolveSnapshot(base, append(chain, sd.URL))
+	if err != nil {
+		return nil, err
+	}
+
+	resolved := withBaseSnapshot(sd, base)
+
+	r.mu.Lock()
+	if r.resolved == nil {
+		r.resolved = make(map[string]resolvedDef)
+	}
+	r.resolved[sd.URL] = resolvedDef{generation: generation, sd: resolved}
+	r.mu.Unlock()
+	return resolved, nil
+}
+
+// withBaseSnapshot returns a copy of sd whose snapshot is generated by applying its
+// differential to the snapshot of base. Type and kind are inherited when unset.
+// The fields are copied one by one, as the element index of sd must not be.
+func withBaseSnapshot(sd, base *StructureDef) *StructureDef {
+	resolved := &StructureDef{
+		URL:            sd.URL,
+		Name:           sd.Name,
+		Type:           sd.Type,
+		Kind:           sd.Kind,
+		Abstract:       sd.Abstract,
+		BaseDefinition: sd.BaseDefinition,
+		FHIRVersion:    sd.FHIRVersion,
+		Snapshot:       applyDifferential(base.Snapshot, sd.Differential),
+		Differential:   sd.Differential,
+	}
+	if resolved.Type == "" {
+		resolved.Type = base.Type
+	}
+	if resolved.Kind == "" {
+		resolved.Kind = base.Kind
+	}
+	return resolved
+}
+
+// resolvedDef is a StructureDef with a generated snapshot, valid for the registry
+// generation it was generated at.
+type resolvedDef struct {
+	generation uint64
+	sd         *StructureDef
+}
+
+// applyDifferential returns a copy of the base snapshot with the differential
+// elements merged in. Elements are matched by id, or by path for unsliced
+// elements without an id; unmatched elements (new slices, or children of types
+// the base does not expand) are inserted after their parent element. A new slice,
+// or a child of one, starts as a copy of the element it slices, so it keeps the
+// types, constraints and cardinality of the base before the differential applies.
+func applyDifferential(base, differential []ElementDef) []ElementDef {
+	snapshot := make([]ElementDef, len(base))
+	copy(snapshot, base)
+
+	for _, diff := range differential {
+		if i := findSnapshotElement(snapshot, diff); i >= 0 {
+			snapshot[i] = mergeElement(snapshot[i], diff)
+			continue
+		}
+		elem := diff
+		if elem.ID == "" {
+			elem.ID = elem.Path
+		}
+		if j := findUnslicedElement(snapshot, elem.ID); j >= 0 {
+			seed := snapshot[j]
+			seed.ID, seed.Path, seed.SliceName = elem.ID, elem.Path, elem.SliceName
+			if elem.SliceName != "" {
+				// The min of the sliced element applies to all of its slices together
+				seed.Min = 0
+			}
+			elem = mergeElement(seed, diff)
+			elem.ID = seed.ID
+		}
+		i := insertionIndex(snapshot, elem.ID)
+		snapshot = append(snapshot, ElementDef{})
+		copy(snapshot[i+1:], snapshot[i:])
+		snapshot[i] = elem
+	}
+	return snapshot
+}
+
+// findSnapshotElement returns the index of the snapshot element a differential
+// element constrains, or -1.
+func findSnapshotElement(snapshot []ElementDef, diff ElementDef) int {
+	for i := range snapshot {
+		elem := &snapshot[i]
+		if diff.ID != "" && elementID(elem) == diff.ID {
+			return i
+		}
+		if diff.ID == "" && diff.SliceName == "" && elem.Path == diff.Path && !isSliceElement(elem) {
+			return i
+		}
+	}
+	return -1
+}
+
+// findUnslicedElement returns the index of the element a slice, or an element
+// within a slice, is based on: the one whose id has the slice names removed
+// ("Patient.extension" for "Patient.extension:race"), or -1.
+func findUnslicedElement(snapshot []ElementDef, id string) int {
+	if !strings.Contains(id, ":") {
+		return -1
+	}
+	segments := strings.Split(id, ".")
+	for i, segment := range segments {
+		if colon := strings.Index(segment, ":"); colon >= 0 {
+			segments[i] = segment[:colon]
+		}
+	}
+	unsliced := strings.Join(segments, ".")
+	for i := range snapshot {
+		if elementID(&snapshot[i]) == unsliced {
+			return i
+		}
+	}
+	return -1
+}
+
+// elementID returns the id of elem, defaulting to its path.
+func elementID(elem *ElementDef) string {
+	if elem.ID != "" {
+		return elem.ID
+	}
+	return elem.Path
+}
+
+// insertionIndex returns where a new element with the given id goes: after the
+// last element of its parent's subtree, where the parent of a slice
+// ("Patient.extension:race") is the sliced element and that of any other
+// element the element before its last dot.
+func insertionIndex(snapshot []ElementDef, id string) int {
+	parent := id
+	if dot := strings.LastIndex(id, "."); dot >= 0 && !strings.Contains(id[dot:], ":") {
+		parent = id[:dot]
+	} else if colon := strings.LastIndex(id, ":"); colon >= 0 {
+		parent = id[:colon]
+	}
+
+	index := len(snapshot)
+	for i := range snapshot {
+		elemID := elementID(&snapshot[i])
+		if elemID == parent || strings.HasPrefix(elemID, parent+".") || strings.HasPrefix(elemID, parent+":") {
+			index = i + 1
+		}
+	}
+	return index
+}
+
+// mergeElement applies the constraints of a differential element to a base
+// element. Cardinality can only be narrowed, so the larger min wins; other
+// fields set in the differential replace the base ones, and constraints are
+// added to the inherited ones.
+func mergeElement(base, diff ElementDef) ElementDef {
+	merged := base
+	if diff.Min > merged.Min {
+		merged.Min = diff.Min
+	}
+	if diff.Max != "" {
+		merged.Max = diff.Max
+	}
+	if len(diff.Types) > 0 {
+		merged.Types = diff.Types
+	}
+	if diff.Short != "" {
+		merged.Short = diff.Short
+	}
+	if diff.Definition != "" {
+		merged.Definition = diff.Definition
+	}
+	if diff.Fixed != nil {
+		merged.Fixed = diff.Fixed
+	}
+	if diff.Pattern != nil {
+		merged.Pattern = diff.Pattern
+	}
+	if diff.MaxLength > 0 {
+		merged.MaxLength = diff.MaxLength
+	}
+	if diff.MinValue != nil {
+		merged.MinValue = diff.MinValue
+	}
+	if diff.MaxValue != nil {
+		merged.MaxValue = diff.MaxValue
+	}
+	if diff.Binding != nil {
+		merged.Binding = diff.Binding
+	}
+	if len(diff.Constraints) > 0 {
+		merged.Constraints = append(append([]ElementConstraint(nil), base.Constraints...), diff.Constraints...)
+	}
+	merged.MustSupport = base.MustSupport || diff.MustSupport
+	return merged
+}
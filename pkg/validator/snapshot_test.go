@@ -0,0 +1,204 @@
+package validator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// profileChainDefs holds a base Patient definition, a differential-only profile
+// requiring an identifier, and a profile of that profile requiring a name and
+// allowing a single identifier.
+var profileChainDefs = []*StructureDef{
+	{
+		URL:  "http://hl7.org/fhir/StructureDefinition/Patient",
+		Name: "Patient",
+		Type: "Patient",
+		Kind: "resource",
+		Snapshot: []ElementDef{
+			{Path: "Patient", Min: 0, Max: "*"},
+			{Path: "Patient.id", Min: 0, Max: "1", Types: []TypeRef{{Code: "id"}}},
+			{Path: "Patient.identifier", Min: 0, Max: "*", Types: []TypeRef{{Code: "Identifier"}}},
+			{Path: "Patient.name", Min: 0, Max: "*", Types: []TypeRef{{Code: "HumanName"}}},
+			{Path: "Patient.birthDate", Min: 0, Max: "1", Types: []TypeRef{{Code: "date"}}},
+		},
+	},
+	{
+		URL:            "http://example.org/StructureDefinition/identified-patient",
+		Name:           "IdentifiedPatient",
+		BaseDefinition: "http://hl7.org/fhir/StructureDefinition/Patient",
+		Differential: []ElementDef{
+			{ID: "Patient.identifier", Path: "Patient.identifier", Min: 1},
+		},
+	},
+	{
+		URL:            "http://example.org/StructureDefinition/named-patient",
+		Name:           "NamedPatient",
+		Type:           "Patient",
+		Kind:           "resource",
+		BaseDefinition: "http://example.org/StructureDefinition/identified-patient",
+		Differential: []ElementDef{
+			{ID: "Patient.name", Path: "Patient.name", Min: 1, MustSupport: true},
+			{ID: "Patient.identifier", Path: "Patient.identifier", Max: "1"},
+		},
+	},
+}
+
+func TestRegistryGet_ResolvesBaseDefinitionChain(t *testing.T) {
+	registry := NewRegistry(FHIRVersionR4)
+	for _, sd := range profileChainDefs {
+		require.NoError(t, registry.Register(sd))
+	}
+
+	sd, err := registry.Get(context.Background(), "http://example.org/StructureDefinition/named-patient")
+	require.NoError(t, err)
+	require.Len(t, sd.Snapshot, 5)
+
+	byPath := make(map[string]ElementDef)
+	for _, elem := range sd.Snapshot {
+		byPath[elem.Path] = elem
+	}
+	assert.Equal(t, 1, byPath["Patient.identifier"].Min, "inherited from the parent profile")
+	assert.Equal(t, "1", byPath["Patient.identifier"].Max)
+	assert.Equal(t, 1, byPath["Patient.name"].Min)
+	assert.True(t, byPath["Patient.name"].MustSupport)
+	assert.Equal(t, []TypeRef{{Code: "HumanName"}}, byPath["Patient.name"].Types)
+	assert.Equal(t, "1", byPath["Patient.birthDate"].Max)
+
+	// Type and kind come from the chain when the profile omits them
+	parent, err := registry.Get(context.Background(), "http://example.org/StructureDefinition/identified-patient")
+	require.NoError(t, err)
+	assert.Equal(t, "Patient", parent.Type)
+	assert.Equal(t, "resource", parent.Kind)
+
+	// The registered definitions are not modified
+	registered, _ := registry.lookupURL("http://example.org/StructureDefinition/named-patient")
+	assert.Empty(t, registered.Snapshot)
+}
+
+func TestRegistryGet_CircularBaseDefinition(t *testing.T) {
+	registry := NewRegistry(FHIRVersionR4)
+	require.NoError(t, registry.Register(&StructureDef{URL: "http://example.org/a", BaseDefinition: "http://example.org/b"}))
+	require.NoError(t, registry.Register(&StructureDef{URL: "http://example.org/b", BaseDefinition: "http://example.org/a"}))
+
+	_, err := registry.Get(context.Background(), "http://example.org/a")
+	assert.ErrorContains(t, err, "circular baseDefinition chain")
+}
+
+func TestApplyDifferential_NewElements(t *testing.T) {
+	base := []ElementDef{
+		{Path: "Patient"},
+		{Path: "Patient.extension", Max: "*"},
+		{Path: "Patient.identifier", Max: "*"},
+		{Path: "Patient.name", Max: "*"},
+	}
+	snapshot := applyDifferential(base, []ElementDef{
+		{ID: "Patient.identifier.system", Path: "Patient.identifier.system", Min: 1},
+		{ID: "Patient.extension:race", Path: "Patient.extension", SliceName: "race", Max: "1"},
+		{ID: "Patient.extension:race.url", Path: "Patient.extension.url"},
+	})
+
+	var ids []string
+	for i := range snapshot {
+		ids = append(ids, elementID(&snapshot[i]))
+	}
+	assert.Equal(t, []string{
+		"Patient",
+		"Patient.extension",
+		"Patient.extension:race",
+		"Patient.extension:race.url",
+		"Patient.identifier",
+		"Patient.identifier.system",
+		"Patient.name",
+	}, ids)
+	assert.Len(t, base, 4, "the base snapshot is not modified")
+}
+
+func TestApplyDifferential_SeedsSlicesFromBase(t *testing.T) {
+	extConstraint := ElementConstraint{Key: "ext-1", Severity: "error", Expression: "extension.exists() != value.exists()"}
+	base := []ElementDef{
+		{Path: "Patient"},
+		{ID: "Patient.extension", Path: "Patient.extension", Min: 1, Max: "*", Types: []TypeRef{{Code: "Extension"}}, Constraints: []ElementConstraint{extConstraint}},
+		{ID: "Patient.extension.url", Path: "Patient.extension.url", Min: 1, Max: "1", Types: []TypeRef{{Code: "uri"}}},
+	}
+	snapshot := applyDifferential(base, []ElementDef{
+		{ID: "Patient.extension:race", Path: "Patient.extension", SliceName: "race", Max: "1"},
+		{ID: "Patient.extension:race.url", Path: "Patient.extension.url", Fixed: "http://example.org/race"},
+		{ID: "Patient.extension:birthPlace", Path: "Patient.extension", SliceName: "birthPlace"},
+	})
+
+	byID := make(map[string]ElementDef)
+	for i := range snapshot {
+		byID[elementID(&snapshot[i])] = snapshot[i]
+	}
+
+	race := byID["Patient.extension:race"]
+	assert.Equal(t, "race", race.SliceName)
+	assert.Equal(t, 0, race.Min, "the min of the sliced element is not copied to its slices")
+	assert.Equal(t, "1", race.Max, "the differential narrows the max")
+	assert.Equal(t, []TypeRef{{Code: "Extension"}}, race.Types)
+	assert.Equal(t, []ElementConstraint{extConstraint}, race.Constraints)
+
+	birthPlace := byID["Patient.extension:birthPlace"]
+	assert.Equal(t, "*", birthPlace.Max, "the max of the sliced element is inherited")
+	assert.Equal(t, []TypeRef{{Code: "Extension"}}, birthPlace.Types)
+
+	url := byID["Patient.extension:race.url"]
+	assert.Equal(t, 1, url.Min, "children of a slice keep the base cardinality")
+	assert.Equal(t, "1", url.Max)
+	assert.Equal(t, []TypeRef{{Code: "uri"}}, url.Types)
+	assert.Equal(t, "http://example.org/race", url.Fixed)
+	assert.Equal(t, "Patient.extension.url", url.Path)
+}
+
+func TestValidateProfileOfProfile(t *testing.T) {
+	v := newMinimalValidator(t, ValidatorOptions{
+		Profile: "http://example.org/StructureDefinition/named-patient",
+	}, profileChainDefs...)
+
+	tests := []struct {
+		name      string
+		resource  string
+		wantValid bool
+		wantPaths []string
+	}{
+		{
+			name:      "conforms",
+			resource:  `{"resourceType": "Patient", "identifier": [{"value": "1"}], "name": [{"family": "Doe"}]}`,
+			wantValid: true,
+		},
+		{
+			name:      "missing element required by the parent profile",
+			resource:  `{"resourceType": "Patient", "name": [{"family": "Doe"}]}`,
+			wantPaths: []string{"Patient.identifier"},
+		},
+		{
+			name:      "missing element required by the profile",
+			resource:  `{"resourceType": "Patient", "identifier": [{"value": "1"}]}`,
+			wantPaths: []string{"Patient.name"},
+		},
+		{
+			name:      "cardinality narrowed by the profile",
+			resource:  `{"resourceType": "Patient", "identifier": [{"value": "1"}, {"value": "2"}], "name": [{"family": "Doe"}]}`,
+			wantPaths: []string{"Patient.identifier"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := v.Validate(context.Background(), []byte(tt.resource))
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantValid, result.Valid, "Issues: %v", result.Issues)
+
+			var paths []string
+			for _, issue := range result.Issues {
+				if issue.Severity == SeverityError && len(issue.Expression) > 0 {
+					paths = append(paths, issue.Expression[0])
+				}
+			}
+			assert.Equal(t, tt.wantPaths, paths, "Issues: %v", result.Issues)
+		})
+	}
+}
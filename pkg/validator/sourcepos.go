@@ -0,0 +1,198 @@
+// Package validator provides FHIR resource validation based on StructureDefinitions.
+package validator
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// SourcePosition is the position of a value in the JSON source of a resource.
+type SourcePosition struct {
+	// Line is the 1-based line number
+	Line int `json:"line"`
+	// Column is the 1-based column, in characters
+	Column int `json:"column"`
+	// Offset is the 0-based byte offset
+	Offset int `json:"offset"`
+}
+
+// sourceIndex maps the paths of the values of a JSON resource to their byte offsets.
+type sourceIndex struct {
+	data       []byte
+	lineStarts []int
+	offsets    map[string]int
+}
+
+// newSourceIndex records the offset of every value of the JSON resource data,
+// under its indexed path (e.g., "Patient.name[1].family") and, for the first
+// occurrence, its path without indices (e.g., "Patient.name.family").
+func newSourceIndex(data []byte, resourceType string) (*sourceIndex, error) {
+	idx := &sourceIndex{
+		data:       data,
+		lineStarts: lineStarts(data),
+		offsets:    make(map[string]int),
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := idx.record(dec, resourceType); err != nil {
+		return nil, err
+	}
+	return idx, nil
+}
+
+// lineStarts returns the byte offsets at which the lines of data start.
+func lineStarts(data []byte) []int {
+	starts := []int{0}
+	for i, b := range data {
+		if b == '\n' {
+			starts = append(starts, i+1)
+		}
+	}
+	return starts
+}
+
+// record walks the next JSON value from dec, recording the offsets of it and its children.
+func (idx *sourceIndex) record(dec *json.Decoder, path string) error {
+	offset := idx.valueStart(int(dec.InputOffset()))
+	idx.offsets[path] = offset
+	if unindexed := stripIndices(path); unindexed != path {
+		if _, ok := idx.offsets[unindexed]; !ok {
+			idx.offsets[unindexed] = offset
+		}
+	}
+
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+
+	switch tok {
+	case json.Delim('{'):
+		for dec.More() {
+			keyTok, err := dec.Token()
+			if err != nil {
+				return err
+			}
+			key, _ := keyTok.(string)
+			if err := idx.record(dec, path+"."+key); err != nil {
+				return err
+			}
+		}
+	case json.Delim('['):
+		for i := 0; dec.More(); i++ {
+			if err := idx.record(dec, path+"["+strconv.Itoa(i)+"]"); err != nil {
+				return err
+			}
+		}
+	default:
+		return nil
+	}
+
+	// Consume the closing delimiter
+	_, err = dec.Token()
+	return err
+}
+
+// valueStart skips the whitespace and separators preceding the value at offset.
+func (idx *sourceIndex) valueStart(offset int) int {
+	for offset < len(idx.data) {
+		switch idx.data[offset] {
+		case ' ', '\t', '\r', '\n', ':', ',':
+			offset++
+		default:
+			return offset
+		}
+	}
+	return offset
+}
+
+// position returns the source position of the value at path, or of its closest
+// recorded ancestor (e.g., the parent object of a missing element).
+func (idx *sourceIndex) position(path string) (*SourcePosition, bool) {
+	for path != "" {
+		if offset, ok := idx.offsets[path]; ok {
+			return idx.positionAt(offset), true
+		}
+		if i := strings.LastIndexAny(path, ".["); i >= 0 {
+			path = path[:i]
+		} else {
+			path = ""
+		}
+	}
+	return nil, false
+}
+
+// positionAt converts a byte offset into a source position.
+func (idx *sourceIndex) positionAt(offset int) *SourcePosition {
+	offset = min(max(offset, 0), len(idx.data))
+	line := sort.Search(len(idx.lineStarts), func(i int) bool { return idx.lineStarts[i] > offset }) - 1
+	return &SourcePosition{
+		Line:   line + 1,
+		Column: utf8.RuneCount(idx.data[idx.lineStarts[line]:offset]) + 1,
+		Offset: offset,
+	}
+}
+
+// stripIndices removes the array indices of a path
+// (e.g., "Patient.name[1].family" -> "Patient.name.family").
+func stripIndices(path string) string {
+	if !strings.Contains(path, "[") {
+		return path
+	}
+	var b strings.Builder
+	for {
+		open := strings.IndexByte(path, '[')
+		if open < 0 {
+			b.WriteString(path)
+			return b.String()
+		}
+		b.WriteString(path[:open])
+		end := strings.IndexByte(path[open:], ']')
+		if end < 0 {
+			return b.String()
+		}
+		path = path[open+end+1:]
+	}
+}
+
+// annotateSourcePositions sets the LineColumn of the issues of result to the
+// position in resource of the element their expression (or location) refers to.
+func annotateSourcePositions(resource []byte, resourceType string, result *ValidationResult) {
+	idx, err := newSourceIndex(resource, resourceType)
+	if err != nil {
+		return
+	}
+	for i := range result.Issues {
+		issue := &result.Issues[i]
+		if issue.LineColumn != nil {
+			continue
+		}
+		paths := issue.Expression
+		if len(paths) == 0 {
+			paths = issue.Location
+		}
+		if len(paths) == 0 {
+			continue
+		}
+		if pos, ok := idx.position(paths[0]); ok {
+			issue.LineColumn = pos
+		}
+	}
+}
+
+// syntaxErrorPosition returns the position of a JSON syntax error in resource.
+func syntaxErrorPosition(resource []byte, err error) (*SourcePosition, bool) {
+	var syntaxErr *json.SyntaxError
+	if !errors.As(err, &syntaxErr) {
+		return nil, false
+	}
+	idx := &sourceIndex{data: resource, lineStarts: lineStarts(resource)}
+	// The error occurred after reading Offset bytes
+	return idx.positionAt(int(syntaxErr.Offset) - 1), true
+}
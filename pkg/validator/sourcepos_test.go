@@ -0,0 +1,77 @@
+package validator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrackSourcePositions(t *testing.T) {
+	registry := NewRegistry(FHIRVersionR4)
+	require.NoError(t, registry.Register(&StructureDef{
+		URL:  "http://hl7.org/fhir/StructureDefinition/Patient",
+		Name: "Patient",
+		Type: "Patient",
+		Kind: "resource",
+		Snapshot: []ElementDef{
+			{Path: "Patient", Min: 0, Max: "*"},
+			{Path: "Patient.active", Min: 0, Max: "1", Types: []TypeRef{{Code: "boolean"}}},
+			{Path: "Patient.gender", Min: 1, Max: "1", Types: []TypeRef{{Code: "code"}}},
+			{Path: "Patient.name", Min: 0, Max: "*", Types: []TypeRef{{Code: "HumanName"}}},
+		},
+	}))
+	v := NewValidator(registry, ValidatorOptions{TrackSourcePositions: true})
+
+	resource := "{\n" +
+		"  \"resourceType\": \"Patient\",\n" +
+		"  \"active\": \"yes\",\n" +
+		"  \"name\": [{\"text\": \"é\"}, {}]\n" +
+		"}"
+
+	result, err := v.Validate(context.Background(), []byte(resource))
+	require.NoError(t, err)
+
+	positions := make(map[string]*SourcePosition)
+	for _, issue := range result.Issues {
+		if len(issue.Expression) > 0 {
+			positions[issue.Expression[0]] = issue.LineColumn
+		}
+	}
+
+	if pos := positions["Patient.active"]; assert.NotNil(t, pos, "Issues: %v", result.Issues) {
+		assert.Equal(t, SourcePosition{Line: 3, Column: 13, Offset: 43}, *pos)
+	}
+	// Missing elements are located at their parent
+	if pos := positions["Patient.gender"]; assert.NotNil(t, pos, "Issues: %v", result.Issues) {
+		assert.Equal(t, SourcePosition{Line: 1, Column: 1, Offset: 0}, *pos)
+	}
+	// Columns count characters, not bytes
+	if pos := positions["Patient.name[1]"]; assert.NotNil(t, pos, "Issues: %v", result.Issues) {
+		assert.Equal(t, 4, pos.Line)
+		assert.Equal(t, 27, pos.Column)
+	}
+}
+
+func TestTrackSourcePositions_InvalidJSON(t *testing.T) {
+	v := NewValidator(NewRegistry(FHIRVersionR4), ValidatorOptions{TrackSourcePositions: true})
+
+	result, err := v.Validate(context.Background(), []byte("{\n  \"resourceType\": \"Patient\",\n  \"active\": tru\n}"))
+	require.NoError(t, err)
+	require.Len(t, result.Issues, 1)
+	if pos := result.Issues[0].LineColumn; assert.NotNil(t, pos) {
+		assert.Equal(t, 3, pos.Line)
+	}
+}
+
+func TestTrackSourcePositions_Disabled(t *testing.T) {
+	v := newMinimalValidator(t, ValidatorOptions{}, genderRequiredPatientSD)
+
+	result, err := v.Validate(context.Background(), []byte(`{"resourceType": "Patient"}`))
+	require.NoError(t, err)
+	require.NotEmpty(t, result.Issues)
+	for _, issue := range result.Issues {
+		assert.Nil(t, issue.LineColumn)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 	"sync"
 )
@@ -19,6 +20,7 @@ import (
 // - Supports required, extensible, preferred, and example bindings
 // - Resolves ValueSets that reference CodeSystems (most common pattern)
 // - Handles versioned ValueSet URLs (e.g., http://hl7.org/fhir/ValueSet/address-use|4.0.1)
+// - Translates codes with loaded ConceptMaps
 //
 // Example usage:
 //
@@ -37,6 +39,9 @@ type LocalTerminologyService struct {
 	// valueSetIndex maps ValueSet URL to the systems it includes
 	// Used for ValidateCode when only system+code provided without valueSet
 	valueSetSystems map[string][]string
+
+	// conceptMaps maps ConceptMap URL to its mapping groups
+	conceptMaps map[string][]conceptMapGroup
 }
 
 // NewLocalTerminologyService creates a new local terminology service.
@@ -45,6 +50,7 @@ func NewLocalTerminologyService() *LocalTerminologyService {
 		codeSystems:     make(map[string]map[string]*CodeInfo),
 		valueSets:       make(map[string][]*CodeInfo),
 		valueSetSystems: make(map[string][]string),
+		conceptMaps:     make(map[string][]conceptMapGroup),
 	}
 }
 
@@ -72,7 +78,7 @@ func (s *LocalTerminologyService) LoadFromDirectory(specsDir string) error {
 	return nil
 }
 
-// LoadFromBundle loads ValueSets and CodeSystems from a FHIR Bundle JSON.
+// LoadFromBundle loads ValueSets, CodeSystems and ConceptMaps from a FHIR Bundle JSON.
 func (s *LocalTerminologyService) LoadFromBundle(data []byte) error {
 	var bundle struct {
 		ResourceType string `json:"resourceType"`
@@ -105,11 +111,16 @@ func (s *LocalTerminologyService) LoadFromBundle(data []byte) error {
 			continue
 		}
 
-		if base.ResourceType == "CodeSystem" {
+		switch base.ResourceType {
+		case "CodeSystem":
 			if err := s.loadCodeSystem(entry.Resource); err != nil {
 				// Log but continue loading other resources
 				continue
 			}
+		case "ConceptMap":
+			if err := s.loadConceptMap(entry.Resource); err != nil {
+				continue
+			}
 		}
 	}
 
@@ -197,6 +208,55 @@ func (s *LocalTerminologyService) flattenConcepts(system string, concepts []code
 	}
 }
 
+// conceptMapResource represents a FHIR ConceptMap for parsing.
+type conceptMapResource struct {
+	ResourceType string            `json:"resourceType"`
+	URL          string            `json:"url"`
+	Group        []conceptMapGroup `json:"group,omitempty"`
+}
+
+type conceptMapGroup struct {
+	Source  string              `json:"source"`
+	Target  string              `json:"target"`
+	Element []conceptMapElement `json:"element,omitempty"`
+}
+
+type conceptMapElement struct {
+	Code   string             `json:"code"`
+	Target []conceptMapTarget `json:"target,omitempty"`
+}
+
+type conceptMapTarget struct {
+	Code    string `json:"code"`
+	Display string `json:"display,omitempty"`
+	// Equivalence (R4) or Relationship (R5) of the target to the source code
+	Equivalence  string `json:"equivalence,omitempty"`
+	Relationship string `json:"relationship,omitempty"`
+}
+
+// unmappedEquivalences are ConceptMap target equivalences (R4) and relationships
+// (R5) stating that the target is not a translation of the source code.
+var unmappedEquivalences = map[string]bool{
+	"unmatched":      true,
+	"disjoint":       true,
+	"not-related-to": true,
+}
+
+// loadConceptMap parses and stores a ConceptMap.
+func (s *LocalTerminologyService) loadConceptMap(data []byte) error {
+	var cm conceptMapResource
+	if err := json.Unmarshal(data, &cm); err != nil {
+		return err
+	}
+
+	if cm.URL == "" || len(cm.Group) == 0 {
+		return nil // Skip ConceptMaps without URL or mappings
+	}
+
+	s.conceptMaps[cm.URL] = cm.Group
+	return nil
+}
+
 // valueSetResource represents a FHIR ValueSet for parsing.
 type valueSetResource struct {
 	ResourceType string             `json:"resourceType"`
@@ -460,6 +520,65 @@ func (s *LocalTerminologyService) LookupCode(_ context.Context, system, code str
 	}, nil
 }
 
+// Lookup returns the display of a code from a loaded CodeSystem, using LookupCode.
+// Implements TerminologyService.Lookup.
+func (s *LocalTerminologyService) Lookup(ctx context.Context, system, code string) (string, bool, error) {
+	info, err := s.LookupCode(ctx, system, code)
+	if err != nil || info == nil {
+		// Unknown CodeSystems and codes are not found
+		return "", false, nil
+	}
+	return info.Display, true, nil
+}
+
+// Translate maps a code to codes in other systems using loaded ConceptMaps.
+// If conceptMapURL is empty, all loaded ConceptMaps are searched.
+// Implements TerminologyService.Translate.
+func (s *LocalTerminologyService) Translate(_ context.Context, system, code, conceptMapURL string) ([]Coding, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var urls []string
+	if conceptMapURL != "" {
+		url := normalizeValueSetURL(conceptMapURL)
+		if _, ok := s.conceptMaps[url]; !ok {
+			return nil, fmt.Errorf("ConceptMap not found: %s", conceptMapURL)
+		}
+		urls = []string{url}
+	} else {
+		for url := range s.conceptMaps {
+			urls = append(urls, url)
+		}
+		sort.Strings(urls)
+	}
+
+	var result []Coding
+	for _, url := range urls {
+		for _, group := range s.conceptMaps[url] {
+			if system != "" && group.Source != "" && group.Source != system {
+				continue
+			}
+			for _, element := range group.Element {
+				if element.Code != code {
+					continue
+				}
+				for _, target := range element.Target {
+					if target.Code == "" || unmappedEquivalences[target.Equivalence] || unmappedEquivalences[target.Relationship] {
+						continue
+					}
+					result = append(result, Coding{
+						System:  group.Target,
+						Code:    target.Code,
+						Display: target.Display,
+					})
+				}
+			}
+		}
+	}
+
+	return result, nil
+}
+
 // Stats returns statistics about loaded terminology resources.
 func (s *LocalTerminologyService) Stats() (codeSystems, valueSets, totalCodes int) {
 	s.mu.RLock()
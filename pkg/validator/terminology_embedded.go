@@ -9,9 +9,12 @@ import (
 )
 
 // embeddedValueSetRegistry holds all registered embedded ValueSets by FHIR version.
+// embeddedCodeStatusRegistry holds the status of deprecated or retired codes
+// (ValueSet URL -> code -> status) by FHIR version.
 var (
-	embeddedValueSetRegistry = make(map[string]map[string]map[string]bool)
-	embeddedRegistryMu       sync.RWMutex
+	embeddedValueSetRegistry   = make(map[string]map[string]map[string]bool)
+	embeddedCodeStatusRegistry = make(map[string]map[string]map[string]string)
+	embeddedRegistryMu         sync.RWMutex
 )
 
 // registerEmbeddedValueSets registers ValueSets for a FHIR version.
@@ -22,11 +25,21 @@ func registerEmbeddedValueSets(fhirVersion string, valueSets map[string]map[stri
 	embeddedValueSetRegistry[fhirVersion] = valueSets
 }
 
+// registerEmbeddedCodeStatus registers the concept status of deprecated or
+// retired codes for a FHIR version.
+// Called by init() functions in generated terminology_embedded_*.go files.
+func registerEmbeddedCodeStatus(fhirVersion string, statuses map[string]map[string]string) {
+	embeddedRegistryMu.Lock()
+	defer embeddedRegistryMu.Unlock()
+	embeddedCodeStatusRegistry[fhirVersion] = statuses
+}
+
 // EmbeddedTerminologyService provides terminology validation using embedded ValueSets.
 // This is more efficient than LocalTerminologyService as it doesn't require file I/O.
 type EmbeddedTerminologyService struct {
 	fhirVersion string
 	valueSets   map[string]map[string]bool
+	codeStatus  map[string]map[string]string
 }
 
 // NewEmbeddedTerminologyService creates a new embedded terminology service for the specified FHIR version.
@@ -47,6 +60,7 @@ func NewEmbeddedTerminologyService(fhirVersion string) (*EmbeddedTerminologyServ
 	return &EmbeddedTerminologyService{
 		fhirVersion: fhirVersion,
 		valueSets:   valueSets,
+		codeStatus:  embeddedCodeStatusRegistry[fhirVersion],
 	}, nil
 }
 
@@ -99,13 +113,21 @@ func (s *EmbeddedTerminologyService) ExpandValueSet(_ context.Context, valueSetU
 		return nil, fmt.Errorf("ValueSet not found: %s", valueSetURL)
 	}
 
+	statuses := s.codeStatus[vsURL]
 	result := make([]CodeInfo, 0, len(codes))
 	for code := range codes {
-		result = append(result, CodeInfo{Code: code, Active: true})
+		result = append(result, CodeInfo{Code: code, Active: true, Status: statuses[code]})
 	}
 	return result, nil
 }
 
+// CodeStatus returns the concept status of a code in the ValueSet (e.g., "deprecated"
+// or "retired"), or "" if the code is active or unknown.
+// Implements CodeStatusProvider.
+func (s *EmbeddedTerminologyService) CodeStatus(_ context.Context, _, code, valueSetURL string) (string, error) {
+	return s.codeStatus[normalizeEmbeddedURL(valueSetURL)][code], nil
+}
+
 // LookupCode returns information about a specific code.
 // Note: Embedded service only stores codes, not full CodeInfo with display/system.
 func (s *EmbeddedTerminologyService) LookupCode(_ context.Context, _, _ string) (*CodeInfo, error) {
@@ -113,6 +135,17 @@ func (s *EmbeddedTerminologyService) LookupCode(_ context.Context, _, _ string)
 	return nil, nil
 }
 
+// Lookup returns not found: the embedded service doesn't track CodeSystems.
+func (s *EmbeddedTerminologyService) Lookup(_ context.Context, _, _ string) (string, bool, error) {
+	return "", false, nil
+}
+
+// Translate returns empty: the embedded service doesn't include ConceptMaps.
+// Use LocalTerminologyService to translate with loaded ConceptMaps.
+func (s *EmbeddedTerminologyService) Translate(_ context.Context, _, _, _ string) ([]Coding, error) {
+	return nil, nil
+}
+
 // HasValueSet returns true if the ValueSet is available.
 func (s *EmbeddedTerminologyService) HasValueSet(url string) bool {
 	_, ok := s.valueSets[normalizeEmbeddedURL(url)]
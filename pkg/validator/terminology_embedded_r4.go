@@ -1651,6 +1651,11 @@ var embeddedValueSetsR4 = map[string]map[string]bool{
 	},
 }
 
+// embeddedCodeStatusR4 lists the deprecated or retired codes of the ValueSets above.
+var embeddedCodeStatusR4 = map[string]map[string]string{
+}
+
 func init() {
 	registerEmbeddedValueSets("4.0.1", embeddedValueSetsR4)
+	registerEmbeddedCodeStatus("4.0.1", embeddedCodeStatusR4)
 }
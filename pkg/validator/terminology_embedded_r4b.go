@@ -1640,6 +1640,11 @@ var embeddedValueSetsR4B = map[string]map[string]bool{
 	},
 }
 
+// embeddedCodeStatusR4B lists the deprecated or retired codes of the ValueSets above.
+var embeddedCodeStatusR4B = map[string]map[string]string{
+}
+
 func init() {
 	registerEmbeddedValueSets("4.3.0", embeddedValueSetsR4B)
+	registerEmbeddedCodeStatus("4.3.0", embeddedCodeStatusR4B)
 }
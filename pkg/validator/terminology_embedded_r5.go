@@ -1237,6 +1237,11 @@ var embeddedValueSetsR5 = map[string]map[string]bool{
 	},
 }
 
+// embeddedCodeStatusR5 lists the deprecated or retired codes of the ValueSets above.
+var embeddedCodeStatusR5 = map[string]map[string]string{
+}
+
 func init() {
 	registerEmbeddedValueSets("5.0.0", embeddedValueSetsR5)
+	registerEmbeddedCodeStatus("5.0.0", embeddedCodeStatusR5)
 }
@@ -0,0 +1,291 @@
+package validator
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultTerminologyCacheTTL is how long the default cache of HTTPTerminologyService keeps results.
+const DefaultTerminologyCacheTTL = 10 * time.Minute
+
+// errTerminologyNotFound is returned when the server responds 404 Not Found.
+var errTerminologyNotFound = errors.New("not found")
+
+// HTTPTerminologyService validates codes against a remote FHIR terminology server
+// (e.g., https://tx.fhir.org/r4) using the $validate-code, $expand, $lookup and
+// $translate operations.
+//
+// ValidateCode results are cached, so repeated validations of the same code across
+// resources do not hit the server again.
+//
+// Example usage:
+//
+//	termService := NewHTTPTerminologyService("https://tx.fhir.org/r4", nil)
+//	validator := NewValidator(registry, opts).WithTerminologyService(termService)
+type HTTPTerminologyService struct {
+	baseURL string
+	client  *http.Client
+	cache   TerminologyCache
+}
+
+// NewHTTPTerminologyService creates a terminology service for the server at baseURL.
+// If cache is nil, an in-memory cache with DefaultTerminologyCacheTTL is used.
+func NewHTTPTerminologyService(baseURL string, cache TerminologyCache) *HTTPTerminologyService {
+	if cache == nil {
+		cache = NewMemoryTerminologyCache(DefaultTerminologyCacheTTL)
+	}
+	return &HTTPTerminologyService{
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		client:  &http.Client{Timeout: 30 * time.Second},
+		cache:   cache,
+	}
+}
+
+// WithHTTPClient sets the HTTP client used for requests.
+func (s *HTTPTerminologyService) WithHTTPClient(client *http.Client) *HTTPTerminologyService {
+	s.client = client
+	return s
+}
+
+// operationParameters is the Parameters resource returned by terminology operations.
+type operationParameters struct {
+	Parameter []operationParameter `json:"parameter,omitempty"`
+}
+
+type operationParameter struct {
+	Name         string               `json:"name"`
+	ValueBoolean *bool                `json:"valueBoolean,omitempty"`
+	ValueString  string               `json:"valueString,omitempty"`
+	ValueCode    string               `json:"valueCode,omitempty"`
+	ValueCoding  *Coding              `json:"valueCoding,omitempty"`
+	Part         []operationParameter `json:"part,omitempty"`
+}
+
+// ValidateCode checks if a code is valid in the given ValueSet using $validate-code.
+// Implements TerminologyService.ValidateCode.
+func (s *HTTPTerminologyService) ValidateCode(ctx context.Context, system, code, valueSetURL string) (bool, error) {
+	key := system + "|" + code + "|" + valueSetURL
+	if valid, found := s.cache.Get(key); found {
+		return valid, nil
+	}
+
+	query := url.Values{"url": {valueSetURL}, "code": {code}}
+	if system != "" {
+		query.Set("system", system)
+	}
+
+	var params operationParameters
+	if err := s.get(ctx, "ValueSet/$validate-code", query, &params); err != nil {
+		return false, err
+	}
+
+	for _, p := range params.Parameter {
+		if p.Name == "result" && p.ValueBoolean != nil {
+			s.cache.Set(key, *p.ValueBoolean)
+			return *p.ValueBoolean, nil
+		}
+	}
+	return false, fmt.Errorf("$validate-code response has no result for %s", code)
+}
+
+// ExpandValueSet returns all codes in the ValueSet using $expand.
+// Implements TerminologyService.ExpandValueSet.
+func (s *HTTPTerminologyService) ExpandValueSet(ctx context.Context, valueSetURL string) ([]CodeInfo, error) {
+	var vs valueSetResource
+	if err := s.get(ctx, "ValueSet/$expand", url.Values{"url": {valueSetURL}}, &vs); err != nil {
+		return nil, err
+	}
+	if vs.Expansion == nil {
+		return nil, nil
+	}
+
+	result := make([]CodeInfo, len(vs.Expansion.Contains))
+	for i, c := range vs.Expansion.Contains {
+		result[i] = CodeInfo{System: c.System, Code: c.Code, Display: c.Display, Active: true}
+	}
+	return result, nil
+}
+
+// LookupCode returns information about a specific code using $lookup.
+// Implements TerminologyService.LookupCode.
+func (s *HTTPTerminologyService) LookupCode(ctx context.Context, system, code string) (*CodeInfo, error) {
+	var params operationParameters
+	if err := s.get(ctx, "CodeSystem/$lookup", url.Values{"system": {system}, "code": {code}}, &params); err != nil {
+		return nil, err
+	}
+
+	info := &CodeInfo{System: system, Code: code, Active: true}
+	for _, p := range params.Parameter {
+		switch p.Name {
+		case "display":
+			info.Display = p.ValueString
+		case "inactive":
+			if p.ValueBoolean != nil {
+				info.Active = !*p.ValueBoolean
+			}
+		}
+	}
+	return info, nil
+}
+
+// Lookup returns the display of a code using $lookup, through LookupCode.
+// A 404 response from the server is reported as not found.
+// Implements TerminologyService.Lookup.
+func (s *HTTPTerminologyService) Lookup(ctx context.Context, system, code string) (string, bool, error) {
+	info, err := s.LookupCode(ctx, system, code)
+	if errors.Is(err, errTerminologyNotFound) {
+		return "", false, nil
+	}
+	if err != nil {
+		return "", false, err
+	}
+	return info.Display, true, nil
+}
+
+// Translate maps a code to codes in other systems using $translate.
+// Implements TerminologyService.Translate.
+func (s *HTTPTerminologyService) Translate(ctx context.Context, system, code, conceptMapURL string) ([]Coding, error) {
+	query := url.Values{"system": {system}, "code": {code}}
+	if conceptMapURL != "" {
+		query.Set("url", conceptMapURL)
+	}
+
+	var params operationParameters
+	if err := s.get(ctx, "ConceptMap/$translate", query, &params); err != nil {
+		return nil, err
+	}
+
+	var result []Coding
+	for _, p := range params.Parameter {
+		if p.Name != "match" {
+			continue
+		}
+		var concept *Coding
+		unmapped := false
+		for _, part := range p.Part {
+			switch part.Name {
+			case "concept":
+				concept = part.ValueCoding
+			case "equivalence", "relationship":
+				unmapped = unmappedEquivalences[part.ValueCode]
+			}
+		}
+		if concept != nil && !unmapped {
+			result = append(result, *concept)
+		}
+	}
+	return result, nil
+}
+
+// get performs a GET request for a terminology operation and decodes the JSON response into out.
+func (s *HTTPTerminologyService) get(ctx context.Context, operation string, query url.Values, out interface{}) error {
+	endpoint := s.baseURL + "/" + operation + "?" + query.Encode()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, http.NoBody)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/fhir+json")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("terminology request %s failed: %w", operation, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("terminology request %s failed: %w", operation, errTerminologyNotFound)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("terminology request %s failed: %s", operation, resp.Status)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("invalid %s response: %w", operation, err)
+	}
+	return nil
+}
+
+// MemoryTerminologyCache is an in-memory TerminologyCache whose entries expire after a TTL.
+// Expired entries are dropped when they are read, and swept from the whole cache at
+// most once per TTL when new entries are stored, so that keys that are never read
+// again do not accumulate.
+type MemoryTerminologyCache struct {
+	mu        sync.RWMutex
+	ttl       time.Duration
+	entries   map[string]terminologyCacheEntry
+	nextSweep time.Time
+	now       func() time.Time // replaced in tests
+}
+
+type terminologyCacheEntry struct {
+	valid   bool
+	expires time.Time
+}
+
+// expired reports whether the entry has a TTL that has passed at now.
+func (e terminologyCacheEntry) expired(now time.Time) bool {
+	return !e.expires.IsZero() && now.After(e.expires)
+}
+
+// NewMemoryTerminologyCache creates an in-memory cache. A ttl of 0 keeps entries forever.
+func NewMemoryTerminologyCache(ttl time.Duration) *MemoryTerminologyCache {
+	return &MemoryTerminologyCache{
+		ttl:     ttl,
+		entries: make(map[string]terminologyCacheEntry),
+		now:     time.Now,
+	}
+}
+
+// Get returns the cached validity of key and whether it was found and not expired.
+func (c *MemoryTerminologyCache) Get(key string) (valid, found bool) {
+	c.mu.RLock()
+	entry, ok := c.entries[key]
+	c.mu.RUnlock()
+
+	if !ok {
+		return false, false
+	}
+	if entry.expired(c.now()) {
+		c.mu.Lock()
+		// The entry may have been refreshed by Set since it was read
+		if current, ok := c.entries[key]; ok && current.expired(c.now()) {
+			delete(c.entries, key)
+		}
+		c.mu.Unlock()
+		return false, false
+	}
+	return entry.valid, true
+}
+
+// Set stores the validity of key.
+func (c *MemoryTerminologyCache) Set(key string, valid bool) {
+	now := c.now()
+	entry := terminologyCacheEntry{valid: valid}
+	if c.ttl > 0 {
+		entry.expires = now.Add(c.ttl)
+	}
+
+	c.mu.Lock()
+	c.entries[key] = entry
+	if c.ttl > 0 && !now.Before(c.nextSweep) {
+		c.sweep(now)
+	}
+	c.mu.Unlock()
+}
+
+// sweep drops the entries that have expired at now and schedules the next sweep.
+// Must be called with the write lock held.
+func (c *MemoryTerminologyCache) sweep(now time.Time) {
+	for key, entry := range c.entries {
+		if entry.expired(now) {
+			delete(c.entries, key)
+		}
+	}
+	c.nextSweep = now.Add(c.ttl)
+}
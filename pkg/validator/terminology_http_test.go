@@ -0,0 +1,214 @@
+package validator
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// newValidateCodeServer returns a test server answering $validate-code with result=true
+// for code "male" and counting the requests it receives.
+func newValidateCodeServer(t *testing.T, requests *int32) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(requests, 1)
+		if r.URL.Path != "/ValueSet/$validate-code" {
+			http.NotFound(w, r)
+			return
+		}
+		result := "false"
+		if r.URL.Query().Get("code") == "male" {
+			result = "true"
+		}
+		w.Header().Set("Content-Type", "application/fhir+json")
+		_, _ = w.Write([]byte(`{"resourceType": "Parameters", "parameter": [{"name": "result", "valueBoolean": ` + result + `}]}`))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+// mapTerminologyCache is a TerminologyCache used to check that custom caches are honored.
+type mapTerminologyCache struct {
+	mu      sync.Mutex
+	entries map[string]bool
+}
+
+func (c *mapTerminologyCache) Get(key string) (valid, found bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	valid, found = c.entries[key]
+	return valid, found
+}
+
+func (c *mapTerminologyCache) Set(key string, valid bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[key] = valid
+}
+
+func TestHTTPTerminologyServiceCache(t *testing.T) {
+	ctx := context.Background()
+	vsURL := "http://hl7.org/fhir/ValueSet/administrative-gender"
+
+	t.Run("default cache avoids repeated requests", func(t *testing.T) {
+		var requests int32
+		server := newValidateCodeServer(t, &requests)
+		svc := NewHTTPTerminologyService(server.URL, nil)
+
+		for i := 0; i < 3; i++ {
+			valid, err := svc.ValidateCode(ctx, "http://hl7.org/fhir/administrative-gender", "male", vsURL)
+			if err != nil {
+				t.Fatalf("ValidateCode() error = %v", err)
+			}
+			if !valid {
+				t.Error("expected male to be valid")
+			}
+		}
+		valid, err := svc.ValidateCode(ctx, "http://hl7.org/fhir/administrative-gender", "invalid", vsURL)
+		if err != nil {
+			t.Fatalf("ValidateCode() error = %v", err)
+		}
+		if valid {
+			t.Error("expected invalid code to be rejected")
+		}
+
+		if got := atomic.LoadInt32(&requests); got != 2 {
+			t.Errorf("server received %d requests, want 2", got)
+		}
+	})
+
+	t.Run("shared custom cache", func(t *testing.T) {
+		var requests int32
+		server := newValidateCodeServer(t, &requests)
+		cache := &mapTerminologyCache{entries: make(map[string]bool)}
+
+		first := NewHTTPTerminologyService(server.URL, cache)
+		second := NewHTTPTerminologyService(server.URL, cache)
+		for _, svc := range []*HTTPTerminologyService{first, second} {
+			if _, err := svc.ValidateCode(ctx, "", "male", vsURL); err != nil {
+				t.Fatalf("ValidateCode() error = %v", err)
+			}
+		}
+
+		if got := atomic.LoadInt32(&requests); got != 1 {
+			t.Errorf("server received %d requests, want 1", got)
+		}
+		if len(cache.entries) != 1 {
+			t.Errorf("cache has %d entries, want 1", len(cache.entries))
+		}
+	})
+
+	t.Run("server errors are not cached", func(t *testing.T) {
+		var requests int32
+		server := newValidateCodeServer(t, &requests)
+		svc := NewHTTPTerminologyService(server.URL+"/missing", nil)
+
+		for i := 0; i < 2; i++ {
+			if _, err := svc.ValidateCode(ctx, "", "male", vsURL); err == nil {
+				t.Error("expected error for unknown endpoint")
+			}
+		}
+		if got := atomic.LoadInt32(&requests); got != 2 {
+			t.Errorf("server received %d requests, want 2", got)
+		}
+	})
+}
+
+// testClock is a manually advanced clock for cache expiry tests.
+type testClock struct {
+	now time.Time
+}
+
+func (c *testClock) Now() time.Time { return c.now }
+
+func (c *testClock) Advance(d time.Duration) { c.now = c.now.Add(d) }
+
+func TestHTTPTerminologyServiceLookup(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path != "/CodeSystem/$lookup":
+			http.NotFound(w, r)
+		case r.URL.Query().Get("system") == "http://example.org/unknown":
+			http.NotFound(w, r)
+		case r.URL.Query().Get("code") == "fail":
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+		default:
+			w.Header().Set("Content-Type", "application/fhir+json")
+			_, _ = w.Write([]byte(`{"resourceType": "Parameters", "parameter": [{"name": "display", "valueString": "Male"}]}`))
+		}
+	}))
+	t.Cleanup(server.Close)
+	svc := NewHTTPTerminologyService(server.URL, nil)
+	ctx := context.Background()
+
+	if display, ok, err := svc.Lookup(ctx, "http://hl7.org/fhir/administrative-gender", "male"); err != nil || !ok || display != "Male" {
+		t.Errorf("Lookup(male) = %q, %v, %v; want Male", display, ok, err)
+	}
+	if _, ok, err := svc.Lookup(ctx, "http://example.org/unknown", "male"); err != nil || ok {
+		t.Errorf("Lookup(unknown system) = %v, %v; want not found", ok, err)
+	}
+	if _, _, err := svc.Lookup(ctx, "http://hl7.org/fhir/administrative-gender", "fail"); err == nil {
+		t.Error("Expected an error when the server fails")
+	}
+}
+
+func TestMemoryTerminologyCacheTTL(t *testing.T) {
+	clock := &testClock{now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+	cache := NewMemoryTerminologyCache(time.Minute)
+	cache.now = clock.Now
+	cache.Set("key", true)
+
+	if valid, found := cache.Get("key"); !found || !valid {
+		t.Errorf("Get() = %v, %v; want true, true", valid, found)
+	}
+
+	clock.Advance(time.Minute)
+	if _, found := cache.Get("key"); !found {
+		t.Error("expected entry to be kept until the TTL has passed")
+	}
+
+	clock.Advance(time.Second)
+	if _, found := cache.Get("key"); found {
+		t.Error("expected entry to expire after the TTL")
+	}
+	if _, ok := cache.entries["key"]; ok {
+		t.Error("expected the expired entry to be dropped")
+	}
+}
+
+func TestMemoryTerminologyCacheSweep(t *testing.T) {
+	clock := &testClock{now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+	cache := NewMemoryTerminologyCache(time.Minute)
+	cache.now = clock.Now
+
+	for _, key := range []string{"a", "b", "c"} {
+		cache.Set(key, true)
+	}
+
+	// Entries that are never read again are swept by a later Set
+	clock.Advance(2 * time.Minute)
+	cache.Set("d", false)
+	if len(cache.entries) != 1 {
+		t.Errorf("cache has %d entries after the sweep, want 1", len(cache.entries))
+	}
+	if valid, found := cache.Get("d"); !found || valid {
+		t.Errorf("Get(d) = %v, %v; want false, true", valid, found)
+	}
+}
+
+func TestMemoryTerminologyCacheNoTTL(t *testing.T) {
+	clock := &testClock{now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+	cache := NewMemoryTerminologyCache(0)
+	cache.now = clock.Now
+	cache.Set("key", true)
+
+	clock.Advance(24 * 365 * time.Hour)
+	cache.Set("other", true)
+	if valid, found := cache.Get("key"); !found || !valid {
+		t.Errorf("Get() = %v, %v; want true, true", valid, found)
+	}
+}
@@ -3,6 +3,9 @@ package validator
 
 import (
 	"context"
+	"os"
+	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -289,6 +292,89 @@ func TestLocalTerminologyServiceLookupCode(t *testing.T) {
 	if err == nil {
 		t.Error("Expected error for unknown system")
 	}
+
+	// Lookup reports unknown codes and systems as not found
+	if display, ok, err := svc.Lookup(ctx, "http://example.org/codes", "B"); err != nil || !ok || display != "Beta" {
+		t.Errorf("Lookup(B) = %q, %v, %v; want Beta", display, ok, err)
+	}
+	if _, ok, err := svc.Lookup(ctx, "http://example.org/codes", "Z"); err != nil || ok {
+		t.Errorf("Lookup(Z) = %v, %v; want not found", ok, err)
+	}
+	if _, ok, err := svc.Lookup(ctx, "http://example.org/unknown", "A"); err != nil || ok {
+		t.Errorf("Lookup(unknown system) = %v, %v; want not found", ok, err)
+	}
+}
+
+// TestLocalTerminologyServiceTranslate tests ConceptMap-based code translation.
+func TestLocalTerminologyServiceTranslate(t *testing.T) {
+	bundle := []byte(`{
+		"resourceType": "Bundle",
+		"entry": [
+			{
+				"resource": {
+					"resourceType": "ConceptMap",
+					"url": "http://example.org/ConceptMap/local-to-loinc",
+					"group": [{
+						"source": "http://example.org/local-labs",
+						"target": "http://loinc.org",
+						"element": [
+							{"code": "GLU", "target": [{"code": "2345-7", "display": "Glucose", "equivalence": "equivalent"}]},
+							{"code": "HB", "target": [{"code": "718-7", "equivalence": "wider"}, {"code": "0000-0", "equivalence": "disjoint"}]},
+							{"code": "OLD", "target": [{"equivalence": "unmatched"}]}
+						]
+					}]
+				}
+			}
+		]
+	}`)
+
+	svc := NewLocalTerminologyService()
+	if err := svc.LoadFromBundle(bundle); err != nil {
+		t.Fatalf("Failed to load bundle: %v", err)
+	}
+
+	ctx := context.Background()
+	mapURL := "http://example.org/ConceptMap/local-to-loinc"
+
+	codings, err := svc.Translate(ctx, "http://example.org/local-labs", "GLU", mapURL)
+	if err != nil {
+		t.Fatalf("Translate() error = %v", err)
+	}
+	want := []Coding{{System: "http://loinc.org", Code: "2345-7", Display: "Glucose"}}
+	if !reflect.DeepEqual(codings, want) {
+		t.Errorf("Translate(GLU) = %+v, want %+v", codings, want)
+	}
+
+	// Disjoint targets are not translations
+	codings, err = svc.Translate(ctx, "http://example.org/local-labs", "HB", "")
+	if err != nil {
+		t.Fatalf("Translate() error = %v", err)
+	}
+	if len(codings) != 1 || codings[0].Code != "718-7" {
+		t.Errorf("Translate(HB) = %+v, want only 718-7", codings)
+	}
+
+	// No mapping: empty result, no error
+	for _, code := range []string{"OLD", "UNKNOWN"} {
+		codings, err = svc.Translate(ctx, "http://example.org/local-labs", code, mapURL)
+		if err != nil {
+			t.Errorf("Translate(%s) error = %v, want nil", code, err)
+		}
+		if len(codings) != 0 {
+			t.Errorf("Translate(%s) = %+v, want no mapping", code, codings)
+		}
+	}
+
+	// Source system must match the group source
+	codings, _ = svc.Translate(ctx, "http://example.org/other", "GLU", mapURL)
+	if len(codings) != 0 {
+		t.Errorf("Expected no mapping for another source system, got %+v", codings)
+	}
+
+	// Unknown ConceptMap
+	if _, err := svc.Translate(ctx, "http://example.org/local-labs", "GLU", "http://example.org/ConceptMap/unknown"); err == nil {
+		t.Error("Expected error for unknown ConceptMap")
+	}
 }
 
 // TestLocalTerminologyServiceNestedConcepts tests hierarchical CodeSystems.
@@ -558,6 +644,152 @@ func TestTerminologyValidationIntegration(t *testing.T) {
 	}
 }
 
+// countingTerminologyService records the ValueSets it is asked to validate
+// codes against, and accepts only the code "known".
+type countingTerminologyService struct {
+	NoopTerminologyService
+	valueSets []string
+}
+
+func (s *countingTerminologyService) ValidateCode(_ context.Context, _, code, valueSetURL string) (bool, error) {
+	s.valueSets = append(s.valueSets, valueSetURL)
+	return code == "known", nil
+}
+
+func TestTerminologyCodeableReference(t *testing.T) {
+	sd := &StructureDef{
+		URL:         "http://hl7.org/fhir/StructureDefinition/MedicationRequest",
+		Name:        "MedicationRequest",
+		Type:        "MedicationRequest",
+		Kind:        "resource",
+		FHIRVersion: "5.0.0",
+		Snapshot: []ElementDef{
+			{Path: "MedicationRequest", Min: 0, Max: "*"},
+			{
+				Path:    "MedicationRequest.reason",
+				Max:     "*",
+				Types:   []TypeRef{{Code: "CodeableReference", TargetProfile: []string{"http://hl7.org/fhir/StructureDefinition/Condition"}}},
+				Binding: &ElementBinding{Strength: "required", ValueSet: "http://example.org/ValueSet/reasons"},
+			},
+		},
+	}
+	registry := &mockRegistry{sds: map[string]*StructureDef{"MedicationRequest": sd}}
+	ctx := context.Background()
+
+	service := &countingTerminologyService{}
+	v := NewValidator(registry, ValidatorOptions{ValidateTerminology: true}).WithTerminologyService(service)
+
+	result, err := v.Validate(ctx, []byte(`{"resourceType": "MedicationRequest", "reason": [
+		{"concept": {"coding": [{"system": "http://example.org/reasons", "code": "known"}]}},
+		{"reference": {"reference": "Condition/1"}}]}`))
+	if err != nil {
+		t.Fatalf("Validate() error = %v", err)
+	}
+	if !result.Valid {
+		t.Errorf("Expected valid request, got issues: %+v", result.Issues)
+	}
+	if len(service.valueSets) != 1 {
+		t.Errorf("Expected only the concept to be validated, got %v", service.valueSets)
+	}
+
+	result, err = v.Validate(ctx, []byte(`{"resourceType": "MedicationRequest", "reason": [
+		{"concept": {"coding": [{"system": "http://example.org/reasons", "code": "unknown"}]}}]}`))
+	if err != nil {
+		t.Fatalf("Validate() error = %v", err)
+	}
+	if result.Valid || len(result.Issues) != 1 || result.Issues[0].Expression[0] != "MedicationRequest.reason" {
+		t.Errorf("Expected one error for the reason concept, got issues: %+v", result.Issues)
+	}
+}
+
+func TestValidateCodingDisplay(t *testing.T) {
+	sd := &StructureDef{
+		URL:  "http://hl7.org/fhir/StructureDefinition/Observation",
+		Name: "Observation",
+		Type: "Observation",
+		Kind: "resource",
+		Snapshot: []ElementDef{
+			{Path: "Observation", Min: 0, Max: "*"},
+			{
+				Path:  "Observation.code",
+				Min:   0,
+				Max:   "1",
+				Types: []TypeRef{{Code: "CodeableConcept"}},
+				Binding: &ElementBinding{
+					Strength: "extensible",
+					ValueSet: "http://example.org/ValueSet/labs",
+				},
+			},
+		},
+	}
+	registry := NewRegistry(FHIRVersionR4)
+	if err := registry.Register(sd); err != nil {
+		t.Fatalf("Register() error = %v", err)
+	}
+
+	termService := NewLocalTerminologyService()
+	err := termService.LoadFromBundle([]byte(`{
+		"resourceType": "Bundle",
+		"entry": [
+			{
+				"resource": {
+					"resourceType": "CodeSystem",
+					"url": "http://example.org/labs",
+					"content": "complete",
+					"concept": [{"code": "GLU", "display": "Glucose"}]
+				}
+			},
+			{
+				"resource": {
+					"resourceType": "ValueSet",
+					"url": "http://example.org/ValueSet/labs",
+					"compose": {"include": [{"system": "http://example.org/labs"}, {"system": "http://example.org/unknown"}]}
+				}
+			}
+		]
+	}`))
+	if err != nil {
+		t.Fatalf("Failed to load terminology: %v", err)
+	}
+
+	tests := []struct {
+		name         string
+		coding       string
+		disabled     bool
+		wantWarnings int
+	}{
+		{"matching display", `{"system": "http://example.org/labs", "code": "GLU", "display": "Glucose"}`, false, 0},
+		{"display differs in case and spacing", `{"system": "http://example.org/labs", "code": "GLU", "display": " glucose "}`, false, 0},
+		{"mismatched display", `{"system": "http://example.org/labs", "code": "GLU", "display": "Sugar"}`, false, 1},
+		{"no display", `{"system": "http://example.org/labs", "code": "GLU"}`, false, 0},
+		{"unknown code system", `{"system": "http://example.org/unknown", "code": "X", "display": "Anything"}`, false, 0},
+		{"option disabled", `{"system": "http://example.org/labs", "code": "GLU", "display": "Sugar"}`, true, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := ValidatorOptions{ValidateTerminology: true, ValidateDisplay: !tt.disabled}
+			v := NewValidator(registry, opts).WithTerminologyService(termService)
+
+			resource := []byte(`{"resourceType": "Observation", "code": {"coding": [` + tt.coding + `]}}`)
+			result, err := v.Validate(context.Background(), resource)
+			if err != nil {
+				t.Fatalf("Validate() error = %v", err)
+			}
+
+			warnings := 0
+			for _, issue := range result.Issues {
+				if issue.Severity == SeverityWarning && strings.Contains(issue.Diagnostics, "does not match the CodeSystem display") {
+					warnings++
+				}
+			}
+			if warnings != tt.wantWarnings {
+				t.Errorf("got %d display warnings, want %d: %+v", warnings, tt.wantWarnings, result.Issues)
+			}
+		})
+	}
+}
+
 // mockRegistry is a simple mock for testing.
 type mockRegistry struct {
 	sds map[string]*StructureDef
@@ -800,3 +1032,118 @@ func TestValidatorOptionsTerminology(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateDeprecatedCode(t *testing.T) {
+	const vsURL = "http://example.org/ValueSet/observation-status"
+	sd := &StructureDef{
+		URL:  "http://hl7.org/fhir/StructureDefinition/Observation",
+		Name: "Observation",
+		Type: "Observation",
+		Kind: "resource",
+		Snapshot: []ElementDef{
+			{Path: "Observation", Min: 0, Max: "*"},
+			{
+				Path:    "Observation.status",
+				Min:     0,
+				Max:     "1",
+				Types:   []TypeRef{{Code: "code"}},
+				Binding: &ElementBinding{Strength: "required", ValueSet: vsURL},
+			},
+		},
+	}
+	registry := NewRegistry(FHIRVersionR4)
+	if err := registry.Register(sd); err != nil {
+		t.Fatalf("Register() error = %v", err)
+	}
+
+	// Register the tables as the generated terminology_embedded_*.go files do
+	const fhirVersion = "0.0.0-test"
+	registerEmbeddedValueSets(fhirVersion, map[string]map[string]bool{
+		vsURL: {"final": true, "preliminary": true, "old": true},
+	})
+	registerEmbeddedCodeStatus(fhirVersion, map[string]map[string]string{
+		vsURL: {"preliminary": "deprecated", "old": "retired"},
+	})
+	t.Cleanup(func() {
+		embeddedRegistryMu.Lock()
+		defer embeddedRegistryMu.Unlock()
+		delete(embeddedValueSetRegistry, fhirVersion)
+		delete(embeddedCodeStatusRegistry, fhirVersion)
+	})
+	termService, err := NewEmbeddedTerminologyService(fhirVersion)
+	if err != nil {
+		t.Fatalf("NewEmbeddedTerminologyService() error = %v", err)
+	}
+
+	tests := []struct {
+		name         string
+		status       string
+		wantErrors   int
+		wantWarnings int
+	}{
+		{"active code", "final", 0, 0},
+		{"deprecated code", "preliminary", 0, 1},
+		{"retired code", "old", 0, 1},
+		{"unknown code", "unknown", 1, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := NewValidator(registry, ValidatorOptions{ValidateTerminology: true}).WithTerminologyService(termService)
+
+			resource := []byte(`{"resourceType": "Observation", "status": "` + tt.status + `"}`)
+			result, err := v.Validate(context.Background(), resource)
+			if err != nil {
+				t.Fatalf("Validate() error = %v", err)
+			}
+
+			if got := result.ErrorCount(); got != tt.wantErrors {
+				t.Errorf("ErrorCount() = %d, want %d: %v", got, tt.wantErrors, result.Issues)
+			}
+			if got := result.WarningCount(); got != tt.wantWarnings {
+				t.Errorf("WarningCount() = %d, want %d: %v", got, tt.wantWarnings, result.Issues)
+			}
+			for _, issue := range result.Issues {
+				if issue.Severity == SeverityWarning && issue.Code != IssueCodeBusinessRule {
+					t.Errorf("Expected business-rule warning, got %s", issue.Code)
+				}
+			}
+		})
+	}
+
+	t.Run("expansion includes status", func(t *testing.T) {
+		codes, err := termService.ExpandValueSet(context.Background(), vsURL)
+		if err != nil {
+			t.Fatalf("ExpandValueSet() error = %v", err)
+		}
+		for _, c := range codes {
+			if c.Code == "preliminary" && c.Status != "deprecated" {
+				t.Errorf("Status of preliminary = %q, want deprecated", c.Status)
+			}
+		}
+	})
+}
+
+// TestEmbeddedCodeStatusPopulated guards against shipping the embedded terminology
+// without the deprecated/retired code status data of the spec's code systems.
+func TestEmbeddedCodeStatusPopulated(t *testing.T) {
+	tests := []struct {
+		version    string
+		codeStatus map[string]map[string]string
+	}{
+		{"r4", embeddedCodeStatusR4},
+		{"r4b", embeddedCodeStatusR4B},
+		{"r5", embeddedCodeStatusR5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.version, func(t *testing.T) {
+			if _, err := os.Stat("../../specs/" + tt.version + "/valuesets.json"); err != nil {
+				t.Skipf("Skipping test - could not load specs: %v", err)
+			}
+			if len(tt.codeStatus) == 0 {
+				t.Errorf("embedded code status for %s is empty; regenerate with go run ./cmd/gen-terminology -version %s", tt.version, tt.version)
+			}
+		})
+	}
+}
@@ -4,12 +4,17 @@ package validator
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"regexp"
 	"strings"
 	"sync"
+	"unicode"
+	"unicode/utf8"
 
+	"github.com/robertoaraneda/gofhir/pkg/common"
 	"github.com/robertoaraneda/gofhir/pkg/fhirpath"
+	"github.com/robertoaraneda/gofhir/pkg/fhirpath/eval"
 	"github.com/robertoaraneda/gofhir/pkg/fhirpath/types"
 )
 
@@ -111,6 +116,10 @@ type Validator struct {
 	refResolver ReferenceResolver
 	// exprCache caches compiled FHIRPath expressions
 	exprCache *expressionCache
+	// profiles caches resolved StructureDefinitions and their element indexes
+	profiles *profileCache
+	// resourceValidators holds custom validators by resource type
+	resourceValidators map[string][]ResourceValidatorFunc
 }
 
 // expressionCache is a simple thread-safe cache for compiled FHIRPath expressions.
@@ -147,6 +156,78 @@ func (c *expressionCache) set(expr string, compiled *fhirpath.Expression) {
 	c.cache[expr] = compiled
 }
 
+// generationProvider is implemented by StructureDefinitionProviders whose
+// definitions can change after the validator is created. The generation changes
+// whenever definitions are added or reloaded.
+type generationProvider interface {
+	Generation() uint64
+}
+
+// profileCache is a thread-safe cache of resolved StructureDefinitions and their
+// element indexes. It is emptied when the provider reports a new generation.
+type profileCache struct {
+	mu         sync.RWMutex
+	generation uint64
+	defs       map[string]*StructureDef
+	indexes    map[*StructureDef]elementIndex
+}
+
+// newProfileCache creates an empty profile cache.
+func newProfileCache() *profileCache {
+	return &profileCache{
+		defs:    make(map[string]*StructureDef),
+		indexes: make(map[*StructureDef]elementIndex),
+	}
+}
+
+// get retrieves a StructureDefinition cached under key for the given generation.
+func (c *profileCache) get(generation uint64, key string) (*StructureDef, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if c.generation != generation {
+		return nil, false
+	}
+	sd, ok := c.defs[key]
+	return sd, ok
+}
+
+// set stores a StructureDefinition under key.
+func (c *profileCache) set(generation uint64, key string, sd *StructureDef) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.reset(generation)
+	c.defs[key] = sd
+}
+
+// index retrieves the element index of sd, building it with build on a miss.
+func (c *profileCache) index(generation uint64, sd *StructureDef, build func(*StructureDef) elementIndex) elementIndex {
+	c.mu.RLock()
+	index, ok := c.indexes[sd]
+	current := c.generation == generation
+	c.mu.RUnlock()
+	if ok && current {
+		return index
+	}
+
+	index = build(sd)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.reset(generation)
+	c.indexes[sd] = index
+	return index
+}
+
+// reset drops all entries if generation differs from the cached one.
+// Must be called with c.mu held for writing.
+func (c *profileCache) reset(generation uint64) {
+	if c.generation == generation {
+		return
+	}
+	c.generation = generation
+	c.defs = make(map[string]*StructureDef)
+	c.indexes = make(map[*StructureDef]elementIndex)
+}
+
 // validationContext holds parsed data to avoid re-parsing JSON multiple times.
 type validationContext struct {
 	raw          []byte
@@ -183,14 +264,36 @@ type ValidatorOptions struct {
 	// Only used when ValidateTerminology is true.
 	// If not set (TerminologyNone), defaults to TerminologyEmbeddedR4 when ValidateTerminology is true.
 	TerminologyService TerminologyServiceType
+	// ValidateDisplay warns when a Coding's display differs from the display
+	// defined by its CodeSystem (only checked when the CodeSystem is known)
+	ValidateDisplay bool
 	// ValidateReferences enables reference validation
 	ValidateReferences bool
 	// ValidateExtensions enables extension validation
 	ValidateExtensions bool
+	// ValidateIdentifiers warns about Identifiers without a system or value and
+	// about an Identifier.assigner that does not reference an Organization
+	ValidateIdentifiers bool
+	// ValidateNarrative enables XHTML validation of Narrative.div
+	// (well-formedness, namespace and absence of active content)
+	ValidateNarrative bool
+	// StrictJSON reports duplicate JSON object keys, which FHIR forbids
+	// but encoding/json silently accepts (keeping the last value)
+	StrictJSON bool
+	// ValidateBestPractices enables best-practice checks reported as warnings
+	// (e.g., a searchset Bundle.total lower than its number of match entries, or
+	// Observation reference ranges in units incompatible with the value)
+	ValidateBestPractices bool
+	// ReportMustSupport reports the must-support elements and extensions of the
+	// profile that are not populated, as informational issues
+	ReportMustSupport bool
 	// SkipContainedValidation skips validation of contained resources.
 	// Useful when contained resources may be from a different FHIR version
 	// (e.g., R4 fixtures in an R5 TestScript).
 	SkipContainedValidation bool
+	// TrackSourcePositions sets the LineColumn of issues to the line and column
+	// of the offending value in the JSON source, for editor integrations
+	TrackSourcePositions bool
 	// StrictMode treats warnings as errors
 	StrictMode bool
 	// MaxErrors stops validation after this many errors (0 = unlimited)
@@ -219,6 +322,7 @@ func NewValidator(registry StructureDefinitionProvider, opts ValidatorOptions) *
 		termService: &NoopTerminologyService{},
 		refResolver: &NoopReferenceResolver{},
 		exprCache:   newExpressionCache(1000), // Cache up to 1000 expressions
+		profiles:    newProfileCache(),
 	}
 
 	// Auto-configure terminology service based on options
@@ -256,29 +360,41 @@ func (v *Validator) WithReferenceResolver(rr ReferenceResolver) *Validator {
 	return v
 }
 
-// Validate validates a FHIR resource (as JSON) against its StructureDefinition.
-func (v *Validator) Validate(ctx context.Context, resource []byte) (*ValidationResult, error) {
-	result := NewValidationResult()
+// RegisterResourceValidator adds a custom validator for a resource type.
+// Custom validators run after the standard validation passes, in registration
+// order, for the validated resource and for resources in Bundle entries.
+// Register validators before the Validator is used concurrently.
+func (v *Validator) RegisterResourceValidator(resourceType string, fn ResourceValidatorFunc) *Validator {
+	if v.resourceValidators == nil {
+		v.resourceValidators = make(map[string][]ResourceValidatorFunc)
+	}
+	v.resourceValidators[resourceType] = append(v.resourceValidators[resourceType], fn)
+	return v
+}
 
-	// Parse the resource once - reuse throughout validation
-	var parsed map[string]any
-	if err := json.Unmarshal(resource, &parsed); err != nil {
-		result.AddIssue(ValidationIssue{
-			Severity:    SeverityFatal,
-			Code:        IssueCodeStructure,
-			Diagnostics: fmt.Sprintf("Invalid JSON: %v", err),
-		})
-		return result, nil
+// runResourceValidators invokes the custom validators registered for the
+// resource in vctx, located at path.
+func (v *Validator) runResourceValidators(ctx context.Context, vctx *validationContext, path string, result *ValidationResult) {
+	validators := v.resourceValidators[vctx.resourceType]
+	if len(validators) == 0 {
+		return
+	}
+	resource := &ResourceContext{
+		ResourceType:        vctx.resourceType,
+		Path:                path,
+		Resource:            vctx.parsed,
+		StructureDefinition: vctx.sd,
 	}
+	for _, fn := range validators {
+		fn(ctx, resource, result)
+	}
+}
 
-	resourceType, ok := parsed[resourceTypeKey].(string)
-	if !ok || resourceType == "" {
-		result.AddIssue(ValidationIssue{
-			Severity:    SeverityFatal,
-			Code:        IssueCodeRequired,
-			Diagnostics: "Resource must have a resourceType",
-			Expression:  []string{"resourceType"},
-		})
+// Validate validates a FHIR resource (as JSON) against its StructureDefinition.
+func (v *Validator) Validate(ctx context.Context, resource []byte) (*ValidationResult, error) {
+	result := NewValidationResult()
+	parsed, resourceType, ok := v.parseResource(resource, result)
+	if !ok {
 		return result, nil
 	}
 
@@ -288,7 +404,7 @@ func (v *Validator) Validate(ctx context.Context, resource []byte) (*ValidationR
 
 	if v.options.Profile != "" {
 		// Validate against specific profile
-		sd, err = v.registry.Get(ctx, v.options.Profile)
+		sd, err = v.structureDefByURL(ctx, v.options.Profile)
 		if err != nil {
 			result.AddIssue(ValidationIssue{
 				Severity:    SeverityFatal,
@@ -299,7 +415,7 @@ func (v *Validator) Validate(ctx context.Context, resource []byte) (*ValidationR
 		}
 	} else {
 		// Validate against base resource type
-		sd, err = v.registry.GetByType(ctx, resourceType)
+		sd, err = v.structureDefByType(ctx, resourceType)
 		if err != nil {
 			result.AddIssue(ValidationIssue{
 				Severity:    SeverityFatal,
@@ -310,9 +426,77 @@ func (v *Validator) Validate(ctx context.Context, resource []byte) (*ValidationR
 		}
 	}
 
-	// Build element index for faster lookup
-	elemIndex := v.buildElementIndex(sd)
+	v.validateWithDef(ctx, resource, parsed, resourceType, sd, v.elementIndexFor(sd), result)
+	result.sortIssues()
+	if v.options.TrackSourcePositions {
+		annotateSourcePositions(resource, resourceType, result)
+	}
+	return result, nil
+}
+
+// ValidateAgainst validates a FHIR resource (as JSON) against sd instead of
+// a StructureDefinition of the registry, so callers can validate against
+// ad-hoc profiles, such as the ones contained in a TestScript or an
+// implementation guide. ValidatorOptions.Profile is ignored; the registry
+// still provides the definitions of the data types and extensions that sd uses.
+// A profile published with only a differential gets its snapshot generated
+// from its baseDefinition, which must be in the registry.
+func (v *Validator) ValidateAgainst(ctx context.Context, resource []byte, sd *StructureDef) (*ValidationResult, error) {
+	if sd == nil {
+		return nil, errors.New("no StructureDefinition to validate against")
+	}
+	if len(sd.Snapshot) == 0 {
+		resolved, err := v.adHocSnapshot(ctx, sd)
+		if err != nil {
+			return nil, err
+		}
+		sd = resolved
+	}
+
+	result := NewValidationResult()
+	parsed, resourceType, ok := v.parseResource(resource, result)
+	if !ok {
+		return result, nil
+	}
+
+	if sd.Type != "" && sd.Type != resourceType {
+		result.AddIssue(ValidationIssue{
+			Severity:    SeverityFatal,
+			Code:        IssueCodeInvalid,
+			Diagnostics: fmt.Sprintf("Resource type %s does not match StructureDefinition %s of type %s", resourceType, sd.URL, sd.Type),
+			Expression:  []string{resourceType},
+		})
+		return result, nil
+	}
+
+	// Ad-hoc definitions are not cached, as they are not owned by the registry
+	v.validateWithDef(ctx, resource, parsed, resourceType, sd, buildElementIndex(sd), result)
+	result.sortIssues()
+	if v.options.TrackSourcePositions {
+		annotateSourcePositions(resource, resourceType, result)
+	}
+	return result, nil
+}
 
+// adHocSnapshot generates the snapshot of a differential-only profile passed to
+// ValidateAgainst from its baseDefinition, resolved through the registry. The
+// result is not cached, as sd is not owned by the registry.
+func (v *Validator) adHocSnapshot(ctx context.Context, sd *StructureDef) (*StructureDef, error) {
+	if sd.BaseDefinition == "" {
+		return nil, fmt.Errorf("StructureDefinition %s has neither a snapshot nor a baseDefinition", sd.URL)
+	}
+	base, err := v.registry.Get(ctx, sd.BaseDefinition)
+	if err != nil {
+		return nil, fmt.Errorf("StructureDefinition %s has no snapshot: %w", sd.URL, err)
+	}
+	if len(base.Snapshot) == 0 {
+		return nil, fmt.Errorf("StructureDefinition %s has no snapshot: base %s has none either", sd.URL, sd.BaseDefinition)
+	}
+	return withBaseSnapshot(sd, base), nil
+}
+
+// validateWithDef validates a parsed resource against sd, whose elements are indexed by elemIndex.
+func (v *Validator) validateWithDef(ctx context.Context, resource []byte, parsed map[string]any, resourceType string, sd *StructureDef, elemIndex elementIndex, result *ValidationResult) {
 	// Create validation context to pass parsed data (avoids re-parsing)
 	vctx := &validationContext{
 		raw:          resource,
@@ -327,7 +511,7 @@ func (v *Validator) Validate(ctx context.Context, resource []byte) (*ValidationR
 
 	// Check max errors
 	if v.options.MaxErrors > 0 && result.ErrorCount() >= v.options.MaxErrors {
-		return result, nil
+		return
 	}
 
 	// Validate primitive types
@@ -337,9 +521,10 @@ func (v *Validator) Validate(ctx context.Context, resource []byte) (*ValidationR
 	// This is a fundamental constraint that applies to ALL elements
 	v.validateEle1(ctx, vctx, result)
 
-	// Validate constraints (FHIRPath)
+	// Validate constraints (FHIRPath), and the contained resource invariants
 	if v.options.ValidateConstraints {
 		v.validateConstraints(ctx, vctx, result)
+		v.validateContainedRules(ctx, vctx, resourceType, result)
 	}
 
 	// Validate terminology bindings
@@ -357,12 +542,84 @@ func (v *Validator) Validate(ctx context.Context, resource []byte) (*ValidationR
 		v.validateExtensions(ctx, vctx, result)
 	}
 
+	// Validate identifiers
+	if v.options.ValidateIdentifiers {
+		v.validateIdentifiers(ctx, vctx, resourceType, result)
+	}
+
+	// Best-practice checks of Observation reference ranges
+	if v.options.ValidateBestPractices && resourceType == resourceTypeObservation {
+		v.validateReferenceRangeUnits(parsed, resourceType, result)
+	}
+
+	// Validate narrative XHTML
+	if v.options.ValidateNarrative {
+		v.validateNarrative(ctx, vctx, result)
+	}
+
+	// Report unpopulated must-support elements and extensions
+	if v.options.ReportMustSupport {
+		v.reportMustSupport(vctx, result)
+	}
+
 	// Bundle-specific validation
 	if resourceType == "Bundle" {
 		v.validateBundle(ctx, vctx, result)
 	}
 
-	return result, nil
+	// Parameters-specific validation of nested parts
+	if resourceType == resourceTypeParameters {
+		v.validateParameters(vctx, result)
+	}
+
+	// Custom validators
+	v.runResourceValidators(ctx, vctx, resourceType, result)
+}
+
+// parseResource parses a FHIR resource (as JSON) and returns it with its
+// resourceType. It reports false, with a fatal issue, if the resource cannot
+// be validated.
+func (v *Validator) parseResource(resource []byte, result *ValidationResult) (map[string]any, string, bool) {
+	// encoding/json replaces invalid UTF-8 with U+FFFD, so check the raw bytes
+	if !utf8.Valid(resource) {
+		result.AddIssue(ValidationIssue{
+			Severity:    SeverityError,
+			Code:        IssueCodeStructure,
+			Diagnostics: "Resource contains invalid UTF-8; FHIR JSON must be UTF-8 encoded",
+		})
+	}
+
+	// Parse the resource once - reuse throughout validation
+	var parsed map[string]any
+	if err := json.Unmarshal(resource, &parsed); err != nil {
+		issue := ValidationIssue{
+			Severity:    SeverityFatal,
+			Code:        IssueCodeStructure,
+			Diagnostics: fmt.Sprintf("Invalid JSON: %v", err),
+		}
+		if v.options.TrackSourcePositions {
+			issue.LineColumn, _ = syntaxErrorPosition(resource, err)
+		}
+		result.AddIssue(issue)
+		return nil, "", false
+	}
+
+	resourceType, ok := parsed[resourceTypeKey].(string)
+	if !ok || resourceType == "" {
+		result.AddIssue(ValidationIssue{
+			Severity:    SeverityFatal,
+			Code:        IssueCodeRequired,
+			Diagnostics: "Resource must have a resourceType",
+			Expression:  []string{"resourceType"},
+		})
+		return nil, "", false
+	}
+
+	if v.options.StrictJSON {
+		v.validateDuplicateKeys(resource, resourceType, result)
+	}
+
+	return parsed, resourceType, true
 }
 
 // ValidateResource validates a parsed resource map.
@@ -380,19 +637,114 @@ func (v *Validator) ValidateResource(ctx context.Context, resource map[string]in
 	return v.Validate(ctx, data)
 }
 
+// structureDefByURL returns the StructureDefinition with the given canonical URL,
+// using the validator's profile cache.
+func (v *Validator) structureDefByURL(ctx context.Context, url string) (*StructureDef, error) {
+	return v.cachedStructureDef("url|"+url, func() (*StructureDef, error) {
+		return v.registry.Get(ctx, url)
+	})
+}
+
+// structureDefByType returns the base StructureDefinition of a resource type,
+// using the validator's profile cache.
+func (v *Validator) structureDefByType(ctx context.Context, resourceType string) (*StructureDef, error) {
+	return v.cachedStructureDef("type|"+resourceType, func() (*StructureDef, error) {
+		return v.registry.GetByType(ctx, resourceType)
+	})
+}
+
+// cachedStructureDef returns the StructureDefinition cached under key, calling
+// lookup on a miss. Lookup errors are not cached. Providers that do not report a
+// generation are not cached, as the validator cannot tell when they change.
+func (v *Validator) cachedStructureDef(key string, lookup func() (*StructureDef, error)) (*StructureDef, error) {
+	generation, ok := v.registryGeneration()
+	if !ok {
+		return lookup()
+	}
+	if sd, ok := v.profiles.get(generation, key); ok {
+		return sd, nil
+	}
+	sd, err := lookup()
+	if err != nil {
+		return nil, err
+	}
+	v.profiles.set(generation, key, sd)
+	return sd, nil
+}
+
+// elementIndexFor returns the element index of sd, building it once per StructureDefinition
+// of providers that report a generation, and on every call otherwise.
+func (v *Validator) elementIndexFor(sd *StructureDef) elementIndex {
+	generation, ok := v.registryGeneration()
+	if !ok {
+		return buildElementIndex(sd)
+	}
+	return v.profiles.index(generation, sd, buildElementIndex)
+}
+
+// registryGeneration returns the generation of the registry, and false for
+// providers that do not report one.
+func (v *Validator) registryGeneration() (uint64, bool) {
+	if p, ok := v.registry.(generationProvider); ok {
+		return p.Generation(), true
+	}
+	return 0, false
+}
+
 // elementIndex maps element path to ElementDef for quick lookup.
 type elementIndex map[string]*ElementDef
 
 // buildElementIndex creates an index of elements by path.
-func (v *Validator) buildElementIndex(sd *StructureDef) elementIndex {
+// Slices share the path of the sliced element and are skipped so that the
+// index always holds the base definition.
+func buildElementIndex(sd *StructureDef) elementIndex {
 	index := make(elementIndex)
 	for i := range sd.Snapshot {
 		elem := &sd.Snapshot[i]
+		if isSliceElement(elem) {
+			continue
+		}
 		index[elem.Path] = elem
 	}
 	return index
 }
 
+// lookup returns the element at path, resolving typed choice names against
+// their choice element (e.g., "Patient.deceasedBoolean" -> "Patient.deceased[x]").
+// A choice element is returned as a copy at path restricted to the named type.
+func (index elementIndex) lookup(path string) *ElementDef {
+	if elem, ok := index[path]; ok {
+		return elem
+	}
+
+	// Uses package-level choiceSuffixes to avoid allocation
+	dot := strings.LastIndexByte(path, '.')
+	if dot < 0 {
+		return nil
+	}
+	lastPart := path[dot+1:]
+	for _, suffix := range choiceSuffixes {
+		baseName, ok := strings.CutSuffix(lastPart, suffix)
+		if !ok {
+			continue
+		}
+		if elem, ok := index[path[:dot+1]+baseName+"[x]"]; ok {
+			// Convert suffix to lowercase for type code (e.g., "DateTime" -> "dateTime")
+			choiceElem := *elem
+			choiceElem.Path = path
+			choiceElem.Types = []TypeRef{{Code: strings.ToLower(suffix[:1]) + suffix[1:]}}
+			return &choiceElem
+		}
+	}
+	return nil
+}
+
+// isSliceElement reports whether elem is a slice or an element inside a slice
+// (e.g., "Patient.extension:race" or "Patient.extension:race.url").
+func isSliceElement(elem *ElementDef) bool {
+	return elem.SliceName != "" || strings.Contains(elem.ID, ":")
+}
+
 // validateStructure validates cardinality and required fields.
 func (v *Validator) validateStructure(ctx context.Context, vctx *validationContext, result *ValidationResult) {
 	// Track which required elements are present
@@ -401,23 +753,25 @@ func (v *Validator) validateStructure(ctx context.Context, vctx *validationConte
 	// Recursively validate the resource structure
 	v.validateNode(ctx, vctx.parsed, vctx.sd, vctx.index, vctx.resourceType, "", presentElements, result)
 
-	// Check for missing required elements
-	for _, elem := range vctx.sd.Snapshot {
-		if elem.Min > 0 {
-			// Element is required
-			if !presentElements[elem.Path] {
-				// Only report if parent exists (direct child of resource or child of present element)
-				parentPath := getParentPath(elem.Path)
-				if parentPath == vctx.resourceType || presentElements[parentPath] {
-					// Check if this is a choice element that might be satisfied by another choice
-					if !v.isChoiceElementSatisfied(elem.Path, presentElements) {
-						result.AddIssue(ValidationIssue{
-							Severity:    SeverityError,
-							Code:        IssueCodeRequired,
-							Diagnostics: fmt.Sprintf("Missing required element: %s (min=%d)", elem.Path, elem.Min),
-							Expression:  []string{elem.Path},
-						})
-					}
+	// FHIR JSON forbids empty arrays and only allows nulls to align primitive arrays
+	v.checkArrays(vctx.parsed, vctx.resourceType, result)
+
+	// Check for missing required elements. Slice cardinality is checked per
+	// slice (see validateExtensionSlices)
+	for _, elem := range vctx.sd.RequiredElements() {
+		if !presentElements[elem.Path] {
+			// Only report if parent exists (direct child of resource or child of present element)
+			parentPath := getParentPath(elem.Path)
+			if parentPath == vctx.resourceType || presentElements[parentPath] {
+				// Check if this is a choice element that might be satisfied by another choice
+				if !v.isChoiceElementSatisfied(elem.Path, presentElements) {
+					result.AddIssue(ValidationIssue{
+						Severity:    SeverityError,
+						Code:        IssueCodeRequired,
+						Diagnostics: fmt.Sprintf("Missing required element: %s (min=%d)", elem.Path, elem.Min),
+						Expression:  []string{elem.Path},
+						Details:     NewIssueDetails(DetailsCardinalityMin),
+					})
 				}
 			}
 		}
@@ -467,6 +821,7 @@ func (v *Validator) validateNode(ctx context.Context, node interface{}, sd *Stru
 				Code:        IssueCodeStructure,
 				Diagnostics: fmt.Sprintf("Unknown element: %s", childPath),
 				Expression:  []string{childPath},
+				Details:     NewIssueDetails(DetailsUnknownElement),
 			})
 			continue
 		}
@@ -496,6 +851,90 @@ func (v *Validator) validateNode(ctx context.Context, node interface{}, sd *Stru
 	}
 }
 
+// checkArrays recursively reports invalid JSON arrays.
+//
+// Absent elements must be omitted rather than represented as [] (including
+// primitive extension arrays such as "_given"), so an empty array is a structural
+// error even for elements with min=0. A null item is only allowed in a primitive
+// array or its "_" sibling, to keep both aligned, and only when the sibling has a
+// value at the same index. For the same reason both arrays must have the same length.
+func (v *Validator) checkArrays(node interface{}, path string, result *ValidationResult) {
+	switch val := node.(type) {
+	case map[string]interface{}:
+		for key, child := range val {
+			if key == resourceTypeKey {
+				continue
+			}
+			childPath := buildElementPath(path, key)
+			if items, ok := child.([]interface{}); ok {
+				v.checkArrayNulls(val, key, items, childPath, result)
+				v.checkArrayPairLength(val, key, items, childPath, result)
+			}
+			v.checkArrays(child, childPath, result)
+		}
+	case []interface{}:
+		if len(val) == 0 {
+			result.AddIssue(ValidationIssue{
+				Severity:    SeverityError,
+				Code:        IssueCodeStructure,
+				Diagnostics: fmt.Sprintf("Array '%s' is empty; FHIR JSON requires absent elements to be omitted", path),
+				Expression:  []string{path},
+			})
+			return
+		}
+		for i, item := range val {
+			v.checkArrays(item, fmt.Sprintf("%s[%d]", path, i), result)
+		}
+	}
+}
+
+// checkArrayPairLength reports a primitive array whose "_" sibling array has a
+// different length (given/_given). Only called for the primitive array's key, so
+// each mismatch is reported once.
+func (v *Validator) checkArrayPairLength(parent map[string]interface{}, key string, items []interface{}, path string, result *ValidationResult) {
+	if strings.HasPrefix(key, "_") {
+		return
+	}
+	siblingKey := "_" + key
+	sibling, ok := parent[siblingKey].([]interface{})
+	if !ok || len(sibling) == len(items) {
+		return
+	}
+	result.AddIssue(ValidationIssue{
+		Severity: SeverityError,
+		Code:     IssueCodeStructure,
+		Diagnostics: fmt.Sprintf("Array '%s' has %d items but '%s' has %d; primitive arrays and their extensions must be aligned",
+			path, len(items), buildElementPath(getParentPath(path), siblingKey), len(sibling)),
+		Expression: []string{path},
+	})
+}
+
+// checkArrayNulls reports the null items of the array property key of parent
+// that are not matched by a non-null item of its sibling (given/_given).
+func (v *Validator) checkArrayNulls(parent map[string]interface{}, key string, items []interface{}, path string, result *ValidationResult) {
+	siblingKey := "_" + key
+	if strings.HasPrefix(key, "_") {
+		siblingKey = strings.TrimPrefix(key, "_")
+	}
+	sibling, _ := parent[siblingKey].([]interface{})
+
+	for i, item := range items {
+		if item != nil {
+			continue
+		}
+		if i < len(sibling) && sibling[i] != nil {
+			continue
+		}
+		result.AddIssue(ValidationIssue{
+			Severity: SeverityError,
+			Code:     IssueCodeStructure,
+			Diagnostics: fmt.Sprintf("Array '%s' has a null at index %d; nulls are only allowed to align a primitive array with a value in '%s[%d]'",
+				path, i, buildElementPath(getParentPath(path), siblingKey), i),
+			Expression: []string{fmt.Sprintf("%s[%d]", path, i)},
+		})
+	}
+}
+
 // hasResourceType checks if an ElementDef allows type "Resource".
 // This indicates the element can contain any FHIR resource (e.g., contained resources).
 func (v *Validator) hasResourceType(elemDef *ElementDef) bool {
@@ -543,7 +982,7 @@ func (v *Validator) validateContainedResources(ctx context.Context, child interf
 		}
 
 		// Get the StructureDefinition for this resource type
-		containedSD, err := v.registry.GetByType(ctx, resourceType)
+		containedSD, err := v.structureDefByType(ctx, resourceType)
 		if err != nil {
 			result.AddIssue(ValidationIssue{
 				Severity:    SeverityError,
@@ -555,7 +994,7 @@ func (v *Validator) validateContainedResources(ctx context.Context, child interf
 		}
 
 		// Build element index for the contained resource's StructureDefinition
-		containedIndex := v.buildElementIndex(containedSD)
+		containedIndex := v.elementIndexFor(containedSD)
 
 		// Validate the contained resource against its own StructureDefinition
 		// Use the contained resource's type as basePath and reset currentPath
@@ -570,48 +1009,24 @@ func (v *Validator) findElementDef(index elementIndex, path, _ string) *ElementD
 
 // findElementDefWithContext finds the ElementDef for a path, with context for loading complex type definitions.
 func (v *Validator) findElementDefWithContext(ctx context.Context, index elementIndex, path string) *ElementDef {
-	// Direct match
-	if elem, ok := index[path]; ok {
+	// Direct match or choice type (e.g., "Patient.deceasedBoolean" -> "Patient.deceased[x]")
+	if elem := index.lookup(path); elem != nil {
 		return elem
 	}
 
-	parts := strings.Split(path, ".")
-
-	// Try choice type (e.g., "Patient.deceasedBoolean" -> "Patient.deceased[x]")
-	// Uses package-level choiceSuffixes to avoid allocation
-	if len(parts) >= 2 {
-		lastPart := parts[len(parts)-1]
-		for _, suffix := range choiceSuffixes {
-			if strings.HasSuffix(lastPart, suffix) {
-				// Try to find the [x] version
-				baseName := strings.TrimSuffix(lastPart, suffix)
-				choicePath := strings.Join(parts[:len(parts)-1], ".") + "." + baseName + "[x]"
-				if elem, ok := index[choicePath]; ok {
-					// Return a modified ElementDef with the correct type based on suffix
-					// Convert suffix to lowercase for type code (e.g., "DateTime" -> "dateTime")
-					typeCode := strings.ToLower(suffix[:1]) + suffix[1:]
-					return &ElementDef{
-						ID:          elem.ID,
-						Path:        path,
-						SliceName:   elem.SliceName,
-						Min:         elem.Min,
-						Max:         elem.Max,
-						Types:       []TypeRef{{Code: typeCode}},
-						Binding:     elem.Binding,
-						Constraints: elem.Constraints,
-						Fixed:       elem.Fixed,
-						Pattern:     elem.Pattern,
-						Short:       elem.Short,
-						Definition:  elem.Definition,
-						MustSupport: elem.MustSupport,
-						IsModifier:  elem.IsModifier,
-						IsSummary:   elem.IsSummary,
-					}
-				}
-			}
+	// Parameters.parameter.part reuses the definition of Parameters.parameter
+	if mapped := parametersElementPath(path); mapped != path {
+		elem := v.findElementDefWithContext(ctx, index, mapped)
+		if elem == nil {
+			return nil
 		}
+		partElem := *elem
+		partElem.Path = path
+		return &partElem
 	}
 
+	parts := strings.Split(path, ".")
+
 	// For nested elements of complex types (e.g., Patient.name.family or Observation.code.coding.system),
 	// check if any ancestor is a complex type and look up the element in the type's StructureDefinition.
 	if len(parts) >= 3 {
@@ -685,7 +1100,7 @@ func (v *Validator) findElementInComplexType(ctx context.Context, typeCode strin
 
 	// Try to load the type's StructureDefinition
 	typeDef, err := v.registry.Get(ctx, typeURL)
-	if err != nil {
+	if err != nil || typeDef == nil {
 		return nil
 	}
 
@@ -708,6 +1123,9 @@ func (v *Validator) findElementInComplexType(ctx context.Context, typeCode strin
 				Constraints: elem.Constraints,
 				Fixed:       elem.Fixed,
 				Pattern:     elem.Pattern,
+				MaxLength:   elem.MaxLength,
+				MinValue:    elem.MinValue,
+				MaxValue:    elem.MaxValue,
 				Short:       elem.Short,
 				Definition:  elem.Definition,
 				MustSupport: elem.MustSupport,
@@ -746,6 +1164,9 @@ func (v *Validator) findElementInComplexType(ctx context.Context, typeCode strin
 							Constraints: elem.Constraints,
 							Fixed:       elem.Fixed,
 							Pattern:     elem.Pattern,
+							MaxLength:   elem.MaxLength,
+							MinValue:    elem.MinValue,
+							MaxValue:    elem.MaxValue,
 							Short:       elem.Short,
 							Definition:  elem.Definition,
 							MustSupport: elem.MustSupport,
@@ -807,6 +1228,7 @@ func (v *Validator) validateCardinality(value interface{}, elem *ElementDef, pat
 			Code:        IssueCodeRequired,
 			Diagnostics: fmt.Sprintf("Element '%s' has %d items but minimum is %d", path, count, elem.Min),
 			Expression:  []string{path},
+			Details:     NewIssueDetails(DetailsCardinalityMin),
 		})
 	}
 
@@ -819,6 +1241,7 @@ func (v *Validator) validateCardinality(value interface{}, elem *ElementDef, pat
 				Code:        IssueCodeStructure,
 				Diagnostics: fmt.Sprintf("Element '%s' has %d items but maximum is %d", path, count, maxVal),
 				Expression:  []string{path},
+				Details:     NewIssueDetails(DetailsCardinalityMax),
 			})
 		}
 	}
@@ -835,31 +1258,35 @@ func (v *Validator) validatePrimitiveNode(ctx context.Context, node interface{},
 	case map[string]interface{}:
 		// Check if this is a contained resource (has resourceType)
 		if resourceType, ok := val[resourceTypeKey].(string); ok && resourceType != "" {
-			// This is a contained resource - get its own index
-			containedSD, err := v.registry.GetByType(ctx, resourceType)
-			if err == nil {
-				containedIndex := v.buildElementIndex(containedSD)
-				// Validate contained resource with its own index
-				for key, child := range val {
-					if key == resourceTypeKey || strings.HasPrefix(key, "_") {
-						continue
-					}
-					childPath := resourceType + "." + key
-					v.validatePrimitiveNode(ctx, child, containedIndex, childPath, result)
-				}
-				return
+			// This is a contained resource - validate it with its own index
+			if containedSD, err := v.structureDefByType(ctx, resourceType); err == nil {
+				index = v.elementIndexFor(containedSD)
+				path = resourceType
 			}
 		}
 
 		for key, child := range val {
-			if key == resourceTypeKey || strings.HasPrefix(key, "_") {
+			switch {
+			case key == resourceTypeKey:
 				continue
+			case key == "id":
+				// Element.id is typed string in the definitions, but every
+				// resource and element id must have the id format
+				if !v.isElementDefinition(ctx, index, path) {
+					v.validatePrimitiveValue(child, "id", path+".id", result)
+				}
+			case strings.HasPrefix(key, "_"):
+				v.validateElementIDs(child, path+"."+key[1:], result)
+			default:
+				v.validatePrimitiveNode(ctx, child, index, path+"."+key, result)
 			}
-			childPath := path + "." + key
-			v.validatePrimitiveNode(ctx, child, index, childPath, result)
 		}
 	case []interface{}:
 		for _, item := range val {
+			// Nulls only align primitive arrays with their extensions (see checkArrays)
+			if item == nil {
+				continue
+			}
 			v.validatePrimitiveNode(ctx, item, index, path, result)
 		}
 	default:
@@ -868,9 +1295,160 @@ func (v *Validator) validatePrimitiveNode(ctx context.Context, node interface{},
 		if elemDef != nil && len(elemDef.Types) > 0 {
 			v.validatePrimitiveValue(val, elemDef.Types[0].Code, path, result)
 		}
+		if str, ok := val.(string); ok {
+			v.validateStringCharacters(str, path, result)
+		}
+		if elemDef != nil && elemDef.MaxLength > 0 {
+			v.validateMaxLength(val, elemDef.MaxLength, path, result)
+		}
+		if elemDef != nil && (elemDef.MinValue != nil || elemDef.MaxValue != nil) {
+			v.validateValueRange(val, elemDef, path, result)
+		}
 	}
 }
 
+// isElementDefinition reports whether the element at path is an ElementDefinition,
+// whose id is an element path with slice names (e.g., "Patient.identifier:mrn")
+// rather than an id.
+func (v *Validator) isElementDefinition(ctx context.Context, index elementIndex, path string) bool {
+	elemDef := v.findElementDefWithContext(ctx, index, path)
+	return elemDef != nil && len(elemDef.Types) == 1 && elemDef.Types[0].Code == "ElementDefinition"
+}
+
+// validateElementIDs validates the format of the ids in the extension content of
+// a primitive element (e.g., "_birthDate"), including those of its extensions.
+func (v *Validator) validateElementIDs(node interface{}, path string, result *ValidationResult) {
+	switch val := node.(type) {
+	case map[string]interface{}:
+		for key, child := range val {
+			if key == "id" {
+				v.validatePrimitiveValue(child, "id", path+".id", result)
+				continue
+			}
+			v.validateElementIDs(child, path+"."+key, result)
+		}
+	case []interface{}:
+		for _, item := range val {
+			v.validateElementIDs(item, path, result)
+		}
+	}
+}
+
+// validateStringCharacters rejects control characters in string values.
+// FHIR strings SHALL NOT contain characters below U+0020 except tab, CR and LF.
+func (v *Validator) validateStringCharacters(str, path string, result *ValidationResult) {
+	for _, r := range str {
+		if r < 0x20 && r != '\t' && r != '\r' && r != '\n' {
+			result.AddIssue(ValidationIssue{
+				Severity:    SeverityError,
+				Code:        IssueCodeValue,
+				Diagnostics: fmt.Sprintf("Element '%s' contains an illegal control character (U+%04X)", path, r),
+				Expression:  []string{path},
+			})
+			return
+		}
+	}
+}
+
+// validateMaxLength checks a string value against ElementDefinition.maxLength.
+// Length is counted in characters, not bytes.
+func (v *Validator) validateMaxLength(value interface{}, maxLength int, path string, result *ValidationResult) {
+	str, ok := value.(string)
+	if !ok {
+		return
+	}
+	if length := utf8.RuneCountInString(str); length > maxLength {
+		result.AddIssue(ValidationIssue{
+			Severity:    SeverityError,
+			Code:        IssueCodeValue,
+			Diagnostics: fmt.Sprintf("Element '%s' exceeds maximum length of %d (length is %d)", path, maxLength, length),
+			Expression:  []string{path},
+		})
+	}
+}
+
+// validateDuplicateKeys reports the first duplicated JSON object key in the resource.
+func (v *Validator) validateDuplicateKeys(resource []byte, resourceType string, result *ValidationResult) {
+	err := common.CheckDuplicateKeys(resource)
+	if !errors.Is(err, common.ErrDuplicateKey) {
+		return
+	}
+	result.AddIssue(ValidationIssue{
+		Severity:    SeverityError,
+		Code:        IssueCodeStructure,
+		Diagnostics: fmt.Sprintf("Invalid JSON: %v", errors.Unwrap(err)),
+		Expression:  []string{resourceType + "." + common.GetPath(err)},
+	})
+}
+
+// validateValueRange checks a value against ElementDefinition.minValue[x] and maxValue[x].
+// Both bounds are inclusive. Values that can't be compared with a bound are skipped.
+func (v *Validator) validateValueRange(value interface{}, elemDef *ElementDef, path string, result *ValidationResult) {
+	if elemDef.MinValue != nil {
+		if cmp, ok := compareRangeValue(value, elemDef.MinValue); ok && cmp < 0 {
+			result.AddIssue(ValidationIssue{
+				Severity:    SeverityError,
+				Code:        IssueCodeValue,
+				Diagnostics: fmt.Sprintf("Element '%s' value %v is below the minimum value %v", path, value, elemDef.MinValue),
+				Expression:  []string{path},
+			})
+		}
+	}
+	if elemDef.MaxValue != nil {
+		if cmp, ok := compareRangeValue(value, elemDef.MaxValue); ok && cmp > 0 {
+			result.AddIssue(ValidationIssue{
+				Severity:    SeverityError,
+				Code:        IssueCodeValue,
+				Diagnostics: fmt.Sprintf("Element '%s' value %v is above the maximum value %v", path, value, elemDef.MaxValue),
+				Expression:  []string{path},
+			})
+		}
+	}
+}
+
+// compareRangeValue compares an instance value with a minValue[x]/maxValue[x] bound.
+// Numbers are compared numerically; date, dateTime, instant and time strings are
+// compared chronologically. Returns false if the values are not comparable,
+// including dates whose precision makes the comparison ambiguous.
+func compareRangeValue(value, bound interface{}) (int, bool) {
+	switch b := bound.(type) {
+	case float64:
+		n, ok := value.(float64)
+		if !ok {
+			return 0, false
+		}
+		switch {
+		case n < b:
+			return -1, true
+		case n > b:
+			return 1, true
+		}
+		return 0, true
+	case string:
+		s, ok := value.(string)
+		if !ok {
+			return 0, false
+		}
+		if valueDT, err := types.NewDateTime(s); err == nil {
+			boundDT, err := types.NewDateTime(b)
+			if err != nil {
+				return 0, false
+			}
+			cmp, err := valueDT.Compare(boundDT)
+			return cmp, err == nil
+		}
+		if valueTime, err := types.NewTime(s); err == nil {
+			boundTime, err := types.NewTime(b)
+			if err != nil {
+				return 0, false
+			}
+			cmp, err := valueTime.Compare(boundTime)
+			return cmp, err == nil
+		}
+	}
+	return 0, false
+}
+
 // validatePrimitiveValue validates a primitive value against its type.
 func (v *Validator) validatePrimitiveValue(value interface{}, typeCode, path string, result *ValidationResult) {
 	// Type validation based on FHIR primitive types
@@ -1087,58 +1665,125 @@ func (v *Validator) validatePrimitiveValue(value interface{}, typeCode, path str
 // validateConstraints validates FHIRPath constraints defined in the StructureDefinition.
 // Uses validationContext to avoid re-parsing JSON.
 func (v *Validator) validateConstraints(_ context.Context, vctx *validationContext, result *ValidationResult) {
-	// Collect all constraints from snapshot elements
+	for _, sc := range snapshotConstraints(vctx) {
+		elemPath, constraint := sc.path, sc.constraint
+
+		// Evaluate the FHIRPath expression
+		valid, err := v.evaluateConstraint(vctx.raw, elemPath, vctx.resourceType, constraint)
+		if err != nil {
+			// If expression fails to evaluate, report as warning
+			result.AddIssue(ValidationIssue{
+				Severity:    SeverityWarning,
+				Code:        IssueCodeProcessing,
+				Diagnostics: fmt.Sprintf("Failed to evaluate constraint %s on %s: %v", constraint.Key, elemPath, err),
+				Expression:  []string{elemPath},
+			})
+			continue
+		}
+
+		if !valid {
+			// Constraint violated
+			severity := SeverityError
+			if constraint.Severity == "warning" {
+				severity = SeverityWarning
+			}
+
+			result.AddIssue(ValidationIssue{
+				Severity:    severity,
+				Code:        IssueCodeInvariant,
+				Diagnostics: fmt.Sprintf("Constraint %s violated: %s", constraint.Key, constraint.Human),
+				Expression:  []string{elemPath},
+				Constraint:  issueConstraint(constraint, vctx.sd.URL),
+			})
+		}
+	}
+}
+
+// abstractBaseTypeURLs are the StructureDefinitions of the abstract FHIR base
+// types. Every element or resource inherits their invariants, which are left to
+// dedicated checks (ele-1 is checked for every element by validateEle1).
+var abstractBaseTypeURLs = map[string]bool{
+	"http://hl7.org/fhir/StructureDefinition/Element":         true,
+	"http://hl7.org/fhir/StructureDefinition/BackboneElement": true,
+	"http://hl7.org/fhir/StructureDefinition/Resource":        true,
+	"http://hl7.org/fhir/StructureDefinition/DomainResource":  true,
+}
+
+// nativeConstraintKeys are constraints checked by dedicated validation passes
+// instead of their FHIRPath expression.
+var nativeConstraintKeys = map[string]bool{
+	"ele-1": true, // validateEle1
+	"txt-1": true, // validateNarrative; htmlChecks() is not a FHIRPath function
+	"txt-2": true, // htmlChecks()
+	"dom-2": true, // validateContainedRules
+	"dom-4": true, // validateContainedRules
+	"dom-5": true, // validateContainedRules
+}
+
+// snapshotConstraint is a constraint to evaluate on the elements at path.
+type snapshotConstraint struct {
+	path       string
+	constraint ElementConstraint
+}
+
+// snapshotConstraints returns the constraints of the StructureDefinition in vctx
+// to evaluate for the resource. A snapshot already contains the constraints an
+// element inherits from base profiles and from its datatypes, each keeping its
+// Source, so these are evaluated along with the profile's own constraints; only
+// the invariants of the abstract base types and those with native checks are
+// skipped. Constraints are only returned for elements present in the resource,
+// and once per element and key even when the snapshot repeats them (e.g., a
+// datatype constraint also listed on each slice of the element).
+func snapshotConstraints(vctx *validationContext) []snapshotConstraint {
+	var constraints []snapshotConstraint
+	seen := make(map[string]bool)
 	for _, elem := range vctx.sd.Snapshot {
+		if len(elem.Constraints) == 0 {
+			continue
+		}
+
+		// Only validate constraints for elements that exist in the resource
+		// Root level constraints (e.g., Patient) always apply
+		if elem.Path != vctx.resourceType && !elementExistsInResource(vctx.parsed, elem.Path, vctx.resourceType) {
+			continue
+		}
+
 		for _, constraint := range elem.Constraints {
 			// Skip constraints without expressions
 			if constraint.Expression == "" {
 				continue
 			}
-
-			// Skip constraints from external sources (they're validated by the source profile)
-			// Only validate constraints defined in this StructureDefinition
-			if constraint.Source != "" && constraint.Source != vctx.sd.URL {
+			if nativeConstraintKeys[constraint.Key] || abstractBaseTypeURLs[constraint.Source] {
 				continue
 			}
 
-			// Only validate constraints for elements that exist in the resource
-			// Root level constraints (e.g., Patient) always apply
-			if elem.Path != vctx.resourceType && !elementExistsInResource(vctx.parsed, elem.Path, vctx.resourceType) {
-				continue
+			id := constraint.Key
+			if id == "" {
+				id = constraint.Expression
 			}
-
-			// Evaluate the FHIRPath expression
-			valid, err := v.evaluateConstraint(vctx.raw, elem.Path, vctx.resourceType, constraint)
-			if err != nil {
-				// If expression fails to evaluate, report as warning
-				result.AddIssue(ValidationIssue{
-					Severity:    SeverityWarning,
-					Code:        IssueCodeProcessing,
-					Diagnostics: fmt.Sprintf("Failed to evaluate constraint %s on %s: %v", constraint.Key, elem.Path, err),
-					Expression:  []string{elem.Path},
-				})
+			if seen[elem.Path+"|"+id] {
 				continue
 			}
-
-			if !valid {
-				// Constraint violated
-				severity := SeverityError
-				if constraint.Severity == "warning" {
-					severity = SeverityWarning
-				}
-
-				result.AddIssue(ValidationIssue{
-					Severity:    severity,
-					Code:        IssueCodeInvariant,
-					Diagnostics: fmt.Sprintf("Constraint %s violated: %s", constraint.Key, constraint.Human),
-					Expression:  []string{elem.Path},
-				})
-			}
+			seen[elem.Path+"|"+id] = true
+			constraints = append(constraints, snapshotConstraint{path: elem.Path, constraint: constraint})
 		}
 	}
+	return constraints
+}
+
+// issueConstraint returns a copy of constraint for attaching to a ValidationIssue,
+// defaulting Source to the URL of the StructureDefinition being validated.
+func issueConstraint(constraint ElementConstraint, sdURL string) *ElementConstraint {
+	c := constraint
+	if c.Source == "" {
+		c.Source = sdURL
+	}
+	return &c
 }
 
 // elementExistsInResource checks if an element path exists in the resource.
+// Paths through arrays exist when they exist in any item, and choice elements
+// (value[x]) match any of their typed variants.
 func elementExistsInResource(resource map[string]interface{}, elementPath, resourceType string) bool {
 	// Remove resource type prefix
 	path := strings.TrimPrefix(elementPath, resourceType+".")
@@ -1146,91 +1791,97 @@ func elementExistsInResource(resource map[string]interface{}, elementPath, resou
 		// Path doesn't start with resource type
 		return false
 	}
+	return pathExists(resource, strings.Split(path, "."))
+}
 
-	parts := strings.Split(path, ".")
-	current := interface{}(resource)
-
-	for _, part := range parts {
-		switch v := current.(type) {
-		case map[string]interface{}:
-			var found bool
-			current, found = v[part]
-			if !found {
-				// Try choice type variants
-				for key := range v {
-					if strings.HasPrefix(key, part) {
-						current = v[key]
-						found = true
-						break
-					}
-				}
-				if !found {
-					return false
+// pathExists reports whether the element path parts exist under node.
+func pathExists(node interface{}, parts []string) bool {
+	if len(parts) == 0 {
+		return true
+	}
+
+	switch v := node.(type) {
+	case map[string]interface{}:
+		if child, found := v[parts[0]]; found {
+			return pathExists(child, parts[1:])
+		}
+		// Try choice type variants
+		if base, isChoice := strings.CutSuffix(parts[0], "[x]"); isChoice {
+			for key, child := range v {
+				if len(key) > len(base) && strings.HasPrefix(key, base) && unicode.IsUpper(rune(key[len(base)])) &&
+					pathExists(child, parts[1:]) {
+					return true
 				}
 			}
-		case []interface{}:
-			// For arrays, check if any element has the path
-			if len(v) == 0 {
-				return false
-			}
-			// Check first element
-			if m, ok := v[0].(map[string]interface{}); ok {
-				if val, found := m[part]; found {
-					current = val
-				} else {
-					return false
-				}
-			} else {
-				return false
+		}
+	case []interface{}:
+		for _, item := range v {
+			if pathExists(item, parts) {
+				return true
 			}
-		default:
-			return false
 		}
 	}
-
-	return true
+	return false
 }
 
 // evaluateConstraint evaluates a single FHIRPath constraint.
-// For element-level constraints, wraps the expression to evaluate in the context of that element.
+// For element-level constraints, the expression is evaluated once per element
+// with $this and %context set to the element and %resource to the resource.
 // Uses expression cache to avoid recompiling the same expressions.
 func (v *Validator) evaluateConstraint(resource []byte, elementPath, resourceType string, constraint ElementConstraint) (bool, error) {
-	// Build the full FHIRPath expression
-	// For root-level constraints (e.g., Patient), use the expression directly
-	// For element-level constraints (e.g., Patient.contact), wrap with .all()
-	fullExpr := constraint.Expression
-	if elementPath != resourceType {
-		// Element-level constraint - need to evaluate in context of the element
-		// Convert "Patient.contact" -> "contact" relative path
-		relativePath := strings.TrimPrefix(elementPath, resourceType+".")
-		// Wrap: contact.all(name.exists() or telecom.exists() ...)
-		fullExpr = fmt.Sprintf("%s.all(%s)", relativePath, constraint.Expression)
-	}
-
-	// Try to get compiled expression from cache
-	var expr *fhirpath.Expression
-	var err error
+	expr, err := v.compileExpression(constraint.Expression)
+	if err != nil {
+		return false, err
+	}
 
-	if cached, ok := v.exprCache.get(fullExpr); ok {
-		expr = cached
-	} else {
-		// Compile the FHIRPath expression
-		expr, err = fhirpath.Compile(fullExpr)
+	// For root-level constraints (e.g., Patient), evaluate against the resource
+	if elementPath == resourceType {
+		result, err := expr.Evaluate(resource)
 		if err != nil {
-			return false, fmt.Errorf("compile error: %w", err)
+			return false, fmt.Errorf("evaluation error: %w", err)
 		}
-		// Store in cache for future use
-		v.exprCache.set(fullExpr, expr)
+		return isTruthy(result), nil
 	}
 
-	// Evaluate the expression
-	result, err := expr.Evaluate(resource)
+	// Element-level constraint (e.g., Patient.contact): select the elements with
+	// the relative path and require the constraint to hold for each of them.
+	// Choice elements (value[x]) are selected by their name (value).
+	relativePath := strings.ReplaceAll(strings.TrimPrefix(elementPath, resourceType+"."), "[x]", "")
+	pathExpr, err := v.compileExpression(relativePath)
+	if err != nil {
+		return false, err
+	}
+	elements, err := pathExpr.Evaluate(resource)
 	if err != nil {
 		return false, fmt.Errorf("evaluation error: %w", err)
 	}
 
-	// Check the result
-	return isTruthy(result), nil
+	for _, element := range elements {
+		evalCtx := eval.NewContext(resource)
+		evalCtx.SetVariable("context", types.Collection{element})
+		result, err := expr.EvaluateWithContext(evalCtx.WithThis(types.Collection{element}))
+		if err != nil {
+			return false, fmt.Errorf("evaluation error: %w", err)
+		}
+		if !isTruthy(result) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// compileExpression compiles a FHIRPath expression, using the expression cache.
+func (v *Validator) compileExpression(source string) (*fhirpath.Expression, error) {
+	if cached, ok := v.exprCache.get(source); ok {
+		return cached, nil
+	}
+
+	expr, err := fhirpath.Compile(source)
+	if err != nil {
+		return nil, fmt.Errorf("compile error: %w", err)
+	}
+	v.exprCache.set(source, expr)
+	return expr, nil
 }
 
 // isTruthy determines if a FHIRPath result is truthy for constraint evaluation.
@@ -1308,6 +1959,10 @@ func (v *Validator) getValuesAtPath(resource map[string]interface{}, path string
 // collectValues recursively collects values at a path.
 func (v *Validator) collectValues(current interface{}, parts []string, index int) []interface{} {
 	if index >= len(parts) {
+		// The items of a repeating element are values of their own
+		if items, ok := current.([]interface{}); ok {
+			return items
+		}
 		return []interface{}{current}
 	}
 
@@ -1339,7 +1994,8 @@ func (v *Validator) collectValues(current interface{}, parts []string, index int
 	}
 }
 
-// validateCodeValue validates a single code/Coding/CodeableConcept value.
+// validateCodeValue validates a single code/Coding/CodeableConcept value, or the
+// concept of a CodeableReference (R5).
 func (v *Validator) validateCodeValue(ctx context.Context, value interface{}, elem *ElementDef, result *ValidationResult) {
 	if value == nil {
 		return
@@ -1354,8 +2010,11 @@ func (v *Validator) validateCodeValue(ctx context.Context, value interface{}, el
 		v.validateSingleCode(ctx, "", val, elem.Path, binding, result)
 
 	case map[string]interface{}:
-		// Could be Coding or CodeableConcept
-		if coding, ok := val["coding"].([]interface{}); ok {
+		// Could be Coding, CodeableConcept or CodeableReference
+		if concept, ok := val["concept"].(map[string]interface{}); ok {
+			// CodeableReference - the binding applies to the concept, not the reference
+			v.validateCodeValue(ctx, concept, elem, result)
+		} else if coding, ok := val["coding"].([]interface{}); ok {
 			// CodeableConcept - validate each coding
 			for _, c := range coding {
 				if codingMap, ok := c.(map[string]interface{}); ok {
@@ -1363,6 +2022,7 @@ func (v *Validator) validateCodeValue(ctx context.Context, value interface{}, el
 					code, _ := codingMap["code"].(string)
 					if code != "" {
 						v.validateSingleCode(ctx, system, code, elem.Path, binding, result)
+						v.validateCodingDisplay(ctx, codingMap, elem.Path, result)
 					}
 				}
 			}
@@ -1370,10 +2030,43 @@ func (v *Validator) validateCodeValue(ctx context.Context, value interface{}, el
 			// Coding
 			system, _ := val["system"].(string)
 			v.validateSingleCode(ctx, system, code, elem.Path, binding, result)
+			v.validateCodingDisplay(ctx, val, elem.Path, result)
 		}
 	}
 }
 
+// validateCodingDisplay warns when the display of a Coding differs from the
+// canonical display of its code. Codes from unknown CodeSystems are skipped.
+func (v *Validator) validateCodingDisplay(ctx context.Context, coding map[string]interface{}, path string, result *ValidationResult) {
+	if !v.options.ValidateDisplay {
+		return
+	}
+
+	system, _ := coding["system"].(string)
+	code, _ := coding["code"].(string)
+	display, _ := coding["display"].(string)
+	if system == "" || code == "" || display == "" {
+		return
+	}
+
+	canonical, ok, err := v.termService.Lookup(ctx, system, code)
+	if err != nil || !ok || canonical == "" {
+		return
+	}
+
+	// Displays are compared ignoring case and whitespace differences
+	if strings.EqualFold(strings.Join(strings.Fields(display), " "), strings.Join(strings.Fields(canonical), " ")) {
+		return
+	}
+
+	result.AddIssue(ValidationIssue{
+		Severity:    SeverityWarning,
+		Code:        IssueCodeCodeInvalid,
+		Diagnostics: fmt.Sprintf("Display '%s' for code '%s#%s' does not match the CodeSystem display '%s'", display, system, code, canonical),
+		Expression:  []string{path},
+	})
+}
+
 // validateSingleCode validates a single code against the bound ValueSet.
 func (v *Validator) validateSingleCode(ctx context.Context, system, code, path string, binding *ElementBinding, result *ValidationResult) {
 	if code == "" {
@@ -1409,7 +2102,31 @@ func (v *Validator) validateSingleCode(ctx context.Context, system, code, path s
 			Diagnostics: fmt.Sprintf("Code '%s' is not in ValueSet %s (binding: %s)", displayCode, binding.ValueSet, binding.Strength),
 			Expression:  []string{path},
 		})
+		return
 	}
+
+	v.validateCodeStatus(ctx, system, code, path, binding, result)
+}
+
+// validateCodeStatus warns when a valid code is deprecated or retired in its
+// CodeSystem. Only terminology services implementing CodeStatusProvider are checked.
+func (v *Validator) validateCodeStatus(ctx context.Context, system, code, path string, binding *ElementBinding, result *ValidationResult) {
+	provider, ok := v.termService.(CodeStatusProvider)
+	if !ok {
+		return
+	}
+
+	status, err := provider.CodeStatus(ctx, system, code, binding.ValueSet)
+	if err != nil || status == "" || status == "active" {
+		return
+	}
+
+	result.AddIssue(ValidationIssue{
+		Severity:    SeverityWarning,
+		Code:        IssueCodeBusinessRule,
+		Diagnostics: fmt.Sprintf("Code '%s' in ValueSet %s is %s", code, binding.ValueSet, status),
+		Expression:  []string{path},
+	})
 }
 
 // validateReferences is implemented in reference.go
@@ -1439,6 +2156,15 @@ func (v *Validator) isChoiceElementSatisfied(path string, present map[string]boo
 	return false
 }
 
+// ele1Constraint describes ele-1 for issues reported by validateEle1.
+var ele1Constraint = ElementConstraint{
+	Key:        "ele-1",
+	Severity:   "error",
+	Human:      "All FHIR elements must have a @value or children",
+	Expression: "hasValue() or (children().count() > id.count())",
+	Source:     "http://hl7.org/fhir/StructureDefinition/Element",
+}
+
 // validateEle1 validates the ele-1 constraint globally across all FHIR elements.
 // ele-1: "All FHIR elements must have a @value or children"
 // Expression: hasValue() or (children().count() > id.count())
@@ -1474,6 +2200,7 @@ func (v *Validator) checkEle1Recursive(node interface{}, path string, result *Va
 				Code:        IssueCodeInvariant,
 				Diagnostics: "Constraint ele-1 violated: All FHIR elements must have a @value or children",
 				Expression:  []string{path},
+				Constraint:  issueConstraint(ele1Constraint, ""),
 			})
 			return // Don't recurse into invalid element
 		}
@@ -1503,6 +2230,7 @@ func (v *Validator) checkEle1Recursive(node interface{}, path string, result *Va
 				Code:        IssueCodeInvariant,
 				Diagnostics: "Constraint ele-1 violated: All FHIR elements must have a @value or children (empty string)",
 				Expression:  []string{path},
+				Constraint:  issueConstraint(ele1Constraint, ""),
 			})
 		}
 	}
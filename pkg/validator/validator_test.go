@@ -2,9 +2,12 @@ package validator
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 	"testing"
 )
@@ -33,6 +36,19 @@ func setupTestValidator(t *testing.T) *Validator {
 	return NewValidator(reg, DefaultValidatorOptions())
 }
 
+// newMinimalValidator creates a validator whose registry only holds sds, so tests
+// can run against hand-written definitions without the FHIR specification files.
+func newMinimalValidator(t *testing.T, opts ValidatorOptions, sds ...*StructureDef) *Validator {
+	t.Helper()
+	reg := NewRegistry(FHIRVersionR4)
+	for _, sd := range sds {
+		if err := reg.Register(sd); err != nil {
+			t.Fatalf("Register(%s) error = %v", sd.URL, err)
+		}
+	}
+	return NewValidator(reg, opts)
+}
+
 func TestValidateSimplePatient(t *testing.T) {
 	v := setupTestValidator(t)
 	ctx := context.Background()
@@ -427,6 +443,183 @@ func TestValidateConstraintPass(t *testing.T) {
 	}
 }
 
+func TestValidateConstraintIssueMetadata(t *testing.T) {
+	registry := NewRegistry(FHIRVersionR4)
+	sdURL := "http://hl7.org/fhir/StructureDefinition/Patient"
+	err := registry.Register(&StructureDef{
+		URL:  sdURL,
+		Name: "Patient",
+		Type: "Patient",
+		Kind: "resource",
+		Snapshot: []ElementDef{
+			{
+				Path: "Patient",
+				Max:  "*",
+				Constraints: []ElementConstraint{{
+					Key:        "test-1",
+					Severity:   "error",
+					Human:      "Patient must be active",
+					Expression: "active = true",
+				}},
+			},
+			{Path: "Patient.id", Max: "1", Types: []TypeRef{{Code: "id"}}},
+			{Path: "Patient.active", Max: "1", Types: []TypeRef{{Code: "boolean"}}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Register failed: %v", err)
+	}
+
+	v := NewValidator(registry, ValidatorOptions{ValidateConstraints: true})
+	result, err := v.Validate(context.Background(), []byte(`{"resourceType": "Patient", "active": false}`))
+	if err != nil {
+		t.Fatalf("Validate error: %v", err)
+	}
+
+	var issue *ValidationIssue
+	for i := range result.Issues {
+		if result.Issues[i].Code == IssueCodeInvariant {
+			issue = &result.Issues[i]
+		}
+	}
+	if issue == nil {
+		t.Fatalf("Expected invariant issue, got %v", result.Issues)
+	}
+	if issue.Constraint == nil {
+		t.Fatal("Expected constraint metadata on invariant issue")
+	}
+	if issue.Constraint.Key != "test-1" || issue.Constraint.Expression != "active = true" {
+		t.Errorf("Unexpected constraint metadata: %+v", issue.Constraint)
+	}
+	if issue.Constraint.Source != sdURL {
+		t.Errorf("Expected constraint source %s, got %s", sdURL, issue.Constraint.Source)
+	}
+}
+
+func TestValidateElementConstraintContext(t *testing.T) {
+	registry := NewRegistry(FHIRVersionR4)
+	err := registry.Register(&StructureDef{
+		URL:  "http://hl7.org/fhir/StructureDefinition/Patient",
+		Name: "Patient",
+		Type: "Patient",
+		Kind: "resource",
+		Snapshot: []ElementDef{
+			{Path: "Patient", Max: "*"},
+			{Path: "Patient.id", Max: "1", Types: []TypeRef{{Code: "id"}}},
+			{Path: "Patient.name", Max: "*", Types: []TypeRef{{Code: "HumanName"}}},
+			{
+				Path:  "Patient.contact",
+				Max:   "*",
+				Types: []TypeRef{{Code: "BackboneElement"}},
+				Constraints: []ElementConstraint{{
+					Key:        "test-ctx",
+					Severity:   "error",
+					Human:      "Contact must have a name and belong to a named patient",
+					Expression: "%context.name.exists() and %resource.name.exists()",
+				}},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Register failed: %v", err)
+	}
+
+	v := NewValidator(registry, ValidatorOptions{ValidateConstraints: true})
+	ctx := context.Background()
+
+	tests := []struct {
+		name      string
+		patient   string
+		wantError bool
+	}{
+		{
+			name:      "every contact has a name",
+			patient:   `{"resourceType": "Patient", "name": [{"family": "Doe"}], "contact": [{"name": {"family": "Roe"}}]}`,
+			wantError: false,
+		},
+		{
+			// %context must be the contact, not the (named) patient
+			name:      "contact without name",
+			patient:   `{"resourceType": "Patient", "name": [{"family": "Doe"}], "contact": [{"name": {"family": "Roe"}}, {"gender": "male"}]}`,
+			wantError: true,
+		},
+		{
+			name:      "no contacts",
+			patient:   `{"resourceType": "Patient", "name": [{"family": "Doe"}]}`,
+			wantError: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := v.Validate(ctx, []byte(tt.patient))
+			if err != nil {
+				t.Fatalf("Validate error: %v", err)
+			}
+
+			hasError := false
+			for _, issue := range result.Issues {
+				if issue.Constraint != nil && issue.Constraint.Key == "test-ctx" {
+					hasError = true
+				}
+			}
+			if hasError != tt.wantError {
+				t.Errorf("Expected test-ctx violation = %v, got issues: %v", tt.wantError, result.Issues)
+			}
+		})
+	}
+}
+
+func TestValidateStrictJSON(t *testing.T) {
+	registry := NewRegistry(FHIRVersionR4)
+	err := registry.Register(&StructureDef{
+		URL:  "http://hl7.org/fhir/StructureDefinition/Observation",
+		Name: "Observation",
+		Type: "Observation",
+		Kind: "resource",
+		Snapshot: []ElementDef{
+			{Path: "Observation", Max: "*"},
+			{Path: "Observation.id", Max: "1", Types: []TypeRef{{Code: "id"}}},
+			{Path: "Observation.status", Max: "1", Types: []TypeRef{{Code: "code"}}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Register failed: %v", err)
+	}
+
+	duplicated := []byte(`{"resourceType": "Observation", "status": "final", "status": "amended"}`)
+	ctx := context.Background()
+
+	t.Run("duplicated status reported", func(t *testing.T) {
+		v := NewValidator(registry, ValidatorOptions{StrictJSON: true})
+		result, err := v.Validate(ctx, duplicated)
+		if err != nil {
+			t.Fatalf("Validate error: %v", err)
+		}
+
+		found := false
+		for _, issue := range result.Issues {
+			if issue.Code == IssueCodeStructure && len(issue.Expression) > 0 && issue.Expression[0] == "Observation.status" {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("Expected duplicate key error on Observation.status, got %v", result.Issues)
+		}
+	})
+
+	t.Run("not checked by default", func(t *testing.T) {
+		v := NewValidator(registry, ValidatorOptions{})
+		result, err := v.Validate(ctx, duplicated)
+		if err != nil {
+			t.Fatalf("Validate error: %v", err)
+		}
+		if result.HasErrors() {
+			t.Errorf("Expected no errors without StrictJSON, got %v", result.Issues)
+		}
+	})
+}
+
 func BenchmarkValidatePatient(b *testing.B) {
 	reg := NewRegistry(FHIRVersionR4)
 	resourcesPath := filepath.Join("..", "..", "specs", "r4", "profiles-resources.json")
@@ -661,6 +854,208 @@ func BenchmarkExpressionCacheHit(b *testing.B) {
 	}
 }
 
+// profileCacheTestURL is the profile used by the profile cache tests.
+const profileCacheTestURL = "http://example.org/StructureDefinition/cached-patient"
+
+// registerProfileCacheTestProfile registers a Patient profile with a
+// realistically sized snapshot. If requireName is set, Patient.name is required.
+func registerProfileCacheTestProfile(tb testing.TB, reg *Registry, requireName bool) {
+	tb.Helper()
+	nameMin := 0
+	if requireName {
+		nameMin = 1
+	}
+	snapshot := []ElementDef{
+		{ID: "Patient", Path: "Patient", Min: 0, Max: "*"},
+		{ID: "Patient.id", Path: "Patient.id", Min: 0, Max: "1", Types: []TypeRef{{Code: "id"}}},
+		{ID: "Patient.active", Path: "Patient.active", Min: 0, Max: "1", Types: []TypeRef{{Code: "boolean"}}},
+		{ID: "Patient.name", Path: "Patient.name", Min: nameMin, Max: "*", Types: []TypeRef{{Code: "string"}}},
+	}
+	for i := 0; i < 300; i++ {
+		path := fmt.Sprintf("Patient.element%d", i)
+		snapshot = append(snapshot, ElementDef{ID: path, Path: path, Min: 0, Max: "1", Types: []TypeRef{{Code: "string"}}})
+	}
+	if err := reg.Register(&StructureDef{
+		URL:            profileCacheTestURL,
+		Name:           "CachedPatient",
+		Type:           "Patient",
+		Kind:           "resource",
+		BaseDefinition: "http://hl7.org/fhir/StructureDefinition/Patient",
+		Snapshot:       snapshot,
+	}); err != nil {
+		tb.Fatalf("Register error: %v", err)
+	}
+}
+
+// TestProfileCacheInvalidation tests that cached profiles are dropped when
+// definitions in the registry change.
+func TestProfileCacheInvalidation(t *testing.T) {
+	reg := NewRegistry(FHIRVersionR4)
+	registerProfileCacheTestProfile(t, reg, false)
+
+	v := NewValidator(reg, ValidatorOptions{Profile: profileCacheTestURL})
+	ctx := context.Background()
+	patient := []byte(`{"resourceType": "Patient", "id": "p1", "active": true}`)
+
+	result, err := v.Validate(ctx, patient)
+	if err != nil {
+		t.Fatalf("Validate error: %v", err)
+	}
+	if result.HasErrors() {
+		t.Fatalf("Expected no errors, got %v", result.Issues)
+	}
+
+	// Replace the profile with one that requires Patient.name
+	registerProfileCacheTestProfile(t, reg, true)
+
+	result, err = v.Validate(ctx, patient)
+	if err != nil {
+		t.Fatalf("Validate error: %v", err)
+	}
+	if !result.HasErrors() {
+		t.Error("Expected missing Patient.name error after the profile was replaced")
+	}
+}
+
+func TestValidateAgainst(t *testing.T) {
+	// The registry has no Patient definition: the hand-built profile is used as is
+	v := NewValidator(NewRegistry(FHIRVersionR4), ValidatorOptions{})
+	ctx := context.Background()
+	profile := &StructureDef{
+		URL:  "http://example.org/fhir/StructureDefinition/contained-patient",
+		Name: "ContainedPatient",
+		Type: "Patient",
+		Kind: "resource",
+		Snapshot: []ElementDef{
+			{Path: "Patient", Min: 0, Max: "*"},
+			{Path: "Patient.id", Min: 0, Max: "1", Types: []TypeRef{{Code: "id"}}},
+			{Path: "Patient.active", Min: 1, Max: "1", Types: []TypeRef{{Code: "boolean"}}},
+		},
+	}
+
+	result, err := v.ValidateAgainst(ctx, []byte(`{"resourceType": "Patient", "id": "p1", "active": true}`), profile)
+	if err != nil {
+		t.Fatalf("ValidateAgainst error: %v", err)
+	}
+	if result.HasErrors() {
+		t.Errorf("Expected no errors, got %v", result.Issues)
+	}
+
+	result, err = v.ValidateAgainst(ctx, []byte(`{"resourceType": "Patient", "id": "p1", "gender": "male"}`), profile)
+	if err != nil {
+		t.Fatalf("ValidateAgainst error: %v", err)
+	}
+	var paths []string
+	for _, issue := range result.Issues {
+		if issue.Severity == SeverityError {
+			paths = append(paths, issue.Expression...)
+		}
+	}
+	if !slices.Contains(paths, "Patient.active") || !slices.Contains(paths, "Patient.gender") {
+		t.Errorf("Expected errors on Patient.active and Patient.gender, got %v", result.Issues)
+	}
+
+	result, err = v.ValidateAgainst(ctx, []byte(`{"resourceType": "Observation", "status": "final"}`), profile)
+	if err != nil {
+		t.Fatalf("ValidateAgainst error: %v", err)
+	}
+	if result.Valid || len(result.Issues) != 1 || result.Issues[0].Severity != SeverityFatal {
+		t.Errorf("Expected a fatal resource type mismatch, got %v", result.Issues)
+	}
+
+	if _, err := v.ValidateAgainst(ctx, []byte(`{"resourceType": "Patient"}`), nil); err == nil {
+		t.Error("Expected an error without a StructureDefinition")
+	}
+}
+
+func TestValidateAgainstDifferential(t *testing.T) {
+	registry := NewRegistry(FHIRVersionR4)
+	if err := registry.Register(&StructureDef{
+		URL:  "http://hl7.org/fhir/StructureDefinition/Patient",
+		Name: "Patient",
+		Type: "Patient",
+		Kind: "resource",
+		Snapshot: []ElementDef{
+			{Path: "Patient", Min: 0, Max: "*"},
+			{Path: "Patient.id", Min: 0, Max: "1", Types: []TypeRef{{Code: "id"}}},
+			{Path: "Patient.active", Min: 0, Max: "1", Types: []TypeRef{{Code: "boolean"}}},
+		},
+	}); err != nil {
+		t.Fatalf("Register() error = %v", err)
+	}
+	v := NewValidator(registry, ValidatorOptions{})
+	ctx := context.Background()
+
+	// Only a differential: the snapshot comes from the registered base
+	profile := &StructureDef{
+		URL:            "http://example.org/fhir/StructureDefinition/active-patient",
+		Name:           "ActivePatient",
+		BaseDefinition: "http://hl7.org/fhir/StructureDefinition/Patient",
+		Differential: []ElementDef{
+			{ID: "Patient.active", Path: "Patient.active", Min: 1, Max: "1"},
+		},
+	}
+
+	result, err := v.ValidateAgainst(ctx, []byte(`{"resourceType": "Patient", "id": "p1", "active": true}`), profile)
+	if err != nil {
+		t.Fatalf("ValidateAgainst error: %v", err)
+	}
+	if result.HasErrors() {
+		t.Errorf("Expected no errors, got %v", result.Issues)
+	}
+
+	result, err = v.ValidateAgainst(ctx, []byte(`{"resourceType": "Patient", "id": "p1"}`), profile)
+	if err != nil {
+		t.Fatalf("ValidateAgainst error: %v", err)
+	}
+	found := false
+	for _, issue := range result.Issues {
+		if issue.Severity == SeverityError && slices.Contains(issue.Expression, "Patient.active") {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("Expected the differential's min of Patient.active to apply, got %v", result.Issues)
+	}
+
+	profile.BaseDefinition = "http://example.org/fhir/StructureDefinition/unknown"
+	if _, err := v.ValidateAgainst(ctx, []byte(`{"resourceType": "Patient"}`), profile); err == nil {
+		t.Error("Expected an error for a differential-only profile with an unknown base")
+	}
+}
+
+// BenchmarkProfileCache compares validating 1000 resources against one profile
+// with a shared validator (cached profile) and with a new validator per resource.
+func BenchmarkProfileCache(b *testing.B) {
+	reg := NewRegistry(FHIRVersionR4)
+	registerProfileCacheTestProfile(b, reg, false)
+
+	opts := ValidatorOptions{Profile: profileCacheTestURL}
+	ctx := context.Background()
+	patients := make([][]byte, 1000)
+	for i := range patients {
+		patients[i] = []byte(fmt.Sprintf(`{"resourceType": "Patient", "id": "p%d", "active": true}`, i))
+	}
+
+	b.Run("cached", func(b *testing.B) {
+		v := NewValidator(reg, opts)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			for _, p := range patients {
+				v.Validate(ctx, p)
+			}
+		}
+	})
+
+	b.Run("uncached", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			for _, p := range patients {
+				NewValidator(reg, opts).Validate(ctx, p)
+			}
+		}
+	})
+}
+
 // TestValidateEle1EmptyObject tests that empty objects violate ele-1
 func TestValidateEle1EmptyObject(t *testing.T) {
 	v := setupTestValidator(t)
@@ -1639,6 +2034,197 @@ func TestValidateDecimalTypeInvalid(t *testing.T) {
 	}
 }
 
+// TestValidateMaxLength tests ElementDefinition.maxLength on a profiled string element.
+func TestValidateMaxLength(t *testing.T) {
+	profileURL := "http://example.org/fhir/StructureDefinition/short-name-patient"
+
+	registry := NewRegistry(FHIRVersionR4)
+	err := registry.Register(&StructureDef{
+		URL:            profileURL,
+		Name:           "ShortNamePatient",
+		Type:           "Patient",
+		Kind:           "resource",
+		BaseDefinition: "http://hl7.org/fhir/StructureDefinition/Patient",
+		Snapshot: []ElementDef{
+			{Path: "Patient", Max: "*"},
+			{Path: "Patient.id", Max: "1", Types: []TypeRef{{Code: "id"}}},
+			{Path: "Patient.name", Max: "*", Types: []TypeRef{{Code: "HumanName"}}},
+			{Path: "Patient.name.family", Max: "1", Types: []TypeRef{{Code: "string"}}, MaxLength: 10},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Register failed: %v", err)
+	}
+
+	v := NewValidator(registry, ValidatorOptions{Profile: profileURL})
+	ctx := context.Background()
+
+	tests := []struct {
+		name      string
+		family    string
+		wantError bool
+	}{
+		{"within limit", "Smith", false},
+		{"exactly at limit", "Abcdefghij", false},
+		{"multi-byte characters counted as characters", "Müller-Lüd", false},
+		{"exceeds limit", "Wolfeschlegelsteinhausen", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			patient := []byte(fmt.Sprintf(`{
+				"resourceType": "Patient",
+				"id": "test",
+				"name": [{"family": %q}]
+			}`, tt.family))
+
+			result, err := v.Validate(ctx, patient)
+			if err != nil {
+				t.Fatalf("Validate error: %v", err)
+			}
+
+			hasLengthError := false
+			for _, issue := range result.Issues {
+				if issue.Code == IssueCodeValue && strings.Contains(issue.Diagnostics, "maximum length") {
+					hasLengthError = true
+				}
+			}
+
+			if hasLengthError != tt.wantError {
+				t.Errorf("Expected length error = %v, got issues: %v", tt.wantError, result.Issues)
+			}
+		})
+	}
+}
+
+func TestValidateStringCharacters(t *testing.T) {
+	registry := NewRegistry(FHIRVersionR4)
+	err := registry.Register(&StructureDef{
+		URL:  "http://hl7.org/fhir/StructureDefinition/Patient",
+		Name: "Patient",
+		Type: "Patient",
+		Kind: "resource",
+		Snapshot: []ElementDef{
+			{Path: "Patient", Max: "*"},
+			{Path: "Patient.id", Max: "1", Types: []TypeRef{{Code: "id"}}},
+			{Path: "Patient.name", Max: "*", Types: []TypeRef{{Code: "HumanName"}}},
+			{Path: "Patient.name.text", Max: "1", Types: []TypeRef{{Code: "string"}}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Register failed: %v", err)
+	}
+
+	v := NewValidator(registry, ValidatorOptions{})
+	ctx := context.Background()
+
+	tests := []struct {
+		name      string
+		resource  []byte
+		wantError string
+	}{
+		{"plain text", []byte(`{"resourceType": "Patient", "name": [{"text": "John Doe"}]}`), ""},
+		{"tab, CR and LF allowed", []byte(`{"resourceType": "Patient", "name": [{"text": "John\tDoe\r\n"}]}`), ""},
+		{"NUL byte", []byte(`{"resourceType": "Patient", "name": [{"text": "John\u0000Doe"}]}`), "illegal control character (U+0000)"},
+		{"escape character", []byte(`{"resourceType": "Patient", "name": [{"text": "John\u001bDoe"}]}`), "illegal control character (U+001B)"},
+		{"invalid UTF-8", []byte("{\"resourceType\": \"Patient\", \"name\": [{\"text\": \"John\xffDoe\"}]}"), "invalid UTF-8"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := v.Validate(ctx, tt.resource)
+			if err != nil {
+				t.Fatalf("Validate error: %v", err)
+			}
+
+			if tt.wantError == "" {
+				if result.HasErrors() {
+					t.Errorf("Expected no errors, got %v", result.Issues)
+				}
+				return
+			}
+
+			found := false
+			for _, issue := range result.Issues {
+				if issue.Severity == SeverityError && strings.Contains(issue.Diagnostics, tt.wantError) {
+					found = true
+				}
+			}
+			if !found {
+				t.Errorf("Expected error containing %q, got %v", tt.wantError, result.Issues)
+			}
+		})
+	}
+}
+
+func TestValidateValueRange(t *testing.T) {
+	profileURL := "http://example.org/fhir/StructureDefinition/ranged-patient"
+
+	registry := NewRegistry(FHIRVersionR4)
+	_, err := registry.LoadFromJSON([]byte(`{
+		"resourceType": "StructureDefinition",
+		"url": "` + profileURL + `",
+		"name": "RangedPatient",
+		"type": "Patient",
+		"kind": "resource",
+		"baseDefinition": "http://hl7.org/fhir/StructureDefinition/Patient",
+		"snapshot": {"element": [
+			{"path": "Patient", "min": 0, "max": "*"},
+			{"path": "Patient.id", "min": 0, "max": "1", "type": [{"code": "id"}]},
+			{"path": "Patient.birthDate", "min": 0, "max": "1", "type": [{"code": "date"}],
+				"maxValueDateTime": "2020-12-31"},
+			{"path": "Patient.multipleBirthInteger", "min": 0, "max": "1", "type": [{"code": "integer"}],
+				"minValueInteger": 1}
+		]}
+	}`))
+	if err != nil {
+		t.Fatalf("LoadFromJSON failed: %v", err)
+	}
+
+	v := NewValidator(registry, ValidatorOptions{Profile: profileURL})
+	ctx := context.Background()
+
+	tests := []struct {
+		name      string
+		field     string
+		wantError string
+	}{
+		{"integer within range", `"multipleBirthInteger": 2`, ""},
+		{"integer at minimum", `"multipleBirthInteger": 1`, ""},
+		{"integer below minValueInteger", `"multipleBirthInteger": 0`, "below the minimum value"},
+		{"date within range", `"birthDate": "1990-05-17"`, ""},
+		{"date at maximum", `"birthDate": "2020-12-31"`, ""},
+		{"date after maxValueDateTime", `"birthDate": "2021-01-02"`, "above the maximum value"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			patient := []byte(`{"resourceType": "Patient", "id": "test", ` + tt.field + `}`)
+
+			result, err := v.Validate(ctx, patient)
+			if err != nil {
+				t.Fatalf("Validate error: %v", err)
+			}
+
+			rangeErrors := 0
+			for _, issue := range result.Issues {
+				if issue.Code == IssueCodeValue && tt.wantError != "" && strings.Contains(issue.Diagnostics, tt.wantError) {
+					rangeErrors++
+				}
+				if strings.Contains(issue.Diagnostics, "minimum value") || strings.Contains(issue.Diagnostics, "maximum value") {
+					if tt.wantError == "" {
+						t.Errorf("Unexpected range error: %s", issue.Diagnostics)
+					}
+				}
+			}
+
+			if tt.wantError != "" && rangeErrors != 1 {
+				t.Errorf("Expected one %q error, got issues: %v", tt.wantError, result.Issues)
+			}
+		})
+	}
+}
+
 // =============================================================================
 // REQUIRED FIELDS VALIDATION TESTS
 // =============================================================================
@@ -2904,6 +3490,123 @@ func TestValidateIdFormat(t *testing.T) {
 	}
 }
 
+// Tests for id format validation of element ids, which are typed string in the
+// definitions
+func TestValidateElementIdFormat(t *testing.T) {
+	registry := NewRegistry(FHIRVersionR4)
+	for _, sd := range []*StructureDef{
+		{
+			URL:  "http://hl7.org/fhir/StructureDefinition/Patient",
+			Name: "Patient",
+			Type: "Patient",
+			Kind: "resource",
+			Snapshot: []ElementDef{
+				{Path: "Patient", Min: 0, Max: "*"},
+				{Path: "Patient.id", Min: 0, Max: "1", Types: []TypeRef{{Code: "id"}}},
+				{Path: "Patient.extension", Min: 0, Max: "*", Types: []TypeRef{{Code: "Extension"}}},
+				{Path: "Patient.name", Min: 0, Max: "*", Types: []TypeRef{{Code: "HumanName"}}},
+				{Path: "Patient.birthDate", Min: 0, Max: "1", Types: []TypeRef{{Code: "date"}}},
+				{Path: "Patient.contact", Min: 0, Max: "*", Types: []TypeRef{{Code: "BackboneElement"}}},
+				{Path: "Patient.contact.id", Min: 0, Max: "1", Types: []TypeRef{{Code: "http://hl7.org/fhirpath/System.String"}}},
+				{Path: "Patient.contact.gender", Min: 0, Max: "1", Types: []TypeRef{{Code: "code"}}},
+			},
+		},
+		{
+			URL:  "http://hl7.org/fhir/StructureDefinition/Condition",
+			Name: "Condition",
+			Type: "Condition",
+			Kind: "resource",
+			Snapshot: []ElementDef{
+				{Path: "Condition", Min: 0, Max: "*"},
+				{Path: "Condition.contained", Min: 0, Max: "*", Types: []TypeRef{{Code: "Resource"}}},
+			},
+		},
+		{
+			URL:  "http://hl7.org/fhir/StructureDefinition/StructureDefinition",
+			Name: "StructureDefinition",
+			Type: "StructureDefinition",
+			Kind: "resource",
+			Snapshot: []ElementDef{
+				{Path: "StructureDefinition", Min: 0, Max: "*"},
+				{Path: "StructureDefinition.snapshot", Min: 0, Max: "1", Types: []TypeRef{{Code: "BackboneElement"}}},
+				{Path: "StructureDefinition.snapshot.element", Min: 1, Max: "*", Types: []TypeRef{{Code: "ElementDefinition"}}},
+			},
+		},
+	} {
+		if err := registry.Register(sd); err != nil {
+			t.Fatalf("Register(%s) error: %v", sd.Type, err)
+		}
+	}
+	v := NewValidator(registry, ValidatorOptions{})
+	ctx := context.Background()
+	longID := strings.Repeat("a", 65)
+
+	tests := []struct {
+		name     string
+		resource string
+		wantPath string
+	}{
+		{
+			name:     "valid nested ids",
+			resource: `{"resourceType": "Patient", "id": "p1", "name": [{"id": "n1", "family": "Doe"}], "_birthDate": {"id": "b1"}, "birthDate": "1990-01-01"}`,
+		},
+		{
+			name:     "over-long datatype id",
+			resource: `{"resourceType": "Patient", "name": [{"id": "` + longID + `", "family": "Doe"}]}`,
+			wantPath: "Patient.name.id",
+		},
+		{
+			name:     "over-long backbone element id",
+			resource: `{"resourceType": "Patient", "contact": [{"id": "` + longID + `", "gender": "male"}]}`,
+			wantPath: "Patient.contact.id",
+		},
+		{
+			name:     "invalid primitive element id",
+			resource: `{"resourceType": "Patient", "birthDate": "1990-01-01", "_birthDate": {"id": "bad id"}}`,
+			wantPath: "Patient.birthDate.id",
+		},
+		{
+			name: "over-long extension id",
+			resource: `{"resourceType": "Patient", "extension": [{"id": "` + longID + `",
+				"url": "http://example.org/ext", "valueString": "x"}]}`,
+			wantPath: "Patient.extension.id",
+		},
+		{
+			name:     "invalid contained resource id",
+			resource: `{"resourceType": "Condition", "contained": [{"resourceType": "Patient", "id": "p_1"}]}`,
+			wantPath: "Patient.id",
+		},
+		{
+			name: "element definition ids are paths",
+			resource: `{"resourceType": "StructureDefinition", "snapshot": {"element": [
+				{"id": "Patient.identifier:mrn.system", "path": "Patient.identifier.system"}]}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := v.Validate(ctx, []byte(tt.resource))
+			if err != nil {
+				t.Fatalf("Validate error: %v", err)
+			}
+
+			var paths []string
+			for _, issue := range result.Issues {
+				if strings.Contains(issue.Diagnostics, "invalid id format") {
+					paths = append(paths, issue.Expression[0])
+				}
+			}
+
+			if tt.wantPath == "" && len(paths) > 0 {
+				t.Errorf("Unexpected id format errors at %v", paths)
+			}
+			if tt.wantPath != "" && (len(paths) != 1 || paths[0] != tt.wantPath) {
+				t.Errorf("Expected one id format error at %s, got %v", tt.wantPath, paths)
+			}
+		})
+	}
+}
+
 // Tests for oid format validation using Extension.valueOid
 func TestValidateOidFormat(t *testing.T) {
 	v := setupTestValidator(t)
@@ -3019,3 +3722,551 @@ func TestValidateUuidFormat(t *testing.T) {
 		})
 	}
 }
+
+// arrayTestDefs are a minimal Patient with repeating names and given names.
+var arrayTestDefs = []*StructureDef{
+	{
+		URL:  "http://hl7.org/fhir/StructureDefinition/Patient",
+		Name: "Patient",
+		Type: "Patient",
+		Kind: "resource",
+		Snapshot: []ElementDef{
+			{Path: "Patient", Min: 0, Max: "*"},
+			{Path: "Patient.id", Min: 0, Max: "1", Types: []TypeRef{{Code: "id"}}},
+			{Path: "Patient.name", Min: 0, Max: "*", Types: []TypeRef{{Code: "HumanName"}}},
+		},
+	},
+	{
+		URL:  "http://hl7.org/fhir/StructureDefinition/HumanName",
+		Name: "HumanName",
+		Type: "HumanName",
+		Kind: "complex-type",
+		Snapshot: []ElementDef{
+			{Path: "HumanName", Min: 0, Max: "*"},
+			{Path: "HumanName.family", Min: 0, Max: "1", Types: []TypeRef{{Code: "string"}}},
+			{Path: "HumanName.given", Min: 0, Max: "*", Types: []TypeRef{{Code: "string"}}},
+		},
+	},
+}
+
+// TestValidateEmptyArrays tests that empty arrays are structural errors, even for optional elements.
+func TestValidateEmptyArrays(t *testing.T) {
+	v := newMinimalValidator(t, ValidatorOptions{}, arrayTestDefs...)
+
+	tests := []struct {
+		name     string
+		resource string
+		wantPath string
+	}{
+		{
+			name:     "empty name",
+			resource: `{"resourceType": "Patient", "id": "p1", "name": []}`,
+			wantPath: "Patient.name",
+		},
+		{
+			name:     "empty given",
+			resource: `{"resourceType": "Patient", "id": "p1", "name": [{"family": "Doe", "given": []}]}`,
+			wantPath: "Patient.name[0].given",
+		},
+		{
+			name:     "non-empty arrays",
+			resource: `{"resourceType": "Patient", "id": "p1", "name": [{"family": "Doe", "given": ["John"]}]}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := v.Validate(context.Background(), []byte(tt.resource))
+			if err != nil {
+				t.Fatalf("Validate error: %v", err)
+			}
+
+			var emptyArrayIssues []ValidationIssue
+			for _, issue := range result.Issues {
+				if issue.Code == IssueCodeStructure && strings.Contains(issue.Diagnostics, "is empty") {
+					emptyArrayIssues = append(emptyArrayIssues, issue)
+				}
+			}
+			if tt.wantPath == "" {
+				if !result.Valid {
+					t.Errorf("expected valid resource, got issues: %v", result.Issues)
+				}
+				return
+			}
+			if result.Valid {
+				t.Error("expected resource with an empty array to be invalid")
+			}
+			if len(emptyArrayIssues) != 1 || emptyArrayIssues[0].Severity != SeverityError ||
+				len(emptyArrayIssues[0].Expression) != 1 || emptyArrayIssues[0].Expression[0] != tt.wantPath {
+				t.Errorf("empty array issues = %v, want one error at %s", emptyArrayIssues, tt.wantPath)
+			}
+		})
+	}
+}
+
+// TestValidateArrayNulls tests that nulls are only accepted to align primitive arrays with their extensions.
+func TestValidateArrayNulls(t *testing.T) {
+	v := newMinimalValidator(t, ValidatorOptions{}, arrayTestDefs...)
+	ext := `{"extension": [{"url": "http://example.org/initial", "valueBoolean": true}]}`
+
+	tests := []struct {
+		name      string
+		name0     string
+		wantPaths []string
+	}{
+		{
+			name:  "null aligned with an extension",
+			name0: `{"given": ["John", null], "_given": [null, ` + ext + `]}`,
+		},
+		{
+			name:      "standalone null",
+			name0:     `{"given": ["John", null]}`,
+			wantPaths: []string{"Patient.name[0].given[1]"},
+		},
+		{
+			name:      "null at the same index in both arrays",
+			name0:     `{"given": ["John", null], "_given": [` + ext + `, null]}`,
+			wantPaths: []string{"Patient.name[0].given[1]", "Patient.name[0]._given[1]"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resource := `{"resourceType": "Patient", "id": "p1", "name": [` + tt.name0 + `]}`
+			result, err := v.Validate(context.Background(), []byte(resource))
+			if err != nil {
+				t.Fatalf("Validate error: %v", err)
+			}
+
+			var gotPaths []string
+			for _, issue := range result.Issues {
+				if issue.Code == IssueCodeStructure && strings.Contains(issue.Diagnostics, "null") {
+					gotPaths = append(gotPaths, issue.Expression...)
+				}
+			}
+			if len(tt.wantPaths) == 0 {
+				if !result.Valid {
+					t.Errorf("expected valid resource, got issues: %v", result.Issues)
+				}
+				return
+			}
+			if len(gotPaths) != len(tt.wantPaths) {
+				t.Fatalf("null issues at %v, want %v", gotPaths, tt.wantPaths)
+			}
+			for _, want := range tt.wantPaths {
+				found := false
+				for _, got := range gotPaths {
+					found = found || got == want
+				}
+				if !found {
+					t.Errorf("null issues at %v, want one at %s", gotPaths, want)
+				}
+			}
+		})
+	}
+}
+
+// TestValidateArrayPairLength tests that a primitive array and its "_" sibling must have the same length.
+func TestValidateArrayPairLength(t *testing.T) {
+	v := newMinimalValidator(t, ValidatorOptions{}, arrayTestDefs...)
+	ext := `{"extension": [{"url": "http://example.org/initial", "valueBoolean": true}]}`
+
+	tests := []struct {
+		name    string
+		name0   string
+		wantErr bool
+	}{
+		{"equal lengths", `{"given": ["John", "Q"], "_given": [null, ` + ext + `]}`, false},
+		{"extension array shorter", `{"given": ["John", "Q"], "_given": [` + ext + `]}`, true},
+		{"extension array longer", `{"given": ["John"], "_given": [` + ext + `, ` + ext + `]}`, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resource := `{"resourceType": "Patient", "id": "p1", "name": [` + tt.name0 + `]}`
+			result, err := v.Validate(context.Background(), []byte(resource))
+			if err != nil {
+				t.Fatalf("Validate error: %v", err)
+			}
+
+			var mismatches []ValidationIssue
+			for _, issue := range result.Issues {
+				if issue.Code == IssueCodeStructure && strings.Contains(issue.Diagnostics, "must be aligned") {
+					mismatches = append(mismatches, issue)
+				}
+			}
+			if !tt.wantErr {
+				if !result.Valid {
+					t.Errorf("expected valid resource, got issues: %v", result.Issues)
+				}
+				return
+			}
+			if len(mismatches) != 1 || mismatches[0].Expression[0] != "Patient.name[0].given" {
+				t.Errorf("length mismatch issues = %v, want one at Patient.name[0].given", mismatches)
+			}
+		})
+	}
+}
+
+// TestRegisterResourceValidator tests that custom validators run for their resource type only.
+func TestRegisterResourceValidator(t *testing.T) {
+	v := newMinimalValidator(t, ValidatorOptions{}, arrayTestDefs...)
+
+	var calls []string
+	v.RegisterResourceValidator("Patient", func(_ context.Context, resource *ResourceContext, result *ValidationResult) {
+		calls = append(calls, "first:"+resource.Path)
+		if resource.StructureDefinition == nil || resource.StructureDefinition.Type != "Patient" {
+			t.Errorf("StructureDefinition = %v, want the Patient definition", resource.StructureDefinition)
+		}
+		if _, ok := resource.Resource["name"]; !ok {
+			result.AddIssue(ValidationIssue{
+				Severity:    SeverityError,
+				Code:        IssueCodeBusinessRule,
+				Diagnostics: "Patient must have a name",
+				Expression:  []string{resource.Path},
+			})
+		}
+	}).RegisterResourceValidator("Patient", func(_ context.Context, resource *ResourceContext, _ *ValidationResult) {
+		calls = append(calls, "second:"+resource.Path)
+	}).RegisterResourceValidator("Observation", func(_ context.Context, _ *ResourceContext, _ *ValidationResult) {
+		t.Error("Observation validator must not run for a Patient")
+	})
+
+	result, err := v.Validate(context.Background(), []byte(`{"resourceType": "Patient", "id": "p1"}`))
+	if err != nil {
+		t.Fatalf("Validate() error = %v", err)
+	}
+	if result.Valid {
+		t.Error("expected the custom validator to make the resource invalid")
+	}
+	if len(result.Issues) != 1 || result.Issues[0].Code != IssueCodeBusinessRule || result.Issues[0].Expression[0] != "Patient" {
+		t.Errorf("Issues = %+v, want a single business-rule issue at Patient", result.Issues)
+	}
+	if got := strings.Join(calls, ","); got != "first:Patient,second:Patient" {
+		t.Errorf("calls = %s, want the Patient validators in registration order", got)
+	}
+
+	calls = nil
+	result, err = v.Validate(context.Background(), []byte(`{"resourceType": "Patient", "name": [{"family": "Doe"}]}`))
+	if err != nil {
+		t.Fatalf("Validate() error = %v", err)
+	}
+	if !result.Valid {
+		t.Errorf("expected valid result, got issues %+v", result.Issues)
+	}
+	if len(calls) != 2 {
+		t.Errorf("calls = %v, want both Patient validators to run", calls)
+	}
+}
+
+// TestValidateInheritedConstraints tests that constraints inherited from datatypes
+// and base profiles are evaluated, once per element, for every present element.
+func TestValidateInheritedConstraints(t *testing.T) {
+	const patientURL = "http://hl7.org/fhir/StructureDefinition/Patient"
+	// A datatype constraint, repeated in the snapshot as on a sliced element
+	hnm := ElementConstraint{
+		Key:        "hnm-test",
+		Severity:   "error",
+		Human:      "A name needs a family or a given name",
+		Expression: "family.exists() or given.exists()",
+		Source:     "http://hl7.org/fhir/StructureDefinition/HumanName",
+	}
+	reg := NewRegistry(FHIRVersionR4)
+	for _, sd := range []*StructureDef{
+		{
+			URL:  patientURL,
+			Name: "Patient",
+			Type: "Patient",
+			Kind: "resource",
+			Snapshot: []ElementDef{
+				{Path: "Patient", Min: 0, Max: "*"},
+				{Path: "Patient.id", Min: 0, Max: "1", Types: []TypeRef{{Code: "id"}}},
+				{Path: "Patient.name", Min: 0, Max: "*", Types: []TypeRef{{Code: "HumanName"}}, Constraints: []ElementConstraint{hnm}},
+				{Path: "Patient.name", SliceName: "official", Min: 0, Max: "1", Types: []TypeRef{{Code: "HumanName"}}, Constraints: []ElementConstraint{hnm}},
+				{Path: "Patient.name.family", Min: 0, Max: "1", Types: []TypeRef{{Code: "string"}}, Constraints: []ElementConstraint{{
+					Key:        "fam-test",
+					Severity:   "error",
+					Human:      "Family names are upper case",
+					Expression: "$this = upper()",
+					Source:     patientURL,
+				}}},
+				{Path: "Patient.name.given", Min: 0, Max: "*", Types: []TypeRef{{Code: "string"}}},
+			},
+		},
+		{
+			URL:            "http://example.org/StructureDefinition/my-patient",
+			Name:           "MyPatient",
+			Type:           "Patient",
+			Kind:           "resource",
+			BaseDefinition: patientURL,
+			Snapshot: []ElementDef{
+				{Path: "Patient", Min: 0, Max: "*", Constraints: []ElementConstraint{{
+					Key:        "pat-test",
+					Severity:   "error",
+					Human:      "A patient needs an id",
+					Expression: "id.exists()",
+					Source:     patientURL,
+				}}},
+				{Path: "Patient.id", Min: 0, Max: "1", Types: []TypeRef{{Code: "id"}}},
+				{Path: "Patient.name", Min: 0, Max: "*", Types: []TypeRef{{Code: "HumanName"}}},
+			},
+		},
+	} {
+		if err := reg.Register(sd); err != nil {
+			t.Fatalf("Register(%s) error = %v", sd.Name, err)
+		}
+	}
+	v := NewValidator(reg, ValidatorOptions{ValidateConstraints: true})
+
+	invariants := func(t *testing.T, v *Validator, resource string) map[string]int {
+		t.Helper()
+		result, err := v.Validate(context.Background(), []byte(resource))
+		if err != nil {
+			t.Fatalf("Validate() error = %v", err)
+		}
+		counts := make(map[string]int)
+		for _, issue := range result.Issues {
+			if issue.Code == IssueCodeInvariant && issue.Constraint != nil {
+				counts[issue.Constraint.Key]++
+			}
+		}
+		return counts
+	}
+
+	t.Run("datatype constraint is evaluated once", func(t *testing.T) {
+		counts := invariants(t, v, `{"resourceType": "Patient", "id": "p1", "name": [{"text": "John"}]}`)
+		if counts["hnm-test"] != 1 {
+			t.Errorf("hnm-test issues = %d, want 1 (counts = %v)", counts["hnm-test"], counts)
+		}
+	})
+
+	t.Run("element constraint applies to any array item", func(t *testing.T) {
+		counts := invariants(t, v, `{"resourceType": "Patient", "id": "p1", "name": [{"given": ["John"]}, {"family": "Doe"}]}`)
+		if counts["fam-test"] != 1 {
+			t.Errorf("fam-test issues = %d, want 1 (counts = %v)", counts["fam-test"], counts)
+		}
+	})
+
+	t.Run("absent element constraints are not evaluated", func(t *testing.T) {
+		counts := invariants(t, v, `{"resourceType": "Patient", "id": "p1"}`)
+		if len(counts) != 0 {
+			t.Errorf("invariant issues = %v, want none", counts)
+		}
+	})
+
+	t.Run("base profile constraint is evaluated", func(t *testing.T) {
+		profiled := NewValidator(reg, ValidatorOptions{
+			ValidateConstraints: true,
+			Profile:             "http://example.org/StructureDefinition/my-patient",
+		})
+		counts := invariants(t, profiled, `{"resourceType": "Patient", "active": true}`)
+		if counts["pat-test"] != 1 {
+			t.Errorf("pat-test issues = %d, want 1 (counts = %v)", counts["pat-test"], counts)
+		}
+	})
+}
+
+// TestValidationResultAsError tests converting a validation result to a Go error.
+func TestValidationResultAsError(t *testing.T) {
+	v := newMinimalValidator(t, ValidatorOptions{}, arrayTestDefs...)
+
+	t.Run("valid resource", func(t *testing.T) {
+		result, err := v.Validate(context.Background(), []byte(`{"resourceType": "Patient", "id": "p1", "name": [{"family": "Doe"}]}`))
+		if err != nil {
+			t.Fatalf("Validate() error = %v", err)
+		}
+		if err := result.AsError(); err != nil {
+			t.Errorf("AsError() = %v, want nil", err)
+		}
+	})
+
+	t.Run("invalid resource", func(t *testing.T) {
+		result, err := v.Validate(context.Background(), []byte(`{"resourceType": "Patient", "id": "p1", "name": []}`))
+		if err != nil {
+			t.Fatalf("Validate() error = %v", err)
+		}
+		err = result.AsError()
+		if err == nil {
+			t.Fatal("AsError() = nil, want an error")
+		}
+		if !strings.HasPrefix(err.Error(), "validation failed with 1 error: error [") || !strings.Contains(err.Error(), "Patient.name") {
+			t.Errorf("AsError() message = %q", err.Error())
+		}
+
+		var validationErr *ValidationError
+		if !errors.As(err, &validationErr) || len(validationErr.Issues) != 1 {
+			t.Fatalf("errors.As(*ValidationError) = %v", validationErr)
+		}
+		var issue ValidationIssue
+		if !errors.As(err, &issue) || issue.Expression[0] != "Patient.name" {
+			t.Errorf("errors.As(ValidationIssue) = %+v", issue)
+		}
+	})
+
+	t.Run("summary of many issues", func(t *testing.T) {
+		result := NewValidationResult()
+		result.AddIssue(ValidationIssue{Severity: SeverityWarning, Code: IssueCodeValue, Diagnostics: "ignored"})
+		for i := 0; i < 5; i++ {
+			result.AddIssue(ValidationIssue{
+				Severity:    SeverityError,
+				Code:        IssueCodeRequired,
+				Diagnostics: fmt.Sprintf("missing %d", i),
+				Expression:  []string{fmt.Sprintf("Patient.field%d", i)},
+			})
+		}
+		want := "validation failed with 5 errors: error [required] Patient.field0: missing 0; " +
+			"error [required] Patient.field1: missing 1; error [required] Patient.field2: missing 2 (and 2 more)"
+		if err := result.AsError(); err == nil || err.Error() != want {
+			t.Errorf("AsError() = %v, want %s", err, want)
+		}
+	})
+}
+
+func TestValidationIssueDetails(t *testing.T) {
+	registry := NewRegistry(FHIRVersionR4)
+	err := registry.Register(&StructureDef{
+		URL:  "http://hl7.org/fhir/StructureDefinition/Observation",
+		Name: "Observation",
+		Type: "Observation",
+		Kind: "resource",
+		Snapshot: []ElementDef{
+			{Path: "Observation", Min: 0, Max: "*"},
+			{Path: "Observation.status", Min: 1, Max: "1", Types: []TypeRef{{Code: "code"}}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Register failed: %v", err)
+	}
+	v := NewValidator(registry, ValidatorOptions{})
+
+	result, err := v.Validate(context.Background(), []byte(`{"resourceType": "Observation", "statis": "final"}`))
+	if err != nil {
+		t.Fatalf("Validate error: %v", err)
+	}
+
+	details := make(map[string]string)
+	for _, issue := range result.Issues {
+		if issue.Details == nil {
+			t.Fatalf("Issue without details: %v", issue)
+		}
+		if issue.Details.System != IssueDetailsSystem {
+			t.Errorf("Details system = %s, want %s", issue.Details.System, IssueDetailsSystem)
+		}
+		details[issue.Expression[0]] = issue.Details.Code
+	}
+	if details["Observation.status"] != DetailsCardinalityMin {
+		t.Errorf("Expected %s for the missing status, got %v", DetailsCardinalityMin, details)
+	}
+	if details["Observation.statis"] != DetailsUnknownElement {
+		t.Errorf("Expected %s for the unknown element, got %v", DetailsUnknownElement, details)
+	}
+
+	t.Run("default details", func(t *testing.T) {
+		result := NewValidationResult()
+		result.AddIssue(ValidationIssue{Severity: SeverityError, Code: IssueCodeCodeInvalid, Diagnostics: "bad code"})
+		if got := result.Issues[0].Details; got == nil || got.Code != "GOFHIR-CODE-INVALID" {
+			t.Errorf("Details = %+v, want GOFHIR-CODE-INVALID", got)
+		}
+	})
+
+	t.Run("preserved in the OperationOutcome", func(t *testing.T) {
+		data, err := json.Marshal(result.ToOperationOutcome())
+		if err != nil {
+			t.Fatalf("Marshal error: %v", err)
+		}
+		var outcome struct {
+			ResourceType string `json:"resourceType"`
+			Issue        []struct {
+				Expression []string `json:"expression"`
+				Details    struct {
+					Coding []struct {
+						System string `json:"system"`
+						Code   string `json:"code"`
+					} `json:"coding"`
+					Text string `json:"text"`
+				} `json:"details"`
+			} `json:"issue"`
+		}
+		if err := json.Unmarshal(data, &outcome); err != nil {
+			t.Fatalf("Unmarshal error: %v", err)
+		}
+		if outcome.ResourceType != "OperationOutcome" || len(outcome.Issue) != len(result.Issues) {
+			t.Fatalf("Unexpected OperationOutcome: %s", data)
+		}
+		for _, issue := range outcome.Issue {
+			if issue.Expression[0] != "Observation.status" {
+				continue
+			}
+			coding := issue.Details.Coding
+			if len(coding) != 1 || coding[0].System != IssueDetailsSystem || coding[0].Code != DetailsCardinalityMin || issue.Details.Text == "" {
+				t.Errorf("Unexpected details of the missing status: %+v", issue.Details)
+			}
+		}
+
+		data, err = json.Marshal(result.Issues[0])
+		if err != nil {
+			t.Fatalf("Marshal error: %v", err)
+		}
+		var decoded ValidationIssue
+		if err := json.Unmarshal(data, &decoded); err != nil {
+			t.Fatalf("Unmarshal error: %v", err)
+		}
+		if decoded.Details == nil || *decoded.Details != *result.Issues[0].Details {
+			t.Errorf("Details round trip = %+v, want %+v", decoded.Details, result.Issues[0].Details)
+		}
+	})
+
+	t.Run("empty result", func(t *testing.T) {
+		outcome := NewValidationResult().ToOperationOutcome()
+		if len(outcome.Issue) != 1 || outcome.Issue[0].Diagnostics != "All OK" {
+			t.Errorf("Expected a single All OK issue, got %+v", outcome.Issue)
+		}
+	})
+}
+
+func TestValidateIssueOrderIsDeterministic(t *testing.T) {
+	registry := NewRegistry(FHIRVersionR4)
+	if err := registry.Register(&StructureDef{
+		URL:  "http://hl7.org/fhir/StructureDefinition/Patient",
+		Name: "Patient",
+		Type: "Patient",
+		Kind: "resource",
+		Snapshot: []ElementDef{
+			{Path: "Patient", Min: 0, Max: "*"},
+			{Path: "Patient.active", Min: 0, Max: "1", Types: []TypeRef{{Code: "boolean"}}},
+			{Path: "Patient.gender", Min: 1, Max: "1", Types: []TypeRef{{Code: "code"}}},
+			{Path: "Patient.birthDate", Min: 0, Max: "1", Types: []TypeRef{{Code: "date"}}},
+		},
+	}); err != nil {
+		t.Fatalf("Register failed: %v", err)
+	}
+	v := NewValidator(registry, ValidatorOptions{})
+	resource := []byte(`{"resourceType": "Patient", "zeta": 1, "active": "yes", "birthDate": "soon",
+		"alpha": true, "mu": "x", "beta": [], "omega": {}}`)
+
+	first, err := v.Validate(context.Background(), resource)
+	if err != nil {
+		t.Fatalf("Validate failed: %v", err)
+	}
+	if len(first.Issues) < 5 {
+		t.Fatalf("Expected several issues, got %v", first.Issues)
+	}
+	for i := 1; i < len(first.Issues); i++ {
+		if issuePath(first.Issues[i-1]) > issuePath(first.Issues[i]) {
+			t.Errorf("Issues not sorted by path: %s before %s", issuePath(first.Issues[i-1]), issuePath(first.Issues[i]))
+		}
+	}
+
+	// Map iteration order is randomized, so compare several runs
+	for run := 0; run < 10; run++ {
+		again, err := v.Validate(context.Background(), resource)
+		if err != nil {
+			t.Fatalf("Validate failed: %v", err)
+		}
+		if !slices.EqualFunc(first.Issues, again.Issues, func(a, b ValidationIssue) bool {
+			return a.Error() == b.Error()
+		}) {
+			t.Fatalf("Issue order differs between runs:\n%v\n%v", first.Issues, again.Issues)
+		}
+	}
+}